@@ -1,5 +1,6 @@
 //go:generate go run pkg/codegen/buildconfig/writer.go pkg/codegen/buildconfig/main.go
 //go:generate go run pkg/codegen/generator/cleanup/main.go
 //go:generate go run pkg/codegen/main.go
+//go:generate scripts/build-applyconfigurations
 //go:generate scripts/build-crds
 package main
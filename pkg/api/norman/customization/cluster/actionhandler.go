@@ -34,14 +34,15 @@ func (a ActionHandler) ClusterActionHandler(actionName string, action *types.Act
 	return httperror.NewAPIError(httperror.NotFound, "not found")
 }
 
-// ensureClusterToken will create a new kubeconfig token for the user in the provided context with the default TTL.
-func (a ActionHandler) ensureClusterToken(clusterID string, apiContext *types.APIContext) (string, error) {
-	input, err := a.createTokenInput(apiContext)
+// ensureClusterToken will create a new kubeconfig token for the user in the provided context, scoped to the cluster,
+// with the kubeconfig token TTL of the cluster.
+func (a ActionHandler) ensureClusterToken(cluster *mgmtclient.Cluster, apiContext *types.APIContext) (string, error) {
+	input, err := a.createTokenInput(cluster, apiContext)
 	if err != nil {
 		return "", err
 	}
 
-	tokenKey, _, err := a.UserMgr.EnsureClusterToken(clusterID, input)
+	tokenKey, _, err := a.UserMgr.EnsureClusterToken(cluster.ID, input)
 	if err != nil {
 		return "", err
 	}
@@ -49,9 +50,10 @@ func (a ActionHandler) ensureClusterToken(clusterID string, apiContext *types.AP
 	return tokenKey, nil
 }
 
-// ensureToken will create a new kubeconfig token for the user in the provided context with the default TTL.
-func (a ActionHandler) ensureToken(apiContext *types.APIContext) (string, error) {
-	input, err := a.createTokenInput(apiContext)
+// ensureToken will create a new kubeconfig token for the user in the provided context with the kubeconfig token TTL of
+// the cluster.
+func (a ActionHandler) ensureToken(cluster *mgmtclient.Cluster, apiContext *types.APIContext) (string, error) {
+	input, err := a.createTokenInput(cluster, apiContext)
 	if err != nil {
 		return "", err
 	}
@@ -64,8 +66,9 @@ func (a ActionHandler) ensureToken(apiContext *types.APIContext) (string, error)
 	return tokenKey, nil
 }
 
-// createTokenInput will create the input for a new kubeconfig token with the default TTL.
-func (a ActionHandler) createTokenInput(apiContext *types.APIContext) (user.TokenInput, error) {
+// createTokenInput will create the input for a new kubeconfig token with the kubeconfig token TTL of the cluster, which
+// defaults to the default TTL.
+func (a ActionHandler) createTokenInput(cluster *mgmtclient.Cluster, apiContext *types.APIContext) (user.TokenInput, error) {
 	userName := a.UserMgr.GetUser(apiContext)
 	tokenNamePrefix := fmt.Sprintf("kubeconfig-%s", userName)
 
@@ -74,9 +77,9 @@ func (a ActionHandler) createTokenInput(apiContext *types.APIContext) (user.Toke
 		return user.TokenInput{}, err
 	}
 
-	defaultTokenTTL, err := tokens.GetKubeconfigDefaultTokenTTLInMilliSeconds()
+	tokenTTL, err := tokens.GetClusterKubeconfigTokenTTLInMilliSeconds(cluster.Annotations)
 	if err != nil {
-		return user.TokenInput{}, fmt.Errorf("failed to get default token TTL: %w", err)
+		return user.TokenInput{}, fmt.Errorf("failed to get token TTL for cluster %s: %w", cluster.ID, err)
 	}
 
 	return user.TokenInput{
@@ -85,14 +88,14 @@ func (a ActionHandler) createTokenInput(apiContext *types.APIContext) (user.Toke
 		Kind:          "kubeconfig",
 		UserName:      userName,
 		AuthProvider:  authToken.GetAuthProvider(),
-		TTL:           defaultTokenTTL,
+		TTL:           tokenTTL,
 		Randomize:     true,
 		UserPrincipal: authToken.GetUserPrincipal(),
 	}, nil
 }
 
 func (a ActionHandler) generateKubeConfig(apiContext *types.APIContext, cluster *mgmtclient.Cluster) (*clientcmdapi.Config, error) {
-	token, err := a.ensureToken(apiContext)
+	token, err := a.ensureToken(cluster, apiContext)
 	if err != nil {
 		return nil, err
 	}
@@ -39,9 +39,9 @@ func (a ActionHandler) GenerateKubeconfigActionHandler(actionName string, action
 	if generateToken {
 		// generate token and place it in kubeconfig, token doesn't expire
 		if endpointEnabled {
-			tokenKey, err = a.ensureClusterToken(cluster.ID, apiContext)
+			tokenKey, err = a.ensureClusterToken(&cluster, apiContext)
 		} else {
-			tokenKey, err = a.ensureToken(apiContext)
+			tokenKey, err = a.ensureToken(&cluster, apiContext)
 		}
 		if err != nil {
 			return err
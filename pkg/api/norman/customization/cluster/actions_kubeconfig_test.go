@@ -240,7 +240,7 @@ func (f *fakeUserManager) DeleteToken(tokenName string) error { return nil }
 func (f *fakeUserManager) EnsureUser(principalName, displayName string) (*apimgmtv3.User, error) {
 	return nil, nil
 }
-func (f *fakeUserManager) CheckAccess(accessMode string, allowedPrincipalIDs []string, userPrincipalID string, groups []apimgmtv3.Principal) (bool, error) {
+func (f *fakeUserManager) CheckAccess(accessMode string, allowedPrincipalIDs, deniedPrincipalIDs []string, userPrincipalID string, groups []apimgmtv3.Principal) (bool, error) {
 	return false, nil
 }
 func (f *fakeUserManager) SetPrincipalOnCurrentUserByUserID(userID string, principal apimgmtv3.Principal) (*apimgmtv3.User, error) {
@@ -5,9 +5,23 @@
 
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/types/config"
 )
 
-func Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+func NewValidator(management *config.ScaledContext) types.Validator {
+	v := &validator{
+		escalationChecker: rbac.NewEscalationChecker(management.Wrangler.Mgmt, management.Wrangler.RBAC),
+	}
+	return v.Validator
+}
+
+type validator struct {
+	escalationChecker *rbac.EscalationChecker
+}
+
+func (v *validator) Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
 	if request.Method == http.MethodPut {
 		return nil
 	}
@@ -19,5 +33,15 @@ func Validator(request *types.APIContext, schema *types.Schema, data map[string]
 		return httperror.NewAPIError(httperror.InvalidBodyContent, "must contain field [groupPrincipalId] "+
 			"OR field [userId]")
 	}
+
+	// Reject the binding if the user creating it doesn't hold the permissions it grants.
+	u, ok := rbac.RequestUser(request.Request)
+	if !ok {
+		return httperror.NewAPIError(httperror.PermissionDenied, "unable to determine the user creating the binding")
+	}
+	globalRoleName, _ := data[client.GlobalRoleBindingFieldGlobalRoleID].(string)
+	if err := v.escalationChecker.CheckGlobalRoleBinding(u, globalRoleName); err != nil {
+		return httperror.NewAPIError(httperror.PermissionDenied, err.Error())
+	}
 	return nil
 }
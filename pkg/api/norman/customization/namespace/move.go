@@ -0,0 +1,88 @@
+package namespace
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rancher/norman/httperror"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementagent/nslabels"
+	validate "github.com/rancher/rancher/pkg/resourcequota"
+	"github.com/rancher/rancher/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const resourceQuotaAnnotation = "field.cattle.io/resourceQuota"
+
+// moveToProject sets the project of the namespace in a single update, so that the annotation and label of the project
+// and the resource quota of the namespace never disagree. The RoleBindings derived from the ProjectRoleTemplateBindings
+// of the previous project are then replaced with the ones of the new project by the RBAC controllers of the cluster.
+// If the new project has a resource quota, the namespace gets the default namespace quota of the project, which must
+// fit in the project quota along with the quotas of the other namespaces of the project.
+func moveToProject(ns *corev1.Namespace, projectID string, project *v3.Project, projectNamespaces []corev1.Namespace) (*corev1.Namespace, error) {
+	toUpdate := ns.DeepCopy()
+	if toUpdate.Annotations == nil {
+		toUpdate.Annotations = map[string]string{}
+	}
+	if toUpdate.Labels == nil {
+		toUpdate.Labels = map[string]string{}
+	}
+
+	if project == nil {
+		delete(toUpdate.Annotations, nslabels.ProjectIDFieldLabel)
+		delete(toUpdate.Labels, nslabels.ProjectIDFieldLabel)
+		delete(toUpdate.Annotations, resourceQuotaAnnotation)
+		return toUpdate, nil
+	}
+
+	toUpdate.Annotations[nslabels.ProjectIDFieldLabel] = projectID
+	toUpdate.Labels[nslabels.ProjectIDFieldLabel] = project.Name
+
+	if project.Spec.ResourceQuota == nil {
+		delete(toUpdate.Annotations, resourceQuotaAnnotation)
+		return toUpdate, nil
+	}
+
+	nsQuota := &v3.NamespaceResourceQuota{}
+	if project.Spec.NamespaceDefaultResourceQuota != nil {
+		nsQuota = project.Spec.NamespaceDefaultResourceQuota.DeepCopy()
+	}
+
+	var nsLimits []*v3.ResourceQuotaLimit
+	for _, other := range projectNamespaces {
+		if other.Name == ns.Name || other.Annotations[nslabels.ProjectIDFieldLabel] != projectID {
+			continue
+		}
+		value := other.Annotations[resourceQuotaAnnotation]
+		if value == "" {
+			continue
+		}
+		otherQuota := &v3.NamespaceResourceQuota{}
+		if err := json.Unmarshal([]byte(value), otherQuota); err != nil {
+			return nil, fmt.Errorf("failed to parse resource quota of namespace %s: %w", other.Name, err)
+		}
+		nsLimits = append(nsLimits, &otherQuota.Limit)
+	}
+
+	isFit, exceeded, err := validate.IsQuotaFit(&nsQuota.Limit, nsLimits, &project.Spec.ResourceQuota.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if !isFit {
+		return nil, httperror.NewAPIError(httperror.MaxLimitExceeded,
+			fmt.Sprintf("can't move namespace %s to project %s: resource quota [%s] exceeds project limit", ns.Name, project.Spec.DisplayName, utils.FormatResourceList(exceeded)))
+	}
+
+	b, err := json.Marshal(nsQuota)
+	if err != nil {
+		return nil, err
+	}
+	toUpdate.Annotations[resourceQuotaAnnotation] = string(b)
+	return toUpdate, nil
+}
+
+// projectNamespacesSelector selects the namespaces of the project.
+func projectNamespacesSelector(projectName string) string {
+	return labels.Set{nslabels.ProjectIDFieldLabel: projectName}.String()
+}
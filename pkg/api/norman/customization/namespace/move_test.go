@@ -0,0 +1,107 @@
+package namespace
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMoveToProject(t *testing.T) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "ns",
+			Annotations: map[string]string{
+				"field.cattle.io/projectId":     "c-abc:p-old",
+				"field.cattle.io/resourceQuota": `{"limit":{"pods":"20"}}`,
+			},
+			Labels: map[string]string{
+				"field.cattle.io/projectId": "p-old",
+			},
+		},
+	}
+	quotaProject := &v3.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "p-new", Namespace: "c-abc"},
+		Spec: v3.ProjectSpec{
+			DisplayName: "new",
+			ResourceQuota: &v3.ProjectResourceQuota{
+				Limit: v3.ResourceQuotaLimit{Pods: "10"},
+			},
+			NamespaceDefaultResourceQuota: &v3.NamespaceResourceQuota{
+				Limit: v3.ResourceQuotaLimit{Pods: "5"},
+			},
+		},
+	}
+	otherNamespace := func(pods string) corev1.Namespace {
+		return corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "other",
+				Annotations: map[string]string{
+					"field.cattle.io/projectId":     "c-abc:p-new",
+					"field.cattle.io/resourceQuota": `{"limit":{"pods":"` + pods + `"}}`,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                string
+		project             *v3.Project
+		projectNamespaces   []corev1.Namespace
+		expectedAnnotations map[string]string
+		expectedLabels      map[string]string
+		expectedErr         string
+	}{
+		{
+			name:                "move out of any project",
+			expectedAnnotations: map[string]string{},
+			expectedLabels:      map[string]string{},
+		},
+		{
+			name: "move to project without quota",
+			project: &v3.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "p-new", Namespace: "c-abc"},
+			},
+			expectedAnnotations: map[string]string{"field.cattle.io/projectId": "c-abc:p-new"},
+			expectedLabels:      map[string]string{"field.cattle.io/projectId": "p-new"},
+		},
+		{
+			name:              "move to project with quota",
+			project:           quotaProject,
+			projectNamespaces: []corev1.Namespace{otherNamespace("5")},
+			expectedAnnotations: map[string]string{
+				"field.cattle.io/projectId":     "c-abc:p-new",
+				"field.cattle.io/resourceQuota": `{"limit":{"pods":"5"}}`,
+			},
+			expectedLabels: map[string]string{"field.cattle.io/projectId": "p-new"},
+		},
+		{
+			name:              "move to project with exhausted quota",
+			project:           quotaProject,
+			projectNamespaces: []corev1.Namespace{otherNamespace("8")},
+			expectedErr:       "can't move namespace ns to project new: resource quota [pods=13] exceeds project limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var projectID string
+			if tt.project != nil {
+				projectID = tt.project.Namespace + ":" + tt.project.Name
+			}
+
+			moved, err := moveToProject(ns, projectID, tt.project, tt.projectNamespaces)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAnnotations, moved.Annotations)
+			assert.Equal(t, tt.expectedLabels, moved.Labels)
+			assert.Equal(t, "c-abc:p-old", ns.Annotations["field.cattle.io/projectId"], "namespace is not modified")
+		})
+	}
+}
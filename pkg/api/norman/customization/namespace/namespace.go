@@ -1,6 +1,7 @@
 package namespace
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
@@ -9,12 +10,14 @@
 	"github.com/rancher/norman/parse"
 	"github.com/rancher/norman/types"
 	"github.com/rancher/norman/types/convert"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	client "github.com/rancher/rancher/pkg/client/generated/cluster/v3"
 	"github.com/rancher/rancher/pkg/clustermanager"
-	"github.com/rancher/rancher/pkg/controllers/managementagent/nslabels"
 	"github.com/rancher/rancher/pkg/rbac"
 	"github.com/rancher/rancher/pkg/ref"
+	validate "github.com/rancher/rancher/pkg/resourcequota"
 	schema "github.com/rancher/rancher/pkg/schemas/cluster.cattle.io/v3"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
@@ -80,15 +83,6 @@ func (w ActionWrapper) ActionHandler(actionName string, action *types.Action, ap
 			}
 			return httperror.NewAPIError(httperror.NotFound, err.Error())
 		}
-		if projectID != "" {
-			project, err := userContext.Management.Management.Projects(clusterID).Get(projectID, metav1.GetOptions{})
-			if err != nil {
-				return err
-			}
-			if project.Spec.ResourceQuota != nil {
-				return errors.Errorf("can't move namespace. Project %s has resource quota set", project.Spec.DisplayName)
-			}
-		}
 		nsClient := userContext.Core.Namespaces("")
 		ns, err := nsClient.Get(apiContext.ID, metav1.GetOptions{})
 		if err != nil {
@@ -97,11 +91,28 @@ func (w ActionWrapper) ActionHandler(actionName string, action *types.Action, ap
 			}
 			return httperror.NewAPIError(httperror.NotFound, err.Error())
 		}
-		if projectID == "" {
-			delete(ns.Annotations, nslabels.ProjectIDFieldLabel)
-			delete(ns.Labels, nslabels.ProjectIDFieldLabel)
-		} else {
-			ns.Annotations[nslabels.ProjectIDFieldLabel] = convert.ToString(actionInput["projectId"])
+		var project *v3.Project
+		var projectNamespaces []corev1.Namespace
+		if projectID != "" {
+			project, err = userContext.Management.Management.Projects(clusterID).Get(projectID, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if project.Spec.ResourceQuota != nil {
+				mu := validate.GetProjectLock(fmt.Sprintf("%s:%s", clusterID, projectID))
+				mu.Lock()
+				defer mu.Unlock()
+
+				list, err := nsClient.List(metav1.ListOptions{LabelSelector: projectNamespacesSelector(projectID)})
+				if err != nil {
+					return err
+				}
+				projectNamespaces = list.Items
+			}
+		}
+		ns, err = moveToProject(ns, convert.ToString(actionInput["projectId"]), project, projectNamespaces)
+		if err != nil {
+			return err
 		}
 		if _, err := nsClient.Update(ns); err != nil {
 			return err
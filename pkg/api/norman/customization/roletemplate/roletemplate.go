@@ -4,16 +4,24 @@
 	"fmt"
 	"net/http"
 
+	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
 	"github.com/rancher/norman/types/convert"
+	apisv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
+	rbacv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac/v1"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
 type Wrapper struct {
-	RoleTemplateLister v3.RoleTemplateLister
+	RoleTemplateLister      v3.RoleTemplateLister
+	RoleTemplateCache       mgmtcontrollers.RoleTemplateCache
+	RoleTemplatePolicyCache mgmtcontrollers.RoleTemplatePolicyCache
+	ClusterRoleCache        rbacv1.ClusterRoleCache
 }
 
 func (w Wrapper) Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
@@ -31,23 +39,68 @@ func (w Wrapper) Validator(request *types.APIContext, schema *types.Schema, data
 		}
 	}
 
-	if request.Method != http.MethodPut {
-		return nil
+	if request.Method == http.MethodPut {
+		rt, err := w.RoleTemplateLister.Get("", request.ID)
+		if err != nil {
+			return err
+		}
+
+		if rt.Builtin == true {
+			// Drop everything but locked and defaults. If it's builtin nothing else can change.
+			for k := range data {
+				if k == "locked" || k == "clusterCreatorDefault" || k == "projectCreatorDefault" {
+					continue
+				}
+				delete(data, k)
+			}
+			return nil
+		}
 	}
-	rt, err := w.RoleTemplateLister.Get("", request.ID)
+
+	return w.validatePolicies(request, data)
+}
+
+// validatePolicies rejects custom roleTemplates granting, directly or through the roleTemplates they inherit, a rule
+// forbidden by a roleTemplatePolicy, and updates making a roleTemplate inheriting the updated one grant such a rule.
+func (w Wrapper) validatePolicies(request *types.APIContext, data map[string]interface{}) error {
+	policies, err := w.RoleTemplatePolicyCache.List(labels.Everything())
 	if err != nil {
-		return err
+		return httperror.WrapAPIError(err, httperror.ServerError, "failed to list roleTemplatePolicies")
+	}
+	if len(policies) == 0 {
+		return nil
 	}
 
-	if rt.Builtin == true {
-		// Drop everything but locked and defaults. If it's builtin nothing else can change.
-		for k := range data {
-			if k == "locked" || k == "clusterCreatorDefault" || k == "projectCreatorDefault" {
-				continue
-			}
-			delete(data, k)
+	rt := &apisv3.RoleTemplate{
+		Context:           convert.ToString(data[client.RoleTemplateFieldContext]),
+		External:          convert.ToBool(data[client.RoleTemplateFieldExternal]),
+		RoleTemplateNames: convert.ToStringSlice(data[client.RoleTemplateFieldRoleTemplateIDs]),
+	}
+	rt.Name = request.ID
+	if rt.Name == "" {
+		rt.Name = convert.ToString(data[client.RoleTemplateFieldName])
+	}
+	if err := convert.ToObj(data[client.RoleTemplateFieldRules], &rt.Rules); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "roleTemplate rules conversion error")
+	}
+	if err := convert.ToObj(data[client.RoleTemplateFieldExternalRules], &rt.ExternalRules); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "roleTemplate external rules conversion error")
+	}
+
+	if request.ID != "" {
+		if err := rbac.CheckRoleTemplateChange(w.ClusterRoleCache, w.RoleTemplateCache, policies, rt); err != nil {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
 		}
+		return nil
+	}
 
+	// Nothing inherits a roleTemplate being created.
+	rules, err := rbac.RulesFromTemplate(w.ClusterRoleCache, w.RoleTemplateCache, rt)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("failed to gather the rules of roleTemplate %s: %v", rt.Name, err))
+	}
+	if err := rbac.CheckRoleTemplatePolicies(policies, rt, rules); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
 	}
 	return nil
 }
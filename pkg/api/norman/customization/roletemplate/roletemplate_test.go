@@ -1,12 +1,19 @@
 package roletemplate
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
+	apisv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
@@ -18,7 +25,7 @@ func testAsRoletemplate(key string) *types.RawResource {
 	}
 
 	testWrapper := Wrapper{
-		mockRTLister,
+		RoleTemplateLister: mockRTLister,
 	}
 
 	testResource := &types.RawResource{
@@ -81,3 +88,119 @@ func TestDelete(t *testing.T) {
 	assert.Equal(resource.Links["remove"], "")
 	assert.Equal(resource.Links["update"], "/test/link")
 }
+
+func TestValidatorPolicies(t *testing.T) {
+	policies := []*apisv3.RoleTemplatePolicy{
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "secrets"},
+			Spec: apisv3.RoleTemplatePolicySpec{
+				ForbiddenRules: []apisv3.ForbiddenRule{
+					{
+						Description: "secrets can't be read cluster-wide",
+						Contexts:    []string{"cluster"},
+						Resources:   []string{"secrets"},
+					},
+				},
+			},
+		},
+	}
+	secretsRule := map[string]interface{}{
+		"apiGroups": []interface{}{""},
+		"resources": []interface{}{"secrets"},
+		"verbs":     []interface{}{"*"},
+	}
+	podsRule := map[string]interface{}{
+		"apiGroups": []interface{}{""},
+		"resources": []interface{}{"pods"},
+		"verbs":     []interface{}{"*"},
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		id       string
+		data     map[string]interface{}
+		policies []*apisv3.RoleTemplatePolicy
+		wantErr  string
+	}{
+		{
+			name:     "roleTemplate grants allowed rules",
+			method:   http.MethodPost,
+			data:     map[string]interface{}{"name": "rt-1", "context": "cluster", "rules": []interface{}{podsRule}},
+			policies: policies,
+		},
+		{
+			name:     "roleTemplate grants a forbidden rule",
+			method:   http.MethodPost,
+			data:     map[string]interface{}{"name": "rt-1", "context": "cluster", "rules": []interface{}{podsRule, secretsRule}},
+			policies: policies,
+			wantErr:  `roleTemplate rt-1 grants forbidden rules: rule {apiGroups: [""], resources: ["secrets"], verbs: ["*"]} is forbidden by rule 0 of roleTemplatePolicy secrets: secrets can't be read cluster-wide`,
+		},
+		{
+			name:     "roleTemplate inherits a forbidden rule",
+			method:   http.MethodPut,
+			id:       "rt-1",
+			data:     map[string]interface{}{"context": "cluster", "roleTemplateIds": []interface{}{"secrets-reader"}},
+			policies: policies,
+			wantErr:  `roleTemplate rt-1 grants forbidden rules: rule {apiGroups: [""], resources: ["secrets"], verbs: ["get"]} is forbidden by rule 0 of roleTemplatePolicy secrets: secrets can't be read cluster-wide`,
+		},
+		{
+			name:     "roleTemplate inheriting the updated one grants a forbidden rule",
+			method:   http.MethodPut,
+			id:       "rt-2",
+			data:     map[string]interface{}{"context": "project", "rules": []interface{}{secretsRule}},
+			policies: policies,
+			wantErr:  `roleTemplate rt-3 grants forbidden rules: rule {apiGroups: [""], resources: ["secrets"], verbs: ["*"]} is forbidden by rule 0 of roleTemplatePolicy secrets: secrets can't be read cluster-wide`,
+		},
+		{
+			name:   "rules aren't forbidden without policies",
+			method: http.MethodPost,
+			data:   map[string]interface{}{"name": "rt-1", "context": "cluster", "rules": []interface{}{secretsRule}},
+		},
+		{
+			name:     "builtin roleTemplates aren't subject to policies",
+			method:   http.MethodPut,
+			id:       "cluster-owner",
+			data:     map[string]interface{}{"context": "cluster", "rules": []interface{}{secretsRule}},
+			policies: policies,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			policyCache := fake.NewMockNonNamespacedCacheInterface[*apisv3.RoleTemplatePolicy](ctrl)
+			policyCache.EXPECT().List(labels.Everything()).Return(tt.policies, nil).AnyTimes()
+			rtCache := fake.NewMockNonNamespacedCacheInterface[*apisv3.RoleTemplate](ctrl)
+			rtCache.EXPECT().Get("secrets-reader").Return(&apisv3.RoleTemplate{
+				ObjectMeta: v1.ObjectMeta{Name: "secrets-reader"},
+				Context:    "cluster",
+				Rules:      []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}}},
+			}, nil).AnyTimes()
+			rtCache.EXPECT().List(labels.Everything()).Return([]*apisv3.RoleTemplate{
+				{ObjectMeta: v1.ObjectMeta{Name: "rt-1"}, Context: "cluster"},
+				{ObjectMeta: v1.ObjectMeta{Name: "rt-2"}, Context: "project"},
+				{ObjectMeta: v1.ObjectMeta{Name: "rt-3"}, Context: "cluster", RoleTemplateNames: []string{"rt-2"}},
+			}, nil).AnyTimes()
+			w := Wrapper{
+				RoleTemplateLister: &fakes.RoleTemplateListerMock{
+					GetFunc: func(namespace, name string) (*v3.RoleTemplate, error) {
+						return &v3.RoleTemplate{ObjectMeta: v1.ObjectMeta{Name: name}, Builtin: name == "cluster-owner"}, nil
+					},
+				},
+				RoleTemplateCache:       rtCache,
+				RoleTemplatePolicyCache: policyCache,
+				ClusterRoleCache:        fake.NewMockNonNamespacedCacheInterface[*rbacv1.ClusterRole](ctrl),
+			}
+
+			err := w.Validator(&types.APIContext{Method: tt.method, ID: tt.id}, nil, tt.data)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var apiErr *httperror.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, httperror.InvalidBodyContent, apiErr.Code)
+			assert.Equal(t, tt.wantErr, apiErr.Message)
+		})
+	}
+}
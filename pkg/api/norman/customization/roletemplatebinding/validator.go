@@ -8,21 +8,24 @@
 	"github.com/rancher/norman/types"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
 	"github.com/rancher/rancher/pkg/types/config"
 )
 
 func NewPRTBValidator(management *config.ScaledContext) types.Validator {
-	return newValidator(management, client.ProjectRoleTemplateBindingFieldRoleTemplateID, "project")
+	return newValidator(management, client.ProjectRoleTemplateBindingFieldRoleTemplateID, client.ProjectRoleTemplateBindingFieldProjectID, "project")
 }
 
 func NewCRTBValidator(management *config.ScaledContext) types.Validator {
-	return newValidator(management, client.ClusterRoleTemplateBindingFieldRoleTemplateID, "cluster")
+	return newValidator(management, client.ClusterRoleTemplateBindingFieldRoleTemplateID, client.ClusterRoleTemplateBindingFieldClusterID, "cluster")
 }
 
-func newValidator(management *config.ScaledContext, field string, context string) types.Validator {
+func newValidator(management *config.ScaledContext, field, scopeField, context string) types.Validator {
 	validator := &validator{
 		roleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+		escalationChecker:  rbac.NewEscalationChecker(management.Wrangler.Mgmt, management.Wrangler.RBAC),
 		field:              field,
+		scopeField:         scopeField,
 		context:            context,
 	}
 
@@ -31,7 +34,9 @@ func newValidator(management *config.ScaledContext, field string, context string
 
 type validator struct {
 	roleTemplateLister v3.RoleTemplateLister
+	escalationChecker  *rbac.EscalationChecker
 	field              string
+	scopeField         string
 	context            string
 }
 
@@ -68,6 +73,26 @@ func (v *validator) validator(request *types.APIContext, schema *types.Schema, d
 			"OR a group [groupId]/[groupPrincipalId]")
 	}
 
+	return v.checkEscalation(request, roleTemplate.Name, data)
+}
+
+// checkEscalation rejects the binding if the user creating it doesn't hold the permissions it grants.
+func (v *validator) checkEscalation(request *types.APIContext, roleTemplateName string, data map[string]interface{}) error {
+	u, ok := rbac.RequestUser(request.Request)
+	if !ok {
+		return httperror.NewAPIError(httperror.PermissionDenied, "unable to determine the user creating the binding")
+	}
+	scope, _ := data[v.scopeField].(string)
+
+	var err error
+	if v.context == "cluster" {
+		err = v.escalationChecker.CheckClusterRoleTemplateBinding(u, scope, roleTemplateName)
+	} else {
+		err = v.escalationChecker.CheckProjectRoleTemplateBinding(u, scope, roleTemplateName)
+	}
+	if err != nil {
+		return httperror.NewAPIError(httperror.PermissionDenied, err.Error())
+	}
 	return nil
 }
 
@@ -2,15 +2,22 @@
 
 import (
 	"fmt"
+	"strconv"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/rancher/norman/api/access"
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
 	"github.com/rancher/norman/types/convert"
 	"github.com/rancher/norman/types/slice"
 	"github.com/rancher/rancher/pkg/auth/providerrefresh"
+	"github.com/rancher/rancher/pkg/auth/providers"
+	"github.com/rancher/rancher/pkg/auth/requests"
+	"github.com/rancher/rancher/pkg/auth/tokens"
 	v3client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/auth/project_cluster"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
 )
 
 var ReadOnlySettings = []string{
@@ -61,12 +68,35 @@ func Validator(request *types.APIContext, schema *types.Schema, data map[string]
 		return fmt.Errorf("value not string")
 	}
 
-	var err error
-	switch id {
-	case "auth-user-info-max-age-seconds":
-		_, err = providerrefresh.ParseMaxAge(newValueString)
-	case "auth-user-info-resync-cron":
-		_, err = providerrefresh.ParseCron(newValueString)
+	err := settings.Validate(id, newValueString)
+	if err == nil {
+		switch id {
+		case "auth-user-info-max-age-seconds":
+			_, err = providerrefresh.ParseMaxAge(newValueString)
+		case "auth-user-info-resync-cron":
+			_, err = providerrefresh.ParseCron(newValueString)
+		case settings.TrustedJWTIssuers.Name:
+			_, err = requests.ParseTrustedJWTIssuers(newValueString)
+		case settings.ImportKubernetesVersionRange.Name:
+			_, err = semver.NewConstraint(newValueString)
+		case settings.AuthUserSessionIdleTTLMinutes.Name:
+			err = validateSessionIdleTTL(newValueString, settings.AuthUserSessionTTLMinutes.Get())
+		case settings.AuthUserSessionTTLMinutes.Name:
+			err = validateSessionIdleTTL(settings.AuthUserSessionIdleTTLMinutes.Get(), newValueString)
+			if err == nil {
+				err = tokens.ValidateIdleTTLMinutesByKind(settings.AuthUserSessionIdleTTLMinutesByKind.Get(), newValueString, settings.AuthTokenMaxTTLMinutes.Get())
+			}
+		case settings.AuthTokenMaxTTLMinutes.Name:
+			err = tokens.ValidateIdleTTLMinutesByKind(settings.AuthUserSessionIdleTTLMinutesByKind.Get(), settings.AuthUserSessionTTLMinutes.Get(), newValueString)
+		case settings.AuthUserSessionIdleTTLMinutesByKind.Name:
+			err = tokens.ValidateIdleTTLMinutesByKind(newValueString, settings.AuthUserSessionTTLMinutes.Get(), settings.AuthTokenMaxTTLMinutes.Get())
+		case settings.AuthUserSessionMaxTTL.Name:
+			_, err = tokens.ParseSessionMaxTTL(newValueString)
+		case settings.PrincipalSearchTimeoutSecondsByProvider.Name:
+			_, err = providers.ParseSearchTimeoutSecondsByProvider(newValueString)
+		case settings.ClusterDeletionBindingProtection.Name:
+			err = project_cluster.ValidateDeletionBindingProtection(newValueString)
+		}
 	}
 
 	if err != nil {
@@ -75,3 +105,28 @@ func Validator(request *types.APIContext, schema *types.Schema, data map[string]
 
 	return nil
 }
+
+// validateSessionIdleTTL checks that the idle timeout of login sessions isn't greater than their time to live.
+// Empty values stand for the defaults of the settings.
+func validateSessionIdleTTL(idleTTL, ttl string) error {
+	if idleTTL == "" {
+		idleTTL = settings.AuthUserSessionIdleTTLMinutes.Default
+	}
+	if ttl == "" {
+		ttl = settings.AuthUserSessionTTLMinutes.Default
+	}
+
+	idle, err := strconv.Atoi(idleTTL)
+	if err != nil {
+		return nil
+	}
+	session, err := strconv.Atoi(ttl)
+	if err != nil {
+		return nil
+	}
+	if idle > session {
+		return fmt.Errorf("%s (%d) must not be greater than %s (%d)",
+			settings.AuthUserSessionIdleTTLMinutes.Name, idle, settings.AuthUserSessionTTLMinutes.Name, session)
+	}
+	return nil
+}
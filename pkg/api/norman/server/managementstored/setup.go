@@ -330,12 +330,15 @@ func GlobalRoleBindings(schemas *types.Schemas, management *config.ScaledContext
 	schema := schemas.Schema(&managementschema.Version, client.GlobalRoleBindingType)
 	grLister := management.Management.GlobalRoles("").Controller().Lister()
 	schema.Store = grbstore.Wrap(schema.Store, grLister)
-	schema.Validator = globalrolebinding.Validator
+	schema.Validator = globalrolebinding.NewValidator(management)
 }
 
 func RoleTemplate(schemas *types.Schemas, management *config.ScaledContext) {
 	rt := roletemplate.Wrapper{
-		RoleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+		RoleTemplateLister:      management.Management.RoleTemplates("").Controller().Lister(),
+		RoleTemplateCache:       management.Wrangler.Mgmt.RoleTemplate().Cache(),
+		RoleTemplatePolicyCache: management.Wrangler.Mgmt.RoleTemplatePolicy().Cache(),
+		ClusterRoleCache:        management.Wrangler.RBAC.ClusterRole().Cache(),
 	}
 	schema := schemas.Schema(&managementschema.Version, client.RoleTemplateType)
 	schema.Formatter = rt.Formatter
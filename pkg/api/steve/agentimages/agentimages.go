@@ -0,0 +1,104 @@
+// Package agentimages provides a HTTPHandler listing the images needed by the agents of downstream clusters, for
+// air-gapped installs and upgrades. This handler should be registered at Endpoint.
+package agentimages
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rancher/rancher/pkg/auth/util"
+	"github.com/rancher/rancher/pkg/catalogv2/git"
+	catalogcontrollers "github.com/rancher/rancher/pkg/generated/controllers/catalog.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/image"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Endpoint The endpoint that this URL is accessible at - used for routing
+	Endpoint = "/v1/agentimages"
+	// chartsRepo is the repository the system charts of downstream clusters are installed from.
+	chartsRepo = "rancher-charts"
+	logPrefix  = "agent-images"
+)
+
+// Response is the list of images returned by the handler.
+type Response struct {
+	Images []string `json:"images"`
+}
+
+// Handler implements http.Handler - and serves the list of the images needed by the agents and system charts of a
+// downstream cluster. The query parameters are:
+//   - clusterType: either rke2, k3s or imported (required)
+//   - kubernetesVersion: the RKE2/K3s version of the cluster, required for rke2 and k3s clusters
+//   - rancherVersion: the Rancher version the cluster is managed by, defaults to the running version
+//   - registry: the registry the images are pulled from, e.g. the agent image registry of the cluster
+//   - format: json (default) or txt, one image per line like rancher-images.txt
+type Handler struct {
+	clusterRepoCache catalogcontrollers.ClusterRepoCache
+	getImages        func(image.AgentImagesConfig) ([]string, error)
+}
+
+// NewHandler creates a handler listing the system chart images from the local clone of the rancher-charts repository.
+func NewHandler(clusterRepoCache catalogcontrollers.ClusterRepoCache) *Handler {
+	return &Handler{
+		clusterRepoCache: clusterRepoCache,
+		getImages:        image.GetAgentImages,
+	}
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	format := query.Get("format")
+	if format != "" && format != "json" && format != "txt" {
+		util.ReturnHTTPError(writer, request, http.StatusBadRequest, fmt.Sprintf("unsupported format %q", format))
+		return
+	}
+
+	chartsPath, err := h.chartsPath()
+	if err != nil {
+		logrus.Errorf("[%s] Failed to get the %s repository: %v", logPrefix, chartsRepo, err)
+		util.ReturnHTTPError(writer, request, http.StatusServiceUnavailable, fmt.Sprintf("%s repository is not available, try again later", chartsRepo))
+		return
+	}
+
+	images, err := h.getImages(image.AgentImagesConfig{
+		RancherVersion:    query.Get("rancherVersion"),
+		ClusterType:       query.Get("clusterType"),
+		KubernetesVersion: query.Get("kubernetesVersion"),
+		ChartsPath:        chartsPath,
+		Registry:          query.Get("registry"),
+	})
+	if errors.Is(err, image.ErrInvalidAgentImagesConfig) {
+		util.ReturnHTTPError(writer, request, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		logrus.Errorf("[%s] Failed to list agent images: %v", logPrefix, err)
+		util.ReturnHTTPError(writer, request, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+
+	if format == "txt" {
+		writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprint(writer, strings.Join(images, "\n")+"\n")
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(Response{Images: images}); err != nil {
+		logrus.Warnf("[%s] Failed to write response: %v", logPrefix, err)
+	}
+}
+
+// chartsPath returns the directory the rancher-charts repository is cloned in.
+func (h *Handler) chartsPath() (string, error) {
+	repo, err := h.clusterRepoCache.Get(chartsRepo)
+	if err != nil {
+		return "", err
+	}
+	if repo.Status.URL == "" {
+		return "", fmt.Errorf("%s repository has not been downloaded yet", chartsRepo)
+	}
+	return git.RepoDir(repo.Namespace, repo.Name, repo.Status.URL), nil
+}
@@ -0,0 +1,125 @@
+package agentimages
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	catalogv1 "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/image"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestServeHTTP(t *testing.T) {
+	downloaded := &catalogv1.ClusterRepo{
+		ObjectMeta: metav1.ObjectMeta{Name: chartsRepo},
+		Status:     catalogv1.RepoStatus{URL: "https://git.rancher.io/charts"},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		repo       *catalogv1.ClusterRepo
+		repoErr    error
+		imagesErr  error
+		wantStatus int
+		wantBody   string
+		wantConfig image.AgentImagesConfig
+	}{
+		{
+			name:       "json",
+			query:      "clusterType=rke2&kubernetesVersion=v1.32.4%2Brke2r1&rancherVersion=v2.12.1&registry=registry.example.com",
+			repo:       downloaded,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"images":["rancher/rancher-agent:v2.12.1","rancher/shell:v0.5.0"]}` + "\n",
+			wantConfig: image.AgentImagesConfig{
+				RancherVersion:    "v2.12.1",
+				ClusterType:       "rke2",
+				KubernetesVersion: "v1.32.4+rke2r1",
+				Registry:          "registry.example.com",
+			},
+		},
+		{
+			name:       "txt",
+			query:      "clusterType=imported&format=txt",
+			repo:       downloaded,
+			wantStatus: http.StatusOK,
+			wantBody:   "rancher/rancher-agent:v2.12.1\nrancher/shell:v0.5.0\n",
+			wantConfig: image.AgentImagesConfig{ClusterType: "imported"},
+		},
+		{
+			name:       "unsupported format",
+			query:      "clusterType=imported&format=yaml",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid config",
+			query:      "clusterType=rke1",
+			repo:       downloaded,
+			imagesErr:  fmt.Errorf("%w: unsupported cluster type", image.ErrInvalidAgentImagesConfig),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "failure to list images",
+			query:      "clusterType=imported",
+			repo:       downloaded,
+			imagesErr:  errors.New("corrupted chart"),
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "charts repository not found",
+			query:      "clusterType=imported",
+			repoErr:    apierrors.NewNotFound(schema.GroupResource{Group: "catalog.cattle.io", Resource: "clusterrepos"}, chartsRepo),
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "charts repository not downloaded",
+			query:      "clusterType=imported",
+			repo:       &catalogv1.ClusterRepo{ObjectMeta: metav1.ObjectMeta{Name: chartsRepo}},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repoCache := fake.NewMockNonNamespacedCacheInterface[*catalogv1.ClusterRepo](ctrl)
+			repoCache.EXPECT().Get(chartsRepo).Return(tt.repo, tt.repoErr).AnyTimes()
+
+			var gotConfig image.AgentImagesConfig
+			h := &Handler{
+				clusterRepoCache: repoCache,
+				getImages: func(config image.AgentImagesConfig) ([]string, error) {
+					gotConfig = config
+					if tt.imagesErr != nil {
+						return nil, tt.imagesErr
+					}
+					return []string{"rancher/rancher-agent:v2.12.1", "rancher/shell:v0.5.0"}, nil
+				},
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Endpoint+"?"+tt.query, nil))
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus != http.StatusOK {
+				var body map[string]string
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				return
+			}
+			assert.Equal(t, tt.wantBody, rec.Body.String())
+			assert.NotEmpty(t, gotConfig.ChartsPath)
+			gotConfig.ChartsPath = ""
+			assert.Equal(t, tt.wantConfig, gotConfig)
+		})
+	}
+}
@@ -0,0 +1,249 @@
+// Package authexport provides a HTTPHandler exporting the auth configuration of Rancher as YAML suitable for committing
+// to Git and re-applying. This handler should be registered at Endpoint.
+package authexport
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/rancher/rancher/pkg/auth/util"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	authzv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	authv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// Endpoint The endpoint that this URL is accessible at - used for routing
+	Endpoint  = "/v1/authexport"
+	logPrefix = "auth-export"
+)
+
+var (
+	// exportedResources are the resources the user must be able to list to export them.
+	exportedResources = []string{
+		v3.AuthConfigResourceName,
+		v3.RoleTemplateResourceName,
+		v3.GlobalRoleBindingResourceName,
+	}
+
+	// secretFields are the fields of auth configs holding secrets, which are stored in secrets of
+	// common.SecretsNamespace and only referenced by the auth configs.
+	secretFields = []string{
+		"applicationSecret",
+		"clientSecret",
+		"oauthCredential",
+		"password",
+		"privateKey",
+		"serviceAccountCredential",
+		"serviceAccountPassword",
+		"spKey",
+	}
+
+	// serverPopulatedPrefixes are the prefixes of the annotations and labels set by Rancher or Kubernetes, which are
+	// recreated when the exported objects are applied.
+	serverPopulatedPrefixes = []string{
+		"authz.management.cattle.io/",
+		"cleanup.cattle.io/",
+		"field.cattle.io/creatorId",
+		"kubectl.kubernetes.io/last-applied-configuration",
+		"lifecycle.cattle.io/",
+		"management.cattle.io/creator",
+	}
+)
+
+type lister interface {
+	List(opts metav1.ListOptions) (runtime.Object, error)
+}
+
+// Handler implements http.Handler - and serves the enabled auth configs, the RoleTemplates not created by Rancher and
+// the GlobalRoleBindings not created from other objects, as a multi-document YAML. Secrets are referenced rather than
+// inlined, and the fields populated by the server are removed for the objects to be applied to another install.
+type Handler struct {
+	authConfigs          lister
+	roleTemplateCache    mgmtcontrollers.RoleTemplateCache
+	grbCache             mgmtcontrollers.GlobalRoleBindingCache
+	subjectAccessReviews authv1.SubjectAccessReviewInterface
+}
+
+// NewHandler creates a handler using the clients defined in scaledContext.
+func NewHandler(scaledContext *config.ScaledContext) *Handler {
+	return &Handler{
+		authConfigs:          scaledContext.Management.AuthConfigs("").ObjectClient().UnstructuredClient(),
+		roleTemplateCache:    scaledContext.Wrangler.Mgmt.RoleTemplate().Cache(),
+		grbCache:             scaledContext.Wrangler.Mgmt.GlobalRoleBinding().Cache(),
+		subjectAccessReviews: scaledContext.K8sClient.AuthorizationV1().SubjectAccessReviews(),
+	}
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	if err := h.authorize(req); err != nil {
+		logrus.Debugf("[%s] Failed to authorize user: %v", logPrefix, err)
+		util.ReturnHTTPError(writer, req, http.StatusForbidden, http.StatusText(http.StatusForbidden))
+		return
+	}
+
+	objs, err := h.export()
+	if err != nil {
+		logrus.Errorf("[%s] Failed to export auth configuration: %v", logPrefix, err)
+		util.ReturnHTTPError(writer, req, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+
+	var out strings.Builder
+	for _, obj := range objs {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			logrus.Errorf("[%s] Failed to marshal %s: %v", logPrefix, obj["kind"], err)
+			util.ReturnHTTPError(writer, req, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+		out.WriteString("---\n")
+		out.Write(data)
+	}
+
+	writer.Header().Set("Content-Type", "application/yaml")
+	writer.Header().Set("Content-Disposition", `attachment; filename="rancher-auth.yaml"`)
+	if _, err := fmt.Fprint(writer, out.String()); err != nil {
+		logrus.Warnf("[%s] Failed to write response: %v", logPrefix, err)
+	}
+}
+
+// authorize returns an error if the user can't list every exported resource.
+func (h *Handler) authorize(req *http.Request) error {
+	userInfo, ok := request.UserFrom(req.Context())
+	if !ok {
+		return fmt.Errorf("unable to extract user info from context")
+	}
+	extra := make(map[string]authzv1.ExtraValue, len(userInfo.GetExtra()))
+	for key, value := range userInfo.GetExtra() {
+		extra[key] = value
+	}
+	for _, resource := range exportedResources {
+		response, err := h.subjectAccessReviews.Create(req.Context(), &authzv1.SubjectAccessReview{
+			Spec: authzv1.SubjectAccessReviewSpec{
+				ResourceAttributes: &authzv1.ResourceAttributes{
+					Group:    v3.SchemeGroupVersion.Group,
+					Resource: resource,
+					Verb:     "list",
+				},
+				User:   userInfo.GetName(),
+				Groups: userInfo.GetGroups(),
+				Extra:  extra,
+				UID:    userInfo.GetUID(),
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create a SubjectAccessReview: %w", err)
+		}
+		if !response.Status.Allowed {
+			return fmt.Errorf("user %s can't list %s", userInfo.GetName(), resource)
+		}
+	}
+	return nil
+}
+
+// export returns the normalized objects to export, auth configs first, each kind sorted by name.
+func (h *Handler) export() ([]map[string]interface{}, error) {
+	var objs []map[string]interface{}
+
+	list, err := h.authConfigs.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auth configs: %w", err)
+	}
+	authConfigs, ok := list.(*unstructured.UnstructuredList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected auth config list %T", list)
+	}
+	slices.SortFunc(authConfigs.Items, func(a, b unstructured.Unstructured) int { return strings.Compare(a.GetName(), b.GetName()) })
+	for _, authConfig := range authConfigs.Items {
+		if enabled, _ := authConfig.Object["enabled"].(bool); !enabled {
+			continue
+		}
+		obj := authConfig.DeepCopy().Object
+		obj["apiVersion"], obj["kind"] = v3.SchemeGroupVersion.String(), "AuthConfig"
+		for _, field := range secretFields {
+			// Secrets inlined by older versions of Rancher are never exported.
+			if value, ok := obj[field].(string); ok && !strings.HasPrefix(value, common.SecretsNamespace+":") {
+				delete(obj, field)
+			}
+		}
+		objs = append(objs, normalize(obj))
+	}
+
+	roleTemplates, err := h.roleTemplateCache.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role templates: %w", err)
+	}
+	slices.SortFunc(roleTemplates, func(a, b *v3.RoleTemplate) int { return strings.Compare(a.Name, b.Name) })
+	for _, roleTemplate := range roleTemplates {
+		if roleTemplate.Builtin || len(roleTemplate.OwnerReferences) > 0 {
+			continue
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(roleTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert role template %s: %w", roleTemplate.Name, err)
+		}
+		obj["apiVersion"], obj["kind"] = v3.SchemeGroupVersion.String(), "RoleTemplate"
+		objs = append(objs, normalize(obj))
+	}
+
+	grbs, err := h.grbCache.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list global role bindings: %w", err)
+	}
+	slices.SortFunc(grbs, func(a, b *v3.GlobalRoleBinding) int { return strings.Compare(a.Name, b.Name) })
+	for _, grb := range grbs {
+		// Bindings owned by other objects, e.g. a BootstrapPrincipal, are recreated from them.
+		if len(grb.OwnerReferences) > 0 || grb.Labels[v3.GlobalRoleGroupMappingUserLabel] != "" {
+			continue
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(grb)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert global role binding %s: %w", grb.Name, err)
+		}
+		obj["apiVersion"], obj["kind"] = v3.SchemeGroupVersion.String(), "GlobalRoleBinding"
+		// Users are created with generated names, they are resolved from their principal instead.
+		if grb.UserPrincipalName != "" {
+			delete(obj, "userName")
+		}
+		objs = append(objs, normalize(obj))
+	}
+
+	return objs, nil
+}
+
+// normalize removes the status and the metadata populated by the server from the object.
+func normalize(obj map[string]interface{}) map[string]interface{} {
+	delete(obj, "status")
+
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	normalized := map[string]interface{}{"name": metadata["name"]}
+	if namespace, ok := metadata["namespace"]; ok {
+		normalized["namespace"] = namespace
+	}
+	for _, field := range []string{"labels", "annotations"} {
+		values, _ := metadata[field].(map[string]interface{})
+		for key := range values {
+			if slices.ContainsFunc(serverPopulatedPrefixes, func(prefix string) bool { return strings.HasPrefix(key, prefix) }) {
+				delete(values, key)
+			}
+		}
+		if len(values) > 0 {
+			normalized[field] = values
+		}
+	}
+	obj["metadata"] = normalized
+	return obj
+}
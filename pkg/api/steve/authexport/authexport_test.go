@@ -0,0 +1,191 @@
+package authexport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	authzv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type fakeLister struct {
+	list *unstructured.UnstructuredList
+}
+
+func (f *fakeLister) List(_ metav1.ListOptions) (runtime.Object, error) {
+	return f.list, nil
+}
+
+// The inlined secret of the activedirectory auth config isn't exported.
+const wantExport = `---
+apiVersion: management.cattle.io/v3
+enabled: true
+kind: AuthConfig
+metadata:
+  name: activedirectory
+type: activeDirectoryConfig
+---
+apiVersion: management.cattle.io/v3
+clientId: rancher
+clientSecret: cattle-global-data:githubconfig-clientsecret
+enabled: true
+kind: AuthConfig
+metadata:
+  name: github
+type: githubConfig
+---
+apiVersion: management.cattle.io/v3
+builtin: false
+context: cluster
+description: ""
+external: false
+hidden: false
+kind: RoleTemplate
+metadata:
+  labels:
+    team: platform
+  name: rt-custom
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - pods
+  verbs:
+  - get
+---
+apiVersion: management.cattle.io/v3
+globalRoleName: admin
+kind: GlobalRoleBinding
+metadata:
+  name: grb-admin
+userPrincipalName: github_user://1234
+`
+
+func TestServeHTTP(t *testing.T) {
+	authConfigs := &fakeLister{list: &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		{Object: map[string]interface{}{
+			"apiVersion": "management.cattle.io/v3",
+			"kind":       "AuthConfig",
+			"metadata": map[string]interface{}{
+				"name":            "github",
+				"uid":             "1234",
+				"resourceVersion": "42",
+				"annotations": map[string]interface{}{
+					"lifecycle.cattle.io/create.mgmt-auth-config-controller": "true",
+				},
+			},
+			"type":         "githubConfig",
+			"enabled":      true,
+			"clientId":     "rancher",
+			"clientSecret": "cattle-global-data:githubconfig-clientsecret",
+			"status":       map[string]interface{}{"conditions": []interface{}{}},
+		}},
+		{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "openldap"},
+			"type":     "openLdapConfig",
+			"enabled":  false,
+		}},
+		{Object: map[string]interface{}{
+			"metadata":               map[string]interface{}{"name": "activedirectory"},
+			"type":                   "activeDirectoryConfig",
+			"enabled":                true,
+			"serviceAccountPassword": "inlined",
+		}},
+	}}}
+
+	ctrl := gomock.NewController(t)
+	roleTemplateCache := fake.NewMockNonNamespacedCacheInterface[*v3.RoleTemplate](ctrl)
+	roleTemplateCache.EXPECT().List(labels.Everything()).Return([]*v3.RoleTemplate{
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster-owner"}, Builtin: true, Context: "cluster"},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "rt-custom",
+				Labels:            map[string]string{"team": "platform"},
+				Annotations:       map[string]string{"field.cattle.io/creatorId": "u-abcde"},
+				CreationTimestamp: metav1.Now(),
+			},
+			Context: "cluster",
+			Rules:   []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}},
+		},
+	}, nil).AnyTimes()
+	grbCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleBinding](ctrl)
+	grbCache.EXPECT().List(labels.Everything()).Return([]*v3.GlobalRoleBinding{
+		{
+			ObjectMeta:        metav1.ObjectMeta{Name: "grb-admin"},
+			UserName:          "u-abcde",
+			UserPrincipalName: "github_user://1234",
+			GlobalRoleName:    "admin",
+			Status:            v3.GlobalRoleBindingStatus{Summary: "Completed"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "grb-bootstrap-admin",
+				OwnerReferences: []metav1.OwnerReference{{Kind: "BootstrapPrincipal", Name: "bootstrap"}},
+			},
+			GroupPrincipalName: "github_org://1",
+			GlobalRoleName:     "admin",
+		},
+	}, nil).AnyTimes()
+
+	tests := []struct {
+		name       string
+		allowed    bool
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "exports auth configuration",
+			allowed:    true,
+			wantStatus: http.StatusOK,
+			wantBody:   wantExport,
+		},
+		{
+			name:       "user can't list resources",
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := k8sfake.NewSimpleClientset()
+			k8sClient.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				sar := action.(k8stesting.CreateAction).GetObject().(*authzv1.SubjectAccessReview)
+				assert.Equal(t, "list", sar.Spec.ResourceAttributes.Verb)
+				sar.Status.Allowed = tt.allowed
+				return true, sar, nil
+			})
+
+			h := &Handler{
+				authConfigs:          authConfigs,
+				roleTemplateCache:    roleTemplateCache,
+				grbCache:             grbCache,
+				subjectAccessReviews: k8sClient.AuthorizationV1().SubjectAccessReviews(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, Endpoint, nil)
+			req = req.WithContext(request.WithUser(req.Context(), &user.DefaultInfo{Name: "u-abcde"}))
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantBody != "" {
+				assert.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+				assert.Equal(t, tt.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -46,8 +46,9 @@ func Register(ctx context.Context, server *steve.Server, wrangler *wrangler.Cont
 		return err
 	}
 	kubeconfig := kubeconfigDownload{
-		userMgr: userManager,
-		auth:    requests.NewAuthenticator(ctx, clusterrouter.GetClusterID, sc),
+		userMgr:      userManager,
+		auth:         requests.NewAuthenticator(ctx, clusterrouter.GetClusterID, sc),
+		clusterCache: wrangler.Mgmt.Cluster().Cache(),
 	}
 
 	server.ClusterCache.OnAdd(ctx, shell.impersonator.PurgeOldRoles)
@@ -10,6 +10,7 @@
 	"github.com/rancher/rancher/pkg/auth/requests"
 	"github.com/rancher/rancher/pkg/auth/tokens"
 	"github.com/rancher/rancher/pkg/features"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kubeconfig"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/user"
@@ -18,8 +19,9 @@
 )
 
 type kubeconfigDownload struct {
-	userMgr user.Manager
-	auth    requests.Authenticator
+	userMgr      user.Manager
+	auth         requests.Authenticator
+	clusterCache mgmtcontrollers.ClusterCache
 }
 
 func (k kubeconfigDownload) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
@@ -43,7 +45,7 @@ func (k kubeconfigDownload) ServeHTTP(rw http.ResponseWriter, req *http.Request)
 	var err error
 	generateToken := strings.EqualFold(settings.KubeconfigGenerateToken.Get(), "true")
 	if generateToken {
-		tokenKey, err = k.ensureToken(userName.GetName(), req)
+		tokenKey, err = k.ensureToken(apiRequest.Name, userName.GetName(), req)
 		if err != nil {
 			apiRequest.WriteError(err)
 			return
@@ -74,10 +76,14 @@ func (k kubeconfigDownload) ServeHTTP(rw http.ResponseWriter, req *http.Request)
 	})
 }
 
-func (k kubeconfigDownload) ensureToken(userName string, req *http.Request) (string, error) {
-	defaultTokenTTL, err := tokens.GetKubeconfigDefaultTokenTTLInMilliSeconds()
+func (k kubeconfigDownload) ensureToken(clusterName, userName string, req *http.Request) (string, error) {
+	cluster, err := k.clusterCache.Get(clusterName)
 	if err != nil {
-		return "", fmt.Errorf("failed to get default token TTL: %w", err)
+		return "", err
+	}
+	tokenTTL, err := tokens.GetClusterKubeconfigTokenTTLInMilliSeconds(cluster.Annotations)
+	if err != nil {
+		return "", fmt.Errorf("failed to get token TTL for cluster %s: %w", clusterName, err)
 	}
 
 	authToken, err := k.auth.TokenFromRequest(req)
@@ -92,7 +98,7 @@ func (k kubeconfigDownload) ensureToken(userName string, req *http.Request) (str
 		Kind:          "kubeconfig",
 		UserName:      userName,
 		AuthProvider:  authToken.GetAuthProvider(),
-		TTL:           defaultTokenTTL,
+		TTL:           tokenTTL,
 		Randomize:     true,
 		UserPrincipal: authToken.GetUserPrincipal(),
 	}
@@ -0,0 +1,177 @@
+package provisioningcluster
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rancher/apiserver/pkg/types"
+	"github.com/rancher/rancher/pkg/controllers/capr/machineprovision"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/provisioninglog"
+	capicontrollers "github.com/rancher/rancher/pkg/generated/controllers/cluster.x-k8s.io/v1beta1"
+	provcontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	corecontrollers "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const logPollInterval = 2 * time.Second
+
+// provisioningLogs streams the provisioning log of a cluster, as recorded by the provisioning log controller, followed
+// by the logs of the jobs provisioning its machines. With follow=true, new provisioning log lines are streamed until
+// the client disconnects.
+type provisioningLogs struct {
+	clusterCache   provcontrollers.ClusterCache
+	configMapCache corecontrollers.ConfigMapCache
+	machineCache   capicontrollers.MachineCache
+	pods           kubernetes.Interface
+	pollInterval   time.Duration
+}
+
+func (p *provisioningLogs) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	apiRequest := types.GetAPIContext(req.Context())
+	if err := apiRequest.AccessControl.CanGet(apiRequest, apiRequest.Schema); err != nil {
+		apiRequest.WriteError(err)
+		return
+	}
+	// The logs of the machine provisioning jobs are only disclosed to the users able to manage the machines,
+	// consistently with the shell and sshkeys links of the machines.
+	withMachines := apiRequest.AccessControl.CanUpdate(apiRequest, types.APIObject{}, apiRequest.Schema) == nil
+
+	cluster, err := p.clusterCache.Get(apiRequest.Namespace, apiRequest.Name)
+	if err != nil {
+		apiRequest.WriteError(err)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("X-Content-Type-Options", "nosniff")
+	rw.WriteHeader(http.StatusOK)
+
+	w := &flushWriter{w: rw}
+	if flusher, ok := rw.(http.Flusher); ok {
+		w.flusher = flusher
+	}
+
+	log := p.provisioningLog(cluster.Status.ClusterName)
+	fmt.Fprint(w, log)
+
+	if withMachines {
+		if err := p.writeMachineLogs(req, w, cluster.Namespace, cluster.Name); err != nil {
+			logrus.Errorf("[provisioninglogs] cluster %s/%s: failed to stream machine logs: %v", cluster.Namespace, cluster.Name, err)
+			fmt.Fprintf(w, "failed to stream machine logs: %v\n", err)
+		}
+	}
+
+	if req.URL.Query().Get("follow") != "true" {
+		return
+	}
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-ticker.C:
+			current := p.provisioningLog(cluster.Status.ClusterName)
+			fmt.Fprint(w, newLogLines(log, current))
+			log = current
+		}
+	}
+}
+
+// provisioningLog returns the provisioning log recorded in the namespace of the management cluster.
+func (p *provisioningLogs) provisioningLog(clusterName string) string {
+	if clusterName == "" {
+		return ""
+	}
+	cm, err := p.configMapCache.Get(clusterName, provisioninglog.ProvisioningLogName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logrus.Errorf("[provisioninglogs] cluster %s: failed to get provisioning log: %v", clusterName, err)
+		}
+		return ""
+	}
+	return cm.Data["log"]
+}
+
+// writeMachineLogs writes the logs of the pods of the jobs provisioning the machines of the cluster.
+func (p *provisioningLogs) writeMachineLogs(req *http.Request, w io.Writer, namespace, clusterName string) error {
+	machines, err := p.machineCache.List(namespace, labels.SelectorFromSet(labels.Set{capi.ClusterNameLabel: clusterName}))
+	if err != nil {
+		return err
+	}
+
+	for _, machine := range machines {
+		jobName := machineprovision.GetJobName(machine.Spec.InfrastructureRef.Name)
+		pods, err := p.pods.CoreV1().Pods(namespace).List(req.Context(), metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(labels.Set{"job-name": jobName}).String(),
+		})
+		if err != nil {
+			return err
+		}
+		for _, pod := range pods.Items {
+			fmt.Fprintf(w, "==> machine %s (pod %s) <==\n", machine.Name, pod.Name)
+			if err := p.writePodLogs(req, w, &pod); err != nil {
+				fmt.Fprintf(w, "failed to get logs: %v\n", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (p *provisioningLogs) writePodLogs(req *http.Request, w io.Writer, pod *corev1.Pod) error {
+	stream, err := p.pods.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{}).Stream(req.Context())
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	for scanner.Scan() {
+		fmt.Fprintln(w, scanner.Text())
+	}
+	return scanner.Err()
+}
+
+// newLogLines returns the lines of the current log that weren't in the previous one. The provisioning log is trimmed
+// from its beginning once it exceeds its maximum length, so the new lines are the ones following the last line of the
+// previous log.
+func newLogLines(previous, current string) string {
+	if previous == "" {
+		return current
+	}
+	if previous == current {
+		return ""
+	}
+	lines := strings.SplitAfter(strings.TrimSuffix(previous, "\n"), "\n")
+	last := lines[len(lines)-1]
+	if i := strings.LastIndex(current, last); i >= 0 {
+		return strings.TrimPrefix(current[i+len(last):], "\n")
+	}
+	return current
+}
+
+// flushWriter flushes every write, so that logs are streamed to the client as they come.
+type flushWriter struct {
+	w       io.Writer
+	flusher http.Flusher
+}
+
+func (f *flushWriter) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	if f.flusher != nil {
+		f.flusher.Flush()
+	}
+	return n, err
+}
@@ -0,0 +1,84 @@
+package provisioningcluster
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/provisioninglog"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestNewLogLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous string
+		current  string
+		want     string
+	}{
+		{name: "empty previous log", previous: "", current: "a\nb\n", want: "a\nb\n"},
+		{name: "unchanged log", previous: "a\nb\n", current: "a\nb\n", want: ""},
+		{name: "appended lines", previous: "a\nb\n", current: "a\nb\nc\nd\n", want: "c\nd\n"},
+		{name: "trimmed log", previous: "a\nb\n", current: "b\nc\n", want: "c\n"},
+		{name: "previous lines all trimmed", previous: "a\nb\n", current: "c\nd\n", want: "c\nd\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newLogLines(tt.previous, tt.current))
+		})
+	}
+}
+
+func TestProvisioningLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	configMaps := fake.NewMockCacheInterface[*corev1.ConfigMap](ctrl)
+	configMaps.EXPECT().Get("c-m-abcdefgh", provisioninglog.ProvisioningLogName).Return(&corev1.ConfigMap{
+		Data: map[string]string{"log": "provisioning done\n"},
+	}, nil)
+	configMaps.EXPECT().Get("c-m-missing1", provisioninglog.ProvisioningLogName).Return(nil, apierrors.NewNotFound(corev1.Resource("configmaps"), provisioninglog.ProvisioningLogName))
+
+	p := &provisioningLogs{configMapCache: configMaps}
+	assert.Equal(t, "provisioning done\n", p.provisioningLog("c-m-abcdefgh"))
+	assert.Empty(t, p.provisioningLog("c-m-missing1"))
+	assert.Empty(t, p.provisioningLog(""))
+}
+
+func TestWriteMachineLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	machines := fake.NewMockCacheInterface[*capi.Machine](ctrl)
+	machines.EXPECT().List("fleet-default", labels.SelectorFromSet(labels.Set{capi.ClusterNameLabel: "test"})).Return([]*capi.Machine{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pool-abcde", Namespace: "fleet-default"},
+			Spec: capi.MachineSpec{
+				InfrastructureRef: corev1.ObjectReference{Name: "test-pool-abcde-infra"},
+			},
+		},
+	}, nil)
+
+	pods := k8sfake.NewSimpleClientset(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pool-abcde-infra-machine-provision-xyz",
+			Namespace: "fleet-default",
+			Labels:    map[string]string{"job-name": "test-pool-abcde-infra-machine-provision"},
+		}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:      "unrelated",
+			Namespace: "fleet-default",
+			Labels:    map[string]string{"job-name": "unrelated"},
+		}},
+	)
+
+	p := &provisioningLogs{machineCache: machines, pods: pods}
+	out := &bytes.Buffer{}
+	require.NoError(t, p.writeMachineLogs(httptest.NewRequest("GET", "/", nil), out, "fleet-default", "test"))
+	assert.Equal(t, "==> machine test-pool-abcde (pod test-pool-abcde-infra-machine-provision-xyz) <==\nfake logs\n", out.String())
+}
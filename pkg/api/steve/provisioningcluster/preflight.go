@@ -0,0 +1,241 @@
+package provisioningcluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/rancher/apiserver/pkg/apierror"
+	"github.com/rancher/apiserver/pkg/types"
+	provcontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/v3/pkg/schemas/validation"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	preflightTimeout = 10 * time.Second
+
+	agentNamespace    = "cattle-system"
+	agentDeployment   = "cattle-cluster-agent"
+	rancherDeployment = "rancher"
+
+	PreflightCheckConnectivity       = "Connectivity"
+	PreflightCheckKubernetesVersion  = "KubernetesVersion"
+	PreflightCheckPermissions        = "Permissions"
+	PreflightCheckConflictingInstall = "ConflictingInstall"
+
+	PreflightPassed  = "Passed"
+	PreflightWarning = "Warning"
+	PreflightFailed  = "Failed"
+)
+
+// ImportPreflightInput is the input of the importPreflight action.
+type ImportPreflightInput struct {
+	// Kubeconfig is a kubeconfig granting access to the cluster to import.
+	Kubeconfig string `json:"kubeconfig"`
+}
+
+// ImportPreflight is the report of the checks run against a cluster before importing it.
+type ImportPreflight struct {
+	// Passed is true if none of the checks failed. Warnings don't prevent the import.
+	Passed bool                   `json:"passed"`
+	Checks []ImportPreflightCheck `json:"checks"`
+}
+
+// ImportPreflightCheck is the result of a single import preflight check.
+type ImportPreflightCheck struct {
+	Name string `json:"name"`
+	// Status is either Passed, Warning or Failed.
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// importPreflight checks that a cluster can be imported before the registration manifest is applied to it: that it's
+// reachable, runs a supported Kubernetes version, that the provided credentials can apply the manifest and that it
+// isn't already managed by another Rancher.
+type importPreflight struct {
+	clusterCache provcontrollers.ClusterCache
+	newClient    func(*rest.Config) (kubernetes.Interface, error)
+}
+
+func (i *importPreflight) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	apiRequest := types.GetAPIContext(req.Context())
+	// Importing a cluster requires the same permissions as updating it.
+	if err := apiRequest.AccessControl.CanUpdate(apiRequest, types.APIObject{}, apiRequest.Schema); err != nil {
+		apiRequest.WriteError(err)
+		return
+	}
+
+	cluster, err := i.clusterCache.Get(apiRequest.Namespace, apiRequest.Name)
+	if err != nil {
+		apiRequest.WriteError(err)
+		return
+	}
+	if cluster.Spec.RKEConfig != nil {
+		apiRequest.WriteError(apierror.NewAPIError(validation.InvalidAction, "cluster is provisioned by Rancher and can't be imported"))
+		return
+	}
+
+	var input ImportPreflightInput
+	if err := json.NewDecoder(req.Body).Decode(&input); err != nil {
+		apiRequest.WriteError(apierror.NewAPIError(validation.InvalidBodyContent, err.Error()))
+		return
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(input.Kubeconfig))
+	if err != nil {
+		apiRequest.WriteError(apierror.NewAPIError(validation.InvalidBodyContent, fmt.Sprintf("invalid kubeconfig: %v", err)))
+		return
+	}
+	restConfig.Timeout = preflightTimeout
+
+	client, err := i.newClient(restConfig)
+	if err != nil {
+		apiRequest.WriteError(apierror.NewAPIError(validation.InvalidBodyContent, fmt.Sprintf("invalid kubeconfig: %v", err)))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), 4*preflightTimeout)
+	defer cancel()
+
+	apiRequest.WriteResponse(http.StatusOK, types.APIObject{
+		Type:   "importPreflight",
+		Object: runImportPreflight(ctx, client),
+	})
+}
+
+// runImportPreflight runs the import preflight checks against the cluster of the given client. The other checks are
+// skipped if the cluster isn't reachable.
+func runImportPreflight(ctx context.Context, client kubernetes.Interface) *ImportPreflight {
+	report := &ImportPreflight{Passed: true}
+	add := func(check ImportPreflightCheck) {
+		if check.Status == PreflightFailed {
+			report.Passed = false
+		}
+		report.Checks = append(report.Checks, check)
+	}
+
+	version, err := client.Discovery().ServerVersion()
+	if err != nil {
+		add(ImportPreflightCheck{
+			Name:    PreflightCheckConnectivity,
+			Status:  PreflightFailed,
+			Message: fmt.Sprintf("cluster is not reachable: %v", err),
+		})
+		return report
+	}
+	add(ImportPreflightCheck{Name: PreflightCheckConnectivity, Status: PreflightPassed})
+	add(checkKubernetesVersion(version.GitVersion))
+	add(checkPermissions(ctx, client))
+	add(checkConflictingInstall(ctx, client))
+	return report
+}
+
+// checkKubernetesVersion checks that the version of the cluster is in the import-k8s-supported-range setting.
+func checkKubernetesVersion(gitVersion string) ImportPreflightCheck {
+	check := ImportPreflightCheck{Name: PreflightCheckKubernetesVersion}
+
+	version, err := semver.NewVersion(gitVersion)
+	if err != nil {
+		check.Status = PreflightWarning
+		check.Message = fmt.Sprintf("unable to parse Kubernetes version %s: %v", gitVersion, err)
+		return check
+	}
+	supported := settings.ImportKubernetesVersionRange.Get()
+	constraint, err := semver.NewConstraint(supported)
+	if err != nil {
+		check.Status = PreflightWarning
+		check.Message = fmt.Sprintf("invalid setting %s: %v", settings.ImportKubernetesVersionRange.Name, err)
+		return check
+	}
+	if !constraint.Check(version) {
+		check.Status = PreflightFailed
+		check.Message = fmt.Sprintf("Kubernetes version %s is not supported, supported versions are %s", gitVersion, supported)
+		return check
+	}
+
+	check.Status = PreflightPassed
+	check.Message = fmt.Sprintf("Kubernetes version %s is supported", gitVersion)
+	return check
+}
+
+// checkPermissions checks that the credentials of the kubeconfig are cluster admin, as required to apply the
+// registration manifest, which creates cluster roles and bindings.
+func checkPermissions(ctx context.Context, client kubernetes.Interface) ImportPreflightCheck {
+	check := ImportPreflightCheck{Name: PreflightCheckPermissions}
+
+	review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Verb:     "*",
+				Group:    "*",
+				Resource: "*",
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		check.Status = PreflightFailed
+		check.Message = fmt.Sprintf("unable to review the permissions of the kubeconfig: %v", err)
+		return check
+	}
+	if !review.Status.Allowed {
+		check.Status = PreflightFailed
+		check.Message = "the kubeconfig must be cluster admin to apply the registration manifest"
+		return check
+	}
+
+	check.Status = PreflightPassed
+	return check
+}
+
+// checkConflictingInstall checks that the cluster isn't running Rancher, nor a cluster agent registered with another
+// Rancher. An agent registered with this Rancher is only reported as a warning, as the cluster is being re-imported.
+func checkConflictingInstall(ctx context.Context, client kubernetes.Interface) ImportPreflightCheck {
+	check := ImportPreflightCheck{Name: PreflightCheckConflictingInstall}
+
+	if _, err := client.AppsV1().Deployments(agentNamespace).Get(ctx, rancherDeployment, metav1.GetOptions{}); err == nil {
+		check.Status = PreflightFailed
+		check.Message = "cluster is running Rancher"
+		return check
+	} else if !apierrors.IsNotFound(err) {
+		check.Status = PreflightWarning
+		check.Message = fmt.Sprintf("unable to check for a Rancher install: %v", err)
+		return check
+	}
+
+	agent, err := client.AppsV1().Deployments(agentNamespace).Get(ctx, agentDeployment, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		check.Status = PreflightPassed
+		return check
+	} else if err != nil {
+		check.Status = PreflightWarning
+		check.Message = fmt.Sprintf("unable to check for an existing cluster agent: %v", err)
+		return check
+	}
+
+	var server string
+	for _, container := range agent.Spec.Template.Spec.Containers {
+		for _, env := range container.Env {
+			if env.Name == "CATTLE_SERVER" {
+				server = env.Value
+			}
+		}
+	}
+	if server != settings.ServerURL.Get() {
+		check.Status = PreflightFailed
+		check.Message = fmt.Sprintf("cluster is already registered with the Rancher at %s", server)
+		return check
+	}
+
+	check.Status = PreflightWarning
+	check.Message = "cluster is already registered with this Rancher, its cluster agent will be replaced"
+	return check
+}
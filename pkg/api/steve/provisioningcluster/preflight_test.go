@@ -0,0 +1,146 @@
+package provisioningcluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func agentDeploymentFor(server string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: agentNamespace, Name: agentDeployment},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "cluster-register",
+						Env:  []corev1.EnvVar{{Name: "CATTLE_SERVER", Value: server}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestRunImportPreflight(t *testing.T) {
+	require.NoError(t, settings.ServerURL.Set("https://rancher.example.com"))
+	t.Cleanup(func() { _ = settings.ServerURL.Set("") })
+
+	tests := []struct {
+		name         string
+		gitVersion   string
+		versionErr   error
+		allowed      bool
+		objects      []runtime.Object
+		wantPassed   bool
+		wantStatuses map[string]string
+	}{
+		{
+			name:       "cluster can be imported",
+			gitVersion: "v1.32.4+k3s1",
+			allowed:    true,
+			wantPassed: true,
+			wantStatuses: map[string]string{
+				PreflightCheckConnectivity:       PreflightPassed,
+				PreflightCheckKubernetesVersion:  PreflightPassed,
+				PreflightCheckPermissions:        PreflightPassed,
+				PreflightCheckConflictingInstall: PreflightPassed,
+			},
+		},
+		{
+			name:       "unreachable cluster",
+			versionErr: errors.New("connection refused"),
+			wantStatuses: map[string]string{
+				PreflightCheckConnectivity: PreflightFailed,
+			},
+		},
+		{
+			name:       "unsupported version and missing permissions",
+			gitVersion: "v1.25.16",
+			wantStatuses: map[string]string{
+				PreflightCheckConnectivity:       PreflightPassed,
+				PreflightCheckKubernetesVersion:  PreflightFailed,
+				PreflightCheckPermissions:        PreflightFailed,
+				PreflightCheckConflictingInstall: PreflightPassed,
+			},
+		},
+		{
+			name:       "registered with another Rancher",
+			gitVersion: "v1.31.1",
+			allowed:    true,
+			objects:    []runtime.Object{agentDeploymentFor("https://other.example.com")},
+			wantStatuses: map[string]string{
+				PreflightCheckConnectivity:       PreflightPassed,
+				PreflightCheckKubernetesVersion:  PreflightPassed,
+				PreflightCheckPermissions:        PreflightPassed,
+				PreflightCheckConflictingInstall: PreflightFailed,
+			},
+		},
+		{
+			name:       "registered with this Rancher",
+			gitVersion: "v1.31.1",
+			allowed:    true,
+			objects:    []runtime.Object{agentDeploymentFor("https://rancher.example.com")},
+			wantPassed: true,
+			wantStatuses: map[string]string{
+				PreflightCheckConnectivity:       PreflightPassed,
+				PreflightCheckKubernetesVersion:  PreflightPassed,
+				PreflightCheckPermissions:        PreflightPassed,
+				PreflightCheckConflictingInstall: PreflightWarning,
+			},
+		},
+		{
+			name:       "running Rancher",
+			gitVersion: "v1.31.1",
+			allowed:    true,
+			objects: []runtime.Object{&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: agentNamespace, Name: rancherDeployment},
+			}},
+			wantStatuses: map[string]string{
+				PreflightCheckConnectivity:       PreflightPassed,
+				PreflightCheckKubernetesVersion:  PreflightPassed,
+				PreflightCheckPermissions:        PreflightPassed,
+				PreflightCheckConflictingInstall: PreflightFailed,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := k8sfake.NewSimpleClientset(tt.objects...)
+			discovery := client.Discovery().(*fakediscovery.FakeDiscovery)
+			discovery.FakedServerVersion = &version.Info{GitVersion: tt.gitVersion}
+			if tt.versionErr != nil {
+				discovery.PrependReactor("get", "version", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tt.versionErr
+				})
+			}
+			client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				review.Status.Allowed = tt.allowed
+				return true, review, nil
+			})
+
+			report := runImportPreflight(context.Background(), client)
+			assert.Equal(t, tt.wantPassed, report.Passed)
+			statuses := map[string]string{}
+			for _, check := range report.Checks {
+				statuses[check.Name] = check.Status
+			}
+			assert.Equal(t, tt.wantStatuses, statuses)
+		})
+	}
+}
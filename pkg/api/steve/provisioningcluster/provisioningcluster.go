@@ -0,0 +1,107 @@
+package provisioningcluster
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rancher/apiserver/pkg/apierror"
+	"github.com/rancher/apiserver/pkg/types"
+	provv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/provisioningcluster"
+	provcontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/wrangler"
+	schema2 "github.com/rancher/steve/pkg/schema"
+	steve "github.com/rancher/steve/pkg/server"
+	"github.com/rancher/wrangler/v3/pkg/schemas"
+	"github.com/rancher/wrangler/v3/pkg/schemas/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func Register(server *steve.Server, clients *wrangler.Context) {
+	preview := &planPreview{
+		clusterCache: clients.Provisioning.Cluster().Cache(),
+		previewer:    provisioningcluster.NewPreviewer(clients),
+	}
+	logs := &provisioningLogs{
+		clusterCache:   clients.Provisioning.Cluster().Cache(),
+		configMapCache: clients.Core.ConfigMap().Cache(),
+		machineCache:   clients.CAPI.Machine().Cache(),
+		pods:           clients.K8s,
+		pollInterval:   logPollInterval,
+	}
+	preflight := &importPreflight{
+		clusterCache: clients.Provisioning.Cluster().Cache(),
+		newClient: func(config *rest.Config) (kubernetes.Interface, error) {
+			return kubernetes.NewForConfig(config)
+		},
+	}
+
+	server.BaseSchemas.MustImportAndCustomize(provisioningcluster.PlanPreview{}, nil)
+	server.BaseSchemas.MustImportAndCustomize(ImportPreflightInput{}, nil)
+	server.BaseSchemas.MustImportAndCustomize(ImportPreflight{}, nil)
+	server.SchemaFactory.AddTemplate(schema2.Template{
+		Group: "provisioning.cattle.io",
+		Kind:  "Cluster",
+		Customize: func(schema *types.APISchema) {
+			if schema.ActionHandlers == nil {
+				schema.ActionHandlers = map[string]http.Handler{}
+			}
+			schema.ActionHandlers["planPreview"] = preview
+			if schema.ResourceActions == nil {
+				schema.ResourceActions = map[string]schemas.Action{}
+			}
+			schema.ResourceActions["planPreview"] = schemas.Action{
+				Output: "planPreview",
+			}
+			schema.ActionHandlers["importPreflight"] = preflight
+			schema.ResourceActions["importPreflight"] = schemas.Action{
+				Input:  "importPreflightInput",
+				Output: "importPreflight",
+			}
+			if schema.LinkHandlers == nil {
+				schema.LinkHandlers = map[string]http.Handler{}
+			}
+			schema.LinkHandlers["provisioninglogs"] = logs
+		},
+	})
+}
+
+// planPreview renders the changes the cluster spec in the request body would cause to the cluster, without applying
+// it.
+type planPreview struct {
+	clusterCache provcontrollers.ClusterCache
+	previewer    *provisioningcluster.Previewer
+}
+
+func (p *planPreview) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	apiRequest := types.GetAPIContext(req.Context())
+	// Previewing a change requires the same permissions as making it.
+	if err := apiRequest.AccessControl.CanUpdate(apiRequest, types.APIObject{}, apiRequest.Schema); err != nil {
+		apiRequest.WriteError(err)
+		return
+	}
+
+	cluster, err := p.clusterCache.Get(apiRequest.Namespace, apiRequest.Name)
+	if err != nil {
+		apiRequest.WriteError(err)
+		return
+	}
+
+	var spec provv1.ClusterSpec
+	if err := json.NewDecoder(req.Body).Decode(&spec); err != nil {
+		apiRequest.WriteError(apierror.NewAPIError(validation.InvalidBodyContent, err.Error()))
+		return
+	}
+
+	preview, err := p.previewer.Preview(cluster, spec)
+	if err != nil {
+		apiRequest.WriteError(err)
+		return
+	}
+
+	apiRequest.WriteResponse(http.StatusOK, types.APIObject{
+		Type:   "planPreview",
+		Object: preview,
+	})
+}
@@ -10,6 +10,7 @@
 
 	gmux "github.com/gorilla/mux"
 	"github.com/rancher/rancher/pkg/api/steve/disallow"
+	"github.com/rancher/rancher/pkg/auth/decisionlog"
 	v3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	managementv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/settings"
@@ -50,6 +51,7 @@ func RewriteLocalCluster(next http.Handler) http.Handler {
 func NewProxyMiddleware(sar v1.AuthorizationV1Interface,
 	dialerFactory ClusterDialerFactory,
 	clusters v3.ClusterCache,
+	explainer *decisionlog.Explainer,
 	localSupport bool,
 	localCluster http.Handler) (func(http.Handler) http.Handler, error) {
 	cfg := authorizerfactory.DelegatingAuthorizerConfig{
@@ -64,7 +66,7 @@ func NewProxyMiddleware(sar v1.AuthorizationV1Interface,
 		return nil, err
 	}
 
-	proxyHandler := NewProxyHandler(authorizer, dialerFactory, clusters)
+	proxyHandler := NewProxyHandler(decisionlog.NewAuthorizer(authorizer, explainer, "proxy"), dialerFactory, clusters)
 
 	mux := gmux.NewRouter()
 	mux.UseEncodedPath()
@@ -8,6 +8,7 @@
 	"github.com/rancher/rancher/pkg/api/steve/disallow"
 	"github.com/rancher/rancher/pkg/api/steve/machine"
 	"github.com/rancher/rancher/pkg/api/steve/navlinks"
+	"github.com/rancher/rancher/pkg/api/steve/provisioningcluster"
 	"github.com/rancher/rancher/pkg/api/steve/settings"
 	"github.com/rancher/rancher/pkg/api/steve/userpreferences"
 	"github.com/rancher/rancher/pkg/wrangler"
@@ -20,6 +21,7 @@ func Setup(ctx context.Context, server *steve.Server, config *wrangler.Context)
 		return err
 	}
 	machine.Register(server, config)
+	provisioningcluster.Register(server, config)
 	navlinks.Register(ctx, server)
 	settings.Register(server)
 	disallow.Register(server)
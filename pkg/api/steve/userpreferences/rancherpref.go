@@ -1,8 +1,10 @@
 package userpreferences
 
 import (
+	"github.com/rancher/apiserver/pkg/apierror"
 	"github.com/rancher/apiserver/pkg/store/empty"
 	"github.com/rancher/apiserver/pkg/types"
+	"github.com/rancher/rancher/pkg/controllers/management/usernamespace"
 	"github.com/rancher/steve/pkg/attributes"
 	"github.com/rancher/steve/pkg/stores/proxy"
 	"github.com/rancher/wrangler/v3/pkg/data/convert"
@@ -115,6 +117,9 @@ func (e *rancherPrefStore) Update(apiOp *types.APIRequest, schema *types.APISche
 	for k, v := range data.Data().Map("data") {
 		newValues[k] = convert.ToString(v)
 	}
+	if err := usernamespace.SizeQuotaExceeded(newValues); err != nil {
+		return types.APIObject{}, apierror.NewAPIError(validation.MaxLimitExceeded, err.Error())
+	}
 
 	prefs, err := client.List(apiOp.Context(), metav1.ListOptions{})
 	if err != nil {
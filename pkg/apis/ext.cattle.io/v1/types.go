@@ -8,6 +8,7 @@
 
 // +genclient
 // +genclient:nonNamespaced
+// +genclient:onlyVerbs=create,get
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // UserActivity keeps tracks user activity in the UI.
@@ -17,20 +18,66 @@ type UserActivity struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
+	// Spec identifies the user and the token whose activity is recorded.
+	// +optional
+	Spec UserActivitySpec `json:"spec,omitempty"`
+
 	// Status is the most recently observed status of the UserActivity.
 	Status UserActivityStatus `json:"status"`
 }
 
+// UserActivitySpec identifies the user and the token whose activity is recorded. The name of a UserActivity is an
+// opaque hash of both, requests naming the token instead are still accepted for compatibility.
+type UserActivitySpec struct {
+	// UserID is the name of the user whose activity is recorded. It defaults to, and must be, the user making the request.
+	// +optional
+	UserID string `json:"userID,omitempty"`
+	// TokenID is the name of the token whose activity is recorded. It defaults to the token authenticating the request.
+	// +optional
+	TokenID string `json:"tokenID,omitempty"`
+	// AdditionalTokenIDs are the names of other tokens of the user whose activity is recorded by the same request, e.g.
+	// the sessions of the other tabs of a browser. The outcome for each of them is reported in status.additionalTokens.
+	// +optional
+	AdditionalTokenIDs []string `json:"additionalTokenIDs,omitempty"`
+}
+
 // UserActivityStatus defines the most recently observed status of the UserActivity.
 type UserActivityStatus struct {
 	// ExpiresAt is the timestamp at which the user's session expires if it stays idle, invalidating the corresponding session token.
 	// It is calculated by adding the duration specified in the auth-user-session-idle-ttl-minutes setting to the time of the request.
+	// It is serialized in RFC3339 format, in UTC.
 	// +optional
-	ExpiresAt string `json:"expiresAt"`
+	ExpiresAt metav1.Time `json:"expiresAt"`
+	// HeartbeatIntervalSeconds is the interval, in seconds, at which clients should report the activity of the user.
+	// It is computed from the idle timeout of the session token, and reporting activity more often than this is not needed
+	// to keep the session alive.
+	// +optional
+	HeartbeatIntervalSeconds int64 `json:"heartbeatIntervalSeconds,omitempty"`
+	// SessionRemainingSeconds is the remaining lifetime of the session token, in seconds, after which it expires
+	// regardless of the activity of the user. It is not set if the session token doesn't expire.
+	// +optional
+	SessionRemainingSeconds *int64 `json:"sessionRemainingSeconds,omitempty"`
+	// AdditionalTokens reports the activity recorded for the additional tokens of the spec, in the same order.
+	// +optional
+	AdditionalTokens []UserActivityTokenStatus `json:"additionalTokens,omitempty"`
+}
+
+// UserActivityTokenStatus is the activity recorded for an additional token of a UserActivity.
+type UserActivityTokenStatus struct {
+	// TokenID is the name of the token.
+	TokenID string `json:"tokenID"`
+	// ExpiresAt is the timestamp at which the session of the token expires if it stays idle. It is not set if the
+	// activity couldn't be recorded.
+	// +optional
+	ExpiresAt metav1.Time `json:"expiresAt,omitempty"`
+	// Error is why the activity couldn't be recorded for the token.
+	// +optional
+	Error string `json:"error,omitempty"`
 }
 
 // +genclient
 // +genclient:nonNamespaced
+// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Token is used to authenticate requests to Rancher.
@@ -196,6 +243,7 @@ func (t *Token) GetCreationTime() metav1.Time {
 
 // +genclient
 // +genclient:nonNamespaced
+// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Kubeconfig allows creating v1.Config kubeconfig files for interacting with Rancher and clusters managed by Rancher.
@@ -251,6 +299,8 @@ type KubeconfigStatus struct {
 }
 
 // +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // GroupMembershipRefreshRequest is used to initiate a user refresh action.
@@ -282,6 +332,8 @@ type GroupMembershipRefreshRequestStatus struct {
 }
 
 // +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // PasswordChangeRequest is used to change the password for a local user.
@@ -317,6 +369,8 @@ type PasswordChangeRequestStatus struct {
 }
 
 // +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SelfUser is used to retrieve the current user information.
@@ -334,3 +388,281 @@ type SelfUser struct {
 type SelfUserStatus struct {
 	UserID string `json:"userID,omitempty"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TokenRevocationRequest is used to revoke all the tokens matching a selection at once, e.g. after credentials leaked.
+type TokenRevocationRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the desired state of the TokenRevocationRequest.
+	// +optional
+	Spec TokenRevocationRequestSpec `json:"spec,omitempty"`
+	// Status is the most recently observed status of the TokenRevocationRequest.
+	// +optional
+	Status TokenRevocationRequestStatus `json:"status,omitempty"`
+}
+
+// TokenRevocationRequestSpec selects the tokens to revoke. A token is revoked if it matches all the given criteria, at
+// least one of which must be given.
+type TokenRevocationRequestSpec struct {
+	// UserID selects the tokens of the user.
+	// +optional
+	UserID string `json:"userID,omitempty"`
+	// ClusterName selects the tokens scoped to the cluster. Only management.cattle.io tokens can be scoped to a
+	// cluster.
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+	// LabelSelector selects the tokens by their labels. It must not be empty.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// CreatedBefore selects the tokens created before the given time.
+	// +optional
+	CreatedBefore *metav1.Time `json:"createdBefore,omitempty"`
+}
+
+// TokenRevocationRequestStatus defines the most recently observed status of the TokenRevocationRequest.
+type TokenRevocationRequestStatus struct {
+	// Conditions indicate state for particular aspects of the TokenRevocationRequest.
+	Conditions []metav1.Condition `json:"conditions"`
+	// Summary of the TokenRevocationRequest status.
+	Summary string `json:"summary,omitempty"`
+	// Matched is the number of tokens selected by the request.
+	Matched int `json:"matched"`
+	// Revoked is the number of tokens revoked.
+	Revoked int `json:"revoked"`
+	// Failed lists the names of the selected tokens which could not be revoked.
+	// +optional
+	Failed []string `json:"failed,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PrincipalMigrationRequest is used to migrate the cluster and project role template bindings of users from one
+// principal ID prefix to another, e.g. after moving to a different auth provider.
+type PrincipalMigrationRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the desired state of the PrincipalMigrationRequest.
+	// +optional
+	Spec PrincipalMigrationRequestSpec `json:"spec,omitempty"`
+	// Status is the most recently observed status of the PrincipalMigrationRequest.
+	// +optional
+	Status PrincipalMigrationRequestStatus `json:"status,omitempty"`
+}
+
+// PrincipalMigrationRequestSpec selects the bindings to migrate.
+type PrincipalMigrationRequestSpec struct {
+	// OldPrefix is the principal ID prefix the bindings are migrated from, e.g. "openldap_user://".
+	OldPrefix string `json:"oldPrefix"`
+	// NewPrefix is the principal ID prefix the bindings are migrated to, e.g. "activedirectory_user://".
+	NewPrefix string `json:"newPrefix"`
+	// UserID restricts the migration to the bindings of the user. All the users are migrated if it's not set.
+	// +optional
+	UserID string `json:"userID,omitempty"`
+}
+
+// PrincipalMigrationRequestStatus defines the most recently observed status of the PrincipalMigrationRequest.
+type PrincipalMigrationRequestStatus struct {
+	// Conditions indicate state for particular aspects of the PrincipalMigrationRequest.
+	Conditions []metav1.Condition `json:"conditions"`
+	// Summary of the PrincipalMigrationRequest status.
+	Summary string `json:"summary,omitempty"`
+	// Matched is the number of bindings selected by the request.
+	Matched int `json:"matched"`
+	// Migrated is the number of bindings migrated.
+	Migrated int `json:"migrated"`
+	// Failed lists the namespaced names of the selected bindings which could not be migrated.
+	// +optional
+	Failed []string `json:"failed,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccountLinkRequest is used to link an external principal, or a duplicate account, to an existing user, e.g. to
+// move a local user to an external auth provider or the other way around.
+type AccountLinkRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the desired state of the AccountLinkRequest.
+	// +optional
+	Spec AccountLinkRequestSpec `json:"spec,omitempty"`
+	// Status is the most recently observed status of the AccountLinkRequest.
+	// +optional
+	Status AccountLinkRequestStatus `json:"status,omitempty"`
+}
+
+// AccountLinkRequestSpec selects the user to keep and what is linked to it. At least one of PrincipalID and
+// DuplicateUserID must be given.
+type AccountLinkRequestSpec struct {
+	// UserID is the user to link to.
+	UserID string `json:"userID"`
+	// PrincipalID is the external principal to link to the user. The user owning the principal, if any, is the
+	// duplicate user.
+	// +optional
+	PrincipalID string `json:"principalID,omitempty"`
+	// DuplicateUserID is the duplicate account to merge into the user. Its principals, except its local one, bindings
+	// and tokens are transferred to the user, and it is deactivated.
+	// +optional
+	DuplicateUserID string `json:"duplicateUserID,omitempty"`
+}
+
+// AccountLinkRequestStatus defines the most recently observed status of the AccountLinkRequest.
+type AccountLinkRequestStatus struct {
+	// Conditions indicate state for particular aspects of the AccountLinkRequest.
+	Conditions []metav1.Condition `json:"conditions"`
+	// Summary of the AccountLinkRequest status.
+	Summary string `json:"summary,omitempty"`
+	// LinkedPrincipalIDs are the principal IDs linked to the user.
+	// +optional
+	LinkedPrincipalIDs []string `json:"linkedPrincipalIDs,omitempty"`
+	// Bindings is the number of bindings transferred to the user.
+	Bindings int `json:"bindings"`
+	// Tokens is the number of tokens transferred to the user.
+	Tokens int `json:"tokens"`
+	// Failed lists the bindings and tokens which could not be transferred.
+	// +optional
+	Failed []string `json:"failed,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UserMergeRequest is used to merge duplicate accounts, e.g. reported by the auth.cattle.io/duplicate-users annotation,
+// into one user and delete them.
+type UserMergeRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the desired state of the UserMergeRequest.
+	// +optional
+	Spec UserMergeRequestSpec `json:"spec,omitempty"`
+	// Status is the most recently observed status of the UserMergeRequest.
+	// +optional
+	Status UserMergeRequestStatus `json:"status,omitempty"`
+}
+
+// UserMergeRequestSpec selects the user to keep and the duplicate accounts to merge into it.
+type UserMergeRequestSpec struct {
+	// UserID is the user to keep.
+	UserID string `json:"userID"`
+	// DuplicateUserIDs are the duplicate accounts to merge into the user. Their principals, except their local one,
+	// bindings, tokens and preferences are transferred to the user, then they are deleted.
+	DuplicateUserIDs []string `json:"duplicateUserIDs"`
+}
+
+// UserMergeRequestStatus defines the most recently observed status of the UserMergeRequest.
+type UserMergeRequestStatus struct {
+	// Conditions indicate state for particular aspects of the UserMergeRequest.
+	Conditions []metav1.Condition `json:"conditions"`
+	// Summary of the UserMergeRequest status.
+	Summary string `json:"summary,omitempty"`
+	// MergedUserIDs are the duplicate accounts merged into the user and deleted.
+	// +optional
+	MergedUserIDs []string `json:"mergedUserIDs,omitempty"`
+	// LinkedPrincipalIDs are the principal IDs linked to the user.
+	// +optional
+	LinkedPrincipalIDs []string `json:"linkedPrincipalIDs,omitempty"`
+	// Bindings is the number of bindings transferred to the user.
+	Bindings int `json:"bindings"`
+	// Tokens is the number of tokens transferred to the user.
+	Tokens int `json:"tokens"`
+	// Preferences is the number of preferences copied to the user.
+	Preferences int `json:"preferences"`
+	// Failed lists the bindings, tokens and preferences which could not be transferred. A duplicate account is only
+	// deleted once all of them were transferred.
+	// +optional
+	Failed []string `json:"failed,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WhoCanRequest is used to find the users and groups which can perform an action in a cluster or a project, and the
+// bindings allowing them to.
+type WhoCanRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the desired state of the WhoCanRequest.
+	// +optional
+	Spec WhoCanRequestSpec `json:"spec,omitempty"`
+	// Status is the most recently observed status of the WhoCanRequest.
+	// +optional
+	Status WhoCanRequestStatus `json:"status,omitempty"`
+}
+
+// WhoCanRequestSpec describes the action.
+type WhoCanRequestSpec struct {
+	// Verb is the verb of the action, e.g. "get".
+	Verb string `json:"verb"`
+	// APIGroup is the API group of the resource, empty for the core group.
+	// +optional
+	APIGroup string `json:"apiGroup,omitempty"`
+	// Resource is the resource of the action, optionally with a subresource, e.g. "pods/log".
+	Resource string `json:"resource"`
+	// ResourceName is the name of the resource. Any name is considered if it's not set.
+	// +optional
+	ResourceName string `json:"resourceName,omitempty"`
+	// Namespace is the namespace of the resource, empty for cluster scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// ClusterName is the name of the cluster the action is performed in, e.g. "local".
+	ClusterName string `json:"clusterName"`
+	// ProjectName is the name of the project of the namespace, e.g. "p-xxxxx". The project role template bindings are
+	// only considered if it's set.
+	// +optional
+	ProjectName string `json:"projectName,omitempty"`
+}
+
+// WhoCanRequestStatus defines the most recently observed status of the WhoCanRequest.
+type WhoCanRequestStatus struct {
+	// Subjects are the users and groups which can perform the action, sorted by kind and name.
+	// +optional
+	Subjects []WhoCanRequestSubject `json:"subjects,omitempty"`
+}
+
+// WhoCanRequestSubject is a user or a group which can perform the action.
+type WhoCanRequestSubject struct {
+	// Kind is the kind of the subject, "User" or "Group".
+	Kind string `json:"kind"`
+	// Name is the name of the user, or its principal ID if the bindings only name its principal, or the principal ID of
+	// the group.
+	Name string `json:"name"`
+	// Bindings are the bindings allowing the subject to perform the action.
+	Bindings []WhoCanRequestBinding `json:"bindings"`
+}
+
+// WhoCanRequestBinding is a binding allowing a subject to perform the action.
+type WhoCanRequestBinding struct {
+	// Kind is the kind of the binding, e.g. "ClusterRoleTemplateBinding".
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the binding, empty for global role bindings.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the binding.
+	Name string `json:"name"`
+	// RoleName is the name of the global role or role template the binding grants.
+	RoleName string `json:"roleName"`
+}
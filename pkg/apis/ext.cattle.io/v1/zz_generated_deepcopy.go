@@ -26,12 +26,122 @@
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountLinkRequest) DeepCopyInto(out *AccountLinkRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountLinkRequest.
+func (in *AccountLinkRequest) DeepCopy() *AccountLinkRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountLinkRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountLinkRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountLinkRequestList) DeepCopyInto(out *AccountLinkRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountLinkRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountLinkRequestList.
+func (in *AccountLinkRequestList) DeepCopy() *AccountLinkRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountLinkRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountLinkRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountLinkRequestSpec) DeepCopyInto(out *AccountLinkRequestSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountLinkRequestSpec.
+func (in *AccountLinkRequestSpec) DeepCopy() *AccountLinkRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountLinkRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountLinkRequestStatus) DeepCopyInto(out *AccountLinkRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LinkedPrincipalIDs != nil {
+		in, out := &in.LinkedPrincipalIDs, &out.LinkedPrincipalIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountLinkRequestStatus.
+func (in *AccountLinkRequestStatus) DeepCopy() *AccountLinkRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountLinkRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupMembershipRefreshRequest) DeepCopyInto(out *GroupMembershipRefreshRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -241,7 +351,7 @@ func (in *PasswordChangeRequest) DeepCopyInto(out *PasswordChangeRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -336,6 +446,111 @@ func (in *PasswordChangeRequestStatus) DeepCopy() *PasswordChangeRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrincipalMigrationRequest) DeepCopyInto(out *PrincipalMigrationRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrincipalMigrationRequest.
+func (in *PrincipalMigrationRequest) DeepCopy() *PrincipalMigrationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PrincipalMigrationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrincipalMigrationRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrincipalMigrationRequestList) DeepCopyInto(out *PrincipalMigrationRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PrincipalMigrationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrincipalMigrationRequestList.
+func (in *PrincipalMigrationRequestList) DeepCopy() *PrincipalMigrationRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(PrincipalMigrationRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrincipalMigrationRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrincipalMigrationRequestSpec) DeepCopyInto(out *PrincipalMigrationRequestSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrincipalMigrationRequestSpec.
+func (in *PrincipalMigrationRequestSpec) DeepCopy() *PrincipalMigrationRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrincipalMigrationRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrincipalMigrationRequestStatus) DeepCopyInto(out *PrincipalMigrationRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrincipalMigrationRequestStatus.
+func (in *PrincipalMigrationRequestStatus) DeepCopy() *PrincipalMigrationRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrincipalMigrationRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelfUser) DeepCopyInto(out *SelfUser) {
 	*out = *in
@@ -496,6 +711,120 @@ func (in *TokenPrincipal) DeepCopy() *TokenPrincipal {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRevocationRequest) DeepCopyInto(out *TokenRevocationRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRevocationRequest.
+func (in *TokenRevocationRequest) DeepCopy() *TokenRevocationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRevocationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TokenRevocationRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRevocationRequestList) DeepCopyInto(out *TokenRevocationRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TokenRevocationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRevocationRequestList.
+func (in *TokenRevocationRequestList) DeepCopy() *TokenRevocationRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRevocationRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TokenRevocationRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRevocationRequestSpec) DeepCopyInto(out *TokenRevocationRequestSpec) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreatedBefore != nil {
+		in, out := &in.CreatedBefore, &out.CreatedBefore
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRevocationRequestSpec.
+func (in *TokenRevocationRequestSpec) DeepCopy() *TokenRevocationRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRevocationRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRevocationRequestStatus) DeepCopyInto(out *TokenRevocationRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRevocationRequestStatus.
+func (in *TokenRevocationRequestStatus) DeepCopy() *TokenRevocationRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRevocationRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenSpec) DeepCopyInto(out *TokenSpec) {
 	*out = *in
@@ -547,7 +876,8 @@ func (in *UserActivity) DeepCopyInto(out *UserActivity) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -602,9 +932,43 @@ func (in *UserActivityList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserActivitySpec) DeepCopyInto(out *UserActivitySpec) {
+	*out = *in
+	if in.AdditionalTokenIDs != nil {
+		in, out := &in.AdditionalTokenIDs, &out.AdditionalTokenIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserActivitySpec.
+func (in *UserActivitySpec) DeepCopy() *UserActivitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserActivitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserActivityStatus) DeepCopyInto(out *UserActivityStatus) {
 	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	if in.SessionRemainingSeconds != nil {
+		in, out := &in.SessionRemainingSeconds, &out.SessionRemainingSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AdditionalTokens != nil {
+		in, out := &in.AdditionalTokens, &out.AdditionalTokens
+		*out = make([]UserActivityTokenStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -617,3 +981,277 @@ func (in *UserActivityStatus) DeepCopy() *UserActivityStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserActivityTokenStatus) DeepCopyInto(out *UserActivityTokenStatus) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserActivityTokenStatus.
+func (in *UserActivityTokenStatus) DeepCopy() *UserActivityTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserActivityTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserMergeRequest) DeepCopyInto(out *UserMergeRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserMergeRequest.
+func (in *UserMergeRequest) DeepCopy() *UserMergeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(UserMergeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserMergeRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserMergeRequestList) DeepCopyInto(out *UserMergeRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserMergeRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserMergeRequestList.
+func (in *UserMergeRequestList) DeepCopy() *UserMergeRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserMergeRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserMergeRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserMergeRequestSpec) DeepCopyInto(out *UserMergeRequestSpec) {
+	*out = *in
+	if in.DuplicateUserIDs != nil {
+		in, out := &in.DuplicateUserIDs, &out.DuplicateUserIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserMergeRequestSpec.
+func (in *UserMergeRequestSpec) DeepCopy() *UserMergeRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserMergeRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserMergeRequestStatus) DeepCopyInto(out *UserMergeRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MergedUserIDs != nil {
+		in, out := &in.MergedUserIDs, &out.MergedUserIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LinkedPrincipalIDs != nil {
+		in, out := &in.LinkedPrincipalIDs, &out.LinkedPrincipalIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserMergeRequestStatus.
+func (in *UserMergeRequestStatus) DeepCopy() *UserMergeRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserMergeRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhoCanRequest) DeepCopyInto(out *WhoCanRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhoCanRequest.
+func (in *WhoCanRequest) DeepCopy() *WhoCanRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(WhoCanRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WhoCanRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhoCanRequestBinding) DeepCopyInto(out *WhoCanRequestBinding) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhoCanRequestBinding.
+func (in *WhoCanRequestBinding) DeepCopy() *WhoCanRequestBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(WhoCanRequestBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhoCanRequestList) DeepCopyInto(out *WhoCanRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WhoCanRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhoCanRequestList.
+func (in *WhoCanRequestList) DeepCopy() *WhoCanRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(WhoCanRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WhoCanRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhoCanRequestSpec) DeepCopyInto(out *WhoCanRequestSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhoCanRequestSpec.
+func (in *WhoCanRequestSpec) DeepCopy() *WhoCanRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WhoCanRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhoCanRequestStatus) DeepCopyInto(out *WhoCanRequestStatus) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]WhoCanRequestSubject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhoCanRequestStatus.
+func (in *WhoCanRequestStatus) DeepCopy() *WhoCanRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WhoCanRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhoCanRequestSubject) DeepCopyInto(out *WhoCanRequestSubject) {
+	*out = *in
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]WhoCanRequestBinding, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhoCanRequestSubject.
+func (in *WhoCanRequestSubject) DeepCopy() *WhoCanRequestSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(WhoCanRequestSubject)
+	in.DeepCopyInto(out)
+	return out
+}
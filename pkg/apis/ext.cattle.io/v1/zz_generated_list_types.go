@@ -27,6 +27,23 @@
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// AccountLinkRequestList is a list of AccountLinkRequest resources
+type AccountLinkRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AccountLinkRequest `json:"items"`
+}
+
+func NewAccountLinkRequest(namespace, name string, obj AccountLinkRequest) *AccountLinkRequest {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("AccountLinkRequest").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // GroupMembershipRefreshRequestList is a list of GroupMembershipRefreshRequest resources
 type GroupMembershipRefreshRequestList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -78,6 +95,23 @@ func NewPasswordChangeRequest(namespace, name string, obj PasswordChangeRequest)
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// PrincipalMigrationRequestList is a list of PrincipalMigrationRequest resources
+type PrincipalMigrationRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []PrincipalMigrationRequest `json:"items"`
+}
+
+func NewPrincipalMigrationRequest(namespace, name string, obj PrincipalMigrationRequest) *PrincipalMigrationRequest {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("PrincipalMigrationRequest").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // SelfUserList is a list of SelfUser resources
 type SelfUserList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -112,6 +146,23 @@ func NewToken(namespace, name string, obj Token) *Token {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// TokenRevocationRequestList is a list of TokenRevocationRequest resources
+type TokenRevocationRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TokenRevocationRequest `json:"items"`
+}
+
+func NewTokenRevocationRequest(namespace, name string, obj TokenRevocationRequest) *TokenRevocationRequest {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("TokenRevocationRequest").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // UserActivityList is a list of UserActivity resources
 type UserActivityList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -126,3 +177,37 @@ func NewUserActivity(namespace, name string, obj UserActivity) *UserActivity {
 	obj.Namespace = namespace
 	return &obj
 }
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UserMergeRequestList is a list of UserMergeRequest resources
+type UserMergeRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []UserMergeRequest `json:"items"`
+}
+
+func NewUserMergeRequest(namespace, name string, obj UserMergeRequest) *UserMergeRequest {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("UserMergeRequest").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WhoCanRequestList is a list of WhoCanRequest resources
+type WhoCanRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []WhoCanRequest `json:"items"`
+}
+
+func NewWhoCanRequest(namespace, name string, obj WhoCanRequest) *WhoCanRequest {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("WhoCanRequest").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
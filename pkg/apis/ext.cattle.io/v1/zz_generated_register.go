@@ -29,12 +29,17 @@
 )
 
 var (
+	AccountLinkRequestResourceName            = "accountlinkrequests"
 	GroupMembershipRefreshRequestResourceName = "groupmembershiprefreshrequests"
 	KubeconfigResourceName                    = "kubeconfigs"
 	PasswordChangeRequestResourceName         = "passwordchangerequests"
+	PrincipalMigrationRequestResourceName     = "principalmigrationrequests"
 	SelfUserResourceName                      = "selfusers"
 	TokenResourceName                         = "tokens"
+	TokenRevocationRequestResourceName        = "tokenrevocationrequests"
 	UserActivityResourceName                  = "useractivities"
+	UserMergeRequestResourceName              = "usermergerequests"
+	WhoCanRequestResourceName                 = "whocanrequests"
 )
 
 // SchemeGroupVersion is group version used to register these objects
@@ -58,18 +63,28 @@ func Resource(resource string) schema.GroupResource {
 // Adds the list of known types to Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&AccountLinkRequest{},
+		&AccountLinkRequestList{},
 		&GroupMembershipRefreshRequest{},
 		&GroupMembershipRefreshRequestList{},
 		&Kubeconfig{},
 		&KubeconfigList{},
 		&PasswordChangeRequest{},
 		&PasswordChangeRequestList{},
+		&PrincipalMigrationRequest{},
+		&PrincipalMigrationRequestList{},
 		&SelfUser{},
 		&SelfUserList{},
 		&Token{},
 		&TokenList{},
+		&TokenRevocationRequest{},
+		&TokenRevocationRequestList{},
 		&UserActivity{},
 		&UserActivityList{},
+		&UserMergeRequest{},
+		&UserMergeRequestList{},
+		&WhoCanRequest{},
+		&WhoCanRequestList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
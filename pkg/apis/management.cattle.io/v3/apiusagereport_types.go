@@ -0,0 +1,78 @@
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// APIUsageReportClusterLabel is set on the API usage reports to the name of the cluster they report on.
+	APIUsageReportClusterLabel = "management.cattle.io/api-usage-report-cluster"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName"
+// +kubebuilder:printcolumn:name="Requests",type="integer",JSONPath=".spec.totalRequests"
+// +kubebuilder:printcolumn:name="Period Start",type="date",JSONPath=".spec.periodStart"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// APIUsageReport is a periodic report of the requests made to a downstream cluster through the cluster proxy of a
+// Rancher replica, aggregated by user and resource. Reports are created by Rancher and deleted when they are older
+// than the api-usage-report-retention setting.
+type APIUsageReport struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the content of the report.
+	// +optional
+	Spec APIUsageReportSpec `json:"spec,omitempty"`
+}
+
+// APIUsageReportSpec is the content of an API usage report.
+type APIUsageReportSpec struct {
+	// ClusterName is the name of the management cluster the requests were made to.
+	ClusterName string `json:"clusterName"`
+
+	// PeriodStart is the time the report period started.
+	PeriodStart metav1.Time `json:"periodStart"`
+
+	// PeriodEnd is the time the report period ended.
+	PeriodEnd metav1.Time `json:"periodEnd"`
+
+	// TotalRequests is the number of requests made to the cluster during the period.
+	// +optional
+	TotalRequests int64 `json:"totalRequests,omitempty"`
+
+	// Entries are the request counts during the period, by user, verb and resource.
+	// +optional
+	Entries []APIUsageReportEntry `json:"entries,omitempty"`
+}
+
+// APIUsageReportEntry is the number of requests a user made with a verb on a resource.
+type APIUsageReportEntry struct {
+	// User is the name of the Rancher user who made the requests.
+	// +optional
+	User string `json:"user,omitempty"`
+
+	// Verb is the Kubernetes API verb of the requests, e.g. get, list or watch. It is the lowercase HTTP method of
+	// requests that are not made to a resource.
+	// +optional
+	Verb string `json:"verb,omitempty"`
+
+	// APIGroup is the API group of the resource.
+	// +optional
+	APIGroup string `json:"apiGroup,omitempty"`
+
+	// Resource is the requested resource, including its subresource if any, e.g. pods/log. It is empty for requests
+	// that are not made to a resource, such as discovery requests.
+	// +optional
+	Resource string `json:"resource,omitempty"`
+
+	// Count is the number of requests.
+	Count int64 `json:"count"`
+}
@@ -15,6 +15,9 @@
 	// AuthConfigConditionShibbolethSecretFixed is applied to an AuthConfig when the
 	// incorrect name for the shibboleth OpenLDAP secret has been fixed.
 	AuthConfigConditionShibbolethSecretFixed condition.Cond = "ShibbolethSecretFixed"
+	// AuthConfigConditionOrphanedTokens is true when tokens of users of a disabled AuthConfig remain, its message
+	// summarizes them.
+	AuthConfigConditionOrphanedTokens condition.Cond = "OrphanedTokens"
 
 	// AuthConfigOKTAPasswordMigrated is applied when an Okta password has been
 	// moved to a Secret.
@@ -175,6 +178,10 @@ type UserAttribute struct {
 	LastLogin       *metav1.Time                   `json:"lastLogin,omitempty"`
 	DisableAfter    *metav1.Duration               `json:"disableAfter,omitempty"` // Overrides DisableInactiveUserAfter setting.
 	DeleteAfter     *metav1.Duration               `json:"deleteAfter,omitempty"`  // Overrides DeleteInactiveUserAfter setting.
+	// SuspendedByProvider holds the auth providers whose access mode and allowed or denied principals currently deny
+	// the user access, with the time their access was suspended. Tokens of suspended providers are rejected, the
+	// bindings of the user are kept and take effect again once they regain access.
+	SuspendedByProvider map[string]metav1.Time `json:"suspendedByProvider,omitempty"`
 }
 
 type Principals struct {
@@ -229,6 +236,10 @@ type Principal struct {
 type SearchPrincipalsInput struct {
 	Name          string `json:"name" norman:"type=string,required,notnullable"`
 	PrincipalType string `json:"principalType,omitempty" norman:"type=enum,options=user|group"`
+	// Limit is the maximum number of principals returned. Zero returns all the principals found.
+	Limit int64 `json:"limit,omitempty" norman:"min=0"`
+	// Continue is the token returned with the previous page of principals, to get the next page.
+	Continue string `json:"continue,omitempty"`
 }
 
 type ChangePasswordInput struct {
@@ -253,6 +264,9 @@ type AuthConfig struct {
 	Enabled             bool     `json:"enabled,omitempty"`
 	AccessMode          string   `json:"accessMode,omitempty" norman:"required,notnullable,type=enum,options=required|restricted|unrestricted"`
 	AllowedPrincipalIDs []string `json:"allowedPrincipalIds,omitempty" norman:"type=array[reference[principal]]"`
+	// DeniedPrincipalIDs are the users and groups which can't log in with the provider, whatever the access mode.
+	// Users denied access or falling out of the allowed principals have their access suspended until they regain it.
+	DeniedPrincipalIDs []string `json:"deniedPrincipalIds,omitempty" norman:"type=array[reference[principal]]"`
 
 	// Flag. True when the auth provider supports a `Logout All` operation.
 	// Currently only the SAML providers do, with their `Single Log Out` flow.
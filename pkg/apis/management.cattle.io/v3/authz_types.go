@@ -65,6 +65,35 @@ type ProjectStatus struct {
 	// BackingNamespace is the name of the namespace that contains resources associated with the project.
 	// +optional
 	BackingNamespace string `json:"backingNamespace,omitempty"`
+
+	// UsedResources is the sum of the resources used by all namespaces in the project, as reported by the status of
+	// their resource quotas. Only set if the project has a resource quota.
+	// +optional
+	UsedResources *ResourceQuotaLimit `json:"usedResources,omitempty"`
+
+	// MemberSummary summarizes the members of the project, as granted by its ProjectRoleTemplateBindings.
+	// +optional
+	MemberSummary *ProjectMemberSummary `json:"memberSummary,omitempty"`
+}
+
+// ProjectMemberSummary summarizes the users and groups with access to a project, so that they can be listed without
+// fetching all the ProjectRoleTemplateBindings.
+type ProjectMemberSummary struct {
+	// MemberCount is the number of distinct users and groups bound to the project.
+	// +optional
+	MemberCount int `json:"memberCount,omitempty"`
+
+	// MembersByRole is the number of distinct users and groups bound to the project, by name of RoleTemplate.
+	// +optional
+	MembersByRole map[string]int `json:"membersByRole,omitempty"`
+
+	// Groups are the principal names of the groups bound to the project, sorted.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// LastMembershipChange is the last time the members of the project or their roles changed.
+	// +optional
+	LastMembershipChange metav1.Time `json:"lastMembershipChange,omitempty"`
 }
 
 // ProjectCondition is the status of an aspect of the project.
@@ -125,8 +154,49 @@ type ProjectSpec struct {
 	// See https://kubernetes.io/docs/concepts/policy/limit-range/ for more details.
 	// +optional
 	ContainerDefaultResourceLimit *ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty"`
+
+	// NamespaceDeletionPolicy controls what happens to the namespaces of the project when the project is deleted.
+	// Cascade deletes all namespaces, Orphan retains all namespaces and detaches them from the project, and Block
+	// keeps the project from being deleted until it has no namespaces.
+	// If empty, the namespaces created through Rancher are deleted, and the others are retained and detached.
+	// +kubebuilder:validation:Enum=Cascade;Orphan;Block
+	// +optional
+	NamespaceDeletionPolicy ProjectNamespaceDeletionPolicy `json:"namespaceDeletionPolicy,omitempty"`
+
+	// NetworkIsolation controls the default NetworkPolicies Rancher maintains in the namespaces of the project.
+	// DenyAll denies all ingress traffic to the namespaces, and SameProject only allows ingress traffic from the
+	// namespaces of the project and of the System project. Traffic allowed by other NetworkPolicies is not affected.
+	// If empty or None, no default NetworkPolicies are maintained.
+	// +kubebuilder:validation:Enum=None;DenyAll;SameProject
+	// +optional
+	NetworkIsolation ProjectNetworkIsolation `json:"networkIsolation,omitempty"`
 }
 
+// ProjectNamespaceDeletionPolicy controls what happens to the namespaces of a project when the project is deleted.
+type ProjectNamespaceDeletionPolicy string
+
+const (
+	// ProjectNamespaceDeletionPolicyCascade deletes all namespaces of the project.
+	ProjectNamespaceDeletionPolicyCascade ProjectNamespaceDeletionPolicy = "Cascade"
+	// ProjectNamespaceDeletionPolicyOrphan retains all namespaces of the project and detaches them from the project.
+	ProjectNamespaceDeletionPolicyOrphan ProjectNamespaceDeletionPolicy = "Orphan"
+	// ProjectNamespaceDeletionPolicyBlock keeps the project from being deleted until it has no namespaces.
+	ProjectNamespaceDeletionPolicyBlock ProjectNamespaceDeletionPolicy = "Block"
+)
+
+// ProjectNetworkIsolation controls the default NetworkPolicies maintained in the namespaces of a project.
+type ProjectNetworkIsolation string
+
+const (
+	// ProjectNetworkIsolationNone maintains no default NetworkPolicies.
+	ProjectNetworkIsolationNone ProjectNetworkIsolation = "None"
+	// ProjectNetworkIsolationDenyAll denies all ingress traffic to the namespaces of the project.
+	ProjectNetworkIsolationDenyAll ProjectNetworkIsolation = "DenyAll"
+	// ProjectNetworkIsolationSameProject only allows ingress traffic from the namespaces of the project and of the
+	// System project.
+	ProjectNetworkIsolationSameProject ProjectNetworkIsolation = "SameProject"
+)
+
 func (p *ProjectSpec) ObjClusterName() string {
 	return p.ClusterName
 }
@@ -0,0 +1,55 @@
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// BootstrapPrincipalLabel is set on the GlobalRoleBindings created from BootstrapPrincipals to the name of the
+	// BootstrapPrincipal they were created from.
+	BootstrapPrincipalLabel = "management.cattle.io/bootstrap-principal"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.userPrincipalName"
+// +kubebuilder:printcolumn:name="Group",type="string",JSONPath=".spec.groupPrincipalName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BootstrapPrincipal declares a user or group principal bound to global roles, e.g. the initial admins of an install
+// managed with GitOps. Rancher creates a GlobalRoleBinding for the principal and each global role, creating the user of
+// a user principal before it first logs in, and deletes the bindings when the BootstrapPrincipal is deleted. As for
+// GlobalRoleBindings, the user who created the BootstrapPrincipal, recorded in its field.cattle.io/creatorId
+// annotation, must be able to grant the global roles.
+type BootstrapPrincipal struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the principal. It is immutable, as the global roles are only checked against the
+	// creator of the BootstrapPrincipal.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+	Spec BootstrapPrincipalSpec `json:"spec,omitempty"`
+}
+
+// BootstrapPrincipalSpec is the desired state of a BootstrapPrincipal.
+// +kubebuilder:validation:XValidation:rule="has(self.userPrincipalName) != has(self.groupPrincipalName)",message="exactly one of userPrincipalName or groupPrincipalName must be set"
+type BootstrapPrincipalSpec struct {
+	// UserPrincipalName is the name of the user principal bound to the global roles, e.g. github_user://1234.
+	// +optional
+	UserPrincipalName string `json:"userPrincipalName,omitempty"`
+
+	// GroupPrincipalName is the name of the group principal bound to the global roles,
+	// e.g. activedirectory_group://CN=admins,DC=example,DC=com.
+	// +optional
+	GroupPrincipalName string `json:"groupPrincipalName,omitempty"`
+
+	// GlobalRoleNames are the names of the global roles bound to the principal.
+	// +kubebuilder:validation:MinItems=1
+	GlobalRoleNames []string `json:"globalRoleNames"`
+}
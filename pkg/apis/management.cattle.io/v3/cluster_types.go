@@ -120,6 +120,7 @@ type ClusterSpecBase struct {
 	ClusterSecrets                                       ClusterSecrets                          `json:"clusterSecrets" norman:"nocreate,noupdate"`
 	ClusterAgentDeploymentCustomization                  *AgentDeploymentCustomization           `json:"clusterAgentDeploymentCustomization,omitempty"`
 	FleetAgentDeploymentCustomization                    *AgentDeploymentCustomization           `json:"fleetAgentDeploymentCustomization,omitempty"`
+	SystemChartVersions                                  map[string]string                       `json:"systemChartVersions,omitempty"`
 }
 
 type AgentDeploymentCustomization struct {
@@ -228,6 +229,28 @@ type ClusterStatus struct {
 	AADClientCertSecret        string                    `json:"aadClientCertSecret,omitempty" norman:"nocreate,noupdate"`   // Deprecated: use ClusterSpec.ClusterSecrets.AADClientCertSecret instead
 
 	AppliedClusterAgentDeploymentCustomization *AgentDeploymentCustomization `json:"appliedClusterAgentDeploymentCustomization,omitempty"`
+
+	// MemberSummary summarizes the members of the cluster, as granted by its ClusterRoleTemplateBindings.
+	MemberSummary *ClusterMemberSummary `json:"memberSummary,omitempty" norman:"nocreate,noupdate"`
+}
+
+// ClusterMemberSummary summarizes the users and groups with access to a cluster, so that they can be audited and
+// listed without fetching all the ClusterRoleTemplateBindings.
+type ClusterMemberSummary struct {
+	// MemberCount is the number of distinct users and groups bound to the cluster.
+	MemberCount int `json:"memberCount,omitempty"`
+
+	// OwnerCount is the number of distinct users and groups bound to the cluster-owner RoleTemplate.
+	OwnerCount int `json:"ownerCount,omitempty"`
+
+	// MembersByRole is the number of distinct users and groups bound to the cluster, by name of RoleTemplate.
+	MembersByRole map[string]int `json:"membersByRole,omitempty"`
+
+	// Groups are the principal names of the groups bound to the cluster, sorted.
+	Groups []string `json:"groups,omitempty"`
+
+	// LastMembershipChange is the last time the members of the cluster or their roles changed.
+	LastMembershipChange metav1.Time `json:"lastMembershipChange,omitempty"`
 }
 
 type ClusterComponentStatus struct {
@@ -41,6 +41,22 @@ type FeatureStatus struct {
 	Default     bool   `json:"default"`
 	Description string `json:"description"`
 	LockedValue *bool  `json:"lockedValue"`
+	// Dependencies are the names of the features that must be enabled for the feature to work.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// UnmetDependencies are the names of the features the feature depends on that are disabled.
+	UnmetDependencies []string `json:"unmetDependencies,omitempty"`
+	// MinKubernetesVersion is the minimum Kubernetes version a downstream cluster must run for the feature to be applied to it.
+	MinKubernetesVersion string `json:"minKubernetesVersion,omitempty"`
+	// Clusters reports whether the feature is applied to each downstream cluster. It is only set for features that
+	// require a minimum Kubernetes version.
+	Clusters []FeatureClusterStatus `json:"clusters,omitempty"`
+}
+
+// FeatureClusterStatus reports whether a feature is applied to a downstream cluster.
+type FeatureClusterStatus struct {
+	ClusterName string `json:"clusterName"`
+	Applied     bool   `json:"applied"`
+	Message     string `json:"message,omitempty"`
 }
 
 const (
@@ -0,0 +1,45 @@
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GlobalRoleGroupMappingUserLabel is set on the GlobalRoleBindings created from GlobalRoleGroupMappings to the name
+	// of the user they bind.
+	GlobalRoleGroupMappingUserLabel = "management.cattle.io/global-role-group-mapping-user"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Group",type="string",JSONPath=".spec.groupPrincipalName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GlobalRoleGroupMapping binds global roles to every member of a directory group. Rancher creates a GlobalRoleBinding
+// for each member of the group and global role, and deletes it when the user is no longer a member of the group, as
+// group memberships are refreshed from the auth provider.
+type GlobalRoleGroupMapping struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the mapping.
+	// +optional
+	Spec GlobalRoleGroupMappingSpec `json:"spec,omitempty"`
+}
+
+// GlobalRoleGroupMappingSpec is the desired state of a GlobalRoleGroupMapping.
+type GlobalRoleGroupMappingSpec struct {
+	// GroupPrincipalName is the name of the group principal whose members are bound to the global roles,
+	// e.g. activedirectory_group://CN=admins,DC=example,DC=com.
+	// +kubebuilder:validation:Required
+	GroupPrincipalName string `json:"groupPrincipalName"`
+
+	// GlobalRoleNames are the names of the global roles bound to the members of the group.
+	// +optional
+	GlobalRoleNames []string `json:"globalRoleNames,omitempty"`
+}
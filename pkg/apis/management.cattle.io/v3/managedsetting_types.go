@@ -0,0 +1,63 @@
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ManagedSetting declares settings, such as the audit level, registry mirrors or pod security admission defaults, once
+// in Rancher and propagates them to all the downstream clusters, or to the ones matching its cluster selector.
+type ManagedSetting struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the settings and the clusters they are propagated to.
+	// +optional
+	Spec ManagedSettingSpec `json:"spec,omitempty"`
+
+	// Status is the most recently observed status of the propagation to the downstream clusters.
+	// +optional
+	Status ManagedSettingStatus `json:"status,omitempty"`
+}
+
+// ManagedSettingSpec is the specification of a managed setting.
+type ManagedSettingSpec struct {
+	// ClusterSelector selects the downstream clusters the settings are propagated to, by the labels of their
+	// management cluster. All the clusters are selected when it is not set.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// Settings are the settings propagated to the selected clusters, keyed by setting name.
+	// +optional
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+// ManagedSettingStatus represents the most recently observed status of a managed setting.
+type ManagedSettingStatus struct {
+	// Clusters is the propagation status of the settings in each of the selected clusters.
+	// +optional
+	Clusters []ManagedSettingClusterStatus `json:"clusters,omitempty"`
+}
+
+// ManagedSettingClusterStatus represents the propagation status of a managed setting in a downstream cluster.
+type ManagedSettingClusterStatus struct {
+	// ClusterName is the name of the management cluster.
+	ClusterName string `json:"clusterName"`
+	// ObservedGeneration is the generation of the managed setting last propagated to the cluster.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Applied indicates whether the settings of the observed generation are applied in the cluster.
+	// +optional
+	Applied bool `json:"applied,omitempty"`
+	// Error is the error that prevented the settings from being applied in the cluster, if any.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,102 @@
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodSecurityAdmissionProfile declares the pod security levels of the namespaces of the projects it is bound to, in all
+// the downstream clusters or in the ones matching its cluster selector. The levels are applied as pod security
+// admission labels of the namespaces, which are reconciled by Rancher instead of being labeled manually.
+type PodSecurityAdmissionProfile struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the pod security levels and the clusters and projects they are applied to.
+	// +optional
+	Spec PodSecurityAdmissionProfileSpec `json:"spec,omitempty"`
+
+	// Status is the most recently observed status of the profile in the downstream clusters.
+	// +optional
+	Status PodSecurityAdmissionProfileStatus `json:"status,omitempty"`
+}
+
+// PodSecurityAdmissionProfileSpec is the specification of a pod security admission profile.
+type PodSecurityAdmissionProfileSpec struct {
+	// ClusterSelector selects the downstream clusters the profile is bound to, by the labels of their management
+	// cluster. All the clusters are selected when it is not set.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// ProjectSelector selects the projects of the selected clusters the profile is bound to, by their labels. All the
+	// projects but the System project are selected when it is not set. A profile binding a project through its
+	// project selector takes precedence over a profile binding all the projects of the cluster.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+
+	// Enforce is the pod security level enforced in the namespaces.
+	// +kubebuilder:validation:Enum=privileged;baseline;restricted
+	// +optional
+	Enforce string `json:"enforce,omitempty"`
+
+	// EnforceVersion is the version of the enforced pod security level, either 'latest' or a Kubernetes minor version
+	// such as 'v1.32'.
+	// +optional
+	EnforceVersion string `json:"enforceVersion,omitempty"`
+
+	// Audit is the pod security level whose violations are recorded in the audit log.
+	// +kubebuilder:validation:Enum=privileged;baseline;restricted
+	// +optional
+	Audit string `json:"audit,omitempty"`
+
+	// AuditVersion is the version of the audited pod security level.
+	// +optional
+	AuditVersion string `json:"auditVersion,omitempty"`
+
+	// Warn is the pod security level whose violations are returned as warnings to the users.
+	// +kubebuilder:validation:Enum=privileged;baseline;restricted
+	// +optional
+	Warn string `json:"warn,omitempty"`
+
+	// WarnVersion is the version of the warned pod security level.
+	// +optional
+	WarnVersion string `json:"warnVersion,omitempty"`
+}
+
+// PodSecurityAdmissionProfileStatus represents the most recently observed status of a pod security admission profile.
+type PodSecurityAdmissionProfileStatus struct {
+	// Clusters is the status of the profile in each of the selected clusters.
+	// +optional
+	Clusters []PodSecurityAdmissionProfileClusterStatus `json:"clusters,omitempty"`
+}
+
+// PodSecurityAdmissionProfileClusterStatus represents the status of a pod security admission profile in a downstream
+// cluster.
+type PodSecurityAdmissionProfileClusterStatus struct {
+	// ClusterName is the name of the management cluster.
+	ClusterName string `json:"clusterName"`
+	// ObservedGeneration is the generation of the profile last applied to the cluster.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Namespaces is the number of namespaces of the cluster the profile is applied to.
+	// +optional
+	Namespaces int `json:"namespaces,omitempty"`
+	// Compliant indicates whether all the namespaces the profile is applied to are labeled with its pod security
+	// levels.
+	// +optional
+	Compliant bool `json:"compliant,omitempty"`
+	// NonCompliantNamespaces are the namespaces whose labels could not be reconciled with the profile.
+	// +optional
+	NonCompliantNamespaces []string `json:"nonCompliantNamespaces,omitempty"`
+	// Error is the error that prevented the profile from being applied in the cluster, if any.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
@@ -55,6 +55,9 @@ type GenericLogin struct {
 	TTLMillis    int64  `json:"ttl,omitempty"`
 	Description  string `json:"description,omitempty" norman:"type=string,required"`
 	ResponseType string `json:"responseType,omitempty" norman:"type=string,required"` //json or cookie
+	// ChallengeResponse is the response to the challenge required from logins showing risk signals, e.g. a CAPTCHA
+	// token. It is passed as is to the configured challenge, see the challenge package.
+	ChallengeResponse string `json:"challengeResponse,omitempty"`
 }
 
 type BasicLogin struct {
@@ -66,6 +69,7 @@ type BasicLogin struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type LocalProvider struct {
@@ -77,6 +81,7 @@ type LocalProvider struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type GithubProvider struct {
@@ -95,6 +100,7 @@ type GithubLogin struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type GoogleOAuthProvider struct {
@@ -113,6 +119,7 @@ type GoogleOauthLogin struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type ActiveDirectoryProvider struct {
@@ -126,6 +133,7 @@ type ActiveDirectoryProvider struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type AzureADProvider struct {
@@ -142,6 +150,7 @@ type AzureADProvider struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type SamlProvider struct {
@@ -161,6 +170,7 @@ type AzureADLogin struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type OpenLdapProvider struct {
@@ -172,6 +182,7 @@ type OpenLdapProvider struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type FreeIpaProvider struct {
@@ -214,6 +225,7 @@ type SamlLoginOutput struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type OIDCProvider struct {
@@ -236,6 +248,7 @@ type KeyCloakOIDCProvider struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type GenericOIDCProvider struct {
@@ -255,6 +268,7 @@ type GenericOIDCLogin struct {
 // +genclient
 // +kubebuilder:skipversion
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=apply,applyStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type CognitoProvider struct {
@@ -0,0 +1,60 @@
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RoleTemplatePolicy declares rule patterns custom RoleTemplates must not grant, e.g. all verbs on secrets cluster-wide,
+// to keep the roles of tenants within guardrails. The Rancher API rejects the creation and update of RoleTemplates
+// granting a forbidden rule, and the RoleTemplates granting one anyway, e.g. created before the policy, are flagged with
+// the authz.management.cattle.io/policy-violation annotation. Builtin RoleTemplates aren't subject to policies.
+type RoleTemplatePolicy struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the policy.
+	// +optional
+	Spec RoleTemplatePolicySpec `json:"spec,omitempty"`
+}
+
+// RoleTemplatePolicySpec is the desired state of a RoleTemplatePolicy.
+type RoleTemplatePolicySpec struct {
+	// ForbiddenRules are the rule patterns custom RoleTemplates must not grant.
+	// +kubebuilder:validation:MinItems=1
+	ForbiddenRules []ForbiddenRule `json:"forbiddenRules"`
+}
+
+// ForbiddenRule is a rule pattern custom RoleTemplates must not grant. A rule of a RoleTemplate grants the pattern if
+// it grants any of its verbs on any of its resources in any of its API groups. An empty list of the pattern matches
+// anything, and "*" matches anything in both the pattern and the rules.
+type ForbiddenRule struct {
+	// Description explains why the rule is forbidden. It is part of the error returned for RoleTemplates granting it.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Contexts are the contexts of the RoleTemplates the rule is forbidden in, "cluster" or "project". The rule is
+	// forbidden in both if empty.
+	// +optional
+	Contexts []string `json:"contexts,omitempty"`
+
+	// APIGroups are the API groups of the forbidden resources.
+	// +optional
+	APIGroups []string `json:"apiGroups,omitempty"`
+
+	// Resources are the forbidden resources.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+
+	// Verbs are the forbidden verbs.
+	// +optional
+	Verbs []string `json:"verbs,omitempty"`
+}
@@ -202,6 +202,105 @@ func (in *APIServiceStatus) DeepCopy() *APIServiceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIUsageReport) DeepCopyInto(out *APIUsageReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIUsageReport.
+func (in *APIUsageReport) DeepCopy() *APIUsageReport {
+	if in == nil {
+		return nil
+	}
+	out := new(APIUsageReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIUsageReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIUsageReportEntry) DeepCopyInto(out *APIUsageReportEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIUsageReportEntry.
+func (in *APIUsageReportEntry) DeepCopy() *APIUsageReportEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(APIUsageReportEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIUsageReportList) DeepCopyInto(out *APIUsageReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIUsageReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIUsageReportList.
+func (in *APIUsageReportList) DeepCopy() *APIUsageReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(APIUsageReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIUsageReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIUsageReportSpec) DeepCopyInto(out *APIUsageReportSpec) {
+	*out = *in
+	in.PeriodStart.DeepCopyInto(&out.PeriodStart)
+	in.PeriodEnd.DeepCopyInto(&out.PeriodEnd)
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]APIUsageReportEntry, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIUsageReportSpec.
+func (in *APIUsageReportSpec) DeepCopy() *APIUsageReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIUsageReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Action) DeepCopyInto(out *Action) {
 	*out = *in
@@ -467,6 +566,11 @@ func (in *AuthConfig) DeepCopyInto(out *AuthConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DeniedPrincipalIDs != nil {
+		in, out := &in.DeniedPrincipalIDs, &out.DeniedPrincipalIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -846,6 +950,87 @@ func (in *BasicLogin) DeepCopy() *BasicLogin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapPrincipal) DeepCopyInto(out *BootstrapPrincipal) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapPrincipal.
+func (in *BootstrapPrincipal) DeepCopy() *BootstrapPrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapPrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BootstrapPrincipal) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapPrincipalList) DeepCopyInto(out *BootstrapPrincipalList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BootstrapPrincipal, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapPrincipalList.
+func (in *BootstrapPrincipalList) DeepCopy() *BootstrapPrincipalList {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapPrincipalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BootstrapPrincipalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapPrincipalSpec) DeepCopyInto(out *BootstrapPrincipalSpec) {
+	*out = *in
+	if in.GlobalRoleNames != nil {
+		in, out := &in.GlobalRoleNames, &out.GlobalRoleNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapPrincipalSpec.
+func (in *BootstrapPrincipalSpec) DeepCopy() *BootstrapPrincipalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapPrincipalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Capabilities) DeepCopyInto(out *Capabilities) {
 	*out = *in
@@ -1087,6 +1272,35 @@ func (in *ClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMemberSummary) DeepCopyInto(out *ClusterMemberSummary) {
+	*out = *in
+	if in.MembersByRole != nil {
+		in, out := &in.MembersByRole, &out.MembersByRole
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastMembershipChange.DeepCopyInto(&out.LastMembershipChange)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMemberSummary.
+func (in *ClusterMemberSummary) DeepCopy() *ClusterMemberSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMemberSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterProxyConfig) DeepCopyInto(out *ClusterProxyConfig) {
 	*out = *in
@@ -1451,6 +1665,13 @@ func (in *ClusterSpecBase) DeepCopyInto(out *ClusterSpecBase) {
 		*out = new(AgentDeploymentCustomization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SystemChartVersions != nil {
+		in, out := &in.SystemChartVersions, &out.SystemChartVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1548,6 +1769,11 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		*out = new(AgentDeploymentCustomization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MemberSummary != nil {
+		in, out := &in.MemberSummary, &out.MemberSummary
+		*out = new(ClusterMemberSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2383,6 +2609,22 @@ func (in *Feature) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureClusterStatus) DeepCopyInto(out *FeatureClusterStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureClusterStatus.
+func (in *FeatureClusterStatus) DeepCopy() *FeatureClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureList) DeepCopyInto(out *FeatureList) {
 	*out = *in
@@ -2445,6 +2687,21 @@ func (in *FeatureStatus) DeepCopyInto(out *FeatureStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnmetDependencies != nil {
+		in, out := &in.UnmetDependencies, &out.UnmetDependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]FeatureClusterStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2605,6 +2862,42 @@ func (in *FleetWorkspaceStatus) DeepCopy() *FleetWorkspaceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForbiddenRule) DeepCopyInto(out *ForbiddenRule) {
+	*out = *in
+	if in.Contexts != nil {
+		in, out := &in.Contexts, &out.Contexts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIGroups != nil {
+		in, out := &in.APIGroups, &out.APIGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForbiddenRule.
+func (in *ForbiddenRule) DeepCopy() *ForbiddenRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ForbiddenRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreeIpaConfig) DeepCopyInto(out *FreeIpaConfig) {
 	*out = *in
@@ -3219,32 +3512,26 @@ func (in *GlobalRoleBindingStatus) DeepCopy() *GlobalRoleBindingStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalRoleList) DeepCopyInto(out *GlobalRoleList) {
+func (in *GlobalRoleGroupMapping) DeepCopyInto(out *GlobalRoleGroupMapping) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]GlobalRole, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRoleList.
-func (in *GlobalRoleList) DeepCopy() *GlobalRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRoleGroupMapping.
+func (in *GlobalRoleGroupMapping) DeepCopy() *GlobalRoleGroupMapping {
 	if in == nil {
 		return nil
 	}
-	out := new(GlobalRoleList)
+	out := new(GlobalRoleGroupMapping)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GlobalRoleList) DeepCopyObject() runtime.Object {
+func (in *GlobalRoleGroupMapping) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3252,11 +3539,13 @@ func (in *GlobalRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalRoleStatus) DeepCopyInto(out *GlobalRoleStatus) {
+func (in *GlobalRoleGroupMappingList) DeepCopyInto(out *GlobalRoleGroupMappingList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalRoleGroupMapping, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -3264,36 +3553,121 @@ func (in *GlobalRoleStatus) DeepCopyInto(out *GlobalRoleStatus) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRoleStatus.
-func (in *GlobalRoleStatus) DeepCopy() *GlobalRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRoleGroupMappingList.
+func (in *GlobalRoleGroupMappingList) DeepCopy() *GlobalRoleGroupMappingList {
 	if in == nil {
 		return nil
 	}
-	out := new(GlobalRoleStatus)
+	out := new(GlobalRoleGroupMappingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalRoleGroupMappingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GoogleOAuthProvider) DeepCopyInto(out *GoogleOAuthProvider) {
+func (in *GlobalRoleGroupMappingSpec) DeepCopyInto(out *GlobalRoleGroupMappingSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.AuthProvider.DeepCopyInto(&out.AuthProvider)
+	if in.GlobalRoleNames != nil {
+		in, out := &in.GlobalRoleNames, &out.GlobalRoleNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleOAuthProvider.
-func (in *GoogleOAuthProvider) DeepCopy() *GoogleOAuthProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRoleGroupMappingSpec.
+func (in *GlobalRoleGroupMappingSpec) DeepCopy() *GlobalRoleGroupMappingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GoogleOAuthProvider)
+	out := new(GlobalRoleGroupMappingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRoleList) DeepCopyInto(out *GlobalRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRoleList.
+func (in *GlobalRoleList) DeepCopy() *GlobalRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRoleStatus) DeepCopyInto(out *GlobalRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRoleStatus.
+func (in *GlobalRoleStatus) DeepCopy() *GlobalRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleOAuthProvider) DeepCopyInto(out *GoogleOAuthProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.AuthProvider.DeepCopyInto(&out.AuthProvider)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleOAuthProvider.
+func (in *GoogleOAuthProvider) DeepCopy() *GoogleOAuthProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleOAuthProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
 func (in *GoogleOAuthProvider) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
@@ -4245,6 +4619,132 @@ func (in *ManagedChartStatus) DeepCopy() *ManagedChartStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSetting) DeepCopyInto(out *ManagedSetting) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSetting.
+func (in *ManagedSetting) DeepCopy() *ManagedSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedSetting) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSettingClusterStatus) DeepCopyInto(out *ManagedSettingClusterStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSettingClusterStatus.
+func (in *ManagedSettingClusterStatus) DeepCopy() *ManagedSettingClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSettingClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSettingList) DeepCopyInto(out *ManagedSettingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedSetting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSettingList.
+func (in *ManagedSettingList) DeepCopy() *ManagedSettingList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSettingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedSettingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSettingSpec) DeepCopyInto(out *ManagedSettingSpec) {
+	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSettingSpec.
+func (in *ManagedSettingSpec) DeepCopy() *ManagedSettingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSettingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSettingStatus) DeepCopyInto(out *ManagedSettingStatus) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ManagedSettingClusterStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSettingStatus.
+func (in *ManagedSettingStatus) DeepCopy() *ManagedSettingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSettingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MapDelta) DeepCopyInto(out *MapDelta) {
 	*out = *in
@@ -5600,6 +6100,137 @@ func (in *PodSecurityAdmissionConfigurationTemplateSpec) DeepCopy() *PodSecurity
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionProfile) DeepCopyInto(out *PodSecurityAdmissionProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionProfile.
+func (in *PodSecurityAdmissionProfile) DeepCopy() *PodSecurityAdmissionProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityAdmissionProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodSecurityAdmissionProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionProfileClusterStatus) DeepCopyInto(out *PodSecurityAdmissionProfileClusterStatus) {
+	*out = *in
+	if in.NonCompliantNamespaces != nil {
+		in, out := &in.NonCompliantNamespaces, &out.NonCompliantNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionProfileClusterStatus.
+func (in *PodSecurityAdmissionProfileClusterStatus) DeepCopy() *PodSecurityAdmissionProfileClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityAdmissionProfileClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionProfileList) DeepCopyInto(out *PodSecurityAdmissionProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodSecurityAdmissionProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionProfileList.
+func (in *PodSecurityAdmissionProfileList) DeepCopy() *PodSecurityAdmissionProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityAdmissionProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodSecurityAdmissionProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionProfileSpec) DeepCopyInto(out *PodSecurityAdmissionProfileSpec) {
+	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionProfileSpec.
+func (in *PodSecurityAdmissionProfileSpec) DeepCopy() *PodSecurityAdmissionProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityAdmissionProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionProfileStatus) DeepCopyInto(out *PodSecurityAdmissionProfileStatus) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]PodSecurityAdmissionProfileClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionProfileStatus.
+func (in *PodSecurityAdmissionProfileStatus) DeepCopy() *PodSecurityAdmissionProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityAdmissionProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Preference) DeepCopyInto(out *Preference) {
 	*out = *in
@@ -5848,6 +6479,35 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMemberSummary) DeepCopyInto(out *ProjectMemberSummary) {
+	*out = *in
+	if in.MembersByRole != nil {
+		in, out := &in.MembersByRole, &out.MembersByRole
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastMembershipChange.DeepCopyInto(&out.LastMembershipChange)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMemberSummary.
+func (in *ProjectMemberSummary) DeepCopy() *ProjectMemberSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMemberSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectNetworkPolicy) DeepCopyInto(out *ProjectNetworkPolicy) {
 	*out = *in
@@ -6063,6 +6723,16 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		*out = make([]ProjectCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.UsedResources != nil {
+		in, out := &in.UsedResources, &out.UsedResources
+		*out = new(ResourceQuotaLimit)
+		**out = **in
+	}
+	if in.MemberSummary != nil {
+		in, out := &in.MemberSummary, &out.MemberSummary
+		*out = new(ProjectMemberSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6493,6 +7163,89 @@ func (in *RoleTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleTemplatePolicy) DeepCopyInto(out *RoleTemplatePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleTemplatePolicy.
+func (in *RoleTemplatePolicy) DeepCopy() *RoleTemplatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleTemplatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoleTemplatePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleTemplatePolicyList) DeepCopyInto(out *RoleTemplatePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RoleTemplatePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleTemplatePolicyList.
+func (in *RoleTemplatePolicyList) DeepCopy() *RoleTemplatePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleTemplatePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoleTemplatePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleTemplatePolicySpec) DeepCopyInto(out *RoleTemplatePolicySpec) {
+	*out = *in
+	if in.ForbiddenRules != nil {
+		in, out := &in.ForbiddenRules, &out.ForbiddenRules
+		*out = make([]ForbiddenRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleTemplatePolicySpec.
+func (in *RoleTemplatePolicySpec) DeepCopy() *RoleTemplatePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleTemplatePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RotateCertificateInput) DeepCopyInto(out *RotateCertificateInput) {
 	*out = *in
@@ -7191,6 +7944,13 @@ func (in *UserAttribute) DeepCopyInto(out *UserAttribute) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.SuspendedByProvider != nil {
+		in, out := &in.SuspendedByProvider, &out.SuspendedByProvider
+		*out = make(map[string]metav1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
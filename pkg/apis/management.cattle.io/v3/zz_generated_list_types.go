@@ -43,6 +43,23 @@ func NewAPIService(namespace, name string, obj APIService) *APIService {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// APIUsageReportList is a list of APIUsageReport resources
+type APIUsageReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []APIUsageReport `json:"items"`
+}
+
+func NewAPIUsageReport(namespace, name string, obj APIUsageReport) *APIUsageReport {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("APIUsageReport").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // ActiveDirectoryProviderList is a list of ActiveDirectoryProvider resources
 type ActiveDirectoryProviderList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -128,6 +145,23 @@ func NewAzureADProvider(namespace, name string, obj AzureADProvider) *AzureADPro
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// BootstrapPrincipalList is a list of BootstrapPrincipal resources
+type BootstrapPrincipalList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []BootstrapPrincipal `json:"items"`
+}
+
+func NewBootstrapPrincipal(namespace, name string, obj BootstrapPrincipal) *BootstrapPrincipal {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("BootstrapPrincipal").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // CloudCredentialList is a list of CloudCredential resources
 type CloudCredentialList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -434,6 +468,23 @@ func NewGlobalRoleBinding(namespace, name string, obj GlobalRoleBinding) *Global
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// GlobalRoleGroupMappingList is a list of GlobalRoleGroupMapping resources
+type GlobalRoleGroupMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []GlobalRoleGroupMapping `json:"items"`
+}
+
+func NewGlobalRoleGroupMapping(namespace, name string, obj GlobalRoleGroupMapping) *GlobalRoleGroupMapping {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("GlobalRoleGroupMapping").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // GoogleOAuthProviderList is a list of GoogleOAuthProvider resources
 type GoogleOAuthProviderList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -536,6 +587,23 @@ func NewManagedChart(namespace, name string, obj ManagedChart) *ManagedChart {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ManagedSettingList is a list of ManagedSetting resources
+type ManagedSettingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ManagedSetting `json:"items"`
+}
+
+func NewManagedSetting(namespace, name string, obj ManagedSetting) *ManagedSetting {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ManagedSetting").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // NodeList is a list of Node resources
 type NodeList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -672,6 +740,23 @@ func NewPodSecurityAdmissionConfigurationTemplate(namespace, name string, obj Po
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// PodSecurityAdmissionProfileList is a list of PodSecurityAdmissionProfile resources
+type PodSecurityAdmissionProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []PodSecurityAdmissionProfile `json:"items"`
+}
+
+func NewPodSecurityAdmissionProfile(namespace, name string, obj PodSecurityAdmissionProfile) *PodSecurityAdmissionProfile {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("PodSecurityAdmissionProfile").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // PreferenceList is a list of Preference resources
 type PreferenceList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -842,6 +927,23 @@ func NewRoleTemplate(namespace, name string, obj RoleTemplate) *RoleTemplate {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// RoleTemplatePolicyList is a list of RoleTemplatePolicy resources
+type RoleTemplatePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []RoleTemplatePolicy `json:"items"`
+}
+
+func NewRoleTemplatePolicy(namespace, name string, obj RoleTemplatePolicy) *RoleTemplatePolicy {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("RoleTemplatePolicy").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // SamlProviderList is a list of SamlProvider resources
 type SamlProviderList struct {
 	metav1.TypeMeta `json:",inline"`
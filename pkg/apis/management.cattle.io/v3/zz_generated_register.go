@@ -29,11 +29,13 @@
 
 var (
 	APIServiceResourceName                                = "apiservices"
+	APIUsageReportResourceName                            = "apiusagereports"
 	ActiveDirectoryProviderResourceName                   = "activedirectoryproviders"
 	AuthConfigResourceName                                = "authconfigs"
 	AuthProviderResourceName                              = "authproviders"
 	AuthTokenResourceName                                 = "authtokens"
 	AzureADProviderResourceName                           = "azureadproviders"
+	BootstrapPrincipalResourceName                        = "bootstrapprincipals"
 	CloudCredentialResourceName                           = "cloudcredentials"
 	ClusterResourceName                                   = "clusters"
 	ClusterProxyConfigResourceName                        = "clusterproxyconfigs"
@@ -52,12 +54,14 @@
 	GithubProviderResourceName                            = "githubproviders"
 	GlobalRoleResourceName                                = "globalroles"
 	GlobalRoleBindingResourceName                         = "globalrolebindings"
+	GlobalRoleGroupMappingResourceName                    = "globalrolegroupmappings"
 	GoogleOAuthProviderResourceName                       = "googleoauthproviders"
 	GroupResourceName                                     = "groups"
 	GroupMemberResourceName                               = "groupmembers"
 	KontainerDriverResourceName                           = "kontainerdrivers"
 	LocalProviderResourceName                             = "localproviders"
 	ManagedChartResourceName                              = "managedcharts"
+	ManagedSettingResourceName                            = "managedsettings"
 	NodeResourceName                                      = "nodes"
 	NodeDriverResourceName                                = "nodedrivers"
 	NodePoolResourceName                                  = "nodepools"
@@ -66,6 +70,7 @@
 	OIDCProviderResourceName                              = "oidcproviders"
 	OpenLdapProviderResourceName                          = "openldapproviders"
 	PodSecurityAdmissionConfigurationTemplateResourceName = "podsecurityadmissionconfigurationtemplates"
+	PodSecurityAdmissionProfileResourceName               = "podsecurityadmissionprofiles"
 	PreferenceResourceName                                = "preferences"
 	PrincipalResourceName                                 = "principals"
 	ProjectResourceName                                   = "projects"
@@ -76,6 +81,7 @@
 	RkeK8sServiceOptionResourceName                       = "rkek8sserviceoptions"
 	RkeK8sSystemImageResourceName                         = "rkek8ssystemimages"
 	RoleTemplateResourceName                              = "roletemplates"
+	RoleTemplatePolicyResourceName                        = "roletemplatepolicies"
 	SamlProviderResourceName                              = "samlproviders"
 	SamlTokenResourceName                                 = "samltokens"
 	SettingResourceName                                   = "settings"
@@ -107,6 +113,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&APIService{},
 		&APIServiceList{},
+		&APIUsageReport{},
+		&APIUsageReportList{},
 		&ActiveDirectoryProvider{},
 		&ActiveDirectoryProviderList{},
 		&AuthConfig{},
@@ -117,6 +125,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&AuthTokenList{},
 		&AzureADProvider{},
 		&AzureADProviderList{},
+		&BootstrapPrincipal{},
+		&BootstrapPrincipalList{},
 		&CloudCredential{},
 		&CloudCredentialList{},
 		&Cluster{},
@@ -153,6 +163,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&GlobalRoleList{},
 		&GlobalRoleBinding{},
 		&GlobalRoleBindingList{},
+		&GlobalRoleGroupMapping{},
+		&GlobalRoleGroupMappingList{},
 		&GoogleOAuthProvider{},
 		&GoogleOAuthProviderList{},
 		&Group{},
@@ -165,6 +177,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LocalProviderList{},
 		&ManagedChart{},
 		&ManagedChartList{},
+		&ManagedSetting{},
+		&ManagedSettingList{},
 		&Node{},
 		&NodeList{},
 		&NodeDriver{},
@@ -181,6 +195,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&OpenLdapProviderList{},
 		&PodSecurityAdmissionConfigurationTemplate{},
 		&PodSecurityAdmissionConfigurationTemplateList{},
+		&PodSecurityAdmissionProfile{},
+		&PodSecurityAdmissionProfileList{},
 		&Preference{},
 		&PreferenceList{},
 		&Principal{},
@@ -201,6 +217,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&RkeK8sSystemImageList{},
 		&RoleTemplate{},
 		&RoleTemplateList{},
+		&RoleTemplatePolicy{},
+		&RoleTemplatePolicyList{},
 		&SamlProvider{},
 		&SamlProviderList{},
 		&SamlToken{},
@@ -48,6 +48,13 @@ type ClusterSpec struct {
 	// +optional
 	AgentEnvVars []rkev1.EnvVar `json:"agentEnvVars,omitempty"`
 
+	// AgentImageRegistry is the registry the cluster agent image is pulled
+	// from, overriding the system-default-registry of the cluster for the
+	// agent only, e.g. to upgrade air-gapped clusters from a mirror.
+	// +nullable
+	// +optional
+	AgentImageRegistry string `json:"agentImageRegistry,omitempty"`
+
 	// ClusterAgentDeploymentCustomization is the customization configuration
 	// to apply to the cluster agent deployment.
 	// +nullable
@@ -96,6 +103,22 @@ type ClusterSpec struct {
 	// Rancher server can update the system-upgrade-controller plan.
 	// +optional
 	RedeploySystemAgentGeneration int64 `json:"redeploySystemAgentGeneration,omitempty"`
+
+	// SystemChartVersions pins the versions of the system charts Rancher
+	// installs in the cluster, keyed by chart name, so that upgrades can be
+	// rolled out cluster by cluster. Only the rancher-webhook and
+	// system-upgrade-controller charts can be pinned; the charts that are
+	// not pinned follow the versions set globally in Rancher.
+	// +nullable
+	// +optional
+	SystemChartVersions map[string]string `json:"systemChartVersions,omitempty"`
+
+	// ClusterTemplateName is the name of the cluster template in the
+	// namespace of the cluster that the spec of the cluster must comply
+	// with. Changes to fields locked by the template are not applied.
+	// +nullable
+	// +optional
+	ClusterTemplateName string `json:"clusterTemplateName,omitempty"`
 }
 
 type ClusterAPIConfig struct {
@@ -121,6 +144,12 @@ type RKEConfig struct {
 	// +optional
 	ETCDSnapshotCreate *rkev1.ETCDSnapshotCreate `json:"etcdSnapshotCreate,omitempty"`
 
+	// ETCDSnapshotSchedule is the schedule of the etcd snapshots taken by
+	// Rancher through the etcd snapshot creation operation.
+	// +nullable
+	// +optional
+	ETCDSnapshotSchedule *rkev1.ETCDSnapshotSchedule `json:"etcdSnapshotSchedule,omitempty"`
+
 	// ETCDSnapshotRestore is the configuration for the etcd snapshot restore
 	// operation.
 	// +nullable
@@ -154,6 +183,14 @@ type RKEConfig struct {
 	// +optional
 	MachinePoolDefaults RKEMachinePoolDefaults `json:"machinePoolDefaults,omitempty"`
 
+	// PrivateRegistryNames are the names of the private registries, in the
+	// namespace of the cluster, whose mirrors and configs are added to the
+	// registries of the cluster. Later private registries take precedence
+	// over earlier ones, and the registries of the cluster over all of them.
+	// +nullable
+	// +optional
+	PrivateRegistryNames []string `json:"privateRegistryNames,omitempty"`
+
 	// InfrastructureRef is a reference to the infrastructure cluster object
 	// that is required when provisioning a CAPI cluster.
 	// NOTE: in practice this will always be a rkecluster.rke.cattle.io.
@@ -200,6 +237,15 @@ type RKEMachinePool struct {
 	// +optional
 	DrainBeforeDeleteTimeout *metav1.Duration `json:"drainBeforeDeleteTimeout,omitempty"`
 
+	// DrainOptions is the drain configuration of the machines provisioned
+	// by this pool during upgrades, overriding the control plane or worker
+	// drain options of the upgrade strategy. Its ForceAfter also applies
+	// to the drain before deletion, when DrainBeforeDeleteTimeout is not
+	// set.
+	// +nullable
+	// +optional
+	DrainOptions *rkev1.DrainOptions `json:"drainOptions,omitempty"`
+
 	// NodeConfig is a reference to a MachineConfig object that will be used
 	// to configure the machines provisioned by this pool.
 	// The NodeConfig object will, in turn, be used to create a corresponding
@@ -350,6 +396,16 @@ type RKEMachinePoolRollingUpdate struct {
 	// +nullable
 	// +optional
 	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// DeleteBeforeCreate defines whether machines being replaced should be
+	// deleted before their replacements are created, e.g. when the
+	// infrastructure provider can not accommodate additional machines.
+	// When set, MaxSurge is ignored and treated as 0, and MaxUnavailable
+	// defaults to 1.
+	// The machines are drained before deletion as configured by
+	// DrainBeforeDelete and DrainBeforeDeleteTimeout.
+	// +optional
+	DeleteBeforeCreate bool `json:"deleteBeforeCreate,omitempty"`
 }
 
 // RKEMachinePoolDefaults defines the values to set for all machine pools.
@@ -490,6 +546,19 @@ type ClusterStatus struct {
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration"`
 
+	// ETCDSnapshotSchedule is the observed state of the etcd snapshot
+	// schedule of the cluster.
+	// +nullable
+	// +optional
+	ETCDSnapshotSchedule *rkev1.ETCDSnapshotScheduleStatus `json:"etcdSnapshotSchedule,omitempty"`
+
+	// CertificateExpiration is the earliest expiration date of the
+	// certificates of each component (kube-apiserver, kubelet and etcd)
+	// across the nodes of the cluster.
+	// +nullable
+	// +optional
+	CertificateExpiration map[string]metav1.Time `json:"certificateExpiration,omitempty"`
+
 	// Conditions is a representation of the Cluster's current state.
 	// +optional
 	// +listType=map
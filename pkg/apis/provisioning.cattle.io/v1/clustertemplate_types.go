@@ -0,0 +1,46 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:resource:path=clustertemplates,scope=Namespaced,categories=provisioning
+// +kubebuilder:printcolumn:name="Display Name",type=string,JSONPath=".spec.displayName"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterTemplate is a cluster spec defined by an administrator that
+// clusters referencing it must comply with. Every field set in the template
+// is locked, unless it is listed in the allowed overrides.
+type ClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the cluster template.
+	// +optional
+	Spec ClusterTemplateSpec `json:"spec,omitempty"`
+}
+
+type ClusterTemplateSpec struct {
+	// DisplayName is the human-readable name of the template.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description is the human-readable description of the template.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// ClusterSpec is the spec clusters referencing the template are
+	// created with.
+	// +optional
+	ClusterSpec ClusterSpec `json:"clusterSpec,omitempty"`
+
+	// AllowedOverrides are the paths of the fields of the cluster spec that
+	// clusters may set to a value other than the one of the template, in
+	// dot notation relative to the spec, e.g. "kubernetesVersion" or
+	// "rkeConfig.machinePools". A path allows all the fields below it.
+	// +nullable
+	// +optional
+	AllowedOverrides []string `json:"allowedOverrides,omitempty"`
+}
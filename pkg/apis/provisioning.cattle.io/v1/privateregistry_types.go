@@ -0,0 +1,44 @@
+package v1
+
+import (
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:resource:path=privateregistries,scope=Namespaced,categories=provisioning
+// +kubebuilder:printcolumn:name="Display Name",type=string,JSONPath=".spec.displayName"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PrivateRegistry is a set of registry mirrors and credentials shared by
+// the clusters of its namespace that reference it. The registries are
+// rendered into the registries.yaml of every node of the clusters, and kept
+// up to date on the existing nodes when the private registry, or the
+// secrets it references, change.
+type PrivateRegistry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the private registry.
+	// +optional
+	Spec PrivateRegistrySpec `json:"spec,omitempty"`
+}
+
+type PrivateRegistrySpec struct {
+	// DisplayName is the human-readable name of the private registry.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Mirrors are namespace to mirror mapping for all namespaces.
+	// +nullable
+	// +optional
+	Mirrors map[string]rkev1.Mirror `json:"mirrors,omitempty"`
+
+	// Configs are configs for each registry, keyed by the FQDN or IP of
+	// the registry. The secrets referenced by the configs must reside in
+	// the namespace of the private registry.
+	// +nullable
+	// +optional
+	Configs map[string]rkev1.RegistryConfig `json:"configs,omitempty"`
+}
@@ -40,3 +40,37 @@ func NewCluster(namespace, name string, obj Cluster) *Cluster {
 	obj.Namespace = namespace
 	return &obj
 }
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterTemplateList is a list of ClusterTemplate resources
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ClusterTemplate `json:"items"`
+}
+
+func NewClusterTemplate(namespace, name string, obj ClusterTemplate) *ClusterTemplate {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ClusterTemplate").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PrivateRegistryList is a list of PrivateRegistry resources
+type PrivateRegistryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []PrivateRegistry `json:"items"`
+}
+
+func NewPrivateRegistry(namespace, name string, obj PrivateRegistry) *PrivateRegistry {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("PrivateRegistry").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
@@ -157,6 +157,19 @@ type DrainOptions struct {
 	// +optional
 	Timeout int `json:"timeout"`
 
+	// PodDisruptionBudgetTimeout is the time to wait (in seconds) for
+	// evictions blocked by PodDisruptionBudgets before giving up for one
+	// try. Defaults to Timeout. Ignored if DisableEviction is set.
+	// +optional
+	PodDisruptionBudgetTimeout int `json:"podDisruptionBudgetTimeout,omitempty"`
+
+	// ForceAfter is the time (in seconds) after the drain started after
+	// which it is forced: pods are deleted rather than evicted, bypassing
+	// PodDisruptionBudgets, and pods not managed by a controller are
+	// deleted as well. If 0, the drain is never forced.
+	// +optional
+	ForceAfter int `json:"forceAfter,omitempty"`
+
 	// SkipWaitForDeleteTimeoutSeconds defines how long the draining
 	// operation should wait for a given to be removed after deletion.
 	// If the pod's DeletionTimestamp is older than N seconds, the drain
@@ -71,6 +71,24 @@ type ETCDSnapshotCreate struct {
 	// snapshot.
 	// +optional
 	Generation int `json:"generation,omitempty"`
+
+	// Name is the base name of the snapshot, to which the distribution
+	// appends the node name and a timestamp. Defaults to "on-demand".
+	// +nullable
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Retention is the number of snapshots with the same base name to
+	// retain. Older snapshots are pruned after the snapshot is created. If
+	// 0, no snapshots are pruned.
+	// +optional
+	Retention int `json:"retention,omitempty"`
+
+	// S3 is the S3 target of the snapshot. If nil, the S3 configuration
+	// of the cluster is used, if any.
+	// +nullable
+	// +optional
+	S3 *ETCDSnapshotS3 `json:"s3,omitempty"`
 }
 
 type ETCDSnapshotRestore struct {
@@ -102,11 +120,30 @@ type RotateCertificates struct {
 
 	// Services is a list of services to rotate certificates for.
 	// If the list is empty, all services will be rotated.
+	// Common services are api-server, kubelet and etcd, see the
+	// CertificateRotationService constants for the full list.
 	// +nullable
 	// +optional
 	Services []string `json:"services,omitempty"`
 }
 
+// Services whose certificates can be rotated through RotateCertificates.
+const (
+	CertificateRotationServiceAdmin             = "admin"
+	CertificateRotationServiceAPIServer         = "api-server"
+	CertificateRotationServiceAuthProxy         = "auth-proxy"
+	CertificateRotationServiceCloudController   = "cloud-controller"
+	CertificateRotationServiceControllerManager = "controller-manager"
+	CertificateRotationServiceETCD              = "etcd"
+	CertificateRotationServiceK3sController     = "k3s-controller"
+	CertificateRotationServiceK3sServer         = "k3s-server"
+	CertificateRotationServiceKubeProxy         = "kube-proxy"
+	CertificateRotationServiceKubelet           = "kubelet"
+	CertificateRotationServiceRKE2Controller    = "rke2-controller"
+	CertificateRotationServiceRKE2Server        = "rke2-server"
+	CertificateRotationServiceScheduler         = "scheduler"
+)
+
 type RotateEncryptionKeys struct {
 	// Generation is the current generation for which an encryption key
 	// rotation operation has been requested.
@@ -62,9 +62,57 @@ type ETCDSnapshotS3 struct {
 	Folder string `json:"folder,omitempty"`
 }
 
+// ETCDSnapshotSchedule defines etcd snapshots taken periodically by Rancher, in addition to the ones taken by the
+// distribution according to the ETCD configuration of the cluster.
+type ETCDSnapshotSchedule struct {
+	// Cron is the schedule of the snapshots, in the standard 5 fields
+	// cron format.
+	Cron string `json:"cron"`
+
+	// Retention is the number of snapshots taken by this schedule to
+	// retain. If 0, no snapshots are pruned.
+	// +optional
+	Retention int `json:"retention,omitempty"`
+
+	// S3 is the S3 target of the snapshots. If nil, the S3 configuration
+	// of the cluster is used, if any.
+	// +nullable
+	// +optional
+	S3 *ETCDSnapshotS3 `json:"s3,omitempty"`
+}
+
+// ETCDSnapshotScheduleStatus is the observed state of an etcd snapshot schedule.
+type ETCDSnapshotScheduleStatus struct {
+	// LastScheduleTime is the last time a snapshot was requested by the
+	// schedule.
+	// +nullable
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// NextScheduleTime is the next time a snapshot will be requested by
+	// the schedule.
+	// +nullable
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// ScheduledGeneration is the generation of the etcd snapshot creation
+	// requested by the schedule at LastScheduleTime.
+	// +optional
+	ScheduledGeneration int `json:"scheduledGeneration,omitempty"`
+
+	// Error is the error encountered while evaluating the schedule, if
+	// any.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
 // +genclient
 // +kubebuilder:resource:path=etcdsnapshots,scope=Namespaced
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=".spec.clusterName"
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".snapshotFile.status"
+// +kubebuilder:printcolumn:name="Size",type=integer,JSONPath=".snapshotFile.size"
+// +kubebuilder:printcolumn:name="Created",type=date,JSONPath=".snapshotFile.createdAt"
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ETCDSnapshot is the top-level resource representing a snapshot operation.
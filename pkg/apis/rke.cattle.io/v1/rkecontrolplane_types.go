@@ -95,6 +95,13 @@ type RKEControlPlaneStatus struct {
 	// +optional
 	CertificateRotationGeneration int64 `json:"certificateRotationGeneration,omitempty"`
 
+	// CertificateExpiration is the earliest expiration date of the
+	// certificates of each component (kube-apiserver, kubelet and etcd)
+	// across the nodes of the cluster.
+	// +nullable
+	// +optional
+	CertificateExpiration map[string]metav1.Time `json:"certificateExpiration,omitempty"`
+
 	// RotateEncryptionKeys is the state for which the last encryption key
 	// rotation operation was successful.
 	// +optional
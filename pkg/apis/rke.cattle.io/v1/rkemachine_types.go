@@ -95,8 +95,25 @@ type CustomMachineSpec struct {
 	ProviderID string `json:"providerID,omitempty"`
 }
 
+const (
+	// CustomMachineConditionProvisioned is true once the machine has been
+	// registered and has a provider ID. It is false with the failure reason
+	// and message of the CAPI machine if provisioning failed.
+	CustomMachineConditionProvisioned = "Provisioned"
+
+	// CustomMachineConditionBootstrapped is true once the bootstrap data of
+	// the CAPI machine owning the custom machine is ready.
+	CustomMachineConditionBootstrapped = "Bootstrapped"
+
+	// CustomMachineConditionNodeRefResolved is true once the CAPI machine
+	// owning the custom machine references a node of the downstream cluster.
+	CustomMachineConditionNodeRefResolved = "NodeRefResolved"
+)
+
 type CustomMachineStatus struct {
 	// Conditions is a representation of the current state of the machine.
+	// Next to Ready, the machine controllers maintain the Provisioned,
+	// Bootstrapped and NodeRefResolved conditions.
 	// +optional
 	Conditions []genericcondition.GenericCondition `json:"conditions,omitempty"`
 	// Ready indicates that the machine infrastructure is fully provisioned,
@@ -108,4 +125,12 @@ type CustomMachineStatus struct {
 	// Addresses contains the associated addresses for the machine.
 	// +optional
 	Addresses []capi.MachineAddress `json:"addresses,omitempty"`
+	// OS is the operating system of the machine, as reported when it
+	// registered to the cluster.
+	// +optional
+	OS string `json:"os,omitempty"`
+	// Arch is the CPU architecture of the machine, as reported when it
+	// registered to the cluster.
+	// +optional
+	Arch string `json:"arch,omitempty"`
 }
@@ -315,6 +315,11 @@ func (in *ETCDSnapshot) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCDSnapshotCreate) DeepCopyInto(out *ETCDSnapshotCreate) {
 	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ETCDSnapshotS3)
+		**out = **in
+	}
 	return
 }
 
@@ -418,6 +423,51 @@ func (in *ETCDSnapshotS3) DeepCopy() *ETCDSnapshotS3 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDSnapshotSchedule) DeepCopyInto(out *ETCDSnapshotSchedule) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ETCDSnapshotS3)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDSnapshotSchedule.
+func (in *ETCDSnapshotSchedule) DeepCopy() *ETCDSnapshotSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDSnapshotSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDSnapshotScheduleStatus) DeepCopyInto(out *ETCDSnapshotScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDSnapshotScheduleStatus.
+func (in *ETCDSnapshotScheduleStatus) DeepCopy() *ETCDSnapshotScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDSnapshotScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCDSnapshotSpec) DeepCopyInto(out *ETCDSnapshotSpec) {
 	*out = *in
@@ -973,7 +1023,7 @@ func (in *RKEControlPlaneSpec) DeepCopyInto(out *RKEControlPlaneSpec) {
 	if in.ETCDSnapshotCreate != nil {
 		in, out := &in.ETCDSnapshotCreate, &out.ETCDSnapshotCreate
 		*out = new(ETCDSnapshotCreate)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ETCDSnapshotRestore != nil {
 		in, out := &in.ETCDSnapshotRestore, &out.ETCDSnapshotRestore
@@ -1016,6 +1066,13 @@ func (in *RKEControlPlaneStatus) DeepCopyInto(out *RKEControlPlaneStatus) {
 		*out = make([]genericcondition.GenericCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.CertificateExpiration != nil {
+		in, out := &in.CertificateExpiration, &out.CertificateExpiration
+		*out = make(map[string]metav1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.RotateEncryptionKeys != nil {
 		in, out := &in.RotateEncryptionKeys, &out.RotateEncryptionKeys
 		*out = new(RotateEncryptionKeys)
@@ -1029,7 +1086,7 @@ func (in *RKEControlPlaneStatus) DeepCopyInto(out *RKEControlPlaneStatus) {
 	if in.ETCDSnapshotCreate != nil {
 		in, out := &in.ETCDSnapshotCreate, &out.ETCDSnapshotCreate
 		*out = new(ETCDSnapshotCreate)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -0,0 +1,153 @@
+// Package apiusage aggregates the requests made to the downstream clusters through the cluster proxy by user and
+// resource, and periodically stores them as API usage reports.
+package apiusage
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+var (
+	defaultRecorder = newRecorder(time.Now())
+
+	requestInfoFactory = &request.RequestInfoFactory{
+		APIPrefixes:          sets.NewString("api", "apis"),
+		GrouplessAPIPrefixes: sets.NewString("api"),
+	}
+)
+
+type entryKey struct {
+	user     string
+	verb     string
+	apiGroup string
+	resource string
+}
+
+// recorder counts the requests made to each cluster during the current report period.
+type recorder struct {
+	lock        sync.Mutex
+	periodStart time.Time
+	counts      map[string]map[entryKey]int64
+}
+
+func newRecorder(now time.Time) *recorder {
+	return &recorder{
+		periodStart: now,
+		counts:      map[string]map[entryKey]int64{},
+	}
+}
+
+// Record records a request the user made to the cluster through the cluster proxy. It does nothing if the
+// api-usage-report-interval setting is empty or zero.
+func Record(clusterName, user string, req *http.Request) {
+	if reportInterval() <= 0 {
+		return
+	}
+	defaultRecorder.record(clusterName, user, req)
+}
+
+func (r *recorder) record(clusterName, user string, req *http.Request) {
+	key := newEntryKey(clusterName, user, req)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	clusterCounts, ok := r.counts[clusterName]
+	if !ok {
+		clusterCounts = map[entryKey]int64{}
+		r.counts[clusterName] = clusterCounts
+	}
+	clusterCounts[key]++
+}
+
+// newEntryKey returns the key a request is counted under, resolving its verb and resource from the path of the request
+// relative to the cluster proxy.
+func newEntryKey(clusterName, user string, req *http.Request) entryKey {
+	path := strings.TrimPrefix(req.URL.Path, "/k8s/clusters/"+clusterName)
+	info, err := requestInfoFactory.NewRequestInfo(&http.Request{
+		Method: req.Method,
+		URL:    &url.URL{Path: path, RawQuery: req.URL.RawQuery},
+	})
+	if err != nil || !info.IsResourceRequest {
+		return entryKey{user: user, verb: strings.ToLower(req.Method)}
+	}
+
+	resource := info.Resource
+	if info.Subresource != "" {
+		resource += "/" + info.Subresource
+	}
+	return entryKey{
+		user:     user,
+		verb:     info.Verb,
+		apiGroup: info.APIGroup,
+		resource: resource,
+	}
+}
+
+// drain returns the reports of the period ending now, one per cluster sorted by cluster name, and starts a new period.
+func (r *recorder) drain(now time.Time) []*v3.APIUsageReport {
+	r.lock.Lock()
+	counts, periodStart := r.counts, r.periodStart
+	r.counts, r.periodStart = map[string]map[entryKey]int64{}, now
+	r.lock.Unlock()
+
+	reports := make([]*v3.APIUsageReport, 0, len(counts))
+	for clusterName, clusterCounts := range counts {
+		report := &v3.APIUsageReport{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: clusterName + "-",
+				Labels:       map[string]string{v3.APIUsageReportClusterLabel: clusterName},
+			},
+			Spec: v3.APIUsageReportSpec{
+				ClusterName: clusterName,
+				PeriodStart: metav1.NewTime(periodStart),
+				PeriodEnd:   metav1.NewTime(now),
+			},
+		}
+		for key, count := range clusterCounts {
+			report.Spec.TotalRequests += count
+			report.Spec.Entries = append(report.Spec.Entries, v3.APIUsageReportEntry{
+				User:     key.user,
+				Verb:     key.verb,
+				APIGroup: key.apiGroup,
+				Resource: key.resource,
+				Count:    count,
+			})
+		}
+		sort.Slice(report.Spec.Entries, func(i, j int) bool {
+			a, b := report.Spec.Entries[i], report.Spec.Entries[j]
+			if a.User != b.User {
+				return a.User < b.User
+			}
+			if a.APIGroup != b.APIGroup {
+				return a.APIGroup < b.APIGroup
+			}
+			if a.Resource != b.Resource {
+				return a.Resource < b.Resource
+			}
+			return a.Verb < b.Verb
+		})
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Spec.ClusterName < reports[j].Spec.ClusterName
+	})
+	return reports
+}
+
+func reportInterval() time.Duration {
+	if settings.APIUsageReportInterval.Get() == "" {
+		return 0
+	}
+	return settings.APIUsageReportInterval.GetDuration()
+}
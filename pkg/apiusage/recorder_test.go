@@ -0,0 +1,94 @@
+package apiusage
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewEntryKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		want   entryKey
+	}{
+		{
+			name:   "list core resource",
+			method: "GET",
+			target: "/k8s/clusters/c-abcde/api/v1/namespaces/default/pods",
+			want:   entryKey{user: "u-1", verb: "list", resource: "pods"},
+		},
+		{
+			name:   "watch grouped resource",
+			method: "GET",
+			target: "/k8s/clusters/c-abcde/apis/apps/v1/deployments?watch=true",
+			want:   entryKey{user: "u-1", verb: "watch", apiGroup: "apps", resource: "deployments"},
+		},
+		{
+			name:   "subresource",
+			method: "GET",
+			target: "/k8s/clusters/c-abcde/api/v1/namespaces/default/pods/web/log",
+			want:   entryKey{user: "u-1", verb: "get", resource: "pods/log"},
+		},
+		{
+			name:   "create",
+			method: "POST",
+			target: "/k8s/clusters/c-abcde/api/v1/namespaces/default/configmaps",
+			want:   entryKey{user: "u-1", verb: "create", resource: "configmaps"},
+		},
+		{
+			name:   "discovery",
+			method: "GET",
+			target: "/k8s/clusters/c-abcde/apis",
+			want:   entryKey{user: "u-1", verb: "get"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			assert.Equal(t, tt.want, newEntryKey("c-abcde", "u-1", req))
+		})
+	}
+}
+
+func TestDrain(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	r := newRecorder(start)
+	r.record("c-b", "u-1", httptest.NewRequest("GET", "/k8s/clusters/c-b/api/v1/pods", nil))
+	r.record("c-a", "u-2", httptest.NewRequest("GET", "/k8s/clusters/c-a/api/v1/pods", nil))
+	r.record("c-a", "u-1", httptest.NewRequest("GET", "/k8s/clusters/c-a/api/v1/pods", nil))
+	r.record("c-a", "u-1", httptest.NewRequest("GET", "/k8s/clusters/c-a/api/v1/pods", nil))
+	r.record("c-a", "u-1", httptest.NewRequest("GET", "/k8s/clusters/c-a/api/v1/namespaces", nil))
+
+	reports := r.drain(end)
+	require.Len(t, reports, 2)
+
+	assert.Equal(t, "c-a-", reports[0].GenerateName)
+	assert.Equal(t, map[string]string{v3.APIUsageReportClusterLabel: "c-a"}, reports[0].Labels)
+	assert.Equal(t, v3.APIUsageReportSpec{
+		ClusterName:   "c-a",
+		PeriodStart:   metav1.NewTime(start),
+		PeriodEnd:     metav1.NewTime(end),
+		TotalRequests: 4,
+		Entries: []v3.APIUsageReportEntry{
+			{User: "u-1", Verb: "list", Resource: "namespaces", Count: 1},
+			{User: "u-1", Verb: "list", Resource: "pods", Count: 2},
+			{User: "u-2", Verb: "list", Resource: "pods", Count: 1},
+		},
+	}, reports[0].Spec)
+	assert.Equal(t, "c-b", reports[1].Spec.ClusterName)
+	assert.Equal(t, int64(1), reports[1].Spec.TotalRequests)
+
+	// A new period starts when the reports are drained.
+	assert.Empty(t, r.drain(end.Add(time.Hour)))
+	assert.Equal(t, end.Add(time.Hour), r.periodStart)
+}
@@ -0,0 +1,96 @@
+package apiusage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	mgmtv3controllers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// disabledPollInterval is how often the reporter checks whether the reports were enabled.
+const disabledPollInterval = time.Minute
+
+type reporter struct {
+	recorder *recorder
+	reports  mgmtv3controllers.APIUsageReportClient
+}
+
+// Start periodically stores the requests recorded by this Rancher replica as API usage reports, and deletes the reports
+// older than the api-usage-report-retention setting. It runs on every replica, as each one only records the requests
+// it proxies.
+func Start(ctx context.Context, reports mgmtv3controllers.APIUsageReportClient) {
+	r := &reporter{
+		recorder: defaultRecorder,
+		reports:  reports,
+	}
+	go r.run(ctx)
+}
+
+func (r *reporter) run(ctx context.Context) {
+	for {
+		interval := reportInterval()
+		if interval <= 0 {
+			interval = disabledPollInterval
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		if reportInterval() <= 0 {
+			// Discard the requests recorded before the reports were disabled, and start a new period.
+			r.recorder.drain(time.Now())
+			continue
+		}
+		if err := r.report(time.Now()); err != nil {
+			logrus.Errorf("[apiusage] failed to report API usage: %v", err)
+		}
+	}
+}
+
+// report creates the reports of the period ending now and deletes the expired reports.
+func (r *reporter) report(now time.Time) error {
+	var errs []error
+	for _, report := range r.recorder.drain(now) {
+		if _, err := r.reports.Create(report); err != nil {
+			errs = append(errs, fmt.Errorf("creating API usage report for cluster %s: %w", report.Spec.ClusterName, err))
+		}
+	}
+	if err := r.prune(now); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (r *reporter) prune(now time.Time) error {
+	if settings.APIUsageReportRetention.Get() == "" {
+		return nil
+	}
+	retention := settings.APIUsageReportRetention.GetDuration()
+	if retention <= 0 {
+		return nil
+	}
+
+	reports, err := r.reports.List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing API usage reports: %w", err)
+	}
+	var errs []error
+	for _, report := range reports.Items {
+		if now.Sub(report.Spec.PeriodEnd.Time) <= retention {
+			continue
+		}
+		// The reports are pruned by every replica, so another one may have already deleted it.
+		if err := r.reports.Delete(report.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting API usage report %s: %w", report.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,101 @@
+package apiusage
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newReport(name string, periodEnd time.Time) v3.APIUsageReport {
+	return v3.APIUsageReport{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v3.APIUsageReportSpec{PeriodEnd: metav1.NewTime(periodEnd)},
+	}
+}
+
+func TestReport(t *testing.T) {
+	retention := settings.APIUsageReportRetention.Get()
+	t.Cleanup(func() { _ = settings.APIUsageReportRetention.Set(retention) })
+
+	now := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		retention   string
+		existing    []v3.APIUsageReport
+		createErr   error
+		wantDeleted []string
+		wantErr     bool
+	}{
+		{
+			name:      "creates reports and deletes expired ones",
+			retention: "168h",
+			existing: []v3.APIUsageReport{
+				newReport("c-a-expired", now.Add(-8*24*time.Hour)),
+				newReport("c-a-gone", now.Add(-9*24*time.Hour)),
+				newReport("c-a-recent", now.Add(-24*time.Hour)),
+			},
+			wantDeleted: []string{"c-a-expired", "c-a-gone"},
+		},
+		{
+			name:      "keeps reports without retention",
+			retention: "",
+		},
+		{
+			name:      "returns create errors",
+			retention: "",
+			createErr: errors.New("unavailable"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, settings.APIUsageReportRetention.Set(tt.retention))
+
+			ctrl := gomock.NewController(t)
+			reports := fake.NewMockNonNamespacedClientInterface[*v3.APIUsageReport, *v3.APIUsageReportList](ctrl)
+			var created []string
+			reports.EXPECT().Create(gomock.Any()).DoAndReturn(func(report *v3.APIUsageReport) (*v3.APIUsageReport, error) {
+				created = append(created, report.Spec.ClusterName)
+				return report, tt.createErr
+			}).Times(2)
+			if tt.retention != "" {
+				reports.EXPECT().List(metav1.ListOptions{}).Return(&v3.APIUsageReportList{Items: tt.existing}, nil)
+			}
+			var deleted []string
+			reports.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(name string, _ *metav1.DeleteOptions) error {
+				deleted = append(deleted, name)
+				if name == "c-a-gone" {
+					return apierrors.NewNotFound(schema.GroupResource{}, name)
+				}
+				return nil
+			}).AnyTimes()
+
+			rec := newRecorder(now.Add(-time.Hour))
+			rec.record("c-a", "u-1", httptest.NewRequest("GET", "/k8s/clusters/c-a/api/v1/pods", nil))
+			rec.record("c-b", "u-1", httptest.NewRequest("GET", "/k8s/clusters/c-b/api/v1/pods", nil))
+			r := &reporter{recorder: rec, reports: reports}
+
+			err := r.report(now)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, []string{"c-a", "c-b"}, created)
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
@@ -10,6 +10,7 @@
 	"github.com/rancher/norman/parse"
 	"github.com/rancher/norman/types"
 	"github.com/rancher/rancher/pkg/auth/providerrefresh"
+	"github.com/rancher/rancher/pkg/auth/providers/local"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	exttokenstore "github.com/rancher/rancher/pkg/ext/stores/tokens"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
@@ -29,6 +30,12 @@ func (h *Handler) UserFormatter(apiContext *types.APIContext, resource *types.Ra
 	if canRefresh := h.userCanRefresh(apiContext); canRefresh {
 		resource.AddAction(apiContext, "refreshauthprovideraccess")
 	}
+
+	if annotations, ok := resource.Values["annotations"].(map[string]interface{}); ok {
+		if _, locked := annotations[local.LockedUntilAnnotation]; locked && h.userCanUpdate(apiContext) {
+			resource.AddAction(apiContext, "unlock")
+		}
+	}
 }
 
 func (h *Handler) CollectionFormatter(apiContext *types.APIContext, collection *types.GenericCollection) {
@@ -62,6 +69,10 @@ func (h *Handler) Actions(actionName string, action *types.Action, apiContext *t
 		if err := h.refreshAttributes(apiContext); err != nil {
 			return err
 		}
+	case "unlock":
+		if err := h.unlock(apiContext); err != nil {
+			return err
+		}
 	default:
 		return errors.Errorf("bad action %v", actionName)
 	}
@@ -196,6 +207,30 @@ func (h *Handler) userCanRefresh(request *types.APIContext) bool {
 	return request.AccessControl.CanDo(v3.UserGroupVersionKind.Group, v3.UserResource.Name, "create", request, nil, request.Schema) == nil
 }
 
+func (h *Handler) userCanUpdate(request *types.APIContext) bool {
+	return request.AccessControl.CanDo(v3.UserGroupVersionKind.Group, v3.UserResource.Name, "update", request, nil, request.Schema) == nil
+}
+
+// unlock ends the lockout of a local user after too many failed logins.
+func (h *Handler) unlock(request *types.APIContext) error {
+	if !h.userCanUpdate(request) {
+		return httperror.NewAPIError(httperror.PermissionDenied, "not allowed to unlock users")
+	}
+
+	user, err := h.UserClient.Get(request.ID, v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if _, ok := user.Annotations[local.LockedUntilAnnotation]; !ok {
+		return nil
+	}
+
+	user = user.DeepCopy()
+	delete(user.Annotations, local.LockedUntilAnnotation)
+	_, err = h.UserClient.Update(user)
+	return err
+}
+
 // validatePassword will ensure a password is at least the minimum required length in runes,
 // that the username and password do not match, and that the new password is not the same as the current password.
 func validatePassword(user string, currentPass string, pass string, minPassLen int) error {
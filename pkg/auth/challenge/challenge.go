@@ -0,0 +1,215 @@
+// Package challenge lets integrators require an additional challenge from logins showing risk signals, e.g. a CAPTCHA
+// or the approval of the login on another device of the user.
+package challenge
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+// Signal is a risk signal shown by a login.
+type Signal string
+
+const (
+	// SignalFailedLogins is shown by logins following failed logins with the same username or from the same client
+	// address.
+	SignalFailedLogins Signal = "FailedLogins"
+	// SignalNewClientAddress is shown by logins of a user from an address not seen for their recent logins.
+	SignalNewClientAddress Signal = "NewClientAddress"
+)
+
+const (
+	// failedLoginsThreshold is the number of recent failed logins after which logins show SignalFailedLogins.
+	failedLoginsThreshold = 3
+	// failedLoginsTTL is how long failed logins are remembered after the last one.
+	failedLoginsTTL = time.Hour
+	// knownAddressesPerUser is the number of recent client addresses remembered per user.
+	knownAddressesPerUser = 5
+	// knownAddressesTTL is how long the client addresses of a user are remembered after their last login.
+	knownAddressesTTL = 30 * 24 * time.Hour
+	// cacheSize bounds the number of usernames, client addresses and users tracked.
+	cacheSize = 10000
+)
+
+// Failed is the error code of logins denied by the challenge.
+var Failed = httperror.ErrorCode{Code: "ChallengeFailed", Status: http.StatusUnauthorized}
+
+// Login is a login showing risk signals. The credentials of the login are already verified.
+type Login struct {
+	// Provider is the name of the auth provider of the login.
+	Provider string `json:"provider"`
+	// UserPrincipal is the ID of the principal of the user logging in. It is empty for the logins of SAML providers,
+	// challenged before the user is redirected to the identity provider.
+	UserPrincipal string `json:"userPrincipal,omitempty"`
+	// ClientAddress is the address of the client of the login, if known.
+	ClientAddress string `json:"clientAddress,omitempty"`
+	// Signals are the risk signals shown by the login.
+	Signals []Signal `json:"signals"`
+	// Response is the response to the challenge sent with the login by the client, e.g. a CAPTCHA token, if any.
+	Response string `json:"response,omitempty"`
+}
+
+// Challenger decides whether logins showing risk signals may proceed.
+type Challenger interface {
+	// Challenge returns nil if the login may proceed, and an error explaining why it may not otherwise. It may block
+	// until the challenge is answered, within the deadline of the context.
+	Challenge(ctx context.Context, login Login) error
+}
+
+var (
+	mu         sync.RWMutex
+	challenger Challenger
+)
+
+// Register sets the challenger of the logins showing risk signals, in place of the webhook configured by the
+// login-challenge-webhook-url setting.
+func Register(c Challenger) {
+	mu.Lock()
+	defer mu.Unlock()
+	challenger = c
+}
+
+func registered() Challenger {
+	mu.RLock()
+	defer mu.RUnlock()
+	if challenger != nil {
+		return challenger
+	}
+	if url := settings.LoginChallengeWebhookURL.Get(); url != "" {
+		return newWebhook(url)
+	}
+	return nil
+}
+
+// Verify challenges the login if it shows risk signals. Logins are not challenged if no challenger is configured.
+func Verify(ctx context.Context, login Login) error {
+	if len(login.Signals) == 0 {
+		return nil
+	}
+	c := registered()
+	if c == nil {
+		return nil
+	}
+	if err := c.Challenge(ctx, login); err != nil {
+		logrus.Infof("Login of %s showing risk signals %v denied by the challenge: %v", login.UserPrincipal, login.Signals, err)
+		return httperror.NewAPIError(Failed, "login challenge failed")
+	}
+	return nil
+}
+
+// Detector tracks logins to detect the risk signals they show. It is local to the Rancher replica, and shared by the
+// auth providers, e.g. the local provider slows down and locks out logins after the failed logins it records.
+type Detector struct {
+	mu        sync.Mutex
+	failures  *cache.LRUExpireCache
+	addresses *cache.LRUExpireCache
+}
+
+// NewDetector returns a detector which hasn't seen any login yet.
+func NewDetector() *Detector {
+	return &Detector{
+		failures:  cache.NewLRUExpireCache(cacheSize),
+		addresses: cache.NewLRUExpireCache(cacheSize),
+	}
+}
+
+// Failed records a failed login with the username, if known, from the client address, if known, and returns the
+// number of consecutive failed logins with the username and from the client address.
+func (d *Detector) Failed(username, clientAddr string) (userFailures, addrFailures int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if username != "" {
+		userFailures = d.add(usernameKey(username))
+	}
+	if clientAddr != "" {
+		addrFailures = d.add(clientAddrKey(clientAddr))
+	}
+	return userFailures, addrFailures
+}
+
+// FailedLogins returns the number of consecutive failed logins with the username and from the client address.
+func (d *Detector) FailedLogins(username, clientAddr string) (userFailures, addrFailures int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if username != "" {
+		userFailures = d.count(usernameKey(username))
+	}
+	if clientAddr != "" {
+		addrFailures = d.count(clientAddrKey(clientAddr))
+	}
+	return userFailures, addrFailures
+}
+
+// Signals returns the risk signals shown by a login of the user principal, with credentials verified.
+func (d *Detector) Signals(username, userPrincipal, clientAddr string) []Signal {
+	var signals []Signal
+	if userFailures, addrFailures := d.FailedLogins(username, clientAddr); max(userFailures, addrFailures) >= failedLoginsThreshold {
+		signals = append(signals, SignalFailedLogins)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// Without any known address, e.g. after a restart, every address would be new.
+	if value, ok := d.addresses.Get(userPrincipal); ok && clientAddr != "" && !slices.Contains(value.([]string), clientAddr) {
+		signals = append(signals, SignalNewClientAddress)
+	}
+	return signals
+}
+
+// Succeeded records a successful login of the user principal. The failed logins from the client address are kept, a
+// client cannot clear them by logging in with another account.
+func (d *Detector) Succeeded(username, userPrincipal, clientAddr string) {
+	d.ForgetFailures(username)
+	if clientAddr == "" {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var known []string
+	if value, ok := d.addresses.Get(userPrincipal); ok {
+		known = value.([]string)
+	}
+	known = slices.DeleteFunc(slices.Clone(known), func(addr string) bool { return addr == clientAddr })
+	known = append(known, clientAddr)
+	if len(known) > knownAddressesPerUser {
+		known = known[len(known)-knownAddressesPerUser:]
+	}
+	d.addresses.Add(userPrincipal, known, knownAddressesTTL)
+}
+
+// ForgetFailures forgets the failed logins with the username, e.g. once the user is locked out.
+func (d *Detector) ForgetFailures(username string) {
+	if username != "" {
+		d.failures.Remove(usernameKey(username))
+	}
+}
+
+func (d *Detector) add(key string) int {
+	count := d.count(key) + 1
+	d.failures.Add(key, count, failedLoginsTTL)
+	return count
+}
+
+func (d *Detector) count(key string) int {
+	if value, ok := d.failures.Get(key); ok {
+		return value.(int)
+	}
+	return 0
+}
+
+func usernameKey(username string) string {
+	return "user:" + username
+}
+
+func clientAddrKey(clientAddr string) string {
+	return "addr:" + clientAddr
+}
@@ -0,0 +1,125 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChallenger struct {
+	logins []Login
+	err    error
+}
+
+func (f *fakeChallenger) Challenge(_ context.Context, login Login) error {
+	f.logins = append(f.logins, login)
+	return f.err
+}
+
+func TestDetector(t *testing.T) {
+	d := NewDetector()
+
+	// First login of the user, no address known yet.
+	assert.Empty(t, d.Signals("alice", "local://u-1", "10.0.0.1"))
+	d.Succeeded("alice", "local://u-1", "10.0.0.1")
+	assert.Empty(t, d.Signals("alice", "local://u-1", "10.0.0.1"))
+	assert.Equal(t, []Signal{SignalNewClientAddress}, d.Signals("alice", "local://u-1", "10.0.0.2"))
+
+	for range failedLoginsThreshold {
+		d.Failed("bob", "10.0.0.3")
+	}
+	assert.Equal(t, []Signal{SignalFailedLogins}, d.Signals("bob", "local://u-2", "10.0.0.4"))
+	// The failed logins from the address are kept after a successful login.
+	d.Succeeded("bob", "local://u-2", "10.0.0.4")
+	assert.Empty(t, d.Signals("bob", "local://u-2", "10.0.0.4"))
+	assert.Equal(t, []Signal{SignalFailedLogins}, d.Signals("", "github_user://3", "10.0.0.3"))
+}
+
+func TestDetectorFailedLogins(t *testing.T) {
+	d := NewDetector()
+
+	userFailures, addrFailures := d.Failed("alice", "10.0.0.1")
+	assert.Equal(t, 1, userFailures)
+	assert.Equal(t, 1, addrFailures)
+	userFailures, addrFailures = d.Failed("alice", "")
+	assert.Equal(t, 2, userFailures)
+	assert.Equal(t, 0, addrFailures)
+	_, addrFailures = d.Failed("", "10.0.0.1")
+	assert.Equal(t, 2, addrFailures)
+
+	userFailures, addrFailures = d.FailedLogins("alice", "10.0.0.1")
+	assert.Equal(t, 2, userFailures)
+	assert.Equal(t, 2, addrFailures)
+
+	d.ForgetFailures("alice")
+	userFailures, addrFailures = d.FailedLogins("alice", "10.0.0.1")
+	assert.Equal(t, 0, userFailures)
+	assert.Equal(t, 2, addrFailures)
+}
+
+func TestDetectorKnownAddresses(t *testing.T) {
+	d := NewDetector()
+	for _, addr := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"} {
+		d.Succeeded("", "local://u-1", addr)
+	}
+	assert.Equal(t, []Signal{SignalNewClientAddress}, d.Signals("", "local://u-1", "10.0.0.1"))
+	assert.Empty(t, d.Signals("", "local://u-1", "10.0.0.2"))
+}
+
+func TestVerify(t *testing.T) {
+	t.Cleanup(func() { Register(nil) })
+
+	login := Login{Provider: "local", UserPrincipal: "local://u-1", Signals: []Signal{SignalFailedLogins}}
+
+	// No challenger configured.
+	require.NoError(t, Verify(context.Background(), login))
+
+	challenger := &fakeChallenger{}
+	Register(challenger)
+	require.NoError(t, Verify(context.Background(), Login{Provider: "local", UserPrincipal: "local://u-1"}))
+	assert.Empty(t, challenger.logins, "logins without risk signals are not challenged")
+
+	require.NoError(t, Verify(context.Background(), login))
+	assert.Equal(t, []Login{login}, challenger.logins)
+
+	challenger.err = errors.New("wrong CAPTCHA")
+	err := Verify(context.Background(), login)
+	require.Error(t, err)
+	assert.True(t, httperror.IsAPIError(err))
+}
+
+func TestWebhook(t *testing.T) {
+	var received Login
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		json.NewEncoder(w).Encode(webhookDecision{
+			Allowed: received.Response == "captcha-token",
+			Reason:  "invalid CAPTCHA",
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	require.NoError(t, settings.LoginChallengeWebhookURL.Set(server.URL))
+	t.Cleanup(func() { settings.LoginChallengeWebhookURL.Set("") })
+
+	login := Login{
+		Provider:      "local",
+		UserPrincipal: "local://u-1",
+		ClientAddress: "10.0.0.1",
+		Signals:       []Signal{SignalNewClientAddress},
+		Response:      "captcha-token",
+	}
+	require.NoError(t, Verify(context.Background(), login))
+	assert.Equal(t, login, received)
+
+	login.Response = "wrong"
+	assert.Error(t, Verify(context.Background(), login))
+}
@@ -0,0 +1,69 @@
+package challenge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// webhookTimeout bounds the requests to the webhook. It leaves time for the user to approve the login on another
+// device.
+const webhookTimeout = time.Minute
+
+var webhookClient = &http.Client{Timeout: webhookTimeout}
+
+// webhookDecision is the response of the webhook.
+type webhookDecision struct {
+	// Allowed is whether the login may proceed.
+	Allowed bool `json:"allowed"`
+	// Reason explains why the login may not proceed.
+	Reason string `json:"reason,omitempty"`
+}
+
+// webhook is the challenger posting the logins showing risk signals to the URL configured by the
+// login-challenge-webhook-url setting, and getting back a webhookDecision.
+type webhook struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhook(url string) *webhook {
+	return &webhook{url: url, client: webhookClient}
+}
+
+// Challenge implements [Challenger].
+func (w *webhook) Challenge(ctx context.Context, login Login) error {
+	body, err := json.Marshal(login)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	var decision webhookDecision
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&decision); err != nil {
+		return fmt.Errorf("invalid webhook response: %w", err)
+	}
+	if !decision.Allowed {
+		return fmt.Errorf("denied by the webhook: %s", decision.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+// Package decisionlog explains the authorization decisions denying requests, to diagnose users lacking access they
+// expect to have. The explanations are logged while the authorization-decision-logging setting is enabled.
+package decisionlog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	pkgrbac "github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/wrangler"
+	wrbacv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac/v1"
+	"github.com/sirupsen/logrus"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	rbacv1helpers "k8s.io/kubernetes/pkg/apis/rbac/v1"
+	"k8s.io/kubernetes/plugin/pkg/auth/authorizer/rbac"
+)
+
+// Binding is a binding of the user, or of one of their groups, considered to explain a decision.
+type Binding struct {
+	// Kind is the kind of the binding, e.g. GlobalRoleBinding.
+	Kind string
+	// Name is the name of the binding, prefixed by its namespace if it has one.
+	Name string
+	// Role is the global role or role template the binding grants.
+	Role string
+	// Subject is the user or group the binding grants the role to.
+	Subject string
+	// Allows is true if a rule of the role allows the request. The request is then denied because the RBAC of the
+	// binding isn't reconciled yet, or failed to be.
+	Allows bool
+	// Rules are the rules of the role for the requested resource, none of which allow the request unless Allows is true.
+	Rules []rbacv1.PolicyRule
+	// Err is why the rules of the role couldn't be retrieved.
+	Err error
+}
+
+// Explainer explains why requests are denied from the bindings of the users, their roles and the rules of the roles.
+//
+// Global role bindings are always considered. Cluster role template bindings are considered for requests in the
+// namespace of their cluster or for the cluster itself, and project role template bindings for requests in the namespace
+// of their project.
+type Explainer struct {
+	grbCache  mgmtcontrollers.GlobalRoleBindingCache
+	grCache   mgmtcontrollers.GlobalRoleCache
+	crtbCache mgmtcontrollers.ClusterRoleTemplateBindingCache
+	prtbCache mgmtcontrollers.ProjectRoleTemplateBindingCache
+	rtCache   mgmtcontrollers.RoleTemplateCache
+	crCache   wrbacv1.ClusterRoleCache
+}
+
+// NewExplainer returns an explainer using the caches of the wrangler context.
+func NewExplainer(wranglerContext *wrangler.Context) *Explainer {
+	return &Explainer{
+		grbCache:  wranglerContext.Mgmt.GlobalRoleBinding().Cache(),
+		grCache:   wranglerContext.Mgmt.GlobalRole().Cache(),
+		crtbCache: wranglerContext.Mgmt.ClusterRoleTemplateBinding().Cache(),
+		prtbCache: wranglerContext.Mgmt.ProjectRoleTemplateBinding().Cache(),
+		rtCache:   wranglerContext.Mgmt.RoleTemplate().Cache(),
+		crCache:   wranglerContext.RBAC.ClusterRole().Cache(),
+	}
+}
+
+// NewAuthorizer returns an authorizer delegating the decisions to the given authorizer, and logging the explanation of
+// the requests it doesn't allow while the authorization-decision-logging setting is enabled. The layer names where the
+// decision is made in the logs, e.g. "proxy". The delegate is returned as is if the explainer is nil.
+func NewAuthorizer(delegate authorizer.Authorizer, explainer *Explainer, layer string) authorizer.Authorizer {
+	if explainer == nil {
+		return delegate
+	}
+	return authorizer.AuthorizerFunc(func(ctx context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+		decision, reason, err := delegate.Authorize(ctx, a)
+		if decision != authorizer.DecisionAllow && settings.AuthorizationDecisionLogging.Get() == "true" {
+			logrus.Info(Message(layer, a, reason, err, explainer.Explain(a)))
+		}
+		return decision, reason, err
+	})
+}
+
+// Explain returns the bindings considered for the request of the attributes.
+func (e *Explainer) Explain(a authorizer.Attributes) []Binding {
+	u := a.GetUser()
+	if u == nil {
+		return nil
+	}
+
+	var bindings []Binding
+	grbs, err := e.grbCache.List(labels.Everything())
+	if err != nil {
+		return []Binding{{Kind: "GlobalRoleBinding", Err: err}}
+	}
+	for _, grb := range grbs {
+		subject, ok := subjectOf(u, grb.UserName, grb.GroupPrincipalName)
+		if !ok {
+			continue
+		}
+		binding := Binding{Kind: "GlobalRoleBinding", Name: grb.Name, Role: grb.GlobalRoleName, Subject: subject}
+		gr, err := e.grCache.Get(grb.GlobalRoleName)
+		if err != nil {
+			binding.Err = err
+		} else {
+			rules := slices.Clone(gr.Rules)
+			for _, namespacedRules := range gr.NamespacedRules {
+				rules = append(rules, namespacedRules...)
+			}
+			binding.Allows, binding.Rules = matchRules(a, rules)
+		}
+		bindings = append(bindings, binding)
+	}
+
+	if clusterName := clusterOf(a); clusterName != "" {
+		crtbs, err := e.crtbCache.List(clusterName, labels.Everything())
+		if err != nil {
+			return append(bindings, Binding{Kind: "ClusterRoleTemplateBinding", Err: err})
+		}
+		for _, crtb := range crtbs {
+			if subject, ok := subjectOf(u, crtb.UserName, crtb.GroupPrincipalName); ok {
+				bindings = append(bindings, e.roleTemplateBinding(a, "ClusterRoleTemplateBinding", crtb.Namespace+"/"+crtb.Name, crtb.RoleTemplateName, subject))
+			}
+		}
+	}
+
+	if namespace := a.GetNamespace(); namespace != "" {
+		prtbs, err := e.prtbCache.List(namespace, labels.Everything())
+		if err != nil {
+			return append(bindings, Binding{Kind: "ProjectRoleTemplateBinding", Err: err})
+		}
+		for _, prtb := range prtbs {
+			if subject, ok := subjectOf(u, prtb.UserName, prtb.GroupPrincipalName); ok {
+				bindings = append(bindings, e.roleTemplateBinding(a, "ProjectRoleTemplateBinding", prtb.Namespace+"/"+prtb.Name, prtb.RoleTemplateName, subject))
+			}
+		}
+	}
+	return bindings
+}
+
+func (e *Explainer) roleTemplateBinding(a authorizer.Attributes, kind, name, roleTemplateName, subject string) Binding {
+	binding := Binding{Kind: kind, Name: name, Role: roleTemplateName, Subject: subject}
+	rt, err := e.rtCache.Get(roleTemplateName)
+	if err != nil {
+		binding.Err = fmt.Errorf("getting role template %s: %w", roleTemplateName, err)
+		return binding
+	}
+	rules, err := pkgrbac.RulesFromTemplate(e.crCache, e.rtCache, rt)
+	if err != nil {
+		binding.Err = fmt.Errorf("getting the rules of role template %s: %w", roleTemplateName, err)
+		return binding
+	}
+	binding.Allows, binding.Rules = matchRules(a, rules)
+	return binding
+}
+
+// Message returns the log message explaining the decision of the layer for the request of the attributes.
+func Message(layer string, a authorizer.Attributes, reason string, err error, bindings []Binding) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[decisionlog] %s denied %s", layer, describeRequest(a))
+	if reason != "" {
+		fmt.Fprintf(&b, ", reason: %s", reason)
+	}
+	if err != nil {
+		fmt.Fprintf(&b, ", error: %v", err)
+	}
+	if len(bindings) == 0 {
+		b.WriteString(": no bindings of the user or their groups were found")
+		return b.String()
+	}
+	b.WriteString(":")
+	for _, binding := range bindings {
+		fmt.Fprintf(&b, "\n\t%s %s", binding.Kind, binding.Name)
+		if binding.Role != "" {
+			fmt.Fprintf(&b, " grants %s to %s", binding.Role, binding.Subject)
+		}
+		switch {
+		case binding.Err != nil:
+			fmt.Fprintf(&b, ": %v", binding.Err)
+		case binding.Allows:
+			b.WriteString(": allows the request, its RBAC may not be reconciled")
+		case len(binding.Rules) == 0:
+			b.WriteString(": no rule for the resource")
+		default:
+			descriptions := make([]string, 0, len(binding.Rules))
+			for _, rule := range binding.Rules {
+				descriptions = append(descriptions, describeRule(rule))
+			}
+			fmt.Fprintf(&b, ": rules for the resource don't allow the request: %s", strings.Join(descriptions, ", "))
+		}
+	}
+	return b.String()
+}
+
+// matchRules returns true if a rule allows the request, and the rules for the requested resource or path.
+func matchRules(a authorizer.Attributes, rules []rbacv1.PolicyRule) (bool, []rbacv1.PolicyRule) {
+	var matching []rbacv1.PolicyRule
+	allows := false
+	for i := range rules {
+		rule := &rules[i]
+		if rbac.RuleAllows(a, rule) {
+			allows = true
+		}
+		if forResource(a, rule) {
+			matching = append(matching, *rule)
+		}
+	}
+	return allows, matching
+}
+
+// forResource returns true if the rule is for the requested resource or path, regardless of the verbs and names.
+func forResource(a authorizer.Attributes, rule *rbacv1.PolicyRule) bool {
+	if !a.IsResourceRequest() {
+		return rbacv1helpers.NonResourceURLMatches(rule, a.GetPath())
+	}
+	combinedResource := a.GetResource()
+	if a.GetSubresource() != "" {
+		combinedResource += "/" + a.GetSubresource()
+	}
+	return rbacv1helpers.APIGroupMatches(rule, a.GetAPIGroup()) &&
+		rbacv1helpers.ResourceMatches(rule, combinedResource, a.GetSubresource())
+}
+
+// clusterOf returns the name of the cluster whose cluster role template bindings are considered for the request: the
+// cluster requested, or the cluster whose namespace the request is in.
+func clusterOf(a authorizer.Attributes) string {
+	if a.IsResourceRequest() && a.GetAPIGroup() == management.GroupName && a.GetResource() == "clusters" {
+		return a.GetName()
+	}
+	return a.GetNamespace()
+}
+
+func subjectOf(u user.Info, userName, groupPrincipalName string) (string, bool) {
+	if userName != "" {
+		return "user " + userName, userName == u.GetName()
+	}
+	return "group " + groupPrincipalName, groupPrincipalName != "" && slices.Contains(u.GetGroups(), groupPrincipalName)
+}
+
+func describeRequest(a authorizer.Attributes) string {
+	var userName string
+	var groups []string
+	if u := a.GetUser(); u != nil {
+		userName, groups = u.GetName(), u.GetGroups()
+	}
+	request := fmt.Sprintf("user %s (groups %v) to %s ", userName, groups, a.GetVerb())
+	if !a.IsResourceRequest() {
+		return request + a.GetPath()
+	}
+	request += a.GetResource()
+	if a.GetSubresource() != "" {
+		request += "/" + a.GetSubresource()
+	}
+	if a.GetAPIGroup() != "" {
+		request += "." + a.GetAPIGroup()
+	}
+	if a.GetName() != "" {
+		request += " " + a.GetName()
+	}
+	if a.GetNamespace() != "" {
+		request += " in namespace " + a.GetNamespace()
+	}
+	return request
+}
+
+func describeRule(rule rbacv1.PolicyRule) string {
+	if len(rule.NonResourceURLs) > 0 {
+		return fmt.Sprintf("{verbs=%v nonResourceURLs=%v}", rule.Verbs, rule.NonResourceURLs)
+	}
+	description := fmt.Sprintf("{verbs=%v apiGroups=%v resources=%v", rule.Verbs, rule.APIGroups, rule.Resources)
+	if len(rule.ResourceNames) > 0 {
+		description += fmt.Sprintf(" resourceNames=%v", rule.ResourceNames)
+	}
+	return description + "}"
+}
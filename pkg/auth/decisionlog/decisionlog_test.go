@@ -0,0 +1,168 @@
+package decisionlog
+
+import (
+	"context"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+var (
+	getClusters = rbacv1.PolicyRule{
+		APIGroups: []string{"management.cattle.io"},
+		Resources: []string{"clusters"},
+		Verbs:     []string{"get"},
+	}
+	listClusters = rbacv1.PolicyRule{
+		APIGroups: []string{"management.cattle.io"},
+		Resources: []string{"clusters"},
+		Verbs:     []string{"list"},
+	}
+	getPreferences = rbacv1.PolicyRule{
+		APIGroups: []string{"management.cattle.io"},
+		Resources: []string{"preferences"},
+		Verbs:     []string{"get"},
+	}
+)
+
+func newExplainer(t *testing.T) *Explainer {
+	ctrl := gomock.NewController(t)
+
+	grbCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleBinding](ctrl)
+	grbCache.EXPECT().List(labels.Everything()).Return([]*v3.GlobalRoleBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "grb-1"}, UserName: "u-1", GlobalRoleName: "user-base"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "grb-2"}, UserName: "u-2", GlobalRoleName: "admin"},
+	}, nil).AnyTimes()
+	grCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRole](ctrl)
+	grCache.EXPECT().Get("user-base").Return(&v3.GlobalRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-base"},
+		Rules:      []rbacv1.PolicyRule{getPreferences},
+	}, nil).AnyTimes()
+
+	crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+	crtbCache.EXPECT().List("c-1", labels.Everything()).Return([]*v3.ClusterRoleTemplateBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-1"}, ClusterName: "c-1", GroupPrincipalName: "okta_group://devs", RoleTemplateName: "cluster-viewer"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "crtb-2", Namespace: "c-1"}, ClusterName: "c-1", UserName: "u-1", RoleTemplateName: "removed"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "crtb-3", Namespace: "c-1"}, ClusterName: "c-1", UserName: "u-2", RoleTemplateName: "cluster-owner"},
+	}, nil).AnyTimes()
+	crtbCache.EXPECT().List(gomock.Any(), labels.Everything()).Return(nil, nil).AnyTimes()
+	prtbCache := fake.NewMockCacheInterface[*v3.ProjectRoleTemplateBinding](ctrl)
+	prtbCache.EXPECT().List(gomock.Any(), labels.Everything()).Return(nil, nil).AnyTimes()
+
+	rtCache := fake.NewMockNonNamespacedCacheInterface[*v3.RoleTemplate](ctrl)
+	rtCache.EXPECT().Get("cluster-viewer").Return(&v3.RoleTemplate{
+		ObjectMeta:        metav1.ObjectMeta{Name: "cluster-viewer"},
+		Rules:             []rbacv1.PolicyRule{listClusters},
+		RoleTemplateNames: []string{"cluster-base"},
+	}, nil).AnyTimes()
+	rtCache.EXPECT().Get("cluster-base").Return(&v3.RoleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-base"},
+		External:   true,
+		ExternalRules: []rbacv1.PolicyRule{{
+			APIGroups:     []string{"management.cattle.io"},
+			Resources:     []string{"clusters"},
+			ResourceNames: []string{"c-2"},
+			Verbs:         []string{"get"},
+		}},
+	}, nil).AnyTimes()
+	rtCache.EXPECT().Get("removed").Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "removed")).AnyTimes()
+
+	return &Explainer{
+		grbCache:  grbCache,
+		grCache:   grCache,
+		crtbCache: crtbCache,
+		prtbCache: prtbCache,
+		rtCache:   rtCache,
+		crCache:   fake.NewMockNonNamespacedCacheInterface[*rbacv1.ClusterRole](ctrl),
+	}
+}
+
+func getCluster(name string) authorizer.AttributesRecord {
+	return authorizer.AttributesRecord{
+		User:            &user.DefaultInfo{Name: "u-1", Groups: []string{"okta_group://devs"}},
+		Verb:            "get",
+		APIGroup:        "management.cattle.io",
+		Resource:        "clusters",
+		Name:            name,
+		ResourceRequest: true,
+	}
+}
+
+func TestExplain(t *testing.T) {
+	explainer := newExplainer(t)
+
+	bindings := explainer.Explain(getCluster("c-1"))
+	require.Len(t, bindings, 3)
+
+	assert.Equal(t, Binding{Kind: "GlobalRoleBinding", Name: "grb-1", Role: "user-base", Subject: "user u-1"}, bindings[0])
+
+	assert.Equal(t, "c-1/crtb-1", bindings[1].Name)
+	assert.Equal(t, "group okta_group://devs", bindings[1].Subject)
+	assert.False(t, bindings[1].Allows)
+	assert.Len(t, bindings[1].Rules, 2)
+
+	assert.Equal(t, "c-1/crtb-2", bindings[2].Name)
+	assert.True(t, apierrors.IsNotFound(bindings[2].Err))
+}
+
+func TestExplainAllowed(t *testing.T) {
+	explainer := newExplainer(t)
+	attributes := getCluster("c-1")
+	attributes.Verb = "list"
+	attributes.Name = ""
+
+	// The cluster role template bindings are only considered for requests of a single cluster.
+	bindings := explainer.Explain(attributes)
+	require.Len(t, bindings, 1)
+
+	attributes = getCluster("c-1")
+	attributes.Verb = "list"
+	bindings = explainer.Explain(attributes)
+	require.Len(t, bindings, 3)
+	assert.True(t, bindings[1].Allows)
+}
+
+func TestMessage(t *testing.T) {
+	bindings := []Binding{
+		{Kind: "GlobalRoleBinding", Name: "grb-1", Role: "user-base", Subject: "user u-1"},
+		{Kind: "ClusterRoleTemplateBinding", Name: "c-1/crtb-1", Role: "cluster-viewer", Subject: "group g", Rules: []rbacv1.PolicyRule{listClusters}},
+		{Kind: "ClusterRoleTemplateBinding", Name: "c-1/crtb-2", Role: "cluster-owner", Subject: "user u-1", Allows: true},
+	}
+
+	want := `[decisionlog] proxy denied user u-1 (groups [okta_group://devs]) to get clusters.management.cattle.io c-1, reason: no rules:
+	GlobalRoleBinding grb-1 grants user-base to user u-1: no rule for the resource
+	ClusterRoleTemplateBinding c-1/crtb-1 grants cluster-viewer to group g: rules for the resource don't allow the request: {verbs=[list] apiGroups=[management.cattle.io] resources=[clusters]}
+	ClusterRoleTemplateBinding c-1/crtb-2 grants cluster-owner to user u-1: allows the request, its RBAC may not be reconciled`
+	assert.Equal(t, want, Message("proxy", getCluster("c-1"), "no rules", nil, bindings))
+
+	want = "[decisionlog] proxy denied user u-1 (groups [okta_group://devs]) to get clusters.management.cattle.io c-1: no bindings of the user or their groups were found"
+	assert.Equal(t, want, Message("proxy", getCluster("c-1"), "", nil, nil))
+}
+
+func TestNewAuthorizer(t *testing.T) {
+	delegate := authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+		return authorizer.DecisionDeny, "denied", nil
+	})
+
+	assert.NotNil(t, NewAuthorizer(delegate, nil, "proxy"))
+
+	require.NoError(t, settings.AuthorizationDecisionLogging.Set("true"))
+	t.Cleanup(func() { settings.AuthorizationDecisionLogging.Set("false") })
+	authz := NewAuthorizer(delegate, newExplainer(t), "proxy")
+	decision, reason, err := authz.Authorize(context.Background(), getCluster("c-1"))
+	require.NoError(t, err)
+	assert.Equal(t, authorizer.DecisionDeny, decision)
+	assert.Equal(t, "denied", reason)
+}
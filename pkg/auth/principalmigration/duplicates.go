@@ -0,0 +1,63 @@
+package principalmigration
+
+import (
+	"net/mail"
+	"slices"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+const (
+	// DuplicatesAnnotation is set on a user sharing a principal, or an email address, with other users, to the
+	// comma-separated names of these users.
+	DuplicatesAnnotation = "auth.cattle.io/duplicate-users"
+
+	principalKeyPrefix = "principal:"
+	emailKeyPrefix     = "email:"
+)
+
+// DuplicateKeys returns the keys users sharing with the user are duplicates of it: its principal IDs, except its local
+// one, and its email addresses. Users have no email field; the email addresses are the username and the principal IDs,
+// without their prefix, which are one, e.g. for SAML providers identifying users by email, compared case-insensitively.
+//
+// Users deactivated by linking them to another user aren't duplicates of any user.
+func DuplicateKeys(user *v3.User) []string {
+	if user.Annotations[LinkedToAnnotation] != "" {
+		return nil
+	}
+
+	var keys []string
+	add := func(key string) {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	if email, ok := emailAddress(user.Username); ok {
+		add(emailKeyPrefix + email)
+	}
+	for _, principalID := range user.PrincipalIDs {
+		if strings.HasPrefix(principalID, localPrefix) {
+			continue
+		}
+		add(principalKeyPrefix + principalID)
+		if _, id, ok := strings.Cut(principalID, "://"); ok {
+			if email, ok := emailAddress(id); ok {
+				add(emailKeyPrefix + email)
+			}
+		}
+	}
+	return keys
+}
+
+// emailAddress returns the lowercased value if it is a plain email address.
+func emailAddress(value string) (string, bool) {
+	if !strings.Contains(value, "@") {
+		return "", false
+	}
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Address != value {
+		return "", false
+	}
+	return strings.ToLower(value), true
+}
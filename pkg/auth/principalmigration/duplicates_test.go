@@ -0,0 +1,54 @@
+package principalmigration
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDuplicateKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		user *v3.User
+		want []string
+	}{
+		{
+			name: "local user",
+			user: &v3.User{Username: "admin", PrincipalIDs: []string{"local://u-admin"}},
+		},
+		{
+			name: "local user with an email username",
+			user: &v3.User{Username: "Bob@Example.com", PrincipalIDs: []string{"local://u-bob"}},
+			want: []string{"email:bob@example.com"},
+		},
+		{
+			name: "external principals",
+			user: &v3.User{PrincipalIDs: []string{"local://u-bob", "activedirectory_user://uid=bob", "okta_user://bob@example.com"}},
+			want: []string{"principal:activedirectory_user://uid=bob", "principal:okta_user://bob@example.com", "email:bob@example.com"},
+		},
+		{
+			name: "same email address",
+			user: &v3.User{Username: "bob@example.com", PrincipalIDs: []string{"okta_user://BOB@example.com"}},
+			want: []string{"email:bob@example.com", "principal:okta_user://BOB@example.com"},
+		},
+		{
+			name: "not an email address",
+			user: &v3.User{Username: "Bob <bob@example.com>", PrincipalIDs: []string{"openldap_user://uid=bob@x,dc=example"}},
+			want: []string{"principal:openldap_user://uid=bob@x,dc=example"},
+		},
+		{
+			name: "linked user",
+			user: &v3.User{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{LinkedToAnnotation: "u-local"}},
+				Username:   "bob@example.com",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, DuplicateKeys(test.user))
+		})
+	}
+}
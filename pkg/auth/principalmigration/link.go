@@ -0,0 +1,349 @@
+package principalmigration
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/tokens"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// LinkedToAnnotation is set on a user deactivated by linking it to another user, to the name of that user.
+	LinkedToAnnotation = "auth.cattle.io/linked-to"
+
+	localPrefix = "local://"
+)
+
+// ErrInvalidLink is returned, wrapped, when the accounts of a link request can't be linked.
+var ErrInvalidLink = errors.New("invalid link")
+
+// LinkResult is the outcome of linking accounts.
+type LinkResult struct {
+	// LinkedPrincipalIDs are the principal IDs linked to the user.
+	LinkedPrincipalIDs []string
+	// Bindings is the number of bindings transferred to the user.
+	Bindings int
+	// Tokens is the number of tokens transferred to the user.
+	Tokens int
+	// Failed lists the bindings and tokens which could not be transferred, as kind/[namespace/]name.
+	Failed []string
+}
+
+// Linker links an external principal, or a duplicate account, to an existing user, e.g. to move a local user to an
+// external auth provider or the other way around.
+//
+// The principal IDs of the duplicate account, except its local one, are moved to the user, then its bindings and
+// tokens are transferred to the user and it is deactivated. Login tokens of the duplicate account are revoked rather
+// than transferred. The ext tokens of the duplicate account can't change owner; the derived ones are disabled, and the
+// login ones deleted, as the account is deactivated.
+type Linker struct {
+	*Migrator
+	users           mgmtcontrollers.UserClient
+	userCache       mgmtcontrollers.UserCache
+	grbs            mgmtcontrollers.GlobalRoleBindingClient
+	grbCache        mgmtcontrollers.GlobalRoleBindingCache
+	tokens          mgmtcontrollers.TokenClient
+	tokenCache      mgmtcontrollers.TokenCache
+	preferences     mgmtcontrollers.PreferenceClient
+	preferenceCache mgmtcontrollers.PreferenceCache
+}
+
+// NewLinker returns a new Linker.
+func NewLinker(wranglerContext *wrangler.Context) *Linker {
+	return &Linker{
+		Migrator:        NewMigrator(wranglerContext),
+		users:           wranglerContext.Mgmt.User(),
+		userCache:       wranglerContext.Mgmt.User().Cache(),
+		grbs:            wranglerContext.Mgmt.GlobalRoleBinding(),
+		grbCache:        wranglerContext.Mgmt.GlobalRoleBinding().Cache(),
+		tokens:          wranglerContext.Mgmt.Token(),
+		tokenCache:      wranglerContext.Mgmt.Token().Cache(),
+		preferences:     wranglerContext.Mgmt.Preference(),
+		preferenceCache: wranglerContext.Mgmt.Preference().Cache(),
+	}
+}
+
+// Link links the principal, and the principals of the duplicate user, to the user. The owner of the principal, if
+// any, is the duplicate user if none is given. Nothing is changed when dryRun is true, only the principals to link
+// are reported.
+func (l *Linker) Link(userID, principalID, duplicateUserID string, dryRun bool) (LinkResult, error) {
+	var result LinkResult
+
+	if strings.HasPrefix(principalID, localPrefix) {
+		return result, fmt.Errorf("%w: local principal %s can't be linked to another user, link the user instead", ErrInvalidLink, principalID)
+	}
+	user, err := l.users.Get(userID, metav1.GetOptions{})
+	if err != nil {
+		return result, fmt.Errorf("failed to get user %s: %w", userID, err)
+	}
+
+	if principalID != "" && !slices.Contains(user.PrincipalIDs, principalID) {
+		owner, err := l.principalOwner(principalID)
+		if err != nil {
+			return result, err
+		}
+		switch {
+		case owner == "":
+		case duplicateUserID == "":
+			duplicateUserID = owner
+		case owner != duplicateUserID:
+			return result, fmt.Errorf("%w: principal %s belongs to user %s", ErrInvalidLink, principalID, owner)
+		}
+		result.LinkedPrincipalIDs = append(result.LinkedPrincipalIDs, principalID)
+	}
+	if duplicateUserID == user.Name {
+		return result, fmt.Errorf("%w: a user can't be linked to itself", ErrInvalidLink)
+	}
+
+	var duplicate *v3.User
+	if duplicateUserID != "" {
+		if duplicate, err = l.users.Get(duplicateUserID, metav1.GetOptions{}); err != nil {
+			return result, fmt.Errorf("failed to get user %s: %w", duplicateUserID, err)
+		}
+		for _, id := range duplicate.PrincipalIDs {
+			if !strings.HasPrefix(id, localPrefix) && !slices.Contains(user.PrincipalIDs, id) && !slices.Contains(result.LinkedPrincipalIDs, id) {
+				result.LinkedPrincipalIDs = append(result.LinkedPrincipalIDs, id)
+			}
+		}
+	}
+
+	if dryRun || duplicate == nil {
+		if !dryRun && len(result.LinkedPrincipalIDs) > 0 {
+			err = l.addPrincipals(user, result.LinkedPrincipalIDs)
+		}
+		return result, err
+	}
+
+	// The principals are removed from the duplicate user first, for no two users to share them. The duplicate user is
+	// reactivated if the principals can't be linked to the user, for the link to be retried.
+	original := duplicate
+	duplicate = duplicate.DeepCopy()
+	duplicate.PrincipalIDs = slices.DeleteFunc(duplicate.PrincipalIDs, func(id string) bool {
+		return slices.Contains(result.LinkedPrincipalIDs, id)
+	})
+	duplicate.Enabled = new(bool)
+	if duplicate.Annotations == nil {
+		duplicate.Annotations = map[string]string{}
+	}
+	duplicate.Annotations[LinkedToAnnotation] = user.Name
+	deactivated, err := l.users.Update(duplicate)
+	if err != nil {
+		return result, fmt.Errorf("failed to deactivate user %s: %w", duplicate.Name, err)
+	}
+	if err := l.addPrincipals(user, result.LinkedPrincipalIDs); err != nil {
+		return result, errors.Join(err, l.reactivate(deactivated, original))
+	}
+	logrus.Infof("%s Linked user %s and principals %v to user %s", logPrefix, duplicate.Name, result.LinkedPrincipalIDs, user.Name)
+
+	if err := l.transferBindings(duplicate.Name, user.Name, &result); err != nil {
+		return result, err
+	}
+	if err := l.transferTokens(duplicate.Name, user.Name, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// principalOwner returns the name of the user with the principal, if any.
+func (l *Linker) principalOwner(principalID string) (string, error) {
+	users, err := l.userCache.List(labels.Everything())
+	if err != nil {
+		return "", fmt.Errorf("failed to list users: %w", err)
+	}
+	for _, user := range users {
+		if slices.Contains(user.PrincipalIDs, principalID) {
+			return user.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func (l *Linker) addPrincipals(user *v3.User, principalIDs []string) error {
+	user = user.DeepCopy()
+	user.PrincipalIDs = append(user.PrincipalIDs, principalIDs...)
+	if _, err := l.users.Update(user); err != nil {
+		return fmt.Errorf("failed to link principals to user %s: %w", user.Name, err)
+	}
+	return nil
+}
+
+// reactivate restores the principals and the state of a duplicate user deactivated by a failed link.
+func (l *Linker) reactivate(deactivated, original *v3.User) error {
+	user := deactivated.DeepCopy()
+	user.PrincipalIDs = original.PrincipalIDs
+	user.Enabled = original.Enabled
+	if linkedTo, ok := original.Annotations[LinkedToAnnotation]; ok {
+		user.Annotations[LinkedToAnnotation] = linkedTo
+	} else {
+		delete(user.Annotations, LinkedToAnnotation)
+	}
+	if _, err := l.users.Update(user); err != nil {
+		return fmt.Errorf("failed to reactivate user %s: %w", user.Name, err)
+	}
+	return nil
+}
+
+// transferBindings replaces the bindings of the duplicate user with bindings for the user, unless the user already
+// has an equivalent one.
+func (l *Linker) transferBindings(from, to string, result *LinkResult) error {
+	crtbs, prtbs, err := l.ListBindings("")
+	if err != nil {
+		return err
+	}
+	grbs, err := l.grbCache.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list global role bindings: %w", err)
+	}
+
+	existing := map[string]bool{}
+	for _, crtb := range crtbs {
+		if crtb.UserName == to {
+			existing["crtb/"+crtb.ClusterName+"/"+crtb.RoleTemplateName] = true
+		}
+	}
+	for _, prtb := range prtbs {
+		if prtb.UserName == to {
+			existing["prtb/"+prtb.ProjectName+"/"+prtb.RoleTemplateName] = true
+		}
+	}
+	for _, grb := range grbs {
+		if grb.UserName == to {
+			existing["grb/"+grb.GlobalRoleName] = true
+		}
+	}
+
+	done := func(kind, name string, err error) {
+		if err != nil {
+			logrus.Errorf("%s Failed to transfer %s %s from user %s to %s: %v", logPrefix, kind, name, from, to, err)
+			result.Failed = append(result.Failed, kind+"/"+name)
+			return
+		}
+		result.Bindings++
+	}
+	for _, crtb := range crtbs {
+		if crtb.UserName != from || crtb.DeletionTimestamp != nil {
+			continue
+		}
+		if existing["crtb/"+crtb.ClusterName+"/"+crtb.RoleTemplateName] {
+			done("CRTB", crtb.Namespace+"/"+crtb.Name, l.deleteCRTB(crtb))
+		} else {
+			done("CRTB", crtb.Namespace+"/"+crtb.Name, l.replaceCRTB(crtb, to, linkedPrincipal(crtb.UserPrincipalName)))
+		}
+	}
+	for _, prtb := range prtbs {
+		if prtb.UserName != from || prtb.DeletionTimestamp != nil {
+			continue
+		}
+		if existing["prtb/"+prtb.ProjectName+"/"+prtb.RoleTemplateName] {
+			done("PRTB", prtb.Namespace+"/"+prtb.Name, l.deletePRTB(prtb))
+		} else {
+			done("PRTB", prtb.Namespace+"/"+prtb.Name, l.replacePRTB(prtb, to, linkedPrincipal(prtb.UserPrincipalName)))
+		}
+	}
+	for _, grb := range grbs {
+		if grb.UserName != from || grb.DeletionTimestamp != nil {
+			continue
+		}
+		if existing["grb/"+grb.GlobalRoleName] {
+			done("GRB", grb.Name, l.deleteGRB(grb))
+		} else {
+			done("GRB", grb.Name, l.replaceGRB(grb, to))
+		}
+	}
+	return nil
+}
+
+// linkedPrincipal returns the principal of a transferred binding, which is kept unless it is the local principal of
+// the duplicate user.
+func linkedPrincipal(principalID string) string {
+	if strings.HasPrefix(principalID, localPrefix) {
+		return ""
+	}
+	return principalID
+}
+
+func (l *Linker) deleteCRTB(crtb *v3.ClusterRoleTemplateBinding) error {
+	if err := l.crtbs.Delete(crtb.Namespace, crtb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete binding: %w", err)
+	}
+	return nil
+}
+
+func (l *Linker) deletePRTB(prtb *v3.ProjectRoleTemplateBinding) error {
+	if err := l.prtbs.Delete(prtb.Namespace, prtb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete binding: %w", err)
+	}
+	return nil
+}
+
+func (l *Linker) deleteGRB(grb *v3.GlobalRoleBinding) error {
+	if err := l.grbs.Delete(grb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete binding: %w", err)
+	}
+	return nil
+}
+
+// replaceGRB replaces the binding with one for the given user.
+func (l *Linker) replaceGRB(grb *v3.GlobalRoleBinding, userName string) error {
+	migrated, err := l.grbCache.List(labels.SelectorFromSet(labels.Set{previousNameLabel: grb.Name}))
+	if err != nil {
+		return fmt.Errorf("failed to list migrated bindings: %w", err)
+	}
+	if len(migrated) == 0 {
+		_, err = l.grbs.Create(&v3.GlobalRoleBinding{
+			ObjectMeta:     migratedObjectMeta(grb.ObjectMeta, "grb-", grb.UserPrincipalName),
+			GlobalRoleName: grb.GlobalRoleName,
+			UserName:       userName,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create migrated binding: %w", err)
+		}
+	}
+	return l.deleteGRB(grb)
+}
+
+// transferTokens transfers the derived tokens of the duplicate user to the user, and revokes its login tokens.
+func (l *Linker) transferTokens(from, to string, result *LinkResult) error {
+	userTokens, err := l.tokenCache.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list tokens: %w", err)
+	}
+
+	for _, token := range userTokens {
+		if token.UserID != from {
+			continue
+		}
+		if !token.IsDerived {
+			err = l.tokens.Delete(token.Name, &metav1.DeleteOptions{})
+			if apierrors.IsNotFound(err) {
+				err = nil
+			}
+		} else {
+			token = token.DeepCopy()
+			token.UserID = to
+			if token.Labels == nil {
+				token.Labels = map[string]string{}
+			}
+			token.Labels[tokens.UserIDLabel] = to
+			_, err = l.tokens.Update(token)
+		}
+		if err != nil {
+			logrus.Errorf("%s Failed to transfer token %s from user %s to %s: %v", logPrefix, token.Name, from, to, err)
+			result.Failed = append(result.Failed, "Token/"+token.Name)
+			continue
+		}
+		if token.IsDerived {
+			result.Tokens++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package principalmigration
+
+import (
+	"errors"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/tokens"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type linkerMocks struct {
+	users           *fake.MockNonNamespacedControllerInterface[*v3.User, *v3.UserList]
+	userCache       *fake.MockNonNamespacedCacheInterface[*v3.User]
+	crtbs           *fake.MockControllerInterface[*v3.ClusterRoleTemplateBinding, *v3.ClusterRoleTemplateBindingList]
+	crtbCache       *fake.MockCacheInterface[*v3.ClusterRoleTemplateBinding]
+	prtbCache       *fake.MockCacheInterface[*v3.ProjectRoleTemplateBinding]
+	grbs            *fake.MockNonNamespacedControllerInterface[*v3.GlobalRoleBinding, *v3.GlobalRoleBindingList]
+	grbCache        *fake.MockNonNamespacedCacheInterface[*v3.GlobalRoleBinding]
+	tokens          *fake.MockNonNamespacedControllerInterface[*v3.Token, *v3.TokenList]
+	tokenCache      *fake.MockNonNamespacedCacheInterface[*v3.Token]
+	preferences     *fake.MockControllerInterface[*v3.Preference, *v3.PreferenceList]
+	preferenceCache *fake.MockCacheInterface[*v3.Preference]
+}
+
+func newTestLinker(t *testing.T) (*Linker, *linkerMocks) {
+	ctrl := gomock.NewController(t)
+	m := &linkerMocks{
+		users:           fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl),
+		userCache:       fake.NewMockNonNamespacedCacheInterface[*v3.User](ctrl),
+		crtbs:           fake.NewMockControllerInterface[*v3.ClusterRoleTemplateBinding, *v3.ClusterRoleTemplateBindingList](ctrl),
+		crtbCache:       fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl),
+		prtbCache:       fake.NewMockCacheInterface[*v3.ProjectRoleTemplateBinding](ctrl),
+		grbs:            fake.NewMockNonNamespacedControllerInterface[*v3.GlobalRoleBinding, *v3.GlobalRoleBindingList](ctrl),
+		grbCache:        fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleBinding](ctrl),
+		tokens:          fake.NewMockNonNamespacedControllerInterface[*v3.Token, *v3.TokenList](ctrl),
+		tokenCache:      fake.NewMockNonNamespacedCacheInterface[*v3.Token](ctrl),
+		preferences:     fake.NewMockControllerInterface[*v3.Preference, *v3.PreferenceList](ctrl),
+		preferenceCache: fake.NewMockCacheInterface[*v3.Preference](ctrl),
+	}
+	return &Linker{
+		Migrator:        &Migrator{crtbs: m.crtbs, crtbCache: m.crtbCache, prtbCache: m.prtbCache},
+		users:           m.users,
+		userCache:       m.userCache,
+		grbs:            m.grbs,
+		grbCache:        m.grbCache,
+		tokens:          m.tokens,
+		tokenCache:      m.tokenCache,
+		preferences:     m.preferences,
+		preferenceCache: m.preferenceCache,
+	}, m
+}
+
+func TestLink(t *testing.T) {
+	const principalID = "activedirectory_user://uid=bob"
+	local := func() *v3.User {
+		return &v3.User{ObjectMeta: metav1.ObjectMeta{Name: "u-local"}, PrincipalIDs: []string{"local://u-local"}}
+	}
+	external := func() *v3.User {
+		return &v3.User{ObjectMeta: metav1.ObjectMeta{Name: "u-ext"}, PrincipalIDs: []string{principalID, "local://u-ext"}}
+	}
+
+	t.Run("duplicate account is merged", func(t *testing.T) {
+		linker, m := newTestLinker(t)
+
+		m.users.EXPECT().Get("u-local", gomock.Any()).Return(local(), nil)
+		m.userCache.EXPECT().List(labels.Everything()).Return([]*v3.User{local(), external()}, nil)
+		m.users.EXPECT().Get("u-ext", gomock.Any()).Return(external(), nil)
+		m.users.EXPECT().Update(gomock.Any()).DoAndReturn(func(user *v3.User) (*v3.User, error) {
+			assert.Equal(t, "u-ext", user.Name)
+			assert.Equal(t, []string{"local://u-ext"}, user.PrincipalIDs)
+			assert.False(t, *user.Enabled)
+			assert.Equal(t, "u-local", user.Annotations[LinkedToAnnotation])
+			return user, nil
+		})
+		m.users.EXPECT().Update(gomock.Any()).DoAndReturn(func(user *v3.User) (*v3.User, error) {
+			assert.Equal(t, "u-local", user.Name)
+			assert.Equal(t, []string{"local://u-local", principalID}, user.PrincipalIDs)
+			return user, nil
+		})
+
+		// The CRTB is transferred, the GRB the user already has is only deleted.
+		m.crtbCache.EXPECT().List("", labels.Everything()).Return([]*v3.ClusterRoleTemplateBinding{{
+			ObjectMeta:        metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-1"},
+			ClusterName:       "c-1",
+			RoleTemplateName:  "cluster-member",
+			UserName:          "u-ext",
+			UserPrincipalName: principalID,
+		}}, nil)
+		m.prtbCache.EXPECT().List("", labels.Everything()).Return(nil, nil)
+		m.grbCache.EXPECT().List(labels.Everything()).Return([]*v3.GlobalRoleBinding{
+			{ObjectMeta: metav1.ObjectMeta{Name: "grb-local"}, GlobalRoleName: "user", UserName: "u-local"},
+			{ObjectMeta: metav1.ObjectMeta{Name: "grb-ext"}, GlobalRoleName: "user", UserName: "u-ext"},
+		}, nil)
+		m.crtbCache.EXPECT().List("c-1", gomock.Any()).Return(nil, nil)
+		m.crtbs.EXPECT().Create(gomock.Any()).DoAndReturn(func(crtb *v3.ClusterRoleTemplateBinding) (*v3.ClusterRoleTemplateBinding, error) {
+			assert.Equal(t, "u-local", crtb.UserName)
+			assert.Equal(t, principalID, crtb.UserPrincipalName)
+			return crtb, nil
+		})
+		m.crtbs.EXPECT().Delete("c-1", "crtb-1", gomock.Any()).Return(nil)
+		m.grbs.EXPECT().Delete("grb-ext", gomock.Any()).Return(nil)
+
+		// The derived token is transferred, the login token revoked.
+		m.tokenCache.EXPECT().List(labels.Everything()).Return([]*v3.Token{
+			{ObjectMeta: metav1.ObjectMeta{Name: "token-derived"}, UserID: "u-ext", IsDerived: true},
+			{ObjectMeta: metav1.ObjectMeta{Name: "token-login"}, UserID: "u-ext"},
+			{ObjectMeta: metav1.ObjectMeta{Name: "token-other"}, UserID: "u-local", IsDerived: true},
+		}, nil)
+		m.tokens.EXPECT().Update(gomock.Any()).DoAndReturn(func(token *v3.Token) (*v3.Token, error) {
+			assert.Equal(t, "token-derived", token.Name)
+			assert.Equal(t, "u-local", token.UserID)
+			assert.Equal(t, "u-local", token.Labels[tokens.UserIDLabel])
+			return token, nil
+		})
+		m.tokens.EXPECT().Delete("token-login", gomock.Any()).Return(errors.New("unavailable"))
+
+		result, err := linker.Link("u-local", principalID, "", false)
+		require.NoError(t, err)
+		assert.Equal(t, LinkResult{
+			LinkedPrincipalIDs: []string{principalID},
+			Bindings:           2,
+			Tokens:             1,
+			Failed:             []string{"Token/token-login"},
+		}, result)
+	})
+
+	t.Run("unused principal is linked", func(t *testing.T) {
+		linker, m := newTestLinker(t)
+
+		m.users.EXPECT().Get("u-local", gomock.Any()).Return(local(), nil)
+		m.userCache.EXPECT().List(labels.Everything()).Return([]*v3.User{local()}, nil)
+		m.users.EXPECT().Update(gomock.Any()).DoAndReturn(func(user *v3.User) (*v3.User, error) {
+			assert.Equal(t, []string{"local://u-local", principalID}, user.PrincipalIDs)
+			return user, nil
+		})
+
+		result, err := linker.Link("u-local", principalID, "", false)
+		require.NoError(t, err)
+		assert.Equal(t, LinkResult{LinkedPrincipalIDs: []string{principalID}}, result)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		linker, m := newTestLinker(t)
+
+		m.users.EXPECT().Get("u-ext", gomock.Any()).Return(external(), nil)
+		m.users.EXPECT().Get("u-local", gomock.Any()).Return(local(), nil)
+
+		// The local principal of the duplicate user isn't linked.
+		result, err := linker.Link("u-ext", "", "u-local", true)
+		require.NoError(t, err)
+		assert.Equal(t, LinkResult{}, result)
+	})
+
+	t.Run("duplicate account is reactivated if the principals can't be linked", func(t *testing.T) {
+		linker, m := newTestLinker(t)
+
+		m.users.EXPECT().Get("u-local", gomock.Any()).Return(local(), nil)
+		m.userCache.EXPECT().List(labels.Everything()).Return([]*v3.User{local(), external()}, nil)
+		m.users.EXPECT().Get("u-ext", gomock.Any()).Return(external(), nil)
+		m.users.EXPECT().Update(gomock.Any()).DoAndReturn(func(user *v3.User) (*v3.User, error) {
+			assert.Equal(t, "u-ext", user.Name)
+			assert.False(t, *user.Enabled)
+			return user, nil
+		})
+		m.users.EXPECT().Update(gomock.Any()).DoAndReturn(func(user *v3.User) (*v3.User, error) {
+			assert.Equal(t, "u-local", user.Name)
+			return nil, errors.New("unavailable")
+		})
+		m.users.EXPECT().Update(gomock.Any()).DoAndReturn(func(user *v3.User) (*v3.User, error) {
+			assert.Equal(t, "u-ext", user.Name)
+			assert.Equal(t, []string{principalID, "local://u-ext"}, user.PrincipalIDs)
+			assert.Nil(t, user.Enabled)
+			assert.NotContains(t, user.Annotations, LinkedToAnnotation)
+			return user, nil
+		})
+
+		_, err := linker.Link("u-local", principalID, "", false)
+		assert.ErrorContains(t, err, "failed to link principals to user u-local")
+	})
+
+	t.Run("invalid links", func(t *testing.T) {
+		linker, m := newTestLinker(t)
+
+		_, err := linker.Link("u-ext", "local://u-local", "", false)
+		assert.ErrorIs(t, err, ErrInvalidLink)
+
+		m.users.EXPECT().Get("u-local", gomock.Any()).Return(local(), nil).Times(2)
+		m.userCache.EXPECT().List(labels.Everything()).Return([]*v3.User{local(), external()}, nil)
+		_, err = linker.Link("u-local", principalID, "u-other", false)
+		assert.ErrorIs(t, err, ErrInvalidLink)
+
+		_, err = linker.Link("u-local", "", "u-local", false)
+		assert.ErrorIs(t, err, ErrInvalidLink)
+	})
+}
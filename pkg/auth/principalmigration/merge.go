@@ -0,0 +1,96 @@
+package principalmigration
+
+import (
+	"fmt"
+	"slices"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// MergeResult is the outcome of merging duplicate accounts into a user.
+type MergeResult struct {
+	LinkResult
+	// MergedUserIDs are the duplicate users merged into the user and deleted.
+	MergedUserIDs []string
+	// Preferences is the number of preferences copied to the user.
+	Preferences int
+}
+
+// Merge merges the duplicate users into the user. Each duplicate user is linked to the user, its preferences the user
+// doesn't have are copied to the user, then it is deleted, for the user lifecycle to clean up what is left of it, e.g.
+// its ext tokens and its namespace. A duplicate user is only deleted once everything was transferred, for the merge to
+// be retried otherwise. Nothing is changed when dryRun is true, only the principals to link are reported.
+func (l *Linker) Merge(userID string, duplicateUserIDs []string, dryRun bool) (MergeResult, error) {
+	var result MergeResult
+
+	for i, duplicateUserID := range duplicateUserIDs {
+		if duplicateUserID == "" || slices.Contains(duplicateUserIDs[:i], duplicateUserID) {
+			return result, fmt.Errorf("%w: duplicate user IDs must be set and unique", ErrInvalidLink)
+		}
+	}
+
+	for _, duplicateUserID := range duplicateUserIDs {
+		failed := len(result.Failed)
+		linked, err := l.Link(userID, "", duplicateUserID, dryRun)
+		result.LinkedPrincipalIDs = append(result.LinkedPrincipalIDs, linked.LinkedPrincipalIDs...)
+		result.Bindings += linked.Bindings
+		result.Tokens += linked.Tokens
+		result.Failed = append(result.Failed, linked.Failed...)
+		if err != nil {
+			return result, err
+		}
+		if dryRun {
+			continue
+		}
+
+		if err := l.copyPreferences(duplicateUserID, userID, &result); err != nil {
+			return result, err
+		}
+		if failed = len(result.Failed) - failed; failed > 0 {
+			logrus.Warnf("%s Not deleting user %s merged into user %s as %d resources failed to be transferred", logPrefix, duplicateUserID, userID, failed)
+			continue
+		}
+		if err := l.users.Delete(duplicateUserID, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return result, fmt.Errorf("failed to delete user %s: %w", duplicateUserID, err)
+		}
+		logrus.Infof("%s Merged user %s into user %s", logPrefix, duplicateUserID, userID)
+		result.MergedUserIDs = append(result.MergedUserIDs, duplicateUserID)
+	}
+	return result, nil
+}
+
+// copyPreferences copies the preferences of the duplicate user, kept in its namespace, the user doesn't have.
+func (l *Linker) copyPreferences(from, to string, result *MergeResult) error {
+	preferences, err := l.preferenceCache.List(from, labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list preferences of user %s: %w", from, err)
+	}
+	existing, err := l.preferenceCache.List(to, labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list preferences of user %s: %w", to, err)
+	}
+
+	for _, preference := range preferences {
+		if slices.ContainsFunc(existing, func(p *v3.Preference) bool { return p.Name == preference.Name }) {
+			continue
+		}
+		_, err := l.preferences.Create(&v3.Preference{
+			ObjectMeta: metav1.ObjectMeta{Name: preference.Name, Namespace: to},
+			Value:      preference.Value,
+		})
+		if apierrors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			logrus.Errorf("%s Failed to copy preference %s from user %s to %s: %v", logPrefix, preference.Name, from, to, err)
+			result.Failed = append(result.Failed, "Preference/"+from+"/"+preference.Name)
+			continue
+		}
+		result.Preferences++
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package principalmigration
+
+import (
+	"errors"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestMerge(t *testing.T) {
+	const principalID = "okta_user://bob@example.com"
+	user := func() *v3.User {
+		return &v3.User{ObjectMeta: metav1.ObjectMeta{Name: "u-bob"}, Username: "bob@example.com", PrincipalIDs: []string{"local://u-bob"}}
+	}
+	duplicate := func() *v3.User {
+		return &v3.User{ObjectMeta: metav1.ObjectMeta{Name: "u-dup"}, PrincipalIDs: []string{principalID}}
+	}
+	expectLink := func(m *linkerMocks) {
+		m.users.EXPECT().Get("u-bob", gomock.Any()).Return(user(), nil)
+		m.users.EXPECT().Get("u-dup", gomock.Any()).Return(duplicate(), nil)
+		m.users.EXPECT().Update(gomock.Any()).DoAndReturn(func(user *v3.User) (*v3.User, error) {
+			return user, nil
+		}).Times(2)
+		m.crtbCache.EXPECT().List("", labels.Everything()).Return(nil, nil)
+		m.prtbCache.EXPECT().List("", labels.Everything()).Return(nil, nil)
+		m.grbCache.EXPECT().List(labels.Everything()).Return(nil, nil)
+		m.tokenCache.EXPECT().List(labels.Everything()).Return(nil, nil)
+	}
+
+	t.Run("duplicate user is merged and deleted", func(t *testing.T) {
+		linker, m := newTestLinker(t)
+		expectLink(m)
+
+		m.preferenceCache.EXPECT().List("u-dup", labels.Everything()).Return([]*v3.Preference{
+			{ObjectMeta: metav1.ObjectMeta{Name: "theme", Namespace: "u-dup"}, Value: "ui-dark"},
+			{ObjectMeta: metav1.ObjectMeta{Name: "locale", Namespace: "u-dup"}, Value: "de-de"},
+		}, nil)
+		m.preferenceCache.EXPECT().List("u-bob", labels.Everything()).Return([]*v3.Preference{
+			{ObjectMeta: metav1.ObjectMeta{Name: "locale", Namespace: "u-bob"}, Value: "en-us"},
+		}, nil)
+		m.preferences.EXPECT().Create(gomock.Any()).DoAndReturn(func(preference *v3.Preference) (*v3.Preference, error) {
+			assert.Equal(t, "u-bob", preference.Namespace)
+			assert.Equal(t, "theme", preference.Name)
+			assert.Equal(t, "ui-dark", preference.Value)
+			return preference, nil
+		})
+		m.users.EXPECT().Delete("u-dup", gomock.Any()).Return(nil)
+
+		result, err := linker.Merge("u-bob", []string{"u-dup"}, false)
+		require.NoError(t, err)
+		assert.Equal(t, MergeResult{
+			LinkResult:    LinkResult{LinkedPrincipalIDs: []string{principalID}},
+			MergedUserIDs: []string{"u-dup"},
+			Preferences:   1,
+		}, result)
+	})
+
+	t.Run("duplicate user is kept when transfers fail", func(t *testing.T) {
+		linker, m := newTestLinker(t)
+		expectLink(m)
+
+		m.preferenceCache.EXPECT().List("u-dup", labels.Everything()).Return([]*v3.Preference{
+			{ObjectMeta: metav1.ObjectMeta{Name: "theme", Namespace: "u-dup"}, Value: "ui-dark"},
+		}, nil)
+		m.preferenceCache.EXPECT().List("u-bob", labels.Everything()).Return(nil, nil)
+		m.preferences.EXPECT().Create(gomock.Any()).Return(nil, errors.New("unavailable"))
+
+		result, err := linker.Merge("u-bob", []string{"u-dup"}, false)
+		require.NoError(t, err)
+		assert.Empty(t, result.MergedUserIDs)
+		assert.Equal(t, []string{"Preference/u-dup/theme"}, result.Failed)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		linker, m := newTestLinker(t)
+		m.users.EXPECT().Get("u-bob", gomock.Any()).Return(user(), nil)
+		m.users.EXPECT().Get("u-dup", gomock.Any()).Return(duplicate(), nil)
+
+		result, err := linker.Merge("u-bob", []string{"u-dup"}, true)
+		require.NoError(t, err)
+		assert.Equal(t, MergeResult{LinkResult: LinkResult{LinkedPrincipalIDs: []string{principalID}}}, result)
+	})
+
+	t.Run("invalid duplicate user IDs", func(t *testing.T) {
+		linker, _ := newTestLinker(t)
+		for _, ids := range [][]string{{""}, {"u-dup", "u-dup"}} {
+			_, err := linker.Merge("u-bob", ids, false)
+			assert.ErrorIs(t, err, ErrInvalidLink)
+		}
+	})
+}
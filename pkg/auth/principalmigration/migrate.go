@@ -0,0 +1,241 @@
+// Package principalmigration migrates the user principals of cluster and project role template bindings, e.g. after
+// the principal IDs of users change when moving to a different auth provider.
+package principalmigration
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// MigratedFromAnnotation is set on a migrated binding to the principal ID it was migrated from.
+	MigratedFromAnnotation = "auth.cattle.io/principal-migrated-from"
+	// previousNameLabel is set on a migrated binding to the name of the binding it replaces, for an interrupted
+	// migration to resume without creating the binding twice.
+	previousNameLabel = "auth.cattle.io/principal-migration-previous-name"
+	// lifecycleAnnotationPrefix prefixes the annotations of the handlers which ran for a binding, which must run for the
+	// migrated binding too.
+	lifecycleAnnotationPrefix = "lifecycle.cattle.io/"
+
+	logPrefix = "[principal-migration]"
+)
+
+// Rewrite returns the principal ID a binding for the principal is migrated to, if it is migrated.
+type Rewrite func(principalID string) (string, bool)
+
+// PrefixRewrite migrates the principal IDs from the old prefix to the new one.
+func PrefixRewrite(oldPrefix, newPrefix string) Rewrite {
+	return func(principalID string) (string, bool) {
+		id, ok := strings.CutPrefix(principalID, oldPrefix)
+		if !ok {
+			return "", false
+		}
+		return newPrefix + id, true
+	}
+}
+
+// UserRewrite migrates the principal IDs the user no longer has to the principal ID of the user which only differs in
+// its prefix, i.e. the provider and the principal type, if there is exactly one.
+func UserRewrite(user *v3.User) Rewrite {
+	current := map[string]bool{}
+	byID := map[string][]string{}
+	for _, principalID := range user.PrincipalIDs {
+		current[principalID] = true
+		if _, id, ok := strings.Cut(principalID, "://"); ok {
+			byID[id] = append(byID[id], principalID)
+		}
+	}
+
+	return func(principalID string) (string, bool) {
+		if principalID == "" || current[principalID] {
+			return "", false
+		}
+		_, id, ok := strings.Cut(principalID, "://")
+		if !ok || len(byID[id]) != 1 {
+			return "", false
+		}
+		return byID[id][0], true
+	}
+}
+
+// Result is the outcome of a migration.
+type Result struct {
+	// Matched is the number of bindings to migrate.
+	Matched int
+	// Migrated is the number of migrated bindings.
+	Migrated int
+	// Failed lists the namespaced names of the bindings which could not be migrated.
+	Failed []string
+}
+
+// Migrator migrates bindings from one principal to another.
+//
+// The principal of a binding can't be changed, so a binding is migrated by creating a binding for the new principal
+// then deleting the old one. This re-reconciles the RBAC resources derived from the binding in the management and
+// downstream clusters, without the user losing access in between.
+type Migrator struct {
+	crtbs     mgmtcontrollers.ClusterRoleTemplateBindingClient
+	crtbCache mgmtcontrollers.ClusterRoleTemplateBindingCache
+	prtbs     mgmtcontrollers.ProjectRoleTemplateBindingClient
+	prtbCache mgmtcontrollers.ProjectRoleTemplateBindingCache
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(wranglerContext *wrangler.Context) *Migrator {
+	return &Migrator{
+		crtbs:     wranglerContext.Mgmt.ClusterRoleTemplateBinding(),
+		crtbCache: wranglerContext.Mgmt.ClusterRoleTemplateBinding().Cache(),
+		prtbs:     wranglerContext.Mgmt.ProjectRoleTemplateBinding(),
+		prtbCache: wranglerContext.Mgmt.ProjectRoleTemplateBinding().Cache(),
+	}
+}
+
+// ListBindings returns the bindings of the user, or of all the users if userName is empty.
+func (m *Migrator) ListBindings(userName string) ([]*v3.ClusterRoleTemplateBinding, []*v3.ProjectRoleTemplateBinding, error) {
+	allCRTBs, err := m.crtbCache.List("", labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list cluster role template bindings: %w", err)
+	}
+	allPRTBs, err := m.prtbCache.List("", labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list project role template bindings: %w", err)
+	}
+
+	var crtbs []*v3.ClusterRoleTemplateBinding
+	for _, crtb := range allCRTBs {
+		if userName == "" || crtb.UserName == userName {
+			crtbs = append(crtbs, crtb)
+		}
+	}
+	var prtbs []*v3.ProjectRoleTemplateBinding
+	for _, prtb := range allPRTBs {
+		if userName == "" || prtb.UserName == userName {
+			prtbs = append(prtbs, prtb)
+		}
+	}
+	return crtbs, prtbs, nil
+}
+
+// Migrate migrates the user principal of the given bindings as rewritten. Only the bindings to migrate are counted
+// when dryRun is true. A binding is never migrated back to the principal it was migrated from, e.g. for the migration
+// of a user whose principal IDs haven't changed yet not to undo a migration requested by an admin.
+func (m *Migrator) Migrate(crtbs []*v3.ClusterRoleTemplateBinding, prtbs []*v3.ProjectRoleTemplateBinding, rewrite Rewrite, dryRun bool) Result {
+	var result Result
+	done := func(kind, namespace, name, oldPrincipalID, principalID string, err error) {
+		if err != nil {
+			logrus.Errorf("%s Failed to migrate %s %s/%s from %s to %s: %v", logPrefix, kind, namespace, name, oldPrincipalID, principalID, err)
+			result.Failed = append(result.Failed, namespace+"/"+name)
+			return
+		}
+		logrus.Infof("%s Migrated %s %s/%s from %s to %s", logPrefix, kind, namespace, name, oldPrincipalID, principalID)
+		result.Migrated++
+	}
+
+	for _, crtb := range crtbs {
+		principalID, ok := rewrite(crtb.UserPrincipalName)
+		if !ok || crtb.DeletionTimestamp != nil || crtb.Annotations[MigratedFromAnnotation] == principalID {
+			continue
+		}
+		result.Matched++
+		if !dryRun {
+			done("CRTB", crtb.Namespace, crtb.Name, crtb.UserPrincipalName, principalID, m.replaceCRTB(crtb, crtb.UserName, principalID))
+		}
+	}
+	for _, prtb := range prtbs {
+		principalID, ok := rewrite(prtb.UserPrincipalName)
+		if !ok || prtb.DeletionTimestamp != nil || prtb.Annotations[MigratedFromAnnotation] == principalID {
+			continue
+		}
+		result.Matched++
+		if !dryRun {
+			done("PRTB", prtb.Namespace, prtb.Name, prtb.UserPrincipalName, principalID, m.replacePRTB(prtb, prtb.UserName, principalID))
+		}
+	}
+
+	return result
+}
+
+// replaceCRTB replaces the binding with one for the given user and principal.
+func (m *Migrator) replaceCRTB(crtb *v3.ClusterRoleTemplateBinding, userName, principalID string) error {
+	migrated, err := m.crtbCache.List(crtb.Namespace, labels.SelectorFromSet(labels.Set{previousNameLabel: crtb.Name}))
+	if err != nil {
+		return fmt.Errorf("failed to list migrated bindings: %w", err)
+	}
+	if len(migrated) == 0 {
+		_, err = m.crtbs.Create(&v3.ClusterRoleTemplateBinding{
+			ObjectMeta:        migratedObjectMeta(crtb.ObjectMeta, "crtb-", crtb.UserPrincipalName),
+			ClusterName:       crtb.ClusterName,
+			RoleTemplateName:  crtb.RoleTemplateName,
+			UserName:          userName,
+			UserPrincipalName: principalID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create migrated binding: %w", err)
+		}
+	}
+
+	err = m.crtbs.Delete(crtb.Namespace, crtb.Name, &metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete binding: %w", err)
+	}
+	return nil
+}
+
+// replacePRTB replaces the binding with one for the given user and principal.
+func (m *Migrator) replacePRTB(prtb *v3.ProjectRoleTemplateBinding, userName, principalID string) error {
+	migrated, err := m.prtbCache.List(prtb.Namespace, labels.SelectorFromSet(labels.Set{previousNameLabel: prtb.Name}))
+	if err != nil {
+		return fmt.Errorf("failed to list migrated bindings: %w", err)
+	}
+	if len(migrated) == 0 {
+		_, err = m.prtbs.Create(&v3.ProjectRoleTemplateBinding{
+			ObjectMeta:        migratedObjectMeta(prtb.ObjectMeta, "prtb-", prtb.UserPrincipalName),
+			ProjectName:       prtb.ProjectName,
+			RoleTemplateName:  prtb.RoleTemplateName,
+			UserName:          userName,
+			UserPrincipalName: principalID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create migrated binding: %w", err)
+		}
+	}
+
+	err = m.prtbs.Delete(prtb.Namespace, prtb.Name, &metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete binding: %w", err)
+	}
+	return nil
+}
+
+// migratedObjectMeta returns the metadata of the binding replacing the one with the given metadata and principal ID.
+func migratedObjectMeta(old metav1.ObjectMeta, generateName, oldPrincipalID string) metav1.ObjectMeta {
+	annotations := map[string]string{}
+	for key, value := range old.Annotations {
+		if !strings.HasPrefix(key, lifecycleAnnotationPrefix) {
+			annotations[key] = value
+		}
+	}
+	annotations[MigratedFromAnnotation] = oldPrincipalID
+
+	newLabels := maps.Clone(old.Labels)
+	if newLabels == nil {
+		newLabels = map[string]string{}
+	}
+	newLabels[previousNameLabel] = old.Name
+
+	return metav1.ObjectMeta{
+		Namespace:    old.Namespace,
+		GenerateName: generateName,
+		Labels:       newLabels,
+		Annotations:  annotations,
+	}
+}
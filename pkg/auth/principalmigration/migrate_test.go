@@ -0,0 +1,144 @@
+package principalmigration
+
+import (
+	"errors"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestPrefixRewrite(t *testing.T) {
+	rewrite := PrefixRewrite("openldap_user://", "activedirectory_user://")
+
+	principalID, ok := rewrite("openldap_user://uid=bob,dc=example")
+	assert.True(t, ok)
+	assert.Equal(t, "activedirectory_user://uid=bob,dc=example", principalID)
+
+	_, ok = rewrite("local://u-bob")
+	assert.False(t, ok)
+	_, ok = rewrite("")
+	assert.False(t, ok)
+}
+
+func TestUserRewrite(t *testing.T) {
+	rewrite := UserRewrite(&v3.User{
+		PrincipalIDs: []string{"local://u-bob", "activedirectory_user://uid=bob", "github_user://1", "github_org://1"},
+	})
+
+	principalID, ok := rewrite("openldap_user://uid=bob")
+	assert.True(t, ok)
+	assert.Equal(t, "activedirectory_user://uid=bob", principalID)
+
+	// principals the user still has are kept
+	_, ok = rewrite("activedirectory_user://uid=bob")
+	assert.False(t, ok)
+	// the new principal is ambiguous
+	_, ok = rewrite("githubapp_user://1")
+	assert.False(t, ok)
+	// no principal matches
+	_, ok = rewrite("openldap_user://uid=alice")
+	assert.False(t, ok)
+	_, ok = rewrite("")
+	assert.False(t, ok)
+}
+
+func TestMigrate(t *testing.T) {
+	const (
+		oldPrincipal = "openldap_user://uid=bob"
+		newPrincipal = "activedirectory_user://uid=bob"
+	)
+	rewrite := PrefixRewrite("openldap_user://", "activedirectory_user://")
+
+	newCRTB := func(name, principalID string) *v3.ClusterRoleTemplateBinding {
+		return &v3.ClusterRoleTemplateBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "c-1",
+				Labels:    map[string]string{"foo": "bar"},
+				Annotations: map[string]string{
+					"foo":                          "bar",
+					"lifecycle.cattle.io/create.x": "true",
+				},
+			},
+			ClusterName:       "c-1",
+			RoleTemplateName:  "cluster-member",
+			UserName:          "u-bob",
+			UserPrincipalName: principalID,
+		}
+	}
+	newPRTB := func(name, principalID string) *v3.ProjectRoleTemplateBinding {
+		return &v3.ProjectRoleTemplateBinding{
+			ObjectMeta:        metav1.ObjectMeta{Name: name, Namespace: "p-1"},
+			ProjectName:       "c-1:p-1",
+			RoleTemplateName:  "project-member",
+			UserName:          "u-bob",
+			UserPrincipalName: principalID,
+		}
+	}
+
+	t.Run("bindings are replaced", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		crtbs := fake.NewMockControllerInterface[*v3.ClusterRoleTemplateBinding, *v3.ClusterRoleTemplateBindingList](ctrl)
+		crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+		prtbs := fake.NewMockControllerInterface[*v3.ProjectRoleTemplateBinding, *v3.ProjectRoleTemplateBindingList](ctrl)
+		prtbCache := fake.NewMockCacheInterface[*v3.ProjectRoleTemplateBinding](ctrl)
+
+		crtbCache.EXPECT().List("c-1", labels.SelectorFromSet(labels.Set{previousNameLabel: "crtb-1"})).Return(nil, nil)
+		crtbs.EXPECT().Create(gomock.Any()).DoAndReturn(func(crtb *v3.ClusterRoleTemplateBinding) (*v3.ClusterRoleTemplateBinding, error) {
+			assert.Equal(t, "crtb-", crtb.GenerateName)
+			assert.Equal(t, "c-1", crtb.Namespace)
+			assert.Equal(t, newPrincipal, crtb.UserPrincipalName)
+			assert.Equal(t, "u-bob", crtb.UserName)
+			assert.Equal(t, "cluster-member", crtb.RoleTemplateName)
+			assert.Equal(t, map[string]string{"foo": "bar", previousNameLabel: "crtb-1"}, crtb.Labels)
+			assert.Equal(t, map[string]string{"foo": "bar", MigratedFromAnnotation: oldPrincipal}, crtb.Annotations)
+			return crtb, nil
+		})
+		crtbs.EXPECT().Delete("c-1", "crtb-1", gomock.Any()).Return(nil)
+
+		// an interrupted migration resumes with the deletion of the old binding
+		prtbCache.EXPECT().List("p-1", labels.SelectorFromSet(labels.Set{previousNameLabel: "prtb-1"})).
+			Return([]*v3.ProjectRoleTemplateBinding{newPRTB("prtb-2", newPrincipal)}, nil)
+		prtbs.EXPECT().Delete("p-1", "prtb-1", gomock.Any()).Return(apierrors.NewNotFound(schema.GroupResource{}, "prtb-1"))
+
+		m := &Migrator{crtbs: crtbs, crtbCache: crtbCache, prtbs: prtbs, prtbCache: prtbCache}
+		result := m.Migrate(
+			[]*v3.ClusterRoleTemplateBinding{newCRTB("crtb-1", oldPrincipal), newCRTB("crtb-other", "local://u-bob")},
+			[]*v3.ProjectRoleTemplateBinding{newPRTB("prtb-1", oldPrincipal)},
+			rewrite, false)
+		assert.Equal(t, Result{Matched: 2, Migrated: 2}, result)
+	})
+
+	t.Run("failures are reported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		crtbs := fake.NewMockControllerInterface[*v3.ClusterRoleTemplateBinding, *v3.ClusterRoleTemplateBindingList](ctrl)
+		crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+
+		crtbCache.EXPECT().List("c-1", gomock.Any()).Return(nil, nil)
+		crtbs.EXPECT().Create(gomock.Any()).Return(nil, errors.New("denied"))
+
+		m := &Migrator{crtbs: crtbs, crtbCache: crtbCache}
+		result := m.Migrate([]*v3.ClusterRoleTemplateBinding{newCRTB("crtb-1", oldPrincipal)}, nil, rewrite, false)
+		assert.Equal(t, Result{Matched: 1, Failed: []string{"c-1/crtb-1"}}, result)
+	})
+
+	t.Run("dry run and migrated bindings", func(t *testing.T) {
+		migrated := newCRTB("crtb-2", newPrincipal)
+		migrated.Annotations[MigratedFromAnnotation] = oldPrincipal
+
+		m := &Migrator{}
+		result := m.Migrate([]*v3.ClusterRoleTemplateBinding{newCRTB("crtb-1", oldPrincipal)}, nil, rewrite, true)
+		assert.Equal(t, Result{Matched: 1}, result)
+
+		// a binding isn't migrated back to the principal it was migrated from
+		result = m.Migrate([]*v3.ClusterRoleTemplateBinding{migrated}, nil, PrefixRewrite("activedirectory_user://", "openldap_user://"), false)
+		assert.Equal(t, Result{}, result)
+	})
+}
@@ -20,6 +20,9 @@
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
+// continueHeader is the response header holding the token to get the next page of a search of principals.
+const continueHeader = "X-Principals-Continue"
+
 type principalsHandler struct {
 	principalsClient v3.PrincipalInterface
 	tokensClient     v3.TokenInterface
@@ -54,10 +57,24 @@ func (h *principalsHandler) actions(actionName string, action *types.Action, api
 		return err
 	}
 
-	ps, err := providers.SearchPrincipals(input.Name, input.PrincipalType, token)
+	result, err := providers.SearchPrincipalsPage(input.Name, input.PrincipalType, token, providers.SearchOptions{
+		Limit:    int(input.Limit),
+		Continue: input.Continue,
+	})
 	if err != nil {
+		if errors.Is(err, providers.ErrInvalidContinue) {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+		}
 		return err
 	}
+	ps := result.Principals
+
+	if result.Continue != "" {
+		apiContext.Response.Header().Set(continueHeader, result.Continue)
+	}
+	for _, provider := range result.Incomplete {
+		apiContext.Response.Header().Add("Warning", fmt.Sprintf(`299 - "search of auth provider %s failed or timed out, its principals are missing"`, provider))
+	}
 
 	var principals []map[string]interface{}
 	for _, p := range ps {
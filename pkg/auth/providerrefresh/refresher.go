@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -11,6 +12,7 @@
 	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/auth/accessor"
 	"github.com/rancher/rancher/pkg/auth/providers"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
 	"github.com/rancher/rancher/pkg/auth/settings"
 	"github.com/rancher/rancher/pkg/auth/tokens"
 	exttokenstore "github.com/rancher/rancher/pkg/ext/stores/tokens"
@@ -153,6 +155,7 @@ func (r *refresher) refreshAttributes(attribs *apiv3.UserAttribute) (*v3.UserAtt
 		derivedTokenList      []accessor.TokenAccessor
 		canLogInAtAll         bool
 		errorConfirmingLogins bool
+		suspended             bool
 	)
 
 	attribs = attribs.DeepCopy()
@@ -200,6 +203,7 @@ func (r *refresher) refreshAttributes(attribs *apiv3.UserAttribute) (*v3.UserAtt
 		// We have to find out if the user has a principal for the provider.
 		principalID := GetPrincipalIDForProvider(providerName, user)
 		var newGroupPrincipals []apiv3.Principal
+		sessionsConfirmed := false
 
 		providerDisabled, err := providers.IsDisabledProvider(providerName)
 		if err != nil {
@@ -238,7 +242,23 @@ func (r *refresher) refreshAttributes(attribs *apiv3.UserAttribute) (*v3.UserAtt
 				}
 			} else {
 				newGroupPrincipals, err = providers.RefetchGroupPrincipals(principalID, providerName, secret)
-				if err != nil {
+				if errors.Is(err, common.ErrRefreshRejected) {
+					// The session of the user ended at the identity provider, their login sessions must end too
+					// and they must log in again. They may still have access, their derived tokens are kept.
+					logrus.Infof(
+						"Refresh token rejected by auth provider %s, userattribute %s, principal %s, ending login sessions: %v",
+						providerName,
+						attribs.Name,
+						principalID,
+						err,
+					)
+					if err := r.deleteLoginTokens(loginTokens[providerName]); err != nil {
+						return nil, err
+					}
+					loginTokens[providerName] = nil
+					errorConfirmingLogins = true
+					newGroupPrincipals = attribs.GroupPrincipals[providerName].Items
+				} else if err != nil {
 					// In the case that we cant access a server, we still want to continue refreshing, but
 					// we no longer want to disable derived tokens, or remove their login tokens for this provider.
 					if err.Error() != "no access" {
@@ -260,6 +280,8 @@ func (r *refresher) refreshAttributes(attribs *apiv3.UserAttribute) (*v3.UserAtt
 						// so that their login tokens get blanked out
 						principalID = ""
 					}
+				} else {
+					sessionsConfirmed = providers.RenewsSessions(providerName)
 				}
 			}
 		}
@@ -285,6 +307,28 @@ func (r *refresher) refreshAttributes(attribs *apiv3.UserAttribute) (*v3.UserAtt
 			}
 		}
 
+		// A user who still has a principal but was denied access by the provider is suspended rather than
+		// deactivated: their bindings and derived tokens are kept but can't be used, until they are allowed again.
+		switch {
+		case canAccessProvider || principalID == "":
+			delete(attribs.SuspendedByProvider, providerName)
+		case !errorConfirmingLogins:
+			if _, ok := attribs.SuspendedByProvider[providerName]; !ok {
+				logrus.Infof("Suspending access of userattribute %s, principal %s, to auth provider %s", attribs.Name, principalID, providerName)
+				if attribs.SuspendedByProvider == nil {
+					attribs.SuspendedByProvider = make(map[string]metav1.Time)
+				}
+				attribs.SuspendedByProvider[providerName] = metav1.Now()
+			}
+			suspended = true
+		}
+
+		if canAccessProvider && sessionsConfirmed && settings.OIDCSessionRenewal.Get() == "true" {
+			if err := r.renewLoginTokens(loginTokens[providerName]); err != nil {
+				return nil, err
+			}
+		}
+
 		// Update extras if either the user has an active login token, or an API token/kubeconfig token and is still active in the auth provider.
 		// If the user cannot access the auth provider, the derived tokens are deactivated below and should not be used to determine extra attributes.
 		if principalID != "" && (len(loginTokens[providerName]) > 0 || (len(derivedTokens[providerName]) > 0 && (canAccessProvider || errorConfirmingLogins))) {
@@ -311,28 +355,15 @@ func (r *refresher) refreshAttributes(attribs *apiv3.UserAttribute) (*v3.UserAtt
 		// If the user doesn't have access through this provider, we want to remove their
 		// login tokens for this provider
 		if !canAccessProvider && !errorConfirmingLogins {
-			for _, token := range loginTokens[providerName] {
-				var err error
-				switch token.(type) {
-				case *apiv3.Token:
-					err = r.tokens.Delete(token.GetName(), &metav1.DeleteOptions{})
-				case *ext.Token:
-					err = r.extTokenStore.Delete(token.GetName(), &metav1.DeleteOptions{})
-				default:
-					err = fmt.Errorf("unable to delete token of unknown type %T", token)
-				}
-				if err != nil {
-					if apierrors.IsNotFound(err) {
-						continue
-					}
-					return nil, err
-				}
+			if err := r.deleteLoginTokens(loginTokens[providerName]); err != nil {
+				return nil, err
 			}
 		}
 	}
 
-	// If they can still log in, or we failed to validate one of their logins, don't disable derived tokens
-	if canLogInAtAll || errorConfirmingLogins {
+	// If they can still log in, are only suspended, or we failed to validate one of their logins, don't disable
+	// derived tokens
+	if canLogInAtAll || suspended || errorConfirmingLogins {
 		return attribs, nil
 	}
 
@@ -356,6 +387,56 @@ func (r *refresher) refreshAttributes(attribs *apiv3.UserAttribute) (*v3.UserAtt
 	return attribs, err
 }
 
+// deleteLoginTokens deletes the login tokens, ending the login sessions.
+func (r *refresher) deleteLoginTokens(loginTokens []accessor.TokenAccessor) error {
+	for _, token := range loginTokens {
+		var err error
+		switch token.(type) {
+		case *apiv3.Token:
+			err = r.tokens.Delete(token.GetName(), &metav1.DeleteOptions{})
+		case *ext.Token:
+			err = r.extTokenStore.Delete(token.GetName(), &metav1.DeleteOptions{})
+		default:
+			err = fmt.Errorf("unable to delete token of unknown type %T", token)
+		}
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// renewLoginTokens extends the time to live of the login tokens, whose sessions were confirmed with the identity
+// provider, see [tokens.RenewedSessionTTL].
+func (r *refresher) renewLoginTokens(loginTokens []accessor.TokenAccessor) error {
+	now := time.Now()
+	for _, token := range loginTokens {
+		var err error
+		switch t := token.(type) {
+		case *apiv3.Token:
+			ttl, ok := tokens.RenewedSessionTTL(t.CreationTimestamp.Time, t.TTLMillis, now)
+			if !ok {
+				continue
+			}
+			v3Token := t.DeepCopy()
+			v3Token.TTLMillis = ttl
+			_, err = r.tokenMGR.UpdateToken(v3Token)
+		case *ext.Token:
+			ttl, ok := tokens.RenewedSessionTTL(t.CreationTimestamp.Time, t.Spec.TTL, now)
+			if !ok {
+				continue
+			}
+			err = r.extTokenStore.UpdateTTL(t.GetName(), ttl)
+		default:
+			err = fmt.Errorf("unable to renew token of unknown type %T", token)
+		}
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error renewing token %s: %w", token.GetName(), err)
+		}
+	}
+	return nil
+}
+
 func GetPrincipalIDForProvider(providerName string, user *v3.User) string {
 	prefix := providerName + "_user://"
 	if providerName == "local" {
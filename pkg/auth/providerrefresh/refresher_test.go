@@ -6,6 +6,7 @@
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/rancher/norman/types"
 	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
@@ -17,8 +18,10 @@
 	"github.com/rancher/rancher/pkg/auth/tokens"
 	exttokens "github.com/rancher/rancher/pkg/ext/stores/tokens"
 	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/wrangler/v3/pkg/generic/fake"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,6 +55,11 @@ func TestRefreshAttributes(t *testing.T) {
 		ExtraByProvider: map[string]map[string][]string{},
 	}
 
+	attribsSuspended := *attribsIn.DeepCopy()
+	attribsSuspended.SuspendedByProvider = map[string]metav1.Time{
+		providers.LocalProvider: metav1.NewTime(time.Now().Add(-time.Hour)),
+	}
+
 	wantNoExtra := v3.UserAttribute{
 		ObjectMeta: metav1.ObjectMeta{Name: "user-abcde"},
 		GroupPrincipals: map[string]v3.Principals{
@@ -334,9 +342,12 @@ func TestRefreshAttributes(t *testing.T) {
 		providerDisabledError error
 		tokens                []*v3.Token
 		eTokens               []*ext.Token
+		denied                bool  // user denied access by the provider
+		refetchErr            error // error refetching the groups of the user
 		enabled               bool
 		deleted               bool
-		want                  *v3.UserAttribute // result expected from refreshAttributes
+		suspended             []string          // providers the user is suspended from
+		want                  *v3.UserAttribute // result expected from refreshAttributes, without the suspensions
 		eTokenSetup           func(
 			secrets *fake.MockControllerInterface[*corev1.Secret, *corev1.SecretList],
 			scache *fake.MockCacheInterface[*corev1.Secret])
@@ -389,23 +400,46 @@ func TestRefreshAttributes(t *testing.T) {
 			eTokenSetup: eTokenSetupDerivedLocal,
 		},
 		{
-			name:        "user with derived token disabled in provider",
+			name:        "user denied by provider, suspended with derived token kept",
 			user:        &userLocal,
 			attribs:     &attribsIn,
 			tokens:      []*v3.Token{&derivedTokenLocal},
-			enabled:     false,
+			denied:      true,
+			enabled:     true,
+			suspended:   []string{providers.LocalProvider},
 			want:        &wantNoExtra,
 			eTokenSetup: eTokenSetupEmpty,
 		},
 		{
-			name:        "user with derived ext token disabled in provider",
+			name:        "user denied by provider, suspended with derived ext token kept",
 			user:        &userLocal,
 			attribs:     &attribsIn,
 			eTokens:     []*ext.Token{&eDerivedTokenLocal},
-			enabled:     false,
+			denied:      true,
+			enabled:     true,
+			suspended:   []string{providers.LocalProvider},
 			want:        &wantNoExtra,
 			eTokenSetup: eTokenSetupDerivedLocalPatch,
 		},
+		{
+			name:        "user allowed again by provider, access restored",
+			user:        &userLocal,
+			attribs:     &attribsSuspended,
+			tokens:      []*v3.Token{&loginTokenLocal},
+			enabled:     true,
+			want:        &wantLocal,
+			eTokenSetup: eTokenSetupEmpty,
+		},
+		{
+			name:        "user no longer in provider, derived token disabled",
+			user:        &userLocal,
+			attribs:     &attribsSuspended,
+			tokens:      []*v3.Token{&derivedTokenLocal},
+			refetchErr:  fmt.Errorf("no access"),
+			enabled:     false,
+			want:        &wantNoExtra,
+			eTokenSetup: eTokenSetupEmpty,
+		},
 		{
 			name:        "user with login and derived tokens",
 			user:        &userLocal,
@@ -501,9 +535,10 @@ func TestRefreshAttributes(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			providers.Providers = map[string]common.AuthProvider{
 				providers.LocalProvider: &mockLocalProvider{
-					canAccess:   tt.enabled,
+					canAccess:   tt.enabled && !tt.denied,
 					disabled:    tt.providerDisabled,
 					disabledErr: tt.providerDisabledError,
+					refetchErr:  tt.refetchErr,
 				},
 				saml.ShibbolethName: &mockShibbolethProvider{},
 			}
@@ -567,6 +602,12 @@ func TestRefreshAttributes(t *testing.T) {
 			}
 			got, err := r.refreshAttributes(tt.attribs)
 			assert.Nil(t, err)
+			var suspended []string
+			for provider := range got.SuspendedByProvider {
+				suspended = append(suspended, provider)
+			}
+			assert.Equal(t, tt.suspended, suspended)
+			got.SuspendedByProvider = nil
 			assert.Equal(t, tt.want, got)
 			assert.NotEqual(t, tt.enabled, tokenUpdateCalled)
 			assert.Equal(t, tt.deleted, tokenDeleteCalled)
@@ -574,6 +615,114 @@ func TestRefreshAttributes(t *testing.T) {
 	}
 }
 
+func TestRefreshAttributesSessions(t *testing.T) {
+	user := &v3.User{
+		ObjectMeta:   metav1.ObjectMeta{Name: "user-abcde"},
+		Username:     "admin",
+		PrincipalIDs: []string{"local://user-abcde"},
+	}
+	loginToken := &v3.Token{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "token-login",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+		},
+		UserID:       "user-abcde",
+		AuthProvider: providers.LocalProvider,
+		TTLMillis:    time.Hour.Milliseconds(),
+		UserPrincipal: v3.Principal{
+			ObjectMeta: metav1.ObjectMeta{Name: "local://user-abcde"},
+			Provider:   providers.LocalProvider,
+		},
+	}
+	derivedToken := loginToken.DeepCopy()
+	derivedToken.Name = "token-derived"
+	derivedToken.IsDerived = true
+
+	require.NoError(t, settings.OIDCSessionRenewal.Set("true"))
+	t.Cleanup(func() { settings.OIDCSessionRenewal.Set("false") })
+	providers.ProviderNames = map[string]bool{providers.LocalProvider: true}
+
+	tests := []struct {
+		name        string
+		provider    *mockLocalProvider
+		wantDeleted []string
+		wantUpdated []*v3.Token
+	}{
+		{
+			name:        "refresh rejected, login sessions end and derived tokens are kept",
+			provider:    &mockLocalProvider{canAccess: true, refetchErr: fmt.Errorf("%w: session ended", common.ErrRefreshRejected)},
+			wantDeleted: []string{"token-login"},
+		},
+		{
+			name:        "sessions confirmed, login sessions are renewed",
+			provider:    &mockLocalProvider{canAccess: true, renews: true},
+			wantUpdated: []*v3.Token{loginToken},
+		},
+		{
+			name:     "provider not renewing sessions",
+			provider: &mockLocalProvider{canAccess: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			providers.Providers = map[string]common.AuthProvider{providers.LocalProvider: tt.provider}
+
+			ctrl := gomock.NewController(t)
+			secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+			scache := fake.NewMockCacheInterface[*corev1.Secret](ctrl)
+			users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+			users.EXPECT().Cache().Return(nil)
+			secrets.EXPECT().Cache().Return(scache)
+			scache.EXPECT().List("cattle-tokens", gomock.Any()).Return(nil, nil)
+
+			var deleted []string
+			var updated []*v3.Token
+			r := &refresher{
+				tokenLister: &fakes.TokenListerMock{
+					ListFunc: func(_ string, _ labels.Selector) ([]*v3.Token, error) {
+						return []*v3.Token{loginToken, derivedToken}, nil
+					},
+				},
+				userLister: &fakes.UserListerMock{
+					GetFunc: func(_, _ string) (*v3.User, error) {
+						return user, nil
+					},
+				},
+				tokens: &fakes.TokenInterfaceMock{
+					DeleteFunc: func(name string, _ *metav1.DeleteOptions) error {
+						deleted = append(deleted, name)
+						return nil
+					},
+				},
+				tokenMGR: tokens.NewMockedManager(&fakes.TokenInterfaceMock{
+					UpdateFunc: func(token *v3.Token) (*v3.Token, error) {
+						updated = append(updated, token)
+						return token, nil
+					},
+				}),
+				extTokenStore: exttokens.NewSystem(nil, nil, secrets, users, nil,
+					exttokens.NewTimeHandler(),
+					exttokens.NewHashHandler(),
+					exttokens.NewAuthHandler()),
+			}
+
+			attribs := &v3.UserAttribute{
+				ObjectMeta:      metav1.ObjectMeta{Name: "user-abcde"},
+				GroupPrincipals: map[string]v3.Principals{},
+			}
+			_, err := r.refreshAttributes(attribs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDeleted, deleted)
+			require.Len(t, updated, len(tt.wantUpdated))
+			for i, want := range tt.wantUpdated {
+				assert.Equal(t, want.Name, updated[i].Name)
+				assert.Greater(t, updated[i].TTLMillis, want.TTLMillis)
+			}
+		})
+	}
+}
+
 func TestGetPrincipalIDForProvider(t *testing.T) {
 	const testUserUsername = "tUser"
 	tests := []struct {
@@ -655,6 +804,8 @@ type mockLocalProvider struct {
 	canAccess   bool
 	disabled    bool
 	disabledErr error
+	refetchErr  error
+	renews      bool
 }
 
 func (p *mockLocalProvider) IsDisabledProvider() (bool, error) {
@@ -694,9 +845,16 @@ func (p *mockLocalProvider) TransformToAuthProvider(authConfig map[string]interf
 }
 
 func (p *mockLocalProvider) RefetchGroupPrincipals(principalID string, secret string) ([]v3.Principal, error) {
+	if p.refetchErr != nil {
+		return nil, p.refetchErr
+	}
 	return []v3.Principal{}, nil
 }
 
+func (p *mockLocalProvider) RenewsSessions() bool {
+	return p.renews
+}
+
 func (p *mockLocalProvider) CanAccessWithGroupProviders(userPrincipalID string, groups []v3.Principal) (bool, error) {
 	return p.canAccess, nil
 }
@@ -85,7 +85,7 @@ func (p *adProvider) loginUser(lConn ldapv3.Client, credentials *v3.BasicLogin,
 		return v3.Principal{}, nil, err
 	}
 
-	allowed, err := p.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipal.Name, groupPrincipals)
+	allowed, err := p.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipal.Name, groupPrincipals)
 	if err != nil {
 		return v3.Principal{}, nil, err
 	}
@@ -155,7 +155,6 @@ func (p *adProvider) getPrincipalsFromSearchResult(lConn ldapv3.Client, config *
 		nestedGroupPrincipals []v3.Principal
 	)
 
-	groupMap := make(map[string]bool)
 	entry := result.Entries[0]
 
 	if !p.permissionCheck(entry.Attributes, config) {
@@ -236,11 +235,9 @@ func (p *adProvider) getPrincipalsFromSearchResult(lConn ldapv3.Client, config *
 		}
 		searchAttributes := []string{MemberOfAttribute, ObjectClass, config.GroupObjectClass, config.UserLoginAttribute, config.GroupNameAttribute,
 			config.GroupSearchAttribute}
-		for _, groupPrincipal := range groupPrincipals {
-			err = ldap.GatherParentGroups(groupPrincipal, searchDomain, GroupScope, &commonConfig, lConn, groupMap, &nestedGroupPrincipals, searchAttributes)
-			if err != nil {
-				return userPrincipal, groupPrincipals, nil
-			}
+		nestedGroupPrincipals, err = ldap.ResolveParentGroups(groupPrincipals, searchDomain, GroupScope, &commonConfig, lConn, searchAttributes)
+		if err != nil {
+			return userPrincipal, groupPrincipals, nil
 		}
 		nonDupGroupPrincipals = ldap.FindNonDuplicateBetweenGroupPrincipals(nestedGroupPrincipals, groupPrincipals, []v3.Principal{})
 		groupPrincipals = append(groupPrincipals, nonDupGroupPrincipals...)
@@ -407,7 +404,7 @@ func (p *adProvider) getPrincipal(distinguishedName string, scope string, config
 	return principal, nil
 }
 
-func (p *adProvider) searchPrincipals(name, principalType string, config *v3.ActiveDirectoryConfig, lConn *ldapv3.Conn) ([]v3.Principal, error) {
+func (p *adProvider) searchPrincipals(name, principalType string, config *v3.ActiveDirectoryConfig, lConn ldapv3.Client) ([]v3.Principal, error) {
 	var principals []v3.Principal
 
 	if principalType == "" || principalType == "user" {
@@ -429,7 +426,7 @@ func (p *adProvider) searchPrincipals(name, principalType string, config *v3.Act
 	return principals, nil
 }
 
-func (p *adProvider) searchUser(name string, config *v3.ActiveDirectoryConfig, lConn *ldapv3.Conn) ([]v3.Principal, error) {
+func (p *adProvider) searchUser(name string, config *v3.ActiveDirectoryConfig, lConn ldapv3.Client) ([]v3.Principal, error) {
 	if config.UserSearchFilter != "" {
 		// Make sure user search filter contains a valid LDAP query expression
 		// before interpolating it into the search filter.
@@ -451,7 +448,7 @@ func (p *adProvider) searchUser(name string, config *v3.ActiveDirectoryConfig, l
 	return p.searchLdap(query, UserScope, config, lConn)
 }
 
-func (p *adProvider) searchGroup(name string, config *v3.ActiveDirectoryConfig, lConn *ldapv3.Conn) ([]v3.Principal, error) {
+func (p *adProvider) searchGroup(name string, config *v3.ActiveDirectoryConfig, lConn ldapv3.Client) ([]v3.Principal, error) {
 	if config.GroupSearchFilter != "" {
 		// Make sure group search filter contains a valid LDAP query expression
 		// before interpolating it into the search filter.
@@ -474,7 +471,7 @@ func (p *adProvider) searchGroup(name string, config *v3.ActiveDirectoryConfig,
 	return p.searchLdap(query, GroupScope, config, lConn)
 }
 
-func (p *adProvider) searchLdap(query string, scope string, config *v3.ActiveDirectoryConfig, lConn *ldapv3.Conn) ([]v3.Principal, error) {
+func (p *adProvider) searchLdap(query string, scope string, config *v3.ActiveDirectoryConfig, lConn ldapv3.Client) ([]v3.Principal, error) {
 	var principals []v3.Principal
 	var search *ldapv3.SearchRequest
 
@@ -524,13 +521,13 @@ func (p *adProvider) searchLdap(query string, scope string, config *v3.ActiveDir
 	return principals, nil
 }
 
-func (p *adProvider) ldapConnection(config *v3.ActiveDirectoryConfig, caPool *x509.CertPool) (*ldapv3.Conn, error) {
+func (p *adProvider) ldapConnection(config *v3.ActiveDirectoryConfig, caPool *x509.CertPool) (*ldap.PooledConn, error) {
 	servers := config.Servers
 	TLS := config.TLS
 	port := config.Port
 	connectionTimeout := config.ConnectionTimeout
 	startTLS := config.StartTLS
-	return ldap.NewLDAPConn(servers, TLS, startTLS, port, connectionTimeout, caPool)
+	return ldap.GetPooledConn(servers, TLS, startTLS, port, connectionTimeout, caPool)
 }
 func (p *adProvider) permissionCheck(attributes []*ldapv3.EntryAttribute, config *v3.ActiveDirectoryConfig) bool {
 	userObjectClass := config.UserObjectClass
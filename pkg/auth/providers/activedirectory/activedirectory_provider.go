@@ -231,7 +231,7 @@ func (p *adProvider) CanAccessWithGroupProviders(userPrincipalID string, groupPr
 		logrus.Errorf("Error fetching AD config: %v", err)
 		return false, err
 	}
-	allowed, err := p.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipalID, groupPrincipals)
+	allowed, err := p.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipalID, groupPrincipals)
 	if err != nil {
 		return false, err
 	}
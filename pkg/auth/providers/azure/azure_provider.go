@@ -279,7 +279,7 @@ func (ap *Provider) loginUser(config *v32.AzureADConfig, azureCredential *v32.Az
 		testAllowedPrincipals = append(testAllowedPrincipals, userPrincipal.Name)
 	}
 
-	allowed, err := ap.userMGR.CheckAccess(config.AccessMode, testAllowedPrincipals, userPrincipal.Name, groupPrincipals)
+	allowed, err := ap.userMGR.CheckAccess(config.AccessMode, testAllowedPrincipals, config.DeniedPrincipalIDs, userPrincipal.Name, groupPrincipals)
 	if err != nil {
 		return v3.Principal{}, nil, "", err
 	}
@@ -514,7 +514,7 @@ func (ap *Provider) CanAccessWithGroupProviders(userPrincipalID string, groupPri
 		logrus.Errorf("Error fetching azure config: %v", err)
 		return false, err
 	}
-	allowed, err := ap.userMGR.CheckAccess(cfg.AccessMode, cfg.AllowedPrincipalIDs, userPrincipalID, groupPrincipals)
+	allowed, err := ap.userMGR.CheckAccess(cfg.AccessMode, cfg.AllowedPrincipalIDs, cfg.DeniedPrincipalIDs, userPrincipalID, groupPrincipals)
 	if err != nil {
 		return false, err
 	}
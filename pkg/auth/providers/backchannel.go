@@ -0,0 +1,148 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/rancher/rancher/pkg/auth/providers/oidc"
+	exttokens "github.com/rancher/rancher/pkg/ext/stores/tokens"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// BackChannelLogoutPath is the path identity providers send OIDC back-channel logout requests to.
+const BackChannelLogoutPath = "/v1-oidc/{provider}/backchannel-logout"
+
+// backChannelExtTokenStore is the subset of the ext token system store used to revoke ext tokens.
+type backChannelExtTokenStore interface {
+	ListSelected(selector labels.Selector) (*ext.TokenList, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteHash(token *ext.Token)
+}
+
+type backChannelLogoutHandler struct {
+	getProvider func(name string) (common.AuthProvider, error)
+	tokens      mgmtv3.TokenClient
+	tokenCache  mgmtv3.TokenCache
+	extTokens   backChannelExtTokenStore
+}
+
+// BackChannelLogoutHandler returns the handler of the OIDC back-channel logout requests of identity providers. It
+// revokes the login sessions of Rancher ended at the identity provider, tokens derived from them are kept.
+func BackChannelLogoutHandler(wranglerContext *wrangler.Context) http.Handler {
+	h := &backChannelLogoutHandler{
+		getProvider: GetProvider,
+		tokens:      wranglerContext.Mgmt.Token(),
+		tokenCache:  wranglerContext.Mgmt.Token().Cache(),
+		extTokens:   exttokens.NewSystemFromWrangler(wranglerContext),
+	}
+
+	root := mux.NewRouter()
+	root.Methods(http.MethodPost).Path(BackChannelLogoutPath).Handler(h)
+	return root
+}
+
+// ServeHTTP implements [http.Handler], see https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRequest.
+func (h *backChannelLogoutHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	rw.Header().Set("Cache-Control", "no-store")
+
+	providerName := mux.Vars(req)["provider"]
+	provider, err := h.getProvider(providerName)
+	if err != nil {
+		backChannelLogoutError(rw, http.StatusNotFound, fmt.Sprintf("unknown provider %s", providerName))
+		return
+	}
+	logoutProvider, ok := provider.(common.BackChannelLogoutProvider)
+	if !ok {
+		backChannelLogoutError(rw, http.StatusNotFound, fmt.Sprintf("provider %s does not support back-channel logout", providerName))
+		return
+	}
+
+	rawToken := req.PostFormValue("logout_token")
+	if rawToken == "" {
+		backChannelLogoutError(rw, http.StatusBadRequest, "missing logout_token")
+		return
+	}
+	session, err := logoutProvider.VerifyLogoutToken(req.Context(), rawToken)
+	if err != nil {
+		logrus.Infof("Rejected back-channel logout request of provider %s: %v", providerName, err)
+		backChannelLogoutError(rw, http.StatusBadRequest, "invalid logout_token")
+		return
+	}
+
+	if err := h.revoke(providerName, session); err != nil {
+		logrus.Errorf("Failed to revoke the sessions ended by the back-channel logout request of provider %s: %v", providerName, err)
+		// Per the spec, the identity provider may retry.
+		backChannelLogoutError(rw, http.StatusInternalServerError, "failed to revoke sessions")
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+// revoke deletes the login session tokens of the provider matching the session.
+func (h *backChannelLogoutHandler) revoke(providerName string, session common.LogoutSession) error {
+	matches := func(principal apiv3.Principal) bool {
+		return (session.PrincipalID == "" || principal.Name == session.PrincipalID) &&
+			(session.SessionID == "" || principal.ExtraInfo[oidc.SessionIDExtraInfoKey] == session.SessionID)
+	}
+
+	tokens, err := h.tokenCache.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list tokens: %w", err)
+	}
+	var errs []error
+	revoked := 0
+	for _, token := range tokens {
+		if token.IsDerived || token.AuthProvider != providerName || !matches(token.UserPrincipal) {
+			continue
+		}
+		if err := h.tokens.Delete(token.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("token %s: %w", token.Name, err))
+			continue
+		}
+		revoked++
+	}
+
+	extTokens, err := h.extTokens.ListSelected(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list ext tokens: %w", err)
+	}
+	for _, token := range extTokens.Items {
+		principal := token.Spec.UserPrincipal
+		if token.Spec.Kind != exttokens.IsLogin || principal.Provider != providerName ||
+			!matches(apiv3.Principal{ObjectMeta: metav1.ObjectMeta{Name: principal.Name}, ExtraInfo: principal.ExtraInfo}) {
+			continue
+		}
+		if err := h.extTokens.Delete(token.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("ext token %s: %w", token.Name, err))
+			continue
+		}
+		h.extTokens.DeleteHash(&token)
+		revoked++
+	}
+
+	logrus.Infof("Revoked %d sessions ended by a back-channel logout request of provider %s", revoked, providerName)
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to revoke %d sessions: %v", len(errs), errs)
+	}
+	return nil
+}
+
+// backChannelLogoutError writes the error response of a back-channel logout request.
+func backChannelLogoutError(rw http.ResponseWriter, status int, description string) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(map[string]string{
+		"error":             "invalid_request",
+		"error_description": description,
+	})
+}
@@ -0,0 +1,189 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/rancher/rancher/pkg/auth/providers/oidc"
+	exttokens "github.com/rancher/rancher/pkg/ext/stores/tokens"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type fakeLogoutProvider struct {
+	common.AuthProvider
+	session common.LogoutSession
+	err     error
+}
+
+func (p *fakeLogoutProvider) VerifyLogoutToken(_ context.Context, rawToken string) (common.LogoutSession, error) {
+	if rawToken != "logout-token" {
+		return common.LogoutSession{}, errors.New("invalid signature")
+	}
+	return p.session, p.err
+}
+
+type fakeExtTokenStore struct {
+	tokens  []ext.Token
+	deleted []string
+}
+
+func (s *fakeExtTokenStore) ListSelected(_ labels.Selector) (*ext.TokenList, error) {
+	return &ext.TokenList{Items: s.tokens}, nil
+}
+
+func (s *fakeExtTokenStore) Delete(name string, _ *metav1.DeleteOptions) error {
+	s.deleted = append(s.deleted, name)
+	return nil
+}
+
+func (s *fakeExtTokenStore) DeleteHash(_ *ext.Token) {}
+
+func TestBackChannelLogoutHandler(t *testing.T) {
+	const principalID = "genericoidc_user://248289761001"
+	principal := func(name, sid string) v3.Principal {
+		p := v3.Principal{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if sid != "" {
+			p.ExtraInfo = map[string]string{oidc.SessionIDExtraInfoKey: sid}
+		}
+		return p
+	}
+	tokens := []*v3.Token{
+		{ObjectMeta: metav1.ObjectMeta{Name: "session"}, AuthProvider: "genericoidc", UserPrincipal: principal(principalID, "sid-1")},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other-session"}, AuthProvider: "genericoidc", UserPrincipal: principal(principalID, "sid-2")},
+		{ObjectMeta: metav1.ObjectMeta{Name: "derived"}, AuthProvider: "genericoidc", IsDerived: true, UserPrincipal: principal(principalID, "sid-1")},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other-provider"}, AuthProvider: "github", UserPrincipal: principal(principalID, "sid-1")},
+	}
+	extTokens := []ext.Token{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ext-session"},
+			Spec: ext.TokenSpec{Kind: exttokens.IsLogin, UserPrincipal: ext.TokenPrincipal{
+				Name: principalID, Provider: "genericoidc", ExtraInfo: map[string]string{oidc.SessionIDExtraInfoKey: "sid-1"},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ext-derived"},
+			Spec: ext.TokenSpec{UserPrincipal: ext.TokenPrincipal{
+				Name: principalID, Provider: "genericoidc", ExtraInfo: map[string]string{oidc.SessionIDExtraInfoKey: "sid-1"},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		provider       string
+		logoutToken    string
+		session        common.LogoutSession
+		wantStatus     int
+		wantDeleted    []string
+		wantExtDeleted []string
+	}{
+		{
+			name:           "session of the user",
+			provider:       "genericoidc",
+			logoutToken:    "logout-token",
+			session:        common.LogoutSession{PrincipalID: principalID, SessionID: "sid-1"},
+			wantStatus:     http.StatusOK,
+			wantDeleted:    []string{"session"},
+			wantExtDeleted: []string{"ext-session"},
+		},
+		{
+			name:           "all sessions of the user",
+			provider:       "genericoidc",
+			logoutToken:    "logout-token",
+			session:        common.LogoutSession{PrincipalID: principalID},
+			wantStatus:     http.StatusOK,
+			wantDeleted:    []string{"session", "other-session"},
+			wantExtDeleted: []string{"ext-session"},
+		},
+		{
+			name:        "session only",
+			provider:    "genericoidc",
+			logoutToken: "logout-token",
+			session:     common.LogoutSession{SessionID: "sid-2"},
+			wantStatus:  http.StatusOK,
+			wantDeleted: []string{"other-session"},
+		},
+		{
+			name:        "invalid logout token",
+			provider:    "genericoidc",
+			logoutToken: "forged",
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:       "missing logout token",
+			provider:   "genericoidc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "provider without back-channel logout",
+			provider:    "github",
+			logoutToken: "logout-token",
+			wantStatus:  http.StatusNotFound,
+		},
+		{
+			name:        "unknown provider",
+			provider:    "unknown",
+			logoutToken: "logout-token",
+			wantStatus:  http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tokenCache := fake.NewMockNonNamespacedCacheInterface[*v3.Token](ctrl)
+			tokenCache.EXPECT().List(gomock.Any()).Return(tokens, nil).AnyTimes()
+			var deleted []string
+			tokenClient := fake.NewMockNonNamespacedClientInterface[*v3.Token, *v3.TokenList](ctrl)
+			tokenClient.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(name string, _ *metav1.DeleteOptions) error {
+				deleted = append(deleted, name)
+				return nil
+			}).AnyTimes()
+			extStore := &fakeExtTokenStore{tokens: extTokens}
+
+			h := &backChannelLogoutHandler{
+				getProvider: func(name string) (common.AuthProvider, error) {
+					switch name {
+					case "genericoidc":
+						return &fakeLogoutProvider{session: tt.session}, nil
+					case "github":
+						return &struct{ common.AuthProvider }{}, nil
+					}
+					return nil, errors.New("no such provider")
+				},
+				tokens:     tokenClient,
+				tokenCache: tokenCache,
+				extTokens:  extStore,
+			}
+			router := mux.NewRouter()
+			router.Methods(http.MethodPost).Path(BackChannelLogoutPath).Handler(h)
+
+			form := url.Values{}
+			if tt.logoutToken != "" {
+				form.Set("logout_token", tt.logoutToken)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/v1-oidc/"+tt.provider+"/backchannel-logout", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rw := httptest.NewRecorder()
+			router.ServeHTTP(rw, req)
+
+			assert.Equal(t, tt.wantStatus, rw.Code)
+			assert.Equal(t, "no-store", rw.Header().Get("Cache-Control"))
+			assert.Equal(t, tt.wantDeleted, deleted)
+			assert.Equal(t, tt.wantExtDeleted, extStore.deleted)
+		})
+	}
+}
@@ -26,7 +26,7 @@ func (m FakeUserManager) DeleteToken(tokenName string) error { panic("unimplemen
 func (m FakeUserManager) EnsureUser(principalName, displayName string) (*v3.User, error) {
 	panic("unimplemented")
 }
-func (m FakeUserManager) CheckAccess(accessMode string, allowedPrincipalIDs []string, userPrincipalID string, groups []v3.Principal) (bool, error) {
+func (m FakeUserManager) CheckAccess(accessMode string, allowedPrincipalIDs, deniedPrincipalIDs []string, userPrincipalID string, groups []v3.Principal) (bool, error) {
 	return m.HasAccess, nil
 }
 func (m FakeUserManager) SetPrincipalOnCurrentUserByUserID(userID string, principal v3.Principal) (*v3.User, error) {
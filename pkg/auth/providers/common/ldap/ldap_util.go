@@ -29,45 +29,27 @@ type ConfigAttributes struct {
 	UserObjectClass             string
 }
 
-func Connect(config *v3.LdapConfig, caPool *x509.CertPool) (*ldapv3.Conn, error) {
-	return NewLDAPConn(config.Servers, config.TLS, config.StartTLS, config.Port, config.ConnectionTimeout, caPool)
+// Connect returns a pooled connection to one of the servers of the config, see [GetPooledConn].
+func Connect(config *v3.LdapConfig, caPool *x509.CertPool) (*PooledConn, error) {
+	return GetPooledConn(config.Servers, config.TLS, config.StartTLS, config.Port, config.ConnectionTimeout, caPool)
 }
 
+// NewLDAPConn dials the servers in order and returns a connection to the first one reachable. The connection isn't
+// pooled, see [GetPooledConn].
 func NewLDAPConn(servers []string, TLS, startTLS bool, port int64, connectionTimeout int64, caPool *x509.CertPool) (*ldapv3.Conn, error) {
 	logrus.Debug("Now creating Ldap connection")
 	var (
-		lConn     *ldapv3.Conn
-		err       error
-		tlsConfig *tls.Config
+		lConn *ldapv3.Conn
+		err   error
 	)
-	ldapv3.DefaultTimeout = time.Duration(connectionTimeout) * time.Millisecond
 
 	if len(servers) < 1 {
 		return nil, errors.New("ldap: invalid server config. at least 1 server needs to be configured")
 	}
 
 	for _, server := range servers {
-		tlsConfig = &tls.Config{RootCAs: caPool, InsecureSkipVerify: false, ServerName: server}
-		if TLS {
-			lConn, err = ldapv3.DialTLS("tcp", fmt.Sprintf("%s:%d", server, port), tlsConfig)
-			if err != nil {
-				err = fmt.Errorf("ldap: error creating ssl connection: %w", err)
-			}
-		} else if startTLS {
-			lConn, err = ldapv3.Dial("tcp", fmt.Sprintf("%s:%d", server, port))
-			if err != nil {
-				err = fmt.Errorf("ldap: error creating connection for startTLS: %w", err)
-			} else if err = lConn.StartTLS(tlsConfig); err != nil {
-				err = fmt.Errorf("ldap: error upgrading startTLS connection: %w", err)
-			}
-		} else {
-			lConn, err = ldapv3.Dial("tcp", fmt.Sprintf("%s:%d", server, port))
-			if err != nil {
-				err = fmt.Errorf("ldap: error creating connection: %w", err)
-			}
-		}
+		lConn, err = dialServer(server, TLS, startTLS, port, connectionTimeout, caPool)
 		if err == nil {
-			lConn.SetTimeout(time.Duration(connectionTimeout) * time.Millisecond)
 			return lConn, nil
 		}
 	}
@@ -75,6 +57,40 @@ func NewLDAPConn(servers []string, TLS, startTLS bool, port int64, connectionTim
 	return nil, err
 }
 
+// dialServer returns a new connection to the server.
+func dialServer(server string, TLS, startTLS bool, port int64, connectionTimeout int64, caPool *x509.CertPool) (*ldapv3.Conn, error) {
+	var (
+		lConn *ldapv3.Conn
+		err   error
+	)
+	ldapv3.DefaultTimeout = time.Duration(connectionTimeout) * time.Millisecond
+
+	tlsConfig := &tls.Config{RootCAs: caPool, InsecureSkipVerify: false, ServerName: server}
+	if TLS {
+		lConn, err = ldapv3.DialTLS("tcp", fmt.Sprintf("%s:%d", server, port), tlsConfig)
+		if err != nil {
+			return nil, fmt.Errorf("ldap: error creating ssl connection: %w", err)
+		}
+	} else if startTLS {
+		lConn, err = ldapv3.Dial("tcp", fmt.Sprintf("%s:%d", server, port))
+		if err != nil {
+			return nil, fmt.Errorf("ldap: error creating connection for startTLS: %w", err)
+		}
+		if err = lConn.StartTLS(tlsConfig); err != nil {
+			lConn.Close()
+			return nil, fmt.Errorf("ldap: error upgrading startTLS connection: %w", err)
+		}
+	} else {
+		lConn, err = ldapv3.Dial("tcp", fmt.Sprintf("%s:%d", server, port))
+		if err != nil {
+			return nil, fmt.Errorf("ldap: error creating connection: %w", err)
+		}
+	}
+
+	lConn.SetTimeout(time.Duration(connectionTimeout) * time.Millisecond)
+	return lConn, nil
+}
+
 func GetUserExternalID(username string, loginDomain string) string {
 	if strings.Contains(username, "\\") {
 		return username
@@ -211,14 +227,14 @@ func AttributesToPrincipal(attribs []*ldapv3.EntryAttribute, dnStr, scope, provi
 	return principal, nil
 }
 
-func GatherParentGroups(groupPrincipal v3.Principal, searchDomain string, groupScope string, config *ConfigAttributes, lConn ldapv3.Client,
-	groupMap map[string]bool, nestedGroupPrincipals *[]v3.Principal, searchAttributes []string) error {
-	groupMap[groupPrincipal.ObjectMeta.Name] = true
+// searchParentGroups returns the groups the group is a direct member of.
+func searchParentGroups(groupPrincipal v3.Principal, searchDomain string, groupScope string, config *ConfigAttributes, lConn ldapv3.Client,
+	searchAttributes []string) ([]v3.Principal, error) {
 	principals := []v3.Principal{}
 
 	parts := strings.SplitN(groupPrincipal.ObjectMeta.Name, ":", 2)
 	if len(parts) != 2 {
-		return errors.Errorf("invalid id %v", groupPrincipal.ObjectMeta.Name)
+		return nil, errors.Errorf("invalid id %v", groupPrincipal.ObjectMeta.Name)
 	}
 	groupDN := strings.TrimPrefix(parts[1], "//")
 
@@ -238,7 +254,7 @@ func GatherParentGroups(groupPrincipal v3.Principal, searchDomain string, groupS
 
 	resultGroups, err := lConn.SearchWithPaging(searchGroup, 1000)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for i := 0; i < len(resultGroups.Entries); i++ {
@@ -251,19 +267,7 @@ func GatherParentGroups(groupPrincipal v3.Principal, searchDomain string, groupS
 		principals = append(principals, *principal)
 	}
 
-	for _, gp := range principals {
-		if _, ok := groupMap[gp.ObjectMeta.Name]; ok {
-			continue
-		} else {
-			*nestedGroupPrincipals = append(*nestedGroupPrincipals, gp)
-			err = GatherParentGroups(gp, searchDomain, groupScope, config, lConn, groupMap, nestedGroupPrincipals, searchAttributes)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
+	return principals, nil
 }
 
 func FindNonDuplicateBetweenGroupPrincipals(newGroupPrincipals []v3.Principal, groupPrincipals []v3.Principal, nonDupGroupPrincipals []v3.Principal) []v3.Principal {
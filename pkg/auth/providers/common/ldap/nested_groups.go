@@ -0,0 +1,135 @@
+package ldap
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	ldapv3 "github.com/go-ldap/ldap/v3"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+)
+
+// parentGroupsKey identifies the cached parent groups of a group.
+type parentGroupsKey struct {
+	// search identifies the settings the parent groups were searched with, as they change the principals returned.
+	search string
+	group  string
+}
+
+type cachedParentGroups struct {
+	parents   []v3.Principal
+	expiresAt time.Time
+}
+
+// parentGroupsCache caches the direct parent groups of groups, i.e. the edges of the graph of nested groups, so that
+// the transitive closure of the groups of users is resolved from memory, and only the groups whose parents expired
+// are searched again.
+type parentGroupsCache struct {
+	now func() time.Time
+	// search returns the direct parent groups of a group.
+	search func(groupPrincipal v3.Principal, searchDomain string, groupScope string, config *ConfigAttributes, lConn ldapv3.Client,
+		searchAttributes []string) ([]v3.Principal, error)
+
+	mu        sync.Mutex
+	parents   map[parentGroupsKey]cachedParentGroups
+	lastSweep time.Time
+}
+
+var parentGroups = newParentGroupsCache()
+
+func newParentGroupsCache() *parentGroupsCache {
+	return &parentGroupsCache{
+		now:     time.Now,
+		search:  searchParentGroups,
+		parents: map[parentGroupsKey]cachedParentGroups{},
+	}
+}
+
+// ResolveParentGroups returns the groups the group principals are transitively members of, excluding the group
+// principals themselves, up to ldap-nested-group-max-depth levels of nesting. The parent groups of each group are
+// cached for ldap-nested-group-cache-ttl-seconds.
+func ResolveParentGroups(groupPrincipals []v3.Principal, searchDomain string, groupScope string, config *ConfigAttributes, lConn ldapv3.Client,
+	searchAttributes []string) ([]v3.Principal, error) {
+	ttl := time.Duration(settings.LdapNestedGroupCacheTTLSeconds.GetInt()) * time.Second
+	maxDepth := settings.LdapNestedGroupMaxDepth.GetInt()
+	return parentGroups.resolve(groupPrincipals, searchDomain, groupScope, config, lConn, searchAttributes, ttl, maxDepth)
+}
+
+func (c *parentGroupsCache) resolve(groupPrincipals []v3.Principal, searchDomain string, groupScope string, config *ConfigAttributes,
+	lConn ldapv3.Client, searchAttributes []string, ttl time.Duration, maxDepth int) ([]v3.Principal, error) {
+	search := fmt.Sprintf("%s|%s|%+v|%s", searchDomain, groupScope, *config, strings.Join(searchAttributes, ","))
+
+	seen := make(map[string]bool, len(groupPrincipals))
+	for _, groupPrincipal := range groupPrincipals {
+		seen[groupPrincipal.ObjectMeta.Name] = true
+	}
+
+	// The groups are traversed breadth first, so that each group is reached at its lowest level of nesting.
+	var nested []v3.Principal
+	level := groupPrincipals
+	for depth := 1; len(level) > 0 && (maxDepth == 0 || depth <= maxDepth); depth++ {
+		var next []v3.Principal
+		for _, groupPrincipal := range level {
+			parents, err := c.parentsOf(groupPrincipal, search, searchDomain, groupScope, config, lConn, searchAttributes, ttl)
+			if err != nil {
+				return nil, err
+			}
+			for _, parent := range parents {
+				if seen[parent.ObjectMeta.Name] {
+					continue
+				}
+				seen[parent.ObjectMeta.Name] = true
+				nested = append(nested, parent)
+				next = append(next, parent)
+			}
+		}
+		level = next
+	}
+
+	return nested, nil
+}
+
+// parentsOf returns the direct parent groups of the group, from the cache if they haven't expired.
+func (c *parentGroupsCache) parentsOf(groupPrincipal v3.Principal, search string, searchDomain string, groupScope string, config *ConfigAttributes,
+	lConn ldapv3.Client, searchAttributes []string, ttl time.Duration) ([]v3.Principal, error) {
+	key := parentGroupsKey{search: search, group: groupPrincipal.ObjectMeta.Name}
+
+	if ttl > 0 {
+		c.mu.Lock()
+		cached, ok := c.parents[key]
+		c.mu.Unlock()
+		if ok && c.now().Before(cached.expiresAt) {
+			return cached.parents, nil
+		}
+	}
+
+	parents, err := c.search(groupPrincipal, searchDomain, groupScope, config, lConn, searchAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl > 0 {
+		c.mu.Lock()
+		now := c.now()
+		c.parents[key] = cachedParentGroups{parents: parents, expiresAt: now.Add(ttl)}
+		c.sweep(now, ttl)
+		c.mu.Unlock()
+	}
+
+	return parents, nil
+}
+
+// sweep deletes the expired parent groups, at most once per ttl. It must be called with the lock held.
+func (c *parentGroupsCache) sweep(now time.Time, ttl time.Duration) {
+	if now.Sub(c.lastSweep) < ttl {
+		return
+	}
+	c.lastSweep = now
+	for key, cached := range c.parents {
+		if !now.Before(cached.expiresAt) {
+			delete(c.parents, key)
+		}
+	}
+}
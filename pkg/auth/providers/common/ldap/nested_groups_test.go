@@ -0,0 +1,215 @@
+package ldap
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ldapv3 "github.com/go-ldap/ldap/v3"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func groupPrincipal(name string) v3.Principal {
+	return v3.Principal{ObjectMeta: metav1.ObjectMeta{Name: "activedirectory_group://" + name}}
+}
+
+func principalNames(principals []v3.Principal) []string {
+	var names []string
+	for _, principal := range principals {
+		names = append(names, principal.ObjectMeta.Name)
+	}
+	return names
+}
+
+// newTestParentGroupsCache returns a cache searching the parent groups in the graph, and counting the searches per group.
+func newTestParentGroupsCache(graph map[string][]string, searches map[string]int, now *time.Time) *parentGroupsCache {
+	c := newParentGroupsCache()
+	c.now = func() time.Time {
+		return *now
+	}
+	c.search = func(group v3.Principal, _ string, _ string, _ *ConfigAttributes, _ ldapv3.Client, _ []string) ([]v3.Principal, error) {
+		searches[group.ObjectMeta.Name]++
+		var parents []v3.Principal
+		for _, parent := range graph[group.ObjectMeta.Name] {
+			parents = append(parents, groupPrincipal(parent))
+		}
+		return parents, nil
+	}
+	return c
+}
+
+func TestResolveParentGroups(t *testing.T) {
+	t.Parallel()
+
+	// a is a member of b and c, which are members of d, which is a member of e, which is a member of a.
+	graph := map[string][]string{
+		"activedirectory_group://a": {"b", "c"},
+		"activedirectory_group://b": {"d"},
+		"activedirectory_group://c": {"d"},
+		"activedirectory_group://d": {"e"},
+		"activedirectory_group://e": {"a"},
+	}
+	config := &ConfigAttributes{ProviderName: "activedirectory"}
+
+	tests := []struct {
+		desc     string
+		groups   []v3.Principal
+		maxDepth int
+		want     []string
+	}{
+		{
+			desc:   "all levels",
+			groups: []v3.Principal{groupPrincipal("a")},
+			want: []string{
+				"activedirectory_group://b",
+				"activedirectory_group://c",
+				"activedirectory_group://d",
+				"activedirectory_group://e",
+			},
+		},
+		{
+			desc:     "max depth",
+			groups:   []v3.Principal{groupPrincipal("a")},
+			maxDepth: 2,
+			want: []string{
+				"activedirectory_group://b",
+				"activedirectory_group://c",
+				"activedirectory_group://d",
+			},
+		},
+		{
+			desc:     "groups are reached at their lowest level",
+			groups:   []v3.Principal{groupPrincipal("a"), groupPrincipal("d")},
+			maxDepth: 1,
+			want: []string{
+				"activedirectory_group://b",
+				"activedirectory_group://c",
+				"activedirectory_group://e",
+			},
+		},
+		{
+			desc:   "no parents",
+			groups: []v3.Principal{groupPrincipal("f")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now()
+			c := newTestParentGroupsCache(graph, map[string]int{}, &now)
+			nested, err := c.resolve(test.groups, "dc=example,dc=com", "activedirectory_group", config, nil, nil, time.Minute, test.maxDepth)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, principalNames(nested))
+		})
+	}
+}
+
+func TestResolveParentGroupsCached(t *testing.T) {
+	t.Parallel()
+
+	graph := map[string][]string{
+		"activedirectory_group://a": {"b"},
+		"activedirectory_group://b": {"c"},
+	}
+	searches := map[string]int{}
+	now := time.Now()
+	c := newTestParentGroupsCache(graph, searches, &now)
+	config := &ConfigAttributes{ProviderName: "activedirectory"}
+	resolve := func(groups ...v3.Principal) []string {
+		nested, err := c.resolve(groups, "dc=example,dc=com", "activedirectory_group", config, nil, nil, time.Minute, 0)
+		require.NoError(t, err)
+		return principalNames(nested)
+	}
+
+	assert.Equal(t, []string{"activedirectory_group://b", "activedirectory_group://c"}, resolve(groupPrincipal("a")))
+	assert.Equal(t, map[string]int{
+		"activedirectory_group://a": 1,
+		"activedirectory_group://b": 1,
+		"activedirectory_group://c": 1,
+	}, searches)
+
+	// the parents of b are shared with other users
+	assert.Equal(t, []string{"activedirectory_group://c"}, resolve(groupPrincipal("b")))
+	assert.Equal(t, 1, searches["activedirectory_group://b"])
+
+	// only the expired groups are searched again
+	now = now.Add(30 * time.Second)
+	resolve(groupPrincipal("d"))
+	now = now.Add(31 * time.Second)
+	graph["activedirectory_group://c"] = []string{"d"}
+	assert.Equal(t, []string{"activedirectory_group://b", "activedirectory_group://c", "activedirectory_group://d"}, resolve(groupPrincipal("a")))
+	assert.Equal(t, 2, searches["activedirectory_group://a"])
+	assert.Equal(t, 1, searches["activedirectory_group://d"])
+
+	// expired groups are deleted
+	now = now.Add(2 * time.Minute)
+	resolve(groupPrincipal("e"))
+	assert.Len(t, c.parents, 1)
+}
+
+func TestResolveParentGroupsNotCached(t *testing.T) {
+	t.Parallel()
+
+	searches := map[string]int{}
+	now := time.Now()
+	c := newTestParentGroupsCache(map[string][]string{}, searches, &now)
+	config := &ConfigAttributes{ProviderName: "activedirectory"}
+
+	for range 2 {
+		_, err := c.resolve([]v3.Principal{groupPrincipal("a")}, "dc=example,dc=com", "activedirectory_group", config, nil, nil, 0, 0)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, searches["activedirectory_group://a"])
+	assert.Empty(t, c.parents)
+}
+
+func TestResolveParentGroupsError(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	c := newTestParentGroupsCache(nil, map[string]int{}, &now)
+	c.search = func(v3.Principal, string, string, *ConfigAttributes, ldapv3.Client, []string) ([]v3.Principal, error) {
+		return nil, errors.New("unavailable")
+	}
+
+	_, err := c.resolve([]v3.Principal{groupPrincipal("a")}, "dc=example,dc=com", "activedirectory_group", &ConfigAttributes{}, nil, nil, time.Minute, 0)
+	assert.ErrorContains(t, err, "unavailable")
+	assert.Empty(t, c.parents)
+}
+
+func TestSearchParentGroups(t *testing.T) {
+	t.Parallel()
+
+	config := &ConfigAttributes{
+		GroupMemberMappingAttribute: "member",
+		GroupNameAttribute:          "name",
+		GroupObjectClass:            "group",
+		ObjectClass:                 "objectClass",
+		ProviderName:                "activedirectory",
+		UserObjectClass:             "person",
+	}
+	var filter string
+	lConn := &FakeLdapConn{
+		SearchWithPagingFunc: func(searchRequest *ldapv3.SearchRequest, pagingSize uint32) (*ldapv3.SearchResult, error) {
+			filter = searchRequest.Filter
+			return &ldapv3.SearchResult{
+				Entries: []*ldapv3.Entry{
+					ldapv3.NewEntry("CN=parent,DC=example,DC=com", map[string][]string{
+						"objectClass": {"group"},
+						"name":        {"parent"},
+					}),
+				},
+			}, nil
+		},
+	}
+
+	parents, err := searchParentGroups(groupPrincipal("CN=child,DC=example,DC=com"), "DC=example,DC=com", "activedirectory_group", config, lConn, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "(&(member=CN=child,DC=example,DC=com)(objectClass=group))", filter)
+	assert.Equal(t, []string{"activedirectory_group://CN=parent,DC=example,DC=com"}, principalNames(parents))
+}
@@ -0,0 +1,238 @@
+package ldap
+
+import (
+	"crypto/x509"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	ldapv3 "github.com/go-ldap/ldap/v3"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxIdleConns is the maximum number of idle connections kept per pool.
+	maxIdleConns = 8
+	// idleTimeout is how long idle connections are kept. It is below the idle timeouts of common directory servers,
+	// e.g. 15 minutes for Active Directory, so that pooled connections are rarely closed by the server.
+	idleTimeout = 2 * time.Minute
+	// healthCheckAfter is how long a connection may stay idle before it is checked again before reuse.
+	healthCheckAfter = 30 * time.Second
+	// serverRetryInterval is how long a server which couldn't be dialed is tried after the other servers.
+	serverRetryInterval = 30 * time.Second
+)
+
+// PooledConn is a connection from a pool. Closing it returns it to the pool, after resetting its authorization state
+// with an anonymous bind, so users of the connection must bind before their operations.
+type PooledConn struct {
+	*ldapv3.Conn
+	pool     *pool
+	released sync.Once
+}
+
+// Close returns the connection to its pool.
+func (c *PooledConn) Close() {
+	c.released.Do(func() {
+		c.pool.put(c.Conn)
+	})
+}
+
+// poolKey identifies the pools by the settings of their connections. The CA pool isn't comparable, it is compared
+// separately.
+type poolKey struct {
+	servers           string
+	TLS               bool
+	startTLS          bool
+	port              int64
+	connectionTimeout int64
+}
+
+type idleConn struct {
+	conn  *ldapv3.Conn
+	since time.Time
+}
+
+// pool holds idle connections to the servers of a directory, and tracks the servers which couldn't be dialed to try
+// the other servers first.
+type pool struct {
+	servers []string
+	caPool  *x509.CertPool
+
+	// dial returns a new connection to the server.
+	dial func(server string) (*ldapv3.Conn, error)
+	// reset resets the authorization state of a connection, and checks its health.
+	reset func(conn *ldapv3.Conn) error
+	now   func() time.Time
+
+	mu        sync.Mutex
+	idle      []idleConn
+	downUntil map[string]time.Time
+	// closed is set once the pool is replaced, connections returned to it are closed.
+	closed bool
+}
+
+var (
+	poolsMu sync.Mutex
+	pools   = map[poolKey]*pool{}
+)
+
+// GetPooledConn returns a connection to one of the servers, reusing an idle connection of the pool of connections with
+// the same settings if possible. Servers are dialed in order, except for the servers which recently couldn't be dialed
+// which are tried last, so that logins keep working with little latency while a server is down.
+func GetPooledConn(servers []string, TLS, startTLS bool, port int64, connectionTimeout int64, caPool *x509.CertPool) (*PooledConn, error) {
+	if len(servers) < 1 {
+		return nil, errors.New("ldap: invalid server config. at least 1 server needs to be configured")
+	}
+
+	key := poolKey{
+		servers:           strings.Join(servers, ","),
+		TLS:               TLS,
+		startTLS:          startTLS,
+		port:              port,
+		connectionTimeout: connectionTimeout,
+	}
+
+	poolsMu.Lock()
+	p := pools[key]
+	if p == nil || !p.caPool.Equal(caPool) {
+		if p != nil {
+			// The CA certificate was replaced.
+			p.close()
+		}
+		p = newPool(servers, caPool, func(server string) (*ldapv3.Conn, error) {
+			return dialServer(server, TLS, startTLS, port, connectionTimeout, caPool)
+		})
+		pools[key] = p
+	}
+	poolsMu.Unlock()
+
+	return p.get()
+}
+
+func newPool(servers []string, caPool *x509.CertPool, dial func(server string) (*ldapv3.Conn, error)) *pool {
+	return &pool{
+		servers: slices.Clone(servers),
+		caPool:  caPool,
+		dial:    dial,
+		reset: func(conn *ldapv3.Conn) error {
+			return conn.UnauthenticatedBind("")
+		},
+		now:       time.Now,
+		downUntil: map[string]time.Time{},
+	}
+}
+
+// get returns an idle connection if one is healthy, else a new connection.
+func (p *pool) get() (*PooledConn, error) {
+	for {
+		conn, since, ok := p.takeIdle()
+		if !ok {
+			break
+		}
+		if p.now().Sub(since) > healthCheckAfter {
+			if err := p.reset(conn); err != nil {
+				logrus.Debugf("ldap: discarding unhealthy pooled connection: %v", err)
+				conn.Close()
+				continue
+			}
+		}
+		return &PooledConn{Conn: conn, pool: p}, nil
+	}
+
+	conn, err := p.dialServers()
+	if err != nil {
+		return nil, err
+	}
+	return &PooledConn{Conn: conn, pool: p}, nil
+}
+
+// takeIdle removes the most recently used idle connection from the pool, closing the expired ones.
+func (p *pool) takeIdle() (*ldapv3.Conn, time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	for len(p.idle) > 0 {
+		last := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if last.conn.IsClosing() {
+			continue
+		}
+		if now.Sub(last.since) > idleTimeout {
+			last.conn.Close()
+			continue
+		}
+		return last.conn, last.since, true
+	}
+	return nil, time.Time{}, false
+}
+
+// dialServers dials the servers, the ones which recently couldn't be dialed last, and returns the first connection.
+func (p *pool) dialServers() (*ldapv3.Conn, error) {
+	p.mu.Lock()
+	now := p.now()
+	var up, down []string
+	for _, server := range p.servers {
+		if now.Before(p.downUntil[server]) {
+			down = append(down, server)
+		} else {
+			up = append(up, server)
+		}
+	}
+	p.mu.Unlock()
+
+	var err error
+	for _, server := range append(up, down...) {
+		var conn *ldapv3.Conn
+		conn, err = p.dial(server)
+
+		p.mu.Lock()
+		if err != nil {
+			if _, wasDown := p.downUntil[server]; !wasDown {
+				logrus.Warnf("ldap: server %s is unreachable, failing over to the other servers: %v", server, err)
+			}
+			p.downUntil[server] = p.now().Add(serverRetryInterval)
+		} else {
+			delete(p.downUntil, server)
+		}
+		p.mu.Unlock()
+
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+// put returns the connection to the pool, unless it is unhealthy or the pool is full.
+func (p *pool) put(conn *ldapv3.Conn) {
+	if conn.IsClosing() {
+		return
+	}
+	if err := p.reset(conn); err != nil {
+		logrus.Debugf("ldap: closing connection which couldn't be reset: %v", err)
+		conn.Close()
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed || len(p.idle) >= maxIdleConns {
+		conn.Close()
+		return
+	}
+	p.idle = append(p.idle, idleConn{conn: conn, since: p.now()})
+}
+
+// close closes the idle connections of the pool, and the connections returned to it later.
+func (p *pool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, idle := range p.idle {
+		idle.conn.Close()
+	}
+	p.idle = nil
+	p.closed = true
+}
@@ -0,0 +1,167 @@
+package ldap
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	ldapv3 "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPool is a pool dialing connections to in-memory pipes, with a fake clock.
+type testPool struct {
+	*pool
+	now    time.Time
+	down   map[string]bool
+	dialed []string
+	resets int
+}
+
+func newTestPool(t *testing.T, servers ...string) *testPool {
+	tp := &testPool{
+		now:  time.Date(2025, 2, 5, 8, 0, 0, 0, time.UTC),
+		down: map[string]bool{},
+	}
+	tp.pool = newPool(servers, nil, func(server string) (*ldapv3.Conn, error) {
+		tp.dialed = append(tp.dialed, server)
+		if tp.down[server] {
+			return nil, errors.New("connection refused")
+		}
+		client, srv := net.Pipe()
+		t.Cleanup(func() { srv.Close() })
+		conn := ldapv3.NewConn(client, false)
+		conn.Start()
+		return conn, nil
+	})
+	tp.pool.reset = func(conn *ldapv3.Conn) error {
+		tp.resets++
+		return nil
+	}
+	tp.pool.now = func() time.Time {
+		return tp.now
+	}
+	return tp
+}
+
+func TestPoolReusesConnections(t *testing.T) {
+	p := newTestPool(t, "ldap1")
+
+	conn, err := p.get()
+	require.NoError(t, err)
+	conn.Close()
+	conn.Close() // closing twice only returns the connection once
+	assert.Len(t, p.idle, 1)
+	assert.Equal(t, 1, p.resets)
+
+	reused, err := p.get()
+	require.NoError(t, err)
+	assert.Same(t, conn.Conn, reused.Conn)
+	assert.Equal(t, []string{"ldap1"}, p.dialed)
+	// recently used connections aren't checked again
+	assert.Equal(t, 1, p.resets)
+	reused.Close()
+
+	p.now = p.now.Add(healthCheckAfter + time.Second)
+	checked, err := p.get()
+	require.NoError(t, err)
+	assert.Same(t, conn.Conn, checked.Conn)
+	assert.Equal(t, 3, p.resets)
+}
+
+func TestPoolDiscardsConnections(t *testing.T) {
+	p := newTestPool(t, "ldap1")
+
+	expired, err := p.get()
+	require.NoError(t, err)
+	expired.Close()
+	p.now = p.now.Add(idleTimeout + time.Second)
+
+	conn, err := p.get()
+	require.NoError(t, err)
+	assert.NotSame(t, expired.Conn, conn.Conn)
+	assert.True(t, expired.IsClosing())
+
+	conn.Conn.Close() // e.g. closed by the server
+	conn.Close()
+	assert.Empty(t, p.idle)
+
+	p.reset = func(conn *ldapv3.Conn) error {
+		return errors.New("unhealthy")
+	}
+	conn, err = p.get()
+	require.NoError(t, err)
+	conn.Close()
+	assert.Empty(t, p.idle)
+	assert.True(t, conn.IsClosing())
+}
+
+func TestPoolMaxIdleConns(t *testing.T) {
+	p := newTestPool(t, "ldap1")
+
+	var conns []*PooledConn
+	for range maxIdleConns + 1 {
+		conn, err := p.get()
+		require.NoError(t, err)
+		conns = append(conns, conn)
+	}
+	for _, conn := range conns {
+		conn.Close()
+	}
+	assert.Len(t, p.idle, maxIdleConns)
+	assert.True(t, conns[maxIdleConns].IsClosing())
+}
+
+func TestPoolClosed(t *testing.T) {
+	p := newTestPool(t, "ldap1")
+
+	idle, err := p.get()
+	require.NoError(t, err)
+	inUse, err := p.get()
+	require.NoError(t, err)
+	idle.Close()
+
+	p.close()
+	assert.True(t, idle.IsClosing())
+	inUse.Close()
+	assert.True(t, inUse.IsClosing())
+	assert.Empty(t, p.idle)
+}
+
+func TestPoolFailover(t *testing.T) {
+	p := newTestPool(t, "ldap1", "ldap2", "ldap3")
+	p.down["ldap1"] = true
+
+	conn, err := p.get()
+	require.NoError(t, err)
+	conn.Conn.Close()
+	assert.Equal(t, []string{"ldap1", "ldap2"}, p.dialed)
+
+	// servers which recently couldn't be dialed are tried last
+	p.dialed = nil
+	p.down["ldap2"] = true
+	_, err = p.get()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ldap2", "ldap3"}, p.dialed)
+
+	p.dialed = nil
+	p.down["ldap3"] = true
+	_, err = p.get()
+	assert.ErrorContains(t, err, "connection refused")
+	assert.Equal(t, []string{"ldap3", "ldap1", "ldap2"}, p.dialed)
+
+	// servers are tried in order again once they are back up
+	p.dialed = nil
+	p.down = map[string]bool{}
+	p.now = p.now.Add(serverRetryInterval + time.Second)
+	_, err = p.get()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ldap1"}, p.dialed)
+}
+
+func TestGetPooledConnNoServers(t *testing.T) {
+	_, err := GetPooledConn(nil, false, false, 389, 1000, nil)
+	assert.ErrorContains(t, err, "at least 1 server needs to be configured")
+}
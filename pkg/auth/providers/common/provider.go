@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rancher/norman/types"
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
@@ -47,3 +48,29 @@ type AuthProvider interface {
 	// forced. If "logout-all" is not supported by the provider do nothing and return nil.
 	Logout(apiContext *types.APIContext, token accessor.TokenAccessor) error
 }
+
+// LogoutSession identifies the login sessions ended at an identity provider.
+type LogoutSession struct {
+	// PrincipalID is the ID of the principal of the user logged out. Empty when only the session is known.
+	PrincipalID string
+	// SessionID is the ID of the session at the identity provider. Empty when all the sessions of the user end.
+	SessionID string
+}
+
+// BackChannelLogoutProvider is implemented by the auth providers supporting OIDC back-channel logout, where the
+// identity provider notifies Rancher of the sessions ended there.
+type BackChannelLogoutProvider interface {
+	// VerifyLogoutToken verifies the logout token sent by the identity provider, and returns the sessions it ends.
+	VerifyLogoutToken(ctx context.Context, rawToken string) (LogoutSession, error)
+}
+
+// ErrRefreshRejected is returned by RefetchGroupPrincipals when the identity provider rejects the refresh token of the
+// user, e.g. because the session ended there. The login sessions relying on it must end, the user must log in again.
+var ErrRefreshRejected = errors.New("refresh token rejected by the identity provider")
+
+// SessionRenewer is implemented by the auth providers confirming with the identity provider that the login session of
+// the user is still valid when refetching their group principals, e.g. by using a refresh token.
+type SessionRenewer interface {
+	// RenewsSessions returns whether refetching the group principals of users confirms their login sessions.
+	RenewsSessions() bool
+}
@@ -54,11 +54,12 @@ func NewUserManagerNoBindings(wranglerContext *wrangler.Context) (user.Manager,
 	}
 
 	return &userManager{
-		users:       wranglerContext.Mgmt.User(),
-		userIndexer: userInformer.GetIndexer(),
-		tokens:      wranglerContext.Mgmt.Token(),
-		tokenLister: wranglerContext.Mgmt.Token().Cache(),
-		rbacClient:  wranglerContext.RBAC,
+		users:         wranglerContext.Mgmt.User(),
+		userIndexer:   userInformer.GetIndexer(),
+		tokens:        wranglerContext.Mgmt.Token(),
+		tokenLister:   wranglerContext.Mgmt.Token().Cache(),
+		clusterLister: wranglerContext.Mgmt.Cluster().Cache(),
+		rbacClient:    wranglerContext.RBAC,
 	}, nil
 }
 
@@ -114,6 +115,7 @@ func NewUserManager(wranglerContext *wrangler.Context) (user.Manager, error) {
 		prtbIndexer:              prtbInformer.GetIndexer(),
 		tokens:                   wranglerContext.Mgmt.Token(),
 		tokenLister:              wranglerContext.Mgmt.Token().Cache(),
+		clusterLister:            wranglerContext.Mgmt.Cluster().Cache(),
 		globalRoleBindings:       wranglerContext.Mgmt.GlobalRoleBinding(),
 		globalRoleLister:         wranglerContext.Mgmt.GlobalRole().Cache(),
 		grbIndexer:               grbInformer.GetIndexer(),
@@ -135,6 +137,7 @@ type userManager struct {
 	prtbIndexer              cache.Indexer
 	tokenLister              wrangmgmtv3.TokenCache
 	tokens                   wrangmgmtv3.TokenController
+	clusterLister            wrangmgmtv3.ClusterCache
 	clusterRoleLister        wrangrbacv1.ClusterRoleCache
 	clusterRoleBindingLister wrangrbacv1.ClusterRoleBindingCache
 	rbacClient               wrangrbacv1.Interface
@@ -185,55 +188,71 @@ func (m *userManager) GetUser(apiContext *types.APIContext) string {
 	return apiContext.Request.Header.Get(userAuthHeader)
 }
 
-// checkis if the supplied principal can login based on the accessMode and allowed principals
-func (m *userManager) CheckAccess(accessMode string, allowedPrincipalIDs []string, userPrincipalID string, groups []v3.Principal) (bool, error) {
-	if accessMode == "unrestricted" || accessMode == "" {
+// CheckAccess checks if the supplied principal can log in based on the access mode and the allowed and denied
+// principals. Denied principals take precedence, they can't log in whatever the access mode.
+func (m *userManager) CheckAccess(accessMode string, allowedPrincipalIDs, deniedPrincipalIDs []string, userPrincipalID string, groups []v3.Principal) (bool, error) {
+	unrestricted := accessMode == "unrestricted" || accessMode == ""
+	if !unrestricted && accessMode != "required" && accessMode != "restricted" {
+		return false, errors.Errorf("Unsupported accessMode: %v", accessMode)
+	}
+	if unrestricted && len(deniedPrincipalIDs) == 0 {
 		return true, nil
 	}
 
-	if accessMode == "required" || accessMode == "restricted" {
-		user, err := m.checkCache(userPrincipalID)
-		if err != nil {
-			return false, err
-		}
+	user, err := m.checkCache(userPrincipalID)
+	if err != nil {
+		return false, err
+	}
 
-		userPrincipals := []string{userPrincipalID}
-		if user != nil {
-			for _, p := range user.PrincipalIDs {
-				if userPrincipalID != p {
-					userPrincipals = append(userPrincipals, p)
-				}
+	userPrincipals := []string{userPrincipalID}
+	if user != nil {
+		for _, p := range user.PrincipalIDs {
+			if userPrincipalID != p {
+				userPrincipals = append(userPrincipals, p)
 			}
 		}
+	}
 
-		for _, p := range userPrincipals {
-			if slice.ContainsString(allowedPrincipalIDs, p) {
-				return true, nil
-			}
-		}
+	if containsPrincipal(deniedPrincipalIDs, userPrincipals, groups) {
+		return false, nil
+	}
+	if unrestricted {
+		return true, nil
+	}
 
+	if containsPrincipal(allowedPrincipalIDs, userPrincipals, groups) {
+		return true, nil
+	}
+
+	if accessMode == "restricted" {
+		// check if any of the user's principals are in a project or cluster
+		var userNameAndPrincipals []string
 		for _, g := range groups {
-			if slice.ContainsString(allowedPrincipalIDs, g.Name) {
-				return true, nil
-			}
+			userNameAndPrincipals = append(userNameAndPrincipals, g.Name)
+		}
+		if user != nil {
+			userNameAndPrincipals = append(userNameAndPrincipals, user.Name)
+			userNameAndPrincipals = append(userNameAndPrincipals, userPrincipals...)
 		}
 
-		if accessMode == "restricted" {
-			// check if any of the user's principals are in a project or cluster
-			var userNameAndPrincipals []string
-			for _, g := range groups {
-				userNameAndPrincipals = append(userNameAndPrincipals, g.Name)
-			}
-			if user != nil {
-				userNameAndPrincipals = append(userNameAndPrincipals, user.Name)
-				userNameAndPrincipals = append(userNameAndPrincipals, userPrincipals...)
-			}
+		return m.userExistsInClusterOrProject(userNameAndPrincipals)
+	}
+	return false, nil
+}
 
-			return m.userExistsInClusterOrProject(userNameAndPrincipals)
+// containsPrincipal checks if any of the user principals or groups is in the principal IDs.
+func containsPrincipal(principalIDs []string, userPrincipals []string, groups []v3.Principal) bool {
+	for _, p := range userPrincipals {
+		if slice.ContainsString(principalIDs, p) {
+			return true
+		}
+	}
+	for _, g := range groups {
+		if slice.ContainsString(principalIDs, g.Name) {
+			return true
 		}
-		return false, nil
 	}
-	return false, errors.Errorf("Unsupported accessMode: %v", accessMode)
+	return false
 }
 
 // creates tokens with 0 ttl and returns token in 'token.Name:token.Token' format
@@ -323,9 +342,9 @@ func (m *userManager) EnsureClusterToken(clusterName string, input user.TokenInp
 
 // newTokenForKubeconfig creates a new token for a generated kubeconfig.
 func (m *userManager) newTokenForKubeconfig(clusterName, tokenName, description, kind, userName string, userPrincipal v3.Principal) (string, error) {
-	tokenTTL, err := tokens.GetKubeconfigDefaultTokenTTLInMilliSeconds()
+	tokenTTL, err := m.kubeconfigTokenTTL(clusterName)
 	if err != nil {
-		return "", fmt.Errorf("failed to get default token TTL: %w", err)
+		return "", err
 	}
 
 	input := user.TokenInput{
@@ -347,6 +366,27 @@ func (m *userManager) newTokenForKubeconfig(clusterName, tokenName, description,
 	return tokenKey, nil
 }
 
+// kubeconfigTokenTTL returns the TTL of a token for a kubeconfig of the cluster: the kubeconfig token TTL of the cluster,
+// or the default TTL for a kubeconfig of all clusters or of a cluster which doesn't exist.
+func (m *userManager) kubeconfigTokenTTL(clusterName string) (*int64, error) {
+	var annotations map[string]string
+	if clusterName != "" {
+		cluster, err := m.clusterLister.Get(clusterName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get cluster %s: %w", clusterName, err)
+		}
+		if cluster != nil {
+			annotations = cluster.Annotations
+		}
+	}
+
+	tokenTTL, err := tokens.GetClusterKubeconfigTokenTTLInMilliSeconds(annotations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token TTL: %w", err)
+	}
+	return tokenTTL, nil
+}
+
 // GetKubeconfigToken creates a new token for use in a kubeconfig generated through the CLI.
 func (m *userManager) GetKubeconfigToken(clusterName, tokenName, description, kind, userName string, userPrincipal v3.Principal) (*v3.Token, string, error) {
 	fullCreatedToken, err := m.newTokenForKubeconfig(clusterName, tokenName, description, kind, userName, userPrincipal)
@@ -149,6 +149,7 @@ func TestCheckAccess(t *testing.T) {
 		name                string
 		accessMode          string
 		allowedPrincipalIDs []string
+		deniedPrincipalIDs  []string
 		userPrincipalID     string
 		groups              []v3.Principal
 		user                *v3.User
@@ -193,6 +194,45 @@ func TestCheckAccess(t *testing.T) {
 			expectedResult: false,
 			expectedError:  nil,
 		},
+		{
+			name:               "Unrestricted access, principal denied",
+			accessMode:         "unrestricted",
+			deniedPrincipalIDs: []string{"local://user"},
+			userPrincipalID:    "local://user",
+			expectedResult:     false,
+			expectedError:      nil,
+		},
+		{
+			name:               "Unrestricted access, no matching denied principal",
+			accessMode:         "unrestricted",
+			deniedPrincipalIDs: []string{"github://group2"},
+			userPrincipalID:    "local://user",
+			groups: []v3.Principal{
+				{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "github://group1",
+					},
+				},
+			},
+			expectedResult: true,
+			expectedError:  nil,
+		},
+		{
+			name:                "Required access, principal allowed but group denied",
+			accessMode:          "required",
+			allowedPrincipalIDs: []string{"local://user"},
+			deniedPrincipalIDs:  []string{"github://group1"},
+			userPrincipalID:     "local://user",
+			groups: []v3.Principal{
+				{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "github://group1",
+					},
+				},
+			},
+			expectedResult: false,
+			expectedError:  nil,
+		},
 		{
 			name:                "Unsupported accessMode",
 			accessMode:          "unknown",
@@ -223,7 +263,7 @@ func TestCheckAccess(t *testing.T) {
 				return test.user, test.userErr
 			}).AnyTimes()
 
-			result, err := um.CheckAccess(test.accessMode, test.allowedPrincipalIDs, test.userPrincipalID, test.groups)
+			result, err := um.CheckAccess(test.accessMode, test.allowedPrincipalIDs, test.deniedPrincipalIDs, test.userPrincipalID, test.groups)
 
 			if test.expectedError != nil {
 				assert.EqualError(t, err, test.expectedError.Error())
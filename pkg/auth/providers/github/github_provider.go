@@ -238,7 +238,7 @@ func (g *ghProvider) LoginUser(host string, githubCredential *v32.GithubLogin, c
 		testAllowedPrincipals = append(testAllowedPrincipals, userPrincipal.Name)
 	}
 
-	allowed, err := g.userMGR.CheckAccess(config.AccessMode, testAllowedPrincipals, userPrincipal.Name, groupPrincipals)
+	allowed, err := g.userMGR.CheckAccess(config.AccessMode, testAllowedPrincipals, config.DeniedPrincipalIDs, userPrincipal.Name, groupPrincipals)
 	if err != nil {
 		return v3.Principal{}, nil, "", err
 	}
@@ -417,7 +417,7 @@ func (g *ghProvider) CanAccessWithGroupProviders(userPrincipalID string, groupPr
 		logrus.Errorf("Error fetching github config: %v", err)
 		return false, err
 	}
-	allowed, err := g.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipalID, groupPrincipals)
+	allowed, err := g.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipalID, groupPrincipals)
 	if err != nil {
 		return false, err
 	}
@@ -112,7 +112,7 @@ func (g *googleOauthProvider) loginUser(c context.Context, googleOAuthCredential
 	}
 
 	logrus.Debugf("[Google OAuth] loginuser: Checking user's access to Rancher")
-	allowed, err := g.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipal.Name, groupPrincipals)
+	allowed, err := g.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipal.Name, groupPrincipals)
 	if err != nil {
 		return userPrincipal, groupPrincipals, "", err
 	}
@@ -293,7 +293,7 @@ func (g *googleOauthProvider) CanAccessWithGroupProviders(userPrincipalID string
 		logrus.Errorf("Error fetching google OAuth config: %v", err)
 		return false, err
 	}
-	allowed, err := g.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipalID, groupPrincipals)
+	allowed, err := g.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipalID, groupPrincipals)
 	if err != nil {
 		return false, err
 	}
@@ -2,7 +2,6 @@
 
 import (
 	"context"
-	"encoding/json"
 	"reflect"
 	"strings"
 
@@ -157,10 +156,10 @@ func (k *keyCloakOIDCProvider) GetPrincipal(principalID string, token accessor.T
 }
 
 func (k *keyCloakOIDCProvider) getRefreshAndUpdateToken(ctx context.Context, oauthConfig oauth2.Config, token accessor.TokenAccessor) (*oauth2.Token, error) {
-	var oauthToken *oauth2.Token
 	storedOauthToken, err := k.TokenMGR.GetSecret(token.GetUserID(), token.GetAuthProvider(), []accessor.TokenAccessor{token})
-	if err := json.Unmarshal([]byte(storedOauthToken), &oauthToken); err != nil {
-		return oauthToken, err
+	oauthToken, decryptErr := k.DecryptToken(storedOauthToken)
+	if decryptErr != nil {
+		return oauthToken, decryptErr
 	}
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -180,7 +179,9 @@ func (k *keyCloakOIDCProvider) getRefreshAndUpdateToken(ctx context.Context, oau
 	}
 
 	if !reflect.DeepEqual(oauthToken, reusedToken) {
-		k.UpdateToken(reusedToken, token.GetUserID())
+		if err := k.UpdateToken(reusedToken, token.GetUserID()); err != nil {
+			logrus.Warnf("[generic oidc] RefeshAndUpdateToken: failed to save refreshed token of user %s: %v", token.GetUserID(), err)
+		}
 	}
 	return reusedToken, nil
 }
@@ -101,7 +101,7 @@ func (p *ldapProvider) loginUser(lConn ldapv3.Client, credentials *v3.BasicLogin
 		return v3.Principal{}, nil, err
 	}
 
-	allowed, err := p.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipal.Name, groupPrincipals)
+	allowed, err := p.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipal.Name, groupPrincipals)
 	if err != nil {
 		return v3.Principal{}, nil, err
 	}
@@ -122,7 +122,6 @@ func (p *ldapProvider) getPrincipalsFromSearchResult(result *ldapv3.SearchResult
 		freeipaNonEntrydnApproach bool
 	)
 
-	groupMap := make(map[string]bool)
 	entry := result.Entries[0]
 	userAttributes := entry.Attributes
 
@@ -244,11 +243,9 @@ func (p *ldapProvider) getPrincipalsFromSearchResult(result *ldapv3.SearchResult
 		}
 		searchAttributes := []string{config.GroupMemberUserAttribute, config.GroupMemberMappingAttribute, ObjectClass, config.GroupObjectClass, config.UserLoginAttribute,
 			config.GroupNameAttribute, config.GroupSearchAttribute}
-		for _, groupPrincipal := range groupPrincipals {
-			err = ldap.GatherParentGroups(groupPrincipal, searchDomain, groupScope, &commonConfig, lConn, groupMap, &nestedGroupPrincipals, searchAttributes)
-			if err != nil {
-				return userPrincipal, groupPrincipals, nil
-			}
+		nestedGroupPrincipals, err = ldap.ResolveParentGroups(groupPrincipals, searchDomain, groupScope, &commonConfig, lConn, searchAttributes)
+		if err != nil {
+			return userPrincipal, groupPrincipals, nil
 		}
 		nonDupGroupPrincipals = ldap.FindNonDuplicateBetweenGroupPrincipals(nestedGroupPrincipals, groupPrincipals, []v3.Principal{})
 		groupPrincipals = append(groupPrincipals, nonDupGroupPrincipals...)
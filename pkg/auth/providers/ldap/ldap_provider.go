@@ -58,7 +58,7 @@ func (e ErrorNotConfigured) Error() string {
 
 type userManager interface {
 	SetPrincipalOnCurrentUser(apiContext *types.APIContext, principal v3.Principal) (*v3.User, error)
-	CheckAccess(accessMode string, allowedPrincipalIDs []string, userPrincipalID string, groups []v3.Principal) (bool, error)
+	CheckAccess(accessMode string, allowedPrincipalIDs, deniedPrincipalIDs []string, userPrincipalID string, groups []v3.Principal) (bool, error)
 }
 
 type tokenManager interface {
@@ -307,7 +307,7 @@ func (p *ldapProvider) CanAccessWithGroupProviders(userPrincipalID string, group
 		logrus.Errorf("Error fetching ldap config: %v", err)
 		return false, err
 	}
-	allowed, err := p.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipalID, groupPrincipals)
+	allowed, err := p.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipalID, groupPrincipals)
 	if err != nil {
 		return false, err
 	}
@@ -4,6 +4,7 @@
 	"context"
 	"fmt"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/pkg/errors"
@@ -11,10 +12,12 @@
 	"github.com/rancher/norman/types"
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/auth/accessor"
+	"github.com/rancher/rancher/pkg/auth/challenge"
 	"github.com/rancher/rancher/pkg/auth/providers/common"
 	"github.com/rancher/rancher/pkg/auth/providers/local/pbkdf2"
 	"github.com/rancher/rancher/pkg/auth/tokens"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/bcrypt"
@@ -44,15 +47,18 @@ type PasswordVerifier interface {
 
 type Provider struct {
 	userLister   v3.UserLister
+	userClient   v3.UserInterface
 	groupLister  v3.GroupLister
 	userIndexer  cache.Indexer
 	gmIndexer    cache.Indexer
 	groupIndexer cache.Indexer
 	tokenMGR     *tokens.Manager
 	pwdVerifier  PasswordVerifier
+	throttle     *loginThrottle
 }
 
-func Configure(ctx context.Context, mgmtCtx *config.ScaledContext, tokenMGR *tokens.Manager) common.AuthProvider {
+// Configure returns the local provider. Its failed logins are recorded by risk, shared with the other providers.
+func Configure(ctx context.Context, mgmtCtx *config.ScaledContext, tokenMGR *tokens.Manager, risk *challenge.Detector) common.AuthProvider {
 	informer := mgmtCtx.Management.Users("").Controller().Informer()
 	indexers := map[string]cache.IndexFunc{userNameIndex: userNameIndexer, userSearchIndex: userSearchIndexer}
 	informer.AddIndexers(indexers)
@@ -71,8 +77,10 @@ func Configure(ctx context.Context, mgmtCtx *config.ScaledContext, tokenMGR *tok
 		groupLister:  mgmtCtx.Management.Groups("").Controller().Lister(),
 		groupIndexer: gInformer.GetIndexer(),
 		userLister:   mgmtCtx.Management.Users("").Controller().Lister(),
+		userClient:   mgmtCtx.Management.Users(""),
 		tokenMGR:     tokenMGR,
 		pwdVerifier:  pbkdf2.New(mgmtCtx.Wrangler.Core.Secret().Cache(), mgmtCtx.Wrangler.Core.Secret()),
+		throttle:     newLoginThrottle(risk),
 	}
 	return l
 }
@@ -128,20 +136,42 @@ func (l *Provider) AuthenticateUser(ctx context.Context, input interface{}) (v3.
 	pwd := localInput.Password
 
 	authFailedError := httperror.NewAPIError(httperror.Unauthorized, "authentication failed")
+	clientAddr := clientAddress(ctx)
 	user, err := l.getUser(username)
 	if err != nil {
 		// If the user don't exist the password is evaluated
 		// to avoid user enumeration via timing attack (time based side-channel).
 		bcrypt.CompareHashAndPassword(invalidHash, []byte(pwd))
 		logrus.Debugf("Get User [%s] failed during Authentication: %v", username, err)
+		// Failed logins of unknown users are slowed down as well, for the same reason.
+		l.throttle.failed(username, clientAddr)
+		return v3.Principal{}, nil, "", authFailedError
+	}
+
+	until, locked := lockedUntil(user, l.throttle.now())
+	if locked {
+		// The password is evaluated and the response delayed as for a failed login, for the lockout not to be told
+		// apart from a wrong password by the response time.
+		bcrypt.CompareHashAndPassword(invalidHash, []byte(pwd))
+		logrus.Debugf("Authentication failed for User [%s]: locked out until %s", username, until.Format(time.RFC3339))
+		l.throttle.rejected(username, clientAddr)
 		return v3.Principal{}, nil, "", authFailedError
 	}
 
 	if err := l.pwdVerifier.VerifyPassword(user, pwd); err != nil {
 		logrus.Debugf("Authentication failed for User [%s]: %v", username, err)
+		failures := l.throttle.failed(username, clientAddr)
+		if maxFailures := settings.LocalAuthMaxFailedLogins.GetInt(); maxFailures > 0 && failures >= maxFailures {
+			l.lockOut(user)
+		}
 		return v3.Principal{}, nil, "", authFailedError
 	}
 
+	l.throttle.succeeded(username)
+	if !until.IsZero() {
+		l.clearLockout(user)
+	}
+
 	principalID := getLocalPrincipalID(user)
 	userPrincipal := l.toPrincipal("user", user.DisplayName, user.Username, principalID, nil)
 	userPrincipal.Me = true
@@ -261,6 +291,35 @@ func (l *Provider) SearchPrincipalsDedupe(searchKey, principalType string, token
 	return principals, nil
 }
 
+// DedupePrincipals removes the users linked to the principals from other providers from the principals found by
+// SearchPrincipals, like SearchPrincipalsDedupe does, for searches run concurrently with the other providers.
+func (l *Provider) DedupePrincipals(principals []v3.Principal, principalsFromOtherProviders []v3.Principal) []v3.Principal {
+	if len(principalsFromOtherProviders) == 0 {
+		return principals
+	}
+	fromOtherProviders := map[string]bool{}
+	for _, p := range principalsFromOtherProviders {
+		fromOtherProviders[p.Name] = true
+	}
+
+	var deduped []v3.Principal
+Principal:
+	for _, principal := range principals {
+		if principal.PrincipalType == "user" {
+			user, err := l.userLister.Get("", strings.TrimPrefix(principal.Name, Name+"://"))
+			if err == nil && user != nil {
+				for _, p := range user.PrincipalIDs {
+					if fromOtherProviders[p] {
+						continue Principal
+					}
+				}
+			}
+		}
+		deduped = append(deduped, principal)
+	}
+	return deduped
+}
+
 func (l *Provider) toPrincipal(principalType, displayName, loginName, id string, token accessor.TokenAccessor) v3.Principal {
 	if displayName == "" {
 		displayName = loginName
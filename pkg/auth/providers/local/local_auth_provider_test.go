@@ -189,6 +189,40 @@ func TestProviderSearchPrincipal(t *testing.T) {
 	}
 }
 
+func TestProviderDedupePrincipals(t *testing.T) {
+	testUsers := []*v3.User{
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "u-12345"},
+			Username:     "test",
+			PrincipalIDs: []string{"local://u-12345", "activedirectory_user://CN=test"},
+		},
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "u-23456"},
+			Username:     "other",
+			PrincipalIDs: []string{"local://u-23456"},
+		},
+	}
+	provider := Provider{
+		userLister: fakeUserLister{users: testUsers},
+	}
+
+	principals := []v3.Principal{
+		{ObjectMeta: metav1.ObjectMeta{Name: "local://u-12345"}, PrincipalType: "user"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "local://u-23456"}, PrincipalType: "user"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "local://g-12345"}, PrincipalType: "group"},
+	}
+	fromOtherProviders := []v3.Principal{
+		{ObjectMeta: metav1.ObjectMeta{Name: "activedirectory_user://CN=test"}, PrincipalType: "user"},
+	}
+
+	var names []string
+	for _, p := range provider.DedupePrincipals(principals, fromOtherProviders) {
+		names = append(names, p.Name)
+	}
+	require.Equal(t, []string{"local://u-23456", "local://g-12345"}, names)
+	require.Equal(t, principals, provider.DedupePrincipals(principals, nil))
+}
+
 func TestUserSearchIndexer(t *testing.T) {
 	indexerTests := []struct {
 		user        *v3.User
@@ -287,6 +321,11 @@ func (f fakeUserLister) List(namespace string, selector labels.Selector) ([]*v3.
 	return f.users, nil
 }
 func (f fakeUserLister) Get(namespace, name string) (*v3.User, error) {
+	for _, user := range f.users {
+		if user.Name == name {
+			return user, nil
+		}
+	}
 	return nil, nil
 }
 
@@ -0,0 +1,128 @@
+package local
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rancher/rancher/pkg/auth/challenge"
+	"github.com/rancher/rancher/pkg/auth/util"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// LockedUntilAnnotation is set on a local user locked out after too many failed logins, to the time the lockout
+	// ends. Admins unlock the user before that by removing it, e.g. with the unlock action of the user.
+	LockedUntilAnnotation = "auth.cattle.io/locked-until"
+
+	// failedLoginBaseDelay is the delay of the response to a first failed login, doubled with every consecutive one.
+	failedLoginBaseDelay = 250 * time.Millisecond
+	// failedLoginMaxDelay bounds the delay of the responses to failed logins.
+	failedLoginMaxDelay = 8 * time.Second
+)
+
+// loginThrottle slows down the responses to failed logins according to the consecutive failed logins by username
+// and by client address, tracked by the detector of the risk signals of logins shared with the other providers.
+// Lockouts are recorded on the users.
+type loginThrottle struct {
+	risk  *challenge.Detector
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newLoginThrottle(risk *challenge.Detector) *loginThrottle {
+	return &loginThrottle{
+		risk:  risk,
+		now:   time.Now,
+		sleep: time.Sleep,
+	}
+}
+
+// failed records a failed login with the username from the client address, delays the response, and returns the
+// number of consecutive failed logins with the username.
+func (t *loginThrottle) failed(username, clientAddr string) int {
+	userFailures, addrFailures := t.risk.Failed(username, clientAddr)
+	t.sleep(failedLoginDelay(max(userFailures, addrFailures)))
+	return userFailures
+}
+
+// rejected records a login with the username from the client address rejected without checking the password, e.g.
+// as the user is locked out, and delays the response as for a failed login. Unlike failed logins, it doesn't count
+// towards the lockout of the user, which would otherwise be extended by each attempt.
+func (t *loginThrottle) rejected(username, clientAddr string) {
+	userFailures, _ := t.risk.FailedLogins(username, "")
+	_, addrFailures := t.risk.Failed("", clientAddr)
+	t.sleep(failedLoginDelay(max(userFailures+1, addrFailures)))
+}
+
+// succeeded forgets the failed logins with the username. Those of the client address are kept, a client cannot
+// clear them by logging in with another account.
+func (t *loginThrottle) succeeded(username string) {
+	t.risk.ForgetFailures(username)
+}
+
+// failedLoginDelay returns the delay of the response to a failed login after the given number of consecutive ones.
+func failedLoginDelay(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	delay := failedLoginBaseDelay
+	for i := 1; i < failures && delay < failedLoginMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, failedLoginMaxDelay)
+}
+
+// lockedUntil returns the end of the lockout of the user, and whether it is still locked out at the given time.
+func lockedUntil(user *v3.User, now time.Time) (time.Time, bool) {
+	value, ok := user.Annotations[LockedUntilAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		logrus.Warnf("Ignoring invalid %s annotation of user %s: %v", LockedUntilAnnotation, user.Name, err)
+		return time.Time{}, false
+	}
+	return until, now.Before(until)
+}
+
+// lockOut locks the user out for local-auth-lockout-minutes after too many failed logins.
+func (l *Provider) lockOut(user *v3.User) {
+	until := l.throttle.now().Add(time.Duration(settings.LocalAuthLockoutMinutes.GetInt()) * time.Minute).UTC()
+
+	user = user.DeepCopy()
+	if user.Annotations == nil {
+		user.Annotations = map[string]string{}
+	}
+	user.Annotations[LockedUntilAnnotation] = until.Format(time.RFC3339)
+	if _, err := l.userClient.Update(user); err != nil {
+		logrus.Errorf("Failed to lock out user %s after too many failed logins: %v", user.Name, err)
+		return
+	}
+
+	// The user gets a fresh count of attempts once unlocked.
+	l.throttle.succeeded(user.Username)
+	logrus.Infof("Locked out user %s until %s after too many failed logins", user.Name, until.Format(time.RFC3339))
+}
+
+// clearLockout removes the expired lockout of the user.
+func (l *Provider) clearLockout(user *v3.User) {
+	user = user.DeepCopy()
+	delete(user.Annotations, LockedUntilAnnotation)
+	if _, err := l.userClient.Update(user); err != nil {
+		logrus.Errorf("Failed to clear the expired lockout of user %s: %v", user.Name, err)
+	}
+}
+
+// clientAddress returns the address of the client of the login request, if known. Behind a proxy, e.g. the ingress
+// controller, it's the address forwarded by the proxy if the proxy is trusted, see [util.ClientIP].
+func clientAddress(ctx context.Context) string {
+	req, ok := ctx.Value(util.RequestKey).(*http.Request)
+	if !ok {
+		return ""
+	}
+	return util.ClientIP(req)
+}
@@ -0,0 +1,144 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/challenge"
+	"github.com/rancher/rancher/pkg/auth/util"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+type fakePasswordVerifier struct {
+	password string
+}
+
+func (f fakePasswordVerifier) VerifyPassword(_ *v3.User, password string) error {
+	if password != f.password {
+		return errors.New("invalid password")
+	}
+	return nil
+}
+
+func TestFailedLoginDelay(t *testing.T) {
+	assert.Equal(t, time.Duration(0), failedLoginDelay(0))
+	assert.Equal(t, 250*time.Millisecond, failedLoginDelay(1))
+	assert.Equal(t, 500*time.Millisecond, failedLoginDelay(2))
+	assert.Equal(t, 4*time.Second, failedLoginDelay(5))
+	assert.Equal(t, 8*time.Second, failedLoginDelay(6))
+	assert.Equal(t, 8*time.Second, failedLoginDelay(1000))
+}
+
+func TestLoginThrottle(t *testing.T) {
+	var delays []time.Duration
+	risk := challenge.NewDetector()
+	throttle := newLoginThrottle(risk)
+	throttle.sleep = func(d time.Duration) { delays = append(delays, d) }
+
+	assert.Equal(t, 1, throttle.failed("alice", "10.0.0.1"))
+	assert.Equal(t, 2, throttle.failed("alice", "10.0.0.1"))
+	// Another user from the same address is slowed down as much.
+	assert.Equal(t, 1, throttle.failed("bob", "10.0.0.1"))
+	assert.Equal(t, []time.Duration{250 * time.Millisecond, 500 * time.Millisecond, time.Second}, delays)
+	// The failed logins are those the risk signals of the logins are detected from.
+	assert.Equal(t, []challenge.Signal{challenge.SignalFailedLogins}, risk.Signals("", "github_user://1", "10.0.0.1"))
+
+	throttle.succeeded("alice")
+	assert.Equal(t, 1, throttle.failed("alice", "10.0.0.2"))
+}
+
+func TestLockedUntil(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	user := func(value string) *v3.User {
+		return &v3.User{ObjectMeta: metav1.ObjectMeta{
+			Name:        "u-12345",
+			Annotations: map[string]string{LockedUntilAnnotation: value},
+		}}
+	}
+
+	_, locked := lockedUntil(&v3.User{}, now)
+	assert.False(t, locked)
+
+	until, locked := lockedUntil(user("2025-03-01T12:15:00Z"), now)
+	assert.True(t, locked)
+	assert.Equal(t, now.Add(15*time.Minute), until)
+
+	until, locked = lockedUntil(user("2025-03-01T11:45:00Z"), now)
+	assert.False(t, locked)
+	assert.False(t, until.IsZero())
+
+	_, locked = lockedUntil(user("invalid"), now)
+	assert.False(t, locked)
+}
+
+func TestClientAddress(t *testing.T) {
+	req := &http.Request{RemoteAddr: "10.0.0.1:34567", Header: http.Header{"X-Forwarded-For": {"203.0.113.7"}}}
+	assert.Equal(t, "10.0.0.1", clientAddress(context.WithValue(context.Background(), util.RequestKey, req)))
+	assert.Equal(t, "", clientAddress(context.Background()))
+
+	// Behind a trusted proxy, failed logins are tracked by the address of the client rather than of the proxy.
+	require.NoError(t, settings.TrustedProxyCIDRs.Set("10.0.0.0/8"))
+	t.Cleanup(func() { settings.TrustedProxyCIDRs.Set("") })
+	assert.Equal(t, "203.0.113.7", clientAddress(context.WithValue(context.Background(), util.RequestKey, req)))
+}
+
+func TestAuthenticateUserLockout(t *testing.T) {
+	require.NoError(t, settings.LocalAuthMaxFailedLogins.Set("3"))
+	t.Cleanup(func() { settings.LocalAuthMaxFailedLogins.Set("0") })
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	user := &v3.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "u-12345"},
+		Username:   "alice",
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{userNameIndex: userNameIndexer})
+	require.NoError(t, indexer.Add(user))
+
+	var updated *v3.User
+	var delays []time.Duration
+	throttle := newLoginThrottle(challenge.NewDetector())
+	throttle.now = func() time.Time { return now }
+	throttle.sleep = func(d time.Duration) { delays = append(delays, d) }
+	provider := &Provider{
+		userIndexer: indexer,
+		userClient: &fakes.UserInterfaceMock{
+			UpdateFunc: func(user *v3.User) (*v3.User, error) {
+				updated = user
+				return user, nil
+			},
+		},
+		pwdVerifier: fakePasswordVerifier{password: "secret"},
+		throttle:    throttle,
+	}
+
+	login := &v32.BasicLogin{Username: "alice", Password: "wrong"}
+	for range 2 {
+		_, _, _, err := provider.AuthenticateUser(context.Background(), login)
+		require.Error(t, err)
+	}
+	assert.Nil(t, updated)
+
+	_, _, _, err := provider.AuthenticateUser(context.Background(), login)
+	require.Error(t, err)
+	require.NotNil(t, updated)
+	assert.Equal(t, "2025-03-01T12:15:00Z", updated.Annotations[LockedUntilAnnotation])
+
+	// The right password is rejected while locked out, as slowly as a wrong one, without extending the lockout.
+	require.NoError(t, indexer.Update(updated))
+	updated = nil
+	delays = nil
+	_, _, _, err = provider.AuthenticateUser(context.Background(), &v32.BasicLogin{Username: "alice", Password: "secret"})
+	require.Error(t, err)
+	assert.Equal(t, []time.Duration{failedLoginBaseDelay}, delays)
+	assert.Nil(t, updated)
+}
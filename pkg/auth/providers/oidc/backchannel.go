@@ -0,0 +1,80 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+)
+
+const (
+	// SessionIDExtraInfoKey is the key of the extra info of the user principal holding the ID of the session at the
+	// identity provider the principal logged in with, i.e. the sid claim of the ID token. It ties the login sessions
+	// of Rancher to the session at the identity provider, for back-channel logout.
+	SessionIDExtraInfoKey = "oidc-session-id"
+
+	// backChannelLogoutEvent is the event member of the events claim of logout tokens.
+	backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+)
+
+// logoutTokenClaims are the claims of a logout token, see https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken.
+type logoutTokenClaims struct {
+	Subject   string         `json:"sub"`
+	SessionID string         `json:"sid"`
+	Events    map[string]any `json:"events"`
+	Nonce     *string        `json:"nonce"`
+}
+
+// VerifyLogoutToken implements [common.BackChannelLogoutProvider]. It verifies the logout token as an ID token of the
+// client, and the claims specific to logout tokens.
+func (o *OpenIDCProvider) VerifyLogoutToken(ctx context.Context, rawToken string) (common.LogoutSession, error) {
+	config, err := o.GetOIDCConfig()
+	if err != nil {
+		return common.LogoutSession{}, err
+	}
+	if !config.Enabled {
+		return common.LogoutSession{}, fmt.Errorf("provider %s is disabled", o.Name)
+	}
+
+	updatedContext, err := AddCertKeyToContext(ctx, config.Certificate, config.PrivateKey)
+	if err != nil {
+		return common.LogoutSession{}, err
+	}
+	provider, err := o.getOIDCProvider(updatedContext, config)
+	if err != nil {
+		return common.LogoutSession{}, err
+	}
+
+	token, err := provider.Verifier(&oidc.Config{ClientID: config.ClientID}).Verify(updatedContext, rawToken)
+	if err != nil {
+		return common.LogoutSession{}, fmt.Errorf("failed to verify logout token: %w", err)
+	}
+
+	var claims logoutTokenClaims
+	if err := token.Claims(&claims); err != nil {
+		return common.LogoutSession{}, fmt.Errorf("failed to parse logout token claims: %w", err)
+	}
+	return o.logoutSession(claims)
+}
+
+// logoutSession returns the login session ended by the logout token with the given claims.
+func (o *OpenIDCProvider) logoutSession(claims logoutTokenClaims) (common.LogoutSession, error) {
+	if _, ok := claims.Events[backChannelLogoutEvent]; !ok {
+		return common.LogoutSession{}, errors.New("logout token is missing the back-channel logout event")
+	}
+	// A nonce distinguishes ID tokens from logout tokens.
+	if claims.Nonce != nil {
+		return common.LogoutSession{}, errors.New("logout token must not have a nonce")
+	}
+	if claims.Subject == "" && claims.SessionID == "" {
+		return common.LogoutSession{}, errors.New("logout token is missing both sub and sid")
+	}
+
+	session := common.LogoutSession{SessionID: claims.SessionID}
+	if claims.Subject != "" {
+		session.PrincipalID = o.Name + "_" + UserType + "://" + claims.Subject
+	}
+	return session, nil
+}
@@ -0,0 +1,65 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogoutSession(t *testing.T) {
+	events := map[string]any{backChannelLogoutEvent: map[string]any{}}
+	nonce := "n-0S6_WzA2Mj"
+
+	tests := []struct {
+		name    string
+		claims  logoutTokenClaims
+		want    common.LogoutSession
+		wantErr string
+	}{
+		{
+			name:   "sub and sid",
+			claims: logoutTokenClaims{Subject: "248289761001", SessionID: "08a5019c", Events: events},
+			want:   common.LogoutSession{PrincipalID: "genericoidc_user://248289761001", SessionID: "08a5019c"},
+		},
+		{
+			name:   "sid only",
+			claims: logoutTokenClaims{SessionID: "08a5019c", Events: events},
+			want:   common.LogoutSession{SessionID: "08a5019c"},
+		},
+		{
+			name:   "sub only",
+			claims: logoutTokenClaims{Subject: "248289761001", Events: events},
+			want:   common.LogoutSession{PrincipalID: "genericoidc_user://248289761001"},
+		},
+		{
+			name:    "missing event",
+			claims:  logoutTokenClaims{Subject: "248289761001", Events: map[string]any{"other": map[string]any{}}},
+			wantErr: "back-channel logout event",
+		},
+		{
+			name:    "nonce",
+			claims:  logoutTokenClaims{Subject: "248289761001", Events: events, Nonce: &nonce},
+			wantErr: "nonce",
+		},
+		{
+			name:    "missing sub and sid",
+			claims:  logoutTokenClaims{Events: events},
+			wantErr: "missing both sub and sid",
+		},
+	}
+
+	o := &OpenIDCProvider{Name: "genericoidc"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session, err := o.logoutSession(tt.claims)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, session)
+		})
+	}
+}
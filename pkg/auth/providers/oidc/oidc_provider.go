@@ -2,7 +2,6 @@
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"reflect"
 	"slices"
@@ -65,6 +64,7 @@ type ClaimInfo struct {
 	Groups            []string `json:"groups"`
 	FullGroupPath     []string `json:"full_group_path"`
 	ACR               string   `json:"acr"`
+	SessionID         string   `json:"sid"`
 }
 
 func Configure(ctx context.Context, mgmtCtx *config.ScaledContext, userMGR user.Manager, tokenMGR *tokens.Manager) common.AuthProvider {
@@ -126,7 +126,7 @@ func (o *OpenIDCProvider) LoginUser(ctx context.Context, oauthLoginInfo *v32.OID
 	groupPrincipals = o.getGroupsFromClaimInfo(userClaimInfo)
 
 	logrus.Debugf("[generic oidc] loginuser: checking user's access to rancher")
-	allowed, err := o.UserMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipal.Name, groupPrincipals)
+	allowed, err := o.UserMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipal.Name, groupPrincipals)
 	if err != nil {
 		return userPrincipal, groupPrincipals, "", userClaimInfo, err
 	}
@@ -135,11 +135,11 @@ func (o *OpenIDCProvider) LoginUser(ctx context.Context, oauthLoginInfo *v32.OID
 	}
 	// save entire oauthToken because it contains refresh_token and token expiry time
 	// will use with oauth2.Client and with TokenSource to ensure auto refresh of tokens occurs for api calls
-	oauthToken, err := json.Marshal(oauth2Token)
+	oauthToken, err := o.EncryptToken(oauth2Token)
 	if err != nil {
 		return userPrincipal, groupPrincipals, "", userClaimInfo, err
 	}
-	return userPrincipal, groupPrincipals, string(oauthToken), userClaimInfo, nil
+	return userPrincipal, groupPrincipals, oauthToken, userClaimInfo, nil
 }
 
 func (o *OpenIDCProvider) SearchPrincipals(searchValue, principalType string, token accessor.TokenAccessor) ([]v3.Principal, error) {
@@ -229,12 +229,12 @@ func (o *OpenIDCProvider) RefetchGroupPrincipals(principalID string, secret stri
 		logrus.Errorf("[generic oidc] refetchGroupPrincipals: error getting user by principalID: %v", err)
 		return groupPrincipals, err
 	}
-	var oauthToken oauth2.Token
-	if err := json.Unmarshal([]byte(secret), &oauthToken); err != nil {
+	oauthToken, err := o.DecryptToken(secret)
+	if err != nil {
 		return nil, err
 	}
 
-	claimInfo, err := o.getClaimInfoFromToken(o.CTX, config, &oauthToken, user.Name)
+	claimInfo, err := o.getClaimInfoFromToken(o.CTX, config, oauthToken, user.Name)
 	if err != nil {
 		return groupPrincipals, err
 	}
@@ -247,7 +247,7 @@ func (o *OpenIDCProvider) CanAccessWithGroupProviders(userPrincipalID string, gr
 		logrus.Errorf("[generic oidc] canAccessWithGroupProviders: error fetching OIDCConfig: %v", err)
 		return false, err
 	}
-	allowed, err := o.UserMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipalID, groupPrincipals)
+	allowed, err := o.UserMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipalID, groupPrincipals)
 	if err != nil {
 		return false, err
 	}
@@ -267,6 +267,9 @@ func (o *OpenIDCProvider) userToPrincipal(userInfo *oidc.UserInfo, claimInfo Cla
 		PrincipalType: UserType,
 		Me:            false,
 	}
+	if claimInfo.SessionID != "" {
+		p.ExtraInfo = map[string]string{SessionIDExtraInfoKey: claimInfo.SessionID}
+	}
 	return p
 }
 
@@ -444,8 +447,11 @@ func (o *OpenIDCProvider) getUserInfoFromAuthCode(ctx *context.Context, config *
 		return userInfo, oauth2Token, fmt.Errorf("not valid token: %w", err)
 	}
 
-	if err := o.UpdateToken(oauth2Token, userName); err != nil {
-		return nil, nil, err
+	// The token of a login is stored with the login session.
+	if userName != "" {
+		if err := o.UpdateToken(oauth2Token, userName); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	if config.AcrValue != "" {
@@ -494,8 +500,13 @@ func (o *OpenIDCProvider) getClaimInfoFromToken(ctx context.Context, config *v32
 		logrus.Debugf("[generic oidc] getUserInfo: attempting to refresh access token")
 		reusedToken, err := oauth2.ReuseTokenSource(token, oauthConfig.TokenSource(updatedContext, token)).Token()
 		if err != nil {
+			var retrieveErr *oauth2.RetrieveError
+			if errors.As(err, &retrieveErr) && retrieveErr.ErrorCode == "invalid_grant" {
+				return nil, fmt.Errorf("%w: %s", common.ErrRefreshRejected, retrieveErr.ErrorDescription)
+			}
 			return nil, err
 		}
+		// The identity provider may rotate the refresh token, the new one must be stored for the next refresh.
 		if !reflect.DeepEqual(token, reusedToken) {
 			err := o.UpdateToken(reusedToken, userName)
 			if err != nil {
@@ -582,15 +593,19 @@ func (o *OpenIDCProvider) getGroupsFromClaimInfo(claimInfo ClaimInfo) []v3.Princ
 }
 
 func (o *OpenIDCProvider) UpdateToken(refreshedToken *oauth2.Token, userID string) error {
-	var err error
 	logrus.Debugf("[generic oidc] UpdateToken: access token has been refreshed")
-	marshalledToken, err := json.Marshal(refreshedToken)
+	encryptedToken, err := o.EncryptToken(refreshedToken)
 	if err != nil {
 		return err
 	}
 	logrus.Debugf("[generic oidc] UpdateToken: saving refreshed access token")
-	o.TokenMGR.UpdateSecret(userID, o.Name, string(marshalledToken))
-	return err
+	return o.TokenMGR.UpdateSecret(userID, o.Name, encryptedToken)
+}
+
+// RenewsSessions implements [common.SessionRenewer]. Refetching the group principals of a user uses their refresh
+// token once their access token expired, which the identity provider rejects once their session ended there.
+func (o *OpenIDCProvider) RenewsSessions() bool {
+	return true
 }
 
 // IsDisabledProvider checks if the OIDC auth provider is currently disabled in Rancher.
@@ -15,6 +15,7 @@
 
 	"github.com/golang-jwt/jwt"
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
 	"github.com/rancher/rancher/pkg/auth/providers/mocks"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -267,6 +268,7 @@ func TestGetUserInfoFromAuthCode(t *testing.T) {
 			o := OpenIDCProvider{
 				Name:     providerName,
 				TokenMGR: test.tokenManagerMock(oidcResp.token),
+				Secrets:  newTokenKeySecrets(ctrl),
 			}
 			ctx := context.TODO()
 			claimInfo := &ClaimInfo{}
@@ -384,6 +386,29 @@ func TestGetClaimInfoFromToken(t *testing.T) {
 				return mock
 			},
 		},
+		"error - refresh token rejected": {
+			config: func(port string) *v32.OIDCConfig {
+				return newOIDCConfig(port)
+			},
+			oidcProviderResponses: func(port string) oidcResponses {
+				resp := newOIDCResponses(privateKey, port)
+				resp.tokenError = "invalid_grant"
+
+				return resp
+			},
+			storedToken: func(port string) *oauth2.Token {
+				return &oauth2.Token{
+					AccessToken:  "expired",
+					Expiry:       time.Unix(0, 0), // has expired
+					RefreshToken: "revoked",
+				}
+			},
+			tokenManagerMock: func(_ *Token) tokenManager {
+				return mocks.NewMocktokenManager(ctrl)
+			},
+			expectedClaimInfo:    nil,
+			expectedErrorMessage: common.ErrRefreshRejected.Error(),
+		},
 		"error - invalid certificate": {
 			config: func(port string) *v32.OIDCConfig {
 				return &v32.OIDCConfig{
@@ -470,6 +495,7 @@ func TestGetClaimInfoFromToken(t *testing.T) {
 			o := OpenIDCProvider{
 				Name:     providerName,
 				TokenMGR: test.tokenManagerMock(oidcResp.token),
+				Secrets:  newTokenKeySecrets(ctrl),
 			}
 
 			claimsInfo, err := o.getClaimInfoFromToken(context.TODO(), test.config(port), test.storedToken(port), userId)
@@ -501,6 +527,11 @@ func mockOIDCServer(listener net.Listener, resp oidcResponses) *http.Server {
 	})
 	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
+		if resp.tokenError != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": resp.tokenError, "error_description": "session ended"})
+			return
+		}
 		json.NewEncoder(w).Encode(resp.token)
 	})
 
@@ -520,6 +551,8 @@ type oidcResponses struct {
 	config providerJSON
 	jwks   jsonWebKeySet
 	token  *Token
+	// tokenError is the error the /token endpoint responds with, if any.
+	tokenError string
 }
 
 type Token struct {
@@ -634,8 +667,7 @@ func (m tokenMatcher) Matches(i interface{}) bool {
 	if !ok {
 		return false
 	}
-	token := oauth2.Token{}
-	err := json.Unmarshal([]byte(tokenStr), &token)
+	token, err := decryptToken(testTokenKey, tokenStr)
 	if err != nil {
 		return false
 	}
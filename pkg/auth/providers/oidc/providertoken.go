@@ -0,0 +1,139 @@
+package oidc
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rancher/rancher/pkg/namespace"
+	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// encryptedTokenPrefix prefixes the oauth2 tokens stored encrypted in the per-user secrets. Tokens without it were
+	// stored in plain text by earlier versions.
+	encryptedTokenPrefix = "enc:v1:"
+	// tokenKeySecretName is the name of the secret holding the key the oauth2 tokens of all OIDC providers are
+	// encrypted with.
+	tokenKeySecretName = "oidc-provider-token-key"
+	// tokenKeyField is the field of the secret holding the key.
+	tokenKeyField = "key"
+	// tokenKeySize is the size of the key, selecting AES-256.
+	tokenKeySize = 32
+)
+
+// EncryptToken returns the oauth2 token of a user, holding their refresh token, encrypted for storage in their per-user
+// secret.
+func (o *OpenIDCProvider) EncryptToken(token *oauth2.Token) (string, error) {
+	key, err := o.tokenKey()
+	if err != nil {
+		return "", err
+	}
+	return encryptToken(key, token)
+}
+
+// DecryptToken returns the oauth2 token stored in the per-user secret of a user. Tokens stored in plain text by earlier
+// versions are returned as is, they are encrypted the next time they are refreshed.
+func (o *OpenIDCProvider) DecryptToken(stored string) (*oauth2.Token, error) {
+	if !strings.HasPrefix(stored, encryptedTokenPrefix) {
+		var token oauth2.Token
+		if err := json.Unmarshal([]byte(stored), &token); err != nil {
+			return nil, err
+		}
+		return &token, nil
+	}
+
+	key, err := o.tokenKey()
+	if err != nil {
+		return nil, err
+	}
+	return decryptToken(key, stored)
+}
+
+// tokenKey returns the key the oauth2 tokens are encrypted with, generating it on first use.
+func (o *OpenIDCProvider) tokenKey() ([]byte, error) {
+	secret, err := o.Secrets.Cache().Get(namespace.System, tokenKeySecretName)
+	if apierrors.IsNotFound(err) {
+		key := make([]byte, tokenKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate provider token key: %w", err)
+		}
+		secret, err = o.Secrets.Create(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tokenKeySecretName,
+				Namespace: namespace.System,
+			},
+			Data: map[string][]byte{tokenKeyField: key},
+		})
+		if apierrors.IsAlreadyExists(err) {
+			// Another replica generated the key first.
+			secret, err = o.Secrets.Get(namespace.System, tokenKeySecretName, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get provider token key: %w", err)
+	}
+
+	key := secret.Data[tokenKeyField]
+	if len(key) != tokenKeySize {
+		return nil, fmt.Errorf("invalid provider token key in secret %s/%s", namespace.System, tokenKeySecretName)
+	}
+	return key, nil
+}
+
+func encryptToken(key []byte, token *oauth2.Token) (string, error) {
+	plaintext, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, plaintext, nil)
+	return encryptedTokenPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func decryptToken(key []byte, stored string) (*oauth2.Token, error) {
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, encryptedTokenPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode provider token: %w", err)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("failed to decrypt provider token: too short")
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt provider token: %w", err)
+	}
+
+	var token oauth2.Token
+	if err := json.Unmarshal(plaintext, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,105 @@
+package oidc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rancher/rancher/pkg/namespace"
+	wcorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var testTokenKey = bytes.Repeat([]byte{0x42}, tokenKeySize)
+
+// newTokenKeySecrets returns a secret controller holding testTokenKey.
+func newTokenKeySecrets(ctrl *gomock.Controller) wcorev1.SecretController {
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	cache := fake.NewMockCacheInterface[*corev1.Secret](ctrl)
+	secrets.EXPECT().Cache().Return(cache).AnyTimes()
+	cache.EXPECT().Get(namespace.System, tokenKeySecretName).Return(&corev1.Secret{
+		Data: map[string][]byte{tokenKeyField: testTokenKey},
+	}, nil).AnyTimes()
+	return secrets
+}
+
+func TestEncryptToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	o := &OpenIDCProvider{Secrets: newTokenKeySecrets(ctrl)}
+	token := &oauth2.Token{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		Expiry:       time.Date(2025, 2, 5, 8, 0, 0, 0, time.UTC),
+	}
+
+	encrypted, err := o.EncryptToken(token)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encrypted, encryptedTokenPrefix))
+	assert.NotContains(t, encrypted, "refresh")
+
+	decrypted, err := o.DecryptToken(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, token.AccessToken, decrypted.AccessToken)
+	assert.Equal(t, token.RefreshToken, decrypted.RefreshToken)
+	assert.True(t, token.Expiry.Equal(decrypted.Expiry))
+
+	// tokens stored in plain text by earlier versions
+	decrypted, err = o.DecryptToken(`{"access_token":"access","refresh_token":"refresh"}`)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh", decrypted.RefreshToken)
+
+	// tokens encrypted with another key
+	_, err = decryptToken(bytes.Repeat([]byte{0x24}, tokenKeySize), encrypted)
+	assert.ErrorContains(t, err, "failed to decrypt provider token")
+}
+
+func TestTokenKeyGenerated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	cache := fake.NewMockCacheInterface[*corev1.Secret](ctrl)
+	secrets.EXPECT().Cache().Return(cache).AnyTimes()
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, tokenKeySecretName)
+	cache.EXPECT().Get(namespace.System, tokenKeySecretName).Return(nil, notFound)
+
+	var created *corev1.Secret
+	secrets.EXPECT().Create(gomock.Any()).DoAndReturn(func(secret *corev1.Secret) (*corev1.Secret, error) {
+		created = secret
+		return secret, nil
+	})
+
+	o := &OpenIDCProvider{Secrets: secrets}
+	key, err := o.tokenKey()
+	require.NoError(t, err)
+	assert.Len(t, key, tokenKeySize)
+	require.NotNil(t, created)
+	assert.Equal(t, namespace.System, created.Namespace)
+	assert.Equal(t, key, created.Data[tokenKeyField])
+}
+
+func TestTokenKeyGeneratedConcurrently(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	cache := fake.NewMockCacheInterface[*corev1.Secret](ctrl)
+	secrets.EXPECT().Cache().Return(cache).AnyTimes()
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, tokenKeySecretName)
+	cache.EXPECT().Get(namespace.System, tokenKeySecretName).Return(nil, notFound)
+	alreadyExists := apierrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, tokenKeySecretName)
+	secrets.EXPECT().Create(gomock.Any()).Return(nil, alreadyExists)
+	secrets.EXPECT().Get(namespace.System, tokenKeySecretName, metav1.GetOptions{}).Return(&corev1.Secret{
+		Data: map[string][]byte{tokenKeyField: testTokenKey},
+	}, nil)
+
+	o := &OpenIDCProvider{Secrets: secrets}
+	key, err := o.tokenKey()
+	require.NoError(t, err)
+	assert.Equal(t, testTokenKey, key)
+}
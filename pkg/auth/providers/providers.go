@@ -8,6 +8,7 @@
 
 	"github.com/rancher/norman/types"
 	"github.com/rancher/rancher/pkg/auth/accessor"
+	"github.com/rancher/rancher/pkg/auth/challenge"
 	"github.com/rancher/rancher/pkg/auth/providers/activedirectory"
 	"github.com/rancher/rancher/pkg/auth/providers/azure"
 	"github.com/rancher/rancher/pkg/auth/providers/cognito"
@@ -36,6 +37,8 @@
 	providersByType        = make(map[string]common.AuthProvider)
 	confMu                 sync.Mutex
 	userExtraAttributesMap = map[string]bool{common.UserAttributePrincipalID: true, common.UserAttributeUserName: true}
+	// LoginRisk detects the risk signals shown by the logins of all the providers, from the logins they record.
+	LoginRisk = challenge.NewDetector()
 )
 
 func GetProvider(providerName string) (common.AuthProvider, error) {
@@ -63,7 +66,7 @@ func Configure(ctx context.Context, mgmt *config.ScaledContext) {
 
 	var p common.AuthProvider
 
-	p = local.Configure(ctx, mgmt, tokenMGR)
+	p = local.Configure(ctx, mgmt, tokenMGR, LoginRisk)
 	ProviderNames[local.Name] = true
 	Providers[local.Name] = p
 	providersByType[client.LocalConfigType] = p
@@ -225,29 +228,11 @@ func GetPrincipal(principalID string, myToken accessor.TokenAccessor) (v3.Princi
 	return principal, err
 }
 
+// SearchPrincipals searches the principals of the auth provider of the token and of the local provider, see
+// [SearchPrincipalsPage].
 func SearchPrincipals(name, principalType string, myToken accessor.TokenAccessor) ([]v3.Principal, error) {
-	ap := myToken.GetAuthProvider()
-	if ap == "" {
-		return []v3.Principal{}, fmt.Errorf("[SearchPrincipals] no authProvider specified in token")
-	}
-	if Providers[ap] == nil {
-		return []v3.Principal{}, fmt.Errorf("[SearchPrincipals] authProvider %v not initialized", ap)
-	}
-	principals, err := Providers[ap].SearchPrincipals(name, principalType, myToken)
-	if err != nil {
-		return principals, err
-	}
-	if ap != LocalProvider {
-		lp := Providers[LocalProvider]
-		if lpDedupe, _ := lp.(*local.Provider); lpDedupe != nil {
-			localPrincipals, err := lpDedupe.SearchPrincipalsDedupe(name, principalType, myToken, principals)
-			if err != nil {
-				return principals, err
-			}
-			principals = append(principals, localPrincipals...)
-		}
-	}
-	return principals, err
+	result, err := SearchPrincipalsPage(name, principalType, myToken, SearchOptions{})
+	return result.Principals, err
 }
 
 func CanAccessWithGroupProviders(providerName string, userPrincipalID string, groups []v3.Principal) (bool, error) {
@@ -258,6 +243,13 @@ func RefetchGroupPrincipals(principalID string, providerName string, secret stri
 	return Providers[providerName].RefetchGroupPrincipals(principalID, secret)
 }
 
+// RenewsSessions returns true if refetching the group principals of users of the provider confirms their login
+// sessions with the identity provider, see [common.SessionRenewer].
+func RenewsSessions(providerName string) bool {
+	renewer, ok := Providers[providerName].(common.SessionRenewer)
+	return ok && renewer.RenewsSessions()
+}
+
 func GetUserExtraAttributes(providerName string, userPrincipal v3.Principal) map[string][]string {
 	return Providers[providerName].GetUserExtraAttributes(userPrincipal)
 }
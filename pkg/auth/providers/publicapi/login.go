@@ -13,6 +13,7 @@
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
 	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/challenge"
 	"github.com/rancher/rancher/pkg/auth/providers"
 	"github.com/rancher/rancher/pkg/auth/providers/activedirectory"
 	"github.com/rancher/rancher/pkg/auth/providers/azure"
@@ -50,6 +51,7 @@ func newLoginHandler(ctx context.Context, mgmt *config.ScaledContext) *loginHand
 		tokenMGR:      tokens.NewManager(ctx, mgmt),
 		clusterLister: mgmt.Management.Clusters("").Controller().Lister(),
 		secretLister:  mgmt.Core.Secrets("").Controller().Lister(),
+		risk:          providers.LoginRisk,
 	}
 }
 
@@ -59,6 +61,7 @@ type loginHandler struct {
 	tokenMGR      *tokens.Manager
 	clusterLister v3.ClusterLister
 	secretLister  v1.SecretLister
+	risk          *challenge.Detector
 }
 
 func (h *loginHandler) login(actionName string, action *types.Action, request *types.APIContext) error {
@@ -194,17 +197,54 @@ func (h *loginHandler) createLoginToken(request *types.APIContext) (v3.Token, st
 	// SAML's login flow is different from the other providers. Unlike the other providers, it gets the logged in user's data via a POST from
 	// the identity provider on a separate endpoint specifically for that.
 
+	clientAddr := util.ClientIP(request.Request)
+
 	if providerName == saml.PingName || providerName == saml.ADFSName || providerName == saml.KeyCloakName ||
 		providerName == saml.OKTAName || providerName == saml.ShibbolethName {
+		// The user is only known once the identity provider posts back, so the login is challenged before the
+		// redirection to the identity provider, from the signals of the client address.
+		err = challenge.Verify(request.Request.Context(), challenge.Login{
+			Provider:      providerName,
+			ClientAddress: clientAddr,
+			Signals:       h.risk.Signals("", "", clientAddr),
+			Response:      generic.ChallengeResponse,
+		})
+		if err != nil {
+			h.risk.Failed("", clientAddr)
+			return v3.Token{}, "", "", err
+		}
 		err = saml.PerformSamlLogin(providerName, request, input)
 		return v3.Token{}, "", "saml", err
 	}
 
+	var username string
+	if basic, ok := input.(*apiv3.BasicLogin); ok {
+		username = basic.Username
+	}
+
 	ctx := context.WithValue(request.Request.Context(), util.RequestKey, request.Request)
 	userPrincipal, groupPrincipals, providerToken, err = providers.AuthenticateUser(ctx, input, providerName)
 	if err != nil {
+		// The local provider records its failed logins itself, to slow down and lock out the following ones.
+		if providerName != local.Name {
+			h.risk.Failed(username, clientAddr)
+		}
+		return v3.Token{}, "", "", err
+	}
+
+	// Logins showing risk signals may be required to pass an additional challenge, e.g. a CAPTCHA.
+	err = challenge.Verify(ctx, challenge.Login{
+		Provider:      providerName,
+		UserPrincipal: userPrincipal.Name,
+		ClientAddress: clientAddr,
+		Signals:       h.risk.Signals(username, userPrincipal.Name, clientAddr),
+		Response:      generic.ChallengeResponse,
+	})
+	if err != nil {
+		h.risk.Failed(username, clientAddr)
 		return v3.Token{}, "", "", err
 	}
+	h.risk.Succeeded(username, userPrincipal.Name, clientAddr)
 
 	displayName := userPrincipal.DisplayName
 	if displayName == "" {
@@ -413,7 +413,7 @@ func (s *Provider) HandleSamlAssertion(w http.ResponseWriter, r *http.Request, a
 	}
 	allowedPrincipals := config.AllowedPrincipalIDs
 
-	allowed, err := s.userMGR.CheckAccess(config.AccessMode, allowedPrincipals, userPrincipal.Name, groupPrincipals)
+	allowed, err := s.userMGR.CheckAccess(config.AccessMode, allowedPrincipals, config.DeniedPrincipalIDs, userPrincipal.Name, groupPrincipals)
 	if err != nil {
 		log.Errorf("SAML: Error during login while checking access %v", err)
 		http.Redirect(w, r, redirectURL+"errorCode=500", http.StatusFound)
@@ -423,7 +423,7 @@ func (s *Provider) CanAccessWithGroupProviders(userPrincipalID string, groupPrin
 		logrus.Errorf("Error fetching saml config: %v", err)
 		return false, err
 	}
-	allowed, err := s.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipalID, groupPrincipals)
+	allowed, err := s.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, config.DeniedPrincipalIDs, userPrincipalID, groupPrincipals)
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,195 @@
+package providers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rancher/rancher/pkg/auth/accessor"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrInvalidContinue is returned when the continue token of a search isn't one returned by the same search.
+var ErrInvalidContinue = errors.New("invalid continue token")
+
+// SearchOptions are the options of a paged search of principals.
+type SearchOptions struct {
+	// Limit is the maximum number of principals returned. Zero returns all the principals found.
+	Limit int
+	// Continue is the token returned with the previous page.
+	Continue string
+}
+
+// SearchResult is a page of principals.
+type SearchResult struct {
+	Principals []v3.Principal
+	// Continue is the token to get the next page, empty for the last page.
+	Continue string
+	// Incomplete lists the providers whose search failed or timed out, whose principals are missing.
+	Incomplete []string
+}
+
+// principalDeduper is implemented by the local provider, to remove from its search results the users linked to the
+// principals found by the other providers.
+type principalDeduper interface {
+	DedupePrincipals(principals []v3.Principal, principalsFromOtherProviders []v3.Principal) []v3.Principal
+}
+
+type searchResult struct {
+	principals []v3.Principal
+	err        error
+}
+
+// continueToken is the position of the next page of a search.
+type continueToken struct {
+	Name          string `json:"n"`
+	PrincipalType string `json:"t,omitempty"`
+	Offset        int    `json:"o"`
+}
+
+// SearchPrincipalsPage searches the principals of the auth provider of the token and of the local provider in
+// parallel, and returns a page of the results. Each provider is waited for at most its timeout, see
+// [SearchTimeout]; the principals of the providers which fail or time out are left out, unless all of them do.
+// Pages are computed by running the search again, so they are consistent as long as the providers return the
+// principals in the same order.
+func SearchPrincipalsPage(name, principalType string, myToken accessor.TokenAccessor, opts SearchOptions) (SearchResult, error) {
+	ap := myToken.GetAuthProvider()
+	if ap == "" {
+		return SearchResult{}, fmt.Errorf("[SearchPrincipals] no authProvider specified in token")
+	}
+	if Providers[ap] == nil {
+		return SearchResult{}, fmt.Errorf("[SearchPrincipals] authProvider %v not initialized", ap)
+	}
+
+	offset := 0
+	if opts.Continue != "" {
+		token, err := parseContinue(opts.Continue)
+		if err != nil || token.Name != name || token.PrincipalType != principalType || token.Offset < 0 {
+			return SearchResult{}, ErrInvalidContinue
+		}
+		offset = token.Offset
+	}
+
+	searched := []string{ap}
+	if ap != LocalProvider && Providers[LocalProvider] != nil {
+		searched = append(searched, LocalProvider)
+	}
+
+	results := make([]chan searchResult, len(searched))
+	for i, providerName := range searched {
+		results[i] = make(chan searchResult, 1)
+		go func() {
+			principals, err := searchWithTimeout(Providers[providerName], name, principalType, myToken, SearchTimeout(providerName))
+			results[i] <- searchResult{principals: principals, err: err}
+		}()
+	}
+
+	var (
+		result   SearchResult
+		firstErr error
+	)
+	for i, providerName := range searched {
+		found := <-results[i]
+		if found.err != nil {
+			logrus.Warnf("[SearchPrincipals] search of provider %s failed: %v", providerName, found.err)
+			result.Incomplete = append(result.Incomplete, providerName)
+			if firstErr == nil {
+				firstErr = found.err
+			}
+			continue
+		}
+		principals := found.principals
+		if providerName == LocalProvider && ap != LocalProvider {
+			if deduper, ok := Providers[LocalProvider].(principalDeduper); ok {
+				principals = deduper.DedupePrincipals(principals, result.Principals)
+			}
+		}
+		result.Principals = append(result.Principals, principals...)
+	}
+	if len(result.Incomplete) == len(searched) {
+		return SearchResult{}, firstErr
+	}
+
+	result.Principals = result.Principals[min(offset, len(result.Principals)):]
+	if opts.Limit > 0 && len(result.Principals) > opts.Limit {
+		result.Principals = result.Principals[:opts.Limit]
+		result.Continue = encodeContinue(continueToken{
+			Name:          name,
+			PrincipalType: principalType,
+			Offset:        offset + opts.Limit,
+		})
+	}
+	return result, nil
+}
+
+// searchWithTimeout searches the principals of the provider, giving up after the timeout. The search keeps running in
+// the background after a timeout, as providers can't cancel it.
+func searchWithTimeout(provider common.AuthProvider, name, principalType string, myToken accessor.TokenAccessor, timeout time.Duration) ([]v3.Principal, error) {
+	done := make(chan searchResult, 1)
+	go func() {
+		principals, err := provider.SearchPrincipals(name, principalType, myToken)
+		done <- searchResult{principals: principals, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case result := <-done:
+		return result.principals, result.err
+	case <-timer.C:
+		return nil, fmt.Errorf("search timed out after %v", timeout)
+	}
+}
+
+// SearchTimeout returns the time the search of principals waits for the provider, from
+// principal-search-timeout-seconds-by-provider or else principal-search-timeout-seconds.
+func SearchTimeout(providerName string) time.Duration {
+	timeouts, err := ParseSearchTimeoutSecondsByProvider(settings.PrincipalSearchTimeoutSecondsByProvider.Get())
+	if err != nil {
+		logrus.Errorf("Error parsing setting %s: %v", settings.PrincipalSearchTimeoutSecondsByProvider.Name, err)
+	}
+	if timeout, ok := timeouts[providerName]; ok {
+		return time.Duration(timeout) * time.Second
+	}
+	return time.Duration(settings.PrincipalSearchTimeoutSeconds.GetInt()) * time.Second
+}
+
+// ParseSearchTimeoutSecondsByProvider parses the value of the principal-search-timeout-seconds-by-provider setting, a
+// JSON object mapping the name of a provider to its timeout in seconds, e.g. {"activedirectory": 20}.
+// Like principal-search-timeout-seconds, timeouts must be at least 1 second.
+func ParseSearchTimeoutSecondsByProvider(value string) (map[string]int, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var timeouts map[string]int
+	if err := json.Unmarshal([]byte(value), &timeouts); err != nil {
+		return nil, fmt.Errorf("invalid principal search timeouts by provider: %w", err)
+	}
+	for provider, timeout := range timeouts {
+		if timeout < 1 {
+			return nil, fmt.Errorf("invalid principal search timeout of provider %s: %d must be greater than or equal to 1", provider, timeout)
+		}
+	}
+	return timeouts, nil
+}
+
+func encodeContinue(token continueToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func parseContinue(value string) (continueToken, error) {
+	var token continueToken
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return token, err
+	}
+	err = json.Unmarshal(data, &token)
+	return token, err
+}
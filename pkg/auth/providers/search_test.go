@@ -0,0 +1,143 @@
+package providers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/accessor"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeSearchProvider struct {
+	common.AuthProvider
+	principals []string
+	err        error
+	delay      time.Duration
+	// linked are the principals linked to the users of the local provider, by name of the local principal.
+	linked map[string]string
+}
+
+func (p *fakeSearchProvider) SearchPrincipals(_, _ string, _ accessor.TokenAccessor) ([]v3.Principal, error) {
+	time.Sleep(p.delay)
+	if p.err != nil {
+		return nil, p.err
+	}
+	var principals []v3.Principal
+	for _, name := range p.principals {
+		principals = append(principals, v3.Principal{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	return principals, nil
+}
+
+func (p *fakeSearchProvider) DedupePrincipals(principals []v3.Principal, fromOtherProviders []v3.Principal) []v3.Principal {
+	var deduped []v3.Principal
+Principal:
+	for _, principal := range principals {
+		for _, other := range fromOtherProviders {
+			if p.linked[principal.Name] == other.Name {
+				continue Principal
+			}
+		}
+		deduped = append(deduped, principal)
+	}
+	return deduped
+}
+
+func searchedNames(principals []v3.Principal) []string {
+	var names []string
+	for _, principal := range principals {
+		names = append(names, principal.Name)
+	}
+	return names
+}
+
+func TestSearchPrincipalsPage(t *testing.T) {
+	t.Cleanup(cleanup)
+	Providers["activedirectory"] = &fakeSearchProvider{
+		principals: []string{"activedirectory_user://a", "activedirectory_user://b", "activedirectory_user://c"},
+	}
+	Providers[LocalProvider] = &fakeSearchProvider{
+		principals: []string{"local://u-a", "local://u-d"},
+		linked:     map[string]string{"local://u-a": "activedirectory_user://a"},
+	}
+	token := &v3.Token{AuthProvider: "activedirectory"}
+
+	result, err := SearchPrincipalsPage("user", "user", token, SearchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"activedirectory_user://a", "activedirectory_user://b", "activedirectory_user://c", "local://u-d"}, searchedNames(result.Principals))
+	assert.Empty(t, result.Continue)
+	assert.Empty(t, result.Incomplete)
+
+	var pages [][]string
+	opts := SearchOptions{Limit: 3}
+	for {
+		result, err := SearchPrincipalsPage("user", "user", token, opts)
+		require.NoError(t, err)
+		pages = append(pages, searchedNames(result.Principals))
+		if result.Continue == "" {
+			break
+		}
+		opts.Continue = result.Continue
+	}
+	assert.Equal(t, [][]string{
+		{"activedirectory_user://a", "activedirectory_user://b", "activedirectory_user://c"},
+		{"local://u-d"},
+	}, pages)
+
+	// continue tokens are only valid for the same search
+	first, err := SearchPrincipalsPage("user", "user", token, SearchOptions{Limit: 1})
+	require.NoError(t, err)
+	_, err = SearchPrincipalsPage("other", "user", token, SearchOptions{Limit: 1, Continue: first.Continue})
+	assert.ErrorIs(t, err, ErrInvalidContinue)
+	_, err = SearchPrincipalsPage("user", "user", token, SearchOptions{Continue: "invalid"})
+	assert.ErrorIs(t, err, ErrInvalidContinue)
+}
+
+func TestSearchPrincipalsPagePartial(t *testing.T) {
+	t.Cleanup(cleanup)
+	require.NoError(t, settings.PrincipalSearchTimeoutSecondsByProvider.Set(`{"activedirectory": 1}`))
+	t.Cleanup(func() {
+		settings.PrincipalSearchTimeoutSecondsByProvider.Set("")
+	})
+
+	Providers["activedirectory"] = &fakeSearchProvider{
+		principals: []string{"activedirectory_user://a"},
+		delay:      2 * time.Second,
+	}
+	Providers[LocalProvider] = &fakeSearchProvider{
+		principals: []string{"local://u-a"},
+	}
+	token := &v3.Token{AuthProvider: "activedirectory"}
+
+	result, err := SearchPrincipalsPage("user", "", token, SearchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"local://u-a"}, searchedNames(result.Principals))
+	assert.Equal(t, []string{"activedirectory"}, result.Incomplete)
+
+	// the search fails if all providers fail
+	Providers[LocalProvider] = &fakeSearchProvider{err: errors.New("unavailable")}
+	_, err = SearchPrincipalsPage("user", "", token, SearchOptions{})
+	assert.ErrorContains(t, err, "timed out")
+}
+
+func TestParseSearchTimeoutSecondsByProvider(t *testing.T) {
+	timeouts, err := ParseSearchTimeoutSecondsByProvider(`{"activedirectory": 20, "local": 5}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"activedirectory": 20, "local": 5}, timeouts)
+
+	timeouts, err = ParseSearchTimeoutSecondsByProvider("")
+	require.NoError(t, err)
+	assert.Nil(t, timeouts)
+
+	_, err = ParseSearchTimeoutSecondsByProvider(`{"activedirectory": 0}`)
+	assert.ErrorContains(t, err, "must be greater than or equal to 1")
+
+	_, err = ParseSearchTimeoutSecondsByProvider("20")
+	assert.ErrorContains(t, err, "invalid principal search timeouts by provider")
+}
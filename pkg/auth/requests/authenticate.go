@@ -150,6 +150,12 @@ func (a *tokenAuthenticator) Authenticate(req *http.Request) (*AuthenticatorResp
 		return nil, errors.Wrapf(ErrMustAuthenticate,
 			"failed to retrieve userattribute %s: %v", token.GetUserID(), err)
 	}
+	if attribs != nil && token.GetAuthProvider() != "" {
+		if _, ok := attribs.SuspendedByProvider[token.GetAuthProvider()]; ok {
+			return nil, errors.Wrapf(ErrMustAuthenticate, "user's access to provider %s is suspended",
+				token.GetAuthProvider())
+		}
+	}
 
 	authUser, err := a.userLister.Get("", token.GetUserID())
 	if err != nil {
@@ -310,6 +316,10 @@ func (a *tokenAuthenticator) TokenFromRequest(req *http.Request) (accessor.Token
 			return nil, fmt.Errorf("failed to retrieve auth token, error: %v: %w",
 				err, ErrMustAuthenticate)
 		}
+		if storedToken.Status.Hash, err = a.extTokenStore.ResolveHash(storedToken); err != nil {
+			return nil, fmt.Errorf("failed to retrieve auth token hash, error: %v: %w",
+				err, ErrMustAuthenticate)
+		}
 		if _, err := extVerifyToken(storedToken, extTokenName, tokenKey); err != nil {
 			return nil, fmt.Errorf("failed to verify token: %v: %w", err, ErrMustAuthenticate)
 		}
@@ -374,5 +384,11 @@ func extVerifyToken(storedToken *ext.Token, tokenName, tokenKey string) (int, er
 	if storedToken.Status.Expired {
 		return http.StatusGone, errors.New("must authenticate")
 	}
+
+	if !storedToken.GetIsDerived() {
+		if expiresAt, ok := tokens.SessionMaxExpiresAt(storedToken.CreationTimestamp.Time); ok && !time.Now().Before(expiresAt) {
+			return http.StatusGone, errors.New("must authenticate, maximum session lifetime reached")
+		}
+	}
 	return http.StatusOK, nil
 }
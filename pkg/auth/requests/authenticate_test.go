@@ -560,6 +560,19 @@ func TestTokenAuthenticatorAuthenticate(t *testing.T) {
 		assert.False(t, userRefresher.called)
 	})
 
+	t.Run("user's access to auth provider is suspended", func(t *testing.T) {
+		oldSuspended := userAttribute.SuspendedByProvider
+		defer func() { userAttribute.SuspendedByProvider = oldSuspended }()
+		userAttribute.SuspendedByProvider = map[string]metav1.Time{fakeProvider.name: metav1.NewTime(now)}
+
+		userRefresher.reset()
+
+		resp, err := authenticator.Authenticate(req)
+		require.ErrorIs(t, err, ErrMustAuthenticate)
+		require.Nil(t, resp)
+		assert.False(t, userRefresher.called)
+	})
+
 	t.Run("error getting userattribute", func(t *testing.T) {
 		oldGetUserAttributeFunc := userAttributeLister.GetFunc
 		defer func() { userAttributeLister.GetFunc = oldGetUserAttributeFunc }()
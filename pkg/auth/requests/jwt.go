@@ -0,0 +1,258 @@
+package requests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/rancher/rancher/pkg/auth/accessor"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/rancher/rancher/pkg/auth/tokens"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/types/config"
+	rancheruser "github.com/rancher/rancher/pkg/user"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+const (
+	defaultJWTUsernameClaim = "sub"
+	jwtUserPrincipalPrefix  = "jwt_user://"
+	jwtGroupPrincipalPrefix = "jwt_group://"
+)
+
+var errJWTAuthNotSupported = errors.New("tokens are not supported by the JWT authenticator")
+
+// TrustedJWTIssuer is an external issuer whose JWTs are accepted to authenticate API requests,
+// as configured by the trusted-jwt-issuers setting.
+type TrustedJWTIssuer struct {
+	// Issuer is the expected iss claim of the JWTs.
+	Issuer string `json:"issuer"`
+	// JWKSURL is the URL the keys verifying the signature of the JWTs are fetched from.
+	JWKSURL string `json:"jwksURL"`
+	// Audiences are the accepted aud claims. At least one of them must be in the JWTs.
+	Audiences []string `json:"audiences"`
+	// UsernameClaim is the claim identifying the user. Defaults to sub.
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+	// GroupsClaim is the claim, either a string or a list of strings, listing the groups of the user.
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// UserPrincipalPrefix is prepended to the username to get the principal of the user. Defaults to
+	// "jwt_user://<issuer>/", and must start with it, so that JWTs can't map to users of auth providers or of
+	// other issuers.
+	UserPrincipalPrefix string `json:"userPrincipalPrefix,omitempty"`
+	// GroupPrincipalPrefix is prepended to the groups to get their principals. Defaults to "jwt_group://<issuer>/",
+	// and must start with it.
+	GroupPrincipalPrefix string `json:"groupPrincipalPrefix,omitempty"`
+	// CreateUsers creates a Rancher user for principals that don't have one yet. Otherwise, JWTs of unknown
+	// principals are rejected.
+	CreateUsers bool `json:"createUsers,omitempty"`
+}
+
+// ParseTrustedJWTIssuers parses and validates the value of the trusted-jwt-issuers setting.
+func ParseTrustedJWTIssuers(value string) ([]TrustedJWTIssuer, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	var issuers []TrustedJWTIssuer
+	if err := json.Unmarshal([]byte(value), &issuers); err != nil {
+		return nil, fmt.Errorf("invalid trusted JWT issuers: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for i := range issuers {
+		issuer := &issuers[i]
+		if issuer.Issuer == "" {
+			return nil, fmt.Errorf("trusted JWT issuer %d: issuer is required", i)
+		}
+		if seen[issuer.Issuer] {
+			return nil, fmt.Errorf("trusted JWT issuer %s is defined more than once", issuer.Issuer)
+		}
+		seen[issuer.Issuer] = true
+		if issuer.JWKSURL == "" {
+			return nil, fmt.Errorf("trusted JWT issuer %s: jwksURL is required", issuer.Issuer)
+		}
+		if len(issuer.Audiences) == 0 {
+			return nil, fmt.Errorf("trusted JWT issuer %s: at least one audience is required", issuer.Issuer)
+		}
+		if issuer.UsernameClaim == "" {
+			issuer.UsernameClaim = defaultJWTUsernameClaim
+		}
+		userPrefix := jwtUserPrincipalPrefix + issuer.Issuer + "/"
+		if issuer.UserPrincipalPrefix == "" {
+			issuer.UserPrincipalPrefix = userPrefix
+		} else if !strings.HasPrefix(issuer.UserPrincipalPrefix, userPrefix) {
+			return nil, fmt.Errorf("trusted JWT issuer %s: userPrincipalPrefix must start with %s", issuer.Issuer, userPrefix)
+		}
+		groupPrefix := jwtGroupPrincipalPrefix + issuer.Issuer + "/"
+		if issuer.GroupPrincipalPrefix == "" {
+			issuer.GroupPrincipalPrefix = groupPrefix
+		} else if !strings.HasPrefix(issuer.GroupPrincipalPrefix, groupPrefix) {
+			return nil, fmt.Errorf("trusted JWT issuer %s: groupPrincipalPrefix must start with %s", issuer.Issuer, groupPrefix)
+		}
+	}
+	return issuers, nil
+}
+
+// jwtVerifier verifies the JWTs of a trusted issuer.
+type jwtVerifier struct {
+	issuer   TrustedJWTIssuer
+	verifier *oidc.IDTokenVerifier
+}
+
+// jwtAuthenticator authenticates requests bearing JWTs issued by trusted external issuers, without any login flow.
+// Requests bearing other tokens are left to the next authenticators.
+type jwtAuthenticator struct {
+	ctx         context.Context
+	userManager rancheruser.Manager
+	issuers     func() string
+
+	mu        sync.Mutex
+	config    string
+	verifiers map[string]*jwtVerifier
+}
+
+// NewJWTAuthenticator creates a new authenticator for the JWTs of the issuers trusted by the trusted-jwt-issuers setting.
+func NewJWTAuthenticator(ctx context.Context, mgmtCtx *config.ScaledContext) Authenticator {
+	return &jwtAuthenticator{
+		ctx:         ctx,
+		userManager: mgmtCtx.UserManager,
+		issuers:     settings.TrustedJWTIssuers.Get,
+	}
+}
+
+// Authenticate authenticates a request using a JWT of a trusted issuer.
+func (a *jwtAuthenticator) Authenticate(req *http.Request) (*AuthenticatorResponse, error) {
+	unauthed := &AuthenticatorResponse{Extras: make(map[string][]string)}
+
+	rawToken := tokens.GetTokenAuthFromRequest(req)
+	if strings.Count(rawToken, ".") != 2 {
+		return unauthed, nil
+	}
+
+	// The issuer is only used to pick the verifier, the JWT is verified below.
+	claims := jwtv4.RegisteredClaims{}
+	if _, _, err := jwtv4.NewParser().ParseUnverified(rawToken, &claims); err != nil {
+		return unauthed, nil
+	}
+	verifier, err := a.verifier(claims.Issuer)
+	if err != nil {
+		logrus.Errorf("Ignoring invalid setting %s: %v", settings.TrustedJWTIssuers.Name, err)
+		return unauthed, nil
+	}
+	if verifier == nil {
+		return unauthed, nil
+	}
+
+	idToken, err := verifier.verifier.Verify(a.ctx, rawToken)
+	if err != nil {
+		return nil, errors.Wrapf(ErrMustAuthenticate, "failed to verify JWT: %v", err)
+	}
+	if !slices.ContainsFunc(idToken.Audience, func(aud string) bool {
+		return slices.Contains(verifier.issuer.Audiences, aud)
+	}) {
+		return nil, errors.Wrapf(ErrMustAuthenticate, "JWT audience %v is not trusted", idToken.Audience)
+	}
+
+	var allClaims map[string]any
+	if err := idToken.Claims(&allClaims); err != nil {
+		return nil, errors.Wrapf(ErrMustAuthenticate, "failed to read JWT claims: %v", err)
+	}
+	username, _ := allClaims[verifier.issuer.UsernameClaim].(string)
+	if username == "" {
+		return nil, errors.Wrapf(ErrMustAuthenticate, "JWT is missing claim %s", verifier.issuer.UsernameClaim)
+	}
+
+	principalID := verifier.issuer.UserPrincipalPrefix + username
+	authUser, err := a.userManager.GetUserByPrincipalID(principalID)
+	if err != nil {
+		return nil, errors.Wrapf(ErrMustAuthenticate, "failed to retrieve user for principal %s: %v", principalID, err)
+	}
+	if authUser == nil {
+		if !verifier.issuer.CreateUsers {
+			return nil, errors.Wrapf(ErrMustAuthenticate, "no user for principal %s", principalID)
+		}
+		if authUser, err = a.userManager.EnsureUser(principalID, username); err != nil {
+			return nil, errors.Wrapf(ErrMustAuthenticate, "failed to create user for principal %s: %v", principalID, err)
+		}
+	}
+	if authUser.Enabled != nil && !*authUser.Enabled {
+		return nil, errors.Wrap(ErrMustAuthenticate, "user is not enabled")
+	}
+
+	var groups []string
+	for _, group := range jwtGroups(allClaims[verifier.issuer.GroupsClaim]) {
+		groups = append(groups, verifier.issuer.GroupPrincipalPrefix+group)
+	}
+	groups = append(groups, user.AllAuthenticated, "system:cattle:authenticated")
+
+	logrus.Debugf("Authenticated user %s with a JWT issued by %s", authUser.Name, claims.Issuer)
+
+	return &AuthenticatorResponse{
+		IsAuthed:      true,
+		User:          authUser.Name,
+		UserPrincipal: principalID,
+		Groups:        groups,
+		Extras: map[string][]string{
+			common.ExtraRequestHost:         {req.Host},
+			common.UserAttributePrincipalID: {principalID},
+			common.UserAttributeUserName:    {username},
+		},
+	}, nil
+}
+
+// TokenFromRequest is not supported, as JWTs aren't backed by Rancher tokens.
+func (a *jwtAuthenticator) TokenFromRequest(req *http.Request) (accessor.TokenAccessor, error) {
+	return nil, errJWTAuthNotSupported
+}
+
+// verifier returns the verifier of the given issuer, or nil if the issuer isn't trusted.
+// The verifiers are rebuilt whenever the setting changes.
+func (a *jwtAuthenticator) verifier(issuer string) (*jwtVerifier, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if current := a.issuers(); current != a.config || a.verifiers == nil {
+		issuers, err := ParseTrustedJWTIssuers(current)
+		if err != nil {
+			return nil, err
+		}
+		verifiers := make(map[string]*jwtVerifier, len(issuers))
+		for _, trusted := range issuers {
+			keySet := oidc.NewRemoteKeySet(a.ctx, trusted.JWKSURL)
+			verifiers[trusted.Issuer] = &jwtVerifier{
+				issuer: trusted,
+				// audiences are checked against all the trusted ones after verification
+				verifier: oidc.NewVerifier(trusted.Issuer, keySet, &oidc.Config{SkipClientIDCheck: true}),
+			}
+		}
+		a.config = current
+		a.verifiers = verifiers
+	}
+
+	return a.verifiers[issuer], nil
+}
+
+// jwtGroups returns the groups of a groups claim, which can be either a string or a list of strings.
+func jwtGroups(claim any) []string {
+	switch groups := claim.(type) {
+	case string:
+		return []string{groups}
+	case []any:
+		var result []string
+		for _, group := range groups {
+			if name, ok := group.(string); ok && name != "" {
+				result = append(result, name)
+			}
+		}
+		return result
+	}
+	return nil
+}
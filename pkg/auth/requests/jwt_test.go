@@ -0,0 +1,242 @@
+package requests
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwtv4 "github.com/golang-jwt/jwt/v4"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+const testJWTIssuer = "https://issuer.example.com"
+
+type jwtUserManager struct {
+	common.FakeUserManager
+	users map[string]*v3.User
+}
+
+func (m *jwtUserManager) GetUserByPrincipalID(principalName string) (*v3.User, error) {
+	return m.users[principalName], nil
+}
+
+func (m *jwtUserManager) EnsureUser(principalName, displayName string) (*v3.User, error) {
+	u := &v3.User{ObjectMeta: metav1.ObjectMeta{Name: "u-created"}, DisplayName: displayName, PrincipalIDs: []string{principalName}}
+	m.users[principalName] = u
+	return u, nil
+}
+
+func newJWKSServer(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	jwks := map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": "test",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func signJWT(t *testing.T, key *rsa.PrivateKey, claims jwtv4.MapClaims) string {
+	token := jwtv4.NewWithClaims(jwtv4.SigningMethodRS256, claims)
+	token.Header["kid"] = "test"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestParseTrustedJWTIssuers(t *testing.T) {
+	issuers, err := ParseTrustedJWTIssuers("")
+	require.NoError(t, err)
+	assert.Empty(t, issuers)
+
+	issuers, err = ParseTrustedJWTIssuers(`[{"issuer":"https://issuer","jwksURL":"https://issuer/keys","audiences":["rancher"]}]`)
+	require.NoError(t, err)
+	require.Len(t, issuers, 1)
+	assert.Equal(t, "sub", issuers[0].UsernameClaim)
+	assert.Equal(t, "jwt_user://https://issuer/", issuers[0].UserPrincipalPrefix)
+	assert.Equal(t, "jwt_group://https://issuer/", issuers[0].GroupPrincipalPrefix)
+
+	issuers, err = ParseTrustedJWTIssuers(`[{"issuer":"https://issuer","jwksURL":"https://issuer/keys","audiences":["rancher"],"userPrincipalPrefix":"jwt_user://https://issuer/ci/"}]`)
+	require.NoError(t, err)
+	require.Len(t, issuers, 1)
+	assert.Equal(t, "jwt_user://https://issuer/ci/", issuers[0].UserPrincipalPrefix)
+
+	for _, invalid := range []string{
+		`{}`,
+		`[{"jwksURL":"https://issuer/keys","audiences":["rancher"]}]`,
+		`[{"issuer":"https://issuer","audiences":["rancher"]}]`,
+		`[{"issuer":"https://issuer","jwksURL":"https://issuer/keys"}]`,
+		`[{"issuer":"https://issuer","jwksURL":"https://issuer/keys","audiences":["rancher"]},{"issuer":"https://issuer","jwksURL":"https://issuer/keys","audiences":["rancher"]}]`,
+		`[{"issuer":"https://issuer","jwksURL":"https://issuer/keys","audiences":["rancher"],"userPrincipalPrefix":"local://"}]`,
+		`[{"issuer":"https://issuer","jwksURL":"https://issuer/keys","audiences":["rancher"],"userPrincipalPrefix":"jwt_user://https://other/"}]`,
+		`[{"issuer":"https://issuer","jwksURL":"https://issuer/keys","audiences":["rancher"],"groupPrincipalPrefix":"github_org://"}]`,
+	} {
+		_, err := ParseTrustedJWTIssuers(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks := newJWKSServer(t, key)
+
+	config := `[{"issuer":"` + testJWTIssuer + `","jwksURL":"` + jwks.URL + `","audiences":["rancher"],"groupsClaim":"groups"}]`
+
+	validClaims := func() jwtv4.MapClaims {
+		return jwtv4.MapClaims{
+			"iss":    testJWTIssuer,
+			"aud":    "rancher",
+			"sub":    "1234",
+			"groups": []string{"devs", "ops"},
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		config      string
+		token       func() string
+		users       map[string]*v3.User
+		wantAuthed  bool
+		wantErr     bool
+		wantUser    string
+		wantGroups  []string
+		wantCreated bool
+	}{
+		{
+			name:   "valid JWT of existing user",
+			config: config,
+			token:  func() string { return signJWT(t, key, validClaims()) },
+			users: map[string]*v3.User{
+				"jwt_user://" + testJWTIssuer + "/1234": {ObjectMeta: metav1.ObjectMeta{Name: "u-1234"}},
+			},
+			wantAuthed: true,
+			wantUser:   "u-1234",
+			wantGroups: []string{"jwt_group://" + testJWTIssuer + "/devs", "jwt_group://" + testJWTIssuer + "/ops", user.AllAuthenticated, "system:cattle:authenticated"},
+		},
+		{
+			name:   "auth provider principal prefix is ignored",
+			config: `[{"issuer":"` + testJWTIssuer + `","jwksURL":"` + jwks.URL + `","audiences":["rancher"],"userPrincipalPrefix":"local://"}]`,
+			token:  func() string { return signJWT(t, key, validClaims()) },
+			users: map[string]*v3.User{
+				"local://1234": {ObjectMeta: metav1.ObjectMeta{Name: "u-1234"}},
+			},
+		},
+		{
+			name:   "not a JWT",
+			config: config,
+			token:  func() string { return "token-abcde:secret" },
+		},
+		{
+			name:   "untrusted issuer",
+			config: config,
+			token: func() string {
+				claims := validClaims()
+				claims["iss"] = "https://other.example.com"
+				return signJWT(t, key, claims)
+			},
+		},
+		{
+			name:   "no trusted issuers",
+			config: "",
+			token:  func() string { return signJWT(t, key, validClaims()) },
+		},
+		{
+			name:    "invalid signature",
+			config:  config,
+			token:   func() string { return signJWT(t, otherKey, validClaims()) },
+			wantErr: true,
+		},
+		{
+			name:   "expired JWT",
+			config: config,
+			token: func() string {
+				claims := validClaims()
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+				return signJWT(t, key, claims)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "untrusted audience",
+			config: config,
+			token: func() string {
+				claims := validClaims()
+				claims["aud"] = "other"
+				return signJWT(t, key, claims)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unknown user",
+			config:  config,
+			token:   func() string { return signJWT(t, key, validClaims()) },
+			wantErr: true,
+		},
+		{
+			name:        "unknown user is created",
+			config:      `[{"issuer":"` + testJWTIssuer + `","jwksURL":"` + jwks.URL + `","audiences":["rancher"],"createUsers":true}]`,
+			token:       func() string { return signJWT(t, key, validClaims()) },
+			wantAuthed:  true,
+			wantUser:    "u-created",
+			wantGroups:  []string{user.AllAuthenticated, "system:cattle:authenticated"},
+			wantCreated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := tt.users
+			if users == nil {
+				users = map[string]*v3.User{}
+			}
+			authenticator := &jwtAuthenticator{
+				ctx:         context.Background(),
+				userManager: &jwtUserManager{users: users},
+				issuers:     func() string { return tt.config },
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/v3/users", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token())
+
+			resp, err := authenticator.Authenticate(req)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrMustAuthenticate)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAuthed, resp.IsAuthed)
+			if !tt.wantAuthed {
+				return
+			}
+			assert.Equal(t, tt.wantUser, resp.User)
+			assert.Equal(t, tt.wantGroups, resp.Groups)
+			if tt.wantCreated {
+				assert.Contains(t, users, "jwt_user://"+testJWTIssuer+"/1234")
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@
 	"github.com/rancher/rancher/pkg/auth/api"
 	"github.com/rancher/rancher/pkg/auth/data"
 	"github.com/rancher/rancher/pkg/auth/providerrefresh"
+	"github.com/rancher/rancher/pkg/auth/providers"
 	"github.com/rancher/rancher/pkg/auth/providers/publicapi"
 	"github.com/rancher/rancher/pkg/auth/providers/saml"
 	"github.com/rancher/rancher/pkg/auth/requests"
@@ -77,6 +78,7 @@ func newAPIManagement(ctx context.Context, scaledContext *config.ScaledContext)
 	root.UseEncodedPath()
 	root.PathPrefix("/v3-public").Handler(publicAPI)
 	root.PathPrefix("/v1-saml").Handler(saml)
+	root.PathPrefix("/v1-oidc").Handler(providers.BackChannelLogoutHandler(scaledContext.Wrangler))
 	root.NotFoundHandler = privateAPI
 
 	return func(next http.Handler) http.Handler {
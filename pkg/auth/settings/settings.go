@@ -6,6 +6,7 @@
 	AuthUserSessionIdleTTLMinutes = newSetting("960")  // 16 hours
 	AuthUserInfoMaxAgeSeconds     = newSetting("3600") // 1 hour
 	FirstLogin                    = newSetting("true")
+	OIDCSessionRenewal            = newSetting("false")
 )
 
 type Setting interface {
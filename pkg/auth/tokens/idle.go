@@ -0,0 +1,100 @@
+package tokens
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+)
+
+// SessionTokenKind is the kind of login tokens.
+const SessionTokenKind = "session"
+
+// ParseIdleTTLMinutesByKind parses the value of the auth-user-session-idle-ttl-minutes-by-kind setting, a JSON object
+// mapping a kind of token to its idle timeout in minutes, e.g. {"session": 30, "kubeconfig": 480}.
+// Like auth-user-session-idle-ttl-minutes, timeouts must be at least 1 minute.
+func ParseIdleTTLMinutesByKind(value string) (map[string]int, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var ttls map[string]int
+	if err := json.Unmarshal([]byte(value), &ttls); err != nil {
+		return nil, fmt.Errorf("invalid idle timeouts by token kind: %w", err)
+	}
+	for kind, ttl := range ttls {
+		if ttl < 1 {
+			return nil, fmt.Errorf("invalid idle timeout of %s tokens: %d must be greater than or equal to 1", kind, ttl)
+		}
+	}
+	return ttls, nil
+}
+
+// ValidateIdleTTLMinutesByKind checks the value of the auth-user-session-idle-ttl-minutes-by-kind setting, given the
+// values of auth-user-session-ttl-minutes and auth-token-max-ttl-minutes. As for auth-user-session-idle-ttl-minutes,
+// the idle timeout of login sessions must not be greater than their time to live, and the idle timeout of the other
+// kinds of tokens must not be greater than the maximum time to live of tokens, if any.
+// Empty values stand for the defaults of the settings.
+func ValidateIdleTTLMinutesByKind(value, sessionTTL, maxTTL string) error {
+	ttls, err := ParseIdleTTLMinutesByKind(value)
+	if err != nil {
+		return err
+	}
+
+	if sessionTTL == "" {
+		sessionTTL = settings.AuthUserSessionTTLMinutes.Default
+	}
+	if maxTTL == "" {
+		maxTTL = settings.AuthTokenMaxTTLMinutes.Default
+	}
+	session, err := strconv.Atoi(sessionTTL)
+	if err != nil {
+		return nil
+	}
+	tokenMax, err := strconv.Atoi(maxTTL)
+	if err != nil {
+		return nil
+	}
+
+	for kind, ttl := range ttls {
+		if kind == SessionTokenKind {
+			if ttl > session {
+				return fmt.Errorf("idle timeout of %s tokens (%d) must not be greater than %s (%d)",
+					kind, ttl, settings.AuthUserSessionTTLMinutes.Name, session)
+			}
+		} else if tokenMax > 0 && ttl > tokenMax {
+			return fmt.Errorf("idle timeout of %s tokens (%d) must not be greater than %s (%d)",
+				kind, ttl, settings.AuthTokenMaxTTLMinutes.Name, tokenMax)
+		}
+	}
+	return nil
+}
+
+// IdleTTL returns the idle timeout of the tokens of the given kind, or 0 if they don't time out when idle.
+// Session tokens default to auth-user-session-idle-ttl-minutes, and the other kinds of tokens to no idle timeout.
+func IdleTTL(kind string) time.Duration {
+	ttls, err := ParseIdleTTLMinutesByKind(settings.AuthUserSessionIdleTTLMinutesByKind.Get())
+	if err != nil {
+		logrus.Errorf("Error parsing setting %s: %v", settings.AuthUserSessionIdleTTLMinutesByKind.Name, err)
+	}
+	if ttl, ok := ttls[kind]; ok {
+		return time.Duration(ttl) * time.Minute
+	}
+	if kind == SessionTokenKind {
+		return time.Duration(settings.AuthUserSessionIdleTTLMinutes.GetInt()) * time.Minute
+	}
+	return 0
+}
+
+// tokenKind returns the kind of the token. Tokens which aren't derived are login tokens, even when they predate the
+// kind label.
+func tokenKind(token *v3.Token) string {
+	if !token.IsDerived {
+		return SessionTokenKind
+	}
+	return token.Labels[TokenKindLabel]
+}
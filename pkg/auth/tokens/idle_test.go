@@ -0,0 +1,142 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseIdleTTLMinutesByKind(t *testing.T) {
+	ttls, err := ParseIdleTTLMinutesByKind("")
+	require.NoError(t, err)
+	assert.Empty(t, ttls)
+
+	ttls, err = ParseIdleTTLMinutesByKind(`{"session": 30, "kubeconfig": 480, "cli": 1}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"session": 30, "kubeconfig": 480, "cli": 1}, ttls)
+
+	_, err = ParseIdleTTLMinutesByKind(`{"kubeconfig": 0}`)
+	assert.Error(t, err)
+
+	_, err = ParseIdleTTLMinutesByKind(`{"kubeconfig": -1}`)
+	assert.Error(t, err)
+
+	_, err = ParseIdleTTLMinutesByKind(`{"kubeconfig": "8h"}`)
+	assert.Error(t, err)
+}
+
+func TestValidateIdleTTLMinutesByKind(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		sessionTTL string
+		maxTTL     string
+		wantErr    bool
+	}{
+		{
+			name:  "empty",
+			value: "",
+		},
+		{
+			name:       "within the times to live",
+			value:      `{"session": 60, "kubeconfig": 480}`,
+			sessionTTL: "60",
+			maxTTL:     "480",
+		},
+		{
+			name:       "default times to live",
+			value:      `{"session": 960, "kubeconfig": 129600}`,
+			sessionTTL: "",
+			maxTTL:     "",
+		},
+		{
+			name:       "no maximum time to live",
+			value:      `{"kubeconfig": 1000000}`,
+			sessionTTL: "60",
+			maxTTL:     "0",
+		},
+		{
+			name:       "session idle timeout greater than the session time to live",
+			value:      `{"session": 61}`,
+			sessionTTL: "60",
+			maxTTL:     "0",
+			wantErr:    true,
+		},
+		{
+			name:       "idle timeout greater than the maximum time to live",
+			value:      `{"kubeconfig": 481}`,
+			sessionTTL: "60",
+			maxTTL:     "480",
+			wantErr:    true,
+		},
+		{
+			name:    "idle timeout less than 1",
+			value:   `{"kubeconfig": 0}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIdleTTLMinutesByKind(tt.value, tt.sessionTTL, tt.maxTTL)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIdleTTL(t *testing.T) {
+	origByKind := settings.AuthUserSessionIdleTTLMinutesByKind.Get()
+	origIdleTTL := settings.AuthUserSessionIdleTTLMinutes.Get()
+	t.Cleanup(func() {
+		require.NoError(t, settings.AuthUserSessionIdleTTLMinutesByKind.Set(origByKind))
+		require.NoError(t, settings.AuthUserSessionIdleTTLMinutes.Set(origIdleTTL))
+	})
+	require.NoError(t, settings.AuthUserSessionIdleTTLMinutes.Set("60"))
+
+	require.NoError(t, settings.AuthUserSessionIdleTTLMinutesByKind.Set(""))
+	assert.Equal(t, time.Hour, IdleTTL(SessionTokenKind))
+	assert.Zero(t, IdleTTL("kubeconfig"))
+
+	require.NoError(t, settings.AuthUserSessionIdleTTLMinutesByKind.Set(`{"session": 30, "kubeconfig": 480}`))
+	assert.Equal(t, 30*time.Minute, IdleTTL(SessionTokenKind))
+	assert.Equal(t, 8*time.Hour, IdleTTL("kubeconfig"))
+	assert.Zero(t, IdleTTL("other"))
+
+	// an invalid setting falls back to the defaults
+	require.NoError(t, settings.AuthUserSessionIdleTTLMinutesByKind.Set(`invalid`))
+	assert.Equal(t, time.Hour, IdleTTL(SessionTokenKind))
+	assert.Zero(t, IdleTTL("kubeconfig"))
+}
+
+func TestIsIdleExpiredDerivedToken(t *testing.T) {
+	origByKind := settings.AuthUserSessionIdleTTLMinutesByKind.Get()
+	t.Cleanup(func() {
+		require.NoError(t, settings.AuthUserSessionIdleTTLMinutesByKind.Set(origByKind))
+	})
+
+	token := v3.Token{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{TokenKindLabel: "kubeconfig"},
+		},
+		IsDerived: true,
+		ActivityLastSeenAt: &metav1.Time{
+			Time: time.Date(2025, 2, 5, 13, 10, 0, 0, time.UTC),
+		},
+	}
+	now := metav1.Date(2025, 2, 5, 13, 11, 0, 0, time.UTC)
+
+	require.NoError(t, settings.AuthUserSessionIdleTTLMinutesByKind.Set(""))
+	assert.False(t, IsIdleExpired(token, now))
+
+	require.NoError(t, settings.AuthUserSessionIdleTTLMinutesByKind.Set(`{"kubeconfig": 480}`))
+	assert.True(t, IsIdleExpired(token, now))
+}
@@ -632,6 +632,9 @@ func (m *Manager) UserAttributeCreateOrUpdate(userID, provider string, groupPrin
 		lastLogin := metav1.NewTime(loginTime[0].Truncate(time.Second))
 		attribs.LastLogin = &lastLogin
 		shouldUpdate = true
+
+		// The user was allowed to log in again, their suspended access to the provider is restored.
+		delete(attribs.SuspendedByProvider, provider)
 	}
 
 	attribs.GroupPrincipals[provider] = v32.Principals{Items: groupPrincipals}
@@ -874,3 +877,42 @@ func GetKubeconfigDefaultTokenTTLInMilliSeconds() (*int64, error) {
 	ttlMilli := tokenTTL.Milliseconds()
 	return &ttlMilli, nil
 }
+
+// KubeconfigTokenTTLAnnotation is the annotation of a cluster overriding the kubeconfig-default-token-ttl-minutes
+// setting for the kubeconfig tokens generated for the cluster, in minutes. The overridden TTL is still limited by the
+// auth-token-max-ttl-minutes setting.
+const KubeconfigTokenTTLAnnotation = "management.cattle.io/kubeconfig-token-ttl-minutes"
+
+// GetClusterKubeconfigTokenTTLOverrideInMilliSeconds will return the TTL for kubeconfig tokens overridden by the
+// annotations of a cluster, or nil if the cluster doesn't override it.
+func GetClusterKubeconfigTokenTTLOverrideInMilliSeconds(annotations map[string]string) (*int64, error) {
+	value, ok := annotations[KubeconfigTokenTTLAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	ttl, err := ParseTokenTTL(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse annotation '%s': %w", KubeconfigTokenTTLAnnotation, err)
+	}
+	if ttl < 0 {
+		return nil, fmt.Errorf("invalid annotation '%s': %s must not be negative", KubeconfigTokenTTLAnnotation, value)
+	}
+
+	tokenTTL, err := ClampToMaxTTL(ttl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate token ttl: %w", err)
+	}
+	ttlMilli := tokenTTL.Milliseconds()
+	return &ttlMilli, nil
+}
+
+// GetClusterKubeconfigTokenTTLInMilliSeconds will return the TTL for the kubeconfig tokens of a cluster with the given
+// annotations: the TTL overridden by the cluster if any, or the default TTL for kubeconfig tokens.
+func GetClusterKubeconfigTokenTTLInMilliSeconds(annotations map[string]string) (*int64, error) {
+	ttl, err := GetClusterKubeconfigTokenTTLOverrideInMilliSeconds(annotations)
+	if err != nil || ttl != nil {
+		return ttl, err
+	}
+	return GetKubeconfigDefaultTokenTTLInMilliSeconds()
+}
@@ -12,6 +12,7 @@
 	"github.com/rancher/rancher/pkg/features"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	mgmtFakes "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/wrangler/v3/pkg/randomtoken"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -243,6 +244,49 @@ func TestUserAttributeCreateOrUpdateSetsLastLoginTime(t *testing.T) {
 	assert.Equal(t, loginTime.Truncate(time.Second), createdUserAttribute.LastLogin.Time)
 }
 
+func TestUserAttributeCreateOrUpdateRestoresSuspendedAccess(t *testing.T) {
+	updatedUserAttribute := &v3.UserAttribute{}
+
+	userID := "u-abcdef"
+	manager := Manager{
+		userLister: &mgmtFakes.UserListerMock{
+			GetFunc: func(namespace, name string) (*v3.User, error) {
+				return &v3.User{
+					ObjectMeta: v1.ObjectMeta{
+						Name: userID,
+					},
+					Enabled: pointer.BoolPtr(true),
+				}, nil
+			},
+		},
+		userAttributeLister: &mgmtFakes.UserAttributeListerMock{
+			GetFunc: func(namespace, name string) (*v3.UserAttribute, error) {
+				return &v3.UserAttribute{
+					ObjectMeta: v1.ObjectMeta{
+						Name: userID,
+					},
+					SuspendedByProvider: map[string]v1.Time{
+						"provider":       v1.Now(),
+						"other-provider": v1.Now(),
+					},
+				}, nil
+			},
+		},
+		userAttributes: &mgmtFakes.UserAttributeInterfaceMock{
+			UpdateFunc: func(userAttribute *v3.UserAttribute) (*v3.UserAttribute, error) {
+				updatedUserAttribute = userAttribute.DeepCopy()
+				return updatedUserAttribute, nil
+			},
+		},
+	}
+
+	err := manager.UserAttributeCreateOrUpdate(userID, "provider", []v3.Principal{}, map[string][]string{}, time.Now())
+	assert.NoError(t, err)
+
+	assert.NotContains(t, updatedUserAttribute.SuspendedByProvider, "provider")
+	assert.Contains(t, updatedUserAttribute.SuspendedByProvider, "other-provider")
+}
+
 func TestUserAttributeCreateOrUpdateUpdatesGroups(t *testing.T) {
 	updatedUserAttribute := &v3.UserAttribute{}
 
@@ -296,3 +340,62 @@ func TestUserAttributeCreateOrUpdateUpdatesGroups(t *testing.T) {
 	require.Len(t, principals.Items, 1)
 	assert.Equal(t, principals.Items[0].Name, "group1")
 }
+
+func TestGetClusterKubeconfigTokenTTLInMilliSeconds(t *testing.T) {
+	origDefaultTTL := settings.KubeconfigDefaultTokenTTLMinutes.Get()
+	origMaxTTL := settings.AuthTokenMaxTTLMinutes.Get()
+	t.Cleanup(func() {
+		require.NoError(t, settings.KubeconfigDefaultTokenTTLMinutes.Set(origDefaultTTL))
+		require.NoError(t, settings.AuthTokenMaxTTLMinutes.Set(origMaxTTL))
+	})
+	require.NoError(t, settings.KubeconfigDefaultTokenTTLMinutes.Set("600"))
+	require.NoError(t, settings.AuthTokenMaxTTLMinutes.Set("1440"))
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        int64
+		wantErr     bool
+	}{
+		{
+			name: "default TTL",
+			want: (600 * time.Minute).Milliseconds(),
+		},
+		{
+			name:        "shorter TTL",
+			annotations: map[string]string{KubeconfigTokenTTLAnnotation: "60"},
+			want:        time.Hour.Milliseconds(),
+		},
+		{
+			name:        "longer TTL",
+			annotations: map[string]string{KubeconfigTokenTTLAnnotation: "720"},
+			want:        (720 * time.Minute).Milliseconds(),
+		},
+		{
+			name:        "TTL is clamped to the max TTL",
+			annotations: map[string]string{KubeconfigTokenTTLAnnotation: "0"},
+			want:        (1440 * time.Minute).Milliseconds(),
+		},
+		{
+			name:        "negative TTL",
+			annotations: map[string]string{KubeconfigTokenTTLAnnotation: "-60"},
+			wantErr:     true,
+		},
+		{
+			name:        "invalid TTL",
+			annotations: map[string]string{KubeconfigTokenTTLAnnotation: "1h"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ttl, err := GetClusterKubeconfigTokenTTLInMilliSeconds(tt.annotations)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *ttl)
+		})
+	}
+}
@@ -40,8 +40,9 @@ func (p *purger) purge() {
 	}
 
 	var count int
+	now := metav1.Now()
 	for _, token := range allTokens {
-		if IsExpired(*token) {
+		if IsExpired(*token) || IsIdleExpired(*token, now) || IsSessionMaxTTLExpired(*token, now.Time) {
 			err = p.tokens.Delete(token.ObjectMeta.Name, &metav1.DeleteOptions{})
 			if err != nil && !clientbase.IsNotFound(err) {
 				logrus.Errorf("Error: while deleting expired token %v: %v", err, token.ObjectMeta.Name)
@@ -0,0 +1,72 @@
+package tokens
+
+import (
+	"fmt"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+)
+
+// ParseSessionMaxTTL parses the value of the auth-user-session-max-ttl setting.
+// An empty value or a zero duration means the lifetime of login sessions isn't limited.
+func ParseSessionMaxTTL(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maximum session lifetime: %w", err)
+	}
+	if ttl < 0 {
+		return 0, fmt.Errorf("invalid maximum session lifetime: %s must not be negative", value)
+	}
+	return ttl, nil
+}
+
+// SessionMaxExpiresAt returns the time at which a login session created at the given time reaches its maximum
+// lifetime, and false if the lifetime of login sessions isn't limited.
+func SessionMaxExpiresAt(created time.Time) (time.Time, bool) {
+	ttl, err := ParseSessionMaxTTL(settings.AuthUserSessionMaxTTL.Get())
+	if err != nil {
+		logrus.Errorf("Error parsing setting %s: %v", settings.AuthUserSessionMaxTTL.Name, err)
+		return time.Time{}, false
+	}
+	if ttl == 0 {
+		return time.Time{}, false
+	}
+	return created.Add(ttl), true
+}
+
+// IsSessionMaxTTLExpired checks if the login session of the token reached its maximum lifetime.
+// Derived tokens aren't login sessions, and their lifetime is only limited by their own time to live.
+func IsSessionMaxTTLExpired(token v3.Token, now time.Time) bool {
+	if token.IsDerived {
+		return false
+	}
+
+	expiresAt, ok := SessionMaxExpiresAt(token.CreationTimestamp.Time)
+	return ok && !now.Before(expiresAt)
+}
+
+// RenewedSessionTTL returns the time to live, in milliseconds, of a login session created at the given time and
+// renewed now, i.e. expiring auth-user-session-ttl-minutes from now, within its maximum lifetime. It returns false if
+// the renewal doesn't extend the current time to live, e.g. of sessions which don't expire.
+func RenewedSessionTTL(created time.Time, ttl int64, now time.Time) (int64, bool) {
+	if ttl <= 0 {
+		return ttl, false
+	}
+
+	expiresAt := now.Add(time.Duration(settings.AuthUserSessionTTLMinutes.GetInt()) * time.Minute)
+	if maxExpiresAt, ok := SessionMaxExpiresAt(created); ok && maxExpiresAt.Before(expiresAt) {
+		expiresAt = maxExpiresAt
+	}
+
+	renewed := expiresAt.Sub(created).Milliseconds()
+	if renewed <= ttl {
+		return ttl, false
+	}
+	return renewed, true
+}
@@ -0,0 +1,93 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseSessionMaxTTL(t *testing.T) {
+	ttl, err := ParseSessionMaxTTL("")
+	require.NoError(t, err)
+	assert.Zero(t, ttl)
+
+	ttl, err = ParseSessionMaxTTL("12h")
+	require.NoError(t, err)
+	assert.Equal(t, 12*time.Hour, ttl)
+
+	_, err = ParseSessionMaxTTL("-1h")
+	assert.Error(t, err)
+
+	_, err = ParseSessionMaxTTL("12")
+	assert.Error(t, err)
+}
+
+func TestIsSessionMaxTTLExpired(t *testing.T) {
+	origMaxTTL := settings.AuthUserSessionMaxTTL.Get()
+	t.Cleanup(func() {
+		require.NoError(t, settings.AuthUserSessionMaxTTL.Set(origMaxTTL))
+	})
+
+	created := time.Date(2025, 2, 5, 8, 0, 0, 0, time.UTC)
+	token := v3.Token{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+	}
+	derived := v3.Token{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		IsDerived:  true,
+	}
+
+	require.NoError(t, settings.AuthUserSessionMaxTTL.Set(""))
+	assert.False(t, IsSessionMaxTTLExpired(token, created.Add(24*time.Hour)))
+
+	require.NoError(t, settings.AuthUserSessionMaxTTL.Set("12h"))
+	assert.False(t, IsSessionMaxTTLExpired(token, created.Add(11*time.Hour)))
+	assert.True(t, IsSessionMaxTTLExpired(token, created.Add(12*time.Hour)))
+	assert.False(t, IsSessionMaxTTLExpired(derived, created.Add(24*time.Hour)))
+
+	// an invalid setting doesn't limit the lifetime of sessions
+	require.NoError(t, settings.AuthUserSessionMaxTTL.Set("invalid"))
+	assert.False(t, IsSessionMaxTTLExpired(token, created.Add(24*time.Hour)))
+}
+
+func TestRenewedSessionTTL(t *testing.T) {
+	origTTL := settings.AuthUserSessionTTLMinutes.Get()
+	origMaxTTL := settings.AuthUserSessionMaxTTL.Get()
+	t.Cleanup(func() {
+		require.NoError(t, settings.AuthUserSessionTTLMinutes.Set(origTTL))
+		require.NoError(t, settings.AuthUserSessionMaxTTL.Set(origMaxTTL))
+	})
+	require.NoError(t, settings.AuthUserSessionTTLMinutes.Set("60"))
+	require.NoError(t, settings.AuthUserSessionMaxTTL.Set(""))
+
+	created := time.Date(2025, 2, 5, 8, 0, 0, 0, time.UTC)
+	hour := time.Hour.Milliseconds()
+
+	ttl, ok := RenewedSessionTTL(created, hour, created.Add(30*time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, 90*time.Minute.Milliseconds(), ttl)
+
+	// renewing right after the creation doesn't extend the session
+	ttl, ok = RenewedSessionTTL(created, hour, created)
+	assert.False(t, ok)
+	assert.Equal(t, hour, ttl)
+
+	// sessions which don't expire aren't renewed
+	_, ok = RenewedSessionTTL(created, 0, created.Add(30*time.Minute))
+	assert.False(t, ok)
+
+	// renewed sessions expire at their maximum lifetime
+	require.NoError(t, settings.AuthUserSessionMaxTTL.Set("75m"))
+	ttl, ok = RenewedSessionTTL(created, hour, created.Add(30*time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, 75*time.Minute.Milliseconds(), ttl)
+
+	ttl, ok = RenewedSessionTTL(created, 75*time.Minute.Milliseconds(), created.Add(70*time.Minute))
+	assert.False(t, ok)
+	assert.Equal(t, 75*time.Minute.Milliseconds(), ttl)
+}
@@ -61,8 +61,9 @@ func IsExpired(token v3.Token) bool {
 }
 
 // IsIdleExpired checks if the idle session timeout was reached since last update.
+// Tokens of a kind whose idle timeout is disabled never expire when idle.
 func IsIdleExpired(token v3.Token, lastTimeActivity metav1.Time) bool {
-	if token.ActivityLastSeenAt.IsZero() {
+	if token.ActivityLastSeenAt.IsZero() || IdleTTL(tokenKind(&token)) == 0 {
 		return false
 	}
 
@@ -168,6 +169,10 @@ func VerifyToken(storedToken *v3.Token, tokenName, tokenKey string) (int, error)
 	if IsIdleExpired(*storedToken, metav1.Now()) {
 		return http.StatusGone, errors.New("must authenticate, idle session timeout expired")
 	}
+
+	if IsSessionMaxTTLExpired(*storedToken, time.Now()) {
+		return http.StatusGone, errors.New("must authenticate, maximum session lifetime reached")
+	}
 	return http.StatusOK, nil
 }
 
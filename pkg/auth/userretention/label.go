@@ -173,6 +173,8 @@ func setLabels(settings settings, user *mgmtv3.User, attribs *mgmtv3.UserAttribu
 		user.Labels = map[string]string{}
 	}
 
+	settings = settings.ForProvider(userProvider(user))
+
 	lastLogin := lastLoginTime(settings, attribs)
 	updated := ensureLabel(lastLogin, LastLoginLabelKey, user)
 
@@ -0,0 +1,81 @@
+package userretention
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/namespace"
+	wcorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DryRunReportConfigMapName is the name of the ConfigMap in the cattle-system namespace
+	// the dry run report is written to.
+	DryRunReportConfigMapName = "user-retention-dry-run-report"
+	// DryRunReportKey is the key of the ConfigMap data holding the report in JSON.
+	DryRunReportKey = "report.json"
+
+	DryRunActionDisable = "disable"
+	DryRunActionDelete  = "delete"
+)
+
+// DryRunReport lists the users the user retention process would disable or delete
+// if it wasn't running in dry run mode.
+type DryRunReport struct {
+	GeneratedAt metav1.Time    `json:"generatedAt"`
+	Users       []DryRunResult `json:"users"`
+}
+
+// DryRunResult is the action the user retention process would take for a user.
+type DryRunResult struct {
+	UserName  string       `json:"userName"`
+	Provider  string       `json:"provider"`
+	Action    string       `json:"action"`
+	LastLogin *metav1.Time `json:"lastLogin,omitempty"`
+}
+
+func (r *DryRunReport) add(user *v3.User, provider, action string, lastLogin time.Time) {
+	result := DryRunResult{
+		UserName: user.Name,
+		Provider: provider,
+		Action:   action,
+	}
+	if !lastLogin.IsZero() {
+		result.LastLogin = &metav1.Time{Time: lastLogin}
+	}
+	r.Users = append(r.Users, result)
+}
+
+// reportWriter returns a function writing the dry run report to a ConfigMap, replacing the previous report.
+func reportWriter(configMaps wcorev1.ConfigMapClient) func(report *DryRunReport) error {
+	return func(report *DryRunReport) error {
+		data, err := json.Marshal(report)
+		if err != nil {
+			return fmt.Errorf("error marshaling report: %w", err)
+		}
+
+		existing, err := configMaps.Get(namespace.System, DryRunReportConfigMapName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = configMaps.Create(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      DryRunReportConfigMapName,
+					Namespace: namespace.System,
+				},
+				Data: map[string]string{DryRunReportKey: string(data)},
+			})
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		existing = existing.DeepCopy()
+		existing.Data = map[string]string{DryRunReportKey: string(data)}
+		_, err = configMaps.Update(existing)
+		return err
+	}
+}
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
@@ -20,6 +21,8 @@
 	LastLoginLabelKey    = "cattle.io/last-login"
 	DisableAfterLabelKey = "cattle.io/disable-after"
 	DeleteAfterLabelKey  = "cattle.io/delete-after"
+
+	localProvider = "local"
 )
 
 // Retention is the user retention process that disables or deletes inactive users
@@ -31,11 +34,14 @@
 // - only disable users (disableAfter > 0 && deleteAfter == 0)
 // - progressively disable and delete users (0 < disableAfter < deleteAfter)
 // - only delete users (disableAfter == 0 && deleteAfter > 0 or 0 < deleteAfter < disableAfter)
+// Both settings can be overridden for the users of an auth provider by providerRules.
+// In dry run mode the users that would be disabled or deleted are written to a report.
 type Retention struct {
 	userAttributeCache mgmtcontrollers.UserAttributeCache
 	userCache          mgmtcontrollers.UserCache
 	users              mgmtcontrollers.UserClient
 	readSettings       func() (settings, error)
+	writeReport        func(report *DryRunReport) error
 }
 
 // New creates a new instance of Retention.
@@ -45,6 +51,7 @@ func New(wContext *wrangler.Context) *Retention {
 		users:              wContext.Mgmt.User(),
 		userAttributeCache: wContext.Mgmt.UserAttribute().Cache(),
 		readSettings:       readSettings,
+		writeReport:        reportWriter(wContext.Core.ConfigMap()),
 	}
 }
 
@@ -62,8 +69,8 @@ func (r *Retention) Run(ctx context.Context) error {
 		return fmt.Errorf("error reading settings: %w, retention is disabled", err)
 	}
 
-	if !settings.ShouldDisable() && !settings.ShouldDelete() {
-		logrus.Info("userretention: nothing to do, neither DisableInactiveUserAfter nor DeleteInactiveUserAfter is set")
+	if !settings.ShouldRun() {
+		logrus.Info("userretention: nothing to do, neither DisableInactiveUserAfter nor DeleteInactiveUserAfter nor UserRetentionProviderRules is set")
 		return nil
 	}
 
@@ -79,6 +86,7 @@ func (r *Retention) Run(ctx context.Context) error {
 
 	var processed, skipped, disabled, deleted, errCount int
 	now := time.Now()
+	report := &DryRunReport{GeneratedAt: metav1.NewTime(now)}
 
 	defer func() {
 		logrus.Infof(
@@ -100,7 +108,10 @@ func (r *Retention) Run(ctx context.Context) error {
 
 		processed++
 
-		logrus.Debugf("userretention: processing user %s", user.Name)
+		provider := userProvider(user)
+		userSettings := settings.ForProvider(provider)
+
+		logrus.Debugf("userretention: processing user %s (provider %s)", user.Name, provider)
 
 		attribs, err := r.userAttributeCache.Get(user.Name)
 		if err != nil && !apierrors.IsNotFound(err) {
@@ -124,7 +135,7 @@ func (r *Retention) Run(ctx context.Context) error {
 
 		lastLogin := lastLoginTime(settings, attribs)
 		if !lastLogin.IsZero() {
-			deleteAfterTime := lastLogin.Add(settings.deleteAfter)
+			deleteAfterTime := lastLogin.Add(userSettings.deleteAfter)
 			if attribs.DeleteAfter != nil { // Apply user-specific override.
 				if userDeleteAfter = attribs.DeleteAfter.Duration; userDeleteAfter <= 0 {
 					deleteAfterTime = time.Time{} // The user shouldn't be considered for deletion.
@@ -134,7 +145,7 @@ func (r *Retention) Run(ctx context.Context) error {
 			}
 			deleteAfterTime = deleteAfterTime.Truncate(time.Second)
 
-			disableAfterTime := lastLogin.Add(settings.disableAfter)
+			disableAfterTime := lastLogin.Add(userSettings.disableAfter)
 			if attribs.DisableAfter != nil { // Apply user-specific override.
 				if userDisableAfter = attribs.DisableAfter.Duration; userDisableAfter <= 0 {
 					disableAfterTime = time.Time{} // The user shouldn't be considered for being disabled.
@@ -149,11 +160,13 @@ func (r *Retention) Run(ctx context.Context) error {
 				skipped++ // This is to keep the counter updated.
 			}
 
-			if settings.ShouldDelete() && !deleteAfterTime.IsZero() &&
+			if userSettings.ShouldDelete() && !deleteAfterTime.IsZero() &&
 				now.After(deleteAfterTime) {
 				logrus.Infof("userretention: deleting user %s", user.Name)
 
-				if !settings.dryRun {
+				if settings.dryRun {
+					report.add(user, provider, DryRunActionDelete, lastLogin)
+				} else {
 					err := r.users.Delete(user.Name, &metav1.DeleteOptions{})
 					if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsGone(err) {
 						logrus.Errorf("userretention: error deleting user %s: %v", user.Name, err)
@@ -167,9 +180,12 @@ func (r *Retention) Run(ctx context.Context) error {
 
 			}
 
-			if settings.ShouldDisable() && !disableAfterTime.IsZero() &&
+			if userSettings.ShouldDisable() && !disableAfterTime.IsZero() &&
 				now.After(disableAfterTime) && pointer.BoolDeref(user.Enabled, true) {
 				logrus.Infof("userretention: disabling user %s", user.Name)
+				if settings.dryRun {
+					report.add(user, provider, DryRunActionDisable, lastLogin)
+				}
 				// Flag the needed update but don't apply it as we may need to update retention labels too.
 				disableUser = true
 				disabled++
@@ -228,6 +244,12 @@ func (r *Retention) Run(ctx context.Context) error {
 		}
 	}
 
+	if settings.dryRun && ctx.Err() == nil {
+		if err := r.writeReport(report); err != nil {
+			return fmt.Errorf("error writing dry run report: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -246,3 +268,18 @@ func lastLoginTime(settings settings, attribs *v3.UserAttribute) time.Time {
 
 	return time.Time{}
 }
+
+// userProvider returns the name of the auth provider of the user, which is the provider
+// of its first non-local principal or local if the user only has local principals.
+func userProvider(user *v3.User) string {
+	for _, principalID := range user.PrincipalIDs {
+		scheme, _, found := strings.Cut(principalID, "://")
+		if !found || scheme == localProvider {
+			continue
+		}
+		// Principal schemes are formed by the provider name and the principal type e.g. github_user.
+		return strings.TrimSuffix(scheme, "_user")
+	}
+
+	return localProvider
+}
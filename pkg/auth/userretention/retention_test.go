@@ -327,6 +327,7 @@ func TestRetentionDryRun(t *testing.T) {
 			LastLogin: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
 		},
 	}
+	var report *DryRunReport
 
 	ctrl := gomock.NewController(t)
 
@@ -368,6 +369,10 @@ func TestRetentionDryRun(t *testing.T) {
 				dryRun:       true,
 			}, nil
 		},
+		writeReport: func(r *DryRunReport) error {
+			report = r
+			return nil
+		},
 	}
 
 	err := retention.Run(context.Background())
@@ -375,6 +380,23 @@ func TestRetentionDryRun(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	if report == nil {
+		t.Fatal("Expected dry run report")
+	}
+	results := make(map[string]DryRunResult, len(report.Users))
+	for _, result := range report.Users {
+		results[result.UserName] = result
+	}
+	if want, got := "disable", results["u-ckrl4grxg5"].Action; want != got {
+		t.Errorf("Expected action for user u-ckrl4grxg5 %s got %s", want, got)
+	}
+	if want, got := "delete", results["u-mo773yttt4"].Action; want != got {
+		t.Errorf("Expected action for user u-mo773yttt4 %s got %s", want, got)
+	}
+	if want, got := "activedirectory", results["u-mo773yttt4"].Provider; want != got {
+		t.Errorf("Expected provider for user u-mo773yttt4 %s got %s", want, got)
+	}
+
 	for id, user := range users {
 		if want, got := true, pointer.BoolDeref(user.Enabled, false); want != got {
 			t.Errorf("Expected Enabled for user %s %t got %t", id, want, got)
@@ -560,3 +582,119 @@ func TestRetentionRunContextCancelled(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestRetentionProviderRules(t *testing.T) {
+	now := time.Now()
+
+	users := map[string]*v3.User{
+		"u-cx7gc": {
+			ObjectMeta:   metav1.ObjectMeta{Name: "u-cx7gc"},
+			PrincipalIDs: []string{"local://u-cx7gc"},
+		},
+		"u-ckrl4grxg5": {
+			ObjectMeta:   metav1.ObjectMeta{Name: "u-ckrl4grxg5"},
+			PrincipalIDs: []string{"shibboleth_user://testuser2", "local://u-ckrl4grxg5"},
+		},
+		"u-mo773yttt4": {
+			ObjectMeta:   metav1.ObjectMeta{Name: "u-mo773yttt4"},
+			PrincipalIDs: []string{"github_user://1234", "local://u-mo773yttt4"},
+		},
+	}
+	userAttributes := map[string]*v3.UserAttribute{
+		"u-cx7gc": {
+			LastLogin: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+		},
+		"u-ckrl4grxg5": {
+			LastLogin: &metav1.Time{Time: now.Add(-1000 * time.Hour)},
+		},
+		"u-mo773yttt4": {
+			LastLogin: &metav1.Time{Time: now.Add(-1000 * time.Hour)},
+		},
+	}
+	var deleted []string
+
+	ctrl := gomock.NewController(t)
+
+	usersCacheClient := fake.NewMockNonNamespacedCacheInterface[*v3.User](ctrl)
+	usersCacheClient.EXPECT().List(gomock.Any()).Times(1).DoAndReturn(func(selector labels.Selector) ([]*v3.User, error) {
+		result := make([]*v3.User, 0, len(users))
+		for _, user := range users {
+			result = append(result, user.DeepCopy())
+		}
+		return result, nil
+	})
+
+	usersClient := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+	usersClient.EXPECT().Update(gomock.Any()).AnyTimes().DoAndReturn(func(user *v3.User) (*v3.User, error) {
+		return user, nil
+	})
+	usersClient.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(name string, options *metav1.DeleteOptions) error {
+		deleted = append(deleted, name)
+		return nil
+	})
+
+	userAttributeCacheClient := fake.NewMockNonNamespacedCacheInterface[*v3.UserAttribute](ctrl)
+	userAttributeCacheClient.EXPECT().Get(gomock.Any()).AnyTimes().DoAndReturn(func(name string) (*v3.UserAttribute, error) {
+		if attr, ok := userAttributes[name]; ok {
+			return attr, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+	})
+
+	retention := Retention{
+		userAttributeCache: userAttributeCacheClient,
+		userCache:          usersCacheClient,
+		users:              usersClient,
+		readSettings: func() (settings, error) {
+			return settings{
+				deleteAfter: 500 * time.Hour,
+				providerRules: map[string]providerRule{
+					"local":      {DeleteAfter: &metav1.Duration{Duration: time.Hour}},
+					"shibboleth": {DeleteAfter: &metav1.Duration{Duration: 0}},
+				},
+			}, nil
+		},
+	}
+
+	err := retention.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(deleted)
+	if want, got := []string{"u-cx7gc", "u-mo773yttt4"}, deleted; !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected deleted\n%v\ngot\n%v", want, got)
+	}
+}
+
+func TestUserProvider(t *testing.T) {
+	tests := []struct {
+		principalIDs []string
+		want         string
+	}{
+		{
+			want: "local",
+		},
+		{
+			principalIDs: []string{"local://u-cx7gc"},
+			want:         "local",
+		},
+		{
+			principalIDs: []string{"activedirectory_user://CN=testuser2,CN=Users,DC=qa,DC=rancher,DC=space", "local://u-ckrl4grxg5"},
+			want:         "activedirectory",
+		},
+		{
+			principalIDs: []string{"local://u-ckrl4grxg5", "okta_user://testuser@example.com"},
+			want:         "okta",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.principalIDs, ","), func(t *testing.T) {
+			user := &v3.User{PrincipalIDs: tt.principalIDs}
+			if want, got := tt.want, userProvider(user); want != got {
+				t.Errorf("Expected provider %s got %s", want, got)
+			}
+		})
+	}
+}
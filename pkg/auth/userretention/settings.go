@@ -1,11 +1,13 @@
 package userretention
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	appsettings "github.com/rancher/rancher/pkg/settings"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // settings control user retention process.
@@ -14,6 +16,14 @@ type settings struct {
 	deleteAfter      time.Duration
 	defaultLastLogin time.Time
 	dryRun           bool
+	providerRules    map[string]providerRule
+}
+
+// providerRule overrides the disableAfter and deleteAfter settings for the users of an auth provider.
+// A nil duration falls back to the setting, a zero duration means users are never disabled or deleted.
+type providerRule struct {
+	DisableAfter *metav1.Duration `json:"disableAfter,omitempty"`
+	DeleteAfter  *metav1.Duration `json:"deleteAfter,omitempty"`
 }
 
 // ShouldDisable returns true if the user retention process should disable users.
@@ -26,6 +36,40 @@ func (s *settings) ShouldDelete() bool {
 	return s.deleteAfter != 0
 }
 
+// ShouldRun returns true if the user retention process should disable or delete users
+// of at least one auth provider.
+func (s *settings) ShouldRun() bool {
+	if s.ShouldDisable() || s.ShouldDelete() {
+		return true
+	}
+
+	for _, rule := range s.providerRules {
+		if (rule.DisableAfter != nil && rule.DisableAfter.Duration > 0) ||
+			(rule.DeleteAfter != nil && rule.DeleteAfter.Duration > 0) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ForProvider returns the settings that apply to the users of the given auth provider.
+func (s settings) ForProvider(provider string) settings {
+	rule, ok := s.providerRules[provider]
+	if !ok {
+		return s
+	}
+
+	if rule.DisableAfter != nil {
+		s.disableAfter = max(rule.DisableAfter.Duration, 0)
+	}
+	if rule.DeleteAfter != nil {
+		s.deleteAfter = max(rule.DeleteAfter.Duration, 0)
+	}
+
+	return s
+}
+
 // FormatDefaultLastLogin returns formatted value of the default last login.
 func (s *settings) FormatDefaultLastLogin() string {
 	if s.defaultLastLogin.IsZero() {
@@ -63,6 +107,12 @@ func readSettings() (settings, error) {
 		}
 	}
 
+	if value := appsettings.UserRetentionProviderRules.Get(); value != "" {
+		if err = json.Unmarshal([]byte(value), &parsed.providerRules); err != nil {
+			return settings{}, fmt.Errorf("%s: %w", appsettings.UserRetentionProviderRules.Name, err)
+		}
+	}
+
 	parsed.dryRun = strings.EqualFold(appsettings.UserRetentionDryRun.Get(), "true")
 
 	return parsed, nil
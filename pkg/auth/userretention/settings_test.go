@@ -6,6 +6,7 @@
 	"time"
 
 	appsettings "github.com/rancher/rancher/pkg/settings"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestSettingsShouldDisable(t *testing.T) {
@@ -208,3 +209,118 @@ func TestReadSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestSettingsShouldRun(t *testing.T) {
+	tests := []struct {
+		desc      string
+		settings  settings
+		shouldRun bool
+	}{
+		{
+			desc: "nothing is set",
+		},
+		{
+			desc:      "disableAfter is set",
+			settings:  settings{disableAfter: time.Hour},
+			shouldRun: true,
+		},
+		{
+			desc:      "deleteAfter is set",
+			settings:  settings{deleteAfter: time.Hour},
+			shouldRun: true,
+		},
+		{
+			desc: "provider rules only retain users",
+			settings: settings{providerRules: map[string]providerRule{
+				"local": {DisableAfter: &metav1.Duration{}, DeleteAfter: &metav1.Duration{}},
+			}},
+		},
+		{
+			desc: "provider rule deletes users",
+			settings: settings{providerRules: map[string]providerRule{
+				"local": {DeleteAfter: &metav1.Duration{Duration: time.Hour}},
+			}},
+			shouldRun: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if want, got := tt.shouldRun, tt.settings.ShouldRun(); want != got {
+				t.Errorf("Expected %t got %t", want, got)
+			}
+		})
+	}
+}
+
+func TestSettingsForProvider(t *testing.T) {
+	s := settings{
+		disableAfter: time.Hour,
+		deleteAfter:  2 * time.Hour,
+		providerRules: map[string]providerRule{
+			"local":      {DeleteAfter: &metav1.Duration{Duration: 3 * time.Hour}},
+			"shibboleth": {DisableAfter: &metav1.Duration{}, DeleteAfter: &metav1.Duration{Duration: -time.Hour}},
+		},
+	}
+
+	tests := []struct {
+		provider     string
+		disableAfter time.Duration
+		deleteAfter  time.Duration
+	}{
+		{
+			provider:     "github",
+			disableAfter: time.Hour,
+			deleteAfter:  2 * time.Hour,
+		},
+		{
+			provider:     "local",
+			disableAfter: time.Hour,
+			deleteAfter:  3 * time.Hour,
+		},
+		{
+			provider: "shibboleth",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			got := s.ForProvider(tt.provider)
+			if want, got := tt.disableAfter, got.disableAfter; want != got {
+				t.Errorf("Expected disableAfter %v got %v", want, got)
+			}
+			if want, got := tt.deleteAfter, got.deleteAfter; want != got {
+				t.Errorf("Expected deleteAfter %v got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestReadSettingsProviderRules(t *testing.T) {
+	userRetentionProviderRules := appsettings.UserRetentionProviderRules.Get()
+	defer appsettings.UserRetentionProviderRules.Set(userRetentionProviderRules)
+
+	appsettings.UserRetentionProviderRules.Set(`{"local":{"disableAfter":"720h","deleteAfter":"2160h"},"shibboleth":{"deleteAfter":"0s"}}`)
+	parsed, err := readSettings()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]providerRule{
+		"local": {
+			DisableAfter: &metav1.Duration{Duration: 720 * time.Hour},
+			DeleteAfter:  &metav1.Duration{Duration: 2160 * time.Hour},
+		},
+		"shibboleth": {
+			DeleteAfter: &metav1.Duration{},
+		},
+	}
+	if got := parsed.providerRules; !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected \n%+v\ngot\n%+v", want, got)
+	}
+
+	appsettings.UserRetentionProviderRules.Set("foo")
+	if _, err := readSettings(); err == nil {
+		t.Error("Expected error got nil")
+	}
+}
@@ -0,0 +1,61 @@
+package util
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/rancher/rancher/pkg/settings"
+)
+
+// ClientIP returns the address of the client of the request. The X-Forwarded-For header is only trusted when the
+// request comes from one of the proxies of the trusted-proxy-cidrs setting, in which case the client is the last
+// address of the header not belonging to a trusted proxy, the addresses before it may be forged by the client.
+func ClientIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+
+	trusted := trustedProxies(settings.TrustedProxyCIDRs.Get())
+	if len(trusted) == 0 || !isTrustedProxy(trusted, host) {
+		return host
+	}
+
+	forwarded := strings.Split(strings.Join(req.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(addr) == nil {
+			// Anything before an invalid address can't be trusted either.
+			break
+		}
+		host = addr
+		if !isTrustedProxy(trusted, addr) {
+			break
+		}
+	}
+	return host
+}
+
+func trustedProxies(value string) []*net.IPNet {
+	var trusted []*net.IPNet
+	for _, cidr := range strings.Split(value, ",") {
+		if _, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr)); err == nil {
+			trusted = append(trusted, ipNet)
+		}
+	}
+	return trusted
+}
+
+func isTrustedProxy(trusted []*net.IPNet, addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range trusted {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
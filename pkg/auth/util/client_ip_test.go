@@ -0,0 +1,77 @@
+package util
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name         string
+		trusted      string
+		remoteAddr   string
+		forwardedFor []string
+		wantIP       string
+	}{
+		{
+			name:       "remote address without trusted proxies",
+			remoteAddr: "10.0.0.1:34567",
+			wantIP:     "10.0.0.1",
+		},
+		{
+			name:         "forwarded address isn't trusted without trusted proxies",
+			remoteAddr:   "10.0.0.1:34567",
+			forwardedFor: []string{"203.0.113.7"},
+			wantIP:       "10.0.0.1",
+		},
+		{
+			name:         "forwarded address isn't trusted from other addresses",
+			trusted:      "10.42.0.0/16",
+			remoteAddr:   "198.51.100.1:34567",
+			forwardedFor: []string{"203.0.113.7"},
+			wantIP:       "198.51.100.1",
+		},
+		{
+			name:         "forwarded address is trusted from a trusted proxy",
+			trusted:      "10.42.0.0/16",
+			remoteAddr:   "10.42.0.5:34567",
+			forwardedFor: []string{"203.0.113.7"},
+			wantIP:       "203.0.113.7",
+		},
+		{
+			name:         "addresses forged by the client are ignored",
+			trusted:      "10.42.0.0/16, 192.0.2.0/24",
+			remoteAddr:   "10.42.0.5:34567",
+			forwardedFor: []string{"1.2.3.4, 203.0.113.7", "192.0.2.10"},
+			wantIP:       "203.0.113.7",
+		},
+		{
+			name:         "invalid forwarded address is ignored",
+			trusted:      "10.42.0.0/16",
+			remoteAddr:   "10.42.0.5:34567",
+			forwardedFor: []string{"203.0.113.7, unknown"},
+			wantIP:       "10.42.0.5",
+		},
+		{
+			name:       "remote address without port",
+			remoteAddr: "10.0.0.1",
+			wantIP:     "10.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, settings.TrustedProxyCIDRs.Set(tt.trusted))
+			t.Cleanup(func() { _ = settings.TrustedProxyCIDRs.Set("") })
+
+			req := &http.Request{RemoteAddr: tt.remoteAddr, Header: http.Header{}}
+			for _, value := range tt.forwardedFor {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			assert.Equal(t, tt.wantIP, ClientIP(req))
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// Package whocan answers which users and groups can perform an action in a cluster or a project, by inverting the
+// global role bindings and the cluster and project role template bindings.
+package whocan
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	pkgrbac "github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/wrangler"
+	wrbacv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/kubernetes/plugin/pkg/auth/authorizer/rbac"
+)
+
+const (
+	localClusterName = "local"
+
+	// SubjectUser and SubjectGroup are the kinds of subjects.
+	SubjectUser  = "User"
+	SubjectGroup = "Group"
+)
+
+// Subject is a user or a group which can perform the action.
+type Subject struct {
+	// Kind is SubjectUser or SubjectGroup.
+	Kind string
+	// Name is the name of the user, or its principal ID if the bindings only name its principal, or the principal ID of
+	// the group.
+	Name string
+	// Bindings are the bindings allowing the subject to perform the action.
+	Bindings []Binding
+}
+
+// Binding is a binding allowing a subject to perform the action.
+type Binding struct {
+	// Kind is the kind of the binding, e.g. ClusterRoleTemplateBinding.
+	Kind string
+	// Namespace is the namespace of the binding, empty for global role bindings.
+	Namespace string
+	// Name is the name of the binding.
+	Name string
+	// RoleName is the name of the global role or role template the binding grants.
+	RoleName string
+}
+
+// Resolver finds the subjects which can perform an action from the bindings.
+//
+// The rules of a global role are held in the local cluster, its inherited cluster roles in the downstream clusters, and
+// global roles with admin permissions hold them in every cluster. The rules of a cluster role template binding are held
+// in its cluster, including in its projects, and the ones of a project role template binding in its project.
+type Resolver struct {
+	grbCache  mgmtcontrollers.GlobalRoleBindingCache
+	grCache   mgmtcontrollers.GlobalRoleCache
+	crtbCache mgmtcontrollers.ClusterRoleTemplateBindingCache
+	prtbCache mgmtcontrollers.ProjectRoleTemplateBindingCache
+	rtCache   mgmtcontrollers.RoleTemplateCache
+	crCache   wrbacv1.ClusterRoleCache
+}
+
+// NewResolver returns a resolver using the caches of the wrangler context.
+func NewResolver(wranglerContext *wrangler.Context) *Resolver {
+	return &Resolver{
+		grbCache:  wranglerContext.Mgmt.GlobalRoleBinding().Cache(),
+		grCache:   wranglerContext.Mgmt.GlobalRole().Cache(),
+		crtbCache: wranglerContext.Mgmt.ClusterRoleTemplateBinding().Cache(),
+		prtbCache: wranglerContext.Mgmt.ProjectRoleTemplateBinding().Cache(),
+		rtCache:   wranglerContext.Mgmt.RoleTemplate().Cache(),
+		crCache:   wranglerContext.RBAC.ClusterRole().Cache(),
+	}
+}
+
+// Subjects returns the subjects which can perform the action of the attributes in the cluster, or in the project of the
+// cluster if projectName is set, sorted by kind and name. The user of the attributes is ignored.
+func (r *Resolver) Subjects(clusterName, projectName string, a authorizer.Attributes) ([]Subject, error) {
+	type subjectKey struct{ kind, name string }
+	subjects := map[subjectKey]*Subject{}
+	add := func(userName, principalName, groupPrincipalName string, binding Binding) {
+		key := subjectKey{kind: SubjectUser, name: cmp.Or(userName, principalName)}
+		if key.name == "" {
+			key = subjectKey{kind: SubjectGroup, name: groupPrincipalName}
+		}
+		if key.name == "" {
+			return
+		}
+		subject, ok := subjects[key]
+		if !ok {
+			subject = &Subject{Kind: key.kind, Name: key.name}
+			subjects[key] = subject
+		}
+		subject.Bindings = append(subject.Bindings, binding)
+	}
+
+	grbs, err := r.grbCache.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("listing global role bindings: %w", err)
+	}
+	for _, grb := range grbs {
+		allowed, err := r.globalRoleAllows(grb.GlobalRoleName, clusterName, a)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			add(grb.UserName, grb.UserPrincipalName, grb.GroupPrincipalName,
+				Binding{Kind: "GlobalRoleBinding", Name: grb.Name, RoleName: grb.GlobalRoleName})
+		}
+	}
+
+	crtbs, err := r.crtbCache.List(clusterName, labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("listing cluster role template bindings of cluster %s: %w", clusterName, err)
+	}
+	for _, crtb := range crtbs {
+		if crtb.ClusterName != clusterName {
+			continue
+		}
+		allowed, err := r.roleTemplateAllows(crtb.RoleTemplateName, a)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			add(crtb.UserName, crtb.UserPrincipalName, crtb.GroupPrincipalName,
+				Binding{Kind: "ClusterRoleTemplateBinding", Namespace: crtb.Namespace, Name: crtb.Name, RoleName: crtb.RoleTemplateName})
+		}
+	}
+
+	if projectName != "" {
+		// The bindings are in the backing namespace of the project, which isn't always named after it.
+		prtbs, err := r.prtbCache.List("", labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("listing project role template bindings: %w", err)
+		}
+		for _, prtb := range prtbs {
+			if prtb.ProjectName != clusterName+":"+projectName {
+				continue
+			}
+			allowed, err := r.roleTemplateAllows(prtb.RoleTemplateName, a)
+			if err != nil {
+				return nil, err
+			}
+			if allowed {
+				add(prtb.UserName, prtb.UserPrincipalName, prtb.GroupPrincipalName,
+					Binding{Kind: "ProjectRoleTemplateBinding", Namespace: prtb.Namespace, Name: prtb.Name, RoleName: prtb.RoleTemplateName})
+			}
+		}
+	}
+
+	sorted := make([]Subject, 0, len(subjects))
+	for _, subject := range subjects {
+		sorted = append(sorted, *subject)
+	}
+	slices.SortFunc(sorted, func(a, b Subject) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Name, b.Name))
+	})
+	return sorted, nil
+}
+
+func (r *Resolver) globalRoleAllows(name, clusterName string, a authorizer.Attributes) (bool, error) {
+	gr, err := r.grCache.Get(name)
+	if apierrors.IsNotFound(err) {
+		// The binding of a removed global role grants nothing.
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting global role %s: %w", name, err)
+	}
+	if pkgrbac.GlobalRoleIsAdmin(gr) {
+		return true, nil
+	}
+
+	if clusterName == localClusterName {
+		rules := slices.Clone(gr.Rules)
+		if a.GetNamespace() != "" {
+			rules = append(rules, gr.NamespacedRules[a.GetNamespace()]...)
+		}
+		return rbac.RulesAllow(a, rules...), nil
+	}
+
+	for _, rtName := range gr.InheritedClusterRoles {
+		allowed, err := r.roleTemplateAllows(rtName, a)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
+
+func (r *Resolver) roleTemplateAllows(name string, a authorizer.Attributes) (bool, error) {
+	rules, err := r.roleTemplateRules(name)
+	if err != nil {
+		return false, err
+	}
+	return rbac.RulesAllow(a, rules...), nil
+}
+
+func (r *Resolver) roleTemplateRules(name string) ([]rbacv1.PolicyRule, error) {
+	rt, err := r.rtCache.Get(name)
+	if apierrors.IsNotFound(err) {
+		// The binding of a removed role template grants nothing.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting role template %s: %w", name, err)
+	}
+	rules, err := pkgrbac.RulesFromTemplate(r.crCache, r.rtCache, rt)
+	if err != nil {
+		return nil, fmt.Errorf("getting the rules of role template %s: %w", name, err)
+	}
+	return rules, nil
+}
@@ -0,0 +1,139 @@
+package whocan
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+func newResolver(t *testing.T) *Resolver {
+	ctrl := gomock.NewController(t)
+
+	grbCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleBinding](ctrl)
+	grbCache.EXPECT().List(labels.Everything()).Return([]*v3.GlobalRoleBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "grb-1"}, UserName: "u-1", GlobalRoleName: "admin"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "grb-2"}, UserName: "u-2", GlobalRoleName: "pod-reader"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "grb-3"}, GroupPrincipalName: "okta_group://ops", GlobalRoleName: "removed"},
+	}, nil).AnyTimes()
+	grCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRole](ctrl)
+	grCache.EXPECT().Get("admin").Return(&v3.GlobalRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin"},
+		Builtin:    true,
+	}, nil).AnyTimes()
+	grCache.EXPECT().Get("pod-reader").Return(&v3.GlobalRole{
+		ObjectMeta:            metav1.ObjectMeta{Name: "pod-reader"},
+		NamespacedRules:       map[string][]rbacv1.PolicyRule{"default": {{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}}},
+		InheritedClusterRoles: []string{"pod-viewer"},
+	}, nil).AnyTimes()
+	grCache.EXPECT().Get("removed").Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "removed")).AnyTimes()
+
+	crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+	crtbCache.EXPECT().List("c-1", labels.Everything()).Return([]*v3.ClusterRoleTemplateBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-1"}, ClusterName: "c-1", GroupPrincipalName: "okta_group://devs", RoleTemplateName: "pod-viewer"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "crtb-2", Namespace: "c-1"}, ClusterName: "c-1", UserPrincipalName: "okta_user://jane", RoleTemplateName: "removed"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "crtb-3", Namespace: "c-1"}, ClusterName: "c-1", UserName: "u-1", RoleTemplateName: "pod-viewer"},
+	}, nil).AnyTimes()
+	crtbCache.EXPECT().List(gomock.Any(), labels.Everything()).Return(nil, nil).AnyTimes()
+	prtbCache := fake.NewMockCacheInterface[*v3.ProjectRoleTemplateBinding](ctrl)
+	prtbCache.EXPECT().List("", labels.Everything()).Return([]*v3.ProjectRoleTemplateBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "prtb-1", Namespace: "p-1"}, ProjectName: "c-1:p-1", UserPrincipalName: "okta_user://jane", RoleTemplateName: "pod-viewer"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prtb-2", Namespace: "p-1"}, ProjectName: "c-2:p-1", UserName: "u-3", RoleTemplateName: "pod-viewer"},
+	}, nil).AnyTimes()
+
+	rtCache := fake.NewMockNonNamespacedCacheInterface[*v3.RoleTemplate](ctrl)
+	rtCache.EXPECT().Get("pod-viewer").Return(&v3.RoleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-viewer"},
+		Rules:      []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}, Verbs: []string{"get", "list"}}},
+	}, nil).AnyTimes()
+	rtCache.EXPECT().Get("removed").Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "removed")).AnyTimes()
+
+	return &Resolver{
+		grbCache:  grbCache,
+		grCache:   grCache,
+		crtbCache: crtbCache,
+		prtbCache: prtbCache,
+		rtCache:   rtCache,
+		crCache:   fake.NewMockNonNamespacedCacheInterface[*rbacv1.ClusterRole](ctrl),
+	}
+}
+
+func getPodLogs(namespace string) authorizer.AttributesRecord {
+	return authorizer.AttributesRecord{
+		Verb:            "get",
+		Resource:        "pods",
+		Subresource:     "log",
+		Namespace:       namespace,
+		ResourceRequest: true,
+	}
+}
+
+func TestSubjects(t *testing.T) {
+	resolver := newResolver(t)
+
+	subjects, err := resolver.Subjects("c-1", "", getPodLogs("default"))
+	require.NoError(t, err)
+	assert.Equal(t, []Subject{
+		{Kind: SubjectGroup, Name: "okta_group://devs", Bindings: []Binding{
+			{Kind: "ClusterRoleTemplateBinding", Namespace: "c-1", Name: "crtb-1", RoleName: "pod-viewer"},
+		}},
+		{Kind: SubjectUser, Name: "u-1", Bindings: []Binding{
+			{Kind: "GlobalRoleBinding", Name: "grb-1", RoleName: "admin"},
+			{Kind: "ClusterRoleTemplateBinding", Namespace: "c-1", Name: "crtb-3", RoleName: "pod-viewer"},
+		}},
+		{Kind: SubjectUser, Name: "u-2", Bindings: []Binding{
+			{Kind: "GlobalRoleBinding", Name: "grb-2", RoleName: "pod-reader"},
+		}},
+	}, subjects)
+}
+
+func TestSubjectsProject(t *testing.T) {
+	resolver := newResolver(t)
+	attributes := getPodLogs("default")
+	attributes.Verb = "delete"
+
+	subjects, err := resolver.Subjects("c-1", "p-1", attributes)
+	require.NoError(t, err)
+	assert.Equal(t, []Subject{
+		{Kind: SubjectUser, Name: "u-1", Bindings: []Binding{{Kind: "GlobalRoleBinding", Name: "grb-1", RoleName: "admin"}}},
+	}, subjects)
+
+	attributes.Verb = "list"
+	subjects, err = resolver.Subjects("c-1", "p-1", attributes)
+	require.NoError(t, err)
+	require.Len(t, subjects, 4)
+	// The bindings only naming the principal of the user are reported for the principal.
+	assert.Equal(t, Subject{Kind: SubjectUser, Name: "okta_user://jane", Bindings: []Binding{
+		{Kind: "ProjectRoleTemplateBinding", Namespace: "p-1", Name: "prtb-1", RoleName: "pod-viewer"},
+	}}, subjects[1])
+}
+
+func TestSubjectsLocalCluster(t *testing.T) {
+	resolver := newResolver(t)
+
+	// The namespaced rules of global roles only apply in the local cluster, in their namespace.
+	subjects, err := resolver.Subjects("local", "", authorizer.AttributesRecord{
+		Verb:            "get",
+		Resource:        "pods",
+		Namespace:       "default",
+		ResourceRequest: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, subjects, 2)
+	assert.Equal(t, "u-1", subjects[0].Name)
+	assert.Equal(t, "u-2", subjects[1].Name)
+
+	subjects, err = resolver.Subjects("local", "", getPodLogs("default"))
+	require.NoError(t, err)
+	require.Len(t, subjects, 1)
+	assert.Equal(t, "u-1", subjects[0].Name)
+}
@@ -51,6 +51,7 @@
 	DrainAnnotation                            = "rke.cattle.io/drain-options"
 	DrainDoneAnnotation                        = "rke.cattle.io/drain-done"
 	DrainErrorAnnotation                       = "rke.cattle.io/drain-error"
+	DrainStartedAnnotation                     = "rke.cattle.io/drain-started"
 	EtcdRoleLabel                              = "rke.cattle.io/etcd-role"
 	ForceRemoveEtcdAnnotation                  = "rke.cattle.io/etcd-force-remove"
 	HostnameLengthLimitAnnotation              = "rke.cattle.io/hostname-length-limit"
@@ -63,6 +64,7 @@
 	LabelsAnnotation                           = "rke.cattle.io/labels"
 	MachineIDLabel                             = "rke.cattle.io/machine-id"
 	MachineNameLabel                           = "rke.cattle.io/machine-name"
+	MachinePoolDrainOptionsAnnotation          = "rke.cattle.io/machine-pool-drain-options"
 	MachineTemplateHashLabel                   = "rke.cattle.io/machine-template-hash"
 	RKEMachinePoolNameLabel                    = "rke.cattle.io/rke-machine-pool-name"
 	MachineNamespaceLabel                      = "rke.cattle.io/machine-namespace"
@@ -80,6 +82,10 @@
 	PlanUpdatedTimeAnnotation                  = "rke.cattle.io/plan-last-updated"
 	PlanProbesPassedAnnotation                 = "rke.cattle.io/plan-probes-passed"
 	DeleteMissingCustomMachinesAfterAnnotation = "rke.cattle.io/delete-missing-custom-machines-after"
+	ReplaceUnhealthyMachinesAfterAnnotation    = "rke.cattle.io/replace-unhealthy-machines-after"
+	MaxUnhealthyMachinesAnnotation             = "rke.cattle.io/max-unhealthy-machines"
+	MaxMachineReplacementsAnnotation           = "rke.cattle.io/max-machine-replacements"
+	DisableMachineSelfHealingAnnotation        = "rke.cattle.io/disable-machine-self-healing"
 
 	SnapshotNameAnnotation = "etcdsnapshot.rke.io/snapshot-name"
 
@@ -96,6 +102,7 @@
 	BackupLabel = "resources.cattle.io/backup"
 
 	CattleOSLabel    = "cattle.io/os"
+	CattleArchLabel  = "cattle.io/arch"
 	DefaultMachineOS = "linux"
 	WindowsMachineOS = "windows"
 
@@ -115,6 +122,7 @@
 	InfrastructureReady          = condition.Cond(capi.InfrastructureReadyCondition)
 	SystemUpgradeControllerReady = condition.Cond("SystemUpgradeControllerReady")
 	Bootstrapped                 = condition.Cond("Bootstrapped")
+	CertificatesRotated          = condition.Cond("CertificatesRotated")
 
 	RuntimeK3S  = "k3s"
 	RuntimeRKE2 = "rke2"
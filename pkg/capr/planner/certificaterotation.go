@@ -9,11 +9,61 @@
 	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
 	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
 	"github.com/rancher/rancher/pkg/capr"
+	"github.com/rancher/rancher/pkg/rkecerts"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const certificateExpirationInstructionPrefix = "certificate-expiration-"
+
+// expiringCertificates are the certificates whose expiration dates are reported in the status of the control plane,
+// along with the nodes they are found on. Paths are relative to the data dir of the distribution.
+var expiringCertificates = []struct {
+	component string
+	path      string
+	include   roleFilter
+}{
+	{component: "kube-apiserver", path: "server/tls/serving-kube-apiserver.crt", include: isControlPlane},
+	{component: "kubelet", path: "agent/serving-kubelet.crt", include: func(*planEntry) bool { return true }},
+	{component: "etcd", path: "server/tls/etcd/server-client.crt", include: isEtcd},
+}
+
+func certificateExpirationInstructionName(component string) string {
+	return certificateExpirationInstructionPrefix + component
+}
+
+// certificateExpiration returns the earliest expiration date of the certificates of each component across the nodes of
+// the cluster, as scraped by the periodic instructions of their plans.
+func certificateExpiration(clusterPlan *plan.Plan) map[string]metav1.Time {
+	var result map[string]metav1.Time
+	for _, entry := range collect(clusterPlan, func(entry *planEntry) bool { return entry.Plan != nil }) {
+		for _, cert := range expiringCertificates {
+			output, ok := entry.Plan.PeriodicOutput[certificateExpirationInstructionName(cert.component)]
+			if !ok || output.ExitCode != 0 || len(output.Stdout) == 0 {
+				continue
+			}
+			notAfter, err := rkecerts.GetCertExpirationDate(string(output.Stdout))
+			if err != nil {
+				logrus.Debugf("[planner] failed to parse %s certificate of machine %s: %v", cert.component, entry.Machine.Name, err)
+				continue
+			}
+			if current, ok := result[cert.component]; ok && !notAfter.Before(current.Time) {
+				continue
+			}
+			if result == nil {
+				result = map[string]metav1.Time{}
+			}
+			result[cert.component] = metav1.NewTime(notAfter.UTC())
+		}
+	}
+	return result
+}
+
 // rotateCertificates checks if there is a need to rotate any certificates and updates the plan accordingly.
+// The progress of the rotation is reported by the CertificatesRotated condition.
 func (p *Planner) rotateCertificates(controlPlane *rkev1.RKEControlPlane, status rkev1.RKEControlPlaneStatus, tokensSecret plan.Secret, clusterPlan *plan.Plan) (rkev1.RKEControlPlaneStatus, error) {
+	status.CertificateExpiration = certificateExpiration(clusterPlan)
+
 	if !shouldRotate(controlPlane) {
 		return status, nil
 	}
@@ -29,20 +79,31 @@ func (p *Planner) rotateCertificates(controlPlane *rkev1.RKEControlPlane, status
 	}
 
 	// Assemble our list of nodes in order of etcd-only, etcd with controlplane, controlplane-only, and everything else
-	orderedEntriesToRotate := collectOrderedCertificateRotationEntries(clusterPlan)
-
-	for _, node := range orderedEntriesToRotate {
-		if !shouldRotateEntry(controlPlane.Spec.RotateCertificates, node) {
-			continue
+	var entriesToRotate []*planEntry
+	for _, node := range collectOrderedCertificateRotationEntries(clusterPlan) {
+		if shouldRotateEntry(controlPlane.Spec.RotateCertificates, node) {
+			entriesToRotate = append(entriesToRotate, node)
 		}
+	}
+
+	services := "all services"
+	if len(controlPlane.Spec.RotateCertificates.Services) > 0 {
+		services = strings.Join(controlPlane.Spec.RotateCertificates.Services, ", ")
+	}
 
+	for i, node := range entriesToRotate {
 		rotatePlan, joinedServer, err := p.rotateCertificatesPlan(controlPlane, tokensSecret, controlPlane.Spec.RotateCertificates, node, joinServer)
 		if err != nil {
+			capr.CertificatesRotated.SetError(&status, "", err)
 			return status, err
 		}
 
 		err = assignAndCheckPlan(p.store, fmt.Sprintf("[%s] certificate rotation", node.Machine.Name), node, rotatePlan, joinedServer, 0, 0)
 		if err != nil {
+			capr.CertificatesRotated.Unknown(&status)
+			capr.CertificatesRotated.Reason(&status, "Rotating")
+			capr.CertificatesRotated.Message(&status, fmt.Sprintf("rotating certificates of %s on machine %s (%d/%d)",
+				services, node.Machine.Name, i+1, len(entriesToRotate)))
 			// Ensure the CAPI cluster is paused if we have assigned and are checking a plan.
 			if pauseErr := p.pauseCAPICluster(controlPlane, true); pauseErr != nil {
 				return status, pauseErr
@@ -56,6 +117,10 @@ func (p *Planner) rotateCertificates(controlPlane *rkev1.RKEControlPlane, status
 	}
 
 	status.CertificateRotationGeneration = controlPlane.Spec.RotateCertificates.Generation
+	capr.CertificatesRotated.True(&status)
+	capr.CertificatesRotated.Reason(&status, "")
+	capr.CertificatesRotated.Message(&status, fmt.Sprintf("rotated certificates of %s for generation %d",
+		services, controlPlane.Spec.RotateCertificates.Generation))
 	return status, errWaiting("certificate rotation done")
 }
 
@@ -1,8 +1,15 @@
 package planner
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
 	"strconv"
 	"testing"
+	"time"
 
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
@@ -491,3 +498,74 @@ type expected struct {
 		})
 	}
 }
+
+func Test_certificateExpiration(t *testing.T) {
+	certPEM := func(notAfter time.Time) []byte {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			NotBefore:    notAfter.Add(-24 * time.Hour),
+			NotAfter:     notAfter,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		assert.NoError(t, err)
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+
+	early := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	output := func(stdout []byte, exitCode int) plan.PeriodicInstructionOutput {
+		return plan.PeriodicInstructionOutput{Stdout: stdout, ExitCode: exitCode}
+	}
+
+	clusterPlan := &plan.Plan{
+		Machines: map[string]*capi.Machine{
+			"a": {ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+			"b": {ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+			"c": {ObjectMeta: metav1.ObjectMeta{Name: "c"}},
+		},
+		Metadata: map[string]*plan.Metadata{"a": {}, "b": {}, "c": {}},
+		Nodes: map[string]*plan.Node{
+			"a": {PeriodicOutput: map[string]plan.PeriodicInstructionOutput{
+				"certificate-expiration-kube-apiserver": output(certPEM(late), 0),
+				"certificate-expiration-kubelet":        output(certPEM(late), 0),
+				"certificate-expiration-etcd":           output(certPEM(early), 0),
+			}},
+			"b": {PeriodicOutput: map[string]plan.PeriodicInstructionOutput{
+				"certificate-expiration-kube-apiserver": output(certPEM(early), 0),
+				"certificate-expiration-kubelet":        output([]byte("cat: no such file"), 1),
+			}},
+			"c": {PeriodicOutput: map[string]plan.PeriodicInstructionOutput{
+				"certificate-expiration-kubelet": output([]byte("not a certificate"), 0),
+			}},
+		},
+	}
+
+	assert.Equal(t, map[string]metav1.Time{
+		"kube-apiserver": metav1.NewTime(early),
+		"kubelet":        metav1.NewTime(late),
+		"etcd":           metav1.NewTime(early),
+	}, certificateExpiration(clusterPlan))
+
+	assert.Nil(t, certificateExpiration(&plan.Plan{}))
+}
+
+func Test_addCertificateExpirationPeriodicInstructions(t *testing.T) {
+	controlPlane := &rkev1.RKEControlPlane{Spec: rkev1.RKEControlPlaneSpec{KubernetesVersion: "v1.30.4+rke2r1"}}
+
+	names := func(entry *planEntry) []string {
+		var result []string
+		for _, instruction := range addCertificateExpirationPeriodicInstructions(plan.NodePlan{}, controlPlane, entry).PeriodicInstructions {
+			result = append(result, instruction.Name)
+		}
+		return result
+	}
+
+	worker := &planEntry{Metadata: &plan.Metadata{Labels: map[string]string{capr.WorkerRoleLabel: "true"}}}
+	all := &planEntry{Metadata: &plan.Metadata{Labels: map[string]string{capr.WorkerRoleLabel: "true", capr.ControlPlaneRoleLabel: "true", capr.EtcdRoleLabel: "true"}}}
+
+	assert.Equal(t, []string{"certificate-expiration-kubelet"}, names(worker))
+	assert.Equal(t, []string{"certificate-expiration-kube-apiserver", "certificate-expiration-kubelet", "certificate-expiration-etcd"}, names(all))
+}
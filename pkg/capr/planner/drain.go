@@ -10,6 +10,7 @@
 	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
 	"github.com/rancher/rancher/pkg/capr"
 	"github.com/rancher/wrangler/v3/pkg/kv"
+	"github.com/sirupsen/logrus"
 )
 
 func getDrainHash(plan *plan.NodePlan) string {
@@ -59,6 +60,21 @@ func optionsToString(options rkev1.DrainOptions, disable bool) (string, error) {
 	return string(data), nil
 }
 
+// drainOptionsForEntry returns the drain options of the machine pool of the entry if it has any, or the given default
+// drain options otherwise.
+func drainOptionsForEntry(entry *planEntry, defaults rkev1.DrainOptions) rkev1.DrainOptions {
+	if entry == nil || entry.Machine == nil || entry.Machine.Annotations[capr.MachinePoolDrainOptionsAnnotation] == "" {
+		return defaults
+	}
+
+	var options rkev1.DrainOptions
+	if err := json.Unmarshal([]byte(entry.Machine.Annotations[capr.MachinePoolDrainOptionsAnnotation]), &options); err != nil {
+		logrus.Errorf("[planner] invalid drain options for machine %s/%s, using the defaults: %v", entry.Machine.Namespace, entry.Machine.Name, err)
+		return defaults
+	}
+	return options
+}
+
 func (p *Planner) drain(oldPlan *plan.NodePlan, newPlan plan.NodePlan, entry *planEntry, clusterPlan *plan.Plan, options rkev1.DrainOptions) (bool, error) {
 	if entry == nil || entry.Metadata == nil || entry.Metadata.Annotations == nil || entry.Machine == nil || entry.Machine.Status.NodeRef == nil {
 		return true, nil
@@ -0,0 +1,51 @@
+package planner
+
+import (
+	"testing"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/capr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestDrainOptionsForEntry(t *testing.T) {
+	defaults := rkev1.DrainOptions{Enabled: true, Timeout: 120}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    rkev1.DrainOptions
+	}{
+		{
+			name:     "no pool drain options",
+			expected: defaults,
+		},
+		{
+			name: "pool drain options",
+			annotations: map[string]string{
+				capr.MachinePoolDrainOptionsAnnotation: `{"enabled":true,"podDisruptionBudgetTimeout":300,"forceAfter":900}`,
+			},
+			expected: rkev1.DrainOptions{Enabled: true, PodDisruptionBudgetTimeout: 300, ForceAfter: 900},
+		},
+		{
+			name: "invalid pool drain options",
+			annotations: map[string]string{
+				capr.MachinePoolDrainOptionsAnnotation: `{"enabled":`,
+			},
+			expected: defaults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &planEntry{
+				Machine: &capi.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "fleet-default", Annotations: tt.annotations},
+				},
+			}
+			assert.Equal(t, tt.expected, drainOptionsForEntry(entry, defaults))
+		})
+	}
+}
@@ -14,6 +14,9 @@
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
+// defaultETCDSnapshotName is the base name the distribution gives to on-demand snapshots when none is specified.
+const defaultETCDSnapshotName = "on-demand"
+
 func (p *Planner) setEtcdSnapshotCreateState(status rkev1.RKEControlPlaneStatus, create *rkev1.ETCDSnapshotCreate, phase rkev1.ETCDSnapshotPhase) (rkev1.RKEControlPlaneStatus, error) {
 	if status.ETCDSnapshotCreatePhase != phase || !equality.Semantic.DeepEqual(status.ETCDSnapshotCreate, create) {
 		status.ETCDSnapshotCreatePhase = phase
@@ -110,13 +113,52 @@ func (p *Planner) generateEtcdSnapshotCreatePlan(controlPlane *rkev1.RKEControlP
 	}
 
 	createPlan, _, joinedServer, err := p.generatePlanWithConfigFiles(controlPlane, tokensSecret, entry, joinServer, true)
+	if err != nil {
+		return createPlan, joinedServer, err
+	}
+
+	create := controlPlane.Spec.ETCDSnapshotCreate
+	if create == nil {
+		create = &rkev1.ETCDSnapshotCreate{}
+	}
+
+	if create.Name != "" {
+		args = append(args, "--name="+create.Name)
+	}
+
+	var s3Args, env []string
+	if create.S3 != nil {
+		var s3Files []plan.File
+		s3Args, env, s3Files, err = p.etcdS3Args.ToArgs(create.S3, controlPlane, "etcd-", true)
+		if err != nil {
+			return createPlan, joinedServer, err
+		}
+		createPlan.Files = append(createPlan.Files, s3Files...)
+	}
+
 	createPlan.Instructions = append(createPlan.Instructions, p.generateInstallInstructionWithSkipStart(controlPlane, entry),
 		plan.OneTimeInstruction{
 			Name:    "create",
 			Command: capr.GetRuntimeCommand(controlPlane.Spec.KubernetesVersion),
-			Args:    args,
+			Args:    append(args, s3Args...),
+			Env:     env,
+		})
+
+	// Snapshots can only be pruned by name through the prune subcommand, which was introduced alongside "save".
+	if create.Retention > 0 && v.GreaterThan(managesystemagent.Kubernetes125) {
+		name := create.Name
+		if name == "" {
+			name = defaultETCDSnapshotName
+		}
+		pruneArgs := []string{"etcd-snapshot", "prune", "--name=" + name, fmt.Sprintf("--etcd-snapshot-retention=%d", create.Retention)}
+		createPlan.Instructions = append(createPlan.Instructions, plan.OneTimeInstruction{
+			Name:    "prune",
+			Command: capr.GetRuntimeCommand(controlPlane.Spec.KubernetesVersion),
+			Args:    append(pruneArgs, s3Args...),
+			Env:     env,
 		})
-	return createPlan, joinedServer, err
+	}
+	return createPlan, joinedServer, nil
 }
 
 func (p *Planner) createEtcdSnapshot(controlPlane *rkev1.RKEControlPlane, status rkev1.RKEControlPlaneStatus, tokensSecret plan.Secret, clusterPlan *plan.Plan) (rkev1.RKEControlPlaneStatus, error) {
@@ -153,6 +153,26 @@ func (p *Planner) addInitNodePeriodicInstruction(nodePlan plan.NodePlan, control
 	return nodePlan, nil
 }
 
+// addCertificateExpirationPeriodicInstructions adds periodic instructions scraping the certificates of the components
+// running on the node, so that their expiration dates can be reported in the status of the control plane.
+func addCertificateExpirationPeriodicInstructions(nodePlan plan.NodePlan, controlPlane *rkev1.RKEControlPlane, entry *planEntry) plan.NodePlan {
+	for _, cert := range expiringCertificates {
+		if !cert.include(entry) {
+			continue
+		}
+		nodePlan.PeriodicInstructions = append(nodePlan.PeriodicInstructions, plan.PeriodicInstruction{
+			Name:    certificateExpirationInstructionName(cert.component),
+			Command: "sh",
+			Args: []string{
+				"-c",
+				fmt.Sprintf("cat %s", path.Join(capr.GetDistroDataDir(controlPlane), cert.path)),
+			},
+			PeriodSeconds: 3600,
+		})
+	}
+	return nodePlan
+}
+
 // generateManifestRemovalInstruction generates a rm -rf command for the manifests of a server. This was created in response to https://github.com/rancher/rancher/issues/41174
 func generateManifestRemovalInstruction(controlPlane *rkev1.RKEControlPlane, entry *planEntry) (bool, plan.OneTimeInstruction) {
 	runtime := capr.GetRuntime(controlPlane.Spec.KubernetesVersion)
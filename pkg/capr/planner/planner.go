@@ -957,7 +957,7 @@ func (p *Planner) reconcile(controlPlane *rkev1.RKEControlPlane, tokensSecret pl
 				if !isUnavailable(r) {
 					unavailable++
 				}
-				if ok, err := p.drain(r.entry.Plan.AppliedPlan, r.desiredPlan, r.entry, clusterPlan, drainOptions); !ok && err != nil {
+				if ok, err := p.drain(r.entry.Plan.AppliedPlan, r.desiredPlan, r.entry, clusterPlan, drainOptionsForEntry(r.entry, drainOptions)); !ok && err != nil {
 					return err
 				} else if ok && err == nil {
 					// Drain is done (or didn't need to be done) and there are no errors, so the plan should be updated to enact the reason the node was drained.
@@ -1143,6 +1143,10 @@ func (p *Planner) desiredPlan(controlPlane *rkev1.RKEControlPlane, tokensSecret
 		}
 	}
 
+	if !windows(entry) {
+		nodePlan = addCertificateExpirationPeriodicInstructions(nodePlan, controlPlane, entry)
+	}
+
 	if windows(entry) {
 		// We need to wait for the controlPlane to be ready before sending this plan
 		// to ensure that the initial installation has fully completed
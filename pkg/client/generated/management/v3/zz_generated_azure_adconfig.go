@@ -10,6 +10,7 @@
 	AzureADConfigFieldAuthEndpoint          = "authEndpoint"
 	AzureADConfigFieldCreated               = "created"
 	AzureADConfigFieldCreatorID             = "creatorId"
+	AzureADConfigFieldDeniedPrincipalIDs    = "deniedPrincipalIds"
 	AzureADConfigFieldDeviceAuthEndpoint    = "deviceAuthEndpoint"
 	AzureADConfigFieldEnabled               = "enabled"
 	AzureADConfigFieldEndpoint              = "endpoint"
@@ -37,6 +38,7 @@ type AzureADConfig struct {
 	AuthEndpoint          string            `json:"authEndpoint,omitempty" yaml:"authEndpoint,omitempty"`
 	Created               string            `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID             string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DeniedPrincipalIDs    []string          `json:"deniedPrincipalIds,omitempty" yaml:"deniedPrincipalIds,omitempty"`
 	DeviceAuthEndpoint    string            `json:"deviceAuthEndpoint,omitempty" yaml:"deviceAuthEndpoint,omitempty"`
 	Enabled               bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 	Endpoint              string            `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
@@ -74,6 +74,7 @@
 	ClusterFieldS3CredentialSecret                                   = "s3CredentialSecret"
 	ClusterFieldServiceAccountTokenSecret                            = "serviceAccountTokenSecret"
 	ClusterFieldState                                                = "state"
+	ClusterFieldSystemChartVersions                                  = "systemChartVersions"
 	ClusterFieldTransitioning                                        = "transitioning"
 	ClusterFieldTransitioningMessage                                 = "transitioningMessage"
 	ClusterFieldUUID                                                 = "uuid"
@@ -155,6 +156,7 @@ type Cluster struct {
 	S3CredentialSecret                                   string                         `json:"s3CredentialSecret,omitempty" yaml:"s3CredentialSecret,omitempty"`
 	ServiceAccountTokenSecret                            string                         `json:"serviceAccountTokenSecret,omitempty" yaml:"serviceAccountTokenSecret,omitempty"`
 	State                                                string                         `json:"state,omitempty" yaml:"state,omitempty"`
+	SystemChartVersions                                  map[string]string              `json:"systemChartVersions,omitempty" yaml:"systemChartVersions,omitempty"`
 	Transitioning                                        string                         `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
 	TransitioningMessage                                 string                         `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
 	UUID                                                 string                         `json:"uuid,omitempty" yaml:"uuid,omitempty"`
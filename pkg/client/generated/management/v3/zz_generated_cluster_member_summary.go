@@ -0,0 +1,18 @@
+package client
+
+const (
+	ClusterMemberSummaryType                      = "clusterMemberSummary"
+	ClusterMemberSummaryFieldGroups               = "groups"
+	ClusterMemberSummaryFieldLastMembershipChange = "lastMembershipChange"
+	ClusterMemberSummaryFieldMemberCount          = "memberCount"
+	ClusterMemberSummaryFieldMembersByRole        = "membersByRole"
+	ClusterMemberSummaryFieldOwnerCount           = "ownerCount"
+)
+
+type ClusterMemberSummary struct {
+	Groups               []string         `json:"groups,omitempty" yaml:"groups,omitempty"`
+	LastMembershipChange string           `json:"lastMembershipChange,omitempty" yaml:"lastMembershipChange,omitempty"`
+	MemberCount          int64            `json:"memberCount,omitempty" yaml:"memberCount,omitempty"`
+	MembersByRole        map[string]int64 `json:"membersByRole,omitempty" yaml:"membersByRole,omitempty"`
+	OwnerCount           int64            `json:"ownerCount,omitempty" yaml:"ownerCount,omitempty"`
+}
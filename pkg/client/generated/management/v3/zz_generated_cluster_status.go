@@ -28,6 +28,7 @@
 	ClusterStatusFieldIstioEnabled                               = "istioEnabled"
 	ClusterStatusFieldLimits                                     = "limits"
 	ClusterStatusFieldLinuxWorkerCount                           = "linuxWorkerCount"
+	ClusterStatusFieldMemberSummary                              = "memberSummary"
 	ClusterStatusFieldNodeCount                                  = "nodeCount"
 	ClusterStatusFieldNodeVersion                                = "nodeVersion"
 	ClusterStatusFieldOpenStackSecret                            = "openStackSecret"
@@ -70,6 +71,7 @@ type ClusterStatus struct {
 	IstioEnabled                               bool                          `json:"istioEnabled,omitempty" yaml:"istioEnabled,omitempty"`
 	Limits                                     map[string]string             `json:"limits,omitempty" yaml:"limits,omitempty"`
 	LinuxWorkerCount                           int64                         `json:"linuxWorkerCount,omitempty" yaml:"linuxWorkerCount,omitempty"`
+	MemberSummary                              *ClusterMemberSummary         `json:"memberSummary,omitempty" yaml:"memberSummary,omitempty"`
 	NodeCount                                  int64                         `json:"nodeCount,omitempty" yaml:"nodeCount,omitempty"`
 	NodeVersion                                int64                         `json:"nodeVersion,omitempty" yaml:"nodeVersion,omitempty"`
 	OpenStackSecret                            string                        `json:"openStackSecret,omitempty" yaml:"openStackSecret,omitempty"`
@@ -0,0 +1,14 @@
+package client
+
+const (
+	FeatureClusterStatusType             = "featureClusterStatus"
+	FeatureClusterStatusFieldApplied     = "applied"
+	FeatureClusterStatusFieldClusterName = "clusterName"
+	FeatureClusterStatusFieldMessage     = "message"
+)
+
+type FeatureClusterStatus struct {
+	Applied     bool   `json:"applied,omitempty" yaml:"applied,omitempty"`
+	ClusterName string `json:"clusterName,omitempty" yaml:"clusterName,omitempty"`
+	Message     string `json:"message,omitempty" yaml:"message,omitempty"`
+}
@@ -1,16 +1,24 @@
 package client
 
 const (
-	FeatureStatusType             = "featureStatus"
-	FeatureStatusFieldDefault     = "default"
-	FeatureStatusFieldDescription = "description"
-	FeatureStatusFieldDynamic     = "dynamic"
-	FeatureStatusFieldLockedValue = "lockedValue"
+	FeatureStatusType                      = "featureStatus"
+	FeatureStatusFieldClusters             = "clusters"
+	FeatureStatusFieldDefault              = "default"
+	FeatureStatusFieldDependencies         = "dependencies"
+	FeatureStatusFieldDescription          = "description"
+	FeatureStatusFieldDynamic              = "dynamic"
+	FeatureStatusFieldLockedValue          = "lockedValue"
+	FeatureStatusFieldMinKubernetesVersion = "minKubernetesVersion"
+	FeatureStatusFieldUnmetDependencies    = "unmetDependencies"
 )
 
 type FeatureStatus struct {
-	Default     bool   `json:"default,omitempty" yaml:"default,omitempty"`
-	Description string `json:"description,omitempty" yaml:"description,omitempty"`
-	Dynamic     bool   `json:"dynamic,omitempty" yaml:"dynamic,omitempty"`
-	LockedValue *bool  `json:"lockedValue,omitempty" yaml:"lockedValue,omitempty"`
+	Clusters             []FeatureClusterStatus `json:"clusters,omitempty" yaml:"clusters,omitempty"`
+	Default              bool                   `json:"default,omitempty" yaml:"default,omitempty"`
+	Dependencies         []string               `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
+	Description          string                 `json:"description,omitempty" yaml:"description,omitempty"`
+	Dynamic              bool                   `json:"dynamic,omitempty" yaml:"dynamic,omitempty"`
+	LockedValue          *bool                  `json:"lockedValue,omitempty" yaml:"lockedValue,omitempty"`
+	MinKubernetesVersion string                 `json:"minKubernetesVersion,omitempty" yaml:"minKubernetesVersion,omitempty"`
+	UnmetDependencies    []string               `json:"unmetDependencies,omitempty" yaml:"unmetDependencies,omitempty"`
 }
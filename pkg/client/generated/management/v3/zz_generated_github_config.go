@@ -10,6 +10,7 @@
 	GithubConfigFieldClientSecret        = "clientSecret"
 	GithubConfigFieldCreated             = "created"
 	GithubConfigFieldCreatorID           = "creatorId"
+	GithubConfigFieldDeniedPrincipalIDs  = "deniedPrincipalIds"
 	GithubConfigFieldEnabled             = "enabled"
 	GithubConfigFieldHostname            = "hostname"
 	GithubConfigFieldHostnameToClientID  = "hostnameToClientId"
@@ -33,6 +34,7 @@ type GithubConfig struct {
 	ClientSecret        string            `json:"clientSecret,omitempty" yaml:"clientSecret,omitempty"`
 	Created             string            `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID           string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DeniedPrincipalIDs  []string          `json:"deniedPrincipalIds,omitempty" yaml:"deniedPrincipalIds,omitempty"`
 	Enabled             bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 	Hostname            string            `json:"hostname,omitempty" yaml:"hostname,omitempty"`
 	HostnameToClientID  map[string]string `json:"hostnameToClientId,omitempty" yaml:"hostnameToClientId,omitempty"`
@@ -7,6 +7,7 @@
 	KeyCloakConfigFieldAnnotations         = "annotations"
 	KeyCloakConfigFieldCreated             = "created"
 	KeyCloakConfigFieldCreatorID           = "creatorId"
+	KeyCloakConfigFieldDeniedPrincipalIDs  = "deniedPrincipalIds"
 	KeyCloakConfigFieldDisplayNameField    = "displayNameField"
 	KeyCloakConfigFieldEnabled             = "enabled"
 	KeyCloakConfigFieldEntityID            = "entityID"
@@ -35,6 +36,7 @@ type KeyCloakConfig struct {
 	Annotations         map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
 	Created             string            `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID           string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DeniedPrincipalIDs  []string          `json:"deniedPrincipalIds,omitempty" yaml:"deniedPrincipalIds,omitempty"`
 	DisplayNameField    string            `json:"displayNameField,omitempty" yaml:"displayNameField,omitempty"`
 	Enabled             bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 	EntityID            string            `json:"entityID,omitempty" yaml:"entityID,omitempty"`
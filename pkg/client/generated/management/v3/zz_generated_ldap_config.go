@@ -13,6 +13,7 @@
 	LdapConfigFieldConnectionTimeout               = "connectionTimeout"
 	LdapConfigFieldCreated                         = "created"
 	LdapConfigFieldCreatorID                       = "creatorId"
+	LdapConfigFieldDeniedPrincipalIDs              = "deniedPrincipalIds"
 	LdapConfigFieldEnabled                         = "enabled"
 	LdapConfigFieldGroupDNAttribute                = "groupDNAttribute"
 	LdapConfigFieldGroupMemberMappingAttribute     = "groupMemberMappingAttribute"
@@ -59,6 +60,7 @@ type LdapConfig struct {
 	ConnectionTimeout               int64             `json:"connectionTimeout,omitempty" yaml:"connectionTimeout,omitempty"`
 	Created                         string            `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID                       string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DeniedPrincipalIDs              []string          `json:"deniedPrincipalIds,omitempty" yaml:"deniedPrincipalIds,omitempty"`
 	Enabled                         bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 	GroupDNAttribute                string            `json:"groupDNAttribute,omitempty" yaml:"groupDNAttribute,omitempty"`
 	GroupMemberMappingAttribute     string            `json:"groupMemberMappingAttribute,omitempty" yaml:"groupMemberMappingAttribute,omitempty"`
@@ -7,6 +7,7 @@
 	LocalConfigFieldAnnotations         = "annotations"
 	LocalConfigFieldCreated             = "created"
 	LocalConfigFieldCreatorID           = "creatorId"
+	LocalConfigFieldDeniedPrincipalIDs  = "deniedPrincipalIds"
 	LocalConfigFieldEnabled             = "enabled"
 	LocalConfigFieldLabels              = "labels"
 	LocalConfigFieldLogoutAllSupported  = "logoutAllSupported"
@@ -24,6 +25,7 @@ type LocalConfig struct {
 	Annotations         map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
 	Created             string            `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID           string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DeniedPrincipalIDs  []string          `json:"deniedPrincipalIds,omitempty" yaml:"deniedPrincipalIds,omitempty"`
 	Enabled             bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 	Labels              map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
 	LogoutAllSupported  bool              `json:"logoutAllSupported,omitempty" yaml:"logoutAllSupported,omitempty"`
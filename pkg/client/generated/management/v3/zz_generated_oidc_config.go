@@ -12,6 +12,7 @@
 	OIDCConfigFieldClientSecret        = "clientSecret"
 	OIDCConfigFieldCreated             = "created"
 	OIDCConfigFieldCreatorID           = "creatorId"
+	OIDCConfigFieldDeniedPrincipalIDs  = "deniedPrincipalIds"
 	OIDCConfigFieldEnabled             = "enabled"
 	OIDCConfigFieldGroupSearchEnabled  = "groupSearchEnabled"
 	OIDCConfigFieldGroupsClaim         = "groupsClaim"
@@ -43,6 +44,7 @@ type OIDCConfig struct {
 	ClientSecret        string            `json:"clientSecret,omitempty" yaml:"clientSecret,omitempty"`
 	Created             string            `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID           string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DeniedPrincipalIDs  []string          `json:"deniedPrincipalIds,omitempty" yaml:"deniedPrincipalIds,omitempty"`
 	Enabled             bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 	GroupSearchEnabled  *bool             `json:"groupSearchEnabled,omitempty" yaml:"groupSearchEnabled,omitempty"`
 	GroupsClaim         string            `json:"groupsClaim,omitempty" yaml:"groupsClaim,omitempty"`
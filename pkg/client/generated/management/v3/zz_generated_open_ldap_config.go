@@ -9,6 +9,7 @@
 	OpenLdapConfigFieldConnectionTimeout               = "connectionTimeout"
 	OpenLdapConfigFieldCreated                         = "created"
 	OpenLdapConfigFieldCreatorID                       = "creatorId"
+	OpenLdapConfigFieldDeniedPrincipalIDs              = "deniedPrincipalIds"
 	OpenLdapConfigFieldEnabled                         = "enabled"
 	OpenLdapConfigFieldGroupDNAttribute                = "groupDNAttribute"
 	OpenLdapConfigFieldGroupMemberMappingAttribute     = "groupMemberMappingAttribute"
@@ -54,6 +55,7 @@ type OpenLdapConfig struct {
 	ConnectionTimeout               int64             `json:"connectionTimeout,omitempty" yaml:"connectionTimeout,omitempty"`
 	Created                         string            `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID                       string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DeniedPrincipalIDs              []string          `json:"deniedPrincipalIds,omitempty" yaml:"deniedPrincipalIds,omitempty"`
 	Enabled                         bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 	GroupDNAttribute                string            `json:"groupDNAttribute,omitempty" yaml:"groupDNAttribute,omitempty"`
 	GroupMemberMappingAttribute     string            `json:"groupMemberMappingAttribute,omitempty" yaml:"groupMemberMappingAttribute,omitempty"`
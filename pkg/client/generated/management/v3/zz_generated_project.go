@@ -17,7 +17,9 @@
 	ProjectFieldLabels                        = "labels"
 	ProjectFieldName                          = "name"
 	ProjectFieldNamespaceDefaultResourceQuota = "namespaceDefaultResourceQuota"
+	ProjectFieldNamespaceDeletionPolicy       = "namespaceDeletionPolicy"
 	ProjectFieldNamespaceId                   = "namespaceId"
+	ProjectFieldNetworkIsolation              = "networkIsolation"
 	ProjectFieldOwnerReferences               = "ownerReferences"
 	ProjectFieldRemoved                       = "removed"
 	ProjectFieldResourceQuota                 = "resourceQuota"
@@ -25,6 +27,7 @@
 	ProjectFieldTransitioning                 = "transitioning"
 	ProjectFieldTransitioningMessage          = "transitioningMessage"
 	ProjectFieldUUID                          = "uuid"
+	ProjectFieldUsedResources                 = "usedResources"
 )
 
 type Project struct {
@@ -40,7 +43,9 @@ type Project struct {
 	Labels                        map[string]string       `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Name                          string                  `json:"name,omitempty" yaml:"name,omitempty"`
 	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty" yaml:"namespaceDefaultResourceQuota,omitempty"`
+	NamespaceDeletionPolicy       string                  `json:"namespaceDeletionPolicy,omitempty" yaml:"namespaceDeletionPolicy,omitempty"`
 	NamespaceId                   string                  `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	NetworkIsolation              string                  `json:"networkIsolation,omitempty" yaml:"networkIsolation,omitempty"`
 	OwnerReferences               []OwnerReference        `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
 	Removed                       string                  `json:"removed,omitempty" yaml:"removed,omitempty"`
 	ResourceQuota                 *ProjectResourceQuota   `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
@@ -48,6 +53,7 @@ type Project struct {
 	Transitioning                 string                  `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
 	TransitioningMessage          string                  `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
 	UUID                          string                  `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	UsedResources                 *ResourceQuotaLimit     `json:"usedResources,omitempty" yaml:"usedResources,omitempty"`
 }
 
 type ProjectCollection struct {
@@ -0,0 +1,16 @@
+package client
+
+const (
+	ProjectMemberSummaryType                      = "projectMemberSummary"
+	ProjectMemberSummaryFieldGroups               = "groups"
+	ProjectMemberSummaryFieldLastMembershipChange = "lastMembershipChange"
+	ProjectMemberSummaryFieldMemberCount          = "memberCount"
+	ProjectMemberSummaryFieldMembersByRole        = "membersByRole"
+)
+
+type ProjectMemberSummary struct {
+	Groups               []string         `json:"groups,omitempty" yaml:"groups,omitempty"`
+	LastMembershipChange string           `json:"lastMembershipChange,omitempty" yaml:"lastMembershipChange,omitempty"`
+	MemberCount          int64            `json:"memberCount,omitempty" yaml:"memberCount,omitempty"`
+	MembersByRole        map[string]int64 `json:"membersByRole,omitempty" yaml:"membersByRole,omitempty"`
+}
@@ -7,6 +7,8 @@
 	ProjectSpecFieldDescription                   = "description"
 	ProjectSpecFieldDisplayName                   = "displayName"
 	ProjectSpecFieldNamespaceDefaultResourceQuota = "namespaceDefaultResourceQuota"
+	ProjectSpecFieldNamespaceDeletionPolicy       = "namespaceDeletionPolicy"
+	ProjectSpecFieldNetworkIsolation              = "networkIsolation"
 	ProjectSpecFieldResourceQuota                 = "resourceQuota"
 )
 
@@ -16,5 +18,7 @@ type ProjectSpec struct {
 	Description                   string                  `json:"description,omitempty" yaml:"description,omitempty"`
 	DisplayName                   string                  `json:"displayName,omitempty" yaml:"displayName,omitempty"`
 	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty" yaml:"namespaceDefaultResourceQuota,omitempty"`
+	NamespaceDeletionPolicy       string                  `json:"namespaceDeletionPolicy,omitempty" yaml:"namespaceDeletionPolicy,omitempty"`
+	NetworkIsolation              string                  `json:"networkIsolation,omitempty" yaml:"networkIsolation,omitempty"`
 	ResourceQuota                 *ProjectResourceQuota   `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
 }
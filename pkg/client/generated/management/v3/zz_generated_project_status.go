@@ -4,9 +4,13 @@
 	ProjectStatusType                  = "projectStatus"
 	ProjectStatusFieldBackingNamespace = "backingNamespace"
 	ProjectStatusFieldConditions       = "conditions"
+	ProjectStatusFieldMemberSummary    = "memberSummary"
+	ProjectStatusFieldUsedResources    = "usedResources"
 )
 
 type ProjectStatus struct {
-	BackingNamespace string             `json:"backingNamespace,omitempty" yaml:"backingNamespace,omitempty"`
-	Conditions       []ProjectCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	BackingNamespace string                `json:"backingNamespace,omitempty" yaml:"backingNamespace,omitempty"`
+	Conditions       []ProjectCondition    `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	MemberSummary    *ProjectMemberSummary `json:"memberSummary,omitempty" yaml:"memberSummary,omitempty"`
+	UsedResources    *ResourceQuotaLimit   `json:"usedResources,omitempty" yaml:"usedResources,omitempty"`
 }
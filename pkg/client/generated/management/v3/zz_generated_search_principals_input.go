@@ -2,11 +2,15 @@
 
 const (
 	SearchPrincipalsInputType               = "searchPrincipalsInput"
+	SearchPrincipalsInputFieldContinue      = "continue"
+	SearchPrincipalsInputFieldLimit         = "limit"
 	SearchPrincipalsInputFieldName          = "name"
 	SearchPrincipalsInputFieldPrincipalType = "principalType"
 )
 
 type SearchPrincipalsInput struct {
+	Continue      string `json:"continue,omitempty" yaml:"continue,omitempty"`
+	Limit         int64  `json:"limit,omitempty" yaml:"limit,omitempty"`
 	Name          string `json:"name,omitempty" yaml:"name,omitempty"`
 	PrincipalType string `json:"principalType,omitempty" yaml:"principalType,omitempty"`
 }
@@ -1,16 +1,18 @@
 package client
 
 const (
-	GoogleOauthLoginType              = "googleOauthLogin"
-	GoogleOauthLoginFieldCode         = "code"
-	GoogleOauthLoginFieldDescription  = "description"
-	GoogleOauthLoginFieldResponseType = "responseType"
-	GoogleOauthLoginFieldTTLMillis    = "ttl"
+	GoogleOauthLoginType                   = "googleOauthLogin"
+	GoogleOauthLoginFieldChallengeResponse = "challengeResponse"
+	GoogleOauthLoginFieldCode              = "code"
+	GoogleOauthLoginFieldDescription       = "description"
+	GoogleOauthLoginFieldResponseType      = "responseType"
+	GoogleOauthLoginFieldTTLMillis         = "ttl"
 )
 
 type GoogleOauthLogin struct {
-	Code         string `json:"code,omitempty" yaml:"code,omitempty"`
-	Description  string `json:"description,omitempty" yaml:"description,omitempty"`
-	ResponseType string `json:"responseType,omitempty" yaml:"responseType,omitempty"`
-	TTLMillis    int64  `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	ChallengeResponse string `json:"challengeResponse,omitempty" yaml:"challengeResponse,omitempty"`
+	Code              string `json:"code,omitempty" yaml:"code,omitempty"`
+	Description       string `json:"description,omitempty" yaml:"description,omitempty"`
+	ResponseType      string `json:"responseType,omitempty" yaml:"responseType,omitempty"`
+	TTLMillis         int64  `json:"ttl,omitempty" yaml:"ttl,omitempty"`
 }
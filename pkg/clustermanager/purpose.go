@@ -0,0 +1,163 @@
+package clustermanager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	apimgmtv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/features"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/types/config"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+// AppliedPurposesHashAnnotation is the hash of the purposes whose service accounts and RBAC were applied to the
+// downstream cluster, see [PurposesHash].
+const AppliedPurposesHashAnnotation = "management.cattle.io/controller-service-accounts-hash"
+
+// Purpose is something the management controllers do in the downstream clusters. With the controller-service-accounts
+// feature, they act as the service account of their purpose, impersonated with the service account token of the
+// cluster, and can only do what the rules of the purpose grant.
+type Purpose struct {
+	// Name is the name of the purpose, its service account and RBAC are named rancher-controller-<name>.
+	Name string `json:"name"`
+	// ClusterRules are the rules granted in the whole cluster.
+	ClusterRules []rbacv1.PolicyRule `json:"clusterRules,omitempty"`
+	// NamespaceRules are the rules granted in a namespace, by namespace.
+	NamespaceRules map[string][]rbacv1.PolicyRule `json:"namespaceRules,omitempty"`
+}
+
+var purposes []Purpose
+
+func newPurpose(purpose Purpose) Purpose {
+	purposes = append(purposes, purpose)
+	return purpose
+}
+
+var (
+	// PurposeAgentRestart restarts the cluster agent after the cluster is upgraded.
+	PurposeAgentRestart = newPurpose(Purpose{
+		Name: "agent-restart",
+		NamespaceRules: map[string][]rbacv1.PolicyRule{
+			namespace.System: {{
+				APIGroups:     []string{"apps"},
+				Resources:     []string{"deployments"},
+				ResourceNames: []string{"cattle-cluster-agent"},
+				Verbs:         []string{"get", "update"},
+			}},
+		},
+	})
+	// PurposeUserCleanup deletes the cluster user attributes of the removed users.
+	PurposeUserCleanup = newPurpose(Purpose{
+		Name: "user-cleanup",
+		NamespaceRules: map[string][]rbacv1.PolicyRule{
+			namespace.System: {{
+				APIGroups: []string{"cluster.cattle.io"},
+				Resources: []string{"clusteruserattributes"},
+				Verbs:     []string{"delete"},
+			}},
+		},
+	})
+)
+
+func (p Purpose) resourceName() string {
+	return "rancher-controller-" + p.Name
+}
+
+// impersonationConfig is the impersonation of the service account of the purpose.
+func (p Purpose) impersonationConfig() rest.ImpersonationConfig {
+	return rest.ImpersonationConfig{
+		UserName: fmt.Sprintf("system:serviceaccount:%s:%s", namespace.System, p.resourceName()),
+	}
+}
+
+// PurposeObjects returns the service accounts of all the purposes and the RBAC granting them their rules, to apply to
+// the downstream clusters.
+func PurposeObjects() []runtime.Object {
+	var objs []runtime.Object
+	for _, purpose := range purposes {
+		name := purpose.resourceName()
+		subjects := []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      name,
+			Namespace: namespace.System,
+		}}
+		objs = append(objs, &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.System},
+		})
+
+		if len(purpose.ClusterRules) > 0 {
+			objs = append(objs,
+				&rbacv1.ClusterRole{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Rules:      purpose.ClusterRules,
+				},
+				&rbacv1.ClusterRoleBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: name},
+					Subjects:   subjects,
+				})
+		}
+
+		for ns, rules := range purpose.NamespaceRules {
+			objs = append(objs,
+				&rbacv1.Role{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+					Rules:      rules,
+				},
+				&rbacv1.RoleBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+					RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: name},
+					Subjects:   subjects,
+				})
+		}
+	}
+	return objs
+}
+
+// PurposesHash returns the hash of all the purposes. The service accounts of the purposes are only impersonated in a
+// downstream cluster once the objects of the same purposes were applied to it, marked by its
+// AppliedPurposesHashAnnotation annotation, so that the controllers keep their access while clusters are migrated.
+func PurposesHash() string {
+	// Maps are marshalled sorted by key, the hash is stable.
+	data, _ := json.Marshal(purposes)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// impersonatesPurposes returns true if the controllers act in the cluster as the service accounts of their purposes.
+func impersonatesPurposes(cluster *apimgmtv3.Cluster) bool {
+	return features.ControllerServiceAccounts.Enabled() && cluster.Annotations[AppliedPurposesHashAnnotation] == PurposesHash()
+}
+
+// UserContextForPurpose accepts a cluster name and returns a client for that cluster acting as the service account of
+// the purpose, if the cluster was migrated to the service accounts of the purposes, or else as the service account of
+// the cluster. No controllers are started for that cluster in the process.
+// Note it will block retrying to connect to the cluster for ~30 seconds before returning
+// in case the cluster connection fails.
+func (m *Manager) UserContextForPurpose(clusterName string, purpose Purpose) (*config.UserContext, error) {
+	cluster, err := m.clusterLister.Get("", clusterName)
+	if err != nil {
+		return nil, err
+	}
+	kubeConfig, err := ToRESTConfig(cluster, m.ScaledContext, m.secretLister, true)
+	if err != nil {
+		return nil, err
+	}
+	if kubeConfig == nil {
+		return nil, fmt.Errorf("cluster context %s is unavailable", clusterName)
+	}
+
+	if impersonatesPurposes(cluster) {
+		// The REST config of the local cluster is shared, it must not be modified.
+		kubeConfig = rest.CopyConfig(kubeConfig)
+		kubeConfig.Impersonate = purpose.impersonationConfig()
+	}
+	return config.NewUserContext(m.ScaledContext, *kubeConfig, cluster.Name)
+}
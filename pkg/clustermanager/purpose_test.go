@@ -0,0 +1,88 @@
+package clustermanager
+
+import (
+	"testing"
+
+	apimgmtv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/features"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPurposeObjects(t *testing.T) {
+	objs := PurposeObjects()
+
+	var serviceAccounts, roles, roleBindings []string
+	for _, obj := range objs {
+		switch obj := obj.(type) {
+		case *corev1.ServiceAccount:
+			serviceAccounts = append(serviceAccounts, obj.Namespace+"/"+obj.Name)
+		case *rbacv1.Role:
+			roles = append(roles, obj.Namespace+"/"+obj.Name)
+		case *rbacv1.RoleBinding:
+			roleBindings = append(roleBindings, obj.Namespace+"/"+obj.Name)
+			assert.Equal(t, obj.Name, obj.RoleRef.Name)
+			assert.Equal(t, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: obj.Name, Namespace: "cattle-system"}}, obj.Subjects)
+		case *rbacv1.ClusterRole, *rbacv1.ClusterRoleBinding:
+			t.Errorf("unexpected cluster-wide RBAC %T", obj)
+		}
+	}
+
+	want := []string{"cattle-system/rancher-controller-agent-restart", "cattle-system/rancher-controller-user-cleanup"}
+	assert.Equal(t, want, serviceAccounts)
+	assert.Equal(t, want, roles)
+	assert.Equal(t, want, roleBindings)
+}
+
+func TestPurposeImpersonationConfig(t *testing.T) {
+	assert.Equal(t, "system:serviceaccount:cattle-system:rancher-controller-agent-restart", PurposeAgentRestart.impersonationConfig().UserName)
+}
+
+func TestImpersonatesPurposes(t *testing.T) {
+	tests := []struct {
+		name       string
+		enabled    bool
+		annotation string
+		want       bool
+	}{
+		{
+			name:       "migrated cluster",
+			enabled:    true,
+			annotation: PurposesHash(),
+			want:       true,
+		},
+		{
+			name:    "cluster not migrated yet",
+			enabled: true,
+		},
+		{
+			name:       "cluster migrated to other purposes",
+			enabled:    true,
+			annotation: "outdated",
+		},
+		{
+			name:       "feature disabled",
+			annotation: PurposesHash(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			features.ControllerServiceAccounts.Set(tt.enabled)
+			t.Cleanup(func() { features.ControllerServiceAccounts.Set(false) })
+
+			cluster := &apimgmtv3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "c-1"}}
+			if tt.annotation != "" {
+				cluster.Annotations = map[string]string{AppliedPurposesHashAnnotation: tt.annotation}
+			}
+			assert.Equal(t, tt.want, impersonatesPurposes(cluster))
+		})
+	}
+}
+
+func TestPurposesHashIsStable(t *testing.T) {
+	assert.Equal(t, PurposesHash(), PurposesHash())
+	assert.Len(t, PurposesHash(), 64)
+}
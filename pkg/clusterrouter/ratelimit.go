@@ -0,0 +1,68 @@
+package clusterrouter
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/rancher/pkg/settings"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	rateLimiterCacheSize = 10000
+	rateLimiterTTL       = 10 * time.Minute
+)
+
+var tooManyRequests = httperror.ErrorCode{Code: "TooManyRequests", Status: http.StatusTooManyRequests}
+
+// userRateLimiter limits the rate of the requests each user makes to each downstream cluster, so that one user can't
+// starve the tunnel of a cluster for everyone else.
+type userRateLimiter struct {
+	lock     sync.Mutex
+	limiters *cache.LRUExpireCache
+}
+
+type rateLimiterEntry struct {
+	qps     float32
+	burst   int
+	limiter flowcontrol.RateLimiter
+}
+
+func newUserRateLimiter() *userRateLimiter {
+	return &userRateLimiter{
+		limiters: cache.NewLRUExpireCache(rateLimiterCacheSize),
+	}
+}
+
+// allow returns whether the user can make a request to the cluster now. It always returns true if the
+// cluster-proxy-user-rate-limit setting is 0.
+func (u *userRateLimiter) allow(clusterID, user string) bool {
+	qps := float32(settings.ClusterProxyUserRateLimit.GetInt())
+	if qps <= 0 {
+		return true
+	}
+	burst := settings.ClusterProxyUserRateBurst.GetInt()
+	if burst < 1 {
+		burst = 1
+	}
+
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	key := clusterID + "/" + user
+	obj, ok := u.limiters.Get(key)
+	entry, _ := obj.(*rateLimiterEntry)
+	if !ok || entry.qps != qps || entry.burst != burst {
+		entry = &rateLimiterEntry{
+			qps:     qps,
+			burst:   burst,
+			limiter: flowcontrol.NewTokenBucketRateLimiter(qps, burst),
+		}
+	}
+	// Refresh the expiration, so that only the limiters of inactive users are evicted.
+	u.limiters.Add(key, entry, rateLimiterTTL)
+	return entry.limiter.TryAccept()
+}
@@ -0,0 +1,38 @@
+package clusterrouter
+
+import (
+	"testing"
+
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRateLimiter(t *testing.T) {
+	limit, burst := settings.ClusterProxyUserRateLimit.Get(), settings.ClusterProxyUserRateBurst.Get()
+	t.Cleanup(func() {
+		_ = settings.ClusterProxyUserRateLimit.Set(limit)
+		_ = settings.ClusterProxyUserRateBurst.Set(burst)
+	})
+
+	limiter := newUserRateLimiter()
+
+	// Disabled by default.
+	for i := 0; i < 200; i++ {
+		assert.True(t, limiter.allow("c-1", "u-1"))
+	}
+
+	require.NoError(t, settings.ClusterProxyUserRateLimit.Set("1"))
+	require.NoError(t, settings.ClusterProxyUserRateBurst.Set("2"))
+	assert.True(t, limiter.allow("c-1", "u-1"))
+	assert.True(t, limiter.allow("c-1", "u-1"))
+	assert.False(t, limiter.allow("c-1", "u-1"), "burst is exhausted")
+
+	// Other users and other clusters have their own limit.
+	assert.True(t, limiter.allow("c-1", "u-2"))
+	assert.True(t, limiter.allow("c-2", "u-1"))
+
+	// Changing the settings resets the limiters.
+	require.NoError(t, settings.ClusterProxyUserRateBurst.Set("3"))
+	assert.True(t, limiter.allow("c-1", "u-1"))
+}
@@ -1,24 +1,33 @@
 package clusterrouter
 
 import (
+	"bufio"
 	"encoding/json"
+	"fmt"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/rancher/norman/httperror"
+	"github.com/rancher/rancher/pkg/apiusage"
 	"github.com/rancher/rancher/pkg/clusterrouter/proxy"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/metrics"
 	"github.com/rancher/rancher/pkg/types/config/dialer"
+	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/client-go/rest"
 )
 
 type Router struct {
 	serverFactory *factory
+	rateLimiter   *userRateLimiter
 }
 
 func New(localConfig *rest.Config, lookup ClusterLookup, dialer dialer.Factory, clusterLister v3.ClusterLister, clusterContextGetter proxy.ClusterContextGetter) http.Handler {
 	serverFactory := newFactory(localConfig, dialer, lookup, clusterLister, clusterContextGetter)
 	return &Router{
 		serverFactory: serverFactory,
+		rateLimiter:   newUserRateLimiter(),
 	}
 }
 
@@ -39,7 +48,23 @@ func (r *Router) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	handler.ServeHTTP(rw, req)
+	var userName string
+	if u, ok := request.UserFrom(req.Context()); ok {
+		userName = u.GetName()
+	}
+
+	if !r.rateLimiter.allow(c.Name, userName) {
+		metrics.IncClusterProxyThrottledRequests(c.Name, userName)
+		rw.Header().Set("Retry-After", "1")
+		response(rw, tooManyRequests, "Too many requests to cluster "+c.Name+", slow down")
+		return
+	}
+	apiusage.Record(c.Name, userName, req)
+
+	start := time.Now()
+	sw := &statusWriter{ResponseWriter: rw, status: http.StatusOK}
+	handler.ServeHTTP(sw, req)
+	metrics.ObserveClusterProxyRequest(c.Name, userName, sw.status, time.Since(start))
 }
 
 func response(rw http.ResponseWriter, code httperror.ErrorCode, message string) {
@@ -47,3 +72,39 @@ func response(rw http.ResponseWriter, code httperror.ErrorCode, message string)
 	rw.Header().Set("content-type", "application/json")
 	json.NewEncoder(rw).Encode(httperror.NewAPIError(code, message))
 }
+
+// statusWriter records the status code of the response, while still letting the proxy flush watches and hijack the
+// connection for upgrades.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("upstream ResponseWriter of type %T does not implement http.Hijacker", w.ResponseWriter)
+	}
+	w.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
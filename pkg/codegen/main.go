@@ -125,8 +125,13 @@ func main() {
 					// All structs with an embedded ObjectMeta field will be picked up
 					"./pkg/apis/ext.cattle.io/v1",
 				},
-				GenerateTypes:   true,
-				GenerateOpenAPI: true,
+				GenerateTypes: true,
+				// Typed clients, listers and informers for other components and integrators consuming the
+				// extension API server.
+				GenerateClients:   true,
+				GenerateListers:   true,
+				GenerateInformers: true,
+				GenerateOpenAPI:   true,
 				OpenAPIDependencies: []string{
 					"k8s.io/apimachinery/pkg/apis/meta/v1",
 					"k8s.io/apimachinery/pkg/runtime",
@@ -8,6 +8,7 @@
 	"github.com/rancher/rancher/pkg/controllers/capr/bootstrap"
 	"github.com/rancher/rancher/pkg/controllers/capr/dynamicschema"
 	"github.com/rancher/rancher/pkg/controllers/capr/machinedrain"
+	"github.com/rancher/rancher/pkg/controllers/capr/machinehealth"
 	"github.com/rancher/rancher/pkg/controllers/capr/machinenodelookup"
 	"github.com/rancher/rancher/pkg/controllers/capr/machineprovision"
 	"github.com/rancher/rancher/pkg/controllers/capr/managesystemagent"
@@ -48,6 +49,7 @@ func Register(ctx context.Context, clients *wrangler.Context, kubeconfigManager
 	rkecontrolplane.Register(ctx, clients)
 	managesystemagent.Register(ctx, clients)
 	machinedrain.Register(ctx, clients)
+	machinehealth.Register(ctx, clients)
 
 	return nil
 }
@@ -161,6 +161,12 @@ func (h *handler) drain(secret *corev1.Secret, machine *capi.Machine, drainData
 	}
 
 	if drainOpts.Enabled {
+		if drainOpts.ForceAfter > 0 {
+			var err error
+			if secret, err = h.forceDrainIfExpired(secret, drainOpts); err != nil {
+				return secret, err
+			}
+		}
 		if err := h.performDrain(machine, drainOpts); err != nil {
 			return nil, err
 		}
@@ -169,6 +175,25 @@ func (h *handler) drain(secret *corev1.Secret, machine *capi.Machine, drainData
 	return h.updateSecretAnnotationIfCheckTrue(secret, capr.DrainDoneAnnotation, drainData, checkPreDrainHooks)
 }
 
+// forceDrainIfExpired records when the drain of the machine started, and changes the drain options to delete the pods
+// without respecting PodDisruptionBudgets once the drain has been running longer than allowed by ForceAfter.
+func (h *handler) forceDrainIfExpired(secret *corev1.Secret, drainOpts *rkev1.DrainOptions) (*corev1.Secret, error) {
+	started, err := time.Parse(time.RFC3339, secret.Annotations[capr.DrainStartedAnnotation])
+	if err != nil {
+		current := secret.Annotations[capr.DrainStartedAnnotation]
+		return h.updateSecretAnnotationIfCheckTrue(secret, capr.DrainStartedAnnotation, time.Now().UTC().Format(time.RFC3339), func(secret *corev1.Secret) bool {
+			return secret.Annotations[capr.DrainStartedAnnotation] == current
+		})
+	}
+
+	if time.Since(started) >= time.Duration(drainOpts.ForceAfter)*time.Second {
+		logrus.Infof("[machinedrain] drain of secret %s/%s has been running since %s, forcing it", secret.Namespace, secret.Name, started.Format(time.RFC3339))
+		drainOpts.DisableEviction = true
+		drainOpts.Force = true
+	}
+	return secret, nil
+}
+
 func (h *handler) cordon(machine *capi.Machine, drainOpts *rkev1.DrainOptions) error {
 	if machine.Status.NodeRef == nil || machine.Status.NodeRef.Name == "" {
 		return nil
@@ -189,6 +214,9 @@ func (h *handler) getHelper(machine *capi.Machine, drainOpts rkev1.DrainOptions)
 	}
 
 	timeout := drainOpts.Timeout
+	if drainOpts.PodDisruptionBudgetTimeout > 0 && !drainOpts.DisableEviction {
+		timeout = drainOpts.PodDisruptionBudgetTimeout
+	}
 	if timeout == 0 {
 		timeout = 600
 	}
@@ -256,6 +284,7 @@ func (h *handler) cleanSecretAnnotationsIfCheckTrue(secret *corev1.Secret, drain
 		delete(secret.Annotations, capr.PostDrainAnnotation)
 		delete(secret.Annotations, capr.DrainAnnotation)
 		delete(secret.Annotations, capr.DrainDoneAnnotation)
+		delete(secret.Annotations, capr.DrainStartedAnnotation)
 		delete(secret.Annotations, capr.UnCordonAnnotation)
 		for _, hook := range drainOpts.PreDrainHooks {
 			delete(secret.Annotations, hook.Annotation)
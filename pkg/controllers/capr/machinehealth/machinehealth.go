@@ -0,0 +1,240 @@
+// Package machinehealth replaces worker machines of rke clusters whose node has been unhealthy for longer than the
+// duration configured on the cluster. Replacement goes through the regular provisioning flow: the CAPI machine is
+// deleted and its MachineSet creates a new one. Like the MachineHealthChecks of CAPI, machines aren't replaced while
+// too many workers of the cluster are unhealthy, e.g. during a network outage, and only a few machines of a cluster are
+// replaced at a time.
+package machinehealth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/capr"
+	capicontrollers "github.com/rancher/rancher/pkg/generated/controllers/cluster.x-k8s.io/v1beta1"
+	rkecontroller "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	ReasonMachineUnhealthy = "MachineUnhealthy"
+	ReasonMachineReplaced  = "MachineReplaced"
+
+	// defaultMaxUnhealthyMachines is the number or percentage of the workers of a cluster that may be unhealthy for
+	// unhealthy machines to be replaced, when the cluster doesn't set it.
+	defaultMaxUnhealthyMachines = "40%"
+	// defaultMaxMachineReplacements is the number of machines of a cluster replaced at a time, when the cluster doesn't
+	// set it.
+	defaultMaxMachineReplacements = 1
+	// replacementRetryInterval is the interval at which the replacement of a machine is retried while it's blocked by
+	// the limits of its cluster.
+	replacementRetryInterval = time.Minute
+)
+
+type handler struct {
+	machines               capicontrollers.MachineController
+	machineCache           capicontrollers.MachineCache
+	machineDeploymentCache capicontrollers.MachineDeploymentCache
+	rkeClusterCache        rkecontroller.RKEClusterCache
+	recorder               record.EventRecorder
+	now                    func() time.Time
+
+	// mu serializes the replacements, for the limits to be checked against the machines deleted by other workers.
+	mu sync.Mutex
+	// deleted are the names of the machines deleted by the handler by cluster key, until their deletion is observed in
+	// the cache.
+	deleted map[string]map[string]bool
+}
+
+func Register(ctx context.Context, clients *wrangler.Context) {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clients.K8s.CoreV1().Events("")})
+
+	h := &handler{
+		machines:               clients.CAPI.Machine(),
+		machineCache:           clients.CAPI.Machine().Cache(),
+		machineDeploymentCache: clients.CAPI.MachineDeployment().Cache(),
+		rkeClusterCache:        clients.RKE.RKECluster().Cache(),
+		recorder:               broadcaster.NewRecorder(wrangler.Scheme, corev1.EventSource{Component: "rancher-machine-self-healing"}),
+		now:                    time.Now,
+		deleted:                map[string]map[string]bool{},
+	}
+
+	clients.CAPI.Machine().OnChange(ctx, "machine-self-healing", h.OnChange)
+}
+
+func (h *handler) OnChange(_ string, machine *capi.Machine) (*capi.Machine, error) {
+	if machine == nil || machine.DeletionTimestamp != nil || !isRKEMachine(machine) {
+		return machine, nil
+	}
+
+	// Only workers are replaced, replacing etcd or control plane nodes can break quorum and must be done by hand.
+	if machine.Labels[capr.EtcdRoleLabel] == "true" || machine.Labels[capr.ControlPlaneRoleLabel] == "true" {
+		return machine, nil
+	}
+
+	rkeCluster, err := h.rkeClusterCache.Get(machine.Namespace, machine.Spec.ClusterName)
+	if apierrors.IsNotFound(err) {
+		return machine, nil
+	} else if err != nil {
+		return machine, err
+	}
+
+	after := rkeCluster.Annotations[capr.ReplaceUnhealthyMachinesAfterAnnotation]
+	if after == "" {
+		return machine, nil
+	}
+	d, err := time.ParseDuration(after)
+	if err != nil {
+		return machine, fmt.Errorf("invalid value %q for annotation %s on RKECluster %s/%s: %w", after, capr.ReplaceUnhealthyMachinesAfterAnnotation, rkeCluster.Namespace, rkeCluster.Name, err)
+	}
+
+	disabled, err := h.selfHealingDisabled(machine)
+	if err != nil || disabled {
+		return machine, err
+	}
+
+	if !conditions.IsFalse(machine, capi.MachineNodeHealthyCondition) {
+		return machine, nil
+	}
+	lastTransition := conditions.GetLastTransitionTime(machine, capi.MachineNodeHealthyCondition)
+	if lastTransition == nil {
+		return machine, fmt.Errorf("error retrieving last transition time for condition %s of Machine %s/%s", capi.MachineNodeHealthyCondition, machine.Namespace, machine.Name)
+	}
+
+	now := h.now()
+	deadline := lastTransition.Time.Add(d)
+	if now.Before(deadline) {
+		logrus.Debugf("[machinehealth] Machine %s/%s has been unhealthy since %s, replacing it after %s", machine.Namespace, machine.Name, lastTransition.String(), d)
+		h.machines.EnqueueAfter(machine.Namespace, machine.Name, deadline.Sub(now))
+		return machine, nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	blocked, err := h.replacementBlocked(machine, rkeCluster)
+	if err != nil {
+		return machine, err
+	}
+	if blocked {
+		h.machines.EnqueueAfter(machine.Namespace, machine.Name, replacementRetryInterval)
+		return machine, nil
+	}
+
+	reason := conditions.GetReason(machine, capi.MachineNodeHealthyCondition)
+	h.recorder.Eventf(machine, corev1.EventTypeWarning, ReasonMachineUnhealthy, "Node has been unhealthy (%s) since %s, longer than %s", reason, lastTransition.String(), d)
+
+	logrus.Infof("[machinehealth] Machine %s/%s has been unhealthy since %s, longer than %s, deleting it to be replaced", machine.Namespace, machine.Name, lastTransition.String(), d)
+	if err := h.machines.Delete(machine.Namespace, machine.Name, nil); err != nil && !apierrors.IsNotFound(err) {
+		return machine, err
+	}
+	clusterKey := machine.Namespace + "/" + machine.Spec.ClusterName
+	if h.deleted[clusterKey] == nil {
+		h.deleted[clusterKey] = map[string]bool{}
+	}
+	h.deleted[clusterKey][machine.Name] = true
+	h.recorder.Event(machine, corev1.EventTypeNormal, ReasonMachineReplaced, "Deleted unhealthy machine to be replaced")
+
+	return machine, nil
+}
+
+// replacementBlocked returns whether the replacement of the machine is blocked by the limits of its cluster: the
+// maximum number or percentage of unhealthy workers, above which the nodes are more likely to be unhealthy because of
+// the infrastructure than because of the machines, and the maximum number of machines being replaced at a time.
+func (h *handler) replacementBlocked(machine *capi.Machine, rkeCluster *rkev1.RKECluster) (bool, error) {
+	maxUnhealthy := intstr.Parse(defaultMaxUnhealthyMachines)
+	if value := rkeCluster.Annotations[capr.MaxUnhealthyMachinesAnnotation]; value != "" {
+		maxUnhealthy = intstr.Parse(value)
+	}
+	maxReplacements := defaultMaxMachineReplacements
+	if value := rkeCluster.Annotations[capr.MaxMachineReplacementsAnnotation]; value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return false, fmt.Errorf("invalid value %q for annotation %s on RKECluster %s/%s: must be a positive integer", value, capr.MaxMachineReplacementsAnnotation, rkeCluster.Namespace, rkeCluster.Name)
+		}
+		maxReplacements = n
+	}
+
+	machines, err := h.machineCache.List(machine.Namespace, labels.SelectorFromSet(labels.Set{capi.ClusterNameLabel: machine.Spec.ClusterName}))
+	if err != nil {
+		return false, err
+	}
+	clusterKey := machine.Namespace + "/" + machine.Spec.ClusterName
+	deleted := h.deleted[clusterKey]
+	var workers, unhealthy, replacing int
+	listed := map[string]bool{}
+	for _, m := range machines {
+		if !isRKEMachine(m) || m.Labels[capr.EtcdRoleLabel] == "true" || m.Labels[capr.ControlPlaneRoleLabel] == "true" {
+			continue
+		}
+		listed[m.Name] = true
+		workers++
+		if m.DeletionTimestamp != nil {
+			delete(deleted, m.Name)
+			replacing++
+		} else if deleted[m.Name] {
+			replacing++
+		} else if conditions.IsFalse(m, capi.MachineNodeHealthyCondition) {
+			unhealthy++
+		}
+	}
+	for name := range deleted {
+		if !listed[name] {
+			delete(deleted, name)
+		}
+	}
+	if len(deleted) == 0 {
+		delete(h.deleted, clusterKey)
+	}
+
+	maxUnhealthyCount, err := intstr.GetScaledValueFromIntOrPercent(&maxUnhealthy, workers, true)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for annotation %s on RKECluster %s/%s: %w", maxUnhealthy.String(), capr.MaxUnhealthyMachinesAnnotation, rkeCluster.Namespace, rkeCluster.Name, err)
+	}
+	if unhealthy > maxUnhealthyCount {
+		logrus.Infof("[machinehealth] Not replacing Machine %s/%s: %d of the %d workers of cluster %s are unhealthy, more than %s", machine.Namespace, machine.Name, unhealthy, workers, machine.Spec.ClusterName, maxUnhealthy.String())
+		return true, nil
+	}
+	if replacing >= maxReplacements {
+		logrus.Debugf("[machinehealth] Not replacing Machine %s/%s yet: %d machines of cluster %s are being replaced", machine.Namespace, machine.Name, replacing, machine.Spec.ClusterName)
+		return true, nil
+	}
+	return false, nil
+}
+
+// selfHealingDisabled returns whether self-healing was disabled for the machine pool of the machine, through an
+// annotation on its MachineDeployment.
+func (h *handler) selfHealingDisabled(machine *capi.Machine) (bool, error) {
+	mdName := machine.Labels[capi.MachineDeploymentNameLabel]
+	if mdName == "" {
+		// Machines not managed by a MachineDeployment would not be replaced once deleted.
+		return true, nil
+	}
+
+	md, err := h.machineDeploymentCache.Get(machine.Namespace, mdName)
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	disabled, _ := strconv.ParseBool(md.Annotations[capr.DisableMachineSelfHealingAnnotation])
+	return disabled, nil
+}
+
+func isRKEMachine(machine *capi.Machine) bool {
+	apiVersion := machine.Spec.InfrastructureRef.APIVersion
+	return apiVersion == capr.RKEAPIVersion || apiVersion == capr.RKEMachineAPIVersion
+}
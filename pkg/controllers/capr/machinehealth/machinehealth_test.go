@@ -0,0 +1,282 @@
+package machinehealth
+
+import (
+	"testing"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/capr"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestOnChange(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newMachine := func(healthy corev1.ConditionStatus, since time.Duration, labels map[string]string) *capi.Machine {
+		l := map[string]string{
+			capr.WorkerRoleLabel:            "true",
+			capi.MachineDeploymentNameLabel: "pool",
+		}
+		for k, v := range labels {
+			l[k] = v
+		}
+		return &capi.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "fleet-default", Labels: l},
+			Spec: capi.MachineSpec{
+				ClusterName:       "cluster",
+				InfrastructureRef: corev1.ObjectReference{APIVersion: capr.RKEMachineAPIVersion},
+			},
+			Status: capi.MachineStatus{
+				Conditions: capi.Conditions{{
+					Type:               capi.MachineNodeHealthyCondition,
+					Status:             healthy,
+					Reason:             capi.NodeConditionsFailedReason,
+					LastTransitionTime: metav1.NewTime(now.Add(-since)),
+				}},
+			},
+		}
+	}
+
+	named := func(name string, machine *capi.Machine) *capi.Machine {
+		machine.Name = name
+		return machine
+	}
+	deleting := func(machine *capi.Machine) *capi.Machine {
+		machine.DeletionTimestamp = &metav1.Time{Time: now}
+		return machine
+	}
+
+	tests := []struct {
+		name               string
+		machine            *capi.Machine
+		others             []*capi.Machine
+		clusterAfter       string
+		clusterAnnotations map[string]string
+		poolDisabled       string
+		expectDelete       bool
+		expectEnqueue      time.Duration
+	}{
+		{
+			name:         "unhealthy past deadline is replaced",
+			machine:      newMachine(corev1.ConditionFalse, 10*time.Minute, nil),
+			clusterAfter: "5m",
+			expectDelete: true,
+		},
+		{
+			name:          "unhealthy before deadline is enqueued",
+			machine:       newMachine(corev1.ConditionFalse, 2*time.Minute, nil),
+			clusterAfter:  "5m",
+			expectEnqueue: 3 * time.Minute,
+		},
+		{
+			name:         "healthy is ignored",
+			machine:      newMachine(corev1.ConditionTrue, 10*time.Minute, nil),
+			clusterAfter: "5m",
+		},
+		{
+			name:    "cluster not opted in is ignored",
+			machine: newMachine(corev1.ConditionFalse, 10*time.Minute, nil),
+		},
+		{
+			name:         "pool disabled is ignored",
+			machine:      newMachine(corev1.ConditionFalse, 10*time.Minute, nil),
+			clusterAfter: "5m",
+			poolDisabled: "true",
+		},
+		{
+			name:    "too many unhealthy workers block the replacement",
+			machine: newMachine(corev1.ConditionFalse, 10*time.Minute, nil),
+			others: []*capi.Machine{
+				named("unhealthy-1", newMachine(corev1.ConditionFalse, time.Minute, nil)),
+				named("unhealthy-2", newMachine(corev1.ConditionFalse, time.Minute, nil)),
+				named("healthy", newMachine(corev1.ConditionTrue, time.Minute, nil)),
+				named("control-plane", newMachine(corev1.ConditionTrue, time.Minute, map[string]string{capr.ControlPlaneRoleLabel: "true"})),
+			},
+			clusterAfter:  "5m",
+			expectEnqueue: replacementRetryInterval,
+		},
+		{
+			name:    "unhealthy workers within the maximum of the cluster are replaced",
+			machine: newMachine(corev1.ConditionFalse, 10*time.Minute, nil),
+			others: []*capi.Machine{
+				named("unhealthy-1", newMachine(corev1.ConditionFalse, time.Minute, nil)),
+				named("unhealthy-2", newMachine(corev1.ConditionFalse, time.Minute, nil)),
+				named("healthy", newMachine(corev1.ConditionTrue, time.Minute, nil)),
+			},
+			clusterAfter:       "5m",
+			clusterAnnotations: map[string]string{capr.MaxUnhealthyMachinesAnnotation: "75%"},
+			expectDelete:       true,
+		},
+		{
+			name:    "machine being replaced blocks the replacement",
+			machine: newMachine(corev1.ConditionFalse, 10*time.Minute, nil),
+			others: []*capi.Machine{
+				deleting(named("replaced", newMachine(corev1.ConditionFalse, time.Hour, nil))),
+				named("healthy", newMachine(corev1.ConditionTrue, time.Minute, nil)),
+			},
+			clusterAfter:  "5m",
+			expectEnqueue: replacementRetryInterval,
+		},
+		{
+			name:    "machines are replaced up to the maximum of the cluster at a time",
+			machine: newMachine(corev1.ConditionFalse, 10*time.Minute, nil),
+			others: []*capi.Machine{
+				deleting(named("replaced", newMachine(corev1.ConditionFalse, time.Hour, nil))),
+				named("healthy", newMachine(corev1.ConditionTrue, time.Minute, nil)),
+			},
+			clusterAfter:       "5m",
+			clusterAnnotations: map[string]string{capr.MaxMachineReplacementsAnnotation: "2"},
+			expectDelete:       true,
+		},
+		{
+			name:         "etcd machine is ignored",
+			machine:      newMachine(corev1.ConditionFalse, 10*time.Minute, map[string]string{capr.EtcdRoleLabel: "true"}),
+			clusterAfter: "5m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			machines := fake.NewMockControllerInterface[*capi.Machine, *capi.MachineList](ctrl)
+			mdCache := fake.NewMockCacheInterface[*capi.MachineDeployment](ctrl)
+			rkeClusterCache := fake.NewMockCacheInterface[*rkev1.RKECluster](ctrl)
+			machineCache := fake.NewMockCacheInterface[*capi.Machine](ctrl)
+			recorder := record.NewFakeRecorder(10)
+
+			annotations := map[string]string{capr.ReplaceUnhealthyMachinesAfterAnnotation: tt.clusterAfter}
+			for k, v := range tt.clusterAnnotations {
+				annotations[k] = v
+			}
+			rkeClusterCache.EXPECT().Get("fleet-default", "cluster").Return(&rkev1.RKECluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cluster",
+					Namespace:   "fleet-default",
+					Annotations: annotations,
+				},
+			}, nil).AnyTimes()
+			machineCache.EXPECT().List("fleet-default", labels.SelectorFromSet(labels.Set{capi.ClusterNameLabel: "cluster"})).
+				Return(append([]*capi.Machine{tt.machine}, tt.others...), nil).AnyTimes()
+			mdCache.EXPECT().Get("fleet-default", "pool").Return(&capi.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pool",
+					Namespace:   "fleet-default",
+					Annotations: map[string]string{capr.DisableMachineSelfHealingAnnotation: tt.poolDisabled},
+				},
+			}, nil).AnyTimes()
+			if tt.expectDelete {
+				machines.EXPECT().Delete("fleet-default", "machine", nil).Return(nil)
+			}
+			if tt.expectEnqueue != 0 {
+				machines.EXPECT().EnqueueAfter("fleet-default", "machine", tt.expectEnqueue)
+			}
+
+			h := &handler{
+				machines:               machines,
+				machineCache:           machineCache,
+				machineDeploymentCache: mdCache,
+				rkeClusterCache:        rkeClusterCache,
+				recorder:               recorder,
+				now:                    func() time.Time { return now },
+				deleted:                map[string]map[string]bool{},
+			}
+
+			_, err := h.OnChange("", tt.machine)
+			assert.NoError(t, err)
+			if tt.expectDelete {
+				assert.Len(t, recorder.Events, 2)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+		})
+	}
+}
+
+func TestOnChangeReplacesOneMachineAtATime(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	newMachine := func(name string) *capi.Machine {
+		return &capi.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "fleet-default",
+				Labels:    map[string]string{capr.WorkerRoleLabel: "true", capi.MachineDeploymentNameLabel: "pool"},
+			},
+			Spec: capi.MachineSpec{
+				ClusterName:       "cluster",
+				InfrastructureRef: corev1.ObjectReference{APIVersion: capr.RKEMachineAPIVersion},
+			},
+			Status: capi.MachineStatus{
+				Conditions: capi.Conditions{{
+					Type:               capi.MachineNodeHealthyCondition,
+					Status:             corev1.ConditionFalse,
+					LastTransitionTime: metav1.NewTime(now.Add(-time.Hour)),
+				}},
+			},
+		}
+	}
+	unhealthy1, unhealthy2 := newMachine("unhealthy-1"), newMachine("unhealthy-2")
+	healthy := newMachine("healthy")
+	healthy.Status.Conditions[0].Status = corev1.ConditionTrue
+
+	ctrl := gomock.NewController(t)
+	machines := fake.NewMockControllerInterface[*capi.Machine, *capi.MachineList](ctrl)
+	machineCache := fake.NewMockCacheInterface[*capi.Machine](ctrl)
+	mdCache := fake.NewMockCacheInterface[*capi.MachineDeployment](ctrl)
+	rkeClusterCache := fake.NewMockCacheInterface[*rkev1.RKECluster](ctrl)
+
+	rkeClusterCache.EXPECT().Get("fleet-default", "cluster").Return(&rkev1.RKECluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				capr.ReplaceUnhealthyMachinesAfterAnnotation: "5m",
+				capr.MaxUnhealthyMachinesAnnotation:          "100%",
+			},
+		},
+	}, nil).AnyTimes()
+	mdCache.EXPECT().Get("fleet-default", "pool").Return(&capi.MachineDeployment{}, nil).AnyTimes()
+	// The cache doesn't observe the deletion of the first machine before the second one is synced.
+	machineCache.EXPECT().List("fleet-default", gomock.Any()).Return([]*capi.Machine{unhealthy1, unhealthy2, healthy}, nil).AnyTimes()
+	machines.EXPECT().Delete("fleet-default", "unhealthy-1", nil).Return(nil)
+	machines.EXPECT().EnqueueAfter("fleet-default", "unhealthy-2", replacementRetryInterval)
+
+	h := &handler{
+		machines:               machines,
+		machineCache:           machineCache,
+		machineDeploymentCache: mdCache,
+		rkeClusterCache:        rkeClusterCache,
+		recorder:               record.NewFakeRecorder(10),
+		now:                    func() time.Time { return now },
+		deleted:                map[string]map[string]bool{},
+	}
+
+	_, err := h.OnChange("", unhealthy1)
+	assert.NoError(t, err)
+	_, err = h.OnChange("", unhealthy2)
+	assert.NoError(t, err)
+}
+
+func TestOnChangeInvalidDuration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rkeClusterCache := fake.NewMockCacheInterface[*rkev1.RKECluster](ctrl)
+	rkeClusterCache.EXPECT().Get("fleet-default", "cluster").Return(&rkev1.RKECluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{capr.ReplaceUnhealthyMachinesAfterAnnotation: "soon"},
+		},
+	}, nil)
+
+	h := &handler{rkeClusterCache: rkeClusterCache, now: time.Now}
+	_, err := h.OnChange("", &capi.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "fleet-default"},
+		Spec: capi.MachineSpec{
+			ClusterName:       "cluster",
+			InfrastructureRef: corev1.ObjectReference{APIVersion: capr.RKEAPIVersion},
+		},
+	})
+	assert.Error(t, err)
+}
@@ -14,6 +14,7 @@
 	"github.com/Masterminds/semver/v3"
 	rancherv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
 	"github.com/rancher/rancher/pkg/capr"
+	"github.com/rancher/rancher/pkg/controllers/dashboard/chart"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterconnected"
 	fleetconst "github.com/rancher/rancher/pkg/fleet"
 	fleetcontrollers "github.com/rancher/rancher/pkg/generated/controllers/fleet.cattle.io/v1alpha1"
@@ -141,7 +142,7 @@ func (h *handler) InstallSystemAgentUpgrader(_ string, cluster *rancherv1.Cluste
 		return cluster, nil
 	}
 
-	targetVersion := settings.SystemUpgradeControllerChartVersion.Get()
+	targetVersion := chart.DesiredVersion(cluster.Spec.SystemChartVersions, chart.SystemUpgradeControllerChartName, settings.SystemUpgradeControllerChartVersion)
 	if targetVersion != capr.SystemUpgradeControllerReady.GetMessage(cp) {
 		logrus.Debugf("[managesystemagent] cluster %s/%s: waiting for system-upgrade-controller to be upgraded to %s",
 			cluster.Namespace, cluster.Name, targetVersion)
@@ -22,6 +22,10 @@
 	capi "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
+const (
+	byRegistrySecret = "by-registry-secret"
+)
+
 var (
 	capiScalingUpCondition   = condition.Cond("ScalingUp")
 	capiScalingDownCondition = condition.Cond("ScalingDown")
@@ -39,6 +43,7 @@ func Register(ctx context.Context, clients *wrangler.Context, planner *caprplann
 		controlPlanes: clients.RKE.RKEControlPlane(),
 	}
 	v1.RegisterRKEControlPlaneStatusHandler(ctx, clients.RKE.RKEControlPlane(), "", "planner", h.OnChange)
+	clients.RKE.RKEControlPlane().Cache().AddIndexer(byRegistrySecret, byRegistrySecretIndex)
 	relatedresource.Watch(ctx, "planner", func(namespace, name string, obj runtime.Object) ([]relatedresource.Key, error) {
 		if secret, ok := obj.(*corev1.Secret); ok {
 			var relatedResources []relatedresource.Key
@@ -60,6 +65,19 @@ func Register(ctx context.Context, clients *wrangler.Context, planner *caprplann
 					})
 				}
 			}
+			// The registries.yaml of the nodes embeds the registry credentials and certificates, so it must be
+			// rendered again when they are rotated.
+			controlPlanes, err := clients.RKE.RKEControlPlane().Cache().GetByIndex(byRegistrySecret, secret.Namespace+"/"+secret.Name)
+			if err != nil {
+				return nil, err
+			}
+			for _, cp := range controlPlanes {
+				logrus.Tracef("[planner] rkecluster %s/%s enqueue triggered by registry secret %s/%s", cp.Namespace, cp.Name, secret.Namespace, secret.Name)
+				relatedResources = append(relatedResources, relatedresource.Key{
+					Namespace: cp.Namespace,
+					Name:      cp.Name,
+				})
+			}
 			return relatedResources, nil
 		} else if machine, ok := obj.(*capi.Machine); ok {
 			clusterName := machine.Labels[capi.ClusterNameLabel]
@@ -88,6 +106,23 @@ func Register(ctx context.Context, clients *wrangler.Context, planner *caprplann
 	}, clients.RKE.RKEControlPlane(), clients.Core.Secret(), clients.CAPI.Machine(), clients.Core.ConfigMap())
 }
 
+func byRegistrySecretIndex(cp *rkev1.RKEControlPlane) ([]string, error) {
+	if cp.Spec.Registries == nil {
+		return nil, nil
+	}
+
+	var result []string
+	for _, config := range cp.Spec.Registries.Configs {
+		if config.AuthConfigSecretName != "" {
+			result = append(result, cp.Namespace+"/"+config.AuthConfigSecretName)
+		}
+		if config.TLSSecretName != "" {
+			result = append(result, cp.Namespace+"/"+config.TLSSecretName)
+		}
+	}
+	return result, nil
+}
+
 func (h *handler) OnChange(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlaneStatus) (rkev1.RKEControlPlaneStatus, error) {
 	logrus.Debugf("[planner] rkecluster %s/%s: handler OnChange called", cp.Namespace, cp.Name)
 	if !cp.DeletionTimestamp.IsZero() {
@@ -16,6 +16,7 @@
 	rocontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
 	rkecontroller "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
 	"github.com/rancher/rancher/pkg/provisioningv2/kubeconfig"
+	"github.com/rancher/rancher/pkg/provisioningv2/machineconditions"
 	"github.com/rancher/rancher/pkg/taints"
 	"github.com/rancher/rancher/pkg/wrangler"
 	"github.com/rancher/wrangler/v3/pkg/apply"
@@ -80,18 +81,13 @@ func Register(ctx context.Context, clients *wrangler.Context, kubeconfigManager
 			return relatedResources, nil
 		} else if m, ok := obj.(*capi.Machine); ok {
 			if m.Spec.InfrastructureRef.Kind == UnmanagedMachineKind && m.Spec.InfrastructureRef.APIVersion == capr.RKEAPIVersion {
+				// The conditions of the CustomMachine mirror the state of its CAPI machine, so it is enqueued on
+				// any change. The handler checks for the deletion of missing machines itself.
 				logrus.Tracef("[unmanaged] handling related resource for CAPI machine %s/%s", m.Namespace, m.Name)
-				rkeCluster, err := clients.RKE.RKECluster().Get(m.Namespace, m.Spec.ClusterName, metav1.GetOptions{})
-				if err != nil {
-					return nil, err
-				}
-				if rkeCluster.Annotations[capr.DeleteMissingCustomMachinesAfterAnnotation] != "" && machineHasNodeNotFoundCondition(m) {
-					logrus.Tracef("[unmanaged] machine %s/%s has node not found condition", m.Namespace, m.Name)
-					return []relatedresource.Key{{
-						Namespace: m.Spec.InfrastructureRef.Namespace,
-						Name:      m.Spec.InfrastructureRef.Name,
-					}}, nil
-				}
+				return []relatedresource.Key{{
+					Namespace: m.Spec.InfrastructureRef.Namespace,
+					Name:      m.Spec.InfrastructureRef.Name,
+				}}, nil
 			}
 		}
 		return nil, nil
@@ -145,6 +141,12 @@ func (h *handler) onSecretChange(_ string, secret *corev1.Secret) (*corev1.Secre
 		return secret, nil
 	}
 
+	if err := validateMachineOS(data); err != nil {
+		// Retrying will not make the request valid, the machine has to register again with other roles.
+		logrus.Errorf("[unmanaged] rejecting registration of machine %s/%s: %v", secret.Namespace, secret.Name, err)
+		return secret, nil
+	}
+
 	capiCluster, err := h.getCAPICluster(secret)
 	if err != nil {
 		return secret, err
@@ -209,6 +211,11 @@ func (h *handler) createMachineObjects(capiCluster *capi.Cluster, machineName st
 		annotations[capr.InternalAddressAnnotation] = internalAddress
 	}
 
+	labels[capr.CattleOSLabel] = machineOS(data)
+	if arch := data.String("arch"); arch != "" {
+		labels[capr.CattleArchLabel] = arch
+	}
+
 	labels[capr.MachineIDLabel] = data.String("id")
 	labels[capr.ClusterNameLabel] = capiCluster.Name
 	labels[capi.ClusterNameLabel] = capiCluster.Name
@@ -303,6 +310,35 @@ func (h *handler) createMachineObjects(capiCluster *capi.Cluster, machineName st
 	}, nil
 }
 
+// machineOS returns the operating system of the machine of a registration request. Agents that do not report it
+// are identified through the OS label, and are assumed to be linux if it is not set either.
+func machineOS(data data.Object) string {
+	if os := strings.ToLower(data.String("os")); os != "" {
+		return os
+	}
+	for _, str := range strings.Split(data.String("labels"), ",") {
+		if k, v := kv.Split(str, "="); k == capr.CattleOSLabel && v != "" {
+			return v
+		}
+	}
+	return capr.DefaultMachineOS
+}
+
+// validateMachineOS returns an error if the roles of the machine of a registration request are not supported by its
+// operating system. Windows machines can only be workers.
+func validateMachineOS(data data.Object) error {
+	if machineOS(data) != capr.WindowsMachineOS {
+		return nil
+	}
+	if data.Bool("role-etcd") || data.Bool("role-control-plane") {
+		return fmt.Errorf("windows machines can only have the worker role")
+	}
+	if !data.Bool("role-worker") {
+		return fmt.Errorf("windows machines must have the worker role")
+	}
+	return nil
+}
+
 func (h *handler) getCAPICluster(secret *corev1.Secret) (*capi.Cluster, error) {
 	cluster, err := h.mgmtClusterCache.Get(secret.Namespace)
 	if apierror.IsNotFound(err) {
@@ -340,6 +376,20 @@ func (h *handler) onUnmanagedMachineChange(_ string, customMachine *rkev1.Custom
 		customMachine.Status.Ready = true
 		return h.unmanagedMachine.UpdateStatus(customMachine)
 	}
+	if os, arch := customMachineOS(customMachine), customMachine.Labels[capr.CattleArchLabel]; customMachine.Status.OS != os || customMachine.Status.Arch != arch {
+		customMachine = customMachine.DeepCopy()
+		customMachine.Status.OS = os
+		customMachine.Status.Arch = arch
+		return h.unmanagedMachine.UpdateStatus(customMachine)
+	}
+
+	owner, err := capr.GetMachineByOwner(h.machineCache, customMachine)
+	if err != nil && !errors.Is(err, capr.ErrNoMachineOwnerRef) && !apierror.IsNotFound(err) {
+		return customMachine, err
+	}
+	if updated := customMachine.DeepCopy(); machineconditions.UpdateCustomMachine(updated, owner) {
+		return h.unmanagedMachine.UpdateStatus(updated)
+	}
 
 	clusterName := customMachine.Labels[capi.ClusterNameLabel]
 	rkeCluster, err := h.rkeClusterCache.Get(customMachine.Namespace, clusterName)
@@ -408,6 +458,15 @@ func (h *handler) onUnmanagedMachineChange(_ string, customMachine *rkev1.Custom
 	return customMachine, nil
 }
 
+// customMachineOS returns the operating system of the custom machine. Machines registered before the OS was recorded
+// only have the OS label if they are not linux.
+func customMachineOS(customMachine *rkev1.CustomMachine) string {
+	if os := customMachine.Labels[capr.CattleOSLabel]; os != "" {
+		return os
+	}
+	return capr.DefaultMachineOS
+}
+
 func machineHasNodeNotFoundCondition(capiMachine *capi.Machine) bool {
 	return conditions.IsFalse(capiMachine, capi.MachineNodeHealthyCondition) && (conditions.GetReason(capiMachine, capi.MachineNodeHealthyCondition) == capi.NodeNotFoundReason)
 }
@@ -0,0 +1,96 @@
+package unmanaged
+
+import (
+	"testing"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/capr"
+	"github.com/rancher/wrangler/v3/pkg/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestValidateMachineOS(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    data.Object
+		wantErr bool
+	}{
+		{
+			name: "linux all roles",
+			data: data.Object{"role-etcd": true, "role-control-plane": true, "role-worker": true},
+		},
+		{
+			name: "windows worker",
+			data: data.Object{"os": "windows", "role-worker": true},
+		},
+		{
+			name:    "windows etcd",
+			data:    data.Object{"os": "windows", "role-etcd": true, "role-worker": true},
+			wantErr: true,
+		},
+		{
+			name:    "windows control plane from label",
+			data:    data.Object{"labels": "cattle.io/os=windows", "role-control-plane": true},
+			wantErr: true,
+		},
+		{
+			name:    "windows without roles",
+			data:    data.Object{"os": "Windows"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMachineOS(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateMachineObjectsOSAndArch(t *testing.T) {
+	capiCluster := &capi.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "fleet-default"}}
+
+	tests := []struct {
+		name         string
+		data         data.Object
+		expectedOS   string
+		expectedArch string
+	}{
+		{
+			name:       "defaults to linux",
+			data:       data.Object{"id": "1", "role-worker": true},
+			expectedOS: capr.DefaultMachineOS,
+		},
+		{
+			name:         "reported os and arch",
+			data:         data.Object{"id": "1", "role-worker": true, "os": "windows", "arch": "amd64"},
+			expectedOS:   capr.WindowsMachineOS,
+			expectedArch: "amd64",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &handler{}
+			objs, err := h.createMachineObjects(capiCluster, "machine", tt.data)
+			require.NoError(t, err)
+
+			for _, obj := range objs {
+				if customMachine, ok := obj.(*rkev1.CustomMachine); ok {
+					assert.Equal(t, tt.expectedOS, customMachine.Labels[capr.CattleOSLabel])
+					assert.Equal(t, tt.expectedArch, customMachine.Labels[capr.CattleArchLabel])
+					return
+				}
+			}
+			t.Fatal("no custom machine created")
+		})
+	}
+}
@@ -4,6 +4,7 @@
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/rancher/rancher/pkg/namespace"
 	"github.com/rancher/rancher/pkg/settings"
@@ -30,8 +31,15 @@
 
 	// RemoteDialerProxyChartName name of the remotedialer-proxy
 	RemoteDialerProxyChartName = "remotedialer-proxy"
+
+	// PinnedVersionsMapName is the name of the configMap that holds the chart versions pinned for the downstream cluster
+	// the agent runs in, keyed by chart name.
+	PinnedVersionsMapName = "rancher-pinned-chart-versions"
 )
 
+// PinnableCharts are the system charts whose version can be pinned per downstream cluster.
+var PinnableCharts = []string{WebhookChartName, SystemUpgradeControllerChartName}
+
 var errKeyNotFound = errors.New("key not found")
 
 //go:generate go tool -modfile ../../../../gotools/mockgen/go.mod mockgen -package=fake -destination=fake/manager.go -source=chart.go Manager
@@ -85,6 +93,11 @@ func (r *RancherConfigGetter) GetChartValues(chartName string) (map[string]any,
 	return retValues, nil
 }
 
+// GetPinnedVersion attempts to retrieve the version the specified chart is pinned to from the rancher-pinned-chart-versions configMap.
+func (r *RancherConfigGetter) GetPinnedVersion(chartName string) (string, error) {
+	return r.getKey(chartName, PinnedVersionsMapName)
+}
+
 // getKey attempts to retrieve the provided key for rancher config map.
 func (r *RancherConfigGetter) getKey(key, configName string) (string, error) {
 	configMap, err := r.ConfigCache.Get(namespace.System, configName)
@@ -102,6 +115,15 @@ func (r *RancherConfigGetter) getKey(key, configName string) (string, error) {
 	return keyValue, nil
 }
 
+// DesiredVersion returns the version of the chart pinned in the given chart versions, or the value of the version setting
+// if the chart isn't pinned.
+func DesiredVersion(pinnedVersions map[string]string, chartName string, versionSetting settings.Setting) string {
+	if version := pinnedVersions[chartName]; version != "" && slices.Contains(PinnableCharts, chartName) {
+		return version
+	}
+	return versionSetting.Get()
+}
+
 // IsNotFoundError returns true if the error was caused by either the desired key or ConfigMap not being found.
 func IsNotFoundError(err error) bool {
 	return apierror.IsNotFound(err) || errors.Is(err, errKeyNotFound)
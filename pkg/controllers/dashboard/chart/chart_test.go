@@ -187,3 +187,45 @@ func TestGetChartValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDesiredVersion(t *testing.T) {
+	current := settings.RancherWebhookVersion.Get()
+	assert.NoError(t, settings.RancherWebhookVersion.Set("107.0.0+up0.8.0"))
+	defer settings.RancherWebhookVersion.Set(current)
+
+	tests := []struct {
+		name           string
+		pinnedVersions map[string]string
+		chartName      string
+		want           string
+	}{
+		{
+			name:      "no pinned versions",
+			chartName: chart.WebhookChartName,
+			want:      "107.0.0+up0.8.0",
+		},
+		{
+			name:           "pinned version",
+			pinnedVersions: map[string]string{chart.WebhookChartName: "106.0.2+up0.7.2"},
+			chartName:      chart.WebhookChartName,
+			want:           "106.0.2+up0.7.2",
+		},
+		{
+			name:           "other chart pinned",
+			pinnedVersions: map[string]string{chart.SystemUpgradeControllerChartName: "106.0.0"},
+			chartName:      chart.WebhookChartName,
+			want:           "107.0.0+up0.8.0",
+		},
+		{
+			name:           "chart can't be pinned",
+			pinnedVersions: map[string]string{chart.RemoteDialerProxyChartName: "105.0.0"},
+			chartName:      chart.RemoteDialerProxyChartName,
+			want:           "107.0.0+up0.8.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, chart.DesiredVersion(tt.pinnedVersions, tt.chartName, settings.RancherWebhookVersion))
+		})
+	}
+}
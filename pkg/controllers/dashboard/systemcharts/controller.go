@@ -168,6 +168,9 @@ func (h *handler) onRepo(key string, repo *catalog.ClusterRepo) (*catalog.Cluste
 		// chart definition, but is now part of the chart definition
 		minVersion := chartDef.MinVersionSetting.Get()
 		exactVersion := chartDef.ExactVersionSetting.Get()
+		if pinned := h.getPinnedVersion(chartDef.ChartName); pinned != "" {
+			minVersion, exactVersion = "", pinned
+		}
 		takeOwnership := chartDef.ChartName == chart.WebhookChartName || chartDef.ChartName == chart.ProvisioningCAPIChartName
 		if err := h.manager.Ensure(chartDef.ReleaseNamespace, chartDef.ChartName, chartDef.ReleaseName, minVersion, exactVersion, values, takeOwnership, installImageOverride); err != nil {
 			return repo, err
@@ -532,6 +535,19 @@ func (h *handler) getChartValues(chartName string) map[string]interface{} {
 	return configMapValues
 }
 
+// getPinnedVersion returns the version the specified chart is pinned to for the downstream cluster the agent runs in,
+// or an empty string if the chart isn't pinned.
+func (h *handler) getPinnedVersion(chartName string) string {
+	if !features.MCMAgent.Enabled() || !slices.Contains(chart.PinnableCharts, chartName) {
+		return ""
+	}
+	version, err := h.chartsConfig.GetPinnedVersion(chartName)
+	if err != nil && !chart.IsNotFoundError(err) {
+		logrus.Warnf("[systemcharts] Failed to get pinned version for %s: %s", chartName, err.Error())
+	}
+	return version
+}
+
 func relatedFeatures(_, _ string, obj runtime.Object) ([]relatedresource.Key, error) {
 	if _, ok := obj.(*v3.Feature); ok {
 		return []relatedresource.Key{{
@@ -553,7 +569,7 @@ func relatedSettings(_, _ string, obj runtime.Object) ([]relatedresource.Key, er
 }
 
 func relatedConfigMaps(_, _ string, obj runtime.Object) ([]relatedresource.Key, error) {
-	if configMap, ok := obj.(*v1.ConfigMap); ok && configMap.Namespace == namespace.System && (configMap.Name == chart.CustomValueMapName || configMap.Name == settings.ConfigMapName.Get()) {
+	if configMap, ok := obj.(*v1.ConfigMap); ok && configMap.Namespace == namespace.System && (configMap.Name == chart.CustomValueMapName || configMap.Name == chart.PinnedVersionsMapName || configMap.Name == settings.ConfigMapName.Get()) {
 		return []relatedresource.Key{{
 			Name: repoName,
 		}}, nil
@@ -21,6 +21,7 @@
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -94,6 +95,13 @@
 			},
 		},
 	}
+	pinnedVersionsConfig = &v1.ConfigMap{
+		Data: map[string]string{
+			chart.WebhookChartName:                 "1.0.0",
+			chart.SystemUpgradeControllerChartName: "1.5.0",
+		},
+	}
+	errPinnedVersionsNotFound = apierrors.NewNotFound(v1.Resource("configmaps"), chart.PinnedVersionsMapName)
 )
 
 const testYAML = `---
@@ -153,6 +161,7 @@ func Test_ChartInstallation(t *testing.T) {
 				_ = settings.SystemUpgradeControllerChartVersion.Set("2.0.0")
 				features.MCM.Set(false)
 				features.MCMAgent.Set(true)
+				mocks.configCache.EXPECT().Get(namespace.System, chart.PinnedVersionsMapName).Return(nil, errPinnedVersionsNotFound).AnyTimes()
 				features.ManagedSystemUpgradeController.Set(true)
 				_ = os.Setenv("CATTLE_SUC_APP_NAME_OVERRIDE", "")
 
@@ -225,6 +234,91 @@ func Test_ChartInstallation(t *testing.T) {
 				mocks.manager.EXPECT().Remove(operatorNamespace, "rancher-operator")
 			},
 		},
+		{
+			name: "installation in downstream cluster with pinned versions",
+			setup: func(mocks testMocks) {
+				mocks.namespaceCtrl.EXPECT().Delete(operatorNamespace, nil).Return(nil)
+				mocks.configCache.EXPECT().Get(namespace.System, chart.CustomValueMapName).Return(priorityConfig, nil).Times(6)
+				mocks.deploymentCache.EXPECT().Get(namespace.System, sucDeploymentName).Return(sucDeployment, nil).Times(1)
+				mocks.planCache.EXPECT().List(namespace.System, managedPlanSelector).Return(nil, nil).Times(1)
+				_ = settings.RancherWebhookVersion.Set("2.0.0")
+				_ = settings.RancherProvisioningCAPIVersion.Set("2.0.0")
+				_ = settings.SystemUpgradeControllerChartVersion.Set("2.0.0")
+				features.MCM.Set(false)
+				features.MCMAgent.Set(true)
+				mocks.configCache.EXPECT().Get(namespace.System, chart.PinnedVersionsMapName).Return(pinnedVersionsConfig, nil).Times(2)
+				features.ManagedSystemUpgradeController.Set(true)
+				_ = os.Setenv("CATTLE_SUC_APP_NAME_OVERRIDE", "")
+
+				// rancher-webhook
+				expectedValues := map[string]interface{}{
+					"priorityClassName": priorityClassName,
+					"capi":              nil,
+					"mcm": map[string]interface{}{
+						"enabled": features.MCM.Enabled(),
+					},
+					"global": map[string]interface{}{
+						"cattle": map[string]interface{}{
+							"systemDefaultRegistry": settings.SystemDefaultRegistry.Get(),
+						},
+					},
+				}
+				mocks.manager.EXPECT().Ensure(
+					namespace.System,
+					chart.WebhookChartName,
+					chart.WebhookChartName,
+					"",
+					"1.0.0",
+					expectedValues,
+					gomock.AssignableToTypeOf(false),
+					"",
+				).Return(nil)
+
+				// rancher-provisioning-capi
+				expectedProvCAPIValues := map[string]interface{}{
+					"priorityClassName": priorityClassName,
+					"global": map[string]interface{}{
+						"cattle": map[string]interface{}{
+							"systemDefaultRegistry": settings.SystemDefaultRegistry.Get(),
+						},
+					},
+				}
+				mocks.manager.EXPECT().Ensure(
+					namespace.ProvisioningCAPINamespace,
+					chart.ProvisioningCAPIChartName,
+					chart.ProvisioningCAPIChartName,
+					"",
+					"2.0.0",
+					expectedProvCAPIValues,
+					gomock.AssignableToTypeOf(false),
+					"",
+				).Return(nil)
+
+				// system-upgrade-controller
+				expectedSUCValues := map[string]interface{}{
+					"priorityClassName": priorityClassName,
+					"global": map[string]interface{}{
+						"cattle": map[string]interface{}{
+							"systemDefaultRegistry": settings.SystemDefaultRegistry.Get(),
+						},
+					},
+				}
+				mocks.manager.EXPECT().Ensure(
+					namespace.System,
+					chart.SystemUpgradeControllerChartName,
+					chart.SystemUpgradeControllerChartName,
+					"",
+					"1.5.0",
+					expectedSUCValues,
+					gomock.AssignableToTypeOf(false),
+					"",
+				).Return(nil)
+
+				// rancher-operator
+				mocks.manager.EXPECT().Uninstall(operatorNamespace, "rancher-operator").Return(nil)
+				mocks.manager.EXPECT().Remove(operatorNamespace, "rancher-operator")
+			},
+		},
 		{
 			name: "normal installation in downstream cluster with system-upgrade-controller name override",
 			setup: func(mocks testMocks) {
@@ -237,6 +331,7 @@ func Test_ChartInstallation(t *testing.T) {
 				_ = settings.SystemUpgradeControllerChartVersion.Set("2.0.0")
 				features.MCM.Set(false)
 				features.MCMAgent.Set(true)
+				mocks.configCache.EXPECT().Get(namespace.System, chart.PinnedVersionsMapName).Return(nil, errPinnedVersionsNotFound).AnyTimes()
 				features.ManagedSystemUpgradeController.Set(true)
 				_ = os.Setenv("CATTLE_SUC_APP_NAME_OVERRIDE", sucAppNameOverride)
 
@@ -321,6 +416,7 @@ func Test_ChartInstallation(t *testing.T) {
 				_ = settings.SystemUpgradeControllerChartVersion.Set("2.0.0")
 				features.MCM.Set(false)
 				features.MCMAgent.Set(true)
+				mocks.configCache.EXPECT().Get(namespace.System, chart.PinnedVersionsMapName).Return(nil, errPinnedVersionsNotFound).AnyTimes()
 				features.ManagedSystemUpgradeController.Set(true)
 				_ = os.Setenv("CATTLE_SUC_APP_NAME_OVERRIDE", "")
 
@@ -388,6 +484,7 @@ func Test_ChartInstallation(t *testing.T) {
 				_ = settings.SystemUpgradeControllerChartVersion.Set("2.0.0")
 				features.MCM.Set(false)
 				features.MCMAgent.Set(true)
+				mocks.configCache.EXPECT().Get(namespace.System, chart.PinnedVersionsMapName).Return(nil, errPinnedVersionsNotFound).AnyTimes()
 				features.ManagedSystemUpgradeController.Set(true)
 				_ = os.Setenv("CATTLE_SUC_APP_NAME_OVERRIDE", "")
 
@@ -455,6 +552,7 @@ func Test_ChartInstallation(t *testing.T) {
 				_ = settings.SystemUpgradeControllerChartVersion.Set("2.0.0")
 				features.MCM.Set(false)
 				features.MCMAgent.Set(true)
+				mocks.configCache.EXPECT().Get(namespace.System, chart.PinnedVersionsMapName).Return(nil, errPinnedVersionsNotFound).AnyTimes()
 				features.ManagedSystemUpgradeController.Set(false)
 				_ = os.Setenv("CATTLE_SUC_APP_NAME_OVERRIDE", "")
 
@@ -521,6 +619,7 @@ func Test_ChartInstallation(t *testing.T) {
 				_ = settings.SystemUpgradeControllerChartVersion.Set("2.0.0")
 				features.MCM.Set(false)
 				features.MCMAgent.Set(true)
+				mocks.configCache.EXPECT().Get(namespace.System, chart.PinnedVersionsMapName).Return(nil, errPinnedVersionsNotFound).AnyTimes()
 				features.ManagedSystemUpgradeController.Set(false)
 				_ = os.Setenv("CATTLE_SUC_APP_NAME_OVERRIDE", "")
 
@@ -1307,6 +1406,14 @@ func Test_relatedConfigMaps(t *testing.T) {
 			}},
 			want: []relatedresource.Key{{Name: repoName, Namespace: ""}},
 		},
+		{
+			name: "pinned chart versions change",
+			changedObj: &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      chart.PinnedVersionsMapName,
+				Namespace: namespace.System,
+			}},
+			want: []relatedresource.Key{{Name: repoName, Namespace: ""}},
+		},
 		{
 			name: "rancher-config changed wrong namespace",
 			changedObj: &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
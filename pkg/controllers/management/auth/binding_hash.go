@@ -0,0 +1,350 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	controllersv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	pkgrbac "github.com/rancher/rancher/pkg/rbac"
+	"github.com/sirupsen/logrus"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// bindingHashAnnotation records the hash of the inputs the RBAC of a role template binding was last reconciled
+	// from. The RBAC isn't reconciled again while the hash is unchanged.
+	bindingHashAnnotation = "auth.management.cattle.io/binding-hash"
+	// bindingHashVersion is part of the hashed inputs. It must be changed along with the RBAC derived from the bindings,
+	// for the RBAC of every binding to be reconciled again.
+	bindingHashVersion = "1"
+
+	failedToRecordBindingHash = "FailedToRecordBindingHash"
+)
+
+// resolvedRoleTemplate is a role template the RBAC of a binding is derived from.
+type resolvedRoleTemplate struct {
+	Name    string              `json:"name"`
+	Context string              `json:"context"`
+	Builtin bool                `json:"builtin,omitempty"`
+	Rules   []rbacv1.PolicyRule `json:"rules"`
+}
+
+// bindingHashInputs are the inputs the RBAC of a role template binding is derived from.
+type bindingHashInputs struct {
+	Version       string                 `json:"version"`
+	Subject       rbacv1.Subject         `json:"subject"`
+	Scope         string                 `json:"scope"`
+	RoleTemplates []resolvedRoleTemplate `json:"roleTemplates"`
+	// Namespaces are the namespaces the RBAC is granted in, like the backing namespaces of the projects of a cluster.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+func (i bindingHashInputs) hash() string {
+	// Marshaling a struct of strings and rules can't fail.
+	data, _ := json.Marshal(i)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// setBindingHash records the hash on the binding.
+func setBindingHash(obj metav1.Object, hash string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[bindingHashAnnotation] = hash
+	obj.SetAnnotations(annotations)
+}
+
+// clearBindingHash removes the hash from the binding, for its RBAC to be reconciled after it was removed.
+func clearBindingHash(obj metav1.Object) {
+	annotations := obj.GetAnnotations()
+	delete(annotations, bindingHashAnnotation)
+	obj.SetAnnotations(annotations)
+}
+
+// staleBindings are the role template bindings whose derived ClusterRoleBindings or RoleBindings were changed or
+// deleted since their RBAC was last reconciled. Their RBAC is reconciled on their next sync even though the hash of its
+// inputs is unchanged, to repair the derived bindings. The zero value is ready to use.
+type staleBindings struct {
+	mu   sync.Mutex
+	keys map[string]bool
+}
+
+func (s *staleBindings) mark(namespace, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.keys == nil {
+		s.keys = map[string]bool{}
+	}
+	s.keys[namespace+"/"+name] = true
+}
+
+// take returns true if the binding is stale, and clears it.
+func (s *staleBindings) take(namespace, name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := namespace + "/" + name
+	stale := s.keys[key]
+	delete(s.keys, key)
+	return stale
+}
+
+// derivedBindingWatcher marks stale and enqueues the role template bindings a ClusterRoleBinding or RoleBinding was
+// derived from when it's changed or deleted. The owners are found from the labels set to their RTB label, or from the
+// owner references of the RoleBindings of the management plane privileges. The changes made by the reconciliation
+// itself enqueue the owners too, whose next reconciliation doesn't change anything.
+type derivedBindingWatcher struct {
+	crtbCache   controllersv3.ClusterRoleTemplateBindingCache
+	prtbCache   controllersv3.ProjectRoleTemplateBindingCache
+	staleCRTBs  *staleBindings
+	stalePRTBs  *staleBindings
+	enqueueCRTB func(namespace, name string)
+	enqueuePRTB func(namespace, name string)
+}
+
+// handler returns the event handler of the informers of the ClusterRoleBindings and RoleBindings. The resyncs of the
+// informers aren't changes, for the RBAC of the bindings to still not be reconciled on every resync.
+func (w *derivedBindingWatcher) handler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldMeta, err := meta.Accessor(oldObj)
+			if err != nil {
+				return
+			}
+			newMeta, err := meta.Accessor(newObj)
+			if err != nil || oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() {
+				return
+			}
+			// The owners removed from the labels are enqueued too, for their binding to be recreated.
+			w.enqueueOwners(oldMeta)
+			w.enqueueOwners(newMeta)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			objMeta, err := meta.Accessor(obj)
+			if err != nil {
+				return
+			}
+			w.enqueueOwners(objMeta)
+		},
+	}
+}
+
+func (w *derivedBindingWatcher) enqueueOwners(obj metav1.Object) {
+	for key, value := range obj.GetLabels() {
+		switch value {
+		case MembershipBindingOwner:
+			w.enqueueCRTBs(key)
+			w.enqueuePRTBs(key)
+		case CrtbInProjectBindingOwner:
+			w.enqueueCRTBs(key)
+		case PrtbInClusterBindingOwner:
+			w.enqueuePRTBs(key)
+		}
+	}
+	// The kind of the owner references may be missing, as it's taken from the type meta of the cached bindings, the
+	// owners are matched by UID.
+	for _, ref := range obj.GetOwnerReferences() {
+		if crtb, err := w.crtbCache.Get(obj.GetNamespace(), ref.Name); err == nil && crtb.UID == ref.UID {
+			w.staleCRTBs.mark(crtb.Namespace, crtb.Name)
+			w.enqueueCRTB(crtb.Namespace, crtb.Name)
+		}
+		if prtb, err := w.prtbCache.Get(obj.GetNamespace(), ref.Name); err == nil && prtb.UID == ref.UID {
+			w.stalePRTBs.mark(prtb.Namespace, prtb.Name)
+			w.enqueuePRTB(prtb.Namespace, prtb.Name)
+		}
+	}
+}
+
+func (w *derivedBindingWatcher) enqueueCRTBs(rtbLabel string) {
+	namespace, ok := rtbLabelNamespace(rtbLabel)
+	if !ok {
+		return
+	}
+	crtbs, err := w.crtbCache.List(namespace, labels.Everything())
+	if err != nil {
+		logrus.Errorf("[%v] Error listing ClusterRoleTemplateBindings of namespace %s: %v", ctrbMGMTController, namespace, err)
+		return
+	}
+	for _, crtb := range crtbs {
+		if pkgrbac.GetRTBLabel(crtb.ObjectMeta) == rtbLabel {
+			w.staleCRTBs.mark(crtb.Namespace, crtb.Name)
+			w.enqueueCRTB(crtb.Namespace, crtb.Name)
+		}
+	}
+}
+
+func (w *derivedBindingWatcher) enqueuePRTBs(rtbLabel string) {
+	namespace, ok := rtbLabelNamespace(rtbLabel)
+	if !ok {
+		return
+	}
+	prtbs, err := w.prtbCache.List(namespace, labels.Everything())
+	if err != nil {
+		logrus.Errorf("[%v] Error listing ProjectRoleTemplateBindings of namespace %s: %v", ptrbMGMTController, namespace, err)
+		return
+	}
+	for _, prtb := range prtbs {
+		if pkgrbac.GetRTBLabel(prtb.ObjectMeta) == rtbLabel {
+			w.stalePRTBs.mark(prtb.Namespace, prtb.Name)
+			w.enqueuePRTB(prtb.Namespace, prtb.Name)
+		}
+	}
+}
+
+// rtbLabelNamespace returns the namespace of the binding of an RTB label. The label may be truncated to a valid label
+// key, the namespace is kept as it comes first.
+func rtbLabelNamespace(rtbLabel string) (string, bool) {
+	namespace, _, found := strings.Cut(rtbLabel, "_")
+	return namespace, found && namespace != ""
+}
+
+// bindingHash returns the hash of the inputs of the RBAC of the CRTB: its subject, cluster, role templates and the
+// backing namespaces of the projects of the cluster.
+func (c *crtbLifecycle) bindingHash(binding *v3.ClusterRoleTemplateBinding) (string, error) {
+	subject, err := pkgrbac.BuildSubjectFromRTB(binding)
+	if err != nil {
+		return "", err
+	}
+	roleTemplates, err := c.mgr.resolveRoleTemplates(binding.RoleTemplateName)
+	if err != nil {
+		return "", err
+	}
+	projects, err := c.projectLister.List(binding.Namespace, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	var namespaces []string
+	for _, p := range projects {
+		if p.DeletionTimestamp == nil {
+			namespaces = append(namespaces, p.GetProjectBackingNamespace())
+		}
+	}
+	slices.Sort(namespaces)
+
+	return bindingHashInputs{
+		Version:       bindingHashVersion,
+		Subject:       subject,
+		Scope:         binding.ClusterName,
+		RoleTemplates: roleTemplates,
+		Namespaces:    namespaces,
+	}.hash(), nil
+}
+
+// reconcileBindingsIfChanged reconciles the RBAC of the CRTB unless the hash of its inputs recorded on the CRTB is
+// unchanged and none of its derived bindings changed, and records the hash once it is reconciled. It saves the no-op
+// updates of the RBAC of every CRTB during the periodic resyncs.
+func (c *crtbLifecycle) reconcileBindingsIfChanged(binding *v3.ClusterRoleTemplateBinding, localConditions *[]metav1.Condition) error {
+	hash, err := c.bindingHash(binding)
+	if err != nil {
+		// The CRTBs without a subject or role template are reported by reconcileBindings.
+		logrus.Debugf("[%v] Reconciling the RBAC of ClusterRoleTemplateBinding %s/%s without a hash: %v", ctrbMGMTController, binding.Namespace, binding.Name, err)
+		return c.reconcileBindings(binding, localConditions)
+	}
+	condition := metav1.Condition{Type: bindingExists}
+	stale := c.staleBindings.take(binding.Namespace, binding.Name)
+	if !stale && binding.Annotations[bindingHashAnnotation] == hash {
+		c.s.AddCondition(localConditions, condition, bindingExists, nil)
+		return nil
+	}
+	if err := c.reconcileBindings(binding, localConditions); err != nil {
+		if stale {
+			c.staleBindings.mark(binding.Namespace, binding.Name)
+		}
+		return err
+	}
+
+	crtb, err := c.crtbCache.Get(binding.Namespace, binding.Name)
+	if err == nil {
+		err = updateFromCache(crtb, func() (*v3.ClusterRoleTemplateBinding, error) {
+			return c.crtbClient.Get(binding.Namespace, binding.Name, metav1.GetOptions{})
+		}, func(crtb *v3.ClusterRoleTemplateBinding) {
+			setBindingHash(crtb, hash)
+		}, c.crtbClient.Update)
+	}
+	if err != nil {
+		c.s.AddCondition(localConditions, condition, failedToRecordBindingHash, err)
+		return err
+	}
+	setBindingHash(binding, hash)
+	return nil
+}
+
+// bindingHash returns the hash of the inputs of the RBAC of the PRTB: its subject, project and role templates.
+func (p *prtbLifecycle) bindingHash(binding *v3.ProjectRoleTemplateBinding) (string, error) {
+	clusterName, projectName, found := strings.Cut(binding.ProjectName, ":")
+	if !found {
+		return "", fmt.Errorf("cannot determine project and cluster from %s", binding.ProjectName)
+	}
+	subject, err := pkgrbac.BuildSubjectFromRTB(binding)
+	if err != nil {
+		return "", err
+	}
+	roleTemplates, err := p.mgr.resolveRoleTemplates(binding.RoleTemplateName)
+	if err != nil {
+		return "", err
+	}
+	proj, err := p.projectLister.Get(clusterName, projectName)
+	if err != nil {
+		return "", err
+	}
+	if proj == nil {
+		return "", fmt.Errorf("project %s was not found", projectName)
+	}
+
+	return bindingHashInputs{
+		Version:       bindingHashVersion,
+		Subject:       subject,
+		Scope:         binding.ProjectName,
+		RoleTemplates: roleTemplates,
+		Namespaces:    []string{proj.Namespace},
+	}.hash(), nil
+}
+
+// reconcileBindingsIfChanged reconciles the RBAC of the PRTB unless the hash of its inputs recorded on the PRTB is
+// unchanged and none of its derived bindings changed, and records the hash once it is reconciled.
+func (p *prtbLifecycle) reconcileBindingsIfChanged(binding *v3.ProjectRoleTemplateBinding) error {
+	hash, err := p.bindingHash(binding)
+	if err != nil {
+		// The PRTBs without a subject, project or role template are handled by reconcileBindings.
+		logrus.Debugf("[%v] Reconciling the RBAC of ProjectRoleTemplateBinding %s/%s without a hash: %v", ptrbMGMTController, binding.Namespace, binding.Name, err)
+		return p.reconcileBindings(binding)
+	}
+	stale := p.staleBindings.take(binding.Namespace, binding.Name)
+	if !stale && binding.Annotations[bindingHashAnnotation] == hash {
+		return nil
+	}
+	if err := p.reconcileBindings(binding); err != nil {
+		if stale {
+			p.staleBindings.mark(binding.Namespace, binding.Name)
+		}
+		return err
+	}
+
+	prtb, err := p.prtbCache.Get(binding.Namespace, binding.Name)
+	if err == nil {
+		err = updateFromCache(prtb, func() (*v3.ProjectRoleTemplateBinding, error) {
+			return p.prtbClient.Get(binding.Namespace, binding.Name, metav1.GetOptions{})
+		}, func(prtb *v3.ProjectRoleTemplateBinding) {
+			setBindingHash(prtb, hash)
+		}, p.prtbClient.Update)
+	}
+	if err != nil {
+		return fmt.Errorf("recording the binding hash of ProjectRoleTemplateBinding %s/%s: %w", binding.Namespace, binding.Name, err)
+	}
+	setBindingHash(binding, hash)
+	return nil
+}
@@ -0,0 +1,298 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestCRTBReconcileBindingsIfChanged(t *testing.T) {
+	readRules := []resolvedRoleTemplate{{
+		Name:    "cluster-member",
+		Context: clusterContext,
+		Rules:   []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}},
+	}}
+	writeRules := []resolvedRoleTemplate{{
+		Name:    "cluster-member",
+		Context: clusterContext,
+		Rules:   []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "update"}}},
+	}}
+	projects := []*v3.Project{{ObjectMeta: metav1.ObjectMeta{Name: "p-1", Namespace: "c-1"}}}
+	newCRTB := func() *v3.ClusterRoleTemplateBinding {
+		return &v3.ClusterRoleTemplateBinding{
+			ObjectMeta:       metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-1"},
+			UserName:         "u-1",
+			ClusterName:      "c-1",
+			RoleTemplateName: "cluster-member",
+		}
+	}
+	hashOf := func(roleTemplates []resolvedRoleTemplate, namespaces ...string) string {
+		return bindingHashInputs{
+			Version:       bindingHashVersion,
+			Subject:       rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "u-1"},
+			Scope:         "c-1",
+			RoleTemplates: roleTemplates,
+			Namespaces:    namespaces,
+		}.hash()
+	}
+
+	tests := []struct {
+		name          string
+		recorded      string
+		stale         bool
+		roleTemplates []resolvedRoleTemplate
+		reconcileErr  error
+		wantReconcile bool
+		wantRecorded  string
+		wantErr       bool
+	}{
+		{
+			name:          "hash is unchanged",
+			recorded:      hashOf(readRules, "p-1"),
+			roleTemplates: readRules,
+			wantRecorded:  hashOf(readRules, "p-1"),
+		},
+		{
+			name:          "hash was not recorded",
+			roleTemplates: readRules,
+			wantReconcile: true,
+			wantRecorded:  hashOf(readRules, "p-1"),
+		},
+		{
+			name:          "rules of the role template changed",
+			recorded:      hashOf(readRules, "p-1"),
+			roleTemplates: writeRules,
+			wantReconcile: true,
+			wantRecorded:  hashOf(writeRules, "p-1"),
+		},
+		{
+			name:          "a project was added",
+			recorded:      hashOf(readRules),
+			roleTemplates: readRules,
+			wantReconcile: true,
+			wantRecorded:  hashOf(readRules, "p-1"),
+		},
+		{
+			name:          "derived bindings changed",
+			recorded:      hashOf(readRules, "p-1"),
+			stale:         true,
+			roleTemplates: readRules,
+			wantReconcile: true,
+			wantRecorded:  hashOf(readRules, "p-1"),
+		},
+		{
+			name:          "stale binding stays stale when the reconciliation fails",
+			recorded:      hashOf(readRules, "p-1"),
+			stale:         true,
+			roleTemplates: readRules,
+			reconcileErr:  fmt.Errorf("error"),
+			wantReconcile: true,
+			wantRecorded:  hashOf(readRules, "p-1"),
+			wantErr:       true,
+		},
+		{
+			name:          "hash is not recorded when the reconciliation fails",
+			roleTemplates: readRules,
+			reconcileErr:  fmt.Errorf("error"),
+			wantReconcile: true,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			crtb := newCRTB()
+			if tt.recorded != "" {
+				setBindingHash(crtb, tt.recorded)
+			}
+
+			mgr := NewMockmanagerInterface(ctrl)
+			mgr.EXPECT().resolveRoleTemplates("cluster-member").Return(tt.roleTemplates, nil)
+			var reconciled bool
+			clusterLister := &fakes.ClusterListerMock{
+				GetFunc: func(_, name string) (*v3.Cluster, error) {
+					reconciled = true
+					if tt.reconcileErr != nil {
+						return nil, tt.reconcileErr
+					}
+					return &v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+				},
+			}
+			if tt.wantReconcile && tt.reconcileErr == nil {
+				mgr.EXPECT().checkReferencedRoles("cluster-member", clusterContext, 0).Return(false, nil)
+				mgr.EXPECT().ensureClusterMembershipBinding("c-1-clustermember", "c-1_crtb-1", gomock.Any(), false, gomock.Any()).Return(nil)
+				mgr.EXPECT().grantManagementPlanePrivileges("cluster-member", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mgr.EXPECT().grantManagementClusterScopedPrivilegesInProjectNamespace("cluster-member", "p-1", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			}
+			crtbClient := fake.NewMockControllerInterface[*v3.ClusterRoleTemplateBinding, *v3.ClusterRoleTemplateBindingList](ctrl)
+			crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+			if tt.wantReconcile && !tt.wantErr {
+				crtbCache.EXPECT().Get("c-1", "crtb-1").Return(newCRTB(), nil)
+				crtbClient.EXPECT().Update(gomock.Any()).DoAndReturn(func(obj *v3.ClusterRoleTemplateBinding) (*v3.ClusterRoleTemplateBinding, error) {
+					assert.Equal(t, tt.wantRecorded, obj.Annotations[bindingHashAnnotation])
+					return obj, nil
+				})
+			}
+
+			c := &crtbLifecycle{
+				mgr:           mgr,
+				clusterLister: clusterLister,
+				projectLister: &fakes.ProjectListerMock{
+					ListFunc: func(string, labels.Selector) ([]*v3.Project, error) {
+						return projects, nil
+					},
+				},
+				crtbClient: crtbClient,
+				crtbCache:  crtbCache,
+				s:          &status.Status{TimeNow: time.Now},
+			}
+			if tt.stale {
+				c.staleBindings.mark("c-1", "crtb-1")
+			}
+
+			var conditions []metav1.Condition
+			err := c.reconcileBindingsIfChanged(crtb, &conditions)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantReconcile, reconciled)
+			assert.Equal(t, tt.wantRecorded, crtb.Annotations[bindingHashAnnotation])
+			require.Len(t, conditions, 1)
+			assert.Equal(t, bindingExists, conditions[0].Type)
+			assert.Equal(t, tt.stale && tt.wantErr, c.staleBindings.take("c-1", "crtb-1"))
+		})
+	}
+}
+
+func TestDerivedBindingWatcher(t *testing.T) {
+	crtb := &v3.ClusterRoleTemplateBinding{ObjectMeta: metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-1", UID: "crtb-uid"}}
+	prtb := &v3.ProjectRoleTemplateBinding{ObjectMeta: metav1.ObjectMeta{Name: "prtb-1", Namespace: "p-1", UID: "prtb-uid"}}
+
+	tests := []struct {
+		name       string
+		oldObj     any
+		newObj     any
+		wantCRTBs  []string
+		wantPRTBs  []string
+		wantStaleC bool
+		wantStaleP bool
+	}{
+		{
+			name: "membership binding of a CRTB changed",
+			oldObj: &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{
+				Name:            "crb-1",
+				ResourceVersion: "1",
+				Labels:          map[string]string{"c-1_crtb-1": MembershipBindingOwner},
+			}},
+			newObj: &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{
+				Name:            "crb-1",
+				ResourceVersion: "2",
+			}},
+			wantCRTBs:  []string{"c-1/crtb-1"},
+			wantStaleC: true,
+		},
+		{
+			name: "resync is ignored",
+			oldObj: &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{
+				Name:            "crb-1",
+				ResourceVersion: "1",
+				Labels:          map[string]string{"c-1_crtb-1": MembershipBindingOwner},
+			}},
+			newObj: &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{
+				Name:            "crb-1",
+				ResourceVersion: "1",
+				Labels:          map[string]string{"c-1_crtb-1": MembershipBindingOwner},
+			}},
+		},
+		{
+			name: "management plane binding of a PRTB deleted",
+			oldObj: &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{
+				Name:            "rb-1",
+				Namespace:       "p-1",
+				OwnerReferences: []metav1.OwnerReference{{Name: "prtb-1", UID: "prtb-uid"}},
+			}},
+			wantPRTBs:  []string{"p-1/prtb-1"},
+			wantStaleP: true,
+		},
+		{
+			name: "binding of a PRTB in the cluster namespace deleted",
+			oldObj: cache.DeletedFinalStateUnknown{Obj: &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{
+				Name:      "rb-1",
+				Namespace: "c-1",
+				Labels:    map[string]string{"p-1_prtb-1": PrtbInClusterBindingOwner},
+			}}},
+			wantPRTBs:  []string{"p-1/prtb-1"},
+			wantStaleP: true,
+		},
+		{
+			name: "binding of another owner deleted",
+			oldObj: &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{
+				Name:            "rb-1",
+				Namespace:       "p-1",
+				Labels:          map[string]string{"p-1_prtb-2": MembershipBindingOwner},
+				OwnerReferences: []metav1.OwnerReference{{Name: "prtb-1", UID: "other-uid"}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+			crtbCache.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(namespace string, _ labels.Selector) ([]*v3.ClusterRoleTemplateBinding, error) {
+				if namespace == crtb.Namespace {
+					return []*v3.ClusterRoleTemplateBinding{crtb}, nil
+				}
+				return nil, nil
+			}).AnyTimes()
+			crtbCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, apierrors.NewNotFound(v3.Resource("clusterroletemplatebindings"), "")).AnyTimes()
+			prtbCache := fake.NewMockCacheInterface[*v3.ProjectRoleTemplateBinding](ctrl)
+			prtbCache.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(namespace string, _ labels.Selector) ([]*v3.ProjectRoleTemplateBinding, error) {
+				if namespace == prtb.Namespace {
+					return []*v3.ProjectRoleTemplateBinding{prtb}, nil
+				}
+				return nil, nil
+			}).AnyTimes()
+			prtbCache.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(namespace, name string) (*v3.ProjectRoleTemplateBinding, error) {
+				if namespace == prtb.Namespace && name == prtb.Name {
+					return prtb, nil
+				}
+				return nil, apierrors.NewNotFound(v3.Resource("projectroletemplatebindings"), name)
+			}).AnyTimes()
+
+			var crtbs, prtbs []string
+			w := &derivedBindingWatcher{
+				crtbCache:   crtbCache,
+				prtbCache:   prtbCache,
+				staleCRTBs:  &staleBindings{},
+				stalePRTBs:  &staleBindings{},
+				enqueueCRTB: func(namespace, name string) { crtbs = append(crtbs, namespace+"/"+name) },
+				enqueuePRTB: func(namespace, name string) { prtbs = append(prtbs, namespace+"/"+name) },
+			}
+			if tt.newObj != nil {
+				w.handler().OnUpdate(tt.oldObj, tt.newObj)
+			} else {
+				w.handler().OnDelete(tt.oldObj)
+			}
+
+			assert.Equal(t, tt.wantCRTBs, crtbs)
+			assert.Equal(t, tt.wantPRTBs, prtbs)
+			assert.Equal(t, tt.wantStaleC, w.staleCRTBs.take("c-1", "crtb-1"))
+			assert.Equal(t, tt.wantStaleP, w.stalePRTBs.take("p-1", "prtb-1"))
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Package bootstrapprincipals keeps the GlobalRoleBindings of the principals declared by BootstrapPrincipals in sync
+// with them, for admins to be established declaratively rather than by logging in first. The user of a user principal
+// is created by the GlobalRoleBinding controller from the UserPrincipalName of its bindings.
+package bootstrapprincipals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/auth/project_cluster"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/rancher/wrangler/v3/pkg/name"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+const (
+	controllerName = "mgmt-auth-bootstrap-principal-controller"
+	grbEnqueuer    = "mgmt-auth-bootstrap-principal-grb-enqueuer"
+)
+
+// escalationChecker is the subset of [rbac.EscalationChecker] used by the handler.
+type escalationChecker interface {
+	CheckGlobalRoleBinding(u user.Info, globalRoleName string) error
+}
+
+type handler struct {
+	grbCache          mgmtv3.GlobalRoleBindingCache
+	grbs              mgmtv3.GlobalRoleBindingClient
+	escalationChecker escalationChecker
+}
+
+// Register registers the controller creating and deleting the GlobalRoleBindings of BootstrapPrincipals.
+func Register(ctx context.Context, wContext *wrangler.Context) {
+	h := &handler{
+		grbCache:          wContext.Mgmt.GlobalRoleBinding().Cache(),
+		grbs:              wContext.Mgmt.GlobalRoleBinding(),
+		escalationChecker: rbac.NewEscalationChecker(wContext.Mgmt, wContext.RBAC),
+	}
+	relatedresource.WatchClusterScoped(ctx, grbEnqueuer, enqueueBootstrapPrincipal, wContext.Mgmt.BootstrapPrincipal(), wContext.Mgmt.GlobalRoleBinding())
+	wContext.Mgmt.BootstrapPrincipal().OnChange(ctx, controllerName, h.onChange)
+}
+
+// enqueueBootstrapPrincipal enqueues the BootstrapPrincipal a GlobalRoleBinding was created from, so that the binding
+// is recreated if it's deleted.
+func enqueueBootstrapPrincipal(_, _ string, obj runtime.Object) ([]relatedresource.Key, error) {
+	grb, ok := obj.(*v3.GlobalRoleBinding)
+	if !ok || grb.Labels[v3.BootstrapPrincipalLabel] == "" {
+		return nil, nil
+	}
+	return []relatedresource.Key{{Name: grb.Labels[v3.BootstrapPrincipalLabel]}}, nil
+}
+
+// onChange creates the GlobalRoleBindings of the principal missing for its global roles, and deletes the ones for
+// global roles it no longer has or for another principal. The bindings are owned by the BootstrapPrincipal, for them
+// to be garbage collected when it's deleted. As the bindings are created by Rancher, the global roles are checked
+// against the creator of the BootstrapPrincipal, who must be able to grant them, for it not to be a way around the
+// escalation checks of GlobalRoleBindings.
+func (h *handler) onChange(_ string, bp *v3.BootstrapPrincipal) (*v3.BootstrapPrincipal, error) {
+	if bp == nil || bp.DeletionTimestamp != nil {
+		return bp, nil
+	}
+	if (bp.Spec.UserPrincipalName == "") == (bp.Spec.GroupPrincipalName == "") {
+		return bp, fmt.Errorf("bootstrap principal %s must have exactly one of a user or a group principal", bp.Name)
+	}
+	creatorID := bp.Annotations[project_cluster.CreatorIDAnnotation]
+	if creatorID == "" {
+		return bp, fmt.Errorf("bootstrap principal %s has no %s annotation", bp.Name, project_cluster.CreatorIDAnnotation)
+	}
+	creator := &user.DefaultInfo{Name: creatorID}
+
+	existing, err := h.grbCache.List(labels.SelectorFromSet(labels.Set{v3.BootstrapPrincipalLabel: bp.Name}))
+	if err != nil {
+		return bp, fmt.Errorf("listing global role bindings of bootstrap principal %s: %w", bp.Name, err)
+	}
+
+	desired := slices.Clone(bp.Spec.GlobalRoleNames)
+	slices.Sort(desired)
+	desired = slices.Compact(desired)
+
+	var errs []error
+	for _, grb := range existing {
+		if slices.Contains(desired, grb.GlobalRoleName) && grb.UserPrincipalName == bp.Spec.UserPrincipalName && grb.GroupPrincipalName == bp.Spec.GroupPrincipalName {
+			desired = slices.DeleteFunc(desired, func(globalRoleName string) bool { return globalRoleName == grb.GlobalRoleName })
+			continue
+		}
+		if err := h.grbs.Delete(grb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting global role binding %s: %w", grb.Name, err))
+		}
+	}
+
+	for _, globalRoleName := range desired {
+		if err := h.escalationChecker.CheckGlobalRoleBinding(creator, globalRoleName); err != nil {
+			errs = append(errs, fmt.Errorf("bootstrap principal %s can't be granted global role %s: %w", bp.Name, globalRoleName, err))
+			continue
+		}
+		grbName := name.SafeConcatName("grb", bp.Name, globalRoleName)
+		_, err := h.grbs.Create(&v3.GlobalRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            grbName,
+				Labels:          map[string]string{v3.BootstrapPrincipalLabel: bp.Name},
+				OwnerReferences: []metav1.OwnerReference{ownerReference(bp)},
+			},
+			UserPrincipalName:  bp.Spec.UserPrincipalName,
+			GroupPrincipalName: bp.Spec.GroupPrincipalName,
+			GlobalRoleName:     globalRoleName,
+		})
+		if apierrors.IsAlreadyExists(err) {
+			err = h.adoptGRB(bp, grbName, globalRoleName)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("creating global role binding of global role %s for bootstrap principal %s: %w", globalRoleName, bp.Name, err))
+		}
+	}
+
+	return bp, errors.Join(errs...)
+}
+
+// adoptGRB labels and owns the existing binding with the name of the binding of the global role for the
+// BootstrapPrincipal, if it binds the global role to its principal. As the subject and the role of a binding can't be
+// changed, a binding for another principal or global role is an error, unless it's a binding of the BootstrapPrincipal
+// deleted above, which is recreated once it's gone as its deletion enqueues the BootstrapPrincipal.
+func (h *handler) adoptGRB(bp *v3.BootstrapPrincipal, grbName, globalRoleName string) error {
+	grb, err := h.grbs.Get(grbName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting existing global role binding %s: %w", grbName, err)
+	}
+
+	owned := grb.Labels[v3.BootstrapPrincipalLabel] == bp.Name
+	if grb.DeletionTimestamp != nil && owned {
+		return nil
+	}
+	if grb.DeletionTimestamp != nil || grb.GlobalRoleName != globalRoleName || grb.UserPrincipalName != bp.Spec.UserPrincipalName || grb.GroupPrincipalName != bp.Spec.GroupPrincipalName {
+		return fmt.Errorf("global role binding %s already exists for another principal or global role", grbName)
+	}
+	hasOwner := slices.ContainsFunc(grb.OwnerReferences, func(ref metav1.OwnerReference) bool { return ref.UID == bp.UID })
+	if owned && hasOwner {
+		return nil
+	}
+
+	grb = grb.DeepCopy()
+	if grb.Labels == nil {
+		grb.Labels = map[string]string{}
+	}
+	grb.Labels[v3.BootstrapPrincipalLabel] = bp.Name
+	if !hasOwner {
+		grb.OwnerReferences = append(grb.OwnerReferences, ownerReference(bp))
+	}
+	if _, err := h.grbs.Update(grb); err != nil {
+		return fmt.Errorf("adopting existing global role binding %s: %w", grbName, err)
+	}
+	return nil
+}
+
+func ownerReference(bp *v3.BootstrapPrincipal) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: v3.SchemeGroupVersion.String(),
+		Kind:       "BootstrapPrincipal",
+		Name:       bp.Name,
+		UID:        bp.UID,
+	}
+}
@@ -0,0 +1,277 @@
+package bootstrapprincipals
+
+import (
+	"fmt"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+const (
+	admin  = "github_user://1234"
+	admins = "activedirectory_group://CN=admins,DC=example,DC=com"
+)
+
+// fakeEscalationChecker allows granting the global roles, except the forbidden ones, and records the users checked.
+type fakeEscalationChecker struct {
+	forbidden []string
+	users     []string
+}
+
+func (f *fakeEscalationChecker) CheckGlobalRoleBinding(u user.Info, globalRoleName string) error {
+	f.users = append(f.users, u.GetName())
+	for _, forbidden := range f.forbidden {
+		if forbidden == globalRoleName {
+			return fmt.Errorf("user %s can't grant global role %s", u.GetName(), globalRoleName)
+		}
+	}
+	return nil
+}
+
+func newBootstrapPrincipal(spec v3.BootstrapPrincipalSpec) *v3.BootstrapPrincipal {
+	return &v3.BootstrapPrincipal{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "admins",
+			UID:         "uid",
+			Annotations: map[string]string{"field.cattle.io/creatorId": "u-creator"},
+		},
+		Spec: spec,
+	}
+}
+
+func newManagedGRB(name, userPrincipalName, groupPrincipalName, globalRoleName string) *v3.GlobalRoleBinding {
+	return &v3.GlobalRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{v3.BootstrapPrincipalLabel: "admins"},
+		},
+		UserPrincipalName:  userPrincipalName,
+		GroupPrincipalName: groupPrincipalName,
+		GlobalRoleName:     globalRoleName,
+	}
+}
+
+func TestOnChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        v3.BootstrapPrincipalSpec
+		existing    []*v3.GlobalRoleBinding
+		forbidden   []string
+		wantCreated []string
+		wantDeleted []string
+		wantErr     bool
+	}{
+		{
+			name:        "creates bindings of a user principal",
+			spec:        v3.BootstrapPrincipalSpec{UserPrincipalName: admin, GlobalRoleNames: []string{"admin", "user", "admin"}},
+			wantCreated: []string{"admin", "user"},
+		},
+		{
+			name:        "creates bindings of a group principal",
+			spec:        v3.BootstrapPrincipalSpec{GroupPrincipalName: admins, GlobalRoleNames: []string{"admin"}},
+			wantCreated: []string{"admin"},
+		},
+		{
+			name: "keeps existing bindings",
+			spec: v3.BootstrapPrincipalSpec{UserPrincipalName: admin, GlobalRoleNames: []string{"admin"}},
+			existing: []*v3.GlobalRoleBinding{
+				newManagedGRB("grb-admins-admin", admin, "", "admin"),
+			},
+		},
+		{
+			name: "deletes bindings of removed global roles",
+			spec: v3.BootstrapPrincipalSpec{UserPrincipalName: admin, GlobalRoleNames: []string{"admin"}},
+			existing: []*v3.GlobalRoleBinding{
+				newManagedGRB("grb-admins-admin", admin, "", "admin"),
+				newManagedGRB("grb-admins-user", admin, "", "user"),
+				newManagedGRB("grb-admins-gone", admin, "", "catalogs-use"),
+			},
+			wantDeleted: []string{"grb-admins-user", "grb-admins-gone"},
+		},
+		{
+			name: "replaces bindings of another principal",
+			spec: v3.BootstrapPrincipalSpec{GroupPrincipalName: admins, GlobalRoleNames: []string{"admin"}},
+			existing: []*v3.GlobalRoleBinding{
+				newManagedGRB("grb-admins-admin", admin, "", "admin"),
+			},
+			wantCreated: []string{"admin"},
+			wantDeleted: []string{"grb-admins-admin"},
+		},
+		{
+			name:        "doesn't create bindings of global roles the creator can't grant",
+			spec:        v3.BootstrapPrincipalSpec{UserPrincipalName: admin, GlobalRoleNames: []string{"admin", "user"}},
+			forbidden:   []string{"admin"},
+			wantCreated: []string{"user"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			grbCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleBinding](ctrl)
+			grbCache.EXPECT().List(labels.SelectorFromSet(labels.Set{v3.BootstrapPrincipalLabel: "admins"})).Return(tt.existing, nil)
+
+			grbs := fake.NewMockNonNamespacedControllerInterface[*v3.GlobalRoleBinding, *v3.GlobalRoleBindingList](ctrl)
+			var created, deleted []string
+			grbs.EXPECT().Create(gomock.Any()).DoAndReturn(func(grb *v3.GlobalRoleBinding) (*v3.GlobalRoleBinding, error) {
+				assert.Equal(t, tt.spec.UserPrincipalName, grb.UserPrincipalName)
+				assert.Equal(t, tt.spec.GroupPrincipalName, grb.GroupPrincipalName)
+				assert.Equal(t, "admins", grb.Labels[v3.BootstrapPrincipalLabel])
+				require.Len(t, grb.OwnerReferences, 1)
+				assert.Equal(t, "admins", grb.OwnerReferences[0].Name)
+				created = append(created, grb.GlobalRoleName)
+				return grb, nil
+			}).AnyTimes()
+			grbs.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(name string, _ *metav1.DeleteOptions) error {
+				deleted = append(deleted, name)
+				if name == "grb-admins-gone" {
+					return apierrors.NewNotFound(schema.GroupResource{}, name)
+				}
+				return nil
+			}).AnyTimes()
+
+			checker := &fakeEscalationChecker{forbidden: tt.forbidden}
+			h := &handler{
+				grbCache:          grbCache,
+				grbs:              grbs,
+				escalationChecker: checker,
+			}
+
+			_, err := h.onChange("", newBootstrapPrincipal(tt.spec))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCreated, created)
+			assert.Equal(t, tt.wantDeleted, deleted)
+			for _, checked := range checker.users {
+				assert.Equal(t, "u-creator", checked)
+			}
+		})
+	}
+}
+
+func TestOnChangeExistingBinding(t *testing.T) {
+	now := metav1.Now()
+	tests := []struct {
+		name        string
+		existing    *v3.GlobalRoleBinding
+		wantUpdated bool
+		wantErr     bool
+	}{
+		{
+			name: "adopts a binding of the principal",
+			existing: &v3.GlobalRoleBinding{
+				ObjectMeta:        metav1.ObjectMeta{Name: "grb-admins-admin"},
+				UserPrincipalName: admin,
+				GlobalRoleName:    "admin",
+			},
+			wantUpdated: true,
+		},
+		{
+			name: "rejects a binding of another principal",
+			existing: &v3.GlobalRoleBinding{
+				ObjectMeta:        metav1.ObjectMeta{Name: "grb-admins-admin"},
+				UserPrincipalName: "github_user://5678",
+				GlobalRoleName:    "admin",
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a binding of another global role",
+			existing: &v3.GlobalRoleBinding{
+				ObjectMeta:        metav1.ObjectMeta{Name: "grb-admins-admin"},
+				UserPrincipalName: admin,
+				GlobalRoleName:    "user",
+			},
+			wantErr: true,
+		},
+		{
+			name: "waits for a deleted binding of the bootstrap principal",
+			existing: &v3.GlobalRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "grb-admins-admin",
+					Labels:            map[string]string{v3.BootstrapPrincipalLabel: "admins"},
+					DeletionTimestamp: &now,
+				},
+				GroupPrincipalName: admins,
+				GlobalRoleName:     "admin",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			grbCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleBinding](ctrl)
+			grbCache.EXPECT().List(gomock.Any()).Return(nil, nil)
+
+			grbs := fake.NewMockNonNamespacedControllerInterface[*v3.GlobalRoleBinding, *v3.GlobalRoleBindingList](ctrl)
+			grbs.EXPECT().Create(gomock.Any()).Return(nil, apierrors.NewAlreadyExists(schema.GroupResource{}, "grb-admins-admin"))
+			grbs.EXPECT().Get("grb-admins-admin", gomock.Any()).Return(tt.existing, nil)
+			if tt.wantUpdated {
+				grbs.EXPECT().Update(gomock.Any()).DoAndReturn(func(grb *v3.GlobalRoleBinding) (*v3.GlobalRoleBinding, error) {
+					assert.Equal(t, "admins", grb.Labels[v3.BootstrapPrincipalLabel])
+					require.Len(t, grb.OwnerReferences, 1)
+					assert.Equal(t, types.UID("uid"), grb.OwnerReferences[0].UID)
+					return grb, nil
+				})
+			}
+
+			h := &handler{
+				grbCache:          grbCache,
+				grbs:              grbs,
+				escalationChecker: &fakeEscalationChecker{},
+			}
+
+			_, err := h.onChange("", newBootstrapPrincipal(v3.BootstrapPrincipalSpec{UserPrincipalName: admin, GlobalRoleNames: []string{"admin"}}))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOnChangeInvalid(t *testing.T) {
+	h := &handler{}
+
+	_, err := h.onChange("", newBootstrapPrincipal(v3.BootstrapPrincipalSpec{UserPrincipalName: admin, GroupPrincipalName: admins, GlobalRoleNames: []string{"admin"}}))
+	assert.Error(t, err)
+
+	// Without a creator, the global roles can't be checked.
+	_, err = h.onChange("", &v3.BootstrapPrincipal{
+		ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+		Spec:       v3.BootstrapPrincipalSpec{UserPrincipalName: admin, GlobalRoleNames: []string{"admin"}},
+	})
+	assert.Error(t, err)
+
+	_, err = h.onChange("", nil)
+	assert.NoError(t, err)
+}
+
+func TestEnqueueBootstrapPrincipal(t *testing.T) {
+	keys, err := enqueueBootstrapPrincipal("", "", newManagedGRB("grb-admins-admin", admin, "", "admin"))
+	require.NoError(t, err)
+	assert.Equal(t, []relatedresource.Key{{Name: "admins"}}, keys)
+
+	keys, err = enqueueBootstrapPrincipal("", "", &v3.GlobalRoleBinding{UserName: "u-1", GlobalRoleName: "admin"})
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"k8s.io/client-go/util/retry"
+)
+
+// updateFromCache applies mutate to a copy of the cached object and updates it, retrying on conflicts.
+//
+// The first attempt is made from the cached object, without reading it from the apiserver. An object missing from the
+// cache or stale only fails the update with a conflict, after which the object is read with get for the next attempts.
+// The number of apiserver reads is thus proportional to the conflicts, not to the objects updated.
+func updateFromCache[T interface{ DeepCopy() T }](cached T, get func() (T, error), mutate func(T), update func(T) (T, error)) error {
+	attempt := 0
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj := cached.DeepCopy()
+		if attempt > 0 {
+			latest, err := get()
+			if err != nil {
+				return err
+			}
+			obj = latest
+		}
+		attempt++
+		mutate(obj)
+		_, err := update(obj)
+		return err
+	})
+}
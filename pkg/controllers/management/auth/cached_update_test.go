@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	corefakes "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1/fakes"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestUpdateFromCache(t *testing.T) {
+	cached := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "rb-1", ResourceVersion: "1"}}
+	setLabel := func(rb *rbacv1.RoleBinding) {
+		rb.Labels = map[string]string{"key": "value"}
+	}
+
+	t.Run("the cached object is updated without reading it", func(t *testing.T) {
+		var updated []*rbacv1.RoleBinding
+		err := updateFromCache(cached, func() (*rbacv1.RoleBinding, error) {
+			t.Fatal("the object was read")
+			return nil, nil
+		}, setLabel, func(rb *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
+			updated = append(updated, rb)
+			return rb, nil
+		})
+		require.NoError(t, err)
+		require.Len(t, updated, 1)
+		assert.Equal(t, "value", updated[0].Labels["key"])
+		assert.Nil(t, cached.Labels, "the cached object was modified")
+	})
+
+	t.Run("the object is read after a conflict", func(t *testing.T) {
+		var gets int
+		var updated []*rbacv1.RoleBinding
+		err := updateFromCache(cached, func() (*rbacv1.RoleBinding, error) {
+			gets++
+			latest := cached.DeepCopy()
+			latest.ResourceVersion = "2"
+			return latest, nil
+		}, setLabel, func(rb *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
+			updated = append(updated, rb)
+			if rb.ResourceVersion != "2" {
+				return nil, apierrors.NewConflict(schema.GroupResource{Resource: "rolebindings"}, rb.Name, fmt.Errorf("stale"))
+			}
+			return rb, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, gets)
+		require.Len(t, updated, 2)
+		assert.Equal(t, "value", updated[1].Labels["key"])
+	})
+}
+
+// BenchmarkCRTBReconcileLabels reports the apiserver reads made to migrate the labels of the RBAC of a CRTB, which
+// are only made on conflicts since the cached objects are updated.
+func BenchmarkCRTBReconcileLabels(b *testing.B) {
+	const bindings = 50
+	crtb := &v3.ClusterRoleTemplateBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-1", UID: "crtb-1-uid"},
+	}
+	var crbs []*rbacv1.ClusterRoleBinding
+	for i := range bindings {
+		crbs = append(crbs, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("crb-%d", i)}})
+	}
+
+	var gets int
+	ctrl := gomock.NewController(b)
+	crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+	crtbCache.EXPECT().Get(crtb.Namespace, crtb.Name).Return(crtb, nil).AnyTimes()
+	crtbClient := fake.NewMockControllerInterface[*v3.ClusterRoleTemplateBinding, *v3.ClusterRoleTemplateBindingList](ctrl)
+	crtbClient.EXPECT().Get(crtb.Namespace, crtb.Name, gomock.Any()).DoAndReturn(
+		func(string, string, metav1.GetOptions) (*v3.ClusterRoleTemplateBinding, error) {
+			gets++
+			return crtb.DeepCopy(), nil
+		}).AnyTimes()
+	crtbClient.EXPECT().Update(gomock.Any()).DoAndReturn(func(obj *v3.ClusterRoleTemplateBinding) (*v3.ClusterRoleTemplateBinding, error) {
+		return obj, nil
+	}).AnyTimes()
+
+	c := &crtbLifecycle{
+		crbLister: &corefakes.ClusterRoleBindingListerMock{
+			ListFunc: func(string, labels.Selector) ([]*rbacv1.ClusterRoleBinding, error) {
+				return crbs, nil
+			},
+		},
+		crbClient: &corefakes.ClusterRoleBindingInterfaceMock{
+			GetFunc: func(name string, _ metav1.GetOptions) (*rbacv1.ClusterRoleBinding, error) {
+				gets++
+				return &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+			},
+			UpdateFunc: func(crb *rbacv1.ClusterRoleBinding) (*rbacv1.ClusterRoleBinding, error) {
+				return crb, nil
+			},
+		},
+		rbLister: &corefakes.RoleBindingListerMock{
+			ListFunc: func(string, labels.Selector) ([]*rbacv1.RoleBinding, error) {
+				return nil, nil
+			},
+		},
+		crtbCache:  crtbCache,
+		crtbClient: crtbClient,
+		s:          status.NewStatus(),
+	}
+
+	b.ResetTimer()
+	for range b.N {
+		var conditions []metav1.Condition
+		if err := c.reconcileLabels(crtb, &conditions); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(gets)/float64(b.N), "apiserver-gets/op")
+}
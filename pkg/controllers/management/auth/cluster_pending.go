@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	crtbClusterController = "mgmt-auth-crtb-cluster-controller"
+	// clusterPendingRequeueDelay is the delay after which the bindings pending their cluster are synced again. The
+	// bindings are synced as soon as their cluster is available too.
+	clusterPendingRequeueDelay = 5 * time.Minute
+
+	pending         = "Pending"
+	clusterNotReady = "ClusterNotReady"
+)
+
+// clusterPendingReason returns why the RBAC of the bindings of the cluster is pending, or an empty string if it isn't:
+// the cluster doesn't exist or isn't ready, e.g. because it is disconnected.
+func clusterPendingReason(cluster *v3.Cluster) string {
+	switch {
+	case cluster == nil:
+		return clusterNotFound
+	case !apiv3.ClusterConditionReady.IsTrue(cluster):
+		return clusterNotReady
+	default:
+		return ""
+	}
+}
+
+// pendingReason returns the reason of the Pending condition of the crtb, or an empty string if it isn't pending.
+func pendingReason(crtb *v3.ClusterRoleTemplateBinding) string {
+	i := slices.IndexFunc(crtb.Status.LocalConditions, isPendingCondition)
+	if i < 0 {
+		return ""
+	}
+	return crtb.Status.LocalConditions[i].Reason
+}
+
+func isPendingCondition(condition metav1.Condition) bool {
+	return condition.Type == pending && condition.Status == metav1.ConditionTrue
+}
+
+// reconcileBindingsIfClusterAvailable reconciles the RBAC of the CRTB unless its cluster doesn't exist. While the
+// cluster isn't ready, the RBAC of the management plane is still reconciled, but the RBAC of the downstream cluster
+// can't be until the cluster is ready again.
+//
+// Instead of failing and being retried, a CRTB pending its cluster is marked with the Pending condition and synced
+// again after a long delay, or once the cluster is available.
+func (c *crtbLifecycle) reconcileBindingsIfClusterAvailable(binding *v3.ClusterRoleTemplateBinding, localConditions *[]metav1.Condition) error {
+	cluster, err := c.clusterLister.Get("", binding.ClusterName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		c.s.AddCondition(localConditions, metav1.Condition{Type: bindingExists}, failedToGetCluster, err)
+		return err
+	}
+	if err != nil {
+		cluster = nil
+	}
+
+	reason := clusterPendingReason(cluster)
+	if reason != "" {
+		message := fmt.Sprintf("cluster %s is not ready", binding.ClusterName)
+		if cluster == nil {
+			message = fmt.Sprintf("cluster %s was not found", binding.ClusterName)
+		}
+		c.s.AddCondition(localConditions, metav1.Condition{Type: pending, Message: message}, reason, nil)
+		logrus.Debugf("[%v] ClusterRoleTemplateBinding %s/%s is pending: %s, syncing it again in %s", ctrbMGMTController, binding.Namespace, binding.Name, message, clusterPendingRequeueDelay)
+		c.enqueueAfter(binding.Namespace, binding.Name, clusterPendingRequeueDelay)
+		if cluster == nil {
+			return nil
+		}
+	}
+
+	return c.reconcileBindingsIfChanged(binding, localConditions)
+}
+
+// enqueuePendingBindings syncs the CRTBs pending the cluster once the reason they are pending changed, rather than
+// after their requeue delay.
+func (c *crtbLifecycle) enqueuePendingBindings(_ string, cluster *v3.Cluster) (runtime.Object, error) {
+	if cluster == nil || cluster.DeletionTimestamp != nil {
+		return cluster, nil
+	}
+
+	crtbs, err := c.crtbCache.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return cluster, err
+	}
+	reason := clusterPendingReason(cluster)
+	for _, crtb := range crtbs {
+		if current := pendingReason(crtb); current != "" && current != reason {
+			c.enqueueAfter(crtb.Namespace, crtb.Name, 0)
+		}
+	}
+	return cluster, nil
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCRTBReconcileBindingsIfClusterAvailable(t *testing.T) {
+	readyCluster := &v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "c-1"}}
+	v3.ClusterConditionReady.True(readyCluster)
+	notReadyCluster := &v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "c-1"}}
+	v3.ClusterConditionReady.False(notReadyCluster)
+
+	tests := []struct {
+		name           string
+		cluster        *v3.Cluster
+		clusterErr     error
+		wantConditions []string
+		wantReason     string
+		wantRequeued   bool
+		wantErr        bool
+	}{
+		{
+			name:           "cluster is ready",
+			cluster:        readyCluster,
+			wantConditions: []string{bindingExists},
+		},
+		{
+			name:           "cluster is not ready",
+			cluster:        notReadyCluster,
+			wantConditions: []string{pending, bindingExists},
+			wantReason:     clusterNotReady,
+			wantRequeued:   true,
+		},
+		{
+			name:           "cluster was not found",
+			clusterErr:     apierrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, "c-1"),
+			wantConditions: []string{pending},
+			wantReason:     clusterNotFound,
+			wantRequeued:   true,
+		},
+		{
+			name:           "cluster can't be read",
+			clusterErr:     fmt.Errorf("error"),
+			wantConditions: []string{bindingExists},
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requeued bool
+			c := &crtbLifecycle{
+				clusterLister: &fakes.ClusterListerMock{
+					GetFunc: func(_, name string) (*v3.Cluster, error) {
+						return tt.cluster, tt.clusterErr
+					},
+				},
+				enqueueAfter: func(namespace, name string, after time.Duration) {
+					assert.Equal(t, "c-1", namespace)
+					assert.Equal(t, "crtb-1", name)
+					assert.Equal(t, clusterPendingRequeueDelay, after)
+					requeued = true
+				},
+				s: &status.Status{TimeNow: time.Now},
+			}
+			// Without a subject, there is no RBAC to reconcile.
+			crtb := &v3.ClusterRoleTemplateBinding{
+				ObjectMeta:  metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-1"},
+				ClusterName: "c-1",
+			}
+
+			var conditions []metav1.Condition
+			err := c.reconcileBindingsIfClusterAvailable(crtb, &conditions)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			var types []string
+			for _, condition := range conditions {
+				types = append(types, condition.Type)
+				if condition.Type == pending {
+					assert.Equal(t, metav1.ConditionTrue, condition.Status)
+					assert.Equal(t, tt.wantReason, condition.Reason)
+				}
+			}
+			assert.Equal(t, tt.wantConditions, types)
+			assert.Equal(t, tt.wantRequeued, requeued)
+		})
+	}
+}
+
+func TestCRTBEnqueuePendingBindings(t *testing.T) {
+	crtbPending := func(name, reason string) *v3.ClusterRoleTemplateBinding {
+		crtb := &v3.ClusterRoleTemplateBinding{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "c-1"}}
+		if reason != "" {
+			crtb.Status.LocalConditions = []metav1.Condition{{Type: pending, Status: metav1.ConditionTrue, Reason: reason}}
+		}
+		return crtb
+	}
+	crtbs := []*v3.ClusterRoleTemplateBinding{
+		crtbPending("crtb-not-found", clusterNotFound),
+		crtbPending("crtb-not-ready", clusterNotReady),
+		crtbPending("crtb-reconciled", ""),
+	}
+	readyCluster := &v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "c-1"}}
+	v3.ClusterConditionReady.True(readyCluster)
+	notReadyCluster := &v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "c-1"}}
+	v3.ClusterConditionReady.False(notReadyCluster)
+
+	tests := []struct {
+		name         string
+		cluster      *v3.Cluster
+		wantEnqueued []string
+	}{
+		{
+			name:         "cluster is ready",
+			cluster:      readyCluster,
+			wantEnqueued: []string{"crtb-not-found", "crtb-not-ready"},
+		},
+		{
+			name:         "cluster is not ready",
+			cluster:      notReadyCluster,
+			wantEnqueued: []string{"crtb-not-found"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+			crtbCache.EXPECT().List("c-1", labels.Everything()).Return(crtbs, nil)
+			var enqueued []string
+			c := &crtbLifecycle{
+				crtbCache: crtbCache,
+				enqueueAfter: func(_, name string, after time.Duration) {
+					assert.Zero(t, after)
+					enqueued = append(enqueued, name)
+				},
+			}
+
+			_, err := c.enqueuePendingBindings("c-1", tt.cluster)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEnqueued, enqueued)
+		})
+	}
+}
@@ -3,6 +3,7 @@
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@
 	pkgrbac "github.com/rancher/rancher/pkg/rbac"
 	"github.com/rancher/rancher/pkg/user"
 	"github.com/sirupsen/logrus"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -32,7 +34,7 @@
 	MembershipBindingOwner       = "membership-binding-owner"
 	clusterResource              = "clusters"
 	membershipBindingOwnerIndex  = "auth.management.cattle.io/membership-binding-owner"
-	CrtbInProjectBindingOwner    = "crtb-in-project-binding-owner"
+	CrtbInProjectBindingOwner    = pkgrbac.CrtbInProjectBindingOwner
 	PrtbInClusterBindingOwner    = "prtb-in-cluster-binding-owner"
 	rbByOwnerIndex               = "auth.management.cattle.io/rb-by-owner"
 	rbByRoleAndSubjectIndex      = "auth.management.cattle.io/crb-by-role-and-subject"
@@ -65,17 +67,6 @@
 	failedToDeleteAuthV2Permissions                                  = "FailedToDeleteAuthV2Permissions"
 )
 
-var clusterManagementPlaneResources = map[string]string{
-	"clusterscans":                "management.cattle.io",
-	"clusterregistrationtokens":   "management.cattle.io",
-	"clusterroletemplatebindings": "management.cattle.io",
-	"etcdbackups":                 "management.cattle.io",
-	"nodes":                       "management.cattle.io",
-	"nodepools":                   "management.cattle.io",
-	"projects":                    "management.cattle.io",
-	"etcdsnapshots":               "rke.cattle.io",
-}
-
 type crtbLifecycle struct {
 	mgr           managerInterface
 	clusterLister v3.ClusterLister
@@ -88,14 +79,17 @@ type crtbLifecycle struct {
 	crbClient     typesrbacv1.ClusterRoleBindingInterface
 	crtbClient    controllersv3.ClusterRoleTemplateBindingController
 	crtbCache     controllersv3.ClusterRoleTemplateBindingCache
+	enqueueAfter  func(namespace, name string, after time.Duration)
 	s             *status.Status
+	staleBindings staleBindings
 }
 
 func (c *crtbLifecycle) Create(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
 	var localConditions []metav1.Condition
 	obj, err := c.reconcileSubject(obj, &localConditions)
 	return obj, errors.Join(err,
-		c.reconcileBindings(obj, &localConditions),
+		c.reconcileImmutableFields(obj, &localConditions),
+		c.reconcileBindingsIfClusterAvailable(obj, &localConditions),
 		c.updateStatus(obj, localConditions))
 }
 
@@ -104,28 +98,38 @@ func (c *crtbLifecycle) Updated(obj *v3.ClusterRoleTemplateBinding) (runtime.Obj
 	obj, err := c.reconcileSubject(obj, &localConditions)
 	return obj, errors.Join(err,
 		c.reconcileLabels(obj, &localConditions),
-		c.reconcileBindings(obj, &localConditions),
+		c.reconcileImmutableFields(obj, &localConditions),
+		c.reconcileBindingsIfClusterAvailable(obj, &localConditions),
 		c.updateStatus(obj, localConditions))
 }
 
 func (c *crtbLifecycle) Remove(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	if err := c.removeBindings(obj, &obj.Status.LocalConditions); err != nil {
+		return nil, errors.Join(err, c.updateStatus(obj, obj.Status.LocalConditions))
+	}
+	return nil, nil
+}
+
+// removeBindings removes the RBAC derived from the CRTB. The RBAC is found by its labels, not by the fields of the
+// CRTB, so that the RBAC derived from previous fields is removed too.
+func (c *crtbLifecycle) removeBindings(obj *v3.ClusterRoleTemplateBinding, localConditions *[]metav1.Condition) error {
 	condition := metav1.Condition{Type: clusterRoleTemplateBindingDelete}
 
 	if err := c.mgr.reconcileClusterMembershipBindingForDelete("", pkgrbac.GetRTBLabel(obj.ObjectMeta)); err != nil {
-		c.s.AddCondition(&obj.Status.LocalConditions, condition, failedToDeleteClusterMembershipBinding, err)
-		return nil, errors.Join(err, c.updateStatus(obj, obj.Status.LocalConditions))
+		c.s.AddCondition(localConditions, condition, failedToDeleteClusterMembershipBinding, err)
+		return err
 	}
 	if err := c.removeMGMTClusterScopedPrivilegesInProjectNamespace(obj); err != nil {
-		c.s.AddCondition(&obj.Status.LocalConditions, condition, failedToDeleteMGMTClusterScopedPrivilegesInProjectNamespace, err)
-		return nil, errors.Join(err, c.updateStatus(obj, obj.Status.LocalConditions))
+		c.s.AddCondition(localConditions, condition, failedToDeleteMGMTClusterScopedPrivilegesInProjectNamespace, err)
+		return err
 	}
 
 	if err := c.mgr.removeAuthV2Permissions(authprovisioningv2.CRTBRoleBindingID, obj); err != nil {
-		c.s.AddCondition(&obj.Status.LocalConditions, condition, failedToDeleteAuthV2Permissions, err)
-		return nil, errors.Join(err, c.updateStatus(obj, obj.Status.LocalConditions))
+		c.s.AddCondition(localConditions, condition, failedToDeleteAuthV2Permissions, err)
+		return err
 	}
 
-	return nil, nil
+	return nil
 }
 
 func (c *crtbLifecycle) reconcileSubject(binding *v3.ClusterRoleTemplateBinding, localConditions *[]metav1.Condition) (*v3.ClusterRoleTemplateBinding, error) {
@@ -221,7 +225,7 @@ func (c *crtbLifecycle) reconcileBindings(binding *v3.ClusterRoleTemplateBinding
 		return err
 	}
 
-	err = c.mgr.grantManagementPlanePrivileges(binding.RoleTemplateName, clusterManagementPlaneResources, subject, binding)
+	err = c.mgr.grantManagementPlanePrivileges(binding.RoleTemplateName, pkgrbac.ClusterManagementPlaneResources(), subject, binding)
 	if err != nil {
 		c.s.AddCondition(localConditions, condition, failedToGrantManagementPlanePrivileges, err)
 		return err
@@ -232,6 +236,7 @@ func (c *crtbLifecycle) reconcileBindings(binding *v3.ClusterRoleTemplateBinding
 		c.s.AddCondition(localConditions, condition, failedToListProjects, err)
 		return err
 	}
+	projectManagementPlaneResources := pkgrbac.ProjectManagementPlaneResources()
 	for _, p := range projects {
 		backingNamespace := p.GetProjectBackingNamespace()
 		if p.DeletionTimestamp != nil {
@@ -254,6 +259,7 @@ func (c *crtbLifecycle) removeMGMTClusterScopedPrivilegesInProjectNamespace(bind
 		return err
 	}
 	bindingKey := pkgrbac.GetRTBLabel(binding.ObjectMeta)
+	projectManagementPlaneResources := pkgrbac.ProjectManagementPlaneResources()
 	for _, p := range projects {
 		backingNamespace := p.GetProjectBackingNamespace()
 		set := labels.Set(map[string]string{bindingKey: CrtbInProjectBindingOwner})
@@ -299,19 +305,15 @@ func (c *crtbLifecycle) reconcileLabels(binding *v3.ClusterRoleTemplateBinding,
 	}
 	bindingKey := pkgrbac.GetRTBLabel(binding.ObjectMeta)
 	for _, crb := range crbs {
-		retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-			crbToUpdate, updateErr := c.crbClient.Get(crb.Name, metav1.GetOptions{})
-			if updateErr != nil {
-				return updateErr
-			}
+		retryErr := updateFromCache(crb, func() (*rbacv1.ClusterRoleBinding, error) {
+			return c.crbClient.Get(crb.Name, metav1.GetOptions{})
+		}, func(crbToUpdate *rbacv1.ClusterRoleBinding) {
 			if crbToUpdate.Labels == nil {
 				crbToUpdate.Labels = make(map[string]string)
 			}
 			crbToUpdate.Labels[bindingKey] = MembershipBindingOwner
 			crbToUpdate.Labels[rtbLabelUpdated] = "true"
-			_, err := c.crbClient.Update(crbToUpdate)
-			return err
-		})
+		}, c.crbClient.Update)
 		if retryErr != nil {
 			c.s.AddCondition(localConditions, condition, failedToUpdateClusterRoleBindings, retryErr)
 		}
@@ -326,19 +328,15 @@ func (c *crtbLifecycle) reconcileLabels(binding *v3.ClusterRoleTemplateBinding,
 	}
 
 	for _, rb := range rbs {
-		retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-			rbToUpdate, updateErr := c.rbClient.GetNamespaced(rb.Namespace, rb.Name, metav1.GetOptions{})
-			if updateErr != nil {
-				return updateErr
-			}
+		retryErr := updateFromCache(rb, func() (*rbacv1.RoleBinding, error) {
+			return c.rbClient.GetNamespaced(rb.Namespace, rb.Name, metav1.GetOptions{})
+		}, func(rbToUpdate *rbacv1.RoleBinding) {
 			if rbToUpdate.Labels == nil {
 				rbToUpdate.Labels = make(map[string]string)
 			}
 			rbToUpdate.Labels[bindingKey] = CrtbInProjectBindingOwner
 			rbToUpdate.Labels[rtbLabelUpdated] = "true"
-			_, err := c.rbClient.Update(rbToUpdate)
-			return err
-		})
+		}, c.rbClient.Update)
 		if retryErr != nil {
 			c.s.AddCondition(localConditions, condition, failedToUpdateClusterRoleBindings, retryErr)
 		}
@@ -348,24 +346,37 @@ func (c *crtbLifecycle) reconcileLabels(binding *v3.ClusterRoleTemplateBinding,
 		return returnErr
 	}
 
-	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		crtbToUpdate, updateErr := c.crtbClient.Get(binding.Namespace, binding.Name, metav1.GetOptions{})
-		if updateErr != nil {
-			return updateErr
-		}
+	crtb, err := c.crtbCache.Get(binding.Namespace, binding.Name)
+	if err != nil {
+		c.s.AddCondition(localConditions, condition, failedToUpdateClusterRoleTemplateBindings, err)
+		return err
+	}
+	retryErr := updateFromCache(crtb, func() (*v3.ClusterRoleTemplateBinding, error) {
+		return c.crtbClient.Get(binding.Namespace, binding.Name, metav1.GetOptions{})
+	}, func(crtbToUpdate *v3.ClusterRoleTemplateBinding) {
 		if crtbToUpdate.Labels == nil {
 			crtbToUpdate.Labels = make(map[string]string)
 		}
 		crtbToUpdate.Labels[RtbCrbRbLabelsUpdated] = "true"
-		_, err := c.crtbClient.Update(crtbToUpdate)
-		return err
-	})
+	}, c.crtbClient.Update)
 	if retryErr != nil {
 		c.s.AddCondition(localConditions, condition, failedToUpdateClusterRoleTemplateBindings, retryErr)
 	}
 	return retryErr
 }
 
+// markFailed adds a condition to the status of the binding recording that its reconciliation was given up on. The
+// condition is cleared by the next successful reconciliation.
+func (c *crtbLifecycle) markFailed(obj *v3.ClusterRoleTemplateBinding, err error) error {
+	crtb, getErr := c.crtbCache.Get(obj.Namespace, obj.Name)
+	if getErr != nil {
+		return getErr
+	}
+	localConditions := slices.Clone(crtb.Status.LocalConditions)
+	c.s.AddCondition(&localConditions, metav1.Condition{Type: reconciled}, maxAttemptsReached, err)
+	return c.updateStatus(crtb, localConditions)
+}
+
 var timeNow = func() time.Time {
 	return time.Now()
 }
@@ -391,6 +402,10 @@ func (c *crtbLifecycle) updateStatus(crtb *v3.ClusterRoleTemplateBinding, localC
 				break
 			}
 		}
+		if crtbFromCluster.Status.SummaryLocal != status.SummaryError && slices.ContainsFunc(localConditions, isPendingCondition) {
+			crtbFromCluster.Status.Summary = status.SummaryPending
+			crtbFromCluster.Status.SummaryLocal = status.SummaryPending
+		}
 
 		crtbFromCluster.Status.LastUpdateTime = timeNow().Format(time.RFC3339)
 		crtbFromCluster.Status.ObservedGenerationLocal = crtb.ObjectMeta.Generation
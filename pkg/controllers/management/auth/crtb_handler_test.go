@@ -644,6 +644,42 @@ func TestUpdateStatus(t *testing.T) {
 			},
 			localConditions: crtbSubjectError.Status.LocalConditions,
 		},
+		"set summary to pending when the cluster is pending": {
+			crtb: crtbEmptyStatusRemoteComplete.DeepCopy(),
+			crtbClient: func(crtb *v3.ClusterRoleTemplateBinding) controllersv3.ClusterRoleTemplateBindingController {
+				mock := fake.NewMockControllerInterface[*v3.ClusterRoleTemplateBinding, *v3.ClusterRoleTemplateBindingList](ctrl)
+				mock.EXPECT().UpdateStatus(&v3.ClusterRoleTemplateBinding{
+					Status: v3.ClusterRoleTemplateBindingStatus{
+						LocalConditions: []v1.Condition{
+							{
+								Type:   pending,
+								Status: v1.ConditionTrue,
+								Reason: clusterNotFound,
+								LastTransitionTime: v1.Time{
+									Time: mockTime,
+								},
+							},
+						},
+						LastUpdateTime: mockTime.Format(time.RFC3339),
+						SummaryLocal:   status.SummaryPending,
+						SummaryRemote:  status.SummaryCompleted,
+						Summary:        status.SummaryPending,
+					},
+				})
+
+				return mock
+			},
+			localConditions: []v1.Condition{
+				{
+					Type:   pending,
+					Status: v1.ConditionTrue,
+					Reason: clusterNotFound,
+					LastTransitionTime: v1.Time{
+						Time: mockTime,
+					},
+				},
+			},
+		},
 		"status updated when a condition is removed": {
 			crtb: crtbSubjectAndBindingExist.DeepCopy(),
 			crtbClient: func(crtb *v3.ClusterRoleTemplateBinding) controllersv3.ClusterRoleTemplateBindingController {
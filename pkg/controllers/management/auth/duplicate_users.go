@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	wranglerv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	duplicateUserController = "mgmt-auth-duplicate-user-controller"
+	userByDuplicateKeyIndex = "auth.management.cattle.io/user-by-duplicate-key"
+)
+
+func userByDuplicateKeyFunc(obj interface{}) ([]string, error) {
+	user, ok := obj.(*apiv3.User)
+	if !ok {
+		return []string{}, nil
+	}
+
+	return principalmigration.DuplicateKeys(user), nil
+}
+
+// duplicateUserHandler reports the users sharing a principal, or an email address, with other users, by setting the
+// principalmigration.DuplicatesAnnotation annotation on them, for an admin to merge them with a UserMergeRequest.
+type duplicateUserHandler struct {
+	userIndexer cache.Indexer
+	users       wranglerv3.UserController
+}
+
+func newDuplicateUserHandler(management *config.ManagementContext) *duplicateUserHandler {
+	return &duplicateUserHandler{
+		userIndexer: management.Management.Users("").Controller().Informer().GetIndexer(),
+		users:       management.Wrangler.Mgmt.User(),
+	}
+}
+
+func (h *duplicateUserHandler) sync(_ string, user *apiv3.User) (runtime.Object, error) {
+	if user == nil {
+		return user, nil
+	}
+	reported := splitDuplicates(user.Annotations[principalmigration.DuplicatesAnnotation])
+	if user.DeletionTimestamp != nil {
+		// The users reported as duplicates of a deleted user may no longer be duplicates.
+		for _, name := range reported {
+			h.users.Enqueue(name)
+		}
+		return user, nil
+	}
+
+	var duplicates []string
+	for _, key := range principalmigration.DuplicateKeys(user) {
+		objs, err := h.userIndexer.ByIndex(userByDuplicateKeyIndex, key)
+		if err != nil {
+			return nil, fmt.Errorf("error getting users by duplicate key %s: %w", key, err)
+		}
+		for _, obj := range objs {
+			other, ok := obj.(*apiv3.User)
+			if !ok || other.Name == user.Name || other.DeletionTimestamp != nil || slices.Contains(duplicates, other.Name) {
+				continue
+			}
+			duplicates = append(duplicates, other.Name)
+		}
+	}
+	slices.Sort(duplicates)
+	if slices.Equal(duplicates, reported) {
+		return user, nil
+	}
+
+	// The other users are reported as duplicates of this user too.
+	for _, name := range append(reported, duplicates...) {
+		h.users.Enqueue(name)
+	}
+
+	user = user.DeepCopy()
+	if len(duplicates) == 0 {
+		delete(user.Annotations, principalmigration.DuplicatesAnnotation)
+	} else {
+		logrus.Warnf("[%s] User %s is a duplicate of users %v", duplicateUserController, user.Name, duplicates)
+		if user.Annotations == nil {
+			user.Annotations = map[string]string{}
+		}
+		user.Annotations[principalmigration.DuplicatesAnnotation] = strings.Join(duplicates, ",")
+	}
+	return h.users.Update(user)
+}
+
+func splitDuplicates(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
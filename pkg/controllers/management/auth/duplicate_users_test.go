@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"testing"
+
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	wranglerfake "github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestDuplicateUserHandlerSync(t *testing.T) {
+	local := &apiv3.User{
+		ObjectMeta:   metav1.ObjectMeta{Name: "u-local"},
+		Username:     "bob@example.com",
+		PrincipalIDs: []string{"local://u-local"},
+	}
+	external := &apiv3.User{
+		ObjectMeta:   metav1.ObjectMeta{Name: "u-ext"},
+		PrincipalIDs: []string{"okta_user://bob@example.com"},
+	}
+	other := &apiv3.User{
+		ObjectMeta:   metav1.ObjectMeta{Name: "u-other"},
+		Username:     "alice",
+		PrincipalIDs: []string{"local://u-other"},
+	}
+	userIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{userByDuplicateKeyIndex: userByDuplicateKeyFunc})
+	for _, user := range []*apiv3.User{local, external, other} {
+		require.NoError(t, userIndexer.Add(user))
+	}
+
+	t.Run("duplicates are reported", func(t *testing.T) {
+		users := wranglerfake.NewMockNonNamespacedControllerInterface[*apiv3.User, *apiv3.UserList](gomock.NewController(t))
+		users.EXPECT().Enqueue("u-ext")
+		users.EXPECT().Update(gomock.Any()).DoAndReturn(func(user *apiv3.User) (*apiv3.User, error) {
+			assert.Equal(t, "u-ext", user.Annotations[principalmigration.DuplicatesAnnotation])
+			return user, nil
+		})
+
+		h := &duplicateUserHandler{userIndexer: userIndexer, users: users}
+		_, err := h.sync("", local)
+		require.NoError(t, err)
+	})
+
+	t.Run("reported duplicates are unchanged", func(t *testing.T) {
+		users := wranglerfake.NewMockNonNamespacedControllerInterface[*apiv3.User, *apiv3.UserList](gomock.NewController(t))
+
+		user := local.DeepCopy()
+		user.Annotations = map[string]string{principalmigration.DuplicatesAnnotation: "u-ext"}
+		h := &duplicateUserHandler{userIndexer: userIndexer, users: users}
+		_, err := h.sync("", user)
+		require.NoError(t, err)
+	})
+
+	t.Run("stale report is removed", func(t *testing.T) {
+		users := wranglerfake.NewMockNonNamespacedControllerInterface[*apiv3.User, *apiv3.UserList](gomock.NewController(t))
+		users.EXPECT().Enqueue("u-deleted")
+		users.EXPECT().Update(gomock.Any()).DoAndReturn(func(user *apiv3.User) (*apiv3.User, error) {
+			assert.NotContains(t, user.Annotations, principalmigration.DuplicatesAnnotation)
+			return user, nil
+		})
+
+		user := other.DeepCopy()
+		user.Annotations = map[string]string{principalmigration.DuplicatesAnnotation: "u-deleted"}
+		h := &duplicateUserHandler{userIndexer: userIndexer, users: users}
+		_, err := h.sync("", user)
+		require.NoError(t, err)
+	})
+
+	t.Run("duplicates of a deleted user are enqueued", func(t *testing.T) {
+		users := wranglerfake.NewMockNonNamespacedControllerInterface[*apiv3.User, *apiv3.UserList](gomock.NewController(t))
+		users.EXPECT().Enqueue("u-ext")
+
+		user := local.DeepCopy()
+		user.DeletionTimestamp = &metav1.Time{}
+		user.Annotations = map[string]string{principalmigration.DuplicatesAnnotation: "u-ext"}
+		h := &duplicateUserHandler{userIndexer: userIndexer, users: users}
+		_, err := h.sync("", user)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,142 @@
+// Package globalrolegroupmappings keeps the GlobalRoleBindings of users in sync with the GlobalRoleGroupMappings of the
+// groups they are members of. Group memberships are read from the UserAttributes, which are updated when users log in
+// and when their group principals are refreshed from the auth provider.
+package globalrolegroupmappings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/rancher/wrangler/v3/pkg/name"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	controllerName      = "mgmt-auth-gr-group-mapping-controller"
+	mappingEnqueuer     = "mgmt-auth-gr-group-mapping-enqueuer"
+	grbEnqueuer         = "mgmt-auth-gr-group-mapping-grb-enqueuer"
+	mappingByGroupIndex = "mgmt-auth-gr-group-mapping-by-group"
+)
+
+type handler struct {
+	mappingCache       mgmtv3.GlobalRoleGroupMappingCache
+	userAttributeCache mgmtv3.UserAttributeCache
+	grbCache           mgmtv3.GlobalRoleBindingCache
+	grbs               mgmtv3.GlobalRoleBindingClient
+}
+
+// Register registers the controller creating and deleting the GlobalRoleBindings of users from GlobalRoleGroupMappings.
+func Register(ctx context.Context, wContext *wrangler.Context) {
+	mappings := wContext.Mgmt.GlobalRoleGroupMapping()
+	mappings.Cache().AddIndexer(mappingByGroupIndex, mappingByGroup)
+
+	h := &handler{
+		mappingCache:       mappings.Cache(),
+		userAttributeCache: wContext.Mgmt.UserAttribute().Cache(),
+		grbCache:           wContext.Mgmt.GlobalRoleBinding().Cache(),
+		grbs:               wContext.Mgmt.GlobalRoleBinding(),
+	}
+	relatedresource.WatchClusterScoped(ctx, mappingEnqueuer, h.enqueueUserAttributes, wContext.Mgmt.UserAttribute(), mappings)
+	relatedresource.WatchClusterScoped(ctx, grbEnqueuer, enqueueBoundUserAttribute, wContext.Mgmt.UserAttribute(), wContext.Mgmt.GlobalRoleBinding())
+	wContext.Mgmt.UserAttribute().OnChange(ctx, controllerName, h.onUserAttributeChange)
+}
+
+// mappingByGroup indexes GlobalRoleGroupMappings by the group principal they bind.
+func mappingByGroup(mapping *v3.GlobalRoleGroupMapping) ([]string, error) {
+	return []string{mapping.Spec.GroupPrincipalName}, nil
+}
+
+// enqueueUserAttributes enqueues all the UserAttributes when a mapping changes, as the group it bound before the change
+// or its deletion is unknown.
+func (h *handler) enqueueUserAttributes(_, _ string, _ runtime.Object) ([]relatedresource.Key, error) {
+	attribs, err := h.userAttributeCache.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("listing user attributes: %w", err)
+	}
+	keys := make([]relatedresource.Key, 0, len(attribs))
+	for _, attrib := range attribs {
+		keys = append(keys, relatedresource.Key{Name: attrib.Name})
+	}
+	return keys, nil
+}
+
+// enqueueBoundUserAttribute enqueues the UserAttribute of the user bound by a GlobalRoleBinding created from a mapping,
+// so that the binding is recreated if it's deleted.
+func enqueueBoundUserAttribute(_, _ string, obj runtime.Object) ([]relatedresource.Key, error) {
+	grb, ok := obj.(*v3.GlobalRoleBinding)
+	if !ok || grb.Labels[v3.GlobalRoleGroupMappingUserLabel] == "" {
+		return nil, nil
+	}
+	return []relatedresource.Key{{Name: grb.Labels[v3.GlobalRoleGroupMappingUserLabel]}}, nil
+}
+
+func (h *handler) onUserAttributeChange(_ string, attribs *v3.UserAttribute) (*v3.UserAttribute, error) {
+	// The GlobalRoleBindings of deleted users are removed by the user lifecycle.
+	if attribs == nil || attribs.DeletionTimestamp != nil {
+		return attribs, nil
+	}
+
+	desired, err := h.desiredGlobalRoles(attribs)
+	if err != nil {
+		return attribs, err
+	}
+
+	existing, err := h.grbCache.List(labels.SelectorFromSet(labels.Set{v3.GlobalRoleGroupMappingUserLabel: attribs.Name}))
+	if err != nil {
+		return attribs, fmt.Errorf("listing global role bindings of user %s: %w", attribs.Name, err)
+	}
+
+	var errs []error
+	for _, grb := range existing {
+		if slices.Contains(desired, grb.GlobalRoleName) {
+			desired = slices.DeleteFunc(desired, func(globalRoleName string) bool { return globalRoleName == grb.GlobalRoleName })
+			continue
+		}
+		if err := h.grbs.Delete(grb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting global role binding %s: %w", grb.Name, err))
+		}
+	}
+
+	for _, globalRoleName := range desired {
+		_, err := h.grbs.Create(&v3.GlobalRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name.SafeConcatName("grb", attribs.Name, globalRoleName),
+				Labels: map[string]string{v3.GlobalRoleGroupMappingUserLabel: attribs.Name},
+			},
+			UserName:       attribs.Name,
+			GlobalRoleName: globalRoleName,
+		})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			errs = append(errs, fmt.Errorf("creating global role binding of global role %s for user %s: %w", globalRoleName, attribs.Name, err))
+		}
+	}
+
+	return attribs, errors.Join(errs...)
+}
+
+// desiredGlobalRoles returns the sorted names of the global roles mapped to the groups of the user.
+func (h *handler) desiredGlobalRoles(attribs *v3.UserAttribute) ([]string, error) {
+	var globalRoleNames []string
+	for _, principals := range attribs.GroupPrincipals {
+		for _, principal := range principals.Items {
+			mappings, err := h.mappingCache.GetByIndex(mappingByGroupIndex, principal.Name)
+			if err != nil {
+				return nil, fmt.Errorf("getting global role group mappings of group %s: %w", principal.Name, err)
+			}
+			for _, mapping := range mappings {
+				globalRoleNames = append(globalRoleNames, mapping.Spec.GlobalRoleNames...)
+			}
+		}
+	}
+	slices.Sort(globalRoleNames)
+	return slices.Compact(globalRoleNames), nil
+}
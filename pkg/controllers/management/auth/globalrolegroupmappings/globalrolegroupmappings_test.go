@@ -0,0 +1,181 @@
+package globalrolegroupmappings
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	admins     = "activedirectory_group://CN=admins,DC=example,DC=com"
+	developers = "activedirectory_group://CN=developers,DC=example,DC=com"
+)
+
+func newMapping(name, group string, globalRoleNames ...string) *v3.GlobalRoleGroupMapping {
+	return &v3.GlobalRoleGroupMapping{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v3.GlobalRoleGroupMappingSpec{
+			GroupPrincipalName: group,
+			GlobalRoleNames:    globalRoleNames,
+		},
+	}
+}
+
+func newManagedGRB(name, userName, globalRoleName string) *v3.GlobalRoleBinding {
+	return &v3.GlobalRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{v3.GlobalRoleGroupMappingUserLabel: userName},
+		},
+		UserName:       userName,
+		GlobalRoleName: globalRoleName,
+	}
+}
+
+func TestOnUserAttributeChange(t *testing.T) {
+	mappings := []*v3.GlobalRoleGroupMapping{
+		newMapping("admins", admins, "admin"),
+		newMapping("developers", developers, "user", "clusters-create"),
+		newMapping("developers-catalog", developers, "catalogs-use", "user"),
+	}
+
+	tests := []struct {
+		name        string
+		groups      []string
+		existing    []*v3.GlobalRoleBinding
+		wantCreated []string
+		wantDeleted []string
+	}{
+		{
+			name:        "creates bindings for the mapped groups",
+			groups:      []string{developers},
+			wantCreated: []string{"catalogs-use", "clusters-create", "user"},
+		},
+		{
+			name:   "keeps existing bindings",
+			groups: []string{admins},
+			existing: []*v3.GlobalRoleBinding{
+				newManagedGRB("grb-u-1-admin", "u-1", "admin"),
+			},
+		},
+		{
+			name:   "deletes bindings of groups the user left",
+			groups: []string{admins},
+			existing: []*v3.GlobalRoleBinding{
+				newManagedGRB("grb-u-1-admin", "u-1", "admin"),
+				newManagedGRB("grb-u-1-user", "u-1", "user"),
+				newManagedGRB("grb-u-1-gone", "u-1", "catalogs-use"),
+			},
+			wantDeleted: []string{"grb-u-1-user", "grb-u-1-gone"},
+		},
+		{
+			name:   "deletes duplicate bindings",
+			groups: []string{admins},
+			existing: []*v3.GlobalRoleBinding{
+				newManagedGRB("grb-u-1-admin", "u-1", "admin"),
+				newManagedGRB("grb-u-1-admin-2", "u-1", "admin"),
+			},
+			wantDeleted: []string{"grb-u-1-admin-2"},
+		},
+		{
+			name:   "user without groups",
+			groups: nil,
+			existing: []*v3.GlobalRoleBinding{
+				newManagedGRB("grb-u-1-admin", "u-1", "admin"),
+			},
+			wantDeleted: []string{"grb-u-1-admin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mappingCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleGroupMapping](ctrl)
+			mappingCache.EXPECT().GetByIndex(mappingByGroupIndex, gomock.Any()).DoAndReturn(func(_, group string) ([]*v3.GlobalRoleGroupMapping, error) {
+				var result []*v3.GlobalRoleGroupMapping
+				for _, mapping := range mappings {
+					if mapping.Spec.GroupPrincipalName == group {
+						result = append(result, mapping)
+					}
+				}
+				return result, nil
+			}).AnyTimes()
+
+			grbCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleBinding](ctrl)
+			grbCache.EXPECT().List(labels.SelectorFromSet(labels.Set{v3.GlobalRoleGroupMappingUserLabel: "u-1"})).Return(tt.existing, nil)
+
+			grbs := fake.NewMockNonNamespacedControllerInterface[*v3.GlobalRoleBinding, *v3.GlobalRoleBindingList](ctrl)
+			var created, deleted []string
+			grbs.EXPECT().Create(gomock.Any()).DoAndReturn(func(grb *v3.GlobalRoleBinding) (*v3.GlobalRoleBinding, error) {
+				assert.Equal(t, "u-1", grb.UserName)
+				assert.Equal(t, "u-1", grb.Labels[v3.GlobalRoleGroupMappingUserLabel])
+				created = append(created, grb.GlobalRoleName)
+				return grb, nil
+			}).AnyTimes()
+			grbs.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(name string, _ *metav1.DeleteOptions) error {
+				deleted = append(deleted, name)
+				if name == "grb-u-1-gone" {
+					return apierrors.NewNotFound(schema.GroupResource{}, name)
+				}
+				return nil
+			}).AnyTimes()
+
+			h := &handler{
+				mappingCache: mappingCache,
+				grbCache:     grbCache,
+				grbs:         grbs,
+			}
+
+			attribs := &v3.UserAttribute{
+				ObjectMeta:      metav1.ObjectMeta{Name: "u-1"},
+				GroupPrincipals: map[string]v3.Principals{},
+			}
+			var principals []v3.Principal
+			for _, group := range tt.groups {
+				principals = append(principals, v3.Principal{ObjectMeta: metav1.ObjectMeta{Name: group}})
+			}
+			attribs.GroupPrincipals["activedirectory"] = v3.Principals{Items: principals}
+
+			_, err := h.onUserAttributeChange("", attribs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCreated, created)
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
+
+func TestOnUserAttributeChangeDeleted(t *testing.T) {
+	h := &handler{}
+
+	_, err := h.onUserAttributeChange("u-1", nil)
+	assert.NoError(t, err)
+
+	_, err = h.onUserAttributeChange("u-1", &v3.UserAttribute{
+		ObjectMeta: metav1.ObjectMeta{Name: "u-1", DeletionTimestamp: &metav1.Time{}},
+	})
+	assert.NoError(t, err)
+}
+
+func TestEnqueueBoundUserAttribute(t *testing.T) {
+	keys, err := enqueueBoundUserAttribute("", "", newManagedGRB("grb-u-1-admin", "u-1", "admin"))
+	require.NoError(t, err)
+	assert.Equal(t, []relatedresource.Key{{Name: "u-1"}}, keys)
+
+	keys, err = enqueueBoundUserAttribute("", "", &v3.GlobalRoleBinding{UserName: "u-1", GlobalRoleName: "admin"})
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	keys, err = enqueueBoundUserAttribute("", "", nil)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
@@ -24,6 +24,7 @@
 	grbEnqueuer                = "mgmt-auth-gr-enqueue"
 	clusterGrEnqueuer          = "mgmt-auth-cluster-gr"
 	crtbGRBEnqueuer            = "mgmt-auth-crtb-grb"
+	crtbGREnqueuer             = "mgmt-auth-crtb-gr"
 	roleEnqueuer               = "mgmt-auth-role-gr"
 	roleBindingEnqueuer        = "mgmt-auth-rb-grb"
 	namespaceGrEnqueuer        = "mgmt-auth-ns-gr"
@@ -160,6 +161,35 @@ func (g *globalRBACEnqueuer) crtbEnqueueGRB(_, _ string, obj runtime.Object) ([]
 	}, nil
 }
 
+// crtbEnqueueGR enqueues the GlobalRole of the GlobalRoleBinding which owns a given CRTB when that CRTB is changed, so
+// that the GlobalRole reports whether its InheritedClusterRoles are synced. Uses the label which is protected by the
+// webhook rather than the ownerReference
+func (g *globalRBACEnqueuer) crtbEnqueueGR(_, _ string, obj runtime.Object) ([]relatedresource.Key, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	crtb, ok := obj.(*v3.ClusterRoleTemplateBinding)
+	if !ok {
+		logrus.Errorf("unable to convert object: %[1]v, type: %[1]T to a crtb", obj)
+		return nil, nil
+	}
+	grbOwner, ok := crtb.Labels[grbOwnerLabel]
+	if !ok {
+		// this crtb isn't owned by a GRB, no need to enqueue a GR
+		return nil, nil
+	}
+	grb, err := g.grbCache.Get(grbOwner)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to confirm if grb %s exists for crtb %s", grbOwner, crtb.Name)
+	}
+	return []relatedresource.Key{
+		{Name: grb.GlobalRoleName},
+	}, nil
+}
+
 // roleEnqueueGR enqueues GlobalRoles that own a given Role when that Role is changed. Uses grOwnerLabel
 // which is protected by the webhook rather than the ownerReference.
 func (g *globalRBACEnqueuer) roleEnqueueGR(_, _ string, obj runtime.Object) ([]relatedresource.Key, error) {
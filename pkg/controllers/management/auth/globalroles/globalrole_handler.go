@@ -69,6 +69,9 @@ func newGlobalRoleLifecycle(management *config.ManagementContext) *globalRoleLif
 		rLister:                 management.RBAC.Roles("").Controller().Lister(),
 		rClient:                 management.RBAC.Roles(""),
 		grClient:                management.Wrangler.Mgmt.GlobalRole(),
+		grbCache:                management.Wrangler.Mgmt.GlobalRoleBinding().Cache(),
+		crtbCache:               management.Wrangler.Mgmt.ClusterRoleTemplateBinding().Cache(),
+		clusterCache:            management.Wrangler.Mgmt.Cluster().Cache(),
 		fleetPermissionsHandler: newFleetWorkspaceRoleHandler(management),
 	}
 }
@@ -80,6 +83,9 @@ type globalRoleLifecycle struct {
 	rLister                 rbacv1.RoleLister
 	rClient                 rbacv1.RoleInterface
 	grClient                mgmtconv3.GlobalRoleClient
+	grbCache                mgmtconv3.GlobalRoleBindingCache
+	crtbCache               mgmtconv3.ClusterRoleTemplateBindingCache
+	clusterCache            mgmtconv3.ClusterCache
 	fleetPermissionsHandler fleetPermissionsRoleHandler
 }
 
@@ -87,7 +93,8 @@ func (gr *globalRoleLifecycle) Create(obj *v3.GlobalRole) (runtime.Object, error
 	// ObjectMeta.Generation does not get updated when the Status is updated.
 	// If only the status has been updated and we have finished updating the status (status.Summary != "InProgress")
 	// we don't need to perform a reconcile as nothing has changed.
-	if obj.Status.ObservedGeneration == obj.ObjectMeta.Generation && obj.Status.Summary != SummaryInProgress {
+	if obj.Status.ObservedGeneration == obj.ObjectMeta.Generation && obj.Status.Summary != SummaryInProgress &&
+		!gr.inheritedClusterRolesStatusChanged(obj) {
 		return obj, nil
 	}
 	returnError := errors.Join(
@@ -95,6 +102,7 @@ func (gr *globalRoleLifecycle) Create(obj *v3.GlobalRole) (runtime.Object, error
 		gr.reconcileGlobalRole(obj),
 		gr.reconcileNamespacedRoles(obj),
 		gr.fleetPermissionsHandler.reconcileFleetWorkspacePermissions(obj),
+		gr.reconcileInheritedClusterRolesStatus(obj),
 		gr.setGRAsCompleted(obj),
 	)
 	return obj, returnError
@@ -104,7 +112,8 @@ func (gr *globalRoleLifecycle) Updated(obj *v3.GlobalRole) (runtime.Object, erro
 	// ObjectMeta.Generation does not get updated when the Status is updated.
 	// If only the status has been updated and we have finished updating the status (status.Summary != "InProgress")
 	// we don't need to perform a reconcile as nothing has changed.
-	if obj.Status.ObservedGeneration == obj.ObjectMeta.Generation && obj.Status.Summary != SummaryInProgress {
+	if obj.Status.ObservedGeneration == obj.ObjectMeta.Generation && obj.Status.Summary != SummaryInProgress &&
+		!gr.inheritedClusterRolesStatusChanged(obj) {
 		return obj, nil
 	}
 
@@ -113,6 +122,7 @@ func (gr *globalRoleLifecycle) Updated(obj *v3.GlobalRole) (runtime.Object, erro
 		gr.reconcileGlobalRole(obj),
 		gr.reconcileNamespacedRoles(obj),
 		gr.fleetPermissionsHandler.reconcileFleetWorkspacePermissions(obj),
+		gr.reconcileInheritedClusterRolesStatus(obj),
 		gr.setGRAsCompleted(obj),
 	)
 	return nil, returnError
@@ -0,0 +1,142 @@
+package globalroles
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rancher/rancher/pkg/controllers/status"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// InheritedClusterRolesSynced is the type of the conditions reporting, for each downstream cluster, whether the
+// ClusterRoleTemplateBindings backing the InheritedClusterRoles of a GlobalRole have been synced.
+const InheritedClusterRolesSynced = "InheritedClusterRolesSynced"
+
+// Condition reason types
+const (
+	InheritedClusterRolesNotSynced = "InheritedClusterRolesNotSynced"
+	FailedToListClusters           = "ListClustersFailed"
+)
+
+// inheritedClusterRolesConditions returns one InheritedClusterRolesSynced condition per downstream cluster. The
+// condition is true if, for every GlobalRoleBinding of the GlobalRole, there is a ClusterRoleTemplateBinding for each
+// inherited RoleTemplate in the cluster and its RBAC has been successfully applied.
+func (gr *globalRoleLifecycle) inheritedClusterRolesConditions(globalRole *v3.GlobalRole) ([]metav1.Condition, error) {
+	if len(globalRole.InheritedClusterRoles) == 0 {
+		return nil, nil
+	}
+
+	bindings, err := gr.grbCache.GetByIndex(grbGrIndex, globalRole.Name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get grbs for gr %s from indexer: %w", globalRole.Name, err)
+	}
+	clusters, err := gr.clusterCache.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list clusters: %w", err)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].Name < clusters[j].Name })
+
+	var conditions []metav1.Condition
+	for _, cluster := range clusters {
+		// inheritedClusterRoles only apply on non-local clusters
+		if cluster.Name == localClusterName {
+			continue
+		}
+
+		var pending []string
+		for _, binding := range bindings {
+			if binding.DeletionTimestamp != nil {
+				continue
+			}
+			crtbs, err := gr.crtbCache.GetByIndex(crtbGrbOwnerIndex, fmt.Sprintf("%s/%s", cluster.Name, binding.Name))
+			if err != nil {
+				return nil, fmt.Errorf("unable to get CRTBs for cluster %s: %w", cluster.Name, err)
+			}
+			for _, rtName := range globalRole.InheritedClusterRoles {
+				pending = append(pending, crtbPendingReason(binding.Name, rtName, crtbs)...)
+			}
+		}
+
+		condition := metav1.Condition{
+			Type:               InheritedClusterRolesSynced,
+			LastTransitionTime: metav1.Time{Time: time.Now()},
+		}
+		if len(pending) == 0 {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = InheritedClusterRolesSynced
+			condition.Message = fmt.Sprintf("cluster %s synced", cluster.Name)
+		} else {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = InheritedClusterRolesNotSynced
+			condition.Message = fmt.Sprintf("cluster %s not synced: %s", cluster.Name, strings.Join(pending, ", "))
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+// crtbPendingReason returns why the RBAC of the given RoleTemplate for the given GlobalRoleBinding isn't synced yet,
+// if it isn't.
+func crtbPendingReason(bindingName, rtName string, crtbs []*v3.ClusterRoleTemplateBinding) []string {
+	for _, crtb := range crtbs {
+		if crtb.RoleTemplateName != rtName || crtb.DeletionTimestamp != nil {
+			continue
+		}
+		if crtb.Status.Summary == status.SummaryCompleted {
+			return nil
+		}
+		summary := crtb.Status.Summary
+		if summary == "" {
+			summary = "pending"
+		}
+		return []string{fmt.Sprintf("crtb %s for grb %s and roleTemplate %s is %s", crtb.Name, bindingName, rtName, strings.ToLower(summary))}
+	}
+	return []string{fmt.Sprintf("missing crtb for grb %s and roleTemplate %s", bindingName, rtName)}
+}
+
+// reconcileInheritedClusterRolesStatus adds the InheritedClusterRolesSynced conditions to the status of the GlobalRole.
+func (gr *globalRoleLifecycle) reconcileInheritedClusterRolesStatus(globalRole *v3.GlobalRole) error {
+	conditions, err := gr.inheritedClusterRolesConditions(globalRole)
+	if err != nil {
+		globalRole.Status.Conditions = append(globalRole.Status.Conditions, metav1.Condition{
+			Type:               InheritedClusterRolesSynced,
+			Status:             metav1.ConditionFalse,
+			Reason:             FailedToListClusters,
+			Message:            err.Error(),
+			LastTransitionTime: metav1.Time{Time: time.Now()},
+		})
+		return err
+	}
+	globalRole.Status.Conditions = append(globalRole.Status.Conditions, conditions...)
+	return nil
+}
+
+// inheritedClusterRolesStatusChanged returns whether the InheritedClusterRolesSynced conditions in the status of the
+// GlobalRole are out of date, so that the GlobalRole is reconciled when the backing CRTBs change even if the
+// GlobalRole itself didn't.
+func (gr *globalRoleLifecycle) inheritedClusterRolesStatusChanged(globalRole *v3.GlobalRole) bool {
+	want, err := gr.inheritedClusterRolesConditions(globalRole)
+	if err != nil {
+		return true
+	}
+
+	var have []metav1.Condition
+	for _, c := range globalRole.Status.Conditions {
+		if c.Type == InheritedClusterRolesSynced {
+			have = append(have, c)
+		}
+	}
+	if len(have) != len(want) {
+		return true
+	}
+	for i := range want {
+		if have[i].Status != want[i].Status || have[i].Message != want[i].Message {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package globalroles
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestInheritedClusterRolesConditions(t *testing.T) {
+	t.Parallel()
+	globalRole := &v3.GlobalRole{
+		ObjectMeta:            metav1.ObjectMeta{Name: "test-gr"},
+		InheritedClusterRoles: []string{"cluster-owner", "cluster-member"},
+	}
+	grb := &v3.GlobalRoleBinding{
+		ObjectMeta:     metav1.ObjectMeta{Name: "test-grb"},
+		GlobalRoleName: globalRole.Name,
+	}
+	crtb := func(name, cluster, rt, summary string) *v3.ClusterRoleTemplateBinding {
+		return &v3.ClusterRoleTemplateBinding{
+			ObjectMeta:       metav1.ObjectMeta{Name: name, Namespace: cluster},
+			ClusterName:      cluster,
+			RoleTemplateName: rt,
+			Status:           v3.ClusterRoleTemplateBindingStatus{Summary: summary},
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	grbCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleBinding](ctrl)
+	grbCache.EXPECT().GetByIndex(grbGrIndex, globalRole.Name).Return([]*v3.GlobalRoleBinding{grb}, nil)
+	clusterCache := fake.NewMockNonNamespacedCacheInterface[*v3.Cluster](ctrl)
+	clusterCache.EXPECT().List(gomock.Any()).Return([]*v3.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "c-b"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "local"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "c-a"}},
+	}, nil)
+	crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+	crtbCache.EXPECT().GetByIndex(crtbGrbOwnerIndex, "c-a/test-grb").Return([]*v3.ClusterRoleTemplateBinding{
+		crtb("crtb-1", "c-a", "cluster-owner", "Completed"),
+		crtb("crtb-2", "c-a", "cluster-member", "Completed"),
+	}, nil)
+	crtbCache.EXPECT().GetByIndex(crtbGrbOwnerIndex, "c-b/test-grb").Return([]*v3.ClusterRoleTemplateBinding{
+		crtb("crtb-3", "c-b", "cluster-owner", "Error"),
+	}, nil)
+
+	gr := globalRoleLifecycle{
+		grbCache:     grbCache,
+		crtbCache:    crtbCache,
+		clusterCache: clusterCache,
+	}
+	conditions, err := gr.inheritedClusterRolesConditions(globalRole)
+	require.NoError(t, err)
+	require.Len(t, conditions, 2)
+
+	assert.Equal(t, InheritedClusterRolesSynced, conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+	assert.Equal(t, InheritedClusterRolesSynced, conditions[0].Reason)
+	assert.Equal(t, "cluster c-a synced", conditions[0].Message)
+
+	assert.Equal(t, InheritedClusterRolesSynced, conditions[1].Type)
+	assert.Equal(t, metav1.ConditionFalse, conditions[1].Status)
+	assert.Equal(t, InheritedClusterRolesNotSynced, conditions[1].Reason)
+	assert.Equal(t, "cluster c-b not synced: crtb crtb-3 for grb test-grb and roleTemplate cluster-owner is error, "+
+		"missing crtb for grb test-grb and roleTemplate cluster-member", conditions[1].Message)
+}
+
+func TestInheritedClusterRolesConditionsWithoutInheritedRoles(t *testing.T) {
+	t.Parallel()
+	gr := globalRoleLifecycle{}
+	conditions, err := gr.inheritedClusterRolesConditions(&v3.GlobalRole{})
+	require.NoError(t, err)
+	assert.Empty(t, conditions)
+	assert.False(t, gr.inheritedClusterRolesStatusChanged(&v3.GlobalRole{}))
+}
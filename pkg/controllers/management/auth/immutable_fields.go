@@ -0,0 +1,328 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rancher/rancher/pkg/controllers/management/authprovisioningv2"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	pkgrbac "github.com/rancher/rancher/pkg/rbac"
+	"github.com/sirupsen/logrus"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// immutableFieldsAnnotation records the fields of a role template binding its RBAC was reconciled for. The fields
+	// can't be updated through the norman API, but nothing prevents updating them through the kube API.
+	immutableFieldsAnnotation = "auth.management.cattle.io/immutable-fields"
+
+	immutableFieldsReconciled = "ImmutableFieldsReconciled"
+	immutableFieldsUpdated    = "ImmutableFieldsUpdated"
+	failedToRemoveStaleRBAC   = "FailedToRemoveStaleRBAC"
+	failedToRecordFields      = "FailedToRecordImmutableFields"
+)
+
+// rtbImmutableFields are the fields of a role template binding the RBAC derived from it depends on.
+type rtbImmutableFields struct {
+	UserName           string `json:"userName,omitempty"`
+	GroupName          string `json:"groupName,omitempty"`
+	GroupPrincipalName string `json:"groupPrincipalName,omitempty"`
+	// Scope is the cluster name of a CRTB, or the project name of a PRTB.
+	Scope            string `json:"scope"`
+	RoleTemplateName string `json:"roleTemplateName"`
+}
+
+func crtbImmutableFields(binding *v3.ClusterRoleTemplateBinding) rtbImmutableFields {
+	return rtbImmutableFields{
+		UserName:           binding.UserName,
+		GroupName:          binding.GroupName,
+		GroupPrincipalName: binding.GroupPrincipalName,
+		Scope:              binding.ClusterName,
+		RoleTemplateName:   binding.RoleTemplateName,
+	}
+}
+
+func prtbImmutableFields(binding *v3.ProjectRoleTemplateBinding) rtbImmutableFields {
+	return rtbImmutableFields{
+		UserName:           binding.UserName,
+		GroupName:          binding.GroupName,
+		GroupPrincipalName: binding.GroupPrincipalName,
+		Scope:              binding.ProjectName,
+		RoleTemplateName:   binding.RoleTemplateName,
+	}
+}
+
+// String describes the fields in the messages of conditions and logs.
+func (f rtbImmutableFields) String() string {
+	subject := f.UserName
+	if subject == "" {
+		subject = f.GroupPrincipalName
+	}
+	if subject == "" {
+		subject = f.GroupName
+	}
+	return fmt.Sprintf("subject %s, scope %s, role template %s", subject, f.Scope, f.RoleTemplateName)
+}
+
+// recordedImmutableFields returns the fields recorded on the binding, false if none were. An annotation which can't be
+// parsed is handled as missing, the bindings recording none are checked for stale RBAC.
+func recordedImmutableFields(obj metav1.Object) (rtbImmutableFields, bool) {
+	value, ok := obj.GetAnnotations()[immutableFieldsAnnotation]
+	if !ok {
+		return rtbImmutableFields{}, false
+	}
+	var fields rtbImmutableFields
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		logrus.Warnf("Ignoring the invalid annotation %s of binding %s/%s: %v", immutableFieldsAnnotation, obj.GetNamespace(), obj.GetName(), err)
+		return rtbImmutableFields{}, false
+	}
+	return fields, true
+}
+
+func setImmutableFields(obj metav1.Object, fields rtbImmutableFields) {
+	// Marshaling a struct of strings can't fail.
+	value, _ := json.Marshal(fields)
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[immutableFieldsAnnotation] = string(value)
+	obj.SetAnnotations(annotations)
+}
+
+// hasSubject returns true if the subjects include the subject.
+func hasSubject(subjects []rbacv1.Subject, subject rbacv1.Subject) bool {
+	return slices.ContainsFunc(subjects, func(s rbacv1.Subject) bool {
+		return s.Kind == subject.Kind && s.Name == subject.Name
+	})
+}
+
+// reconcileImmutableFields handles an update of the subject, cluster or role template of the CRTB as a delete and
+// create: the RBAC derived from the previous fields is removed before it is reconciled for the new ones.
+//
+// The CRTBs created before the fields were recorded are migrated: their derived RBAC is removed if it doesn't match
+// the CRTB any more.
+func (c *crtbLifecycle) reconcileImmutableFields(binding *v3.ClusterRoleTemplateBinding, localConditions *[]metav1.Condition) error {
+	condition := metav1.Condition{Type: immutableFieldsReconciled}
+	current := crtbImmutableFields(binding)
+	recorded, ok := recordedImmutableFields(binding)
+	if ok && recorded == current {
+		c.s.AddCondition(localConditions, condition, immutableFieldsReconciled, nil)
+		return nil
+	}
+
+	reason := immutableFieldsReconciled
+	stale := ok
+	if !ok {
+		var err error
+		stale, err = c.hasStaleRBAC(binding)
+		if err != nil {
+			c.s.AddCondition(localConditions, condition, failedToRemoveStaleRBAC, err)
+			return err
+		}
+	}
+	if stale {
+		if ok {
+			logrus.Warnf("[%v] ClusterRoleTemplateBinding %s/%s was updated from (%s) to (%s), removing the RBAC granted for its previous fields",
+				ctrbMGMTController, binding.Namespace, binding.Name, recorded, current)
+		} else {
+			logrus.Warnf("[%v] The RBAC of ClusterRoleTemplateBinding %s/%s doesn't match its fields (%s), removing it",
+				ctrbMGMTController, binding.Namespace, binding.Name, current)
+		}
+		if err := c.removeBindings(binding, localConditions); err != nil {
+			c.s.AddCondition(localConditions, condition, failedToRemoveStaleRBAC, err)
+			return err
+		}
+		clearBindingHash(binding)
+		reason = immutableFieldsUpdated
+	}
+
+	crtb, err := c.crtbCache.Get(binding.Namespace, binding.Name)
+	if err == nil {
+		err = updateFromCache(crtb, func() (*v3.ClusterRoleTemplateBinding, error) {
+			return c.crtbClient.Get(binding.Namespace, binding.Name, metav1.GetOptions{})
+		}, func(crtb *v3.ClusterRoleTemplateBinding) {
+			setImmutableFields(crtb, current)
+		}, c.crtbClient.Update)
+	}
+	if err != nil {
+		c.s.AddCondition(localConditions, condition, failedToRecordFields, err)
+		return err
+	}
+	c.s.AddCondition(localConditions, condition, reason, nil)
+	setImmutableFields(binding, current)
+	return nil
+}
+
+// hasStaleRBAC returns true if the RBAC derived from the CRTB binds another subject, or is in another cluster.
+func (c *crtbLifecycle) hasStaleRBAC(binding *v3.ClusterRoleTemplateBinding) (bool, error) {
+	if binding.UserName == "" && binding.GroupPrincipalName == "" && binding.GroupName == "" {
+		return false, nil
+	}
+	subject, err := pkgrbac.BuildSubjectFromRTB(binding)
+	if err != nil {
+		return false, err
+	}
+	bindingKey := pkgrbac.GetRTBLabel(binding.ObjectMeta)
+
+	crbs, err := c.crbLister.List("", labels.Set{bindingKey: MembershipBindingOwner}.AsSelector())
+	if err != nil {
+		return false, err
+	}
+	rolePrefix := strings.ToLower(binding.ClusterName) + "-cluster"
+	for _, crb := range crbs {
+		if !hasSubject(crb.Subjects, subject) || !strings.HasPrefix(crb.RoleRef.Name, rolePrefix) {
+			return true, nil
+		}
+	}
+
+	rbs, err := c.rbLister.List("", labels.Set{bindingKey: CrtbInProjectBindingOwner}.AsSelector())
+	if err != nil {
+		return false, err
+	}
+	for _, rb := range rbs {
+		if !hasSubject(rb.Subjects, subject) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// reconcileImmutableFields handles an update of the subject, project or role template of the PRTB as a delete and
+// create: the RBAC derived from the previous fields is removed before it is reconciled for the new ones.
+//
+// The PRTBs created before the fields were recorded are migrated: their derived RBAC is removed if it doesn't match
+// the PRTB any more.
+func (p *prtbLifecycle) reconcileImmutableFields(binding *v3.ProjectRoleTemplateBinding) error {
+	current := prtbImmutableFields(binding)
+	recorded, ok := recordedImmutableFields(binding)
+	if ok && recorded == current {
+		return nil
+	}
+
+	stale := ok
+	if !ok {
+		var err error
+		stale, err = p.hasStaleRBAC(binding)
+		if err != nil {
+			return fmt.Errorf("checking the RBAC of ProjectRoleTemplateBinding %s/%s: %w", binding.Namespace, binding.Name, err)
+		}
+	}
+	if stale {
+		if ok {
+			logrus.Warnf("[%v] ProjectRoleTemplateBinding %s/%s was updated from (%s) to (%s), removing the RBAC granted for its previous fields",
+				ptrbMGMTController, binding.Namespace, binding.Name, recorded, current)
+		} else {
+			logrus.Warnf("[%v] The RBAC of ProjectRoleTemplateBinding %s/%s doesn't match its fields (%s), removing it",
+				ptrbMGMTController, binding.Namespace, binding.Name, current)
+		}
+		if err := p.removeStaleRBAC(binding); err != nil {
+			return fmt.Errorf("removing the stale RBAC of ProjectRoleTemplateBinding %s/%s: %w", binding.Namespace, binding.Name, err)
+		}
+		clearBindingHash(binding)
+	}
+
+	prtb, err := p.prtbCache.Get(binding.Namespace, binding.Name)
+	if err == nil {
+		err = updateFromCache(prtb, func() (*v3.ProjectRoleTemplateBinding, error) {
+			return p.prtbClient.Get(binding.Namespace, binding.Name, metav1.GetOptions{})
+		}, func(prtb *v3.ProjectRoleTemplateBinding) {
+			setImmutableFields(prtb, current)
+		}, p.prtbClient.Update)
+	}
+	if err != nil {
+		return err
+	}
+	setImmutableFields(binding, current)
+	return nil
+}
+
+// hasStaleRBAC returns true if the RBAC derived from the PRTB binds another subject, or is in another cluster or
+// project.
+func (p *prtbLifecycle) hasStaleRBAC(binding *v3.ProjectRoleTemplateBinding) (bool, error) {
+	if binding.UserName == "" && binding.GroupPrincipalName == "" && binding.GroupName == "" {
+		return false, nil
+	}
+	clusterName, projectName, found := strings.Cut(binding.ProjectName, ":")
+	if !found {
+		return false, fmt.Errorf("cannot determine project and cluster from %s", binding.ProjectName)
+	}
+	subject, err := pkgrbac.BuildSubjectFromRTB(binding)
+	if err != nil {
+		return false, err
+	}
+	bindingKey := pkgrbac.GetRTBLabel(binding.ObjectMeta)
+
+	crbs, err := p.crbLister.List("", labels.Set{bindingKey: MembershipBindingOwner}.AsSelector())
+	if err != nil {
+		return false, err
+	}
+	clusterRolePrefix := strings.ToLower(clusterName) + "-cluster"
+	for _, crb := range crbs {
+		if !hasSubject(crb.Subjects, subject) || !strings.HasPrefix(crb.RoleRef.Name, clusterRolePrefix) {
+			return true, nil
+		}
+	}
+
+	// The project membership bindings and the bindings granting the cluster scoped privileges are in the namespace of
+	// the cluster.
+	projectRolePrefix := strings.ToLower(projectName) + "-project"
+	for _, owner := range []string{MembershipBindingOwner, PrtbInClusterBindingOwner} {
+		rbs, err := p.rbLister.List("", labels.Set{bindingKey: owner}.AsSelector())
+		if err != nil {
+			return false, err
+		}
+		for _, rb := range rbs {
+			if !hasSubject(rb.Subjects, subject) || rb.Namespace != clusterName {
+				return true, nil
+			}
+			if owner == MembershipBindingOwner && !strings.HasPrefix(rb.RoleRef.Name, projectRolePrefix) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// removeStaleRBAC removes the RBAC derived from the PRTB. Unlike [prtbLifecycle.Remove], it doesn't rely on the fields
+// of the PRTB, which the RBAC may not match, but only on the labels of the RBAC.
+func (p *prtbLifecycle) removeStaleRBAC(binding *v3.ProjectRoleTemplateBinding) error {
+	bindingKey := pkgrbac.GetRTBLabel(binding.ObjectMeta)
+
+	rbs, err := p.rbLister.List("", labels.Set{bindingKey: MembershipBindingOwner}.AsSelector())
+	if err != nil {
+		return err
+	}
+	namespaces := sets.New[string]()
+	for _, rb := range rbs {
+		namespaces.Insert(rb.Namespace)
+	}
+	for _, namespace := range sets.List(namespaces) {
+		if err := p.mgr.reconcileProjectMembershipBindingForDelete(namespace, "", bindingKey); err != nil {
+			return err
+		}
+	}
+
+	if err := p.mgr.reconcileClusterMembershipBindingForDelete("", bindingKey); err != nil {
+		return err
+	}
+
+	rbs, err = p.rbLister.List("", labels.Set{bindingKey: PrtbInClusterBindingOwner}.AsSelector())
+	if err != nil {
+		return err
+	}
+	for _, rb := range rbs {
+		logrus.Infof("[%v] Deleting rolebinding %v in namespace %v for prtb %v", ptrbMGMTController, rb.Name, rb.Namespace, binding.Name)
+		if err := p.rbClient.DeleteNamespaced(rb.Namespace, rb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return p.mgr.removeAuthV2Permissions(authprovisioningv2.PRTBRoleBindingID, binding)
+}
@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	corefakes "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1/fakes"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestCRTBReconcileImmutableFields(t *testing.T) {
+	newCRTB := func(annotation string) *v3.ClusterRoleTemplateBinding {
+		crtb := &v3.ClusterRoleTemplateBinding{
+			ObjectMeta:       metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-1"},
+			UserName:         "u-2",
+			ClusterName:      "c-1",
+			RoleTemplateName: "cluster-member",
+		}
+		if annotation != "" {
+			crtb.Annotations = map[string]string{immutableFieldsAnnotation: annotation}
+		}
+		return crtb
+	}
+	recorded := `{"userName":"u-2","scope":"c-1","roleTemplateName":"cluster-member"}`
+	membershipCRB := func(userName string) *rbacv1.ClusterRoleBinding {
+		return &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "crb-1"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "c-1-clustermember"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: userName}},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		annotation string
+		crbs       []*rbacv1.ClusterRoleBinding
+		wantRemove bool
+		wantRecord bool
+		wantReason string
+	}{
+		{
+			name:       "fields were not updated",
+			annotation: recorded,
+			wantReason: immutableFieldsReconciled,
+		},
+		{
+			name:       "fields were updated",
+			annotation: `{"userName":"u-1","scope":"c-1","roleTemplateName":"cluster-member"}`,
+			wantRemove: true,
+			wantRecord: true,
+			wantReason: immutableFieldsUpdated,
+		},
+		{
+			name:       "fields are recorded when the RBAC matches",
+			crbs:       []*rbacv1.ClusterRoleBinding{membershipCRB("u-2")},
+			wantRecord: true,
+			wantReason: immutableFieldsReconciled,
+		},
+		{
+			name:       "stale RBAC is removed when the fields were not recorded",
+			crbs:       []*rbacv1.ClusterRoleBinding{membershipCRB("u-1")},
+			wantRemove: true,
+			wantRecord: true,
+			wantReason: immutableFieldsUpdated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			crtb := newCRTB(tt.annotation)
+
+			mgr := NewMockmanagerInterface(ctrl)
+			crtbClient := fake.NewMockControllerInterface[*v3.ClusterRoleTemplateBinding, *v3.ClusterRoleTemplateBindingList](ctrl)
+			crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+			if tt.wantRemove {
+				mgr.EXPECT().reconcileClusterMembershipBindingForDelete("", "c-1_crtb-1").Return(nil)
+				mgr.EXPECT().removeAuthV2Permissions(gomock.Any(), gomock.Any()).Return(nil)
+			}
+			if tt.wantRecord {
+				crtbCache.EXPECT().Get("c-1", "crtb-1").Return(newCRTB(tt.annotation), nil)
+				crtbClient.EXPECT().Update(gomock.Any()).DoAndReturn(func(obj *v3.ClusterRoleTemplateBinding) (*v3.ClusterRoleTemplateBinding, error) {
+					assert.JSONEq(t, recorded, obj.Annotations[immutableFieldsAnnotation])
+					return obj, nil
+				})
+			}
+
+			c := &crtbLifecycle{
+				mgr: mgr,
+				projectLister: &fakes.ProjectListerMock{
+					ListFunc: func(string, labels.Selector) ([]*v3.Project, error) {
+						return nil, nil
+					},
+				},
+				crbLister: &corefakes.ClusterRoleBindingListerMock{
+					ListFunc: func(string, labels.Selector) ([]*rbacv1.ClusterRoleBinding, error) {
+						return tt.crbs, nil
+					},
+				},
+				rbLister: &corefakes.RoleBindingListerMock{
+					ListFunc: func(string, labels.Selector) ([]*rbacv1.RoleBinding, error) {
+						return nil, nil
+					},
+				},
+				crtbClient: crtbClient,
+				crtbCache:  crtbCache,
+				s:          &status.Status{TimeNow: time.Now},
+			}
+
+			var conditions []metav1.Condition
+			require.NoError(t, c.reconcileImmutableFields(crtb, &conditions))
+			require.Len(t, conditions, 1)
+			assert.Equal(t, immutableFieldsReconciled, conditions[0].Type)
+			assert.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+			assert.Equal(t, tt.wantReason, conditions[0].Reason)
+			assert.JSONEq(t, recorded, crtb.Annotations[immutableFieldsAnnotation])
+		})
+	}
+}
+
+func TestPRTBHasStaleRBAC(t *testing.T) {
+	prtb := &v3.ProjectRoleTemplateBinding{
+		ObjectMeta:         metav1.ObjectMeta{Name: "prtb-1", Namespace: "c-1-p-1"},
+		GroupPrincipalName: "okta_group://devs",
+		ProjectName:        "c-1:p-1",
+		RoleTemplateName:   "project-member",
+	}
+	subject := rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "okta_group://devs"}
+
+	tests := []struct {
+		name      string
+		rbs       []*rbacv1.RoleBinding
+		wantStale bool
+	}{
+		{
+			name: "RBAC matches",
+			rbs: []*rbacv1.RoleBinding{{
+				ObjectMeta: metav1.ObjectMeta{Name: "rb-1", Namespace: "c-1"},
+				RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "p-1-projectmember"},
+				Subjects:   []rbacv1.Subject{subject},
+			}},
+		},
+		{
+			name: "RBAC is in another project",
+			rbs: []*rbacv1.RoleBinding{{
+				ObjectMeta: metav1.ObjectMeta{Name: "rb-1", Namespace: "c-1"},
+				RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "p-2-projectmember"},
+				Subjects:   []rbacv1.Subject{subject},
+			}},
+			wantStale: true,
+		},
+		{
+			name: "RBAC is in another cluster",
+			rbs: []*rbacv1.RoleBinding{{
+				ObjectMeta: metav1.ObjectMeta{Name: "rb-1", Namespace: "c-2"},
+				RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "p-1-projectmember"},
+				Subjects:   []rbacv1.Subject{subject},
+			}},
+			wantStale: true,
+		},
+		{
+			name: "RBAC binds another subject",
+			rbs: []*rbacv1.RoleBinding{{
+				ObjectMeta: metav1.ObjectMeta{Name: "rb-1", Namespace: "c-1"},
+				RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "p-1-projectmember"},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "u-1"}},
+			}},
+			wantStale: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &prtbLifecycle{
+				crbLister: &corefakes.ClusterRoleBindingListerMock{
+					ListFunc: func(string, labels.Selector) ([]*rbacv1.ClusterRoleBinding, error) {
+						return nil, nil
+					},
+				},
+				rbLister: &corefakes.RoleBindingListerMock{
+					ListFunc: func(_ string, selector labels.Selector) ([]*rbacv1.RoleBinding, error) {
+						if selector.Matches(labels.Set{"c-1-p-1_prtb-1": MembershipBindingOwner}) {
+							return tt.rbs, nil
+						}
+						return nil, nil
+					},
+				},
+			}
+
+			stale, err := p.hasStaleRBAC(prtb)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStale, stale)
+		})
+	}
+}
@@ -6,8 +6,8 @@
 	"reflect"
 	"slices"
 	"sort"
+	"strings"
 
-	"github.com/rancher/norman/objectclient"
 	"github.com/rancher/norman/types/slice"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/rbac"
 	"github.com/rancher/rancher/pkg/controllers/status"
@@ -44,8 +44,9 @@ func newRTBLifecycles(management *config.ManagementContext) (*prtbLifecycle, *cr
 
 	prtb := &prtbLifecycle{
 		mgr: &manager{
-			mgmt:       management,
 			crLister:   management.RBAC.ClusterRoles("").Controller().Lister(),
+			crClient:   management.RBAC.ClusterRoles(""),
+			crbClient:  management.RBAC.ClusterRoleBindings(""),
 			nsLister:   management.Core.Namespaces("").Controller().Lister(),
 			rLister:    management.RBAC.Roles("").Controller().Lister(),
 			rClient:    management.RBAC.Roles(""),
@@ -64,12 +65,14 @@ func newRTBLifecycles(management *config.ManagementContext) (*prtbLifecycle, *cr
 		rbClient:      management.RBAC.RoleBindings(""),
 		crbLister:     management.RBAC.ClusterRoleBindings("").Controller().Lister(),
 		crbClient:     management.RBAC.ClusterRoleBindings(""),
-		prtbClient:    management.Management.ProjectRoleTemplateBindings(""),
+		prtbClient:    management.Wrangler.Mgmt.ProjectRoleTemplateBinding(),
+		prtbCache:     management.Wrangler.Mgmt.ProjectRoleTemplateBinding().Cache(),
 	}
 	crtb := &crtbLifecycle{
 		mgr: &manager{
-			mgmt:       management,
 			crLister:   management.RBAC.ClusterRoles("").Controller().Lister(),
+			crClient:   management.RBAC.ClusterRoles(""),
+			crbClient:  management.RBAC.ClusterRoleBindings(""),
 			nsLister:   management.Core.Namespaces("").Controller().Lister(),
 			rLister:    management.RBAC.Roles("").Controller().Lister(),
 			rClient:    management.RBAC.Roles(""),
@@ -90,6 +93,7 @@ func newRTBLifecycles(management *config.ManagementContext) (*prtbLifecycle, *cr
 		crbClient:     management.RBAC.ClusterRoleBindings(""),
 		crtbClient:    management.Wrangler.Mgmt.ClusterRoleTemplateBinding(),
 		crtbCache:     management.Wrangler.Mgmt.ClusterRoleTemplateBinding().Cache(),
+		enqueueAfter:  management.Management.ClusterRoleTemplateBindings("").Controller().EnqueueAfter,
 		s:             status.NewStatus(),
 	}
 	return prtb, crtb
@@ -105,10 +109,13 @@ type managerInterface interface {
 	grantManagementPlanePrivileges(string, map[string]string, v1.Subject, interface{}) error
 	grantManagementClusterScopedPrivilegesInProjectNamespace(string, string, map[string]string, v1.Subject, *v3.ClusterRoleTemplateBinding) error
 	grantManagementProjectScopedPrivilegesInClusterNamespace(string, string, map[string]string, v1.Subject, *v3.ProjectRoleTemplateBinding) error
+	resolveRoleTemplates(string) ([]resolvedRoleTemplate, error)
 }
 
 type manager struct {
 	crLister   typesrbacv1.ClusterRoleLister
+	crClient   typesrbacv1.ClusterRoleInterface
+	crbClient  typesrbacv1.ClusterRoleBindingInterface
 	rLister    typesrbacv1.RoleLister
 	rClient    typesrbacv1.RoleInterface
 	rbLister   typesrbacv1.RoleBindingLister
@@ -117,7 +124,6 @@ type manager struct {
 	nsLister   v13.NamespaceLister
 	rbIndexer  cache.Indexer
 	crbIndexer cache.Indexer
-	mgmt       *config.ManagementContext
 	controller string
 }
 
@@ -137,7 +143,7 @@ func (m *manager) ensureClusterMembershipBinding(roleName, rtbNsAndName string,
 	var crb *v1.ClusterRoleBinding
 	for _, iCRB := range crbs {
 		if iCRB, ok := iCRB.(*v1.ClusterRoleBinding); ok {
-			if len(iCRB.Subjects) != 1 {
+			if len(iCRB.Subjects) == 1 {
 				iKey := rbRoleSubjectKey(iCRB.RoleRef.Name, iCRB.Subjects[0])
 				if iKey == key {
 					crb = iCRB
@@ -167,7 +173,7 @@ func (m *manager) ensureClusterMembershipBinding(roleName, rtbNsAndName string,
 			Name: roleName,
 		}
 		crbName := pkgrbac.NameForClusterRoleBinding(roleRef, subject) // use deterministic name for crb
-		_, err = m.mgmt.RBAC.ClusterRoleBindings("").Create(&v1.ClusterRoleBinding{
+		_, err = m.crbClient.Create(&v1.ClusterRoleBinding{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        crbName,
 				Annotations: map[string]string{clusterNameLabel: cluster.Name},
@@ -183,7 +189,7 @@ func (m *manager) ensureClusterMembershipBinding(roleName, rtbNsAndName string,
 		}
 
 		// if the binding exists but was not found in the index, manually retrieve it so that we can add appropriate labels
-		crb, err := m.mgmt.RBAC.ClusterRoleBindings("").Get(crbName, metav1.GetOptions{})
+		crb, err := m.crbClient.Get(crbName, metav1.GetOptions{})
 		if err != nil {
 			return err
 		}
@@ -203,7 +209,7 @@ func (m *manager) ensureClusterMembershipBinding(roleName, rtbNsAndName string,
 	}
 	crb.Labels[rtbNsAndName] = MembershipBindingOwner
 	logrus.Infof("[%v] Updating clusterRoleBinding %v for cluster membership in cluster %v for subject %v", m.controller, crb.Name, cluster.Name, subject.Name)
-	_, err = m.mgmt.RBAC.ClusterRoleBindings("").Update(crb)
+	_, err = m.crbClient.Update(crb)
 	return err
 }
 
@@ -222,7 +228,7 @@ func (m *manager) ensureProjectMembershipBinding(roleName, rtbNsAndName, namespa
 	var rb *v1.RoleBinding
 	for _, iRB := range rbs {
 		if iRB, ok := iRB.(*v1.RoleBinding); ok {
-			if len(iRB.Subjects) != 1 {
+			if len(iRB.Subjects) == 1 {
 				iKey := rbRoleSubjectKey(iRB.RoleRef.Name, iRB.Subjects[0])
 				if iKey == key {
 					rb = iRB
@@ -253,9 +259,10 @@ func (m *manager) ensureProjectMembershipBinding(roleName, rtbNsAndName, namespa
 		}
 		// use deterministic name for rb
 		rbName := pkgrbac.NameForRoleBinding(namespace, roleRef, subject)
-		_, err = m.mgmt.RBAC.RoleBindings(namespace).Create(&v1.RoleBinding{
+		_, err = m.rbClient.Create(&v1.RoleBinding{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: rbName,
+				Name:      rbName,
+				Namespace: namespace,
 				Labels: map[string]string{
 					rtbNsAndName: MembershipBindingOwner,
 				},
@@ -268,7 +275,7 @@ func (m *manager) ensureProjectMembershipBinding(roleName, rtbNsAndName, namespa
 		}
 
 		// if the binding already exists but was not found in the index, manually retrieve it so that we can add appropriate labels
-		rb, err := m.mgmt.RBAC.RoleBindings(namespace).Get(rbName, metav1.GetOptions{})
+		rb, err := m.rbClient.GetNamespaced(namespace, rbName, metav1.GetOptions{})
 		if err != nil {
 			return err
 		}
@@ -288,7 +295,7 @@ func (m *manager) ensureProjectMembershipBinding(roleName, rtbNsAndName, namespa
 	}
 	rb.Labels[rtbNsAndName] = MembershipBindingOwner
 	logrus.Infof("[%v] Updating roleBinding %v for project membership in project %v for subject %v", m.controller, rb.Name, project.Name, subject.Name)
-	_, err = m.mgmt.RBAC.RoleBindings(namespace).Update(rb)
+	_, err = m.rbClient.Update(rb)
 	return err
 }
 
@@ -296,7 +303,7 @@ func (m *manager) ensureProjectMembershipBinding(roleName, rtbNsAndName, namespa
 // (or CRUD the project/cluster if they are an owner)
 func (m *manager) createClusterMembershipRole(roleName string, cluster *v3.Cluster, makeOwner bool) error {
 	if cr, _ := m.crLister.Get("", roleName); cr == nil {
-		return m.createMembershipRole(clusterResource, roleName, makeOwner, cluster, m.mgmt.RBAC.ClusterRoles("").ObjectClient(), true)
+		return m.createMembershipRole(clusterResource, roleName, "", makeOwner, cluster)
 	}
 	return nil
 }
@@ -305,12 +312,13 @@ func (m *manager) createClusterMembershipRole(roleName string, cluster *v3.Clust
 // (or CRUD the project if they are an owner)
 func (m *manager) createProjectMembershipRole(roleName, namespace string, project *v3.Project, makeOwner bool) error {
 	if cr, _ := m.rLister.Get(namespace, roleName); cr == nil {
-		return m.createMembershipRole(projectResource, roleName, makeOwner, project, m.mgmt.RBAC.Roles(namespace).ObjectClient(), false)
+		return m.createMembershipRole(projectResource, roleName, namespace, makeOwner, project)
 	}
 	return nil
 }
 
-func (m *manager) createMembershipRole(resourceType, roleName string, makeOwner bool, ownerObject interface{}, client *objectclient.ObjectClient, clusterRole bool) error {
+// createMembershipRole creates the membership role in the namespace, or a cluster role if the namespace is empty.
+func (m *manager) createMembershipRole(resourceType, roleName, namespace string, makeOwner bool, ownerObject interface{}) error {
 	metaObj, err := meta.Accessor(ownerObject)
 	if err != nil {
 		return err
@@ -334,9 +342,9 @@ func (m *manager) createMembershipRole(resourceType, roleName string, makeOwner
 		rules[0].Verbs = []string{"get"}
 	}
 	logrus.Infof("[%v] Creating role/clusterRole %v", m.controller, roleName)
-	var toCreate runtime.Object
 	objectMeta := metav1.ObjectMeta{
-		Name: roleName,
+		Name:      roleName,
+		Namespace: namespace,
 		OwnerReferences: []metav1.OwnerReference{
 			{
 				APIVersion: typeMeta.GetAPIVersion(),
@@ -346,19 +354,18 @@ func (m *manager) createMembershipRole(resourceType, roleName string, makeOwner
 			},
 		},
 	}
-	if clusterRole {
+	if namespace == "" {
 		objectMeta.Annotations = map[string]string{clusterNameLabel: metaObj.GetName()}
-		toCreate = &v1.ClusterRole{
+		_, err = m.crClient.Create(&v1.ClusterRole{
 			ObjectMeta: objectMeta,
 			Rules:      rules,
-		}
-	} else {
-		toCreate = &v1.Role{
-			ObjectMeta: objectMeta,
-			Rules:      rules,
-		}
+		})
+		return err
 	}
-	_, err = client.Create(toCreate)
+	_, err = m.rClient.Create(&v1.Role{
+		ObjectMeta: objectMeta,
+		Rules:      rules,
+	})
 	return err
 }
 
@@ -370,7 +377,14 @@ func (m *manager) reconcileClusterMembershipBindingForDelete(roleToKeep, rtbNsAn
 		return rb.RoleRef.Name
 	}
 
-	return m.reconcileMembershipBindingForDelete("", roleToKeep, rtbNsAndName, m.crbIndexer, convert, m.mgmt.RBAC.ClusterRoleBindings("").ObjectClient())
+	deleteFn := func(name string) error {
+		return m.crbClient.Delete(name, &metav1.DeleteOptions{})
+	}
+	update := func(obj runtime.Object) error {
+		_, err := m.crbClient.Update(obj.(*v1.ClusterRoleBinding))
+		return err
+	}
+	return m.reconcileMembershipBindingForDelete("", roleToKeep, rtbNsAndName, m.crbIndexer, convert, deleteFn, update)
 }
 
 // The PRTB has been deleted, either delete or update the project membership binding so that the subject
@@ -381,12 +395,20 @@ func (m *manager) reconcileProjectMembershipBindingForDelete(namespace, roleToKe
 		return rb.RoleRef.Name
 	}
 
-	return m.reconcileMembershipBindingForDelete(namespace, roleToKeep, rtbNsAndName, m.rbIndexer, convert, m.mgmt.RBAC.RoleBindings(namespace).ObjectClient())
+	deleteFn := func(name string) error {
+		return m.rbClient.DeleteNamespaced(namespace, name, &metav1.DeleteOptions{})
+	}
+	update := func(obj runtime.Object) error {
+		_, err := m.rbClient.Update(obj.(*v1.RoleBinding))
+		return err
+	}
+	return m.reconcileMembershipBindingForDelete(namespace, roleToKeep, rtbNsAndName, m.rbIndexer, convert, deleteFn, update)
 }
 
 type convertFn func(i interface{}) string
 
-func (m *manager) reconcileMembershipBindingForDelete(namespace, roleToKeep, rtbNsAndName string, index cache.Indexer, convert convertFn, client *objectclient.ObjectClient) error {
+func (m *manager) reconcileMembershipBindingForDelete(namespace, roleToKeep, rtbNsAndName string, index cache.Indexer, convert convertFn,
+	deleteFn func(name string) error, update func(obj runtime.Object) error) error {
 	roleBindings, err := index.ByIndex(membershipBindingOwnerIndex, namespace+"/"+rtbNsAndName)
 	if err != nil {
 		return err
@@ -422,7 +444,7 @@ func (m *manager) reconcileMembershipBindingForDelete(namespace, roleToKeep, rtb
 
 		if !otherOwners {
 			logrus.Infof("[%v] Deleting roleBinding %v", m.controller, objMeta.GetName())
-			if err := client.Delete(objMeta.GetName(), &metav1.DeleteOptions{}); err != nil {
+			if err := deleteFn(objMeta.GetName()); err != nil {
 				if apierrors.IsNotFound(err) {
 					continue
 				}
@@ -430,7 +452,7 @@ func (m *manager) reconcileMembershipBindingForDelete(namespace, roleToKeep, rtb
 			}
 		} else {
 			logrus.Infof("[%v] Updating owner label for roleBinding %v", m.controller, objMeta.GetName())
-			if _, err := client.Update(objMeta.GetName(), objCopy); err != nil {
+			if err := update(objCopy); err != nil {
 				return err
 			}
 		}
@@ -478,6 +500,7 @@ func (m *manager) grantManagementPlanePrivileges(roleTemplateName string, resour
 		return err
 	}
 	namespace := bindingMeta.GetNamespace()
+	bindingKey := pkgrbac.GetRTBLabel(metav1.ObjectMeta{Namespace: namespace, Name: bindingMeta.GetName()})
 
 	roles, err := m.gatherAndDedupeRoles(roleTemplateName)
 	if err != nil {
@@ -494,7 +517,8 @@ func (m *manager) grantManagementPlanePrivileges(roleTemplateName string, resour
 			}
 			if len(verbs) > 0 {
 				resourceToVerbs[resource] = verbs
-				bindingName := bindingMeta.GetName() + "-" + role.Name
+				roleRef := v1.RoleRef{Kind: "Role", Name: role.Name}
+				bindingName := pkgrbac.NameForRTBRoleBinding(bindingKey, roleRef, subject)
 				if _, ok := desiredRBs[bindingName]; !ok {
 					desiredRBs[bindingName] = &v1.RoleBinding{
 						ObjectMeta: metav1.ObjectMeta{
@@ -510,10 +534,7 @@ func (m *manager) grantManagementPlanePrivileges(roleTemplateName string, resour
 							},
 						},
 						Subjects: []v1.Subject{subject},
-						RoleRef: v1.RoleRef{
-							Kind: "Role",
-							Name: role.Name,
-						},
+						RoleRef:  roleRef,
 					}
 				}
 			}
@@ -560,7 +581,8 @@ func (m *manager) grantManagementClusterScopedPrivilegesInProjectNamespace(roleT
 			if len(verbs) > 0 {
 				resourceToVerbs[resource] = verbs
 
-				bindingName := binding.Name + "-" + role.Name
+				roleRef := v1.RoleRef{Kind: "Role", Name: role.Name}
+				bindingName := pkgrbac.NameForRTBRoleBinding(bindingKey, roleRef, subject)
 				if _, ok := desiredRBs[bindingName]; !ok {
 					desiredRBs[bindingName] = &v1.RoleBinding{
 						ObjectMeta: metav1.ObjectMeta{
@@ -571,10 +593,7 @@ func (m *manager) grantManagementClusterScopedPrivilegesInProjectNamespace(roleT
 							},
 						},
 						Subjects: []v1.Subject{subject},
-						RoleRef: v1.RoleRef{
-							Kind: "Role",
-							Name: role.Name,
-						},
+						RoleRef:  roleRef,
 					}
 				}
 			}
@@ -620,8 +639,8 @@ func (m *manager) grantManagementProjectScopedPrivilegesInClusterNamespace(roleT
 			if len(verbs) > 0 {
 				resourceToVerbs[resource] = verbs
 
-				bindingName := fmt.Sprintf("%s-%s-%s", binding.Namespace, binding.Name, role.Name)
-
+				roleRef := v1.RoleRef{Kind: "Role", Name: role.Name}
+				bindingName := pkgrbac.NameForRTBRoleBinding(bindingKey, roleRef, subject)
 				if _, ok := desiredRBs[bindingName]; !ok {
 					desiredRBs[bindingName] = &v1.RoleBinding{
 						ObjectMeta: metav1.ObjectMeta{
@@ -632,10 +651,7 @@ func (m *manager) grantManagementProjectScopedPrivilegesInClusterNamespace(roleT
 							},
 						},
 						Subjects: []v1.Subject{subject},
-						RoleRef: v1.RoleRef{
-							Kind: "Role",
-							Name: role.Name,
-						},
+						RoleRef:  roleRef,
 					}
 				}
 			}
@@ -696,9 +712,14 @@ func (m *manager) gatherAndDedupeRoles(roleTemplateName string) (map[string]*v3.
 
 // reconcileDesiredMGMTPlaneRoleBindings ensures that the desired management plane role bindings
 // exist and delete any that should not exist
+// reconcileDesiredMGMTPlaneRoleBindings creates the desired RoleBindings and deletes the current ones which aren't
+// desired. The desired RoleBindings are keyed by their deterministic name. A current RoleBinding with another name, like
+// the ones named before the names were deterministic, is adopted if it binds the role of a desired RoleBinding to its
+// subject, instead of being replaced.
 func (m *manager) reconcileDesiredMGMTPlaneRoleBindings(currentRBs, desiredRBs map[string]*v1.RoleBinding, namespace string) error {
 	rbsToDelete := map[string]bool{}
 	processed := map[string]bool{}
+	var otherRBs []*v1.RoleBinding
 	for _, rb := range currentRBs {
 		// protect against an rb being in the list more than once (shouldn't happen, but just to be safe)
 		if ok := processed[rb.Name]; ok {
@@ -708,6 +729,15 @@ func (m *manager) reconcileDesiredMGMTPlaneRoleBindings(currentRBs, desiredRBs m
 
 		if _, ok := desiredRBs[rb.Name]; ok {
 			delete(desiredRBs, rb.Name)
+		} else {
+			otherRBs = append(otherRBs, rb)
+		}
+	}
+	// The RoleBindings with the name of a desired one are kept first, so that the adopted ones don't replace them.
+	for _, rb := range otherRBs {
+		if name, ok := sameRoleBinding(desiredRBs, rb); ok {
+			logrus.Debugf("[%v] Adopting roleBinding %v for desired roleBinding %v", m.controller, rb.Name, name)
+			delete(desiredRBs, name)
 		} else {
 			rbsToDelete[rb.Name] = true
 		}
@@ -733,7 +763,10 @@ func (m *manager) reconcileDesiredMGMTPlaneRoleBindings(currentRBs, desiredRBs m
 	for _, rb := range desiredRBs {
 		logrus.Infof("[%v] Creating roleBinding for subject %v with role %v in namespace %v", m.controller, rb.Subjects[0].Name, rb.RoleRef.Name, rb.Namespace)
 		_, err := m.rbClient.Create(rb)
-		if err != nil && !apierrors.IsAlreadyExists(err) {
+		if apierrors.IsAlreadyExists(err) {
+			err = m.adoptMGMTPlaneRoleBinding(rb)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -741,24 +774,61 @@ func (m *manager) reconcileDesiredMGMTPlaneRoleBindings(currentRBs, desiredRBs m
 	return nil
 }
 
+// adoptMGMTPlaneRoleBinding makes the existing RoleBinding with the name of the desired one match it. The RoleBinding
+// wasn't found by the labels or owner of the desired one, it was left by a previous role template binding with the same
+// namespace and name, whose owner reference would get it garbage collected.
+func (m *manager) adoptMGMTPlaneRoleBinding(desired *v1.RoleBinding) error {
+	existing, err := m.rbClient.GetNamespaced(desired.Namespace, desired.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if existing.RoleRef != desired.RoleRef || !reflect.DeepEqual(existing.Subjects, desired.Subjects) {
+		// The role of a RoleBinding can't be updated.
+		logrus.Infof("[%v] Replacing roleBinding %v in namespace %v", m.controller, existing.Name, existing.Namespace)
+		if err := m.rbClient.DeleteNamespaced(existing.Namespace, existing.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		_, err := m.rbClient.Create(desired)
+		return err
+	}
+
+	adopted := existing.DeepCopy()
+	for key, value := range desired.Labels {
+		if adopted.Labels == nil {
+			adopted.Labels = map[string]string{}
+		}
+		adopted.Labels[key] = value
+	}
+	for _, ref := range desired.OwnerReferences {
+		// Replace the reference to a previous owner of the same kind and name.
+		adopted.OwnerReferences = slices.DeleteFunc(adopted.OwnerReferences, func(r metav1.OwnerReference) bool {
+			return r.Kind == ref.Kind && r.Name == ref.Name
+		})
+		adopted.OwnerReferences = append(adopted.OwnerReferences, ref)
+	}
+	if reflect.DeepEqual(existing.Labels, adopted.Labels) && reflect.DeepEqual(existing.OwnerReferences, adopted.OwnerReferences) {
+		return nil
+	}
+	logrus.Infof("[%v] Adopting roleBinding %v in namespace %v", m.controller, existing.Name, existing.Namespace)
+	_, err = m.rbClient.Update(adopted)
+	return err
+}
+
+// sameRoleBinding returns the name of the desired RoleBinding binding the same role to the same subjects as rb.
+func sameRoleBinding(desiredRBs map[string]*v1.RoleBinding, rb *v1.RoleBinding) (string, bool) {
+	for name, desired := range desiredRBs {
+		if desired.RoleRef == rb.RoleRef && reflect.DeepEqual(desired.Subjects, rb.Subjects) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
 // If the roleTemplate has rules granting access to a management plane resource, return the verbs for those rules
 func (m *manager) checkForManagementPlaneRules(role *v3.RoleTemplate, managementPlaneResource string, apiGroup string) (map[string]string, error) {
-	var rules []v1.PolicyRule
-	if role.External {
-		if role.ExternalRules != nil {
-			rules = append(rules, role.ExternalRules...)
-		} else {
-			externalRole, err := m.crLister.Get("", role.Name)
-			if err != nil && !apierrors.IsNotFound(err) {
-				// dont error if it doesnt exist
-				return nil, err
-			}
-			if externalRole != nil {
-				rules = externalRole.Rules
-			}
-		}
-	} else {
-		rules = role.Rules
+	rules, err := m.roleTemplateRules(role)
+	if err != nil {
+		return nil, err
 	}
 
 	verbs := map[string]string{}
@@ -775,6 +845,51 @@ func (m *manager) checkForManagementPlaneRules(role *v3.RoleTemplate, management
 	return verbs, nil
 }
 
+// roleTemplateRules returns the rules of the role template, which are the ones of its cluster role for the external
+// role templates without external rules.
+func (m *manager) roleTemplateRules(role *v3.RoleTemplate) ([]v1.PolicyRule, error) {
+	if !role.External {
+		return role.Rules, nil
+	}
+	if role.ExternalRules != nil {
+		return role.ExternalRules, nil
+	}
+	externalRole, err := m.crLister.Get("", role.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		// dont error if it doesnt exist
+		return nil, err
+	}
+	if externalRole != nil {
+		return externalRole.Rules, nil
+	}
+	return nil, nil
+}
+
+// resolveRoleTemplates returns the role template and the ones it inherits, sorted by name, with their rules.
+func (m *manager) resolveRoleTemplates(roleTemplateName string) ([]resolvedRoleTemplate, error) {
+	roles, err := m.gatherAndDedupeRoles(roleTemplateName)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make([]resolvedRoleTemplate, 0, len(roles))
+	for _, role := range roles {
+		rules, err := m.roleTemplateRules(role)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, resolvedRoleTemplate{
+			Name:    role.Name,
+			Context: role.Context,
+			Builtin: role.Builtin,
+			Rules:   rules,
+		})
+	}
+	slices.SortFunc(resolved, func(a, b resolvedRoleTemplate) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return resolved, nil
+}
+
 func checkGroup(apiGroup string, rule v1.PolicyRule) bool {
 	for _, rg := range rule.APIGroups {
 		if rg == apiGroup || rg == "*" {
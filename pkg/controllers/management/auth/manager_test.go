@@ -105,6 +105,7 @@ func Test_reconcileDesiredMGMTPlaneRoleBindings(t *testing.T) {
 	type StateChanges struct {
 		t          *testing.T
 		createdRBs map[string]*rbacv1.RoleBinding
+		updatedRBs map[string]*rbacv1.RoleBinding
 		deletedRBs map[string]bool
 	}
 
@@ -329,6 +330,72 @@ type State struct {
 			desiredRBs: map[string]*rbacv1.RoleBinding{"rb1": rb1},
 			wantError:  false,
 		},
+		{
+			name: "adopt current rb with another name",
+			stateSetup: func(state State) {
+				state.nsListerMock.GetFunc = func(namespace string, name string) (*corev1.Namespace, error) {
+					return &corev1.Namespace{
+						Status: corev1.NamespaceStatus{
+							Phase: corev1.NamespaceActive,
+						},
+					}, nil
+				}
+				state.rbClientMock.CreateFunc = func(rb *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
+					state.stateChanges.createdRBs[rb.Name] = rb
+					return nil, nil
+				}
+				state.rbClientMock.DeleteNamespacedFunc = func(_, name string, _ *v1.DeleteOptions) error {
+					state.stateChanges.deletedRBs[name] = true
+					return nil
+				}
+			},
+			stateAssertions: func(stateChanges StateChanges) {
+				require.Len(stateChanges.t, stateChanges.createdRBs, 0)
+				require.Len(stateChanges.t, stateChanges.deletedRBs, 0)
+			},
+			currentRBs: map[string]*rbacv1.RoleBinding{"crtb-1-roleRef1": func() *rbacv1.RoleBinding {
+				rb := rb1.DeepCopy()
+				rb.Name = "crtb-1-roleRef1"
+				return rb
+			}()},
+			desiredRBs: map[string]*rbacv1.RoleBinding{"rb1": rb1},
+			wantError:  false,
+		},
+		{
+			name: "adopt existing rb of a previous owner",
+			stateSetup: func(state State) {
+				state.nsListerMock.GetFunc = func(namespace string, name string) (*corev1.Namespace, error) {
+					return &corev1.Namespace{
+						Status: corev1.NamespaceStatus{
+							Phase: corev1.NamespaceActive,
+						},
+					}, nil
+				}
+				state.rbClientMock.CreateFunc = func(rb *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
+					return nil, errors.NewAlreadyExists(rbacv1.Resource("rolebindings"), rb.Name)
+				}
+				state.rbClientMock.GetNamespacedFunc = func(_, name string, _ v1.GetOptions) (*rbacv1.RoleBinding, error) {
+					rb := rb1.DeepCopy()
+					rb.OwnerReferences = []v1.OwnerReference{{Kind: "ClusterRoleTemplateBinding", Name: "crtb-1", UID: "old-uid"}}
+					return rb, nil
+				}
+				state.rbClientMock.UpdateFunc = func(rb *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
+					state.stateChanges.updatedRBs[rb.Name] = rb
+					return rb, nil
+				}
+			},
+			stateAssertions: func(stateChanges StateChanges) {
+				require.Len(stateChanges.t, stateChanges.updatedRBs, 1)
+				require.Equal(stateChanges.t, []v1.OwnerReference{{Kind: "ClusterRoleTemplateBinding", Name: "crtb-1", UID: "new-uid"}},
+					stateChanges.updatedRBs["rb1"].OwnerReferences)
+			},
+			desiredRBs: map[string]*rbacv1.RoleBinding{"rb1": func() *rbacv1.RoleBinding {
+				rb := rb1.DeepCopy()
+				rb.OwnerReferences = []v1.OwnerReference{{Kind: "ClusterRoleTemplateBinding", Name: "crtb-1", UID: "new-uid"}}
+				return rb
+			}()},
+			wantError: false,
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -342,6 +409,7 @@ type State struct {
 			stateChanges := StateChanges{
 				t:          t,
 				createdRBs: map[string]*rbacv1.RoleBinding{},
+				updatedRBs: map[string]*rbacv1.RoleBinding{},
 				deletedRBs: map[string]bool{},
 			}
 			state := State{
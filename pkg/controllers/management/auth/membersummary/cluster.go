@@ -0,0 +1,59 @@
+package membersummary
+
+import (
+	"fmt"
+	"reflect"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// enqueueCluster enqueues the cluster of a CRTB when it changes. CRTBs are deleted through a finalizer, their cluster
+// is enqueued when they are marked for deletion.
+func enqueueCluster(_, _ string, obj runtime.Object) ([]relatedresource.Key, error) {
+	crtb, ok := obj.(*v3.ClusterRoleTemplateBinding)
+	if !ok || crtb.ClusterName == "" {
+		return nil, nil
+	}
+	return []relatedresource.Key{{Name: crtb.ClusterName}}, nil
+}
+
+func (h *handler) onClusterChange(_ string, cluster *v3.Cluster) (*v3.Cluster, error) {
+	if cluster == nil || cluster.DeletionTimestamp != nil {
+		return cluster, nil
+	}
+
+	// CRTBs live in the namespace of their cluster.
+	crtbs, err := h.crtbCache.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return cluster, fmt.Errorf("listing the bindings of cluster %s: %w", cluster.Name, err)
+	}
+	var grants []grant
+	for _, crtb := range crtbs {
+		if g, ok := newGrant(crtb.ObjectMeta, crtb.RoleTemplateName, crtb.UserName, crtb.UserPrincipalName, crtb.GroupName, crtb.GroupPrincipalName); ok {
+			grants = append(grants, g)
+		}
+	}
+
+	s := summarize(grants)
+	memberSummary := &v3.ClusterMemberSummary{
+		MemberCount:   s.memberCount,
+		OwnerCount:    s.membersByRole[clusterOwnerRole],
+		MembersByRole: s.membersByRole,
+		Groups:        s.groups,
+	}
+	current := cluster.Status.MemberSummary
+	if current != nil {
+		memberSummary.LastMembershipChange = current.LastMembershipChange
+		if reflect.DeepEqual(current, memberSummary) {
+			return cluster, nil
+		}
+	}
+	memberSummary.LastMembershipChange = h.lastMembershipChange(current != nil, s.lastCreated, cluster.CreationTimestamp)
+
+	cluster = cluster.DeepCopy()
+	cluster.Status.MemberSummary = memberSummary
+	return h.clusters.Update(cluster)
+}
@@ -0,0 +1,135 @@
+// Package membersummary maintains the summaries of the members of clusters and projects on their status, so that
+// they can be audited and listed with their members without fetching all the ClusterRoleTemplateBindings and
+// ProjectRoleTemplateBindings.
+package membersummary
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	projectControllerName = "mgmt-auth-project-members-controller"
+	clusterControllerName = "mgmt-auth-cluster-members-controller"
+	prtbEnqueuer          = "mgmt-auth-project-members-prtb-enqueuer"
+	crtbEnqueuer          = "mgmt-auth-cluster-members-crtb-enqueuer"
+	prtbByProjectIndex    = "mgmt-auth-project-members-prtb-by-project"
+
+	clusterOwnerRole = "cluster-owner"
+)
+
+type handler struct {
+	projects  mgmtv3.ProjectClient
+	prtbCache mgmtv3.ProjectRoleTemplateBindingCache
+	clusters  mgmtv3.ClusterClient
+	crtbCache mgmtv3.ClusterRoleTemplateBindingCache
+	now       func() time.Time
+}
+
+// Register registers the controllers summarizing the members of clusters and projects on their status.
+func Register(ctx context.Context, wContext *wrangler.Context) {
+	projects := wContext.Mgmt.Project()
+	prtbs := wContext.Mgmt.ProjectRoleTemplateBinding()
+	clusters := wContext.Mgmt.Cluster()
+	crtbs := wContext.Mgmt.ClusterRoleTemplateBinding()
+	prtbs.Cache().AddIndexer(prtbByProjectIndex, prtbByProject)
+
+	h := &handler{
+		projects:  projects,
+		prtbCache: prtbs.Cache(),
+		clusters:  clusters,
+		crtbCache: crtbs.Cache(),
+		now:       time.Now,
+	}
+	relatedresource.Watch(ctx, prtbEnqueuer, enqueueProject, projects, prtbs)
+	relatedresource.Watch(ctx, crtbEnqueuer, enqueueCluster, clusters, crtbs)
+	projects.OnChange(ctx, projectControllerName, h.onProjectChange)
+	clusters.OnChange(ctx, clusterControllerName, h.onClusterChange)
+}
+
+// grant is the role of a user or group granted by a binding.
+type grant struct {
+	// member identifies the user or group.
+	member string
+	// group is the principal name of the group, empty for users.
+	group   string
+	role    string
+	created metav1.Time
+}
+
+// newGrant returns the grant of a binding, or false if the binding has no subject or is being deleted.
+func newGrant(meta metav1.ObjectMeta, role, userName, userPrincipalName, groupName, groupPrincipalName string) (grant, bool) {
+	if meta.DeletionTimestamp != nil {
+		return grant{}, false
+	}
+	g := grant{role: role, created: meta.CreationTimestamp}
+	switch {
+	case groupPrincipalName != "":
+		g.member, g.group = "group:"+groupPrincipalName, groupPrincipalName
+	case groupName != "":
+		g.member, g.group = "group:"+groupName, groupName
+	case userName != "":
+		g.member = "user:" + userName
+	case userPrincipalName != "":
+		g.member = "user:" + userPrincipalName
+	default:
+		return grant{}, false
+	}
+	return g, true
+}
+
+// summary summarizes grants.
+type summary struct {
+	memberCount   int
+	membersByRole map[string]int
+	groups        []string
+	lastCreated   metav1.Time
+}
+
+func summarize(grants []grant) summary {
+	var s summary
+	members := map[string]struct{}{}
+	roleMembers := map[string]map[string]struct{}{}
+	for _, g := range grants {
+		if s.lastCreated.Before(&g.created) {
+			s.lastCreated = g.created
+		}
+		members[g.member] = struct{}{}
+		if g.group != "" && !slices.Contains(s.groups, g.group) {
+			s.groups = append(s.groups, g.group)
+		}
+		if roleMembers[g.role] == nil {
+			roleMembers[g.role] = map[string]struct{}{}
+		}
+		roleMembers[g.role][g.member] = struct{}{}
+	}
+
+	s.memberCount = len(members)
+	if len(roleMembers) > 0 {
+		s.membersByRole = make(map[string]int, len(roleMembers))
+		for role, members := range roleMembers {
+			s.membersByRole[role] = len(members)
+		}
+	}
+	slices.Sort(s.groups)
+	return s
+}
+
+// lastMembershipChange returns the time members that changed last changed: now if they were summarized before, or
+// else the creation time of the latest binding, or of the summarized object if it has no bindings.
+func (h *handler) lastMembershipChange(summarized bool, lastCreated, created metav1.Time) metav1.Time {
+	switch {
+	case summarized:
+		return metav1.NewTime(h.now())
+	case !lastCreated.IsZero():
+		return lastCreated
+	default:
+		return created
+	}
+}
@@ -0,0 +1,216 @@
+package membersummary
+
+import (
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var (
+	now     = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	created = metav1.NewTime(now.Add(-24 * time.Hour))
+)
+
+func newPRTB(name, roleTemplate, user, group string) *v3.ProjectRoleTemplateBinding {
+	return &v3.ProjectRoleTemplateBinding{
+		ObjectMeta:         metav1.ObjectMeta{Name: name, Namespace: "c-1-p-1", CreationTimestamp: created},
+		ProjectName:        "c-1:p-1",
+		RoleTemplateName:   roleTemplate,
+		UserName:           user,
+		GroupPrincipalName: group,
+	}
+}
+
+func TestOnProjectChange(t *testing.T) {
+	deleting := newPRTB("prtb-4", "project-member", "u-3", "")
+	deleting.DeletionTimestamp = &metav1.Time{Time: now}
+	serviceAccount := newPRTB("prtb-5", "project-member", "", "")
+	serviceAccount.ServiceAccount = "pipeline"
+	prtbs := []*v3.ProjectRoleTemplateBinding{
+		newPRTB("prtb-1", "project-owner", "u-1", ""),
+		newPRTB("prtb-2", "project-member", "u-1", ""),
+		newPRTB("prtb-3", "project-member", "", "github_team://2"),
+		newPRTB("prtb-6", "read-only", "", "github_team://1"),
+		deleting,
+		serviceAccount,
+	}
+	summary := &v3.ProjectMemberSummary{
+		MemberCount: 3,
+		MembersByRole: map[string]int{
+			"project-owner":  1,
+			"project-member": 2,
+			"read-only":      1,
+		},
+		Groups: []string{"github_team://1", "github_team://2"},
+	}
+	withTime := func(summary *v3.ProjectMemberSummary, lastChange metav1.Time) *v3.ProjectMemberSummary {
+		summary = summary.DeepCopy()
+		summary.LastMembershipChange = lastChange
+		return summary
+	}
+
+	tests := []struct {
+		name        string
+		current     *v3.ProjectMemberSummary
+		prtbs       []*v3.ProjectRoleTemplateBinding
+		wantSummary *v3.ProjectMemberSummary
+	}{
+		{
+			name:        "first summary dates from the latest binding",
+			prtbs:       prtbs,
+			wantSummary: withTime(summary, created),
+		},
+		{
+			name:        "first summary of a project without members dates from the project",
+			wantSummary: &v3.ProjectMemberSummary{LastMembershipChange: metav1.NewTime(now.Add(-48 * time.Hour))},
+		},
+		{
+			name:    "unchanged summary isn't updated",
+			current: withTime(summary, created),
+			prtbs:   prtbs,
+		},
+		{
+			name:    "changed summary is updated",
+			current: withTime(summary, created),
+			prtbs:   prtbs[:1],
+			wantSummary: &v3.ProjectMemberSummary{
+				MemberCount:          1,
+				MembersByRole:        map[string]int{"project-owner": 1},
+				LastMembershipChange: metav1.NewTime(now),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			prtbCache := fake.NewMockCacheInterface[*v3.ProjectRoleTemplateBinding](ctrl)
+			prtbCache.EXPECT().GetByIndex(prtbByProjectIndex, "c-1:p-1").Return(tt.prtbs, nil).AnyTimes()
+
+			projects := fake.NewMockControllerInterface[*v3.Project, *v3.ProjectList](ctrl)
+			var updated *v3.Project
+			projects.EXPECT().Update(gomock.Any()).DoAndReturn(func(project *v3.Project) (*v3.Project, error) {
+				updated = project
+				return project, nil
+			}).AnyTimes()
+
+			h := &handler{
+				projects:  projects,
+				prtbCache: prtbCache,
+				now:       func() time.Time { return now },
+			}
+			project := &v3.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "p-1", Namespace: "c-1", CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour))},
+				Status:     v3.ProjectStatus{MemberSummary: tt.current},
+			}
+
+			_, err := h.onProjectChange("", project)
+			require.NoError(t, err)
+			if tt.wantSummary == nil {
+				assert.Nil(t, updated)
+				return
+			}
+			require.NotNil(t, updated)
+			assert.Equal(t, tt.wantSummary, updated.Status.MemberSummary)
+		})
+	}
+}
+
+func TestOnClusterChange(t *testing.T) {
+	newCRTB := func(name, roleTemplate, user, group string) *v3.ClusterRoleTemplateBinding {
+		return &v3.ClusterRoleTemplateBinding{
+			ObjectMeta:         metav1.ObjectMeta{Name: name, Namespace: "c-1", CreationTimestamp: created},
+			ClusterName:        "c-1",
+			RoleTemplateName:   roleTemplate,
+			UserName:           user,
+			GroupPrincipalName: group,
+		}
+	}
+	deleting := newCRTB("crtb-4", "cluster-owner", "u-3", "")
+	deleting.DeletionTimestamp = &metav1.Time{Time: now}
+	crtbs := []*v3.ClusterRoleTemplateBinding{
+		newCRTB("crtb-1", "cluster-owner", "u-1", ""),
+		newCRTB("crtb-2", "cluster-owner", "", "github_team://1"),
+		newCRTB("crtb-3", "cluster-member", "u-2", ""),
+		deleting,
+	}
+	summary := &v3.ClusterMemberSummary{
+		MemberCount: 3,
+		OwnerCount:  2,
+		MembersByRole: map[string]int{
+			"cluster-owner":  2,
+			"cluster-member": 1,
+		},
+		Groups:               []string{"github_team://1"},
+		LastMembershipChange: created,
+	}
+
+	tests := []struct {
+		name        string
+		current     *v3.ClusterMemberSummary
+		crtbs       []*v3.ClusterRoleTemplateBinding
+		wantSummary *v3.ClusterMemberSummary
+	}{
+		{
+			name:        "first summary dates from the latest binding",
+			crtbs:       crtbs,
+			wantSummary: summary,
+		},
+		{
+			name:    "unchanged summary isn't updated",
+			current: summary,
+			crtbs:   crtbs,
+		},
+		{
+			name:    "changed summary is updated",
+			current: summary,
+			crtbs:   crtbs[2:],
+			wantSummary: &v3.ClusterMemberSummary{
+				MemberCount:          1,
+				MembersByRole:        map[string]int{"cluster-member": 1},
+				LastMembershipChange: metav1.NewTime(now),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+			crtbCache.EXPECT().List("c-1", labels.Everything()).Return(tt.crtbs, nil).AnyTimes()
+
+			clusters := fake.NewMockNonNamespacedControllerInterface[*v3.Cluster, *v3.ClusterList](ctrl)
+			var updated *v3.Cluster
+			clusters.EXPECT().Update(gomock.Any()).DoAndReturn(func(cluster *v3.Cluster) (*v3.Cluster, error) {
+				updated = cluster
+				return cluster, nil
+			}).AnyTimes()
+
+			h := &handler{
+				clusters:  clusters,
+				crtbCache: crtbCache,
+				now:       func() time.Time { return now },
+			}
+			cluster := &v3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "c-1", CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour))},
+				Status:     v3.ClusterStatus{MemberSummary: tt.current},
+			}
+
+			_, err := h.onClusterChange("", cluster)
+			require.NoError(t, err)
+			if tt.wantSummary == nil {
+				assert.Nil(t, updated)
+				return
+			}
+			require.NotNil(t, updated)
+			assert.Equal(t, tt.wantSummary, updated.Status.MemberSummary)
+		})
+	}
+}
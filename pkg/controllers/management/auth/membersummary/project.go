@@ -0,0 +1,73 @@
+package membersummary
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// prtbByProject indexes PRTBs by the name of their project, in the form <cluster>:<project>.
+func prtbByProject(prtb *v3.ProjectRoleTemplateBinding) ([]string, error) {
+	if prtb.ProjectName == "" {
+		return nil, nil
+	}
+	return []string{prtb.ProjectName}, nil
+}
+
+// enqueueProject enqueues the project of a PRTB when it changes. PRTBs are deleted through a finalizer, their project
+// is enqueued when they are marked for deletion.
+func enqueueProject(_, _ string, obj runtime.Object) ([]relatedresource.Key, error) {
+	prtb, ok := obj.(*v3.ProjectRoleTemplateBinding)
+	if !ok {
+		return nil, nil
+	}
+	clusterName, projectName, ok := strings.Cut(prtb.ProjectName, ":")
+	if !ok {
+		return nil, nil
+	}
+	return []relatedresource.Key{{Namespace: clusterName, Name: projectName}}, nil
+}
+
+func (h *handler) onProjectChange(_ string, project *v3.Project) (*v3.Project, error) {
+	if project == nil || project.DeletionTimestamp != nil {
+		return project, nil
+	}
+
+	prtbs, err := h.prtbCache.GetByIndex(prtbByProjectIndex, project.Namespace+":"+project.Name)
+	if err != nil {
+		return project, fmt.Errorf("listing the bindings of project %s: %w", project.Name, err)
+	}
+	var grants []grant
+	for _, prtb := range prtbs {
+		// PRTBs of service accounts grant access to pipelines, not to members.
+		if prtb.ServiceAccount != "" {
+			continue
+		}
+		if g, ok := newGrant(prtb.ObjectMeta, prtb.RoleTemplateName, prtb.UserName, prtb.UserPrincipalName, prtb.GroupName, prtb.GroupPrincipalName); ok {
+			grants = append(grants, g)
+		}
+	}
+
+	s := summarize(grants)
+	memberSummary := &v3.ProjectMemberSummary{
+		MemberCount:   s.memberCount,
+		MembersByRole: s.membersByRole,
+		Groups:        s.groups,
+	}
+	current := project.Status.MemberSummary
+	if current != nil {
+		memberSummary.LastMembershipChange = current.LastMembershipChange
+		if reflect.DeepEqual(current, memberSummary) {
+			return project, nil
+		}
+	}
+	memberSummary.LastMembershipChange = h.lastMembershipChange(current != nil, s.lastCreated, project.CreationTimestamp)
+
+	project = project.DeepCopy()
+	project.Status.MemberSummary = memberSummary
+	return h.projects.Update(project)
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"fmt"
+
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	"github.com/rancher/rancher/pkg/types/config"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+const principalMigrationController = "mgmt-auth-principal-migration-controller"
+
+type principalMigrator interface {
+	Migrate(crtbs []*apiv3.ClusterRoleTemplateBinding, prtbs []*apiv3.ProjectRoleTemplateBinding, rewrite principalmigration.Rewrite, dryRun bool) principalmigration.Result
+}
+
+// principalMigrationHandler migrates the bindings of a user when the principal IDs of the user change, e.g. after
+// an auth provider migration, from the principal IDs the user no longer has to the ones only differing in their
+// prefix.
+type principalMigrationHandler struct {
+	crtbIndexer cache.Indexer
+	prtbIndexer cache.Indexer
+	migrator    principalMigrator
+}
+
+func newPrincipalMigrationHandler(management *config.ManagementContext) *principalMigrationHandler {
+	return &principalMigrationHandler{
+		crtbIndexer: management.Management.ClusterRoleTemplateBindings("").Controller().Informer().GetIndexer(),
+		prtbIndexer: management.Management.ProjectRoleTemplateBindings("").Controller().Informer().GetIndexer(),
+		migrator:    principalmigration.NewMigrator(management.Wrangler),
+	}
+}
+
+func (h *principalMigrationHandler) sync(_ string, user *apiv3.User) (runtime.Object, error) {
+	if user == nil || user.DeletionTimestamp != nil {
+		return user, nil
+	}
+
+	crtbObjs, err := h.crtbIndexer.ByIndex(crtbByUserRefKey, user.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster role template bindings of user %s: %w", user.Name, err)
+	}
+	crtbs := make([]*apiv3.ClusterRoleTemplateBinding, 0, len(crtbObjs))
+	for _, obj := range crtbObjs {
+		if crtb, ok := obj.(*apiv3.ClusterRoleTemplateBinding); ok {
+			crtbs = append(crtbs, crtb)
+		}
+	}
+
+	prtbObjs, err := h.prtbIndexer.ByIndex(prtbByUserRefKey, user.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project role template bindings of user %s: %w", user.Name, err)
+	}
+	prtbs := make([]*apiv3.ProjectRoleTemplateBinding, 0, len(prtbObjs))
+	for _, obj := range prtbObjs {
+		if prtb, ok := obj.(*apiv3.ProjectRoleTemplateBinding); ok {
+			prtbs = append(prtbs, prtb)
+		}
+	}
+
+	result := h.migrator.Migrate(crtbs, prtbs, principalmigration.UserRewrite(user), false)
+	if len(result.Failed) > 0 {
+		return nil, fmt.Errorf("failed to migrate the principal of bindings %v of user %s", result.Failed, user.Name)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"testing"
+
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+type fakePrincipalMigrator struct {
+	crtbs  []*apiv3.ClusterRoleTemplateBinding
+	prtbs  []*apiv3.ProjectRoleTemplateBinding
+	result principalmigration.Result
+}
+
+func (f *fakePrincipalMigrator) Migrate(crtbs []*apiv3.ClusterRoleTemplateBinding, prtbs []*apiv3.ProjectRoleTemplateBinding, rewrite principalmigration.Rewrite, dryRun bool) principalmigration.Result {
+	for _, crtb := range crtbs {
+		if _, ok := rewrite(crtb.UserPrincipalName); ok {
+			f.crtbs = append(f.crtbs, crtb)
+		}
+	}
+	for _, prtb := range prtbs {
+		if _, ok := rewrite(prtb.UserPrincipalName); ok {
+			f.prtbs = append(f.prtbs, prtb)
+		}
+	}
+	return f.result
+}
+
+func TestPrincipalMigrationHandlerSync(t *testing.T) {
+	crtbIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{crtbByUserRefKey: crtbByUserRefFunc})
+	prtbIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{prtbByUserRefKey: prtbByUserRefFunc})
+	require.NoError(t, crtbIndexer.Add(&apiv3.ClusterRoleTemplateBinding{
+		ObjectMeta:        metav1.ObjectMeta{Name: "crtb-old", Namespace: "c-1"},
+		UserName:          "u-bob",
+		UserPrincipalName: "openldap_user://uid=bob",
+	}))
+	require.NoError(t, crtbIndexer.Add(&apiv3.ClusterRoleTemplateBinding{
+		ObjectMeta:        metav1.ObjectMeta{Name: "crtb-other", Namespace: "c-1"},
+		UserName:          "u-alice",
+		UserPrincipalName: "openldap_user://uid=bob",
+	}))
+	require.NoError(t, prtbIndexer.Add(&apiv3.ProjectRoleTemplateBinding{
+		ObjectMeta:        metav1.ObjectMeta{Name: "prtb-current", Namespace: "p-1"},
+		UserName:          "u-bob",
+		UserPrincipalName: "activedirectory_user://uid=bob",
+	}))
+
+	user := &apiv3.User{
+		ObjectMeta:   metav1.ObjectMeta{Name: "u-bob"},
+		PrincipalIDs: []string{"local://u-bob", "activedirectory_user://uid=bob"},
+	}
+
+	migrator := &fakePrincipalMigrator{}
+	h := &principalMigrationHandler{crtbIndexer: crtbIndexer, prtbIndexer: prtbIndexer, migrator: migrator}
+	_, err := h.sync("", user)
+	require.NoError(t, err)
+	require.Len(t, migrator.crtbs, 1)
+	assert.Equal(t, "crtb-old", migrator.crtbs[0].Name)
+	assert.Empty(t, migrator.prtbs)
+
+	// failures are retried
+	migrator.result.Failed = []string{"c-1/crtb-old"}
+	_, err = h.sync("", user)
+	assert.Error(t, err)
+}
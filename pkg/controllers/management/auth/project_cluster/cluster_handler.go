@@ -153,6 +153,10 @@ func (l *clusterLifecycle) Remove(obj *apisv3.Cluster) (runtime.Object, error) {
 		return obj, generic.ErrSkip
 	}
 
+	if err := l.reconcileBindingsForDelete(obj); err != nil {
+		return obj, err
+	}
+
 	returnErr := errors.Join(
 		l.deleteSystemProject(obj, ClusterRemoveController),
 		deleteNamespace(ClusterRemoveController, obj.Name, l.nsClient),
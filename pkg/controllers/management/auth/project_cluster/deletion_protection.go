@@ -0,0 +1,94 @@
+package project_cluster
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apisv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/v3/pkg/generic"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// ForceDeleteWithBindingsAnnotation lets a cluster be deleted while it has bindings, when the
+	// cluster-deletion-binding-protection setting is "block".
+	ForceDeleteWithBindingsAnnotation = "management.cattle.io/force-delete-with-bindings"
+
+	// DeletionBindingProtectionBlock blocks the deletion of clusters while they have bindings.
+	DeletionBindingProtectionBlock = "block"
+	// DeletionBindingProtectionCascade deletes the bindings of clusters before the clusters.
+	DeletionBindingProtectionCascade = "cascade"
+
+	// cascadeRequeueInterval is how often the deletion of the bindings of a cluster is checked in cascade mode.
+	cascadeRequeueInterval = 5 * time.Second
+)
+
+// ValidateDeletionBindingProtection returns an error if the value isn't a valid value of the
+// cluster-deletion-binding-protection setting.
+func ValidateDeletionBindingProtection(value string) error {
+	switch value {
+	case "", DeletionBindingProtectionBlock, DeletionBindingProtectionCascade:
+		return nil
+	}
+	return fmt.Errorf("invalid value %q, valid values are %q and %q, or empty", value, DeletionBindingProtectionBlock, DeletionBindingProtectionCascade)
+}
+
+// reconcileBindingsForDelete applies the cluster-deletion-binding-protection setting to a cluster being deleted. It
+// returns an error while the deletion of the cluster is blocked by its bindings, and generic.ErrSkip while its
+// bindings are being deleted.
+func (l *clusterLifecycle) reconcileBindingsForDelete(cluster *apisv3.Cluster) error {
+	mode := settings.ClusterDeletionBindingProtection.Get()
+	if mode == "" {
+		return nil
+	}
+
+	crtbs, err := l.crtbLister.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return fmt.Errorf("listing the bindings of cluster %s: %w", cluster.Name, err)
+	}
+
+	switch mode {
+	case DeletionBindingProtectionBlock:
+		if cluster.Annotations[ForceDeleteWithBindingsAnnotation] == "true" {
+			return nil
+		}
+		var blocking []string
+		for _, crtb := range crtbs {
+			// The bindings of the creator of the cluster are created with it, they don't block its deletion.
+			if crtb.DeletionTimestamp == nil && crtb.Annotations[creatorOwnerBindingAnnotation] != "true" {
+				blocking = append(blocking, crtb.Name)
+			}
+		}
+		if len(blocking) == 0 {
+			return nil
+		}
+		slices.Sort(blocking)
+		return fmt.Errorf("deletion of cluster %s is blocked by its ClusterRoleTemplateBindings %s: delete them, or set the annotation %s to \"true\" to delete the cluster anyway",
+			cluster.Name, strings.Join(blocking, ", "), ForceDeleteWithBindingsAnnotation)
+	case DeletionBindingProtectionCascade:
+		if len(crtbs) == 0 {
+			return nil
+		}
+		for _, crtb := range crtbs {
+			if crtb.DeletionTimestamp != nil {
+				continue
+			}
+			logrus.Infof("[%s] Deleting ClusterRoleTemplateBinding %s of cluster %s being deleted", ClusterRemoveController, crtb.Name, cluster.Name)
+			if err := l.crtbClient.Delete(crtb.Namespace, crtb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("deleting binding %s of cluster %s: %w", crtb.Name, cluster.Name, err)
+			}
+		}
+		logrus.Debugf("[%s] Waiting for the %d ClusterRoleTemplateBindings of cluster %s to be deleted", ClusterRemoveController, len(crtbs), cluster.Name)
+		l.clusterClient.EnqueueAfter(cluster.Name, cascadeRequeueInterval)
+		return generic.ErrSkip
+	default:
+		logrus.Errorf("[%s] Ignoring invalid value %q of setting %s", ClusterRemoveController, mode, settings.ClusterDeletionBindingProtection.Name)
+		return nil
+	}
+}
@@ -0,0 +1,130 @@
+package project_cluster
+
+import (
+	"testing"
+	"time"
+
+	apisv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/v3/pkg/generic"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func newCRTB(name string, annotations map[string]string, deleting bool) *apisv3.ClusterRoleTemplateBinding {
+	crtb := &apisv3.ClusterRoleTemplateBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: clusterName, Annotations: annotations},
+	}
+	if deleting {
+		crtb.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	}
+	return crtb
+}
+
+func TestReconcileBindingsForDelete(t *testing.T) {
+	creatorCRTB := newCRTB("creator-cluster-owner", map[string]string{creatorOwnerBindingAnnotation: "true"}, false)
+	memberCRTB := newCRTB("crtb-member", nil, false)
+	ownerCRTB := newCRTB("crtb-owner", nil, false)
+	deletingCRTB := newCRTB("crtb-deleting", nil, true)
+
+	tests := []struct {
+		name         string
+		mode         string
+		annotations  map[string]string
+		crtbs        []*apisv3.ClusterRoleTemplateBinding
+		wantErr      string
+		wantSkip     bool
+		wantDeleted  []string
+		wantEnqueued bool
+	}{
+		{
+			name:  "bindings are ignored without protection",
+			mode:  "",
+			crtbs: []*apisv3.ClusterRoleTemplateBinding{memberCRTB},
+		},
+		{
+			name:    "block mode blocks on bindings other than the creator's",
+			mode:    DeletionBindingProtectionBlock,
+			crtbs:   []*apisv3.ClusterRoleTemplateBinding{ownerCRTB, creatorCRTB, memberCRTB, deletingCRTB},
+			wantErr: "deletion of cluster test-cluster is blocked by its ClusterRoleTemplateBindings crtb-member, crtb-owner",
+		},
+		{
+			name:  "block mode doesn't block on the creator's binding",
+			mode:  DeletionBindingProtectionBlock,
+			crtbs: []*apisv3.ClusterRoleTemplateBinding{creatorCRTB, deletingCRTB},
+		},
+		{
+			name:        "block mode is overridden by the annotation",
+			mode:        DeletionBindingProtectionBlock,
+			annotations: map[string]string{ForceDeleteWithBindingsAnnotation: "true"},
+			crtbs:       []*apisv3.ClusterRoleTemplateBinding{memberCRTB},
+		},
+		{
+			name:         "cascade mode deletes the bindings and waits for them",
+			mode:         DeletionBindingProtectionCascade,
+			crtbs:        []*apisv3.ClusterRoleTemplateBinding{creatorCRTB, memberCRTB, deletingCRTB},
+			wantSkip:     true,
+			wantDeleted:  []string{"creator-cluster-owner", "crtb-member"},
+			wantEnqueued: true,
+		},
+		{
+			name: "cascade mode completes once the bindings are deleted",
+			mode: DeletionBindingProtectionCascade,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, settings.ClusterDeletionBindingProtection.Set(tt.mode))
+			t.Cleanup(func() { settings.ClusterDeletionBindingProtection.Set("") })
+
+			ctrl := gomock.NewController(t)
+			crtbLister := fake.NewMockCacheInterface[*apisv3.ClusterRoleTemplateBinding](ctrl)
+			crtbLister.EXPECT().List(clusterName, labels.Everything()).Return(tt.crtbs, nil).AnyTimes()
+
+			crtbClient := fake.NewMockControllerInterface[*apisv3.ClusterRoleTemplateBinding, *apisv3.ClusterRoleTemplateBindingList](ctrl)
+			var deleted []string
+			crtbClient.EXPECT().Delete(clusterName, gomock.Any(), gomock.Any()).DoAndReturn(func(_, name string, _ *metav1.DeleteOptions) error {
+				deleted = append(deleted, name)
+				return nil
+			}).AnyTimes()
+
+			clusterClient := fake.NewMockNonNamespacedControllerInterface[*apisv3.Cluster, *apisv3.ClusterList](ctrl)
+			var enqueued bool
+			clusterClient.EXPECT().EnqueueAfter(clusterName, cascadeRequeueInterval).Do(func(string, time.Duration) {
+				enqueued = true
+			}).AnyTimes()
+
+			l := &clusterLifecycle{
+				crtbLister:    crtbLister,
+				crtbClient:    crtbClient,
+				clusterClient: clusterClient,
+			}
+			cluster := &apisv3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Annotations: tt.annotations}}
+
+			err := l.reconcileBindingsForDelete(cluster)
+			switch {
+			case tt.wantErr != "":
+				assert.ErrorContains(t, err, tt.wantErr)
+			case tt.wantSkip:
+				assert.ErrorIs(t, err, generic.ErrSkip)
+			default:
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantDeleted, deleted)
+			assert.Equal(t, tt.wantEnqueued, enqueued)
+		})
+	}
+}
+
+func TestValidateDeletionBindingProtection(t *testing.T) {
+	for _, value := range []string{"", "block", "cascade"} {
+		assert.NoError(t, ValidateDeletionBindingProtection(value))
+	}
+	assert.Error(t, ValidateDeletionBindingProtection("Block"))
+	assert.Error(t, ValidateDeletionBindingProtection("delete"))
+}
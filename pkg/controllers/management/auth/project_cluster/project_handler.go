@@ -9,11 +9,13 @@
 	apisv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/features"
 	v3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
 	"github.com/rancher/rancher/pkg/systemaccount"
 	"github.com/rancher/rancher/pkg/types/config"
 	corev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
 	rbacv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac/v1"
 	"github.com/sirupsen/logrus"
+	k8srbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -142,7 +144,43 @@ func (l *projectLifecycle) Updated(obj *apisv3.Project) (runtime.Object, error)
 // Remove deletes all backing resources created by the project
 func (l *projectLifecycle) Remove(obj *apisv3.Project) (runtime.Object, error) {
 	backingNamespace := obj.GetProjectBackingNamespace()
-	return obj, deleteNamespace(ProjectRemoveController, backingNamespace, l.nsClient)
+	if err := deleteNamespace(ProjectRemoveController, backingNamespace, l.nsClient); err != nil {
+		return obj, err
+	}
+	return obj, l.deleteCRTBRoleBindings(backingNamespace)
+}
+
+// deleteCRTBRoleBindings deletes the RoleBindings granted by the CRTBs of the cluster in the backing namespace of the
+// project. They are normally removed along with the namespace, but the CRTB controller can race the namespace removal,
+// and the namespace can outlive the project. Once the namespace is terminating, they can't be created again.
+func (l *projectLifecycle) deleteCRTBRoleBindings(namespace string) error {
+	rbs, err := l.roleBindings.List(namespace, metav1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, rb := range rbs.Items {
+		if !isCRTBRoleBinding(&rb) {
+			continue
+		}
+		logrus.Infof("[%s] Deleting roleBinding %s/%s", ProjectRemoveController, rb.Namespace, rb.Name)
+		if err := l.roleBindings.Delete(rb.Namespace, rb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// isCRTBRoleBinding returns true if the RoleBinding is owned by a CRTB, which labels it with the
+// rbac.CrtbInProjectBindingOwner value under its rbac.GetRTBLabel key, i.e. namespace_name.
+func isCRTBRoleBinding(rb *k8srbacv1.RoleBinding) bool {
+	for _, value := range rb.Labels {
+		if value == rbac.CrtbInProjectBindingOwner {
+			return true
+		}
+	}
+	return false
 }
 
 func (l *projectLifecycle) reconcileProjectCreatorRTB(obj runtime.Object, nsName string) (runtime.Object, error) {
@@ -1,13 +1,18 @@
 package project_cluster
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
 	"github.com/rancher/wrangler/v3/pkg/generic/fake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -113,3 +118,87 @@ func TestReconcileProjectCreatorRTBNoCreatorRBAC(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, obj)
 }
+
+func TestRemoveDeletesCRTBRoleBindings(t *testing.T) {
+	const backingNamespace = "p-abc"
+	roleBindings := []rbacv1.RoleBinding{
+		{ObjectMeta: v1.ObjectMeta{
+			Name:      "rb-crtb",
+			Namespace: backingNamespace,
+			Labels:    map[string]string{"c-abc_crtb-1": rbac.CrtbInProjectBindingOwner},
+		}},
+		{ObjectMeta: v1.ObjectMeta{
+			Name:      "rb-prtb",
+			Namespace: backingNamespace,
+			Labels:    map[string]string{"p-abc_prtb-1": "true"},
+		}},
+		{ObjectMeta: v1.ObjectMeta{
+			Name:      "rb-crtb-deleted",
+			Namespace: backingNamespace,
+			Labels:    map[string]string{"c-abc_crtb-2": rbac.CrtbInProjectBindingOwner},
+		}},
+	}
+	project := &v3.Project{
+		ObjectMeta: v1.ObjectMeta{Name: "p-abc", Namespace: clusterID},
+		Status:     v3.ProjectStatus{BackingNamespace: backingNamespace},
+	}
+
+	tests := []struct {
+		name        string
+		deleteNsErr error
+		listErr     error
+		wantDeleted []string
+		wantErr     bool
+	}{
+		{
+			name:        "crtb rolebindings are deleted",
+			wantDeleted: []string{"rb-crtb", "rb-crtb-deleted"},
+		},
+		{
+			name:        "error deleting namespace",
+			deleteNsErr: fmt.Errorf("error"),
+			wantErr:     true,
+		},
+		{
+			name:    "error listing rolebindings",
+			listErr: fmt.Errorf("error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			var deleted []string
+			rbController := fake.NewMockControllerInterface[*rbacv1.RoleBinding, *rbacv1.RoleBindingList](ctrl)
+			rbController.EXPECT().List(backingNamespace, v1.ListOptions{}).Return(&rbacv1.RoleBindingList{Items: roleBindings}, tt.listErr).AnyTimes()
+			rbController.EXPECT().Delete(backingNamespace, gomock.Any(), &v1.DeleteOptions{}).DoAndReturn(
+				func(_, name string, _ *v1.DeleteOptions) error {
+					deleted = append(deleted, name)
+					if name == "rb-crtb-deleted" {
+						return errNotFound
+					}
+					return nil
+				}).AnyTimes()
+			lifecycle := &projectLifecycle{
+				nsClient: mockNamespaces{
+					getter: func(_ context.Context, name string, _ v1.GetOptions) (*corev1.Namespace, error) {
+						assert.Equal(t, backingNamespace, name)
+						return &corev1.Namespace{ObjectMeta: v1.ObjectMeta{Name: name}}, nil
+					},
+					deleter: func(_ context.Context, name string, _ v1.DeleteOptions) error {
+						return tt.deleteNsErr
+					},
+				},
+				roleBindings: rbController,
+			}
+
+			_, err := lifecycle.Remove(project)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
@@ -6,6 +6,7 @@
 	"strings"
 
 	"github.com/rancher/rancher/pkg/controllers/management/authprovisioningv2"
+	controllersv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	typesrbacv1 "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1"
 	pkgrbac "github.com/rancher/rancher/pkg/rbac"
@@ -16,7 +17,6 @@
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -24,10 +24,6 @@
 	ptrbMGMTController = "mgmt-auth-prtb-controller"
 )
 
-var projectManagementPlaneResources = map[string]string{
-	"projectroletemplatebindings": "management.cattle.io",
-	"secrets":                     "",
-}
 var prtbClusterManagmentPlaneResources = map[string]string{}
 
 type prtbLifecycle struct {
@@ -40,7 +36,9 @@ type prtbLifecycle struct {
 	rbClient      typesrbacv1.RoleBindingInterface
 	crbLister     typesrbacv1.ClusterRoleBindingLister
 	crbClient     typesrbacv1.ClusterRoleBindingInterface
-	prtbClient    v3.ProjectRoleTemplateBindingInterface
+	prtbClient    controllersv3.ProjectRoleTemplateBindingController
+	prtbCache     controllersv3.ProjectRoleTemplateBindingCache
+	staleBindings staleBindings
 }
 
 func (p *prtbLifecycle) Create(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
@@ -51,7 +49,10 @@ func (p *prtbLifecycle) Create(obj *v3.ProjectRoleTemplateBinding) (runtime.Obje
 	if err != nil {
 		return nil, err
 	}
-	err = p.reconcileBindings(obj)
+	if err := p.reconcileImmutableFields(obj); err != nil {
+		return nil, err
+	}
+	err = p.reconcileBindingsIfChanged(obj)
 	return obj, err
 }
 
@@ -66,7 +67,10 @@ func (p *prtbLifecycle) Updated(obj *v3.ProjectRoleTemplateBinding) (runtime.Obj
 	if err := p.reconcileLabels(obj); err != nil {
 		return nil, err
 	}
-	err = p.reconcileBindings(obj)
+	if err := p.reconcileImmutableFields(obj); err != nil {
+		return nil, err
+	}
+	err = p.reconcileBindingsIfChanged(obj)
 	return obj, err
 }
 
@@ -191,7 +195,7 @@ func (p *prtbLifecycle) reconcileBindings(binding *v3.ProjectRoleTemplateBinding
 	if err := p.mgr.grantManagementProjectScopedPrivilegesInClusterNamespace(binding.RoleTemplateName, proj.Namespace, prtbClusterManagmentPlaneResources, subject, binding); err != nil {
 		return err
 	}
-	return p.mgr.grantManagementPlanePrivileges(binding.RoleTemplateName, projectManagementPlaneResources, subject, binding)
+	return p.mgr.grantManagementPlanePrivileges(binding.RoleTemplateName, pkgrbac.ProjectManagementPlaneResources(), subject, binding)
 }
 
 // removeMGMTProjectScopedPrivilegesInClusterNamespace revokes access that project roles were granted to certain cluster scoped resources like
@@ -246,19 +250,15 @@ func (p *prtbLifecycle) reconcileLabels(binding *v3.ProjectRoleTemplateBinding)
 		return err
 	}
 	for _, crb := range crbs {
-		retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-			crbToUpdate, updateErr := p.crbClient.Get(crb.Name, v1.GetOptions{})
-			if updateErr != nil {
-				return updateErr
-			}
+		retryErr := updateFromCache(crb, func() (*rbacv1.ClusterRoleBinding, error) {
+			return p.crbClient.Get(crb.Name, v1.GetOptions{})
+		}, func(crbToUpdate *rbacv1.ClusterRoleBinding) {
 			if crbToUpdate.Labels == nil {
 				crbToUpdate.Labels = make(map[string]string)
 			}
 			crbToUpdate.Labels[bindingKey] = MembershipBindingOwner
 			crbToUpdate.Labels[rtbLabelUpdated] = "true"
-			_, err := p.crbClient.Update(crbToUpdate)
-			return err
-		})
+		}, p.crbClient.Update)
 		returnErr = errors.Join(returnErr, retryErr)
 	}
 
@@ -269,19 +269,15 @@ func (p *prtbLifecycle) reconcileLabels(binding *v3.ProjectRoleTemplateBinding)
 			return err
 		}
 		for _, rb := range rbs {
-			retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-				rbToUpdate, updateErr := p.rbClient.GetNamespaced(rb.Namespace, rb.Name, v1.GetOptions{})
-				if updateErr != nil {
-					return updateErr
-				}
+			retryErr := updateFromCache(rb, func() (*rbacv1.RoleBinding, error) {
+				return p.rbClient.GetNamespaced(rb.Namespace, rb.Name, v1.GetOptions{})
+			}, func(rbToUpdate *rbacv1.RoleBinding) {
 				if rbToUpdate.Labels == nil {
 					rbToUpdate.Labels = make(map[string]string)
 				}
 				rbToUpdate.Labels[bindingKey] = prtbLabel
 				rbToUpdate.Labels[rtbLabelUpdated] = "true"
-				_, err := p.rbClient.Update(rbToUpdate)
-				return err
-			})
+			}, p.rbClient.Update)
 			returnErr = errors.Join(returnErr, retryErr)
 		}
 	}
@@ -289,17 +285,17 @@ func (p *prtbLifecycle) reconcileLabels(binding *v3.ProjectRoleTemplateBinding)
 		return returnErr
 	}
 
-	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		prtbToUpdate, updateErr := p.prtbClient.GetNamespaced(binding.Namespace, binding.Name, v1.GetOptions{})
-		if updateErr != nil {
-			return updateErr
-		}
+	prtb, err := p.prtbCache.Get(binding.Namespace, binding.Name)
+	if err != nil {
+		return err
+	}
+	retryErr := updateFromCache(prtb, func() (*v3.ProjectRoleTemplateBinding, error) {
+		return p.prtbClient.Get(binding.Namespace, binding.Name, v1.GetOptions{})
+	}, func(prtbToUpdate *v3.ProjectRoleTemplateBinding) {
 		if prtbToUpdate.Labels == nil {
 			prtbToUpdate.Labels = make(map[string]string)
 		}
 		prtbToUpdate.Labels[RtbCrbRbLabelsUpdated] = "true"
-		_, err := p.prtbClient.Update(prtbToUpdate)
-		return err
-	})
+	}, p.prtbClient.Update)
 	return retryErr
 }
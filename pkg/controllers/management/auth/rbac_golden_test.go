@@ -0,0 +1,360 @@
+package auth
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/controllers/status"
+	normanFakes "github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	fakes "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	rbacFakes "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/yaml"
+)
+
+// updateGolden rewrites the golden files of TestRBACGolden with the RBAC rendered from their fixtures:
+//
+//	go test ./pkg/controllers/management/auth -run TestRBACGolden -update
+var updateGolden = flag.Bool("update", false, "update the golden files of the RBAC rendering tests")
+
+// rbacFixture is a CRTB or PRTB with the RoleTemplates, cluster and projects it refers to, read from
+// testdata/rbac/<name>.yaml. The RBAC it renders is compared against testdata/rbac/<name>.golden.yaml.
+type rbacFixture struct {
+	RoleTemplates []*v3.RoleTemplate             `json:"roleTemplates"`
+	Cluster       *v3.Cluster                    `json:"cluster"`
+	Projects      []*v3.Project                  `json:"projects,omitempty"`
+	CRTB          *v3.ClusterRoleTemplateBinding `json:"crtb,omitempty"`
+	PRTB          *v3.ProjectRoleTemplateBinding `json:"prtb,omitempty"`
+}
+
+// renderedRBAC is all the RBAC in the management cluster after reconciling a fixture, sorted by namespace and name.
+type renderedRBAC struct {
+	ClusterRoles        []rbacv1.ClusterRole        `json:"clusterRoles,omitempty"`
+	ClusterRoleBindings []rbacv1.ClusterRoleBinding `json:"clusterRoleBindings,omitempty"`
+	Roles               []rbacv1.Role               `json:"roles,omitempty"`
+	RoleBindings        []rbacv1.RoleBinding        `json:"roleBindings,omitempty"`
+}
+
+func TestRBACGolden(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "rbac", "*.yaml"))
+	require.NoError(t, err)
+
+	for _, path := range fixtures {
+		if strings.HasSuffix(path, ".golden.yaml") {
+			continue
+		}
+		name := strings.TrimSuffix(filepath.Base(path), ".yaml")
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			fixture := &rbacFixture{}
+			require.NoError(t, yaml.UnmarshalStrict(data, fixture))
+
+			store := newRBACStore(fixture)
+			require.NoError(t, store.reconcile(fixture))
+			got := store.render()
+
+			// Reconciling again must not change anything.
+			require.NoError(t, store.reconcile(fixture))
+			assert.Equal(t, got, store.render(), "reconciling the fixture again changed the RBAC")
+
+			goldenPath := strings.TrimSuffix(path, ".yaml") + ".golden.yaml"
+			if *updateGolden {
+				data, err := yaml.Marshal(got)
+				require.NoError(t, err)
+				require.NoError(t, os.WriteFile(goldenPath, data, 0644))
+				return
+			}
+			data, err = os.ReadFile(goldenPath)
+			require.NoError(t, err, "run the test with -update to create the golden file")
+			want := renderedRBAC{}
+			require.NoError(t, yaml.UnmarshalStrict(data, &want))
+			assert.Equal(t, want, got, "the RBAC differs from %s, run the test with -update if the change is intended", goldenPath)
+		})
+	}
+}
+
+// objectStore is an in-memory store of objects of one kind, indexed like the informers of the controllers.
+type objectStore[T metav1.Object] struct {
+	resource string
+	indexer  cache.Indexer
+}
+
+func newObjectStore[T metav1.Object](resource string, indexers cache.Indexers) *objectStore[T] {
+	return &objectStore[T]{
+		resource: resource,
+		indexer:  cache.NewIndexer(cache.MetaNamespaceKeyFunc, indexers),
+	}
+}
+
+func (s *objectStore[T]) key(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+func (s *objectStore[T]) get(namespace, name string) (T, error) {
+	var zero T
+	obj, ok, err := s.indexer.GetByKey(s.key(namespace, name))
+	if err != nil {
+		return zero, err
+	}
+	if !ok {
+		return zero, apierrors.NewNotFound(schema.GroupResource{Resource: s.resource}, name)
+	}
+	return obj.(T), nil
+}
+
+func (s *objectStore[T]) list(namespace string, selector labels.Selector) []T {
+	var objs []T
+	for _, obj := range s.indexer.List() {
+		obj := obj.(T)
+		if (namespace == "" || obj.GetNamespace() == namespace) && selector.Matches(labels.Set(obj.GetLabels())) {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+func (s *objectStore[T]) create(obj T) (T, error) {
+	var zero T
+	if _, ok, _ := s.indexer.GetByKey(s.key(obj.GetNamespace(), obj.GetName())); ok {
+		return zero, apierrors.NewAlreadyExists(schema.GroupResource{Resource: s.resource}, obj.GetName())
+	}
+	return obj, s.indexer.Add(obj)
+}
+
+func (s *objectStore[T]) update(obj T) (T, error) {
+	var zero T
+	if _, err := s.get(obj.GetNamespace(), obj.GetName()); err != nil {
+		return zero, err
+	}
+	return obj, s.indexer.Update(obj)
+}
+
+func (s *objectStore[T]) delete(namespace, name string) error {
+	obj, err := s.get(namespace, name)
+	if err != nil {
+		return err
+	}
+	return s.indexer.Delete(obj)
+}
+
+// rbacStore is the RBAC of the management cluster, backing the listers, clients and indexers of the CRTB and PRTB
+// lifecycles.
+type rbacStore struct {
+	clusterRoles        *objectStore[*rbacv1.ClusterRole]
+	clusterRoleBindings *objectStore[*rbacv1.ClusterRoleBinding]
+	roles               *objectStore[*rbacv1.Role]
+	roleBindings        *objectStore[*rbacv1.RoleBinding]
+
+	mgr           *manager
+	clusterLister *fakes.ClusterListerMock
+	projectLister *fakes.ProjectListerMock
+}
+
+func newRBACStore(fixture *rbacFixture) *rbacStore {
+	s := &rbacStore{
+		clusterRoles: newObjectStore[*rbacv1.ClusterRole]("clusterroles", nil),
+		clusterRoleBindings: newObjectStore[*rbacv1.ClusterRoleBinding]("clusterrolebindings", cache.Indexers{
+			rbByRoleAndSubjectIndex: func(obj interface{}) ([]string, error) {
+				return rbByClusterRoleAndSubject(obj.(*rbacv1.ClusterRoleBinding))
+			},
+			membershipBindingOwnerIndex: indexByMembershipBindingOwner,
+		}),
+		roles: newObjectStore[*rbacv1.Role]("roles", nil),
+		roleBindings: newObjectStore[*rbacv1.RoleBinding]("rolebindings", cache.Indexers{
+			rbByOwnerIndex: func(obj interface{}) ([]string, error) {
+				return rbByOwner(obj.(*rbacv1.RoleBinding))
+			},
+			rbByRoleAndSubjectIndex: func(obj interface{}) ([]string, error) {
+				return rbByRoleAndSubject(obj.(*rbacv1.RoleBinding))
+			},
+			membershipBindingOwnerIndex: indexByMembershipBindingOwner,
+		}),
+	}
+
+	roleTemplates := map[string]*v3.RoleTemplate{}
+	for _, rt := range fixture.RoleTemplates {
+		roleTemplates[rt.Name] = rt
+	}
+	fixture.Cluster.TypeMeta = metav1.TypeMeta{APIVersion: "management.cattle.io/v3", Kind: "Cluster"}
+	for _, project := range fixture.Projects {
+		project.TypeMeta = metav1.TypeMeta{APIVersion: "management.cattle.io/v3", Kind: "Project"}
+	}
+	if fixture.CRTB != nil {
+		fixture.CRTB.TypeMeta = metav1.TypeMeta{APIVersion: "management.cattle.io/v3", Kind: "ClusterRoleTemplateBinding"}
+	}
+	if fixture.PRTB != nil {
+		fixture.PRTB.TypeMeta = metav1.TypeMeta{APIVersion: "management.cattle.io/v3", Kind: "ProjectRoleTemplateBinding"}
+	}
+
+	s.clusterLister = &fakes.ClusterListerMock{
+		GetFunc: func(_, name string) (*v3.Cluster, error) {
+			if name != fixture.Cluster.Name {
+				return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, name)
+			}
+			return fixture.Cluster, nil
+		},
+	}
+	s.projectLister = &fakes.ProjectListerMock{
+		GetFunc: func(namespace, name string) (*v3.Project, error) {
+			for _, project := range fixture.Projects {
+				if project.Namespace == namespace && project.Name == name {
+					return project, nil
+				}
+			}
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "projects"}, name)
+		},
+		ListFunc: func(namespace string, _ labels.Selector) ([]*v3.Project, error) {
+			var projects []*v3.Project
+			for _, project := range fixture.Projects {
+				if project.Namespace == namespace {
+					projects = append(projects, project)
+				}
+			}
+			return projects, nil
+		},
+	}
+
+	s.mgr = &manager{
+		crLister: &rbacFakes.ClusterRoleListerMock{
+			GetFunc: func(_, name string) (*rbacv1.ClusterRole, error) {
+				return s.clusterRoles.get("", name)
+			},
+		},
+		crClient: &rbacFakes.ClusterRoleInterfaceMock{
+			CreateFunc: s.clusterRoles.create,
+		},
+		crbClient: &rbacFakes.ClusterRoleBindingInterfaceMock{
+			CreateFunc: s.clusterRoleBindings.create,
+			GetFunc: func(name string, _ metav1.GetOptions) (*rbacv1.ClusterRoleBinding, error) {
+				return s.clusterRoleBindings.get("", name)
+			},
+			UpdateFunc: s.clusterRoleBindings.update,
+			DeleteFunc: func(name string, _ *metav1.DeleteOptions) error {
+				return s.clusterRoleBindings.delete("", name)
+			},
+		},
+		rLister: &rbacFakes.RoleListerMock{
+			GetFunc: s.roles.get,
+		},
+		rClient: &rbacFakes.RoleInterfaceMock{
+			CreateFunc: s.roles.create,
+			UpdateFunc: s.roles.update,
+		},
+		rbLister: &rbacFakes.RoleBindingListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*rbacv1.RoleBinding, error) {
+				return s.roleBindings.list(namespace, selector), nil
+			},
+		},
+		rbClient: &rbacFakes.RoleBindingInterfaceMock{
+			CreateFunc: s.roleBindings.create,
+			GetNamespacedFunc: func(namespace, name string, _ metav1.GetOptions) (*rbacv1.RoleBinding, error) {
+				return s.roleBindings.get(namespace, name)
+			},
+			UpdateFunc: s.roleBindings.update,
+			DeleteNamespacedFunc: func(namespace, name string, _ *metav1.DeleteOptions) error {
+				return s.roleBindings.delete(namespace, name)
+			},
+		},
+		rtLister: &fakes.RoleTemplateListerMock{
+			GetFunc: func(_, name string) (*v3.RoleTemplate, error) {
+				rt, ok := roleTemplates[name]
+				if !ok {
+					return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "roletemplates"}, name)
+				}
+				return rt, nil
+			},
+		},
+		nsLister: &normanFakes.NamespaceListerMock{
+			GetFunc: func(_, name string) (*corev1.Namespace, error) {
+				return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+			},
+		},
+		rbIndexer:  s.roleBindings.indexer,
+		crbIndexer: s.clusterRoleBindings.indexer,
+		controller: "rbac-golden-test",
+	}
+	return s
+}
+
+// reconcile reconciles the bindings of the CRTB or PRTB of the fixture.
+func (s *rbacStore) reconcile(fixture *rbacFixture) error {
+	if fixture.CRTB != nil {
+		crtb := &crtbLifecycle{
+			mgr:           s.mgr,
+			clusterLister: s.clusterLister,
+			projectLister: s.projectLister,
+			s:             status.NewStatus(),
+		}
+		var conditions []metav1.Condition
+		return crtb.reconcileBindings(fixture.CRTB, &conditions)
+	}
+	prtb := &prtbLifecycle{
+		mgr:           s.mgr,
+		clusterLister: s.clusterLister,
+		projectLister: s.projectLister,
+	}
+	return prtb.reconcileBindings(fixture.PRTB)
+}
+
+// render returns all the RBAC in the store. The order of the rules of a role doesn't matter, they are sorted by
+// resource to compare them.
+func (s *rbacStore) render() renderedRBAC {
+	var rendered renderedRBAC
+	for _, cr := range s.clusterRoles.list("", labels.Everything()) {
+		cr = cr.DeepCopy()
+		sortRules(cr.Rules)
+		rendered.ClusterRoles = append(rendered.ClusterRoles, *cr)
+	}
+	for _, crb := range s.clusterRoleBindings.list("", labels.Everything()) {
+		rendered.ClusterRoleBindings = append(rendered.ClusterRoleBindings, *crb.DeepCopy())
+	}
+	for _, role := range s.roles.list("", labels.Everything()) {
+		role = role.DeepCopy()
+		sortRules(role.Rules)
+		rendered.Roles = append(rendered.Roles, *role)
+	}
+	for _, rb := range s.roleBindings.list("", labels.Everything()) {
+		rendered.RoleBindings = append(rendered.RoleBindings, *rb.DeepCopy())
+	}
+
+	sort.Slice(rendered.ClusterRoles, func(i, j int) bool {
+		return rendered.ClusterRoles[i].Name < rendered.ClusterRoles[j].Name
+	})
+	sort.Slice(rendered.ClusterRoleBindings, func(i, j int) bool {
+		return rendered.ClusterRoleBindings[i].Name < rendered.ClusterRoleBindings[j].Name
+	})
+	sort.Slice(rendered.Roles, func(i, j int) bool {
+		return objectKey(&rendered.Roles[i]) < objectKey(&rendered.Roles[j])
+	})
+	sort.Slice(rendered.RoleBindings, func(i, j int) bool {
+		return objectKey(&rendered.RoleBindings[i]) < objectKey(&rendered.RoleBindings[j])
+	})
+	return rendered
+}
+
+func objectKey(obj metav1.Object) string {
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+func sortRules(rules []rbacv1.PolicyRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return strings.Join(rules[i].Resources, ",") < strings.Join(rules[j].Resources, ",")
+	})
+}
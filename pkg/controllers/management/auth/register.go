@@ -3,13 +3,21 @@
 import (
 	"context"
 
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/clustermanager"
+	"github.com/rancher/rancher/pkg/controllers/management/auth/bootstrapprincipals"
+	"github.com/rancher/rancher/pkg/controllers/management/auth/globalrolegroupmappings"
 	"github.com/rancher/rancher/pkg/controllers/management/auth/globalroles"
+	"github.com/rancher/rancher/pkg/controllers/management/auth/membersummary"
 	"github.com/rancher/rancher/pkg/controllers/management/auth/project_cluster"
+	"github.com/rancher/rancher/pkg/controllers/management/auth/roletemplatepolicies"
 	"github.com/rancher/rancher/pkg/controllers/management/auth/roletemplates"
+	"github.com/rancher/rancher/pkg/controllers/management/auth/tokenaudit"
 	"github.com/rancher/rancher/pkg/features"
+	"github.com/rancher/rancher/pkg/metrics"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/rbac/v1"
 	"k8s.io/client-go/tools/cache"
 )
@@ -54,13 +62,19 @@ func RegisterIndexers(scaledContext *config.ScaledContext) error {
 	}
 
 	grbInformer := scaledContext.Management.GlobalRoleBindings("").Controller().Informer()
-	return grbInformer.AddIndexers(map[string]cache.IndexFunc{
+	if err := grbInformer.AddIndexers(map[string]cache.IndexFunc{
 		grbByUserRefKey: grbByUserRefFunc,
+	}); err != nil {
+		return err
+	}
+
+	userInformer := scaledContext.Management.Users("").Controller().Informer()
+	return userInformer.AddIndexers(map[string]cache.IndexFunc{
+		userByDuplicateKeyIndex: userByDuplicateKeyFunc,
 	})
 }
 
 func RegisterEarly(ctx context.Context, management *config.ManagementContext, clusterManager *clustermanager.Manager) {
-	prtb, crtb := newRTBLifecycles(management.WithAgent("mgmt-auth-crtb-prtb-controller"))
 	p := project_cluster.NewProjectLifecycle(management)
 	c := project_cluster.NewClusterLifecycle(management)
 	u := newUserLifecycle(management, clusterManager)
@@ -68,8 +82,9 @@ func RegisterEarly(ctx context.Context, management *config.ManagementContext, cl
 	ac := newAuthConfigController(ctx, management, clusterManager.ScaledContext)
 	ua := newUserAttributeController(management.WithAgent(userAttributeController))
 	s := newAuthSettingController(ctx, management)
-	rt := newRoleTemplateLifecycle(management, clusterManager)
 	prtbServiceAccountFinder := newPRTBServiceAccountController(management)
+	pm := newPrincipalMigrationHandler(management)
+	du := newDuplicateUserHandler(management)
 
 	management.Management.Clusters("").AddHandler(ctx, project_cluster.ClusterCreateController, c.Sync)
 	management.Management.Projects("").AddHandler(ctx, project_cluster.ProjectCreateController, p.Sync)
@@ -77,18 +92,86 @@ func RegisterEarly(ctx context.Context, management *config.ManagementContext, cl
 	management.Management.Tokens("").AddHandler(ctx, tokenController, n.sync)
 	management.Management.AuthConfigs("").AddHandler(ctx, authConfigControllerName, ac.sync)
 	management.Management.UserAttributes("").AddHandler(ctx, userAttributeController, ua.sync)
+	management.Management.Users("").AddHandler(ctx, principalMigrationController, pm.sync)
+	management.Management.Users("").AddHandler(ctx, duplicateUserController, du.sync)
 	management.Management.Settings("").AddHandler(ctx, authSettingController, s.sync)
 	globalroles.Register(ctx, management, clusterManager)
+	globalrolegroupmappings.Register(ctx, management.Wrangler)
+	bootstrapprincipals.Register(ctx, management.Wrangler)
+	roletemplatepolicies.Register(ctx, management.Wrangler)
+	tokenaudit.Register(ctx, management.Wrangler)
+	membersummary.Register(ctx, management.Wrangler)
+
+	// With the auth-controllers-leader-election feature, the CRTB/PRTB/RoleTemplate controllers are registered
+	// separately through RegisterRTBControllers, under their own leader election.
+	if !features.AuthControllersLeaderElection.Enabled() {
+		registerRTBControllers(ctx, management, clusterManager)
+	}
+	management.Management.Users("").AddLifecycle(ctx, userController, &trackedUserLifecycle{
+		lifecycle: u,
+		tracker:   metrics.NewSyncTracker(userController),
+	})
+}
 
+// RegisterRTBControllers registers the CRTB/PRTB/RoleTemplate controllers on a management context obtained from
+// ScaledContext.NewSubsystemManagementContext. It is used instead of RegisterEarly registering those controllers
+// when the auth-controllers-leader-election feature is enabled, so that their reconciliation can run on a different
+// Rancher replica than the rest of the management controllers.
+func RegisterRTBControllers(ctx context.Context, management *config.ManagementContext, clusterManager *clustermanager.Manager) {
+	registerRTBControllers(ctx, management, clusterManager)
+}
+
+func registerRTBControllers(ctx context.Context, management *config.ManagementContext, clusterManager *clustermanager.Manager) {
 	// Only one set of CRTB/PRTB/RoleTemplate controllers should run at a time. Using aggregated cluster roles is currently experimental and only available via feature flags.
 	if features.AggregatedRoleTemplates.Enabled() {
 		roletemplates.Register(ctx, management, clusterManager)
-	} else {
-		management.Management.ClusterRoleTemplateBindings("").AddLifecycle(ctx, ctrbMGMTController, crtb)
-		management.Management.ProjectRoleTemplateBindings("").AddLifecycle(ctx, ptrbMGMTController, prtb)
-		management.Management.RoleTemplates("").AddLifecycle(ctx, roleTemplateLifecycleName, rt)
+		return
+	}
+
+	prtb, crtb := newRTBLifecycles(management.WithAgent("mgmt-auth-crtb-prtb-controller"))
+	rt := newRoleTemplateLifecycle(management, clusterManager)
+	crtbTracker := metrics.NewSyncTracker(ctrbMGMTController)
+	crtbEnqueueAfter := management.Management.ClusterRoleTemplateBindings("").Controller().EnqueueAfter
+	management.Management.ClusterRoleTemplateBindings("").AddLifecycle(ctx, ctrbMGMTController, &warmupCRTBLifecycle{
+		lifecycle: &backoffCRTBLifecycle{
+			lifecycle: &trackedCRTBLifecycle{
+				lifecycle: crtb,
+				tracker:   crtbTracker,
+			},
+			backoff: newRTBBackoff(ctrbMGMTController, crtbEnqueueAfter, crtb.markFailed, crtbTracker),
+		},
+		warmup: newRTBWarmup(ctrbMGMTController, crtbEnqueueAfter, crtbReconciled),
+	})
+	management.Management.Clusters("").AddHandler(ctx, crtbClusterController, crtb.enqueuePendingBindings)
+	// PRTBs have no status to record that they were given up on, which is only logged and reported in the metrics.
+	prtbTracker := metrics.NewSyncTracker(ptrbMGMTController)
+	prtbEnqueueAfter := management.Management.ProjectRoleTemplateBindings("").Controller().EnqueueAfter
+	management.Management.ProjectRoleTemplateBindings("").AddLifecycle(ctx, ptrbMGMTController, &warmupPRTBLifecycle{
+		lifecycle: &backoffPRTBLifecycle{
+			lifecycle: &trackedPRTBLifecycle{
+				lifecycle: prtb,
+				tracker:   prtbTracker,
+			},
+			backoff: newRTBBackoff[*v3.ProjectRoleTemplateBinding](ptrbMGMTController, prtbEnqueueAfter, nil, prtbTracker),
+		},
+		warmup: newRTBWarmup(ptrbMGMTController, prtbEnqueueAfter, prtbReconciled),
+	})
+	watcher := &derivedBindingWatcher{
+		crtbCache:   crtb.crtbCache,
+		prtbCache:   prtb.prtbCache,
+		staleCRTBs:  &crtb.staleBindings,
+		stalePRTBs:  &prtb.staleBindings,
+		enqueueCRTB: management.Management.ClusterRoleTemplateBindings("").Controller().Enqueue,
+		enqueuePRTB: management.Management.ProjectRoleTemplateBindings("").Controller().Enqueue,
+	}
+	if _, err := management.RBAC.ClusterRoleBindings("").Controller().Informer().AddEventHandler(watcher.handler()); err != nil {
+		logrus.Errorf("[%v] Error watching the ClusterRoleBindings of role template bindings: %v", ctrbMGMTController, err)
+	}
+	if _, err := management.RBAC.RoleBindings("").Controller().Informer().AddEventHandler(watcher.handler()); err != nil {
+		logrus.Errorf("[%v] Error watching the RoleBindings of role template bindings: %v", ctrbMGMTController, err)
 	}
-	management.Management.Users("").AddLifecycle(ctx, userController, u)
+	management.Management.RoleTemplates("").AddLifecycle(ctx, roleTemplateLifecycleName, rt)
+	management.Management.RoleTemplates("").AddHandler(ctx, roleTemplateRevisionController, newRoleTemplateRevisionHandler(management).sync)
 }
 
 func RegisterLate(ctx context.Context, management *config.ManagementContext) {
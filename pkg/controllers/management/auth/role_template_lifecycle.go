@@ -5,12 +5,14 @@
 	"fmt"
 
 	"github.com/rancher/rancher/pkg/clustermanager"
+	controllersv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	rbacv1 "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/wrangler/v3/pkg/apply"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
 )
@@ -26,7 +28,7 @@ type roleTemplateLifecycle struct {
 	prtbClient     v3.ProjectRoleTemplateBindingInterface
 	crtbIndexer    cache.Indexer
 	crtbClient     v3.ClusterRoleTemplateBindingInterface
-	clusters       v3.ClusterInterface
+	clusterCache   controllersv3.ClusterCache
 	roles          rbacv1.RoleInterface
 	roleLister     rbacv1.RoleLister
 	clusterManager *clustermanager.Manager
@@ -41,7 +43,7 @@ func newRoleTemplateLifecycle(management *config.ManagementContext, clusterManag
 		prtbClient:     management.Management.ProjectRoleTemplateBindings(""),
 		crtbIndexer:    crtbInformer.GetIndexer(),
 		crtbClient:     management.Management.ClusterRoleTemplateBindings(""),
-		clusters:       management.Management.Clusters(""),
+		clusterCache:   management.Wrangler.Mgmt.Cluster().Cache(),
 		roles:          management.RBAC.Roles(""),
 		roleLister:     management.RBAC.Roles("").Controller().Lister(),
 		clusterManager: clusterManager,
@@ -71,7 +73,7 @@ func (rtl *roleTemplateLifecycle) enqueueRtbs(obj *v3.RoleTemplate) (runtime.Obj
 }
 
 func (rtl *roleTemplateLifecycle) Remove(obj *v3.RoleTemplate) (runtime.Object, error) {
-	clusters, err := rtl.clusters.List(metav1.ListOptions{})
+	clusters, err := rtl.clusterCache.List(labels.Everything())
 	if err != nil {
 		return obj, err
 	}
@@ -79,7 +81,7 @@ func (rtl *roleTemplateLifecycle) Remove(obj *v3.RoleTemplate) (runtime.Object,
 	// Collect all the errors to delete as many user context cluster roles as possible
 	var allErrors []error
 
-	for _, cluster := range clusters.Items {
+	for _, cluster := range clusters {
 		userContext, err := rtl.clusterManager.UserContext(cluster.Name)
 		if err != nil {
 			// ClusterUnavailable error indicates the record can't talk to the downstream cluster
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	controllersv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	pkgrbac "github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	roleTemplateRevisionController = "mgmt-auth-roletemplate-revision"
+	// roleTemplateRolloutStepAnnotation records when the current revision of a RoleTemplate was last rolled out to one
+	// more cluster.
+	roleTemplateRolloutStepAnnotation = "authz.management.cattle.io/rollout-step-time"
+)
+
+// roleTemplateRevisionHandler records a new revision of a RoleTemplate each time the fields the RBAC of the downstream
+// clusters is derived from are edited, and rolls the revision out to the downstream clusters one by one, at the interval
+// of the roletemplate-rollout-interval setting. Until the revision is rolled out to their cluster, the cluster
+// controllers derive the RBAC from the RoleTemplate as returned by [pkgrbac.RoleTemplateForCluster].
+type roleTemplateRevisionHandler struct {
+	roleTemplates v3.RoleTemplateInterface
+	clusterCache  controllersv3.ClusterCache
+	enqueueAfter  func(namespace, name string, after time.Duration)
+	interval      func() time.Duration
+	now           func() time.Time
+}
+
+func newRoleTemplateRevisionHandler(management *config.ManagementContext) *roleTemplateRevisionHandler {
+	return &roleTemplateRevisionHandler{
+		roleTemplates: management.Management.RoleTemplates(""),
+		clusterCache:  management.Wrangler.Mgmt.Cluster().Cache(),
+		enqueueAfter:  management.Management.RoleTemplates("").Controller().EnqueueAfter,
+		interval:      settings.RoleTemplateRolloutInterval.GetDuration,
+		now:           time.Now,
+	}
+}
+
+func (h *roleTemplateRevisionHandler) sync(_ string, rt *v3.RoleTemplate) (runtime.Object, error) {
+	if rt == nil || rt.DeletionTimestamp != nil {
+		return rt, nil
+	}
+
+	hash := pkgrbac.RoleTemplateRevisionHash(rt)
+	spec := pkgrbac.RoleTemplateRevisionSpec(rt)
+	recorded, ok := rt.Annotations[pkgrbac.RoleTemplateRevisionHashAnnotation]
+	if !ok {
+		// Nothing was derived from a previous revision of the RoleTemplate, there is nothing to roll out.
+		rt = rt.DeepCopy()
+		setRoleTemplateAnnotation(rt, pkgrbac.RoleTemplateRevisionAnnotation, "1")
+		setRoleTemplateAnnotation(rt, pkgrbac.RoleTemplateRevisionHashAnnotation, hash)
+		setRoleTemplateAnnotation(rt, pkgrbac.RoleTemplateRevisionSpecAnnotation, spec)
+		return h.roleTemplates.Update(rt)
+	}
+	if recorded != hash {
+		// A missing or invalid revision counts as revision 0.
+		revision, _ := strconv.Atoi(rt.Annotations[pkgrbac.RoleTemplateRevisionAnnotation])
+		revision++
+
+		rt = rt.DeepCopy()
+		setRoleTemplateAnnotation(rt, pkgrbac.RoleTemplateRevisionAnnotation, strconv.Itoa(revision))
+		setRoleTemplateAnnotation(rt, pkgrbac.RoleTemplateRevisionHashAnnotation, hash)
+		delete(rt.Annotations, roleTemplateRolloutStepAnnotation)
+		previous := rt.Annotations[pkgrbac.RoleTemplateRevisionSpecAnnotation]
+		if _, rollingOut := pkgrbac.RoleTemplateRolloutClusters(rt); rollingOut && rt.Annotations[pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation] != "" {
+			// The revision being rolled out wasn't rolled out to every cluster, the clusters keep the revision before.
+			previous = rt.Annotations[pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation]
+		}
+		if previous != "" {
+			logrus.Infof("[%s] Rolling out revision %d of roleTemplate %s", roleTemplateRevisionController, revision, rt.Name)
+			setRoleTemplateAnnotation(rt, pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation, previous)
+			setRoleTemplateAnnotation(rt, pkgrbac.RoleTemplateRolloutClustersAnnotation, "")
+		} else {
+			// The previous revision, recorded before its fields were, can't be kept in the clusters.
+			logrus.Infof("[%s] Applying revision %d of roleTemplate %s to all clusters", roleTemplateRevisionController, revision, rt.Name)
+			delete(rt.Annotations, pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation)
+			delete(rt.Annotations, pkgrbac.RoleTemplateRolloutClustersAnnotation)
+		}
+		setRoleTemplateAnnotation(rt, pkgrbac.RoleTemplateRevisionSpecAnnotation, spec)
+		return h.roleTemplates.Update(rt)
+	}
+
+	return h.rollOut(rt)
+}
+
+// rollOut rolls the current revision of the RoleTemplate out to one more cluster, unless the rollout is paused or the
+// interval since the last cluster didn't elapse yet.
+func (h *roleTemplateRevisionHandler) rollOut(rt *v3.RoleTemplate) (runtime.Object, error) {
+	rolledOut, ok := pkgrbac.RoleTemplateRolloutClusters(rt)
+	if !ok || rt.Annotations[pkgrbac.RoleTemplateRolloutPausedAnnotation] == "true" {
+		return rt, nil
+	}
+
+	interval := h.interval()
+	if last, err := time.Parse(time.RFC3339, rt.Annotations[roleTemplateRolloutStepAnnotation]); err == nil {
+		if wait := last.Add(interval).Sub(h.now()); wait > 0 {
+			h.enqueueAfter("", rt.Name, wait)
+			return rt, nil
+		}
+	}
+
+	clusters, err := h.clusterCache.List(labels.Everything())
+	if err != nil {
+		return rt, err
+	}
+	var pending []string
+	for _, cluster := range clusters {
+		if cluster.DeletionTimestamp == nil && !slices.Contains(rolledOut, cluster.Name) {
+			pending = append(pending, cluster.Name)
+		}
+	}
+	slices.Sort(pending)
+
+	rt = rt.DeepCopy()
+	if len(pending) == 0 || interval <= 0 {
+		logrus.Infof("[%s] Rolled out revision %s of roleTemplate %s to all clusters", roleTemplateRevisionController, rt.Annotations[pkgrbac.RoleTemplateRevisionAnnotation], rt.Name)
+		delete(rt.Annotations, pkgrbac.RoleTemplateRolloutClustersAnnotation)
+		delete(rt.Annotations, pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation)
+		delete(rt.Annotations, roleTemplateRolloutStepAnnotation)
+		return h.roleTemplates.Update(rt)
+	}
+
+	logrus.Infof("[%s] Rolling out revision %s of roleTemplate %s to cluster %s", roleTemplateRevisionController, rt.Annotations[pkgrbac.RoleTemplateRevisionAnnotation], rt.Name, pending[0])
+	setRoleTemplateAnnotation(rt, pkgrbac.RoleTemplateRolloutClustersAnnotation, strings.Join(append(rolledOut, pending[0]), ","))
+	setRoleTemplateAnnotation(rt, roleTemplateRolloutStepAnnotation, h.now().UTC().Format(time.RFC3339))
+	return h.roleTemplates.Update(rt)
+}
+
+func setRoleTemplateAnnotation(rt *v3.RoleTemplate, key, value string) {
+	if rt.Annotations == nil {
+		rt.Annotations = map[string]string{}
+	}
+	rt.Annotations[key] = value
+}
@@ -0,0 +1,225 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	pkgrbac "github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestRoleTemplateRevisionSync(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	rules := []rbacv1.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}}
+	hash := pkgrbac.RoleTemplateRevisionHash(&v3.RoleTemplate{Rules: rules})
+	spec := pkgrbac.RoleTemplateRevisionSpec(&v3.RoleTemplate{Rules: rules})
+	oldSpec := pkgrbac.RoleTemplateRevisionSpec(&v3.RoleTemplate{})
+	roleTemplate := func(annotations map[string]string) *v3.RoleTemplate {
+		return &v3.RoleTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "rt-1", Annotations: annotations},
+			Rules:      rules,
+		}
+	}
+	clusters := []*v3.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "c-2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "local"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "c-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "c-3", DeletionTimestamp: &metav1.Time{Time: now}}},
+	}
+
+	tests := []struct {
+		name            string
+		roleTemplate    *v3.RoleTemplate
+		interval        time.Duration
+		wantAnnotations map[string]string
+		wantEnqueued    time.Duration
+	}{
+		{
+			name:         "first revision is recorded",
+			roleTemplate: roleTemplate(nil),
+			interval:     time.Minute,
+			wantAnnotations: map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:     "1",
+				pkgrbac.RoleTemplateRevisionHashAnnotation: hash,
+				pkgrbac.RoleTemplateRevisionSpecAnnotation: spec,
+			},
+		},
+		{
+			name: "edit starts the rollout of a new revision",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:     "2",
+				pkgrbac.RoleTemplateRevisionHashAnnotation: "old",
+				pkgrbac.RoleTemplateRevisionSpecAnnotation: oldSpec,
+			}),
+			interval: time.Minute,
+			wantAnnotations: map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:             "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:         hash,
+				pkgrbac.RoleTemplateRevisionSpecAnnotation:         spec,
+				pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation: oldSpec,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation:      "",
+			},
+		},
+		{
+			name: "edit during a rollout keeps the revision before the one rolled out",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:             "2",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:         "old",
+				pkgrbac.RoleTemplateRevisionSpecAnnotation:         "{}",
+				pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation: oldSpec,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation:      "c-1",
+				roleTemplateRolloutStepAnnotation:                  "2024-01-02T03:04:00Z",
+			}),
+			interval: time.Minute,
+			wantAnnotations: map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:             "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:         hash,
+				pkgrbac.RoleTemplateRevisionSpecAnnotation:         spec,
+				pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation: oldSpec,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation:      "",
+			},
+		},
+		{
+			name: "edit of a revision recorded without its fields is applied at once",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:     "2",
+				pkgrbac.RoleTemplateRevisionHashAnnotation: "old",
+			}),
+			interval: time.Minute,
+			wantAnnotations: map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:     "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation: hash,
+				pkgrbac.RoleTemplateRevisionSpecAnnotation: spec,
+			},
+		},
+		{
+			name: "revision is rolled out to the first cluster",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:        "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:    hash,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation: "",
+			}),
+			interval: time.Minute,
+			wantAnnotations: map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:        "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:    hash,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation: "c-1",
+				roleTemplateRolloutStepAnnotation:             "2024-01-02T03:04:05Z",
+			},
+		},
+		{
+			name: "revision is rolled out to the next cluster after the interval",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:        "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:    hash,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation: "c-1",
+				roleTemplateRolloutStepAnnotation:             "2024-01-02T03:03:05Z",
+			}),
+			interval: time.Minute,
+			wantAnnotations: map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:        "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:    hash,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation: "c-1,c-2",
+				roleTemplateRolloutStepAnnotation:             "2024-01-02T03:04:05Z",
+			},
+		},
+		{
+			name: "rollout waits for the interval to elapse",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:        "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:    hash,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation: "c-1",
+				roleTemplateRolloutStepAnnotation:             "2024-01-02T03:04:00Z",
+			}),
+			interval:     time.Minute,
+			wantEnqueued: 55 * time.Second,
+		},
+		{
+			name: "rollout is paused",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:        "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:    hash,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation: "c-1",
+				pkgrbac.RoleTemplateRolloutPausedAnnotation:   "true",
+			}),
+			interval: time.Minute,
+		},
+		{
+			name: "rollout completes once rolled out to all clusters",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:             "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:         hash,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation:      "c-1,c-2,local",
+				pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation: oldSpec,
+				roleTemplateRolloutStepAnnotation:                  "2024-01-02T03:03:05Z",
+			}),
+			interval: time.Minute,
+			wantAnnotations: map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:     "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation: hash,
+			},
+		},
+		{
+			name: "revision is rolled out to all clusters at once without an interval",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:        "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation:    hash,
+				pkgrbac.RoleTemplateRolloutClustersAnnotation: "",
+			}),
+			wantAnnotations: map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:     "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation: hash,
+			},
+		},
+		{
+			name: "revision was rolled out",
+			roleTemplate: roleTemplate(map[string]string{
+				pkgrbac.RoleTemplateRevisionAnnotation:     "3",
+				pkgrbac.RoleTemplateRevisionHashAnnotation: hash,
+			}),
+			interval: time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			clusterCache := fake.NewMockNonNamespacedCacheInterface[*v3.Cluster](ctrl)
+			clusterCache.EXPECT().List(labels.Everything()).Return(clusters, nil).AnyTimes()
+			var updated *v3.RoleTemplate
+			var enqueued time.Duration
+			h := &roleTemplateRevisionHandler{
+				roleTemplates: &fakes.RoleTemplateInterfaceMock{
+					UpdateFunc: func(rt *v3.RoleTemplate) (*v3.RoleTemplate, error) {
+						updated = rt
+						return rt, nil
+					},
+				},
+				clusterCache: clusterCache,
+				enqueueAfter: func(_, name string, after time.Duration) {
+					assert.Equal(t, "rt-1", name)
+					enqueued = after
+				},
+				interval: func() time.Duration { return tt.interval },
+				now:      func() time.Time { return now },
+			}
+
+			_, err := h.sync("rt-1", tt.roleTemplate)
+			require.NoError(t, err)
+			if tt.wantAnnotations == nil {
+				assert.Nil(t, updated)
+			} else {
+				require.NotNil(t, updated)
+				assert.Equal(t, tt.wantAnnotations, updated.Annotations)
+			}
+			assert.Equal(t, tt.wantEnqueued, enqueued)
+		})
+	}
+}
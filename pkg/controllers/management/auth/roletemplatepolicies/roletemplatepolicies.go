@@ -0,0 +1,111 @@
+// Package roletemplatepolicies flags the RoleTemplates granting a rule forbidden by a RoleTemplatePolicy. The Rancher
+// API rejects such RoleTemplates, but not the RoleTemplates written to the Kubernetes API directly, nor the ones
+// created before the policy.
+package roletemplatepolicies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/wrangler"
+	rbacv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac/v1"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	controllerName = "mgmt-auth-roletemplate-policy-controller"
+	policyEnqueuer = "mgmt-auth-roletemplate-policy-enqueuer"
+)
+
+type handler struct {
+	roleTemplates     mgmtv3.RoleTemplateClient
+	roleTemplateCache mgmtv3.RoleTemplateCache
+	policyCache       mgmtv3.RoleTemplatePolicyCache
+	clusterRoleCache  rbacv1.ClusterRoleCache
+}
+
+// Register registers the controller flagging the RoleTemplates granting a rule forbidden by a RoleTemplatePolicy.
+func Register(ctx context.Context, wContext *wrangler.Context) {
+	h := &handler{
+		roleTemplates:     wContext.Mgmt.RoleTemplate(),
+		roleTemplateCache: wContext.Mgmt.RoleTemplate().Cache(),
+		policyCache:       wContext.Mgmt.RoleTemplatePolicy().Cache(),
+		clusterRoleCache:  wContext.RBAC.ClusterRole().Cache(),
+	}
+	relatedresource.WatchClusterScoped(ctx, policyEnqueuer, h.enqueueRoleTemplates, wContext.Mgmt.RoleTemplate(), wContext.Mgmt.RoleTemplatePolicy())
+	wContext.Mgmt.RoleTemplate().OnChange(ctx, controllerName, h.onChange)
+}
+
+// enqueueRoleTemplates enqueues every RoleTemplate when a RoleTemplatePolicy changes, as any of them may grant a rule
+// it forbids, or no longer does.
+func (h *handler) enqueueRoleTemplates(_, _ string, _ runtime.Object) ([]relatedresource.Key, error) {
+	rts, err := h.roleTemplateCache.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roleTemplates: %w", err)
+	}
+	keys := make([]relatedresource.Key, 0, len(rts))
+	for _, rt := range rts {
+		keys = append(keys, relatedresource.Key{Name: rt.Name})
+	}
+	return keys, nil
+}
+
+// onChange sets the [rbac.RoleTemplatePolicyViolationAnnotation] annotation of the RoleTemplate, and of the
+// RoleTemplates inheriting it as the rules they grant include its rules, to the rules they grant forbidden by the
+// RoleTemplatePolicies, and removes it from the ones no longer granting forbidden rules.
+func (h *handler) onChange(_ string, rt *v3.RoleTemplate) (*v3.RoleTemplate, error) {
+	if rt == nil || rt.DeletionTimestamp != nil {
+		return rt, nil
+	}
+	policies, err := h.policyCache.List(labels.Everything())
+	if err != nil {
+		return rt, fmt.Errorf("failed to list roleTemplatePolicies: %w", err)
+	}
+	inheriting, err := rbac.InheritingRoleTemplates(h.roleTemplateCache, rt.Name)
+	if err != nil {
+		return rt, err
+	}
+
+	rt, err = h.flag(policies, rt)
+	errs := []error{err}
+	for _, template := range inheriting {
+		_, err := h.flag(policies, template)
+		errs = append(errs, err)
+	}
+	return rt, errors.Join(errs...)
+}
+
+// flag updates the annotation of the RoleTemplate if the forbidden rules it grants changed.
+func (h *handler) flag(policies []*v3.RoleTemplatePolicy, rt *v3.RoleTemplate) (*v3.RoleTemplate, error) {
+	rules, err := rbac.RulesFromTemplate(h.clusterRoleCache, h.roleTemplateCache, rt)
+	if err != nil {
+		return rt, fmt.Errorf("failed to gather the rules of roleTemplate %s: %w", rt.Name, err)
+	}
+	var violation string
+	if err := rbac.CheckRoleTemplatePolicies(policies, rt, rules); err != nil {
+		violation = err.Error()
+	}
+	if rt.Annotations[rbac.RoleTemplatePolicyViolationAnnotation] == violation {
+		return rt, nil
+	}
+
+	rt = rt.DeepCopy()
+	if violation == "" {
+		logrus.Infof("[%s] RoleTemplate %s no longer grants forbidden rules", controllerName, rt.Name)
+		delete(rt.Annotations, rbac.RoleTemplatePolicyViolationAnnotation)
+	} else {
+		logrus.Warnf("[%s] %s", controllerName, violation)
+		if rt.Annotations == nil {
+			rt.Annotations = map[string]string{}
+		}
+		rt.Annotations[rbac.RoleTemplatePolicyViolationAnnotation] = violation
+	}
+	return h.roleTemplates.Update(rt)
+}
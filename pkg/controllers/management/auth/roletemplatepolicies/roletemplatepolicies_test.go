@@ -0,0 +1,155 @@
+package roletemplatepolicies
+
+import (
+	"fmt"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const violation = `roleTemplate read-secrets grants forbidden rules: rule {apiGroups: [""], resources: ["secrets"], verbs: ["get"]} is forbidden by rule 0 of roleTemplatePolicy secrets`
+
+var (
+	policies = []*v3.RoleTemplatePolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "secrets"},
+			Spec: v3.RoleTemplatePolicySpec{
+				ForbiddenRules: []v3.ForbiddenRule{{Resources: []string{"secrets"}}},
+			},
+		},
+	}
+	readSecrets = rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}}
+	readPods    = rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}
+)
+
+func newRoleTemplate(name string, annotations map[string]string, rules []rbacv1.PolicyRule, inherited ...string) *v3.RoleTemplate {
+	return &v3.RoleTemplate{
+		ObjectMeta:        metav1.ObjectMeta{Name: name, Annotations: annotations},
+		Context:           "cluster",
+		Rules:             rules,
+		RoleTemplateNames: inherited,
+	}
+}
+
+func TestOnChange(t *testing.T) {
+	flagged := map[string]string{rbac.RoleTemplatePolicyViolationAnnotation: violation}
+
+	tests := []struct {
+		name        string
+		rt          *v3.RoleTemplate
+		existing    []*v3.RoleTemplate
+		wantUpdated map[string]map[string]string
+	}{
+		{
+			name: "roleTemplate granting allowed rules isn't flagged",
+			rt:   newRoleTemplate("read-pods", nil, []rbacv1.PolicyRule{readPods}),
+		},
+		{
+			name: "roleTemplate granting a forbidden rule is flagged",
+			rt:   newRoleTemplate("read-secrets", nil, []rbacv1.PolicyRule{readSecrets}),
+			wantUpdated: map[string]map[string]string{
+				"read-secrets": flagged,
+			},
+		},
+		{
+			name: "flagged roleTemplate isn't updated again",
+			rt:   newRoleTemplate("read-secrets", flagged, []rbacv1.PolicyRule{readSecrets}),
+		},
+		{
+			name: "roleTemplate no longer granting a forbidden rule is no longer flagged",
+			rt:   newRoleTemplate("read-secrets", flagged, []rbacv1.PolicyRule{readPods}),
+			wantUpdated: map[string]map[string]string{
+				"read-secrets": {},
+			},
+		},
+		{
+			name: "roleTemplates inheriting a roleTemplate granting a forbidden rule are flagged",
+			rt:   newRoleTemplate("read-secrets", flagged, []rbacv1.PolicyRule{readSecrets}),
+			existing: []*v3.RoleTemplate{
+				newRoleTemplate("viewer", nil, nil, "read-secrets"),
+				newRoleTemplate("admin", nil, nil, "viewer"),
+				newRoleTemplate("other", nil, []rbacv1.PolicyRule{readPods}),
+			},
+			wantUpdated: map[string]map[string]string{
+				"viewer": {rbac.RoleTemplatePolicyViolationAnnotation: `roleTemplate viewer grants forbidden rules: rule {apiGroups: [""], resources: ["secrets"], verbs: ["get"]} is forbidden by rule 0 of roleTemplatePolicy secrets`},
+				"admin":  {rbac.RoleTemplatePolicyViolationAnnotation: `roleTemplate admin grants forbidden rules: rule {apiGroups: [""], resources: ["secrets"], verbs: ["get"]} is forbidden by rule 0 of roleTemplatePolicy secrets`},
+			},
+		},
+		{
+			name: "builtin roleTemplates aren't flagged",
+			rt: &v3.RoleTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-owner"},
+				Context:    "cluster",
+				Builtin:    true,
+				Rules:      []rbacv1.PolicyRule{readSecrets},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			all := append([]*v3.RoleTemplate{tt.rt}, tt.existing...)
+
+			rtCache := fake.NewMockNonNamespacedCacheInterface[*v3.RoleTemplate](ctrl)
+			rtCache.EXPECT().List(labels.Everything()).Return(all, nil).AnyTimes()
+			rtCache.EXPECT().Get(gomock.Any()).DoAndReturn(func(name string) (*v3.RoleTemplate, error) {
+				for _, rt := range all {
+					if rt.Name == name {
+						return rt, nil
+					}
+				}
+				return nil, fmt.Errorf("roleTemplate %s not found", name)
+			}).AnyTimes()
+			policyCache := fake.NewMockNonNamespacedCacheInterface[*v3.RoleTemplatePolicy](ctrl)
+			policyCache.EXPECT().List(labels.Everything()).Return(policies, nil)
+
+			rts := fake.NewMockNonNamespacedControllerInterface[*v3.RoleTemplate, *v3.RoleTemplateList](ctrl)
+			updated := map[string]map[string]string{}
+			rts.EXPECT().Update(gomock.Any()).DoAndReturn(func(rt *v3.RoleTemplate) (*v3.RoleTemplate, error) {
+				annotations := rt.Annotations
+				if annotations == nil {
+					annotations = map[string]string{}
+				}
+				updated[rt.Name] = annotations
+				return rt, nil
+			}).AnyTimes()
+
+			h := &handler{
+				roleTemplates:     rts,
+				roleTemplateCache: rtCache,
+				policyCache:       policyCache,
+				clusterRoleCache:  fake.NewMockNonNamespacedCacheInterface[*rbacv1.ClusterRole](ctrl),
+			}
+
+			_, err := h.onChange("", tt.rt)
+			assert.NoError(t, err)
+			if tt.wantUpdated == nil {
+				tt.wantUpdated = map[string]map[string]string{}
+			}
+			assert.Equal(t, tt.wantUpdated, updated)
+		})
+	}
+}
+
+func TestEnqueueRoleTemplates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rtCache := fake.NewMockNonNamespacedCacheInterface[*v3.RoleTemplate](ctrl)
+	rtCache.EXPECT().List(labels.Everything()).Return([]*v3.RoleTemplate{
+		newRoleTemplate("read-pods", nil, nil),
+		newRoleTemplate("read-secrets", nil, nil),
+	}, nil)
+	h := &handler{roleTemplateCache: rtCache}
+
+	keys, err := h.enqueueRoleTemplates("", "secrets", policies[0])
+	assert.NoError(t, err)
+	assert.Equal(t, []relatedresource.Key{{Name: "read-pods"}, {Name: "read-secrets"}}, keys)
+}
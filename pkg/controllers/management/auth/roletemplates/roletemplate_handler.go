@@ -15,23 +15,13 @@
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-var (
-	clusterManagementPlaneResources = map[string]string{
-		"clusterscans":                "management.cattle.io",
-		"clusterregistrationtokens":   "management.cattle.io",
-		"clusterroletemplatebindings": "management.cattle.io",
-		"etcdbackups":                 "management.cattle.io",
-		"nodes":                       "management.cattle.io",
-		"nodepools":                   "management.cattle.io",
-		"projects":                    "management.cattle.io",
-		"etcdsnapshots":               "rke.cattle.io",
-	}
-	projectManagementPlaneResources = map[string]string{
-		"apps":                        "project.cattle.io",
-		"projectroletemplatebindings": "management.cattle.io",
-		"secrets":                     "",
-	}
-)
+// projectManagementPlaneResources returns the registered project management plane resources, and the apps of
+// projects which only aggregated RoleTemplates grant access to.
+func projectManagementPlaneResources() map[string]string {
+	resources := rbac.ProjectManagementPlaneResources()
+	resources["apps"] = "project.cattle.io"
+	return resources
+}
 
 type roleTemplateHandler struct {
 	crController      crbacv1.ClusterRoleController
@@ -86,7 +76,7 @@ func (r *roleTemplateHandler) getClusterRoles(rt *v3.RoleTemplate, rules []rbacv
 
 	var clusterRoles []*rbacv1.ClusterRole
 	if rt.Context == "cluster" {
-		clusterScopedPrivileges := getManagementPlaneRules(rules, clusterManagementPlaneResources)
+		clusterScopedPrivileges := getManagementPlaneRules(rules, rbac.ClusterManagementPlaneResources())
 
 		clusterManagementClusterRoles, err := r.getManagementClusterRoles(rt, clusterScopedPrivileges, rbac.ClusterManagementPlaneClusterRoleNameFor)
 		if err != nil {
@@ -95,7 +85,7 @@ func (r *roleTemplateHandler) getClusterRoles(rt *v3.RoleTemplate, rules []rbacv
 		clusterRoles = append(clusterRoles, clusterManagementClusterRoles...)
 	}
 
-	projectScopedPrivileges := getManagementPlaneRules(rules, projectManagementPlaneResources)
+	projectScopedPrivileges := getManagementPlaneRules(rules, projectManagementPlaneResources())
 	projectManagementClusterRoles, err := r.getManagementClusterRoles(rt, projectScopedPrivileges, rbac.ProjectManagementPlaneClusterRoleNameFor)
 	if err != nil {
 		return nil, err
@@ -673,7 +673,7 @@ func Test_getManagementPlaneRules(t *testing.T) {
 					Verbs:     []string{"*"},
 				},
 			},
-			managementResources: clusterManagementPlaneResources,
+			managementResources: rbac.ClusterManagementPlaneResources(),
 			want: []rbacv1.PolicyRule{
 				{
 					Resources: []string{"clusterscans"},
@@ -726,7 +726,7 @@ func Test_getManagementPlaneRules(t *testing.T) {
 					Verbs:     []string{"*"},
 				},
 			},
-			managementResources: projectManagementPlaneResources,
+			managementResources: projectManagementPlaneResources(),
 			want: []rbacv1.PolicyRule{
 				{
 					Resources: []string{"apps"},
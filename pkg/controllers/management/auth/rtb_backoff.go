@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/metrics"
+	"github.com/rancher/rancher/pkg/tracing"
+	"github.com/rancher/wrangler/v3/pkg/generic"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	rtbBackoffBaseDelay   = 5 * time.Second
+	rtbBackoffMaxDelay    = 10 * time.Minute
+	rtbBackoffJitter      = 0.2
+	rtbBackoffMaxAttempts = 10
+
+	reconciled         = "Reconciled"
+	maxAttemptsReached = "MaxAttemptsReached"
+)
+
+// rtbBackoff retries the failed syncs of each binding with its own exponential backoff, instead of the rate limiter of
+// the queue shared with the healthy bindings, so that a binding which keeps failing (e.g. because its role template is
+// missing) doesn't hot-loop. After maxAttempts failed syncs the binding is given up on, until its generation changes.
+type rtbBackoff[T metav1.Object] struct {
+	controller   string
+	enqueueAfter func(namespace, name string, after time.Duration)
+	// markFailed records on the binding that it was given up on. It's optional.
+	markFailed func(obj T, err error) error
+	tracker    *metrics.SyncTracker
+
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	maxAttempts int
+	jitter      func(time.Duration) time.Duration
+
+	lock     sync.Mutex
+	failures map[types.UID]*rtbFailure
+}
+
+type rtbFailure struct {
+	generation int64
+	attempts   int
+	givenUp    bool
+}
+
+func newRTBBackoff[T metav1.Object](controller string, enqueueAfter func(string, string, time.Duration), markFailed func(T, error) error, tracker *metrics.SyncTracker) *rtbBackoff[T] {
+	return &rtbBackoff[T]{
+		controller:   controller,
+		enqueueAfter: enqueueAfter,
+		markFailed:   markFailed,
+		tracker:      tracker,
+		baseDelay:    rtbBackoffBaseDelay,
+		maxDelay:     rtbBackoffMaxDelay,
+		maxAttempts:  rtbBackoffMaxAttempts,
+		jitter: func(d time.Duration) time.Duration {
+			return wait.Jitter(d, rtbBackoffJitter)
+		},
+		failures: map[types.UID]*rtbFailure{},
+	}
+}
+
+// run runs f for the binding. A failed sync is requeued after the backoff delay of the binding, and generic.ErrSkip is
+// returned so that it isn't requeued by the rate limiter of the queue as well.
+func (b *rtbBackoff[T]) run(obj T, f func(T) (runtime.Object, error)) (runtime.Object, error) {
+	if b.givenUp(obj) {
+		return nil, generic.ErrSkip
+	}
+
+	// The lifecycles don't take a context, so each sync is the root of its own trace.
+	_, span := tracing.Start(context.Background(), b.controller,
+		attribute.String("rbac.key", syncKey(obj)),
+		attribute.Int64("rbac.generation", obj.GetGeneration()),
+	)
+	result, err := f(obj)
+	tracing.End(span, err)
+	if err == nil {
+		b.reset(obj.GetUID())
+		return result, nil
+	}
+
+	attempts, delay := b.failed(obj)
+	if attempts < b.maxAttempts {
+		logrus.Errorf("[%s] Error syncing %s (attempt %d/%d), retrying in %s: %v", b.controller, syncKey(obj), attempts, b.maxAttempts, delay, err)
+		b.enqueueAfter(obj.GetNamespace(), obj.GetName(), delay)
+		return result, generic.ErrSkip
+	}
+
+	logrus.Errorf("[%s] Giving up syncing %s after %d attempts until it changes: %v", b.controller, syncKey(obj), attempts, err)
+	b.giveUp(obj)
+	if b.tracker != nil {
+		b.tracker.Forget(syncKey(obj))
+	}
+	if b.markFailed != nil {
+		if markErr := b.markFailed(obj, fmt.Errorf("giving up after %d attempts: %w", attempts, err)); markErr != nil {
+			logrus.Errorf("[%s] Error marking %s as failed: %v", b.controller, syncKey(obj), markErr)
+		}
+	}
+	return result, generic.ErrSkip
+}
+
+// givenUp returns whether the binding was given up on. A binding is retried again once its generation changes.
+func (b *rtbBackoff[T]) givenUp(obj T) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	failure, ok := b.failures[obj.GetUID()]
+	if !ok {
+		return false
+	}
+	if failure.generation != obj.GetGeneration() {
+		delete(b.failures, obj.GetUID())
+		b.reportGivenUp()
+		return false
+	}
+	return failure.givenUp
+}
+
+// failed records a failed sync of the binding, and returns the number of failed attempts and the delay before the
+// next one.
+func (b *rtbBackoff[T]) failed(obj T) (int, time.Duration) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	failure, ok := b.failures[obj.GetUID()]
+	if !ok {
+		failure = &rtbFailure{generation: obj.GetGeneration()}
+		b.failures[obj.GetUID()] = failure
+	}
+	failure.attempts++
+
+	delay := b.baseDelay
+	for i := 1; i < failure.attempts && delay < b.maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, b.maxDelay)
+	return failure.attempts, b.jitter(delay)
+}
+
+func (b *rtbBackoff[T]) giveUp(obj T) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if failure, ok := b.failures[obj.GetUID()]; ok {
+		failure.givenUp = true
+	}
+	b.reportGivenUp()
+}
+
+func (b *rtbBackoff[T]) reset(uid types.UID) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if failure, ok := b.failures[uid]; ok {
+		delete(b.failures, uid)
+		if failure.givenUp {
+			b.reportGivenUp()
+		}
+	}
+}
+
+// reportGivenUp updates the metric of the bindings given up on. It must be called with the lock held.
+func (b *rtbBackoff[T]) reportGivenUp() {
+	var count int
+	for _, failure := range b.failures {
+		if failure.givenUp {
+			count++
+		}
+	}
+	metrics.SetRBACSyncFailedItems(b.controller, count)
+}
+
+// backoffCRTBLifecycle retries the failed syncs of a crtb lifecycle with a per binding backoff.
+type backoffCRTBLifecycle struct {
+	lifecycle mgmtv3.ClusterRoleTemplateBindingLifecycle
+	backoff   *rtbBackoff[*v3.ClusterRoleTemplateBinding]
+}
+
+func (b *backoffCRTBLifecycle) Create(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	return b.backoff.run(obj, b.lifecycle.Create)
+}
+
+func (b *backoffCRTBLifecycle) Updated(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	return b.backoff.run(obj, b.lifecycle.Updated)
+}
+
+func (b *backoffCRTBLifecycle) Remove(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	return b.backoff.run(obj, b.lifecycle.Remove)
+}
+
+// backoffPRTBLifecycle retries the failed syncs of a prtb lifecycle with a per binding backoff.
+type backoffPRTBLifecycle struct {
+	lifecycle mgmtv3.ProjectRoleTemplateBindingLifecycle
+	backoff   *rtbBackoff[*v3.ProjectRoleTemplateBinding]
+}
+
+func (b *backoffPRTBLifecycle) Create(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	return b.backoff.run(obj, b.lifecycle.Create)
+}
+
+func (b *backoffPRTBLifecycle) Updated(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	return b.backoff.run(obj, b.lifecycle.Updated)
+}
+
+func (b *backoffPRTBLifecycle) Remove(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	return b.backoff.run(obj, b.lifecycle.Remove)
+}
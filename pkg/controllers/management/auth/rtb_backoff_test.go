@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/rancher/rancher/pkg/metrics"
+	"github.com/rancher/wrangler/v3/pkg/generic"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRTBBackoff(t *testing.T) {
+	var delays []time.Duration
+	var markedFailed []error
+	b := newRTBBackoff("test-rtb-backoff",
+		func(namespace, name string, after time.Duration) {
+			assert.Equal(t, "c-abc", namespace)
+			assert.Equal(t, "crtb-1", name)
+			delays = append(delays, after)
+		},
+		func(obj *v3.ClusterRoleTemplateBinding, err error) error {
+			markedFailed = append(markedFailed, err)
+			return nil
+		},
+		metrics.NewSyncTracker("test-rtb-backoff"),
+	)
+	b.baseDelay = time.Second
+	b.maxDelay = 5 * time.Second
+	b.maxAttempts = 5
+	b.jitter = func(d time.Duration) time.Duration { return d }
+
+	crtb := &v3.ClusterRoleTemplateBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "crtb-1",
+			Namespace:  "c-abc",
+			UID:        "uid-1",
+			Generation: 1,
+		},
+	}
+	var calls int
+	failing := func(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+		calls++
+		return obj, fmt.Errorf("roletemplate not found")
+	}
+	succeeding := func(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+		calls++
+		return obj, nil
+	}
+
+	for i := 0; i < 4; i++ {
+		_, err := b.run(crtb, failing)
+		require.ErrorIs(t, err, generic.ErrSkip)
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}, delays)
+	assert.Empty(t, markedFailed)
+
+	// the last attempt gives up on the binding instead of requeuing it
+	_, err := b.run(crtb, failing)
+	require.ErrorIs(t, err, generic.ErrSkip)
+	assert.Len(t, delays, 4)
+	require.Len(t, markedFailed, 1)
+	assert.ErrorContains(t, markedFailed[0], "giving up after 5 attempts: roletemplate not found")
+	assert.Equal(t, 5, calls)
+
+	// the binding isn't synced again until it changes
+	_, err = b.run(crtb, succeeding)
+	require.ErrorIs(t, err, generic.ErrSkip)
+	assert.Equal(t, 5, calls)
+
+	crtb.Generation = 2
+	_, err = b.run(crtb, failing)
+	require.ErrorIs(t, err, generic.ErrSkip)
+	assert.Equal(t, 6, calls)
+	assert.Equal(t, time.Second, delays[len(delays)-1])
+
+	// a successful sync resets the backoff
+	_, err = b.run(crtb, succeeding)
+	require.NoError(t, err)
+	assert.Empty(t, b.failures)
+
+	_, err = b.run(crtb, failing)
+	require.ErrorIs(t, err, generic.ErrSkip)
+	assert.Equal(t, time.Second, delays[len(delays)-1])
+}
+
+func TestRTBBackoffWithoutMarkFailed(t *testing.T) {
+	b := newRTBBackoff[*v3.ProjectRoleTemplateBinding]("test-rtb-backoff-prtb", func(string, string, time.Duration) {}, nil, nil)
+	b.maxAttempts = 1
+
+	prtb := &v3.ProjectRoleTemplateBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "prtb-1", Namespace: "p-abc", UID: "uid-1"},
+	}
+	_, err := b.run(prtb, func(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+		return obj, fmt.Errorf("some error")
+	})
+	require.ErrorIs(t, err, generic.ErrSkip)
+	assert.True(t, b.givenUp(prtb))
+}
+
+func TestCRTBMarkFailed(t *testing.T) {
+	mockTime := time.Unix(0, 0)
+	oldTimeNow := timeNow
+	timeNow = func() time.Time {
+		return mockTime
+	}
+	t.Cleanup(func() {
+		timeNow = oldTimeNow
+	})
+
+	ctrl := gomock.NewController(t)
+	crtb := &v3.ClusterRoleTemplateBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-abc"},
+		Status: v3.ClusterRoleTemplateBindingStatus{
+			SummaryRemote: status.SummaryCompleted,
+			LocalConditions: []metav1.Condition{
+				{Type: subjectExists, Status: metav1.ConditionTrue, Reason: subjectExists},
+			},
+		},
+	}
+	crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+	crtbCache.EXPECT().Get("c-abc", "crtb-1").Return(crtb, nil).Times(2)
+	crtbClient := fake.NewMockControllerInterface[*v3.ClusterRoleTemplateBinding, *v3.ClusterRoleTemplateBindingList](ctrl)
+	crtbClient.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(obj *v3.ClusterRoleTemplateBinding) (*v3.ClusterRoleTemplateBinding, error) {
+		require.Len(t, obj.Status.LocalConditions, 2)
+		condition := obj.Status.LocalConditions[1]
+		assert.Equal(t, reconciled, condition.Type)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, maxAttemptsReached, condition.Reason)
+		assert.Equal(t, "some error", condition.Message)
+		assert.Equal(t, status.SummaryError, obj.Status.Summary)
+		assert.Equal(t, status.SummaryError, obj.Status.SummaryLocal)
+		return obj, nil
+	})
+
+	c := &crtbLifecycle{
+		crtbCache:  crtbCache,
+		crtbClient: crtbClient,
+		s:          &status.Status{TimeNow: timeNow},
+	}
+	require.NoError(t, c.markFailed(crtb, fmt.Errorf("some error")))
+}
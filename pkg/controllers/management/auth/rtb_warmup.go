@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	rtbWarmupPeriod = 2 * time.Minute
+	// rtbWarmupQPS is the rate at which the bindings deferred during the warm-up are synced after it.
+	rtbWarmupQPS = 20
+)
+
+// rtbWarmup bounds the load of the resync of every binding when the controllers start. During the warm-up period, the
+// bindings whose derived RBAC was already reconciled are not synced but requeued after the period, spread at a fixed
+// rate. The bindings which changed, or whose last sync failed, are synced right away.
+//
+// Deferred bindings are still synced, as their RBAC may have been changed while the controllers weren't running.
+type rtbWarmup[T metav1.Object] struct {
+	controller   string
+	enqueueAfter func(namespace, name string, after time.Duration)
+	// current returns whether the derived RBAC of the binding was reconciled for its current spec.
+	current func(obj T) bool
+
+	until    time.Time
+	interval time.Duration
+	now      func() time.Time
+
+	lock     sync.Mutex
+	next     time.Time
+	deferred map[types.UID]struct{}
+}
+
+func newRTBWarmup[T metav1.Object](controller string, enqueueAfter func(string, string, time.Duration), current func(T) bool) *rtbWarmup[T] {
+	return &rtbWarmup[T]{
+		controller:   controller,
+		enqueueAfter: enqueueAfter,
+		current:      current,
+		until:        time.Now().Add(rtbWarmupPeriod),
+		interval:     time.Second / rtbWarmupQPS,
+		now:          time.Now,
+		deferred:     map[types.UID]struct{}{},
+	}
+}
+
+// run runs f for the binding, unless its sync is deferred to after the warm-up. generic.ErrSkip is returned for the
+// deferred bindings.
+func (w *rtbWarmup[T]) run(obj T, f func(T) (runtime.Object, error)) (runtime.Object, error) {
+	if w.deferSync(obj) {
+		return nil, generic.ErrSkip
+	}
+	return f(obj)
+}
+
+// deferSync returns whether the sync of the binding is deferred, and requeues it at the next free slot after the
+// warm-up if it wasn't yet.
+func (w *rtbWarmup[T]) deferSync(obj T) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	now := w.now()
+	if !now.Before(w.until) || !w.current(obj) {
+		delete(w.deferred, obj.GetUID())
+		return false
+	}
+	if _, ok := w.deferred[obj.GetUID()]; ok {
+		return true
+	}
+
+	if w.next.Before(w.until) {
+		w.next = w.until
+	}
+	after := w.next.Sub(now)
+	w.next = w.next.Add(w.interval)
+	w.deferred[obj.GetUID()] = struct{}{}
+	logrus.Debugf("[%s] Deferring the sync of %s by %s during the warm-up", w.controller, syncKey(obj), after)
+	w.enqueueAfter(obj.GetNamespace(), obj.GetName(), after)
+	return true
+}
+
+// crtbReconciled returns whether the local RBAC of the crtb was reconciled for its generation.
+func crtbReconciled(obj *v3.ClusterRoleTemplateBinding) bool {
+	return obj.Status.ObservedGenerationLocal == obj.Generation && obj.Status.SummaryLocal == status.SummaryCompleted
+}
+
+// prtbReconciled returns whether the RBAC of the prtb was reconciled for its subject, project and role template. PRTBs
+// have no status, the immutable fields are recorded once their RBAC was reconciled.
+func prtbReconciled(obj *v3.ProjectRoleTemplateBinding) bool {
+	recorded, ok := recordedImmutableFields(obj)
+	return ok && recorded == prtbImmutableFields(obj)
+}
+
+// warmupCRTBLifecycle defers the resync of the reconciled crtbs when the controllers start.
+type warmupCRTBLifecycle struct {
+	lifecycle mgmtv3.ClusterRoleTemplateBindingLifecycle
+	warmup    *rtbWarmup[*v3.ClusterRoleTemplateBinding]
+}
+
+func (w *warmupCRTBLifecycle) Create(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	return w.lifecycle.Create(obj)
+}
+
+func (w *warmupCRTBLifecycle) Updated(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	return w.warmup.run(obj, w.lifecycle.Updated)
+}
+
+func (w *warmupCRTBLifecycle) Remove(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	return w.lifecycle.Remove(obj)
+}
+
+// warmupPRTBLifecycle defers the resync of the reconciled prtbs when the controllers start.
+type warmupPRTBLifecycle struct {
+	lifecycle mgmtv3.ProjectRoleTemplateBindingLifecycle
+	warmup    *rtbWarmup[*v3.ProjectRoleTemplateBinding]
+}
+
+func (w *warmupPRTBLifecycle) Create(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	return w.lifecycle.Create(obj)
+}
+
+func (w *warmupPRTBLifecycle) Updated(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	return w.warmup.run(obj, w.lifecycle.Updated)
+}
+
+func (w *warmupPRTBLifecycle) Remove(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	return w.lifecycle.Remove(obj)
+}
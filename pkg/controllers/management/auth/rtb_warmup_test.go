@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/rancher/wrangler/v3/pkg/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestRTBWarmup(t *testing.T) {
+	start := time.Now()
+	now := start
+	var delays []time.Duration
+	w := newRTBWarmup("test-rtb-warmup",
+		func(namespace, name string, after time.Duration) {
+			delays = append(delays, after)
+		},
+		crtbReconciled,
+	)
+	w.until = start.Add(time.Minute)
+	w.interval = time.Second
+	w.now = func() time.Time { return now }
+
+	newCRTB := func(uid string, generation, observedGeneration int64) *v3.ClusterRoleTemplateBinding {
+		return &v3.ClusterRoleTemplateBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: uid, Namespace: "c-abc", UID: types.UID("uid-" + uid), Generation: generation},
+			Status: v3.ClusterRoleTemplateBindingStatus{
+				ObservedGenerationLocal: observedGeneration,
+				SummaryLocal:            status.SummaryCompleted,
+			},
+		}
+	}
+	var synced []string
+	sync := func(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+		synced = append(synced, obj.Name)
+		return obj, nil
+	}
+
+	// reconciled bindings are deferred to after the warm-up, one per interval
+	for _, crtb := range []*v3.ClusterRoleTemplateBinding{newCRTB("crtb-1", 1, 1), newCRTB("crtb-2", 1, 1)} {
+		_, err := w.run(crtb, sync)
+		require.ErrorIs(t, err, generic.ErrSkip)
+	}
+	assert.Equal(t, []time.Duration{time.Minute, time.Minute + time.Second}, delays)
+	assert.Empty(t, synced)
+
+	// a binding already deferred isn't requeued again
+	now = start.Add(10 * time.Second)
+	_, err := w.run(newCRTB("crtb-1", 1, 1), sync)
+	require.ErrorIs(t, err, generic.ErrSkip)
+	assert.Len(t, delays, 2)
+
+	// bindings which changed are synced right away
+	_, err = w.run(newCRTB("crtb-3", 2, 1), sync)
+	require.NoError(t, err)
+	failed := newCRTB("crtb-4", 1, 1)
+	failed.Status.SummaryLocal = status.SummaryError
+	_, err = w.run(failed, sync)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"crtb-3", "crtb-4"}, synced)
+	assert.Len(t, delays, 2)
+
+	// the deferred bindings are synced after the warm-up
+	now = start.Add(time.Minute)
+	_, err = w.run(newCRTB("crtb-1", 1, 1), sync)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"crtb-3", "crtb-4", "crtb-1"}, synced)
+	assert.Len(t, delays, 2)
+	assert.NotContains(t, w.deferred, newCRTB("crtb-1", 1, 1).UID)
+}
@@ -51,7 +51,8 @@ func (c *SettingController) sync(key string, obj *v3.Setting) (runtime.Object, e
 		}
 	case settings.DisableInactiveUserAfter.Name,
 		settings.DeleteInactiveUserAfter.Name,
-		settings.UserLastLoginDefault.Name:
+		settings.UserLastLoginDefault.Name,
+		settings.UserRetentionProviderRules.Name:
 		if err := c.ensureUserRetentionLabels(); err != nil {
 			logrus.Errorf("error updating retention labels for users: %v", err)
 		}
@@ -13,6 +13,7 @@ func TestSettingsSyncEnsureUserRetentionLabels(t *testing.T) {
 		settings.DisableInactiveUserAfter.Name,
 		settings.DeleteInactiveUserAfter.Name,
 		settings.UserLastLoginDefault.Name,
+		settings.UserRetentionProviderRules.Name,
 	} {
 		t.Run(name, func(t *testing.T) {
 			var ensureLabelsCalledTimes int
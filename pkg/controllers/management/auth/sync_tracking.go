@@ -0,0 +1,81 @@
+package auth
+
+import (
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/metrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// trackedCRTBLifecycle reports the sync lag of a crtb lifecycle through a metrics.SyncTracker.
+type trackedCRTBLifecycle struct {
+	lifecycle mgmtv3.ClusterRoleTemplateBindingLifecycle
+	tracker   *metrics.SyncTracker
+}
+
+func (t *trackedCRTBLifecycle) Create(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	return trackSync(t.tracker, obj, t.lifecycle.Create)
+}
+
+func (t *trackedCRTBLifecycle) Updated(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	return trackSync(t.tracker, obj, t.lifecycle.Updated)
+}
+
+func (t *trackedCRTBLifecycle) Remove(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	return trackSync(t.tracker, obj, t.lifecycle.Remove)
+}
+
+// trackedPRTBLifecycle reports the sync lag of a prtb lifecycle through a metrics.SyncTracker.
+type trackedPRTBLifecycle struct {
+	lifecycle mgmtv3.ProjectRoleTemplateBindingLifecycle
+	tracker   *metrics.SyncTracker
+}
+
+func (t *trackedPRTBLifecycle) Create(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	return trackSync(t.tracker, obj, t.lifecycle.Create)
+}
+
+func (t *trackedPRTBLifecycle) Updated(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	return trackSync(t.tracker, obj, t.lifecycle.Updated)
+}
+
+func (t *trackedPRTBLifecycle) Remove(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	return trackSync(t.tracker, obj, t.lifecycle.Remove)
+}
+
+// trackedUserLifecycle reports the sync lag of a user lifecycle through a metrics.SyncTracker.
+type trackedUserLifecycle struct {
+	lifecycle mgmtv3.UserLifecycle
+	tracker   *metrics.SyncTracker
+}
+
+func (t *trackedUserLifecycle) Create(obj *v3.User) (runtime.Object, error) {
+	return trackSync(t.tracker, obj, t.lifecycle.Create)
+}
+
+func (t *trackedUserLifecycle) Updated(obj *v3.User) (runtime.Object, error) {
+	return trackSync(t.tracker, obj, t.lifecycle.Updated)
+}
+
+func (t *trackedUserLifecycle) Remove(obj *v3.User) (runtime.Object, error) {
+	return trackSync(t.tracker, obj, t.lifecycle.Remove)
+}
+
+func trackSync[T metav1.Object](tracker *metrics.SyncTracker, obj T, f func(T) (runtime.Object, error)) (runtime.Object, error) {
+	var result runtime.Object
+	err := tracker.Track(syncKey(obj), func() error {
+		var err error
+		result, err = f(obj)
+		return err
+	})
+	return result, err
+}
+
+// syncKey returns the key of the object in the queue of its controller.
+func syncKey(obj metav1.Object) string {
+	if obj.GetNamespace() != "" {
+		return obj.GetNamespace() + "/" + obj.GetName()
+	}
+	return obj.GetName()
+}
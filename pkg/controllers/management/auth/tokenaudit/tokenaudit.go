@@ -0,0 +1,281 @@
+// Package tokenaudit reports the tokens of users of disabled or removed auth providers. Those tokens can't be used to
+// authenticate, but they survive the provider and become usable again if it's enabled again. Orphaned tokens are
+// annotated with the time they were found and why, each disabled AuthConfig gets a condition summarizing its orphaned
+// tokens, and tokens orphaned for longer than the orphaned-token-revocation-grace-period setting are revoked.
+package tokenaudit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/rancher/wrangler/v3/pkg/relatedresource"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	tokenControllerName      = "mgmt-auth-token-audit-controller"
+	reportControllerName     = "mgmt-auth-token-audit-report-controller"
+	authConfigEnqueuer       = "mgmt-auth-token-audit-authconfig-enqueuer"
+	settingEnqueuer          = "mgmt-auth-token-audit-setting-enqueuer"
+	tokenByAuthProviderIndex = "mgmt-auth-token-audit-by-auth-provider"
+
+	// OrphanedSinceAnnotation is the time a token was first found orphaned, formatted according to RFC3339.
+	OrphanedSinceAnnotation = "authn.management.cattle.io/orphaned-since"
+	// OrphanedReasonAnnotation is why a token is orphaned, either ReasonProviderDisabled or ReasonProviderRemoved.
+	OrphanedReasonAnnotation = "authn.management.cattle.io/orphaned-reason"
+
+	// ReasonProviderDisabled means the AuthConfig of the auth provider of the token is disabled.
+	ReasonProviderDisabled = "ProviderDisabled"
+	// ReasonProviderRemoved means the AuthConfig of the auth provider of the token doesn't exist.
+	ReasonProviderRemoved = "ProviderRemoved"
+
+	// reportRefreshInterval is how often the report of an AuthConfig with orphaned tokens is refreshed, to account
+	// for the tokens deleted in the meantime.
+	reportRefreshInterval = 10 * time.Minute
+)
+
+type handler struct {
+	tokens          mgmtv3.TokenClient
+	tokenCache      mgmtv3.TokenCache
+	authConfigs     mgmtv3.AuthConfigClient
+	authConfigCache mgmtv3.AuthConfigCache
+
+	enqueueTokenAfter      func(name string, duration time.Duration)
+	enqueueAuthConfig      func(name string)
+	enqueueAuthConfigAfter func(name string, duration time.Duration)
+	now                    func() time.Time
+}
+
+// Register registers the controllers annotating and revoking orphaned tokens, and reporting them on AuthConfigs.
+func Register(ctx context.Context, wContext *wrangler.Context) {
+	tokens := wContext.Mgmt.Token()
+	authConfigs := wContext.Mgmt.AuthConfig()
+	tokens.Cache().AddIndexer(tokenByAuthProviderIndex, tokenByAuthProvider)
+
+	h := &handler{
+		tokens:                 tokens,
+		tokenCache:             tokens.Cache(),
+		authConfigs:            authConfigs,
+		authConfigCache:        authConfigs.Cache(),
+		enqueueTokenAfter:      tokens.EnqueueAfter,
+		enqueueAuthConfig:      authConfigs.Enqueue,
+		enqueueAuthConfigAfter: authConfigs.EnqueueAfter,
+		now:                    time.Now,
+	}
+	relatedresource.WatchClusterScoped(ctx, authConfigEnqueuer, h.enqueueProviderTokens, tokens, authConfigs)
+	relatedresource.WatchClusterScoped(ctx, settingEnqueuer, h.enqueueOrphanedTokens, tokens, wContext.Mgmt.Setting())
+	tokens.OnChange(ctx, tokenControllerName, h.onTokenChange)
+	authConfigs.OnChange(ctx, reportControllerName, h.onAuthConfigChange)
+}
+
+// tokenByAuthProvider indexes tokens by the name of their auth provider.
+func tokenByAuthProvider(token *v3.Token) ([]string, error) {
+	if token.AuthProvider == "" {
+		return nil, nil
+	}
+	return []string{token.AuthProvider}, nil
+}
+
+// enqueueProviderTokens enqueues the tokens of an auth provider when its AuthConfig is enabled, disabled or removed.
+func (h *handler) enqueueProviderTokens(_, name string, _ runtime.Object) ([]relatedresource.Key, error) {
+	tokens, err := h.tokenCache.GetByIndex(tokenByAuthProviderIndex, name)
+	if err != nil {
+		return nil, fmt.Errorf("listing tokens of auth provider %s: %w", name, err)
+	}
+	keys := make([]relatedresource.Key, 0, len(tokens))
+	for _, token := range tokens {
+		keys = append(keys, relatedresource.Key{Name: token.Name})
+	}
+	return keys, nil
+}
+
+// enqueueOrphanedTokens enqueues the orphaned tokens when the revocation grace period changes.
+func (h *handler) enqueueOrphanedTokens(_, name string, _ runtime.Object) ([]relatedresource.Key, error) {
+	if name != settings.OrphanedTokenRevocationGracePeriod.Name {
+		return nil, nil
+	}
+	tokens, err := h.tokenCache.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("listing tokens: %w", err)
+	}
+	var keys []relatedresource.Key
+	for _, token := range tokens {
+		if _, ok := token.Annotations[OrphanedSinceAnnotation]; ok {
+			keys = append(keys, relatedresource.Key{Name: token.Name})
+		}
+	}
+	return keys, nil
+}
+
+// onTokenChange annotates the token if its auth provider is disabled or removed, and revokes it once the grace
+// period has passed. The annotations are removed if the auth provider is enabled again.
+func (h *handler) onTokenChange(_ string, token *v3.Token) (*v3.Token, error) {
+	if token == nil || token.DeletionTimestamp != nil || token.AuthProvider == "" {
+		return token, nil
+	}
+
+	reason, err := h.orphanedReason(token.AuthProvider)
+	if err != nil {
+		return token, err
+	}
+
+	if reason == "" {
+		if _, ok := token.Annotations[OrphanedSinceAnnotation]; !ok {
+			return token, nil
+		}
+		logrus.Infof("[%s] Auth provider %s of token %s is enabled again", tokenControllerName, token.AuthProvider, token.Name)
+		token = token.DeepCopy()
+		delete(token.Annotations, OrphanedSinceAnnotation)
+		delete(token.Annotations, OrphanedReasonAnnotation)
+		h.enqueueAuthConfig(token.AuthProvider)
+		return h.tokens.Update(token)
+	}
+
+	since, err := time.Parse(time.RFC3339, token.Annotations[OrphanedSinceAnnotation])
+	if err != nil || token.Annotations[OrphanedReasonAnnotation] != reason {
+		if err != nil {
+			since = h.now().Truncate(time.Second)
+			logrus.Infof("[%s] Token %s of user %s is orphaned: %s %s", tokenControllerName, token.Name, token.UserID, token.AuthProvider, reason)
+		}
+		token = token.DeepCopy()
+		if token.Annotations == nil {
+			token.Annotations = make(map[string]string)
+		}
+		token.Annotations[OrphanedSinceAnnotation] = since.Format(time.RFC3339)
+		token.Annotations[OrphanedReasonAnnotation] = reason
+		if token, err = h.tokens.Update(token); err != nil {
+			return nil, err
+		}
+		h.enqueueAuthConfig(token.AuthProvider)
+	}
+
+	gracePeriod, err := revocationGracePeriod()
+	if err != nil {
+		logrus.Errorf("[%s] Not revoking orphaned tokens: %v", tokenControllerName, err)
+		return token, nil
+	}
+	if gracePeriod <= 0 {
+		return token, nil
+	}
+	if remaining := since.Add(gracePeriod).Sub(h.now()); remaining > 0 {
+		h.enqueueTokenAfter(token.Name, remaining)
+		return token, nil
+	}
+
+	logrus.Infof("[%s] Revoking token %s of user %s, orphaned since %s: %s %s",
+		tokenControllerName, token.Name, token.UserID, since.Format(time.RFC3339), token.AuthProvider, reason)
+	if err := h.tokens.Delete(token.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return token, fmt.Errorf("revoking token %s: %w", token.Name, err)
+	}
+	h.enqueueAuthConfig(token.AuthProvider)
+	return token, nil
+}
+
+// orphanedReason returns why the tokens of the auth provider are orphaned, or an empty string if they aren't.
+func (h *handler) orphanedReason(provider string) (string, error) {
+	authConfig, err := h.authConfigCache.Get(provider)
+	if apierrors.IsNotFound(err) {
+		return ReasonProviderRemoved, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("getting auth config %s: %w", provider, err)
+	}
+	if !authConfig.Enabled {
+		return ReasonProviderDisabled, nil
+	}
+	return "", nil
+}
+
+// onAuthConfigChange sets the OrphanedTokens condition of a disabled AuthConfig, summarizing the login sessions and
+// tokens of its users that remain.
+func (h *handler) onAuthConfigChange(_ string, authConfig *v3.AuthConfig) (*v3.AuthConfig, error) {
+	if authConfig == nil || authConfig.DeletionTimestamp != nil {
+		return authConfig, nil
+	}
+
+	var message string
+	if !authConfig.Enabled {
+		tokens, err := h.tokenCache.GetByIndex(tokenByAuthProviderIndex, authConfig.Name)
+		if err != nil {
+			return authConfig, fmt.Errorf("listing tokens of auth provider %s: %w", authConfig.Name, err)
+		}
+		message = summarize(tokens)
+	}
+
+	cond := v3.AuthConfigConditionOrphanedTokens
+	if message != "" {
+		h.enqueueAuthConfigAfter(authConfig.Name, reportRefreshInterval)
+		if cond.IsTrue(authConfig) && cond.GetMessage(authConfig) == message {
+			return authConfig, nil
+		}
+	} else if !cond.IsTrue(authConfig) {
+		return authConfig, nil
+	}
+
+	// The AuthConfig is patched, updating it would drop the fields specific to its auth provider.
+	updated := authConfig.DeepCopy()
+	if message != "" {
+		cond.True(updated)
+	} else {
+		cond.False(updated)
+	}
+	cond.Message(updated, message)
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{"conditions": updated.Status.Conditions},
+	})
+	if err != nil {
+		return authConfig, err
+	}
+	return h.authConfigs.Patch(authConfig.Name, types.MergePatchType, patch)
+}
+
+// summarize describes the orphaned login sessions and tokens, or returns an empty string if there are none.
+func summarize(tokens []*v3.Token) string {
+	var sessions, derived int
+	users := make(map[string]struct{})
+	for _, token := range tokens {
+		if _, ok := token.Annotations[OrphanedSinceAnnotation]; !ok || token.DeletionTimestamp != nil {
+			continue
+		}
+		if token.IsDerived {
+			derived++
+		} else {
+			sessions++
+		}
+		users[token.UserID] = struct{}{}
+	}
+	if len(users) == 0 {
+		return ""
+	}
+
+	message := fmt.Sprintf("%d login sessions and %d tokens of %d users remain", sessions, derived, len(users))
+	if gracePeriod, err := revocationGracePeriod(); err == nil && gracePeriod > 0 {
+		message += fmt.Sprintf(", they are revoked once orphaned for %v", gracePeriod)
+	}
+	return message
+}
+
+// revocationGracePeriod returns the value of the orphaned-token-revocation-grace-period setting, zero if tokens
+// aren't revoked.
+func revocationGracePeriod() (time.Duration, error) {
+	value := settings.OrphanedTokenRevocationGracePeriod.Get()
+	if value == "" {
+		return 0, nil
+	}
+	gracePeriod, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", settings.OrphanedTokenRevocationGracePeriod.Name, err)
+	}
+	return gracePeriod, nil
+}
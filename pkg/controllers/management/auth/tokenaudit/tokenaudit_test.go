@@ -0,0 +1,214 @@
+package tokenaudit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newToken(name, provider string, annotations map[string]string) *v3.Token {
+	return &v3.Token{
+		ObjectMeta:   metav1.ObjectMeta{Name: name, Annotations: annotations},
+		UserID:       "u-1",
+		AuthProvider: provider,
+	}
+}
+
+func orphaned(since time.Time, reason string) map[string]string {
+	return map[string]string{
+		OrphanedSinceAnnotation:  since.Format(time.RFC3339),
+		OrphanedReasonAnnotation: reason,
+	}
+}
+
+func TestOnTokenChange(t *testing.T) {
+	authConfigs := map[string]*v3.AuthConfig{
+		"github":          {ObjectMeta: metav1.ObjectMeta{Name: "github"}, Enabled: true},
+		"activedirectory": {ObjectMeta: metav1.ObjectMeta{Name: "activedirectory"}, Enabled: false},
+	}
+
+	tests := []struct {
+		name            string
+		token           *v3.Token
+		gracePeriod     string
+		wantAnnotations map[string]string
+		wantEnqueued    time.Duration
+		wantDeleted     bool
+	}{
+		{
+			name:  "token of an enabled provider",
+			token: newToken("token-1", "github", nil),
+		},
+		{
+			name:            "token of a disabled provider is annotated",
+			token:           newToken("token-1", "activedirectory", nil),
+			wantAnnotations: orphaned(now, ReasonProviderDisabled),
+		},
+		{
+			name:            "token of a removed provider is annotated",
+			token:           newToken("token-1", "keycloak", nil),
+			wantAnnotations: orphaned(now, ReasonProviderRemoved),
+		},
+		{
+			name:            "annotations are removed when the provider is enabled again",
+			token:           newToken("token-1", "github", orphaned(now.Add(-time.Hour), ReasonProviderDisabled)),
+			wantAnnotations: map[string]string{},
+		},
+		{
+			name:        "orphaned token is revoked after the grace period",
+			token:       newToken("token-1", "activedirectory", orphaned(now.Add(-time.Hour), ReasonProviderDisabled)),
+			gracePeriod: "1h",
+			wantDeleted: true,
+		},
+		{
+			name:         "orphaned token is checked again at the end of the grace period",
+			token:        newToken("token-1", "activedirectory", orphaned(now.Add(-time.Hour), ReasonProviderDisabled)),
+			gracePeriod:  "3h",
+			wantEnqueued: 2 * time.Hour,
+		},
+		{
+			name:        "orphaned token isn't revoked without a grace period",
+			token:       newToken("token-1", "activedirectory", orphaned(now.Add(-time.Hour), ReasonProviderDisabled)),
+			gracePeriod: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, settings.OrphanedTokenRevocationGracePeriod.Set(tt.gracePeriod))
+			t.Cleanup(func() { settings.OrphanedTokenRevocationGracePeriod.Set("") })
+
+			ctrl := gomock.NewController(t)
+			authConfigCache := fake.NewMockNonNamespacedCacheInterface[*v3.AuthConfig](ctrl)
+			authConfigCache.EXPECT().Get(gomock.Any()).DoAndReturn(func(name string) (*v3.AuthConfig, error) {
+				if authConfig, ok := authConfigs[name]; ok {
+					return authConfig, nil
+				}
+				return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+			}).AnyTimes()
+
+			tokens := fake.NewMockNonNamespacedControllerInterface[*v3.Token, *v3.TokenList](ctrl)
+			var updated *v3.Token
+			tokens.EXPECT().Update(gomock.Any()).DoAndReturn(func(token *v3.Token) (*v3.Token, error) {
+				updated = token
+				return token, nil
+			}).AnyTimes()
+			var deleted bool
+			tokens.EXPECT().Delete("token-1", gomock.Any()).DoAndReturn(func(_ string, _ *metav1.DeleteOptions) error {
+				deleted = true
+				return nil
+			}).AnyTimes()
+
+			var enqueued time.Duration
+			h := &handler{
+				tokens:          tokens,
+				authConfigCache: authConfigCache,
+				enqueueTokenAfter: func(_ string, duration time.Duration) {
+					enqueued = duration
+				},
+				enqueueAuthConfig: func(string) {},
+				now:               func() time.Time { return now },
+			}
+
+			_, err := h.onTokenChange("", tt.token)
+			require.NoError(t, err)
+			if tt.wantAnnotations == nil {
+				assert.Nil(t, updated)
+			} else {
+				require.NotNil(t, updated)
+				assert.Equal(t, tt.wantAnnotations, updated.Annotations)
+			}
+			assert.Equal(t, tt.wantEnqueued, enqueued)
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
+
+func TestOnAuthConfigChange(t *testing.T) {
+	since := orphaned(now, ReasonProviderDisabled)
+	derived := newToken("token-2", "activedirectory", since)
+	derived.IsDerived = true
+	providerTokens := []*v3.Token{
+		newToken("token-1", "activedirectory", since),
+		derived,
+		newToken("token-3", "activedirectory", nil),
+	}
+
+	tests := []struct {
+		name        string
+		authConfig  *v3.AuthConfig
+		wantPatched bool
+		wantStatus  string
+		wantMessage string
+	}{
+		{
+			name:        "disabled provider with orphaned tokens",
+			authConfig:  &v3.AuthConfig{ObjectMeta: metav1.ObjectMeta{Name: "activedirectory"}},
+			wantPatched: true,
+			wantStatus:  "True",
+			wantMessage: "1 login sessions and 1 tokens of 1 users remain",
+		},
+		{
+			name:       "enabled provider without report",
+			authConfig: &v3.AuthConfig{ObjectMeta: metav1.ObjectMeta{Name: "activedirectory"}, Enabled: true},
+		},
+		{
+			name: "report is cleared when the provider is enabled again",
+			authConfig: func() *v3.AuthConfig {
+				authConfig := &v3.AuthConfig{ObjectMeta: metav1.ObjectMeta{Name: "activedirectory"}, Enabled: true}
+				v3.AuthConfigConditionOrphanedTokens.True(authConfig)
+				v3.AuthConfigConditionOrphanedTokens.Message(authConfig, "1 login sessions and 1 tokens of 1 users remain")
+				return authConfig
+			}(),
+			wantPatched: true,
+			wantStatus:  "False",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tokenCache := fake.NewMockNonNamespacedCacheInterface[*v3.Token](ctrl)
+			tokenCache.EXPECT().GetByIndex(tokenByAuthProviderIndex, "activedirectory").Return(providerTokens, nil).AnyTimes()
+
+			authConfigs := fake.NewMockNonNamespacedControllerInterface[*v3.AuthConfig, *v3.AuthConfigList](ctrl)
+			var patched *v3.AuthConfig
+			authConfigs.EXPECT().Patch("activedirectory", types.MergePatchType, gomock.Any()).DoAndReturn(
+				func(_ string, _ types.PatchType, data []byte, _ ...string) (*v3.AuthConfig, error) {
+					patched = &v3.AuthConfig{}
+					require.NoError(t, json.Unmarshal(data, patched))
+					return patched, nil
+				}).AnyTimes()
+
+			h := &handler{
+				tokenCache:             tokenCache,
+				authConfigs:            authConfigs,
+				enqueueAuthConfigAfter: func(string, time.Duration) {},
+			}
+
+			_, err := h.onAuthConfigChange("", tt.authConfig)
+			require.NoError(t, err)
+			if !tt.wantPatched {
+				assert.Nil(t, patched)
+				return
+			}
+			require.NotNil(t, patched)
+			cond := v3.AuthConfigConditionOrphanedTokens
+			assert.Equal(t, tt.wantStatus, cond.GetStatus(patched))
+			assert.Equal(t, tt.wantMessage, cond.GetMessage(patched))
+		})
+	}
+}
@@ -440,7 +440,7 @@ func (l *userLifecycle) deleteClusterUserAttributes(username string, tokens []*v
 	}
 
 	for _, cluster := range set {
-		userCtx, err := l.clusterManager.UserContextNoControllers(cluster.Name)
+		userCtx, err := l.clusterManager.UserContextForPurpose(cluster.Name, clustermanager.PurposeUserCleanup)
 		if err != nil {
 			return err
 		}
@@ -464,6 +464,9 @@ func (l *userLifecycle) deleteAllTokens(tokens []*v3.Token) error {
 	return nil
 }
 
+// deleteAllExtTokens deletes the ext tokens of a removed user. The backing secrets of the tokens are owned by the
+// user, and garbage collected with it regardless, but deleting them through the store also deletes their hashes kept
+// outside of the secrets.
 func (l *userLifecycle) deleteAllExtTokens(tokens []*ext.Token) error {
 	for _, token := range tokens {
 		logrus.Infof("[%v] Deleting token %v for user %v",
@@ -472,6 +475,7 @@ func (l *userLifecycle) deleteAllExtTokens(tokens []*ext.Token) error {
 		if err != nil {
 			return fmt.Errorf("error deleting ext token: %v", err)
 		}
+		l.extTokenStore.DeleteHash(token)
 	}
 
 	return nil
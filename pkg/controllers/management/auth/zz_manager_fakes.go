@@ -168,3 +168,18 @@ func (mr *MockmanagerInterfaceMockRecorder) removeAuthV2Permissions(arg0, arg1 a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "removeAuthV2Permissions", reflect.TypeOf((*MockmanagerInterface)(nil).removeAuthV2Permissions), arg0, arg1)
 }
+
+// resolveRoleTemplates mocks base method.
+func (m *MockmanagerInterface) resolveRoleTemplates(arg0 string) ([]resolvedRoleTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "resolveRoleTemplates", arg0)
+	ret0, _ := ret[0].([]resolvedRoleTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// resolveRoleTemplates indicates an expected call of resolveRoleTemplates.
+func (mr *MockmanagerInterfaceMockRecorder) resolveRoleTemplates(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "resolveRoleTemplates", reflect.TypeOf((*MockmanagerInterface)(nil).resolveRoleTemplates), arg0)
+}
@@ -30,6 +30,7 @@ func Register(ctx context.Context, wrangler *wrangler.Context) {
 		clusterCache: wrangler.Mgmt.Cluster().Cache(),
 		clusters:     wrangler.Mgmt.Cluster(),
 		tunnelServer: wrangler.TunnelServer,
+		tunnels:      map[string]*tunnelHealth{},
 	}
 
 	go func() {
@@ -45,6 +46,8 @@ type checker struct {
 	clusterCache managementcontrollers.ClusterCache
 	clusters     managementcontrollers.ClusterClient
 	tunnelServer *remotedialer.Server
+	// tunnels is only accessed by the goroutine running the checks.
+	tunnels map[string]*tunnelHealth
 }
 
 func (c *checker) check() error {
@@ -53,19 +56,27 @@ func (c *checker) check() error {
 		return err
 	}
 
+	existing := map[string]bool{}
 	for _, cluster := range clusters {
+		existing[cluster.Name] = true
 		if err := c.checkCluster(cluster); err != nil {
 			logrus.Errorf("failed to check connectivity of cluster [%s]: %v", cluster.Name, err)
 		}
 	}
+	for name := range c.tunnels {
+		if !existing[name] {
+			delete(c.tunnels, name)
+		}
+	}
 	return nil
 }
 
-func (c *checker) hasSession(cluster *v3.Cluster) bool {
+// hasSession returns whether the cluster agent is connected, and the round trip time of a ping through its tunnel.
+func (c *checker) hasSession(cluster *v3.Cluster) (bool, time.Duration) {
 	clientKey := proxy.Prefix + cluster.Name
 	hasSession := c.tunnelServer.HasSession(clientKey)
 	if !hasSession {
-		return false
+		return false, 0
 	}
 
 	dialer := c.tunnelServer.Dialer(clientKey)
@@ -76,15 +87,17 @@ func (c *checker) hasSession(cluster *v3.Cluster) bool {
 	client := &http.Client{
 		Transport: transport,
 	}
+	start := time.Now()
 	resp, err := client.Get("http://not-used/ping")
 	if err != nil {
-		return false
+		return false, 0
 	}
+	rtt := time.Since(start)
 	defer func() {
 		ioutil.ReadAll(resp.Body)
 		resp.Body.Close()
 	}()
-	return resp.StatusCode == http.StatusOK
+	return resp.StatusCode == http.StatusOK, rtt
 }
 
 func (c *checker) checkCluster(cluster *v3.Cluster) error {
@@ -95,7 +108,14 @@ func (c *checker) checkCluster(cluster *v3.Cluster) error {
 		return nil
 	}
 
-	hasSession := c.hasSession(cluster)
+	hasSession, rtt := c.hasSession(cluster)
+	// The tunnel health is informational, failing to record it must not block the update of the connected condition.
+	if updated, err := c.recordTunnelHealth(cluster, hasSession, rtt); err != nil {
+		logrus.Errorf("failed to record tunnel health of cluster [%s]: %v", cluster.Name, err)
+	} else {
+		cluster = updated
+	}
+
 	// The simpler condition of hasSession == Connected.IsTrue(cluster) is not
 	// used because it treats a non-existent conditions as False
 	if hasSession && Connected.IsTrue(cluster) {
@@ -0,0 +1,95 @@
+package clusterconnected
+
+import (
+	"fmt"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/metrics"
+	"github.com/rancher/wrangler/v3/pkg/condition"
+)
+
+var (
+	// AgentTunnelStable is false when the tunnel of the cluster agent reconnects repeatedly.
+	AgentTunnelStable = condition.Cond("AgentTunnelStable")
+)
+
+const (
+	flappingWindow     = 10 * time.Minute
+	flappingReconnects = 3
+)
+
+// tunnelHealth is the health of the tunnel of a cluster agent, as observed by the periodic checks. Reconnections
+// happening between two checks are not observed.
+type tunnelHealth struct {
+	connected      bool
+	connectedSince time.Time
+	// reconnects are the times of the reconnections observed within the flapping window.
+	reconnects []time.Time
+}
+
+// observe records the state of the tunnel at the given time, and returns whether the tunnel reconnected since the
+// previous observation.
+func (h *tunnelHealth) observe(connected bool, now time.Time) bool {
+	var reconnected bool
+	if connected && !h.connected {
+		// The first connection observed is not a reconnection.
+		reconnected = !h.connectedSince.IsZero()
+		h.connectedSince = now
+		if reconnected {
+			h.reconnects = append(h.reconnects, now)
+		}
+	}
+	h.connected = connected
+
+	for len(h.reconnects) > 0 && now.Sub(h.reconnects[0]) > flappingWindow {
+		h.reconnects = h.reconnects[1:]
+	}
+	return reconnected
+}
+
+// stable returns whether the tunnel is stable, and the message of the AgentTunnelStable condition.
+func (h *tunnelHealth) stable() (bool, string) {
+	if len(h.reconnects) >= flappingReconnects {
+		return false, fmt.Sprintf("cluster agent tunnel reconnected %d times in the last %v", len(h.reconnects), flappingWindow)
+	}
+	if !h.connected {
+		return true, ""
+	}
+	return true, fmt.Sprintf("connected since %s", h.connectedSince.UTC().Format(time.RFC3339))
+}
+
+// recordTunnelHealth records the state of the tunnel of the cluster agent in the metrics and in the AgentTunnelStable
+// condition of the cluster. The round trip time is only reported in the metrics, so that the cluster is not updated
+// on every check.
+func (c *checker) recordTunnelHealth(cluster *v3.Cluster, connected bool, rtt time.Duration) (*v3.Cluster, error) {
+	health := c.tunnels[cluster.Name]
+	if health == nil {
+		health = &tunnelHealth{}
+		c.tunnels[cluster.Name] = health
+	}
+
+	if health.observe(connected, time.Now()) {
+		metrics.IncTunnelReconnects(cluster.Name)
+	}
+	if connected {
+		metrics.SetTunnelConnected(cluster.Name, health.connectedSince, rtt)
+	} else {
+		metrics.SetTunnelDisconnected(cluster.Name)
+	}
+
+	stable, message := health.stable()
+	if stable == AgentTunnelStable.IsTrue(cluster) && message == AgentTunnelStable.GetMessage(cluster) {
+		return cluster, nil
+	}
+
+	cluster = cluster.DeepCopy()
+	AgentTunnelStable.SetStatusBool(cluster, stable)
+	AgentTunnelStable.Message(cluster, message)
+	if stable {
+		AgentTunnelStable.Reason(cluster, "")
+	} else {
+		AgentTunnelStable.Reason(cluster, "Flapping")
+	}
+	return c.clusters.Update(cluster)
+}
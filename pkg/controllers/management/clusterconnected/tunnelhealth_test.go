@@ -0,0 +1,42 @@
+package clusterconnected
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTunnelHealth(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := &tunnelHealth{}
+
+	assert.False(t, h.observe(true, start), "first connection is not a reconnection")
+	stable, message := h.stable()
+	assert.True(t, stable)
+	assert.Equal(t, "connected since 2025-01-01T00:00:00Z", message)
+
+	// Flap three times within the window.
+	now := start
+	for i := 0; i < flappingReconnects; i++ {
+		now = now.Add(time.Minute)
+		assert.False(t, h.observe(false, now))
+		now = now.Add(time.Minute)
+		assert.True(t, h.observe(true, now))
+	}
+	stable, message = h.stable()
+	assert.False(t, stable)
+	assert.Equal(t, "cluster agent tunnel reconnected 3 times in the last 10m0s", message)
+
+	// The tunnel is stable again once the reconnections are out of the window.
+	now = now.Add(flappingWindow)
+	assert.False(t, h.observe(true, now))
+	stable, message = h.stable()
+	assert.True(t, stable)
+	assert.Equal(t, "connected since "+start.Add(6*time.Minute).Format(time.RFC3339), message)
+
+	assert.False(t, h.observe(false, now))
+	stable, message = h.stable()
+	assert.True(t, stable)
+	assert.Empty(t, message)
+}
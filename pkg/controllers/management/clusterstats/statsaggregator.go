@@ -259,7 +259,7 @@ func (s *StatsAggregator) updateVersion(cluster *v3.Cluster) bool {
 // upgraded to >=1.22 to ensure that controllers that are not compatible with v1.22 APIs
 // are stopped, and controllers that are only compatible with v1.22 are started.
 func (s *StatsAggregator) restartAgentDeployment(cluster *v3.Cluster) error {
-	userContext, err := s.ClusterManager.UserContextNoControllers(cluster.Name)
+	userContext, err := s.ClusterManager.UserContextForPurpose(cluster.Name, clustermanager.PurposeAgentRestart)
 	if err != nil {
 		return err
 	}
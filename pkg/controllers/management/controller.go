@@ -20,6 +20,7 @@
 	"github.com/rancher/rancher/pkg/controllers/management/secretmigrator"
 	"github.com/rancher/rancher/pkg/controllers/management/settings"
 	"github.com/rancher/rancher/pkg/controllers/management/usercontrollers"
+	"github.com/rancher/rancher/pkg/controllers/management/usernamespace"
 	"github.com/rancher/rancher/pkg/controllers/managementlegacy"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/rancher/pkg/wrangler"
@@ -47,6 +48,7 @@ func Register(ctx context.Context, management *config.ManagementContext, manager
 
 	secretmigrator.Register(ctx, management)
 	settings.Register(ctx, management)
+	usernamespace.Register(ctx, management)
 	managementlegacy.Register(ctx, management, manager)
 
 	// Register last
@@ -0,0 +1,86 @@
+// Package controllerserviceaccounts migrates the downstream clusters to the service accounts the management controllers
+// act as with the controller-service-accounts feature. The service accounts of all the purposes, see
+// [clustermanager.Purpose], and their RBAC are applied to each ready cluster, which is then annotated with the hash of
+// the purposes. Until then, and whenever the purposes change, the controllers keep acting as the service account of the
+// cluster.
+package controllerserviceaccounts
+
+import (
+	"context"
+	"fmt"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/clustermanager"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/rancher/wrangler/v3/pkg/apply"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	controllerName = "mgmt-controller-service-accounts"
+	setID          = "controller-service-accounts"
+)
+
+type handler struct {
+	clusters mgmtv3.ClusterClient
+	// restConfig returns the REST config of the service account of the cluster.
+	restConfig func(clusterName string) (*rest.Config, error)
+	apply      func(restConfig *rest.Config, objs []runtime.Object) error
+}
+
+// Register registers the controller applying the service accounts of the purposes to the downstream clusters.
+func Register(ctx context.Context, wContext *wrangler.Context, clusterManager *clustermanager.Manager) {
+	h := &handler{
+		clusters: wContext.Mgmt.Cluster(),
+		restConfig: func(clusterName string) (*rest.Config, error) {
+			userContext, err := clusterManager.UserContextNoControllersReconnecting(clusterName, false)
+			if err != nil {
+				return nil, err
+			}
+			return &userContext.RESTConfig, nil
+		},
+		apply: applyObjects,
+	}
+	wContext.Mgmt.Cluster().OnChange(ctx, controllerName, h.onClusterChange)
+}
+
+func (h *handler) onClusterChange(_ string, cluster *v3.Cluster) (*v3.Cluster, error) {
+	if cluster == nil || cluster.DeletionTimestamp != nil || !v3.ClusterConditionReady.IsTrue(cluster) {
+		return cluster, nil
+	}
+	hash := clustermanager.PurposesHash()
+	if cluster.Annotations[clustermanager.AppliedPurposesHashAnnotation] == hash {
+		return cluster, nil
+	}
+
+	restConfig, err := h.restConfig(cluster.Name)
+	if err != nil {
+		return cluster, fmt.Errorf("getting REST config of cluster %s: %w", cluster.Name, err)
+	}
+	logrus.Infof("[%s] Applying the service accounts of the controllers to cluster %s", controllerName, cluster.Name)
+	if err := h.apply(restConfig, clustermanager.PurposeObjects()); err != nil {
+		return cluster, fmt.Errorf("applying the service accounts of the controllers to cluster %s: %w", cluster.Name, err)
+	}
+
+	cluster = cluster.DeepCopy()
+	if cluster.Annotations == nil {
+		cluster.Annotations = make(map[string]string)
+	}
+	cluster.Annotations[clustermanager.AppliedPurposesHashAnnotation] = hash
+	return h.clusters.Update(cluster)
+}
+
+// applyObjects applies the objects to the cluster, deleting the ones of the purposes which were removed.
+func applyObjects(restConfig *rest.Config, objs []runtime.Object) error {
+	apply, err := apply.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	return apply.
+		WithSetID(setID).
+		WithDynamicLookup().
+		ApplyObjects(objs...)
+}
@@ -0,0 +1,108 @@
+package controllerserviceaccounts
+
+import (
+	"errors"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/clustermanager"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+func newCluster(ready bool, annotations map[string]string) *v3.Cluster {
+	cluster := &v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "c-1", Annotations: annotations}}
+	if ready {
+		v3.ClusterConditionReady.True(cluster)
+	} else {
+		v3.ClusterConditionReady.False(cluster)
+	}
+	return cluster
+}
+
+func TestOnClusterChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		cluster     *v3.Cluster
+		applyErr    error
+		wantApplied bool
+		wantUpdated bool
+		wantErr     bool
+	}{
+		{
+			name:        "ready cluster is migrated",
+			cluster:     newCluster(true, nil),
+			wantApplied: true,
+			wantUpdated: true,
+		},
+		{
+			name:        "cluster migrated to other purposes is migrated again",
+			cluster:     newCluster(true, map[string]string{clustermanager.AppliedPurposesHashAnnotation: "outdated"}),
+			wantApplied: true,
+			wantUpdated: true,
+		},
+		{
+			name:    "migrated cluster",
+			cluster: newCluster(true, map[string]string{clustermanager.AppliedPurposesHashAnnotation: clustermanager.PurposesHash()}),
+		},
+		{
+			name:    "cluster not ready",
+			cluster: newCluster(false, nil),
+		},
+		{
+			name:        "cluster isn't annotated when applying fails",
+			cluster:     newCluster(true, nil),
+			applyErr:    errors.New("forbidden"),
+			wantApplied: true,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			clusters := fake.NewMockNonNamespacedControllerInterface[*v3.Cluster, *v3.ClusterList](ctrl)
+			var updated *v3.Cluster
+			clusters.EXPECT().Update(gomock.Any()).DoAndReturn(func(cluster *v3.Cluster) (*v3.Cluster, error) {
+				updated = cluster
+				return cluster, nil
+			}).AnyTimes()
+
+			var applied []runtime.Object
+			h := &handler{
+				clusters: clusters,
+				restConfig: func(clusterName string) (*rest.Config, error) {
+					assert.Equal(t, "c-1", clusterName)
+					return &rest.Config{}, nil
+				},
+				apply: func(_ *rest.Config, objs []runtime.Object) error {
+					applied = objs
+					return tt.applyErr
+				},
+			}
+
+			_, err := h.onClusterChange("", tt.cluster)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantApplied {
+				assert.Equal(t, clustermanager.PurposeObjects(), applied)
+			} else {
+				assert.Nil(t, applied)
+			}
+			if tt.wantUpdated {
+				require.NotNil(t, updated)
+				assert.Equal(t, clustermanager.PurposesHash(), updated.Annotations[clustermanager.AppliedPurposesHashAnnotation])
+			} else {
+				assert.Nil(t, updated)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ type handler struct {
 	featureEnqueue       func(string, time.Duration)
 	tokensLister         managementv3.TokenCache
 	tokenEnqueue         func(string, time.Duration)
+	clusterCache         managementv3.ClusterCache
 	nodeDriverController normanv3.NodeDriverInterface
 	managementContext    *config.ManagementContext
 }
@@ -37,10 +38,12 @@ func Register(ctx context.Context, management *config.ManagementContext, wContex
 		featureEnqueue:       wContext.Mgmt.Feature().EnqueueAfter,
 		tokensLister:         wContext.Mgmt.Token().Cache(),
 		tokenEnqueue:         wContext.Mgmt.Token().EnqueueAfter,
+		clusterCache:         wContext.Mgmt.Cluster().Cache(),
 		nodeDriverController: management.Management.NodeDrivers(""),
 		managementContext:    management,
 	}
 	wContext.Mgmt.Feature().OnChange(ctx, "feature-handler", h.sync)
+	wContext.Mgmt.Cluster().OnChange(ctx, "feature-cluster-handler", h.enqueueVersionedFeatures)
 }
 
 func (h *handler) sync(_ string, obj *v3.Feature) (*v3.Feature, error) {
@@ -53,6 +56,11 @@ func (h *handler) sync(_ string, obj *v3.Feature) (*v3.Feature, error) {
 		return obj, err
 	}
 
+	obj, err = h.syncStatus(obj)
+	if err != nil {
+		return obj, err
+	}
+
 	if obj.Name == features.TokenHashing.Name() {
 		return obj, h.refreshTokens()
 	}
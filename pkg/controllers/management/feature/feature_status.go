@@ -0,0 +1,94 @@
+package feature
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/features"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// syncStatus records in the status of the feature the features it depends on and, for features requiring a minimum
+// Kubernetes version, whether the feature is applied to each cluster.
+func (h *handler) syncStatus(obj *v3.Feature) (*v3.Feature, error) {
+	feature := features.GetFeatureByName(obj.Name)
+	if feature == nil {
+		return obj, nil
+	}
+
+	// the features depending on this one need their unmet dependencies refreshed
+	for _, dependent := range feature.Dependents() {
+		h.featureEnqueue(dependent, 0)
+	}
+
+	clusters, err := h.clusterStatuses(feature)
+	if err != nil {
+		return obj, err
+	}
+
+	featureCopy := obj.DeepCopy()
+	featureCopy.Status.Dependencies = feature.Dependencies()
+	featureCopy.Status.UnmetDependencies = feature.UnmetDependencies()
+	featureCopy.Status.MinKubernetesVersion = feature.MinKubernetesVersion()
+	featureCopy.Status.Clusters = clusters
+	if reflect.DeepEqual(obj.Status, featureCopy.Status) {
+		return obj, nil
+	}
+	return h.featuresClient.Update(featureCopy)
+}
+
+// clusterStatuses returns whether the feature is applied to each cluster, sorted by cluster name. It returns nil for
+// features that don't require a minimum Kubernetes version, as they apply to all the clusters alike.
+func (h *handler) clusterStatuses(feature *features.Feature) ([]v3.FeatureClusterStatus, error) {
+	if feature.MinKubernetesVersion() == "" {
+		return nil, nil
+	}
+
+	clusters, err := h.clusterCache.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	statuses := make([]v3.FeatureClusterStatus, 0, len(clusters))
+	for _, cluster := range clusters {
+		status := v3.FeatureClusterStatus{ClusterName: cluster.Name}
+		switch {
+		case !feature.Enabled():
+			status.Message = "feature is disabled"
+		case len(feature.UnmetDependencies()) > 0:
+			status.Message = fmt.Sprintf("features [%s] are disabled", strings.Join(feature.UnmetDependencies(), ", "))
+		case cluster.Status.Version == nil:
+			status.Message = "Kubernetes version of the cluster is unknown"
+		default:
+			supported, err := feature.SupportsKubernetesVersion(cluster.Status.Version.GitVersion)
+			if err != nil {
+				status.Message = err.Error()
+			} else if !supported {
+				status.Message = fmt.Sprintf("Kubernetes version %s is older than the minimum version %s",
+					cluster.Status.Version.GitVersion, feature.MinKubernetesVersion())
+			} else {
+				status.Applied = true
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].ClusterName < statuses[j].ClusterName })
+	return statuses, nil
+}
+
+// enqueueVersionedFeatures enqueues the features requiring a minimum Kubernetes version when a cluster changes, so
+// that their per-cluster status follows cluster upgrades.
+func (h *handler) enqueueVersionedFeatures(_ string, cluster *v3.Cluster) (*v3.Cluster, error) {
+	if cluster == nil {
+		return cluster, nil
+	}
+	for _, feature := range features.List() {
+		if feature.MinKubernetesVersion() != "" {
+			h.featureEnqueue(feature.Name(), 0)
+		}
+	}
+	return cluster, nil
+}
@@ -0,0 +1,259 @@
+// Package usernamespace enforces the quotas of the namespaces backing users, which keep their preferences, reports
+// their usage, and cleans up the namespaces and secrets left behind by deleted users.
+package usernamespace
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	normancorev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	"github.com/rancher/rancher/pkg/metrics"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/types/config"
+	wcorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	"github.com/rancher/wrangler/v3/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	controllerName = "mgmt-user-namespace-controller"
+	// QuotaName is the name of the resource quota enforcing settings.UserNamespaceObjectQuota in the namespace backing
+	// a user.
+	QuotaName = "user-namespace-quota"
+	// legacySecretNamespace keeps the secrets named after users with the legacySecretSuffix, deleted with the user.
+	legacySecretNamespace = "cattle-system"
+	legacySecretSuffix    = "-secret"
+
+	cleanupInterval = time.Hour
+)
+
+// userNamePattern matches the names generated for users, and so the namespaces backing them.
+var userNamePattern = regexp.MustCompile(`^(u|user)-[a-z0-9]+$`)
+
+type handler struct {
+	users           mgmtcontrollers.UserClient
+	userCache       mgmtcontrollers.UserCache
+	preferenceCache mgmtcontrollers.PreferenceCache
+	namespaces      wcorev1.NamespaceController
+	namespaceCache  wcorev1.NamespaceCache
+	quotas          normancorev1.ResourceQuotaInterface
+	quotaLister     normancorev1.ResourceQuotaLister
+	secrets         wcorev1.SecretClient
+	secretCache     wcorev1.SecretCache
+}
+
+func Register(ctx context.Context, management *config.ManagementContext) {
+	h := &handler{
+		users:           management.Wrangler.Mgmt.User(),
+		userCache:       management.Wrangler.Mgmt.User().Cache(),
+		preferenceCache: management.Wrangler.Mgmt.Preference().Cache(),
+		namespaces:      management.Wrangler.Core.Namespace(),
+		namespaceCache:  management.Wrangler.Core.Namespace().Cache(),
+		quotas:          management.Core.ResourceQuotas(""),
+		quotaLister:     management.Core.ResourceQuotas("").Controller().Lister(),
+		secrets:         management.Wrangler.Core.Secret(),
+		secretCache:     management.Wrangler.Core.Secret().Cache(),
+	}
+
+	management.Wrangler.Core.Namespace().OnChange(ctx, controllerName, h.onNamespaceChange)
+	management.Wrangler.Mgmt.Preference().OnChange(ctx, controllerName, h.onPreferenceChange)
+
+	go func() {
+		for range ticker.Context(ctx, cleanupInterval) {
+			h.cleanup()
+		}
+	}()
+}
+
+// isUserNamespace returns whether the namespace backs an existing user.
+func (h *handler) isUserNamespace(name string) (bool, error) {
+	if !userNamePattern.MatchString(name) {
+		return false, nil
+	}
+	if _, err := h.userCache.Get(name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error getting user %s: %w", name, err)
+	}
+	return true, nil
+}
+
+func (h *handler) onNamespaceChange(key string, namespace *corev1.Namespace) (*corev1.Namespace, error) {
+	if namespace == nil || namespace.DeletionTimestamp != nil {
+		metrics.DeleteUserNamespaceUsage(key)
+		return namespace, nil
+	}
+	ok, err := h.isUserNamespace(namespace.Name)
+	if err != nil || !ok {
+		return namespace, err
+	}
+
+	return namespace, h.ensureQuota(namespace.Name)
+}
+
+// ensureQuota creates, updates or deletes the resource quota of the namespace according to
+// settings.UserNamespaceObjectQuota.
+func (h *handler) ensureQuota(namespace string) error {
+	existing, err := h.quotaLister.Get(namespace, QuotaName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error getting resource quota of namespace %s: %w", namespace, err)
+	}
+
+	limit := settings.UserNamespaceObjectQuota.GetInt()
+	if limit == 0 {
+		if existing == nil {
+			return nil
+		}
+		if err := h.quotas.DeleteNamespaced(namespace, QuotaName, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting resource quota of namespace %s: %w", namespace, err)
+		}
+		return nil
+	}
+
+	hard := quotaHard(limit)
+	if existing == nil {
+		_, err := h.quotas.Create(&corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: QuotaName, Namespace: namespace},
+			Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+		})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("error creating resource quota of namespace %s: %w", namespace, err)
+		}
+		return nil
+	}
+	if equality.Semantic.DeepEqual(existing.Spec.Hard, hard) {
+		return nil
+	}
+	existing = existing.DeepCopy()
+	existing.Spec.Hard = hard
+	if _, err := h.quotas.Update(existing); err != nil {
+		return fmt.Errorf("error updating resource quota of namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
+func quotaHard(limit int) corev1.ResourceList {
+	quantity := resource.MustParse(strconv.Itoa(limit))
+	return corev1.ResourceList{
+		"count/preferences.management.cattle.io": quantity,
+		corev1.ResourceConfigMaps:                quantity,
+		corev1.ResourceSecrets:                   quantity,
+	}
+}
+
+// onPreferenceChange reports the usage of the namespace of the preference, and warns when it exceeds
+// settings.UserNamespaceSizeQuotaBytes, e.g. after the quota was lowered, as the quota is only enforced on updates.
+func (h *handler) onPreferenceChange(key string, preference *v3.Preference) (*v3.Preference, error) {
+	namespace, _, _ := strings.Cut(key, "/")
+	ok, err := h.isUserNamespace(namespace)
+	if err != nil || !ok {
+		return preference, err
+	}
+
+	preferences, err := h.preferenceCache.List(namespace, labels.Everything())
+	if err != nil {
+		return preference, fmt.Errorf("error listing preferences of user %s: %w", namespace, err)
+	}
+	values := make(map[string]string, len(preferences))
+	for _, p := range preferences {
+		values[p.Name] = p.Value
+	}
+	metrics.SetUserNamespaceUsage(namespace, len(values), PreferencesSize(values))
+	if err := SizeQuotaExceeded(values); err != nil {
+		logrus.Warnf("[%s] The preferences of user %s are over quota: %v", controllerName, namespace, err)
+	}
+	return preference, nil
+}
+
+// PreferencesSize returns the size counted against settings.UserNamespaceSizeQuotaBytes of the preferences.
+func PreferencesSize(preferences map[string]string) int {
+	var size int
+	for name, value := range preferences {
+		size += len(name) + len(value)
+	}
+	return size
+}
+
+// SizeQuotaExceeded returns an error if the preferences exceed settings.UserNamespaceSizeQuotaBytes.
+func SizeQuotaExceeded(preferences map[string]string) error {
+	limit := settings.UserNamespaceSizeQuotaBytes.GetInt()
+	if size := PreferencesSize(preferences); limit > 0 && size > limit {
+		return fmt.Errorf("preferences of %d bytes exceed the quota of %d bytes", size, limit)
+	}
+	return nil
+}
+
+// cleanup deletes the namespaces and secrets left behind by deleted users, and enqueues the namespaces of existing
+// users for their quota to follow changes of the settings.
+func (h *handler) cleanup() {
+	namespaces, err := h.namespaceCache.List(labels.Everything())
+	if err != nil {
+		logrus.Errorf("[%s] Error listing namespaces: %v", controllerName, err)
+		return
+	}
+	for _, namespace := range namespaces {
+		if namespace.DeletionTimestamp != nil || !userNamePattern.MatchString(namespace.Name) {
+			continue
+		}
+		if _, err := h.userCache.Get(namespace.Name); err == nil {
+			h.namespaces.Enqueue(namespace.Name)
+			continue
+		}
+		// Only namespaces with preferences are known to back a user.
+		preferences, err := h.preferenceCache.List(namespace.Name, labels.Everything())
+		if err != nil || len(preferences) == 0 {
+			continue
+		}
+		if !h.orphaned(namespace.Name) {
+			continue
+		}
+		logrus.Infof("[%s] Deleting namespace %s left behind by a deleted user", controllerName, namespace.Name)
+		if err := h.namespaces.Delete(namespace.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			logrus.Errorf("[%s] Error deleting namespace %s: %v", controllerName, namespace.Name, err)
+			continue
+		}
+		metrics.DeleteUserNamespaceUsage(namespace.Name)
+		metrics.IncUserNamespaceOrphansDeleted()
+	}
+
+	secrets, err := h.secretCache.List(legacySecretNamespace, labels.Everything())
+	if err != nil {
+		logrus.Errorf("[%s] Error listing secrets: %v", controllerName, err)
+		return
+	}
+	for _, secret := range secrets {
+		userName, ok := strings.CutSuffix(secret.Name, legacySecretSuffix)
+		if !ok || !userNamePattern.MatchString(userName) || !h.orphaned(userName) {
+			continue
+		}
+		logrus.Infof("[%s] Deleting secret %s/%s left behind by a deleted user", controllerName, secret.Namespace, secret.Name)
+		if err := h.secrets.Delete(secret.Namespace, secret.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			logrus.Errorf("[%s] Error deleting secret %s/%s: %v", controllerName, secret.Namespace, secret.Name, err)
+			continue
+		}
+		metrics.IncUserNamespaceOrphansDeleted()
+	}
+}
+
+// orphaned returns whether the user doesn't exist, getting it from the API server rather than the cache not to delete
+// the resources of a user just created.
+func (h *handler) orphaned(userName string) bool {
+	if _, err := h.userCache.Get(userName); !apierrors.IsNotFound(err) {
+		return false
+	}
+	_, err := h.users.Get(userName, metav1.GetOptions{})
+	return apierrors.IsNotFound(err)
+}
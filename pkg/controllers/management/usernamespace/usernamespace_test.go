@@ -0,0 +1,162 @@
+package usernamespace
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func setSetting(t *testing.T, setting settings.Setting, value string) {
+	orig := setting.Get()
+	t.Cleanup(func() {
+		require.NoError(t, setting.Set(orig))
+	})
+	require.NoError(t, setting.Set(value))
+}
+
+func notFound(name string) error {
+	return apierrors.NewNotFound(schema.GroupResource{}, name)
+}
+
+func TestEnsureQuota(t *testing.T) {
+	setSetting(t, settings.UserNamespaceObjectQuota, "10")
+
+	t.Run("quota is created", func(t *testing.T) {
+		var created *corev1.ResourceQuota
+		h := &handler{
+			quotaLister: &fakes.ResourceQuotaListerMock{
+				GetFunc: func(namespace, name string) (*corev1.ResourceQuota, error) {
+					return nil, notFound(name)
+				},
+			},
+			quotas: &fakes.ResourceQuotaInterfaceMock{
+				CreateFunc: func(quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+					created = quota
+					return quota, nil
+				},
+			},
+		}
+		require.NoError(t, h.ensureQuota("u-bob"))
+		require.NotNil(t, created)
+		assert.Equal(t, "u-bob", created.Namespace)
+		assert.Equal(t, QuotaName, created.Name)
+		assert.True(t, created.Spec.Hard[corev1.ResourceSecrets].Equal(resource.MustParse("10")))
+	})
+
+	t.Run("quota is updated", func(t *testing.T) {
+		var updated *corev1.ResourceQuota
+		h := &handler{
+			quotaLister: &fakes.ResourceQuotaListerMock{
+				GetFunc: func(namespace, name string) (*corev1.ResourceQuota, error) {
+					return &corev1.ResourceQuota{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+						Spec:       corev1.ResourceQuotaSpec{Hard: quotaHard(5)},
+					}, nil
+				},
+			},
+			quotas: &fakes.ResourceQuotaInterfaceMock{
+				UpdateFunc: func(quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+					updated = quota
+					return quota, nil
+				},
+			},
+		}
+		require.NoError(t, h.ensureQuota("u-bob"))
+		require.NotNil(t, updated)
+		assert.Equal(t, quotaHard(10), updated.Spec.Hard)
+	})
+
+	t.Run("quota is deleted when disabled", func(t *testing.T) {
+		setSetting(t, settings.UserNamespaceObjectQuota, "0")
+
+		var deleted bool
+		h := &handler{
+			quotaLister: &fakes.ResourceQuotaListerMock{
+				GetFunc: func(namespace, name string) (*corev1.ResourceQuota, error) {
+					return &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}, nil
+				},
+			},
+			quotas: &fakes.ResourceQuotaInterfaceMock{
+				DeleteNamespacedFunc: func(namespace, name string, _ *metav1.DeleteOptions) error {
+					deleted = namespace == "u-bob" && name == QuotaName
+					return nil
+				},
+			},
+		}
+		require.NoError(t, h.ensureQuota("u-bob"))
+		assert.True(t, deleted)
+	})
+}
+
+func TestSizeQuotaExceeded(t *testing.T) {
+	setSetting(t, settings.UserNamespaceSizeQuotaBytes, "10")
+
+	assert.NoError(t, SizeQuotaExceeded(map[string]string{"theme": "dark"}))
+	assert.Error(t, SizeQuotaExceeded(map[string]string{"theme": "ui-dark"}))
+
+	setSetting(t, settings.UserNamespaceSizeQuotaBytes, "0")
+	assert.NoError(t, SizeQuotaExceeded(map[string]string{"theme": "ui-dark"}))
+}
+
+func TestCleanup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+	userCache := fake.NewMockNonNamespacedCacheInterface[*v3.User](ctrl)
+	preferenceCache := fake.NewMockCacheInterface[*v3.Preference](ctrl)
+	namespaces := fake.NewMockNonNamespacedControllerInterface[*corev1.Namespace, *corev1.NamespaceList](ctrl)
+	namespaceCache := fake.NewMockNonNamespacedCacheInterface[*corev1.Namespace](ctrl)
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	secretCache := fake.NewMockCacheInterface[*corev1.Secret](ctrl)
+
+	namespaceCache.EXPECT().List(labels.Everything()).Return([]*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-bob"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-gone"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-empty"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cattle-system"}},
+	}, nil)
+	userCache.EXPECT().Get("u-bob").Return(&v3.User{ObjectMeta: metav1.ObjectMeta{Name: "u-bob"}}, nil).AnyTimes()
+	userCache.EXPECT().Get("u-gone").Return(nil, notFound("u-gone")).AnyTimes()
+	userCache.EXPECT().Get("u-empty").Return(nil, notFound("u-empty"))
+	userCache.EXPECT().Get("u-new").Return(nil, notFound("u-new"))
+
+	// The namespace of an existing user is enqueued for its quota to be updated.
+	namespaces.EXPECT().Enqueue("u-bob")
+	// Namespaces without preferences aren't known to back a user.
+	preferenceCache.EXPECT().List("u-empty", labels.Everything()).Return(nil, nil)
+	preferenceCache.EXPECT().List("u-gone", labels.Everything()).Return([]*v3.Preference{{}}, nil)
+	users.EXPECT().Get("u-gone", gomock.Any()).Return(nil, notFound("u-gone")).Times(2)
+	namespaces.EXPECT().Delete("u-gone", gomock.Any()).Return(nil)
+
+	secretCache.EXPECT().List(legacySecretNamespace, labels.Everything()).Return([]*corev1.Secret{
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-bob-secret", Namespace: legacySecretNamespace}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-gone-secret", Namespace: legacySecretNamespace}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-new-secret", Namespace: legacySecretNamespace}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "serving-cert", Namespace: legacySecretNamespace}},
+	}, nil)
+	// The user isn't in the cache yet.
+	users.EXPECT().Get("u-new", gomock.Any()).Return(&v3.User{}, nil)
+	secrets.EXPECT().Delete(legacySecretNamespace, "u-gone-secret", gomock.Any()).Return(nil)
+
+	h := &handler{
+		users:           users,
+		userCache:       userCache,
+		preferenceCache: preferenceCache,
+		namespaces:      namespaces,
+		namespaceCache:  namespaceCache,
+		secrets:         secrets,
+		secretCache:     secretCache,
+	}
+	h.cleanup()
+}
@@ -6,6 +6,7 @@
 	"github.com/rancher/rancher/pkg/controllers/management/aks"
 	"github.com/rancher/rancher/pkg/controllers/management/authprovisioningv2"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterupstreamrefresher"
+	"github.com/rancher/rancher/pkg/controllers/management/controllerserviceaccounts"
 	"github.com/rancher/rancher/pkg/controllers/management/eks"
 	"github.com/rancher/rancher/pkg/controllers/management/feature"
 	"github.com/rancher/rancher/pkg/controllers/management/gke"
@@ -35,5 +36,9 @@ func RegisterWrangler(ctx context.Context, wranglerContext *wrangler.Context, ma
 		oidcprovider.Register(ctx, wranglerContext)
 	}
 
+	if features.ControllerServiceAccounts.Enabled() {
+		controllerserviceaccounts.Register(ctx, wranglerContext, manager)
+	}
+
 	return nil
 }
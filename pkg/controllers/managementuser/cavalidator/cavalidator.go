@@ -3,11 +3,11 @@
 import (
 	"context"
 
+	"github.com/rancher/rancher/pkg/expansion"
 	mgmtv3controllers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/wrangler/v3/pkg/condition"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -41,31 +41,22 @@ func (c *CertificateAuthorityValidator) onStvAggregationSecret(key string, obj *
 		return nil, nil
 	}
 
-	return obj, retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		mgmtCluster, err := c.clusterCache.Get(c.clusterName)
-		if err != nil {
-			return err
-		}
-		mgmtCluster = mgmtCluster.DeepCopy()
+	status := corev1.ConditionUnknown
+	if string(obj.Data[CacertsValid]) == "true" && len(obj.Data["ca.crt"]) != 0 {
+		status = corev1.ConditionTrue
+	} else if string(obj.Data[CacertsValid]) == "false" {
+		status = corev1.ConditionFalse
+	}
 
-		if string(obj.Data[CacertsValid]) == "true" && len(obj.Data["ca.crt"]) != 0 {
-			if CertificateAuthorityValid.IsTrue(mgmtCluster) {
-				return nil
-			}
-			CertificateAuthorityValid.True(mgmtCluster)
-		} else if string(obj.Data[CacertsValid]) == "false" {
-			if CertificateAuthorityValid.IsFalse(mgmtCluster) {
-				return nil
-			}
-			CertificateAuthorityValid.False(mgmtCluster)
-		} else {
-			if CertificateAuthorityValid.IsUnknown(mgmtCluster) {
-				return nil
-			}
-			CertificateAuthorityValid.Unknown(mgmtCluster)
-		}
+	// The secret is resynced periodically, the cluster is only read from the API server if the condition changed.
+	mgmtCluster, err := c.clusterCache.Get(c.clusterName)
+	if err != nil {
+		return obj, err
+	}
+	if CertificateAuthorityValid.GetStatus(mgmtCluster) == string(status) {
+		return obj, nil
+	}
 
-		_, err = c.clusters.Update(mgmtCluster)
-		return err
-	})
+	_, err = expansion.Clusters(c.clusters).UpdateCondition(c.clusterName, CertificateAuthorityValid, status, "", "")
+	return obj, err
 }
@@ -87,6 +87,31 @@ type args struct {
 				},
 			},
 		},
+		{
+			name: "test-good-ca-unchanged",
+			args: args{
+				secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "stv-aggregation",
+						Namespace: namespace.System,
+					},
+					Data: map[string][]byte{
+						CacertsValid: []byte("true"),
+						"ca.crt":     []byte("test"),
+					},
+				},
+				cluster: &mgmtv3.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "c-cluster",
+					},
+					Status: mgmtv3.ClusterStatus{
+						Conditions: []mgmtv3.ClusterCondition{
+							{Type: mgmtv3.ClusterConditionType(CertificateAuthorityValid), Status: corev1.ConditionTrue},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "test-bad-ca",
 			args: args{
@@ -120,6 +145,7 @@ type args struct {
 
 			mockCluster := fake.NewMockNonNamespacedClientInterface[*mgmtv3.Cluster, *mgmtv3.ClusterList](ctrl)
 			if tt.args.conditionSet {
+				mockCluster.EXPECT().Get(tt.args.cluster.Name, metav1.GetOptions{}).Return(tt.args.cluster, nil)
 				mockCluster.EXPECT().Update(gomock.Any()).DoAndReturn(func(cluster *mgmtv3.Cluster) (*mgmtv3.Cluster, error) {
 					if tt.args.conditionSet {
 						require.Len(t, cluster.Status.Conditions, 1)
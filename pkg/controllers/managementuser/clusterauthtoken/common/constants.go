@@ -4,4 +4,10 @@
 	DefaultNamespace                       = "cattle-system"
 	AuthProviderRefreshDebounceSettingName = "auth-provider-refresh-debounce-seconds"
 	ClusterAuthSecretHashField             = "hash"
+	// RevocationListConfigMapName is the name of the ConfigMap listing the recently revoked ClusterAuthTokens, by name,
+	// with the time they were revoked formatted according to RFC3339.
+	RevocationListConfigMapName = "cattle-auth-revocations"
+	// ReplicateToClustersAnnotation lists the clusters, by name and separated by commas, an unscoped token is synced to
+	// as a ClusterAuthToken, to authenticate through their authorized cluster endpoint.
+	ReplicateToClustersAnnotation = "authn.management.cattle.io/replicate-to-clusters"
 )
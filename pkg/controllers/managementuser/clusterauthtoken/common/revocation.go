@@ -0,0 +1,29 @@
+package common
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// RevokedTokens returns the names of the ClusterAuthTokens listed as revoked in the given revocation list ConfigMap
+// after the given time. Agents caching authentication decisions, such as kube-api-auth, call it when the ConfigMap
+// changes, and evict the decisions they made for these tokens.
+func RevokedTokens(revocations *corev1.ConfigMap, since time.Time) []string {
+	if revocations == nil {
+		return nil
+	}
+
+	var names []string
+	for name, value := range revocations.Data {
+		revokedAt, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			continue
+		}
+		// The list only has a second precision.
+		if !revokedAt.Before(since.Truncate(time.Second)) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
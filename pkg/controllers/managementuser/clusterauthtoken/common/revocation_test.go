@@ -0,0 +1,24 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestRevokedTokens(t *testing.T) {
+	revocations := &corev1.ConfigMap{
+		Data: map[string]string{
+			"token-1": "2024-05-01T12:00:00Z",
+			"token-2": "2024-05-01T08:00:00Z",
+			"token-3": "invalid",
+		},
+	}
+
+	assert.ElementsMatch(t, []string{"token-1", "token-2"}, RevokedTokens(revocations, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, []string{"token-1"}, RevokedTokens(revocations, time.Date(2024, 5, 1, 12, 0, 0, 500, time.UTC)))
+	assert.Empty(t, RevokedTokens(revocations, time.Date(2024, 5, 1, 12, 0, 1, 0, time.UTC)))
+	assert.Empty(t, RevokedTokens(nil, time.Time{}))
+}
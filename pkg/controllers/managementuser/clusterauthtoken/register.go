@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/clusterauthtoken/common"
@@ -22,6 +23,7 @@
 	userAttributeController        = "cat-user-attribute-controller"
 	clusterUserAttributeController = "cat-cluster-user-attribute-controller"
 	clusterAuthTokenController     = "cat-cluster-auth-token-controller"
+	replicatedTokenController      = "cat-replicated-token-controller"
 )
 
 func RegisterIndexers(scaledContext *config.ScaledContext) error {
@@ -78,21 +80,32 @@ func registerDeferred(ctx context.Context, cluster *config.UserContext) {
 		settingInterface,
 	}).Sync)
 
-	cluster.Management.Management.Tokens("").AddClusterScopedLifecycle(ctx,
-		tokenController,
-		clusterName,
-		&tokenHandler{
-			namespace,
-			clusterAuthToken,
-			clusterAuthTokenLister,
-			clusterUserAttribute,
-			clusterUserAttributeLister,
-			tokenIndexer,
-			userLister,
-			userAttributeLister,
-			clusterSecret,
-			clusterSecretLister,
-		})
+	tokens := &tokenHandler{
+		namespace,
+		clusterAuthToken,
+		clusterAuthTokenLister,
+		clusterUserAttribute,
+		clusterUserAttributeLister,
+		tokenIndexer,
+		userLister,
+		userAttributeLister,
+		clusterSecret,
+		clusterSecretLister,
+		&revocationList{
+			namespace:       namespace,
+			configMaps:      clusterConfigMap,
+			configMapLister: clusterConfigMapLister,
+			now:             time.Now,
+		},
+	}
+	cluster.Management.Management.Tokens("").AddClusterScopedLifecycle(ctx, tokenController, clusterName, tokens)
+
+	replicatedTokens := &replicatedTokenHandler{
+		clusterName: clusterName,
+		tokens:      tokens,
+		tokenCache:  tokenCache,
+	}
+	cluster.Management.Management.Tokens("").AddHandler(ctx, replicatedTokenController, replicatedTokens.sync)
 
 	cluster.Management.Management.Users("").AddHandler(ctx, userController, (&userHandler{
 		namespace,
@@ -116,6 +129,7 @@ func registerDeferred(ctx context.Context, cluster *config.UserContext) {
 		tokenCache:  tokenCache,
 		tokenClient: tokenClient,
 	}).sync)
+	cluster.Cluster.ClusterAuthTokens(namespace).AddHandler(ctx, replicatedTokenController, replicatedTokens.syncClusterAuthToken)
 }
 
 func tokenUserClusterKey(token *managementv3.Token) string {
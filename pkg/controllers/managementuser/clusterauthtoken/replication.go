@@ -0,0 +1,94 @@
+package clusterauthtoken
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rancher/rancher/pkg/controllers/managementuser/clusterauthtoken/common"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	clusterv3 "github.com/rancher/rancher/pkg/generated/norman/cluster.cattle.io/v3"
+	managementv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// replicatedTokenHandler syncs the unscoped tokens replicated to the cluster with the
+// common.ReplicateToClustersAnnotation to ClusterAuthTokens, the way the tokens scoped to the cluster are synced. It
+// lets automation authenticate with a single token through the authorized cluster endpoint of every cluster the token
+// is replicated to, instead of a token per cluster. The ClusterAuthTokens follow the updates of the tokens, such as
+// their expiration, disabling or new hash, and are removed once a token is deleted or not replicated to the cluster
+// anymore.
+type replicatedTokenHandler struct {
+	clusterName string
+	tokens      *tokenHandler
+	tokenCache  mgmtcontrollers.TokenCache
+}
+
+// replicatedTo returns whether the token is replicated to the cluster. Only unscoped tokens, valid for every cluster
+// already, are replicated: a token scoped to a cluster is never valid for another.
+func replicatedTo(token *managementv3.Token, clusterName string) bool {
+	if token.ClusterName != "" {
+		return false
+	}
+	value, ok := token.Annotations[common.ReplicateToClustersAnnotation]
+	if !ok {
+		return false
+	}
+	return slices.Contains(strings.Split(value, ","), clusterName)
+}
+
+// sync creates or updates the ClusterAuthToken of an unscoped token replicated to the cluster, and removes the
+// ClusterAuthToken of an unscoped token which isn't anymore.
+func (h *replicatedTokenHandler) sync(key string, token *managementv3.Token) (runtime.Object, error) {
+	if token == nil || token.DeletionTimestamp != nil {
+		return nil, h.remove(key)
+	}
+	// The tokens scoped to a cluster are synced by the tokenHandler of the cluster.
+	if token.ClusterName != "" {
+		if _, ok := token.Annotations[common.ReplicateToClustersAnnotation]; ok {
+			logrus.Debugf("[%s] Ignoring the annotation %s of token [%s] scoped to cluster %s",
+				replicatedTokenController, common.ReplicateToClustersAnnotation, token.Name, token.ClusterName)
+		}
+		return nil, nil
+	}
+	if !replicatedTo(token, h.clusterName) {
+		return nil, h.remove(token.Name)
+	}
+	if _, err := h.tokens.Updated(token); err != nil {
+		return nil, fmt.Errorf("replicating token [%s] to cluster %s: %w", token.Name, h.clusterName, err)
+	}
+	return nil, nil
+}
+
+// syncClusterAuthToken removes the ClusterAuthTokens of the replicated tokens deleted, or not replicated to the cluster
+// anymore, while the controllers of the cluster weren't running.
+func (h *replicatedTokenHandler) syncClusterAuthToken(key string, clusterAuthToken *clusterv3.ClusterAuthToken) (runtime.Object, error) {
+	if clusterAuthToken == nil || clusterAuthToken.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	token, err := h.tokenCache.Get(clusterAuthToken.Name)
+	if apierrors.IsNotFound(err) {
+		return nil, h.remove(clusterAuthToken.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if token.ClusterName == "" && !replicatedTo(token, h.clusterName) {
+		return nil, h.remove(token.Name)
+	}
+	return clusterAuthToken, nil
+}
+
+// remove removes the ClusterAuthToken of the token from the cluster, if it was synced. The ClusterUserAttribute of the
+// user is kept, as it may be shared with their other tokens synced to the cluster.
+func (h *replicatedTokenHandler) remove(tokenName string) error {
+	if _, err := h.tokens.clusterAuthTokenLister.Get(h.tokens.namespace, tokenName); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	logrus.Infof("[%s] Removing token [%s] from cluster %s", replicatedTokenController, tokenName, h.clusterName)
+	return h.tokens.removeClusterAuthToken(tokenName)
+}
@@ -0,0 +1,271 @@
+package clusterauthtoken
+
+import (
+	"testing"
+	"time"
+
+	clusterv3 "github.com/rancher/rancher/pkg/apis/cluster.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/clusterauthtoken/common"
+	"github.com/rancher/rancher/pkg/features"
+	"github.com/rancher/rancher/pkg/generated/norman/cluster.cattle.io/v3/fakes"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	coreFakes "github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	managementv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	mgmtFakes "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+)
+
+func TestReplicatedTo(t *testing.T) {
+	tests := []struct {
+		name        string
+		clusterName string
+		annotation  *string
+		want        bool
+	}{
+		{
+			name:       "token is not replicated",
+			annotation: nil,
+		},
+		{
+			name:       "token is replicated to the cluster",
+			annotation: pointer.String("c-1,c-2"),
+			want:       true,
+		},
+		{
+			name:       "token is replicated to other clusters",
+			annotation: pointer.String("c-2,c-3"),
+		},
+		{
+			name:        "scoped token is never replicated",
+			clusterName: "c-2",
+			annotation:  pointer.String("c-1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := &managementv3.Token{ClusterName: tt.clusterName}
+			if tt.annotation != nil {
+				token.Annotations = map[string]string{common.ReplicateToClustersAnnotation: *tt.annotation}
+			}
+			assert.Equal(t, tt.want, replicatedTo(token, "c-1"))
+		})
+	}
+}
+
+func TestReplicatedTokenHandlerSync(t *testing.T) {
+	replicated := func(clusters string) *managementv3.Token {
+		return &managementv3.Token{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "token-1",
+				Annotations: map[string]string{common.ReplicateToClustersAnnotation: clusters},
+			},
+			UserID:    userID,
+			Token:     tokenKey,
+			ExpiresAt: "10000000000",
+		}
+	}
+	existing := &clusterv3.ClusterAuthToken{
+		ObjectMeta: metav1.ObjectMeta{Name: "token-1"},
+		UserName:   userID,
+		ExpiresAt:  "10000000000",
+		Enabled:    true,
+	}
+	scoped := replicated("c-1")
+	scoped.ClusterName = "c-2"
+
+	tests := []struct {
+		name        string
+		key         string
+		token       *managementv3.Token
+		existing    *clusterv3.ClusterAuthToken
+		wantCreated bool
+		wantDeleted bool
+	}{
+		{
+			name:        "token replicated to the cluster is synced",
+			key:         "token-1",
+			token:       replicated("c-1,c-2"),
+			wantCreated: true,
+		},
+		{
+			name:        "token not replicated to the cluster anymore is removed",
+			key:         "token-1",
+			token:       replicated("c-2"),
+			existing:    existing,
+			wantDeleted: true,
+		},
+		{
+			name:        "deleted token is removed",
+			key:         "token-1",
+			existing:    existing,
+			wantDeleted: true,
+		},
+		{
+			name:     "token scoped to another cluster is ignored",
+			key:      "token-1",
+			token:    scoped,
+			existing: existing,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			features.TokenHashing.Set(false)
+			var created, deleted bool
+			h := &replicatedTokenHandler{
+				clusterName: "c-1",
+				tokens: &tokenHandler{
+					clusterAuthTokenLister: &fakes.ClusterAuthTokenListerMock{
+						GetFunc: func(_, name string) (*clusterv3.ClusterAuthToken, error) {
+							if tt.existing == nil {
+								return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "clusterauthtokens"}, name)
+							}
+							return tt.existing.DeepCopy(), nil
+						},
+					},
+					clusterAuthToken: &fakes.ClusterAuthTokenInterfaceMock{
+						CreateFunc: func(in *clusterv3.ClusterAuthToken) (*clusterv3.ClusterAuthToken, error) {
+							created = true
+							return in, nil
+						},
+						DeleteFunc: func(string, *metav1.DeleteOptions) error {
+							deleted = true
+							return nil
+						},
+					},
+					clusterSecret: &coreFakes.SecretInterfaceMock{
+						CreateFunc: func(in *v1.Secret) (*v1.Secret, error) {
+							return in, nil
+						},
+						DeleteFunc: func(string, *metav1.DeleteOptions) error {
+							return nil
+						},
+					},
+					userLister: &mgmtFakes.UserListerMock{
+						GetFunc: func(_, name string) (*v3.User, error) {
+							return &v3.User{ObjectMeta: metav1.ObjectMeta{Name: name}, Enabled: pointer.Bool(true)}, nil
+						},
+					},
+					userAttributeLister: &mgmtFakes.UserAttributeListerMock{
+						GetFunc: func(_, name string) (*v3.UserAttribute, error) {
+							return &v3.UserAttribute{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+						},
+					},
+					clusterUserAttributeLister: &fakes.ClusterUserAttributeListerMock{
+						GetFunc: func(_, name string) (*clusterv3.ClusterUserAttribute, error) {
+							return &clusterv3.ClusterUserAttribute{Enabled: true}, nil
+						},
+					},
+					revocations: &revocationList{
+						configMaps: &coreFakes.ConfigMapInterfaceMock{
+							CreateFunc: func(in *v1.ConfigMap) (*v1.ConfigMap, error) {
+								return in, nil
+							},
+						},
+						configMapLister: &coreFakes.ConfigMapListerMock{
+							GetFunc: func(_, name string) (*v1.ConfigMap, error) {
+								return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, name)
+							},
+						},
+						now: time.Now,
+					},
+				},
+			}
+
+			_, err := h.sync(tt.key, tt.token)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCreated, created)
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
+
+func TestReplicatedTokenHandlerSyncClusterAuthToken(t *testing.T) {
+	clusterAuthToken := &clusterv3.ClusterAuthToken{ObjectMeta: metav1.ObjectMeta{Name: "token-1"}}
+	tests := []struct {
+		name        string
+		token       *v3.Token
+		wantDeleted bool
+	}{
+		{
+			name: "token replicated to the cluster",
+			token: &v3.Token{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "token-1",
+					Annotations: map[string]string{common.ReplicateToClustersAnnotation: "c-1"},
+				},
+			},
+		},
+		{
+			name:  "token scoped to the cluster",
+			token: &v3.Token{ObjectMeta: metav1.ObjectMeta{Name: "token-1"}, ClusterName: "c-1"},
+		},
+		{
+			name:        "token not replicated to the cluster anymore",
+			token:       &v3.Token{ObjectMeta: metav1.ObjectMeta{Name: "token-1"}},
+			wantDeleted: true,
+		},
+		{
+			name:        "token was deleted",
+			wantDeleted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tokenCache := fake.NewMockNonNamespacedCacheInterface[*v3.Token](ctrl)
+			if tt.token != nil {
+				tokenCache.EXPECT().Get("token-1").Return(tt.token, nil)
+			} else {
+				tokenCache.EXPECT().Get("token-1").Return(nil, apierrors.NewNotFound(schema.GroupResource{Resource: "tokens"}, "token-1"))
+			}
+			var deleted bool
+			h := &replicatedTokenHandler{
+				clusterName: "c-1",
+				tokenCache:  tokenCache,
+				tokens: &tokenHandler{
+					clusterAuthTokenLister: &fakes.ClusterAuthTokenListerMock{
+						GetFunc: func(string, string) (*clusterv3.ClusterAuthToken, error) {
+							return clusterAuthToken, nil
+						},
+					},
+					clusterAuthToken: &fakes.ClusterAuthTokenInterfaceMock{
+						DeleteFunc: func(string, *metav1.DeleteOptions) error {
+							deleted = true
+							return nil
+						},
+					},
+					clusterSecret: &coreFakes.SecretInterfaceMock{
+						DeleteFunc: func(string, *metav1.DeleteOptions) error {
+							return nil
+						},
+					},
+					revocations: &revocationList{
+						configMaps: &coreFakes.ConfigMapInterfaceMock{
+							CreateFunc: func(in *v1.ConfigMap) (*v1.ConfigMap, error) {
+								return in, nil
+							},
+						},
+						configMapLister: &coreFakes.ConfigMapListerMock{
+							GetFunc: func(_, name string) (*v1.ConfigMap, error) {
+								return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, name)
+							},
+						},
+						now: time.Now,
+					},
+				},
+			}
+
+			_, err := h.syncClusterAuthToken("cattle-system/token-1", clusterAuthToken)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
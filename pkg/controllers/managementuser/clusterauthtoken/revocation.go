@@ -0,0 +1,100 @@
+package clusterauthtoken
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/rancher/rancher/pkg/controllers/managementuser/clusterauthtoken/common"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// revocationNoticeTTL is how long a revoked token is listed for. Agents don't cache authentication decisions for
+	// longer.
+	revocationNoticeTTL = 24 * time.Hour
+	// maxRevocationListSize bounds the size of the listed notices, keeping the ConfigMap well under the 1MiB limit of
+	// objects even when many tokens are revoked at once, e.g. when a user is deleted. The oldest notices are dropped
+	// first.
+	maxRevocationListSize = 512 * 1024
+)
+
+// revocationList pushes the tokens revoked in Rancher to a ConfigMap of the downstream cluster. Agents caching
+// authentication decisions, such as kube-api-auth, watch it to evict the decisions made for a revoked token right away,
+// instead of when their cache expires, bounding the time a revoked token can still be used.
+type revocationList struct {
+	namespace       string
+	configMaps      corev1.ConfigMapInterface
+	configMapLister corev1.ConfigMapLister
+	now             func() time.Time
+}
+
+// add lists the token as revoked now, and removes the tokens revoked more than revocationNoticeTTL ago from the list, as
+// well as the oldest ones if the list grows over maxRevocationListSize.
+func (l *revocationList) add(tokenName string) error {
+	now := l.now()
+	revokedAt := now.UTC().Format(time.RFC3339)
+
+	configMap, err := l.configMapLister.Get(l.namespace, common.RevocationListConfigMapName)
+	if errors.IsNotFound(err) {
+		_, err = l.configMaps.Create(&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: common.RevocationListConfigMapName,
+			},
+			TypeMeta: metav1.TypeMeta{
+				Kind: "ConfigMap",
+			},
+			Data: map[string]string{
+				tokenName: revokedAt,
+			},
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	configMap = configMap.DeepCopy()
+	if configMap.Data == nil {
+		configMap.Data = make(map[string]string)
+	}
+	for name, value := range configMap.Data {
+		listedAt, err := time.Parse(time.RFC3339, value)
+		if err != nil || now.Sub(listedAt) > revocationNoticeTTL {
+			delete(configMap.Data, name)
+		}
+	}
+	configMap.Data[tokenName] = revokedAt
+	truncateRevocations(configMap.Data)
+	_, err = l.configMaps.Update(configMap)
+	return err
+}
+
+// truncateRevocations removes the oldest notices from the list until it fits in maxRevocationListSize.
+func truncateRevocations(notices map[string]string) {
+	size := 0
+	for name, value := range notices {
+		size += len(name) + len(value)
+	}
+	if size <= maxRevocationListSize {
+		return
+	}
+
+	// Notices are written as RFC3339 UTC timestamps, which sort chronologically.
+	names := make([]string, 0, len(notices))
+	for name := range notices {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return cmp.Compare(notices[a], notices[b])
+	})
+	for _, name := range names {
+		if size <= maxRevocationListSize {
+			return
+		}
+		size -= len(name) + len(notices[name])
+		delete(notices, name)
+	}
+}
@@ -0,0 +1,141 @@
+package clusterauthtoken
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rancher/rancher/pkg/controllers/managementuser/clusterauthtoken/common"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	coreFakes "github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestRevocationListAdd(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		existing   *v1.ConfigMap
+		getErr     error
+		wantData   map[string]string
+		wantCreate bool
+		wantError  bool
+	}{
+		{
+			name:       "list missing, create it",
+			getErr:     apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, common.RevocationListConfigMapName),
+			wantCreate: true,
+			wantData: map[string]string{
+				"token-1": "2024-05-01T12:00:00Z",
+			},
+		},
+		{
+			name: "list exists, prune expired and invalid notices",
+			existing: &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.RevocationListConfigMapName,
+					Namespace: "cattle-system",
+				},
+				Data: map[string]string{
+					"token-2": "2024-05-01T08:00:00Z",
+					"token-3": "2024-04-29T12:00:00Z",
+					"token-4": "invalid",
+				},
+			},
+			wantData: map[string]string{
+				"token-1": "2024-05-01T12:00:00Z",
+				"token-2": "2024-05-01T08:00:00Z",
+			},
+		},
+		{
+			name: "list exists without data",
+			existing: &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.RevocationListConfigMapName,
+					Namespace: "cattle-system",
+				},
+			},
+			wantData: map[string]string{
+				"token-1": "2024-05-01T12:00:00Z",
+			},
+		},
+		{
+			name:      "error getting list",
+			getErr:    fmt.Errorf("some error"),
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var created, updated *v1.ConfigMap
+			l := &revocationList{
+				namespace: "cattle-system",
+				configMaps: &coreFakes.ConfigMapInterfaceMock{
+					CreateFunc: func(in1 *v1.ConfigMap) (*v1.ConfigMap, error) {
+						created = in1
+						return in1, nil
+					},
+					UpdateFunc: func(in1 *v1.ConfigMap) (*v1.ConfigMap, error) {
+						updated = in1
+						return in1, nil
+					},
+				},
+				configMapLister: &coreFakes.ConfigMapListerMock{
+					GetFunc: func(namespace, name string) (*v1.ConfigMap, error) {
+						require.Equal(t, "cattle-system", namespace)
+						require.Equal(t, common.RevocationListConfigMapName, name)
+						return test.existing, test.getErr
+					},
+				},
+				now: func() time.Time { return now },
+			}
+
+			err := l.add("token-1")
+			if test.wantError {
+				require.Error(t, err)
+				require.Nil(t, created)
+				require.Nil(t, updated)
+				return
+			}
+			require.NoError(t, err)
+
+			if test.wantCreate {
+				require.NotNil(t, created)
+				require.Nil(t, updated)
+				require.Equal(t, common.RevocationListConfigMapName, created.Name)
+				require.Equal(t, test.wantData, created.Data)
+				return
+			}
+			require.Nil(t, created)
+			require.NotNil(t, updated)
+			require.Equal(t, test.wantData, updated.Data)
+			// the cached object must not be modified
+			require.NotEqual(t, test.existing.Data, updated.Data)
+		})
+	}
+}
+
+func TestTruncateRevocations(t *testing.T) {
+	notices := map[string]string{}
+	revokedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < maxRevocationListSize/20; i++ {
+		notices[fmt.Sprintf("token-%08d", i)] = revokedAt.Add(time.Duration(i) * time.Second).Format(time.RFC3339)
+	}
+
+	truncateRevocations(notices)
+
+	size := 0
+	for name, value := range notices {
+		size += len(name) + len(value)
+	}
+	require.LessOrEqual(t, size, maxRevocationListSize)
+	require.Greater(t, size, maxRevocationListSize-40)
+	// the oldest notices are dropped first
+	require.NotContains(t, notices, "token-00000000")
+	require.Contains(t, notices, fmt.Sprintf("token-%08d", maxRevocationListSize/20-1))
+}
@@ -38,6 +38,7 @@ type tokenHandler struct {
 	userAttributeLister        managementv3.UserAttributeLister
 	clusterSecret              corev1.SecretInterface
 	clusterSecretLister        corev1.SecretLister
+	revocations                *revocationList
 }
 
 // Create is called when a given token is created, and is responsible for creating a ClusterAuthToken in a downstream cluster.
@@ -199,6 +200,14 @@ func (h *tokenHandler) Updated(token *managementv3.Token) (runtime.Object, error
 		return nil, nil
 	}
 
+	// Notify the agents before disabling the token downstream, so that no cached decision outlives the update.
+	// Agents still evict the decisions when their cache expires, so a failure doesn't block the update.
+	if old.enabled && !current.enabled {
+		if err := h.revocations.add(token.Name); err != nil {
+			logrus.Errorf("failed to list token [%s] as revoked in the downstream cluster: %v", token.Name, err)
+		}
+	}
+
 	// If we were comparing token values, then the token was hashed, so we can update the value in the downstream.
 	if current.value != "" {
 		clusterAuthTokenSecret.Data["hash"] = []byte(current.value)
@@ -226,12 +235,7 @@ func (h *tokenHandler) Remove(token *managementv3.Token) (runtime.Object, error)
 		return nil, err
 	}
 
-	err = h.clusterAuthToken.Delete(token.Name, &metav1.DeleteOptions{})
-	if err != nil && !errors.IsNotFound(err) {
-		return nil, err
-	}
-	err = h.clusterSecret.Delete(common.ClusterAuthTokenSecretName(token.Name), &metav1.DeleteOptions{})
-	if err != nil && !errors.IsNotFound(err) {
+	if err := h.removeClusterAuthToken(token.Name); err != nil {
 		return nil, err
 	}
 
@@ -249,6 +253,29 @@ func (h *tokenHandler) Remove(token *managementv3.Token) (runtime.Object, error)
 	return nil, nil
 }
 
+// removeClusterAuthToken deletes the ClusterAuthToken of the token and its secret from the downstream cluster.
+func (h *tokenHandler) removeClusterAuthToken(tokenName string) error {
+	// Notify the agents before deleting the token downstream, so that no cached decision outlives the deletion.
+	// Only tokens synced downstream can have been used to authenticate there.
+	if _, err := h.clusterAuthTokenLister.Get(h.namespace, tokenName); err == nil {
+		if err := h.revocations.add(tokenName); err != nil {
+			logrus.Errorf("failed to list token [%s] as revoked in the downstream cluster: %v", tokenName, err)
+		}
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+
+	err := h.clusterAuthToken.Delete(tokenName, &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	err = h.clusterSecret.Delete(common.ClusterAuthTokenSecretName(tokenName), &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func (h *tokenHandler) updateClusterUserAttribute(token *managementv3.Token) error {
 	userID := token.UserID
 	user, err := h.userLister.Get("", userID)
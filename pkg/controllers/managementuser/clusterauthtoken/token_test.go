@@ -3,6 +3,7 @@
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	clusterv3 "github.com/rancher/rancher/pkg/apis/cluster.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
@@ -282,6 +283,7 @@ func TestUpdate(t *testing.T) {
 		wantClusterAuthToken bool
 		wantAuthTokenUpdate  bool
 		wantAuthTokenEnabled bool
+		wantTokenRevoked     bool
 		wantError            bool
 		wantSkipError        bool
 	}{
@@ -295,6 +297,7 @@ func TestUpdate(t *testing.T) {
 			wantClusterAuthToken: true,
 			wantAuthTokenEnabled: false,
 			wantAuthTokenUpdate:  true,
+			wantTokenRevoked:     true,
 		},
 		{
 			name:                      "token enabled missing, no token update",
@@ -428,6 +431,7 @@ func TestUpdate(t *testing.T) {
 				CreateAuthTokenErr:        test.createAuthTokenErr,
 				CallCreate:                false,
 			})
+			require.Equal(t, test.wantTokenRevoked, output.TokenRevoked)
 			if test.wantError {
 				require.Error(t, output.Error)
 				if test.wantSkipError {
@@ -514,6 +518,7 @@ type testOutput struct {
 	ModifiedClusterAuthSecret *v1.Secret
 	AuthTokenUpdated          bool
 	AuthTokenDeleted          bool
+	TokenRevoked              bool
 	Error                     error
 }
 
@@ -541,6 +546,7 @@ func runCreateUpdateTest(t *testing.T, testInput *testInput) *testOutput {
 	var modifiedToken *clusterv3.ClusterAuthToken
 	var isUpdated bool
 	var isDeleted bool
+	var revoked bool
 	mockAuthTokens := fakes.ClusterAuthTokenInterfaceMock{}
 	mockAuthTokens.UpdateFunc = func(in1 *clusterv3.ClusterAuthToken) (*clusterv3.ClusterAuthToken, error) {
 		isUpdated = true
@@ -592,6 +598,20 @@ func runCreateUpdateTest(t *testing.T, testInput *testInput) *testOutput {
 		clusterUserAttribute:       &fakes.ClusterUserAttributeInterfaceMock{},
 		clusterSecret:              &mockSecrets,
 		clusterSecretLister:        &mockSecretLister,
+		revocations: &revocationList{
+			configMaps: &coreFakes.ConfigMapInterfaceMock{
+				CreateFunc: func(in1 *v1.ConfigMap) (*v1.ConfigMap, error) {
+					revoked = true
+					return in1, nil
+				},
+			},
+			configMapLister: &coreFakes.ConfigMapListerMock{
+				GetFunc: func(namespace, name string) (*v1.ConfigMap, error) {
+					return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, name)
+				},
+			},
+			now: time.Now,
+		},
 	}
 	var err error
 	if testInput.CallCreate {
@@ -604,6 +624,7 @@ func runCreateUpdateTest(t *testing.T, testInput *testInput) *testOutput {
 		ModifiedClusterAuthSecret: modifiedSecret,
 		AuthTokenUpdated:          isUpdated,
 		AuthTokenDeleted:          isDeleted,
+		TokenRevoked:              revoked,
 		Error:                     err,
 	}
 }
@@ -11,9 +11,13 @@
 	"github.com/rancher/rancher/pkg/controllers/managementuser/clusterauthtoken"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/healthsyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/machinerole"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/managedsetting"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/networkpolicy"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/nodesyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/nsserviceaccount"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/pinnedchartversions"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/projectnetworkisolation"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/psaprofile"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/rbac"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/resourcequota"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/rkecontrolplanecondition"
@@ -58,6 +62,10 @@ func Register(ctx context.Context, mgmt *config.ScaledContext, cluster *config.U
 	registerImpersonationCaches(cluster)
 
 	cavalidator.Register(ctx, cluster)
+	managedsetting.Register(ctx, cluster)
+	pinnedchartversions.Register(ctx, cluster)
+	psaprofile.Register(ctx, cluster)
+	projectnetworkisolation.Register(ctx, cluster)
 
 	// register controller for API
 	cluster.APIAggregation.APIServices("").Controller()
@@ -0,0 +1,188 @@
+// Package managedsetting propagates the managed settings declared in Rancher to the downstream clusters they select.
+// The settings of each managed setting are written to a ConfigMap in the cattle-system namespace of the downstream
+// cluster, from where they are consumed by the agents, and the result is reported in the status of the managed setting.
+package managedsetting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtv3controllers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/types/config"
+	wcorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// ManagedSettingLabel is set on the ConfigMaps of the downstream clusters to the name of their managed setting.
+	ManagedSettingLabel = "management.cattle.io/managed-setting"
+
+	configMapPrefix = "managed-setting-"
+)
+
+type handler struct {
+	clusterName     string
+	clusterCache    mgmtv3controllers.ClusterCache
+	managedSettings mgmtv3controllers.ManagedSettingController
+	configMaps      wcorev1.ConfigMapClient
+}
+
+func Register(ctx context.Context, downstream *config.UserContext) {
+	h := &handler{
+		clusterName:     downstream.ClusterName,
+		clusterCache:    downstream.Management.Wrangler.Mgmt.Cluster().Cache(),
+		managedSettings: downstream.Management.Wrangler.Mgmt.ManagedSetting(),
+		configMaps:      downstream.Corew.ConfigMap(),
+	}
+
+	// Each downstream cluster registers its own handlers, as the settings are applied through its own clients.
+	downstream.Management.Wrangler.Mgmt.ManagedSetting().OnChange(ctx, "managed-setting-"+h.clusterName, h.onManagedSettingChange)
+	downstream.Management.Wrangler.Mgmt.Cluster().OnChange(ctx, "managed-setting-cluster-"+h.clusterName, h.onClusterChange)
+}
+
+// onClusterChange enqueues the managed settings when the management cluster changes, as a change of its labels can
+// change the managed settings that select it.
+func (h *handler) onClusterChange(_ string, cluster *v3.Cluster) (*v3.Cluster, error) {
+	if cluster == nil || cluster.Name != h.clusterName {
+		return cluster, nil
+	}
+
+	settings, err := h.managedSettings.Cache().List(labels.Everything())
+	if err != nil {
+		return cluster, err
+	}
+	for _, setting := range settings {
+		h.managedSettings.Enqueue(setting.Name)
+	}
+	return cluster, nil
+}
+
+func (h *handler) onManagedSettingChange(key string, setting *v3.ManagedSetting) (*v3.ManagedSetting, error) {
+	if setting == nil {
+		return nil, h.removeConfigMap(key)
+	}
+	if setting.DeletionTimestamp != nil {
+		return setting, nil
+	}
+
+	cluster, err := h.clusterCache.Get(h.clusterName)
+	if err != nil {
+		return setting, err
+	}
+
+	selected, err := selectsCluster(setting, cluster)
+	if err != nil {
+		// An invalid selector is reported in the status of every cluster, instead of being retried.
+		return setting, h.updateStatus(setting.Name, true, err)
+	}
+
+	var applyErr error
+	if selected {
+		applyErr = h.applyConfigMap(setting)
+	} else {
+		applyErr = h.removeConfigMap(setting.Name)
+	}
+	return setting, errors.Join(applyErr, h.updateStatus(setting.Name, selected, applyErr))
+}
+
+func selectsCluster(setting *v3.ManagedSetting, cluster *v3.Cluster) (bool, error) {
+	if setting.Spec.ClusterSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(setting.Spec.ClusterSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid cluster selector: %w", err)
+	}
+	return selector.Matches(labels.Set(cluster.Labels)), nil
+}
+
+func (h *handler) applyConfigMap(setting *v3.ManagedSetting) error {
+	desired := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapPrefix + setting.Name,
+			Namespace: namespace.System,
+			Labels: map[string]string{
+				ManagedSettingLabel: setting.Name,
+			},
+		},
+		Data: setting.Spec.Settings,
+	}
+
+	existing, err := h.configMaps.Get(desired.Namespace, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = h.configMaps.Create(desired)
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if existing.Labels[ManagedSettingLabel] == setting.Name && equality.Semantic.DeepEqual(existing.Data, desired.Data) {
+		return nil
+	}
+	existing = existing.DeepCopy()
+	if existing.Labels == nil {
+		existing.Labels = map[string]string{}
+	}
+	existing.Labels[ManagedSettingLabel] = setting.Name
+	existing.Data = desired.Data
+	_, err = h.configMaps.Update(existing)
+	return err
+}
+
+func (h *handler) removeConfigMap(name string) error {
+	err := h.configMaps.Delete(namespace.System, configMapPrefix+name, &metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// updateStatus records the result of the propagation to the cluster in the status of the managed setting, or removes
+// the cluster from the status if it is no longer selected.
+func (h *handler) updateStatus(name string, selected bool, applyErr error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// The status is updated by the handlers of all the downstream clusters, the cache is likely to be stale.
+		setting, err := h.managedSettings.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		clusters := make([]v3.ManagedSettingClusterStatus, 0, len(setting.Status.Clusters)+1)
+		for _, status := range setting.Status.Clusters {
+			if status.ClusterName != h.clusterName {
+				clusters = append(clusters, status)
+			}
+		}
+		if selected {
+			status := v3.ManagedSettingClusterStatus{
+				ClusterName:        h.clusterName,
+				ObservedGeneration: setting.Generation,
+				Applied:            applyErr == nil,
+			}
+			if applyErr != nil {
+				status.Error = applyErr.Error()
+			}
+			clusters = append(clusters, status)
+		}
+		sort.Slice(clusters, func(i, j int) bool {
+			return clusters[i].ClusterName < clusters[j].ClusterName
+		})
+
+		if len(clusters) == 0 && len(setting.Status.Clusters) == 0 || equality.Semantic.DeepEqual(clusters, setting.Status.Clusters) {
+			return nil
+		}
+		setting = setting.DeepCopy()
+		setting.Status.Clusters = clusters
+		_, err = h.managedSettings.UpdateStatus(setting)
+		return err
+	})
+}
@@ -0,0 +1,160 @@
+package managedsetting
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestOnManagedSettingChange(t *testing.T) {
+	cluster := &v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "c-abcde",
+			Labels: map[string]string{"env": "prod"},
+		},
+	}
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "managed-setting-audit")
+
+	newSetting := func(selector map[string]string, statuses ...v3.ManagedSettingClusterStatus) *v3.ManagedSetting {
+		setting := &v3.ManagedSetting{
+			ObjectMeta: metav1.ObjectMeta{Name: "audit", Generation: 2},
+			Spec: v3.ManagedSettingSpec{
+				Settings: map[string]string{"audit-level": "2"},
+			},
+			Status: v3.ManagedSettingStatus{Clusters: statuses},
+		}
+		if selector != nil {
+			setting.Spec.ClusterSelector = &metav1.LabelSelector{MatchLabels: selector}
+		}
+		return setting
+	}
+
+	tests := []struct {
+		name             string
+		setting          *v3.ManagedSetting
+		existing         *corev1.ConfigMap
+		expectCreate     bool
+		expectUpdate     bool
+		expectDelete     bool
+		expectedStatuses []v3.ManagedSettingClusterStatus
+		expectStatus     bool
+	}{
+		{
+			name:         "selected cluster without config map",
+			setting:      newSetting(map[string]string{"env": "prod"}),
+			expectCreate: true,
+			expectStatus: true,
+			expectedStatuses: []v3.ManagedSettingClusterStatus{
+				{ClusterName: "c-abcde", ObservedGeneration: 2, Applied: true},
+			},
+		},
+		{
+			name:    "selected cluster with outdated config map",
+			setting: newSetting(nil, v3.ManagedSettingClusterStatus{ClusterName: "c-abcde", ObservedGeneration: 1, Applied: true}),
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "managed-setting-audit", Namespace: namespace.System},
+				Data:       map[string]string{"audit-level": "1"},
+			},
+			expectUpdate: true,
+			expectStatus: true,
+			expectedStatuses: []v3.ManagedSettingClusterStatus{
+				{ClusterName: "c-abcde", ObservedGeneration: 2, Applied: true},
+			},
+		},
+		{
+			name:    "selected cluster up to date",
+			setting: newSetting(nil, v3.ManagedSettingClusterStatus{ClusterName: "c-abcde", ObservedGeneration: 2, Applied: true}),
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "managed-setting-audit",
+					Namespace: namespace.System,
+					Labels:    map[string]string{ManagedSettingLabel: "audit"},
+				},
+				Data: map[string]string{"audit-level": "2"},
+			},
+		},
+		{
+			name: "cluster no longer selected",
+			setting: newSetting(map[string]string{"env": "dev"},
+				v3.ManagedSettingClusterStatus{ClusterName: "c-abcde", ObservedGeneration: 1, Applied: true},
+				v3.ManagedSettingClusterStatus{ClusterName: "c-fghij", ObservedGeneration: 2, Applied: true},
+			),
+			expectDelete: true,
+			expectStatus: true,
+			expectedStatuses: []v3.ManagedSettingClusterStatus{
+				{ClusterName: "c-fghij", ObservedGeneration: 2, Applied: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			clusterCache := fake.NewMockNonNamespacedCacheInterface[*v3.Cluster](ctrl)
+			clusterCache.EXPECT().Get(cluster.Name).Return(cluster, nil)
+
+			managedSettings := fake.NewMockNonNamespacedControllerInterface[*v3.ManagedSetting, *v3.ManagedSettingList](ctrl)
+			managedSettings.EXPECT().Get(tt.setting.Name, gomock.Any()).Return(tt.setting, nil)
+			if tt.expectStatus {
+				managedSettings.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(setting *v3.ManagedSetting) (*v3.ManagedSetting, error) {
+					assert.Equal(t, tt.expectedStatuses, setting.Status.Clusters)
+					return setting, nil
+				})
+			}
+
+			configMaps := fake.NewMockClientInterface[*corev1.ConfigMap, *corev1.ConfigMapList](ctrl)
+			if tt.expectDelete {
+				configMaps.EXPECT().Delete(namespace.System, "managed-setting-audit", gomock.Any()).Return(notFound)
+			} else if tt.existing != nil {
+				configMaps.EXPECT().Get(namespace.System, "managed-setting-audit", gomock.Any()).Return(tt.existing, nil)
+			} else {
+				configMaps.EXPECT().Get(namespace.System, "managed-setting-audit", gomock.Any()).Return(nil, notFound)
+			}
+			if tt.expectCreate {
+				configMaps.EXPECT().Create(gomock.Any()).DoAndReturn(func(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+					assert.Equal(t, "audit", configMap.Labels[ManagedSettingLabel])
+					assert.Equal(t, tt.setting.Spec.Settings, configMap.Data)
+					return configMap, nil
+				})
+			}
+			if tt.expectUpdate {
+				configMaps.EXPECT().Update(gomock.Any()).DoAndReturn(func(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+					assert.Equal(t, "audit", configMap.Labels[ManagedSettingLabel])
+					assert.Equal(t, tt.setting.Spec.Settings, configMap.Data)
+					return configMap, nil
+				})
+			}
+
+			h := &handler{
+				clusterName:     cluster.Name,
+				clusterCache:    clusterCache,
+				managedSettings: managedSettings,
+				configMaps:      configMaps,
+			}
+			_, err := h.onManagedSettingChange(tt.setting.Name, tt.setting)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestOnManagedSettingRemoved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	configMaps := fake.NewMockClientInterface[*corev1.ConfigMap, *corev1.ConfigMapList](ctrl)
+	configMaps.EXPECT().Delete(namespace.System, "managed-setting-audit", gomock.Any()).Return(nil)
+
+	h := &handler{
+		clusterName: "c-abcde",
+		configMaps:  configMaps,
+	}
+	_, err := h.onManagedSettingChange("audit", nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,73 @@
+// Package pinnedchartversions propagates the system chart versions pinned in the spec of a cluster to the downstream
+// cluster. The versions are written to a ConfigMap in the cattle-system namespace of the downstream cluster, from where
+// they are honored by the system charts controller of the cluster agent.
+package pinnedchartversions
+
+import (
+	"context"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/dashboard/chart"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/types/config"
+	wcorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type handler struct {
+	clusterName string
+	configMaps  wcorev1.ConfigMapClient
+}
+
+func Register(ctx context.Context, downstream *config.UserContext) {
+	h := &handler{
+		clusterName: downstream.ClusterName,
+		configMaps:  downstream.Corew.ConfigMap(),
+	}
+	downstream.Management.Wrangler.Mgmt.Cluster().OnChange(ctx, "pinned-chart-versions-"+h.clusterName, h.onClusterChange)
+}
+
+func (h *handler) onClusterChange(_ string, cluster *v3.Cluster) (*v3.Cluster, error) {
+	if cluster == nil || cluster.DeletionTimestamp != nil || cluster.Name != h.clusterName {
+		return cluster, nil
+	}
+	if len(cluster.Spec.SystemChartVersions) == 0 {
+		return cluster, h.removeConfigMap()
+	}
+	return cluster, h.applyConfigMap(cluster.Spec.SystemChartVersions)
+}
+
+func (h *handler) applyConfigMap(versions map[string]string) error {
+	existing, err := h.configMaps.Get(namespace.System, chart.PinnedVersionsMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = h.configMaps.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      chart.PinnedVersionsMapName,
+				Namespace: namespace.System,
+			},
+			Data: versions,
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(existing.Data, versions) {
+		return nil
+	}
+	existing = existing.DeepCopy()
+	existing.Data = versions
+	_, err = h.configMaps.Update(existing)
+	return err
+}
+
+func (h *handler) removeConfigMap() error {
+	err := h.configMaps.Delete(namespace.System, chart.PinnedVersionsMapName, &metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,114 @@
+package pinnedchartversions
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/dashboard/chart"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestOnClusterChange(t *testing.T) {
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, chart.PinnedVersionsMapName)
+	pinned := map[string]string{chart.WebhookChartName: "106.0.2+up0.7.2"}
+
+	tests := []struct {
+		name         string
+		clusterName  string
+		versions     map[string]string
+		existing     *corev1.ConfigMap
+		expectGet    bool
+		expectCreate bool
+		expectUpdate bool
+		expectDelete bool
+	}{
+		{
+			name:         "pinned versions without config map",
+			clusterName:  "c-abcde",
+			versions:     pinned,
+			expectGet:    true,
+			expectCreate: true,
+		},
+		{
+			name:        "pinned versions with outdated config map",
+			clusterName: "c-abcde",
+			versions:    pinned,
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: chart.PinnedVersionsMapName, Namespace: namespace.System},
+				Data:       map[string]string{chart.WebhookChartName: "106.0.1+up0.7.1"},
+			},
+			expectGet:    true,
+			expectUpdate: true,
+		},
+		{
+			name:        "pinned versions up to date",
+			clusterName: "c-abcde",
+			versions:    pinned,
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: chart.PinnedVersionsMapName, Namespace: namespace.System},
+				Data:       pinned,
+			},
+			expectGet: true,
+		},
+		{
+			name:         "no pinned versions",
+			clusterName:  "c-abcde",
+			expectDelete: true,
+		},
+		{
+			name:        "other cluster",
+			clusterName: "c-fghij",
+			versions:    pinned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			configMaps := fake.NewMockClientInterface[*corev1.ConfigMap, *corev1.ConfigMapList](ctrl)
+			if tt.expectGet {
+				if tt.existing != nil {
+					configMaps.EXPECT().Get(namespace.System, chart.PinnedVersionsMapName, gomock.Any()).Return(tt.existing, nil)
+				} else {
+					configMaps.EXPECT().Get(namespace.System, chart.PinnedVersionsMapName, gomock.Any()).Return(nil, notFound)
+				}
+			}
+			if tt.expectCreate {
+				configMaps.EXPECT().Create(gomock.Any()).DoAndReturn(func(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+					assert.Equal(t, tt.versions, configMap.Data)
+					return configMap, nil
+				})
+			}
+			if tt.expectUpdate {
+				configMaps.EXPECT().Update(gomock.Any()).DoAndReturn(func(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+					assert.Equal(t, tt.versions, configMap.Data)
+					return configMap, nil
+				})
+			}
+			if tt.expectDelete {
+				configMaps.EXPECT().Delete(namespace.System, chart.PinnedVersionsMapName, gomock.Any()).Return(notFound)
+			}
+
+			h := &handler{
+				clusterName: "c-abcde",
+				configMaps:  configMaps,
+			}
+			cluster := &v3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: tt.clusterName},
+				Spec: v3.ClusterSpec{
+					ClusterSpecBase: v3.ClusterSpecBase{SystemChartVersions: tt.versions},
+				},
+			}
+			_, err := h.onClusterChange(cluster.Name, cluster)
+			require.NoError(t, err)
+		})
+	}
+}
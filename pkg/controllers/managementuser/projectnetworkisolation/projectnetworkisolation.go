@@ -0,0 +1,262 @@
+// Package projectnetworkisolation maintains the default NetworkPolicies of the namespaces of the projects opting in to
+// network isolation through their NetworkIsolation field. Unlike the legacy project network isolation, the policies
+// only rely on the NetworkPolicy API, so they are enforced by any CNI implementing it, and no host network policies are
+// programmed.
+package projectnetworkisolation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementagent/nslabels"
+	mgmtv3controllers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	wcorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	wnetworkingv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/networking.k8s.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// ManagedLabel is set on the NetworkPolicies maintained for the network isolation of the projects.
+	ManagedLabel = "management.cattle.io/project-network-isolation"
+
+	denyAllPolicyName     = "np-project-deny-all"
+	sameProjectPolicyName = "np-project-allow-same-project"
+	systemProjectLabel    = "authz.management.cattle.io/system-project"
+)
+
+var policyNames = []string{denyAllPolicyName, sameProjectPolicyName}
+
+type handler struct {
+	clusterName     string
+	projectCache    mgmtv3controllers.ProjectCache
+	namespaces      wcorev1.NamespaceController
+	networkPolicies wnetworkingv1.NetworkPolicyController
+}
+
+func Register(ctx context.Context, downstream *config.UserContext) {
+	h := &handler{
+		clusterName:     downstream.ClusterName,
+		projectCache:    downstream.Management.Wrangler.Mgmt.Project().Cache(),
+		namespaces:      downstream.Corew.Namespace(),
+		networkPolicies: downstream.Networkingw.NetworkPolicy(),
+	}
+
+	downstream.Management.Wrangler.Mgmt.Project().OnChange(ctx, "project-network-isolation-"+h.clusterName, h.onProjectChange)
+	downstream.Corew.Namespace().OnChange(ctx, "project-network-isolation-namespace", h.onNamespaceChange)
+	downstream.Networkingw.NetworkPolicy().OnChange(ctx, "project-network-isolation-policy", h.onNetworkPolicyChange)
+}
+
+// onProjectChange enqueues the namespaces of a project of the cluster when it changes, as its network isolation may have
+// changed.
+func (h *handler) onProjectChange(key string, project *v3.Project) (*v3.Project, error) {
+	clusterName, projectName, _ := strings.Cut(key, "/")
+	if clusterName != h.clusterName {
+		return project, nil
+	}
+	return project, h.enqueueNamespaces(projectName)
+}
+
+// onNetworkPolicyChange enqueues the namespace of a maintained NetworkPolicy when it is changed or deleted, so it is
+// restored.
+func (h *handler) onNetworkPolicyChange(key string, _ *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+	namespace, name, _ := strings.Cut(key, "/")
+	for _, policyName := range policyNames {
+		if name == policyName {
+			h.namespaces.Enqueue(namespace)
+			break
+		}
+	}
+	return nil, nil
+}
+
+func (h *handler) enqueueNamespaces(projectName string) error {
+	selector := labels.SelectorFromSet(labels.Set{nslabels.ProjectIDFieldLabel: projectName})
+	namespaces, err := h.namespaces.Cache().List(selector)
+	if err != nil {
+		return err
+	}
+	for _, ns := range namespaces {
+		h.namespaces.Enqueue(ns.Name)
+	}
+	return nil
+}
+
+func (h *handler) onNamespaceChange(_ string, ns *corev1.Namespace) (*corev1.Namespace, error) {
+	if ns == nil || ns.DeletionTimestamp != nil {
+		return ns, nil
+	}
+
+	desired, err := h.desiredPolicies(ns)
+	if err != nil {
+		return ns, err
+	}
+
+	var errs []error
+	for _, name := range policyNames {
+		if err := h.reconcile(ns.Name, name, desired[name]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return ns, errors.Join(errs...)
+}
+
+// desiredPolicies returns the NetworkPolicies the namespace should have, by name, according to the network isolation of
+// its project.
+func (h *handler) desiredPolicies(ns *corev1.Namespace) (map[string]*networkingv1.NetworkPolicy, error) {
+	projectName := ns.Labels[nslabels.ProjectIDFieldLabel]
+	if projectName == "" {
+		return nil, nil
+	}
+	project, err := h.projectCache.Get(h.clusterName, projectName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	switch project.Spec.NetworkIsolation {
+	case v3.ProjectNetworkIsolationDenyAll:
+		return map[string]*networkingv1.NetworkPolicy{
+			denyAllPolicyName: denyAllPolicy(ns.Name, projectName),
+		}, nil
+	case v3.ProjectNetworkIsolationSameProject:
+		systemProjectName, err := h.systemProjectName()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]*networkingv1.NetworkPolicy{
+			denyAllPolicyName:     denyAllPolicy(ns.Name, projectName),
+			sameProjectPolicyName: sameProjectPolicy(ns.Name, projectName, systemProjectName),
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (h *handler) systemProjectName() (string, error) {
+	selector := labels.SelectorFromSet(labels.Set{systemProjectLabel: "true"})
+	projects, err := h.projectCache.List(h.clusterName, selector)
+	if err != nil {
+		return "", err
+	}
+	if len(projects) != 1 {
+		return "", fmt.Errorf("expected one system project in cluster %s, found %d", h.clusterName, len(projects))
+	}
+	return projects[0].Name, nil
+}
+
+// reconcile creates or updates the NetworkPolicy of the namespace with the given name, or deletes it when it is no
+// longer desired and was maintained by this controller.
+func (h *handler) reconcile(namespace, name string, desired *networkingv1.NetworkPolicy) error {
+	existing, err := h.networkPolicies.Cache().Get(namespace, name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	if desired == nil {
+		if !exists || existing.Labels[ManagedLabel] != "true" {
+			return nil
+		}
+		err := h.networkPolicies.Delete(namespace, name, &metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting network policy %s/%s: %w", namespace, name, err)
+		}
+		return nil
+	}
+
+	if !exists {
+		if _, err := h.networkPolicies.Create(desired); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("creating network policy %s/%s: %w", namespace, name, err)
+		}
+		return nil
+	}
+
+	if equality.Semantic.DeepEqual(existing.Spec, desired.Spec) && labelsContain(existing.Labels, desired.Labels) {
+		return nil
+	}
+	updated := existing.DeepCopy()
+	updated.Spec = desired.Spec
+	if updated.Labels == nil {
+		updated.Labels = map[string]string{}
+	}
+	maps.Copy(updated.Labels, desired.Labels)
+	if _, err := h.networkPolicies.Update(updated); err != nil {
+		return fmt.Errorf("updating network policy %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
+
+func labelsContain(actual, expected map[string]string) bool {
+	for k, v := range expected {
+		if actual[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func policyLabels(projectName string) map[string]string {
+	return map[string]string{
+		ManagedLabel:                 "true",
+		nslabels.ProjectIDFieldLabel: projectName,
+	}
+}
+
+// denyAllPolicy denies all ingress traffic to the pods of the namespace that is not allowed by other policies.
+func denyAllPolicy(namespace, projectName string) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      denyAllPolicyName,
+			Namespace: namespace,
+			Labels:    policyLabels(projectName),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			// An empty PodSelector selects all pods in this Namespace.
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+}
+
+// sameProjectPolicy allows ingress traffic to the pods of the namespace from the namespaces of the project and of the
+// System project, which runs the ingress controllers and monitoring of the cluster.
+func sameProjectPolicy(namespace, projectName, systemProjectName string) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sameProjectPolicyName,
+			Namespace: namespace,
+			Labels:    policyLabels(projectName),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From: []networkingv1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{nslabels.ProjectIDFieldLabel: projectName},
+							},
+						},
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{nslabels.ProjectIDFieldLabel: systemProjectName},
+							},
+						},
+					},
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+}
@@ -0,0 +1,213 @@
+package projectnetworkisolation
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementagent/nslabels"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const clusterName = "c-abcde"
+
+var errNotFound = apierrors.NewNotFound(schema.GroupResource{}, "")
+
+func newNamespace(name, project string) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if project != "" {
+		ns.Labels = map[string]string{nslabels.ProjectIDFieldLabel: project}
+	}
+	return ns
+}
+
+func projectCacheMock(ctrl *gomock.Controller) *fake.MockCacheInterface[*v3.Project] {
+	systemProject := &v3.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "p-system", Namespace: clusterName, Labels: map[string]string{systemProjectLabel: "true"}},
+	}
+	projectCache := fake.NewMockCacheInterface[*v3.Project](ctrl)
+	projectCache.EXPECT().Get(clusterName, "p-none").Return(&v3.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "p-none", Namespace: clusterName},
+	}, nil).AnyTimes()
+	projectCache.EXPECT().Get(clusterName, "p-deny").Return(&v3.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "p-deny", Namespace: clusterName},
+		Spec:       v3.ProjectSpec{NetworkIsolation: v3.ProjectNetworkIsolationDenyAll},
+	}, nil).AnyTimes()
+	projectCache.EXPECT().Get(clusterName, "p-same").Return(&v3.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "p-same", Namespace: clusterName},
+		Spec:       v3.ProjectSpec{NetworkIsolation: v3.ProjectNetworkIsolationSameProject},
+	}, nil).AnyTimes()
+	projectCache.EXPECT().Get(clusterName, "p-missing").Return(nil, errNotFound).AnyTimes()
+	projectCache.EXPECT().List(clusterName, labels.SelectorFromSet(labels.Set{systemProjectLabel: "true"})).
+		Return([]*v3.Project{systemProject}, nil).AnyTimes()
+	return projectCache
+}
+
+func TestDesiredPolicies(t *testing.T) {
+	tests := []struct {
+		name string
+		ns   *corev1.Namespace
+		want map[string]*networkingv1.NetworkPolicy
+	}{
+		{
+			name: "namespace outside of a project",
+			ns:   newNamespace("default", ""),
+		},
+		{
+			name: "project not found",
+			ns:   newNamespace("app", "p-missing"),
+		},
+		{
+			name: "project without network isolation",
+			ns:   newNamespace("app", "p-none"),
+		},
+		{
+			name: "deny all",
+			ns:   newNamespace("app", "p-deny"),
+			want: map[string]*networkingv1.NetworkPolicy{
+				denyAllPolicyName: denyAllPolicy("app", "p-deny"),
+			},
+		},
+		{
+			name: "same project",
+			ns:   newNamespace("app", "p-same"),
+			want: map[string]*networkingv1.NetworkPolicy{
+				denyAllPolicyName:     denyAllPolicy("app", "p-same"),
+				sameProjectPolicyName: sameProjectPolicy("app", "p-same", "p-system"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := &handler{
+				clusterName:  clusterName,
+				projectCache: projectCacheMock(ctrl),
+			}
+			got, err := h.desiredPolicies(tt.ns)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOnNamespaceChange(t *testing.T) {
+	staleSameProject := sameProjectPolicy("app", "p-same", "p-other")
+	unmanaged := denyAllPolicy("app", "p-none")
+	delete(unmanaged.Labels, ManagedLabel)
+
+	tests := []struct {
+		name        string
+		ns          *corev1.Namespace
+		existing    []*networkingv1.NetworkPolicy
+		wantCreated []string
+		wantUpdated []string
+		wantDeleted []string
+	}{
+		{
+			name:        "creates policies",
+			ns:          newNamespace("app", "p-same"),
+			wantCreated: []string{denyAllPolicyName, sameProjectPolicyName},
+		},
+		{
+			name:     "policies up to date",
+			ns:       newNamespace("app", "p-same"),
+			existing: []*networkingv1.NetworkPolicy{denyAllPolicy("app", "p-same"), sameProjectPolicy("app", "p-same", "p-system")},
+		},
+		{
+			name:        "updates modified policy",
+			ns:          newNamespace("app", "p-same"),
+			existing:    []*networkingv1.NetworkPolicy{denyAllPolicy("app", "p-same"), staleSameProject},
+			wantUpdated: []string{sameProjectPolicyName},
+		},
+		{
+			name:        "deletes policies no longer desired",
+			ns:          newNamespace("app", "p-deny"),
+			existing:    []*networkingv1.NetworkPolicy{denyAllPolicy("app", "p-deny"), sameProjectPolicy("app", "p-deny", "p-system")},
+			wantDeleted: []string{sameProjectPolicyName},
+		},
+		{
+			name:     "leaves unmanaged policy untouched",
+			ns:       newNamespace("app", "p-none"),
+			existing: []*networkingv1.NetworkPolicy{unmanaged},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			policyCache := fake.NewMockCacheInterface[*networkingv1.NetworkPolicy](ctrl)
+			for _, name := range policyNames {
+				var found *networkingv1.NetworkPolicy
+				for _, policy := range tt.existing {
+					if policy.Name == name {
+						found = policy
+					}
+				}
+				if found != nil {
+					policyCache.EXPECT().Get(tt.ns.Name, name).Return(found, nil)
+				} else {
+					policyCache.EXPECT().Get(tt.ns.Name, name).Return(nil, errNotFound)
+				}
+			}
+
+			var created, updated, deleted []string
+			policies := fake.NewMockControllerInterface[*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicyList](ctrl)
+			policies.EXPECT().Cache().Return(policyCache).AnyTimes()
+			policies.EXPECT().Create(gomock.Any()).DoAndReturn(func(policy *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+				assert.Equal(t, "true", policy.Labels[ManagedLabel])
+				created = append(created, policy.Name)
+				return policy, nil
+			}).AnyTimes()
+			policies.EXPECT().Update(gomock.Any()).DoAndReturn(func(policy *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+				assert.Equal(t, sameProjectPolicy("app", "p-same", "p-system").Spec, policy.Spec)
+				updated = append(updated, policy.Name)
+				return policy, nil
+			}).AnyTimes()
+			policies.EXPECT().Delete(tt.ns.Name, gomock.Any(), gomock.Any()).DoAndReturn(func(_, name string, _ *metav1.DeleteOptions) error {
+				deleted = append(deleted, name)
+				return nil
+			}).AnyTimes()
+
+			h := &handler{
+				clusterName:     clusterName,
+				projectCache:    projectCacheMock(ctrl),
+				networkPolicies: policies,
+			}
+			_, err := h.onNamespaceChange(tt.ns.Name, tt.ns)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCreated, created)
+			assert.Equal(t, tt.wantUpdated, updated)
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
+
+func TestOnProjectChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	namespaceCache := fake.NewMockNonNamespacedCacheInterface[*corev1.Namespace](ctrl)
+	namespaceCache.EXPECT().List(labels.SelectorFromSet(labels.Set{nslabels.ProjectIDFieldLabel: "p-same"})).
+		Return([]*corev1.Namespace{newNamespace("app", "p-same"), newNamespace("web", "p-same")}, nil)
+	namespaces := fake.NewMockNonNamespacedControllerInterface[*corev1.Namespace, *corev1.NamespaceList](ctrl)
+	namespaces.EXPECT().Cache().Return(namespaceCache)
+	namespaces.EXPECT().Enqueue("app")
+	namespaces.EXPECT().Enqueue("web")
+
+	h := &handler{
+		clusterName: clusterName,
+		namespaces:  namespaces,
+	}
+	_, err := h.onProjectChange(clusterName+"/p-same", nil)
+	require.NoError(t, err)
+	_, err = h.onProjectChange("c-other/p-same", nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,360 @@
+// Package psaprofile applies the pod security admission profiles declared in Rancher to the namespaces of the projects
+// they are bound to in the downstream clusters. The pod security levels of a profile are set as the pod security
+// admission labels of the namespaces, and the compliance of the namespaces is reported in the status of the profile.
+package psaprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtv3controllers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	wcorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// ProfileAnnotation is set on the namespaces of the downstream clusters to the name of the profile applied to them.
+	ProfileAnnotation = "management.cattle.io/psa-profile"
+
+	projectIDAnnotation = "field.cattle.io/projectId"
+	systemProjectLabel  = "authz.management.cattle.io/system-project"
+
+	enforceLabel        = "pod-security.kubernetes.io/enforce"
+	enforceVersionLabel = "pod-security.kubernetes.io/enforce-version"
+	auditLabel          = "pod-security.kubernetes.io/audit"
+	auditVersionLabel   = "pod-security.kubernetes.io/audit-version"
+	warnLabel           = "pod-security.kubernetes.io/warn"
+	warnVersionLabel    = "pod-security.kubernetes.io/warn-version"
+)
+
+var psaLabels = []string{enforceLabel, enforceVersionLabel, auditLabel, auditVersionLabel, warnLabel, warnVersionLabel}
+
+type handler struct {
+	clusterName  string
+	clusterCache mgmtv3controllers.ClusterCache
+	projectCache mgmtv3controllers.ProjectCache
+	profiles     mgmtv3controllers.PodSecurityAdmissionProfileController
+	namespaces   wcorev1.NamespaceController
+}
+
+func Register(ctx context.Context, downstream *config.UserContext) {
+	h := &handler{
+		clusterName:  downstream.ClusterName,
+		clusterCache: downstream.Management.Wrangler.Mgmt.Cluster().Cache(),
+		projectCache: downstream.Management.Wrangler.Mgmt.Project().Cache(),
+		profiles:     downstream.Management.Wrangler.Mgmt.PodSecurityAdmissionProfile(),
+		namespaces:   downstream.Corew.Namespace(),
+	}
+
+	// Each downstream cluster registers its own handlers, as the profiles are applied through its own clients.
+	downstream.Management.Wrangler.Mgmt.PodSecurityAdmissionProfile().OnChange(ctx, "psa-profile-"+h.clusterName, h.onProfileChange)
+	downstream.Management.Wrangler.Mgmt.Cluster().OnChange(ctx, "psa-profile-cluster-"+h.clusterName, h.onClusterChange)
+	downstream.Management.Wrangler.Mgmt.Project().OnChange(ctx, "psa-profile-project-"+h.clusterName, h.onProjectChange)
+	downstream.Corew.Namespace().OnChange(ctx, "psa-profile-namespace", h.onNamespaceChange)
+}
+
+// onClusterChange enqueues the profiles when the management cluster changes, as a change of its labels can change the
+// profiles that select it.
+func (h *handler) onClusterChange(_ string, cluster *v3.Cluster) (*v3.Cluster, error) {
+	if cluster == nil || cluster.Name != h.clusterName {
+		return cluster, nil
+	}
+	return cluster, h.enqueueProfiles()
+}
+
+// onProjectChange enqueues the profiles when a project of the cluster changes, as a change of its labels can change
+// the profiles bound to its namespaces.
+func (h *handler) onProjectChange(_ string, project *v3.Project) (*v3.Project, error) {
+	if project == nil || project.Namespace != h.clusterName {
+		return project, nil
+	}
+	return project, h.enqueueProfiles()
+}
+
+func (h *handler) enqueueProfiles() error {
+	profiles, err := h.profiles.Cache().List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, profile := range profiles {
+		h.profiles.Enqueue(profile.Name)
+	}
+	return nil
+}
+
+func (h *handler) onProfileChange(key string, profile *v3.PodSecurityAdmissionProfile) (*v3.PodSecurityAdmissionProfile, error) {
+	if profile == nil {
+		return nil, h.enqueueNamespaces(key)
+	}
+	if profile.DeletionTimestamp != nil {
+		return profile, nil
+	}
+
+	cluster, err := h.clusterCache.Get(h.clusterName)
+	if err != nil {
+		return profile, err
+	}
+
+	selected, err := selectsLabels(profile.Spec.ClusterSelector, cluster.Labels)
+	if err != nil {
+		// An invalid selector is reported in the status of every cluster, instead of being retried.
+		return profile, h.updateStatus(profile.Name, &v3.PodSecurityAdmissionProfileClusterStatus{
+			ClusterName:        h.clusterName,
+			ObservedGeneration: profile.Generation,
+			Error:              fmt.Sprintf("invalid cluster selector: %v", err),
+		})
+	}
+	if !selected {
+		return profile, errors.Join(h.enqueueNamespaces(profile.Name), h.updateStatus(profile.Name, nil))
+	}
+
+	profiles, err := h.clusterProfiles(cluster)
+	if err != nil {
+		return profile, err
+	}
+	namespaces, err := h.namespaces.Cache().List(labels.Everything())
+	if err != nil {
+		return profile, err
+	}
+
+	status := &v3.PodSecurityAdmissionProfileClusterStatus{
+		ClusterName:        h.clusterName,
+		ObservedGeneration: profile.Generation,
+	}
+	var errs []error
+	for _, ns := range namespaces {
+		if ns.DeletionTimestamp != nil {
+			continue
+		}
+		bound, err := h.profileFor(ns, profiles)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if bound == nil || bound.Name != profile.Name {
+			// The namespace is bound to another profile or no longer bound, it is reconciled by the namespace handler.
+			if ns.Annotations[ProfileAnnotation] == profile.Name {
+				h.namespaces.Enqueue(ns.Name)
+			}
+			continue
+		}
+		status.Namespaces++
+		if _, err := h.applyProfile(ns, profile); err != nil {
+			status.NonCompliantNamespaces = append(status.NonCompliantNamespaces, ns.Name)
+			errs = append(errs, fmt.Errorf("failed to apply profile to namespace %s: %w", ns.Name, err))
+		}
+	}
+	sort.Strings(status.NonCompliantNamespaces)
+	status.Compliant = len(status.NonCompliantNamespaces) == 0
+	applyErr := errors.Join(errs...)
+	if applyErr != nil {
+		status.Error = applyErr.Error()
+	}
+	return profile, errors.Join(applyErr, h.updateStatus(profile.Name, status))
+}
+
+func (h *handler) onNamespaceChange(_ string, ns *corev1.Namespace) (*corev1.Namespace, error) {
+	if ns == nil {
+		return nil, nil
+	}
+	previous := ns.Annotations[ProfileAnnotation]
+	if ns.DeletionTimestamp != nil {
+		if previous != "" {
+			// Update the namespace count of the profile.
+			h.profiles.Enqueue(previous)
+		}
+		return ns, nil
+	}
+
+	cluster, err := h.clusterCache.Get(h.clusterName)
+	if err != nil {
+		return ns, err
+	}
+	profiles, err := h.clusterProfiles(cluster)
+	if err != nil {
+		return ns, err
+	}
+	profile, err := h.profileFor(ns, profiles)
+	if err != nil {
+		return ns, err
+	}
+
+	ns, err = h.applyProfile(ns, profile)
+	if err != nil {
+		return ns, err
+	}
+	if profile != nil && profile.Name != previous {
+		h.profiles.Enqueue(profile.Name)
+	}
+	if previous != "" && (profile == nil || profile.Name != previous) {
+		h.profiles.Enqueue(previous)
+	}
+	return ns, nil
+}
+
+// enqueueNamespaces enqueues the namespaces the given profile is applied to, so that they are reconciled with the
+// profiles bound to them.
+func (h *handler) enqueueNamespaces(profileName string) error {
+	namespaces, err := h.namespaces.Cache().List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, ns := range namespaces {
+		if ns.Annotations[ProfileAnnotation] == profileName {
+			h.namespaces.Enqueue(ns.Name)
+		}
+	}
+	return nil
+}
+
+// clusterProfiles returns the profiles selecting the given cluster, sorted by name.
+func (h *handler) clusterProfiles(cluster *v3.Cluster) ([]*v3.PodSecurityAdmissionProfile, error) {
+	profiles, err := h.profiles.Cache().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var result []*v3.PodSecurityAdmissionProfile
+	for _, profile := range profiles {
+		if profile.DeletionTimestamp != nil {
+			continue
+		}
+		// Profiles with an invalid selector are skipped, the error is reported in their status.
+		if selected, err := selectsLabels(profile.Spec.ClusterSelector, cluster.Labels); err == nil && selected {
+			result = append(result, profile)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// profileFor returns the profile bound to the project of the given namespace, if any. A profile binding the project
+// through its project selector takes precedence over a profile binding all the projects, and the first profile by
+// name is chosen among several profiles of the same precedence.
+func (h *handler) profileFor(ns *corev1.Namespace, profiles []*v3.PodSecurityAdmissionProfile) (*v3.PodSecurityAdmissionProfile, error) {
+	_, projectName, ok := strings.Cut(ns.Annotations[projectIDAnnotation], ":")
+	if !ok || len(profiles) == 0 {
+		return nil, nil
+	}
+	project, err := h.projectCache.Get(h.clusterName, projectName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var result *v3.PodSecurityAdmissionProfile
+	for _, profile := range profiles {
+		if profile.Spec.ProjectSelector == nil {
+			if result == nil && project.Labels[systemProjectLabel] != "true" {
+				result = profile
+			}
+			continue
+		}
+		if selected, err := selectsLabels(profile.Spec.ProjectSelector, project.Labels); err == nil && selected {
+			return profile, nil
+		}
+	}
+	return result, nil
+}
+
+// applyProfile sets the pod security admission labels of the given namespace to the levels of the profile, or removes
+// them if the namespace is no longer bound to the profile previously applied to it.
+func (h *handler) applyProfile(ns *corev1.Namespace, profile *v3.PodSecurityAdmissionProfile) (*corev1.Namespace, error) {
+	if profile == nil && ns.Annotations[ProfileAnnotation] == "" {
+		return ns, nil
+	}
+
+	desired := ns.DeepCopy()
+	if desired.Labels == nil {
+		desired.Labels = map[string]string{}
+	}
+	if desired.Annotations == nil {
+		desired.Annotations = map[string]string{}
+	}
+	var levels map[string]string
+	if profile != nil {
+		levels = profileLabels(profile)
+		desired.Annotations[ProfileAnnotation] = profile.Name
+	} else {
+		delete(desired.Annotations, ProfileAnnotation)
+	}
+	for _, label := range psaLabels {
+		if value := levels[label]; value != "" {
+			desired.Labels[label] = value
+		} else {
+			delete(desired.Labels, label)
+		}
+	}
+
+	if equality.Semantic.DeepEqual(ns.Labels, desired.Labels) && equality.Semantic.DeepEqual(ns.Annotations, desired.Annotations) {
+		return ns, nil
+	}
+	return h.namespaces.Update(desired)
+}
+
+func profileLabels(profile *v3.PodSecurityAdmissionProfile) map[string]string {
+	return map[string]string{
+		enforceLabel:        profile.Spec.Enforce,
+		enforceVersionLabel: profile.Spec.EnforceVersion,
+		auditLabel:          profile.Spec.Audit,
+		auditVersionLabel:   profile.Spec.AuditVersion,
+		warnLabel:           profile.Spec.Warn,
+		warnVersionLabel:    profile.Spec.WarnVersion,
+	}
+}
+
+func selectsLabels(selector *metav1.LabelSelector, objLabels map[string]string) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+	return s.Matches(labels.Set(objLabels)), nil
+}
+
+// updateStatus records the status of the profile in the cluster, or removes the cluster from the status of the profile
+// if it is no longer selected.
+func (h *handler) updateStatus(name string, status *v3.PodSecurityAdmissionProfileClusterStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// The status is updated by the handlers of all the downstream clusters, the cache is likely to be stale.
+		profile, err := h.profiles.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		clusters := make([]v3.PodSecurityAdmissionProfileClusterStatus, 0, len(profile.Status.Clusters)+1)
+		for _, clusterStatus := range profile.Status.Clusters {
+			if clusterStatus.ClusterName != h.clusterName {
+				clusters = append(clusters, clusterStatus)
+			}
+		}
+		if status != nil {
+			clusters = append(clusters, *status)
+		}
+		sort.Slice(clusters, func(i, j int) bool {
+			return clusters[i].ClusterName < clusters[j].ClusterName
+		})
+
+		if len(clusters) == 0 && len(profile.Status.Clusters) == 0 || equality.Semantic.DeepEqual(clusters, profile.Status.Clusters) {
+			return nil
+		}
+		profile = profile.DeepCopy()
+		profile.Status.Clusters = clusters
+		_, err = h.profiles.UpdateStatus(profile)
+		return err
+	})
+}
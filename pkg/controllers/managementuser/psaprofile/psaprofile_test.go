@@ -0,0 +1,255 @@
+package psaprofile
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const clusterName = "c-abcde"
+
+func newProfile(name string, projectSelector map[string]string, enforce string) *v3.PodSecurityAdmissionProfile {
+	profile := &v3.PodSecurityAdmissionProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 2},
+		Spec: v3.PodSecurityAdmissionProfileSpec{
+			Enforce:        enforce,
+			EnforceVersion: "latest",
+		},
+	}
+	if projectSelector != nil {
+		profile.Spec.ProjectSelector = &metav1.LabelSelector{MatchLabels: projectSelector}
+	}
+	return profile
+}
+
+func newNamespace(name, project string, nsLabels, annotations map[string]string) *corev1.Namespace {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      nsLabels,
+			Annotations: map[string]string{},
+		},
+	}
+	if project != "" {
+		ns.Annotations[projectIDAnnotation] = clusterName + ":" + project
+	}
+	for k, v := range annotations {
+		ns.Annotations[k] = v
+	}
+	return ns
+}
+
+func projectCacheMock(ctrl *gomock.Controller) *fake.MockCacheInterface[*v3.Project] {
+	projectCache := fake.NewMockCacheInterface[*v3.Project](ctrl)
+	projectCache.EXPECT().Get(clusterName, "p-default").Return(&v3.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "p-default", Namespace: clusterName},
+	}, nil).AnyTimes()
+	projectCache.EXPECT().Get(clusterName, "p-team").Return(&v3.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "p-team", Namespace: clusterName, Labels: map[string]string{"team": "a"}},
+	}, nil).AnyTimes()
+	projectCache.EXPECT().Get(clusterName, "p-system").Return(&v3.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "p-system", Namespace: clusterName, Labels: map[string]string{systemProjectLabel: "true"}},
+	}, nil).AnyTimes()
+	return projectCache
+}
+
+func TestProfileFor(t *testing.T) {
+	clusterWide := newProfile("baseline", nil, "baseline")
+	team := newProfile("team", map[string]string{"team": "a"}, "restricted")
+	system := newProfile("system", map[string]string{systemProjectLabel: "true"}, "privileged")
+	other := newProfile("zz-baseline", nil, "restricted")
+
+	tests := []struct {
+		name     string
+		ns       *corev1.Namespace
+		profiles []*v3.PodSecurityAdmissionProfile
+		want     *v3.PodSecurityAdmissionProfile
+	}{
+		{
+			name:     "namespace outside of a project",
+			ns:       newNamespace("default", "", nil, nil),
+			profiles: []*v3.PodSecurityAdmissionProfile{clusterWide},
+		},
+		{
+			name:     "cluster wide profile",
+			ns:       newNamespace("app", "p-default", nil, nil),
+			profiles: []*v3.PodSecurityAdmissionProfile{clusterWide, other},
+			want:     clusterWide,
+		},
+		{
+			name:     "project profile takes precedence",
+			ns:       newNamespace("app", "p-team", nil, nil),
+			profiles: []*v3.PodSecurityAdmissionProfile{clusterWide, team},
+			want:     team,
+		},
+		{
+			name:     "system project not bound by cluster wide profile",
+			ns:       newNamespace("cattle-system", "p-system", nil, nil),
+			profiles: []*v3.PodSecurityAdmissionProfile{clusterWide},
+		},
+		{
+			name:     "system project bound by its project selector",
+			ns:       newNamespace("cattle-system", "p-system", nil, nil),
+			profiles: []*v3.PodSecurityAdmissionProfile{clusterWide, system},
+			want:     system,
+		},
+		{
+			name:     "project not selected",
+			ns:       newNamespace("app", "p-default", nil, nil),
+			profiles: []*v3.PodSecurityAdmissionProfile{team},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := &handler{
+				clusterName:  clusterName,
+				projectCache: projectCacheMock(ctrl),
+			}
+			got, err := h.profileFor(tt.ns, tt.profiles)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOnNamespaceChange(t *testing.T) {
+	profile := newProfile("restricted", nil, "restricted")
+
+	tests := []struct {
+		name           string
+		ns             *corev1.Namespace
+		profiles       []*v3.PodSecurityAdmissionProfile
+		wantUpdate     bool
+		wantLabels     map[string]string
+		wantAnnotation string
+		wantEnqueued   []string
+	}{
+		{
+			name:     "labels namespace bound to profile",
+			ns:       newNamespace("app", "p-default", map[string]string{enforceLabel: "privileged", auditLabel: "baseline", "app": "web"}, nil),
+			profiles: []*v3.PodSecurityAdmissionProfile{profile},
+			wantLabels: map[string]string{
+				enforceLabel:        "restricted",
+				enforceVersionLabel: "latest",
+				"app":               "web",
+			},
+			wantUpdate:     true,
+			wantAnnotation: "restricted",
+			wantEnqueued:   []string{"restricted"},
+		},
+		{
+			name: "namespace up to date",
+			ns: newNamespace("app", "p-default", map[string]string{enforceLabel: "restricted", enforceVersionLabel: "latest"},
+				map[string]string{ProfileAnnotation: "restricted"}),
+			profiles: []*v3.PodSecurityAdmissionProfile{profile},
+		},
+		{
+			name: "unlabels namespace no longer bound",
+			ns: newNamespace("app", "", map[string]string{enforceLabel: "restricted", enforceVersionLabel: "latest", "app": "web"},
+				map[string]string{ProfileAnnotation: "restricted"}),
+			profiles:     []*v3.PodSecurityAdmissionProfile{profile},
+			wantUpdate:   true,
+			wantLabels:   map[string]string{"app": "web"},
+			wantEnqueued: []string{"restricted"},
+		},
+		{
+			name:     "leaves unmanaged namespace untouched",
+			ns:       newNamespace("app", "p-default", map[string]string{enforceLabel: "baseline"}, nil),
+			profiles: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			clusterCache := fake.NewMockNonNamespacedCacheInterface[*v3.Cluster](ctrl)
+			clusterCache.EXPECT().Get(clusterName).Return(&v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}, nil)
+
+			profileCache := fake.NewMockNonNamespacedCacheInterface[*v3.PodSecurityAdmissionProfile](ctrl)
+			profileCache.EXPECT().List(labels.Everything()).Return(tt.profiles, nil)
+			profiles := fake.NewMockNonNamespacedControllerInterface[*v3.PodSecurityAdmissionProfile, *v3.PodSecurityAdmissionProfileList](ctrl)
+			profiles.EXPECT().Cache().Return(profileCache)
+			var enqueued []string
+			profiles.EXPECT().Enqueue(gomock.Any()).Do(func(name string) {
+				enqueued = append(enqueued, name)
+			}).AnyTimes()
+
+			namespaces := fake.NewMockNonNamespacedControllerInterface[*corev1.Namespace, *corev1.NamespaceList](ctrl)
+			if tt.wantUpdate {
+				namespaces.EXPECT().Update(gomock.Any()).DoAndReturn(func(ns *corev1.Namespace) (*corev1.Namespace, error) {
+					assert.Equal(t, tt.wantLabels, ns.Labels)
+					assert.Equal(t, tt.wantAnnotation, ns.Annotations[ProfileAnnotation])
+					return ns, nil
+				})
+			}
+
+			h := &handler{
+				clusterName:  clusterName,
+				clusterCache: clusterCache,
+				projectCache: projectCacheMock(ctrl),
+				profiles:     profiles,
+				namespaces:   namespaces,
+			}
+			_, err := h.onNamespaceChange(tt.ns.Name, tt.ns)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEnqueued, enqueued)
+		})
+	}
+}
+
+func TestOnProfileChange(t *testing.T) {
+	profile := newProfile("restricted", nil, "restricted")
+	cluster := &v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Labels: map[string]string{"env": "prod"}}}
+
+	ctrl := gomock.NewController(t)
+	clusterCache := fake.NewMockNonNamespacedCacheInterface[*v3.Cluster](ctrl)
+	clusterCache.EXPECT().Get(clusterName).Return(cluster, nil)
+
+	profileCache := fake.NewMockNonNamespacedCacheInterface[*v3.PodSecurityAdmissionProfile](ctrl)
+	profileCache.EXPECT().List(labels.Everything()).Return([]*v3.PodSecurityAdmissionProfile{profile}, nil)
+	profiles := fake.NewMockNonNamespacedControllerInterface[*v3.PodSecurityAdmissionProfile, *v3.PodSecurityAdmissionProfileList](ctrl)
+	profiles.EXPECT().Cache().Return(profileCache)
+	profiles.EXPECT().Get(profile.Name, gomock.Any()).Return(profile, nil)
+	profiles.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(profile *v3.PodSecurityAdmissionProfile) (*v3.PodSecurityAdmissionProfile, error) {
+		assert.Equal(t, []v3.PodSecurityAdmissionProfileClusterStatus{
+			{ClusterName: clusterName, ObservedGeneration: 2, Namespaces: 2, Compliant: true},
+		}, profile.Status.Clusters)
+		return profile, nil
+	})
+
+	namespaceCache := fake.NewMockNonNamespacedCacheInterface[*corev1.Namespace](ctrl)
+	namespaceCache.EXPECT().List(labels.Everything()).Return([]*corev1.Namespace{
+		newNamespace("app", "p-default", nil, nil),
+		newNamespace("web", "p-default", map[string]string{enforceLabel: "restricted", enforceVersionLabel: "latest"},
+			map[string]string{ProfileAnnotation: "restricted"}),
+		newNamespace("cattle-system", "p-system", nil, nil),
+		newNamespace("moved", "", nil, map[string]string{ProfileAnnotation: "restricted"}),
+	}, nil)
+	namespaces := fake.NewMockNonNamespacedControllerInterface[*corev1.Namespace, *corev1.NamespaceList](ctrl)
+	namespaces.EXPECT().Cache().Return(namespaceCache)
+	namespaces.EXPECT().Update(gomock.Any()).DoAndReturn(func(ns *corev1.Namespace) (*corev1.Namespace, error) {
+		assert.Equal(t, "app", ns.Name)
+		assert.Equal(t, map[string]string{enforceLabel: "restricted", enforceVersionLabel: "latest"}, ns.Labels)
+		return ns, nil
+	})
+	namespaces.EXPECT().Enqueue("moved")
+
+	h := &handler{
+		clusterName:  clusterName,
+		clusterCache: clusterCache,
+		projectCache: projectCacheMock(ctrl),
+		profiles:     profiles,
+		namespaces:   namespaces,
+	}
+	_, err := h.onProfileChange(profile.Name, profile)
+	require.NoError(t, err)
+}
@@ -292,7 +292,10 @@ func (m *manager) gatherRoles(rt *v3.RoleTemplate, roleTemplates map[string]*v3.
 	return nil
 }
 
+// gatherRolesRecurse gathers the RoleTemplate and the ones it inherits, as they are until their current revision is
+// rolled out to the cluster.
 func (m *manager) gatherRolesRecurse(rt *v3.RoleTemplate, roleTemplates map[string]*v3.RoleTemplate, depthCounter int) error {
+	rt = pkgrbac.RoleTemplateForCluster(rt, m.clusterName)
 	roleTemplates[rt.Name] = rt
 	depthCounter++
 
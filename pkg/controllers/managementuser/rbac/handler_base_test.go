@@ -6,6 +6,7 @@
 	"testing"
 
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	pkgrbac "github.com/rancher/rancher/pkg/rbac"
 	wrbacv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac/v1"
 	wfakes "github.com/rancher/wrangler/v3/pkg/generic/fake"
 	"github.com/stretchr/testify/assert"
@@ -214,6 +215,44 @@ type args struct {
 	}
 }
 
+func Test_gatherRolesRollout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := newManager(withRoleTemplates(recursiveTestRoleTemplates, nil, ctrl))
+
+	getPods := v1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}
+	getUpdatePods := v1.PolicyRule{Verbs: []string{"get", "update"}, APIGroups: []string{""}, Resources: []string{"pods"}}
+	listSecrets := v1.PolicyRule{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"secrets"}}
+	previous := &v3.RoleTemplate{
+		Rules:             []v1.PolicyRule{getUpdatePods},
+		RoleTemplateNames: []string{"non-recursive", "inherit non-recursive"},
+	}
+	newRoleTemplate := func(rolledOut string) *v3.RoleTemplate {
+		rt := &v3.RoleTemplate{
+			ObjectMeta:        metav1.ObjectMeta{Name: "rt-1"},
+			Rules:             []v1.PolicyRule{getPods, listSecrets},
+			RoleTemplateNames: []string{"non-recursive"},
+		}
+		rt.Annotations = map[string]string{
+			pkgrbac.RoleTemplateRevisionHashAnnotation:         pkgrbac.RoleTemplateRevisionHash(rt),
+			pkgrbac.RoleTemplateRevisionSpecAnnotation:         pkgrbac.RoleTemplateRevisionSpec(rt),
+			pkgrbac.RoleTemplatePreviousRevisionSpecAnnotation: pkgrbac.RoleTemplateRevisionSpec(previous),
+			pkgrbac.RoleTemplateRolloutClustersAnnotation:      rolledOut,
+		}
+		return rt
+	}
+
+	// Until the revision is rolled out, the rules it adds aren't granted and the ones it removes are revoked.
+	roleTemplates := map[string]*v3.RoleTemplate{}
+	assert.NoError(t, m.gatherRoles(newRoleTemplate("c-1"), roleTemplates, 0))
+	assert.Equal(t, []v1.PolicyRule{getPods}, roleTemplates["rt-1"].Rules)
+	assert.Equal(t, []string{"non-recursive"}, roleTemplates["rt-1"].RoleTemplateNames)
+	assert.Len(t, roleTemplates, 2)
+
+	roleTemplates = map[string]*v3.RoleTemplate{}
+	assert.NoError(t, m.gatherRoles(newRoleTemplate("c-1,testcluster"), roleTemplates, 0))
+	assert.Equal(t, []v1.PolicyRule{getPods, listSecrets}, roleTemplates["rt-1"].Rules)
+}
+
 func TestCompareAndUpdateClusterRole(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
@@ -2,6 +2,7 @@
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/rancher/rancher/pkg/apis/management.cattle.io"
@@ -70,28 +71,73 @@ func (p *pLifecycle) Remove(project *v3.Project) (runtime.Object, error) {
 		return project, err
 	}
 
+	var projectNamespaces []*corev1.Namespace
 	for _, o := range namespaces {
-		namespace, _ := o.(*corev1.Namespace)
-		if _, ok := namespace.Annotations["field.cattle.io/creatorId"]; ok {
-			err := p.m.workload.Core.Namespaces("").Delete(namespace.Name, &v1.DeleteOptions{})
-			if err != nil && !apierrors.IsNotFound(err) {
+		if namespace, ok := o.(*corev1.Namespace); ok {
+			projectNamespaces = append(projectNamespaces, namespace)
+		}
+	}
+
+	toDelete, toDetach, err := namespacesOnProjectRemoval(project, projectNamespaces)
+	if err != nil {
+		return project, err
+	}
+
+	for _, namespace := range toDelete {
+		err := p.m.workload.Core.Namespaces("").Delete(namespace.Name, &v1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return project, err
+		}
+	}
+
+	for _, namespace := range toDetach {
+		namespace = namespace.DeepCopy()
+		if namespace.Annotations != nil {
+			delete(namespace.Annotations, projectIDAnnotation)
+			_, err := p.m.workload.Core.Namespaces("").Update(namespace)
+			if err != nil {
 				return project, err
 			}
-		} else {
-			namespace = namespace.DeepCopy()
-			if namespace.Annotations != nil {
-				delete(namespace.Annotations, projectIDAnnotation)
-				_, err := p.m.workload.Core.Namespaces("").Update(namespace)
-				if err != nil {
-					return project, err
-				}
-			}
 		}
 	}
 
 	return nil, nil
 }
 
+// namespacesOnProjectRemoval returns, according to the namespace deletion policy of the project, the namespaces to
+// delete and the namespaces to detach from the project when it is removed. It returns an error if the project can't be
+// removed yet.
+func namespacesOnProjectRemoval(project *v3.Project, namespaces []*corev1.Namespace) ([]*corev1.Namespace, []*corev1.Namespace, error) {
+	switch project.Spec.NamespaceDeletionPolicy {
+	case v32.ProjectNamespaceDeletionPolicyCascade:
+		return namespaces, nil, nil
+	case v32.ProjectNamespaceDeletionPolicyOrphan:
+		return nil, namespaces, nil
+	case v32.ProjectNamespaceDeletionPolicyBlock:
+		if len(namespaces) == 0 {
+			return nil, nil, nil
+		}
+		names := make([]string, 0, len(namespaces))
+		for _, namespace := range namespaces {
+			names = append(names, namespace.Name)
+		}
+		sort.Strings(names)
+		return nil, nil, fmt.Errorf("project %s can't be removed while it has namespaces [%s], as its namespace deletion policy is %s",
+			project.Name, strings.Join(names, ", "), project.Spec.NamespaceDeletionPolicy)
+	}
+
+	// By default, only the namespaces created through Rancher are deleted.
+	var toDelete, toDetach []*corev1.Namespace
+	for _, namespace := range namespaces {
+		if _, ok := namespace.Annotations["field.cattle.io/creatorId"]; ok {
+			toDelete = append(toDelete, namespace)
+		} else {
+			toDetach = append(toDetach, namespace)
+		}
+	}
+	return toDelete, toDetach, nil
+}
+
 func (p *pLifecycle) ensureNamespacesAssigned(project *v3.Project) error {
 	projectName := ""
 	if _, ok := project.Labels["authz.management.cattle.io/default-project"]; ok {
@@ -4,12 +4,14 @@
 	"fmt"
 	"testing"
 
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1"
 	"github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1/fakes"
 	wfakes "github.com/rancher/wrangler/v3/pkg/generic/fake"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -328,6 +330,75 @@ func(in *rbacv1.ClusterRole) (*rbacv1.ClusterRole, error) {
 	}
 }
 
+func TestNamespacesOnProjectRemoval(t *testing.T) {
+	created := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "created",
+			Annotations: map[string]string{"field.cattle.io/creatorId": "u-abc"},
+		},
+	}
+	imported := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "imported",
+		},
+	}
+	namespaces := []*corev1.Namespace{created, imported}
+
+	tests := []struct {
+		name         string
+		policy       v32.ProjectNamespaceDeletionPolicy
+		namespaces   []*corev1.Namespace
+		wantToDelete []*corev1.Namespace
+		wantToDetach []*corev1.Namespace
+		wantErr      string
+	}{
+		{
+			name:         "default deletes namespaces created through rancher",
+			namespaces:   namespaces,
+			wantToDelete: []*corev1.Namespace{created},
+			wantToDetach: []*corev1.Namespace{imported},
+		},
+		{
+			name:         "cascade deletes all namespaces",
+			policy:       v32.ProjectNamespaceDeletionPolicyCascade,
+			namespaces:   namespaces,
+			wantToDelete: namespaces,
+		},
+		{
+			name:         "orphan detaches all namespaces",
+			policy:       v32.ProjectNamespaceDeletionPolicyOrphan,
+			namespaces:   namespaces,
+			wantToDetach: namespaces,
+		},
+		{
+			name:       "block with namespaces",
+			policy:     v32.ProjectNamespaceDeletionPolicyBlock,
+			namespaces: namespaces,
+			wantErr:    "project p-123xyz can't be removed while it has namespaces [created, imported], as its namespace deletion policy is Block",
+		},
+		{
+			name:   "block without namespaces",
+			policy: v32.ProjectNamespaceDeletionPolicyBlock,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			project := &v3.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "p-123xyz"},
+				Spec:       v32.ProjectSpec{NamespaceDeletionPolicy: test.policy},
+			}
+			toDelete, toDetach, err := namespacesOnProjectRemoval(project, test.namespaces)
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantToDelete, toDelete)
+			assert.Equal(t, test.wantToDetach, toDetach)
+		})
+	}
+}
+
 type fakeRBAC struct {
 	clusterRoleFake        fakes.ClusterRoleInterfaceMock
 	clusterRoleBindingFake fakes.ClusterRoleBindingInterfaceMock
@@ -117,7 +117,7 @@ func (m *manager) ensureGlobalResourcesRolesForPRTB(projectName string, rts map[
 						if err != nil {
 							return nil, err
 						}
-						if err := m.ensureRoles(map[string]*v3.RoleTemplate{"create-ns": createNSRT}); err != nil && !apierrors.IsAlreadyExists(err) {
+						if err := m.ensureRoles(map[string]*v3.RoleTemplate{"create-ns": pkgrbac.RoleTemplateForCluster(createNSRT, m.clusterName)}); err != nil && !apierrors.IsAlreadyExists(err) {
 							return nil, err
 						}
 					}
@@ -67,6 +67,17 @@ func registerDeferred(ctx context.Context, cluster *config.UserContext) {
 	cluster.Core.Namespaces("").AddHandler(ctx, "resourceQuotaUsedLimitController", calculate.calculateResourceQuotaUsed)
 	cluster.Management.Management.Projects(cluster.ClusterName).AddHandler(ctx, "resourceQuotaProjectUsedLimitController", calculate.calculateResourceQuotaUsedProject)
 
+	usage := &calculateUsageController{
+		nsIndexer:     nsInformer.GetIndexer(),
+		nsLister:      cluster.Core.Namespaces("").Controller().Lister(),
+		rqLister:      cluster.Core.ResourceQuotas("").Controller().Lister(),
+		projectLister: cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
+		projects:      cluster.Management.Management.Projects(cluster.ClusterName),
+		clusterName:   cluster.ClusterName,
+	}
+	cluster.Core.ResourceQuotas("").AddHandler(ctx, "resourceQuotaProjectUsageController", usage.calculateUsageResourceQuota)
+	cluster.Management.Management.Projects(cluster.ClusterName).AddHandler(ctx, "resourceQuotaProjectUsageProjectController", usage.calculateUsageProject)
+
 	reset := &quotaResetController{
 		nsIndexer:  nsInformer.GetIndexer(),
 		namespaces: cluster.Core.Namespaces(""),
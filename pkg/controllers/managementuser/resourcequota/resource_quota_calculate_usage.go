@@ -0,0 +1,111 @@
+package resourcequota
+
+import (
+	"fmt"
+	"reflect"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	quota "k8s.io/apiserver/pkg/quota/v1"
+	clientcache "k8s.io/client-go/tools/cache"
+)
+
+/*
+calculateUsageController is responsible for calculating the resources actually used by the project's Namespaces,
+as reported by the status of their resource quotas, and setting this information in the project's status
+*/
+type calculateUsageController struct {
+	projectLister v3.ProjectLister
+	projects      v3.ProjectInterface
+	nsIndexer     clientcache.Indexer
+	nsLister      v1.NamespaceLister
+	rqLister      v1.ResourceQuotaLister
+	clusterName   string
+}
+
+func (c *calculateUsageController) calculateUsageResourceQuota(key string, rq *corev1.ResourceQuota) (runtime.Object, error) {
+	if rq == nil || rq.Labels[resourceQuotaLabel] != "true" {
+		return nil, nil
+	}
+	ns, err := c.nsLister.Get("", rq.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	projectID := getProjectID(ns)
+	if projectID == "" {
+		return nil, nil
+	}
+	return nil, c.calculateProjectUsage(projectID)
+}
+
+func (c *calculateUsageController) calculateUsageProject(key string, p *v3.Project) (runtime.Object, error) {
+	if p == nil || p.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	return nil, c.calculateProjectUsage(fmt.Sprintf("%s:%s", c.clusterName, p.Name))
+}
+
+func (c *calculateUsageController) calculateProjectUsage(projectID string) error {
+	projectNamespace, projectName := ref.Parse(projectID)
+	project, err := c.projectLister.Get(projectNamespace, projectName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// A non-existent project is likely managed by another Rancher (e.g. Hosted Rancher)
+			return nil
+		}
+		return err
+	}
+
+	var usage *v32.ResourceQuotaLimit
+	if project.Spec.ResourceQuota != nil {
+		usage, err = c.sumNamespacesUsage(projectID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if reflect.DeepEqual(project.Status.UsedResources, usage) {
+		return nil
+	}
+
+	toUpdate := project.DeepCopy()
+	toUpdate.Status.UsedResources = usage
+	_, err = c.projects.Update(toUpdate)
+	return err
+}
+
+// sumNamespacesUsage returns the sum of the resources used in the namespaces of the project, as reported by the
+// status of the resource quotas Rancher creates in them.
+func (c *calculateUsageController) sumNamespacesUsage(projectID string) (*v32.ResourceQuotaLimit, error) {
+	namespaces, err := c.nsIndexer.ByIndex(nsByProjectIndex, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	used := corev1.ResourceList{}
+	selector := labels.SelectorFromSet(labels.Set{resourceQuotaLabel: "true"})
+	for _, n := range namespaces {
+		ns := n.(*corev1.Namespace)
+		if ns.DeletionTimestamp != nil {
+			continue
+		}
+		quotas, err := c.rqLister.List(ns.Name, selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, q := range quotas {
+			used = quota.Add(used, q.Status.Used)
+		}
+	}
+	return convertResourceListToProjectResourceLimit(used)
+}
@@ -0,0 +1,44 @@
+package resourcequota
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	quota "k8s.io/apiserver/pkg/quota/v1"
+)
+
+func TestConvertResourceListToProjectResourceLimit(t *testing.T) {
+	used := quota.Add(
+		corev1.ResourceList{
+			corev1.ResourcePods:           resource.MustParse("3"),
+			corev1.ResourceRequestsCPU:    resource.MustParse("500m"),
+			corev1.ResourceRequestsMemory: resource.MustParse("128Mi"),
+			corev1.ResourceConfigMaps:     resource.MustParse("2"),
+		},
+		corev1.ResourceList{
+			corev1.ResourcePods:              resource.MustParse("1"),
+			corev1.ResourceRequestsCPU:       resource.MustParse("1"),
+			corev1.ResourceServicesNodePorts: resource.MustParse("1"),
+			// Not part of a Rancher-defined resource quota.
+			corev1.ResourceName("count/deployments.apps"): resource.MustParse("4"),
+		},
+	)
+
+	limit, err := convertResourceListToProjectResourceLimit(used)
+	require.NoError(t, err)
+	assert.Equal(t, &v32.ResourceQuotaLimit{
+		Pods:              "4",
+		ConfigMaps:        "2",
+		ServicesNodePorts: "1",
+		RequestsCPU:       "1500m",
+		RequestsMemory:    "128Mi",
+	}, limit)
+
+	limit, err = convertResourceListToProjectResourceLimit(corev1.ResourceList{})
+	require.NoError(t, err)
+	assert.Equal(t, &v32.ResourceQuotaLimit{}, limit)
+}
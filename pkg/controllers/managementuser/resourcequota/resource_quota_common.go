@@ -71,6 +71,30 @@ func convertProjectResourceLimitToResourceList(limit *v32.ResourceQuotaLimit) (c
 	return limits, nil
 }
 
+// convertResourceListToProjectResourceLimit converts a list of resources in the native Kubernetes notation to a
+// Rancher-defined resource quota limit. Resources that can't be set in a Rancher-defined resource quota are ignored.
+func convertResourceListToProjectResourceLimit(rList corev1.ResourceList) (*v32.ResourceQuotaLimit, error) {
+	limitsMap := map[string]string{}
+	for resourceName, quantity := range rList {
+		key := string(resourceName)
+		for rancherName, nativeName := range resourceQuotaConversion {
+			if nativeName == key {
+				key = rancherName
+				break
+			}
+		}
+		limitsMap[key] = quantity.String()
+	}
+
+	in, err := json.Marshal(limitsMap)
+	if err != nil {
+		return nil, err
+	}
+	limit := &v32.ResourceQuotaLimit{}
+	err = json.Unmarshal(in, limit)
+	return limit, err
+}
+
 func convertContainerResourceLimitToResourceList(limit *v32.ContainerResourceLimit) (corev1.ResourceList, corev1.ResourceList, error) {
 	in, err := json.Marshal(limit)
 	if err != nil {
@@ -8,6 +8,7 @@
 	provv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
 	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
 	"github.com/rancher/rancher/pkg/capr"
+	"github.com/rancher/rancher/pkg/controllers/dashboard/chart"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterconnected"
 	cluster2 "github.com/rancher/rancher/pkg/controllers/provisioningv2/cluster"
 	catalogv1 "github.com/rancher/rancher/pkg/generated/controllers/catalog.cattle.io/v1"
@@ -53,8 +54,7 @@ func (h *handler) syncSystemUpgradeControllerCondition(obj *rkev1.RKEControlPlan
 		return status, nil
 	}
 
-	targetVersion := settings.SystemUpgradeControllerChartVersion.Get()
-	if targetVersion == "" {
+	if settings.SystemUpgradeControllerChartVersion.Get() == "" {
 		logrus.Warn("[rkecontrolplanecondition] the SystemUpgradeControllerChartVersion setting is not set")
 		capr.SystemUpgradeControllerReady.Reason(&status, fmt.Sprintf("the SystemUpgradeControllerChartVersion setting is not set"))
 		capr.SystemUpgradeControllerReady.Message(&status, "")
@@ -62,6 +62,12 @@ func (h *handler) syncSystemUpgradeControllerCondition(obj *rkev1.RKEControlPlan
 		return status, nil
 	}
 
+	cluster, err := h.getCluster()
+	if err != nil {
+		return status, err
+	}
+	targetVersion := chart.DesiredVersion(cluster.Spec.SystemChartVersions, chart.SystemUpgradeControllerChartName, settings.SystemUpgradeControllerChartVersion)
+
 	if capr.SystemUpgradeControllerReady.IsTrue(&status) {
 		actual := capr.SystemUpgradeControllerReady.GetMessage(&status)
 		if actual == targetVersion {
@@ -78,10 +84,6 @@ func (h *handler) syncSystemUpgradeControllerCondition(obj *rkev1.RKEControlPlan
 		}
 	}
 
-	cluster, err := h.getCluster()
-	if err != nil {
-		return status, err
-	}
 	// Skip if Rancher does not have a connection to the cluster
 	if !clusterconnected.Connected.IsTrue(cluster) {
 		return status, nil
@@ -40,6 +40,13 @@
 			},
 		},
 	}
+	pinnedCluster = &prov.Cluster{
+		ObjectMeta: basicCluster.ObjectMeta,
+		Spec: prov.ClusterSpec{
+			SystemChartVersions: map[string]string{"system-upgrade-controller": "160.0.0"},
+		},
+		Status: basicCluster.Status,
+	}
 )
 
 type setupConfig struct {
@@ -378,6 +385,102 @@ func Test_handler_syncSystemUpgradeControllerStatus(t *testing.T) {
 			planClientIsInvoked:   false,
 			clusterCacheIsInvoked: true,
 		},
+		{
+			name: "app is deployed at the pinned version",
+			setup: setupConfig{
+				mgmtClusterName: mgmtClusterName,
+				app: &catalog.App{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      appName(provClusterName),
+						Namespace: namespace.System,
+					},
+					Spec: catalog.ReleaseSpec{
+						Chart: &catalog.Chart{
+							Metadata: &catalog.Metadata{
+								Version: "160.0.0",
+							},
+						},
+					},
+					Status: catalog.ReleaseStatus{
+						Summary: catalog.Summary{
+							State:         string(catalog.StatusDeployed),
+							Error:         false,
+							Transitioning: false,
+						},
+					},
+				},
+				cluster:      pinnedCluster,
+				chartVersion: "160.1.0",
+			},
+			input: &v1.RKEControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      controlPlaneName,
+					Namespace: namespace.System,
+				},
+				Spec: v1.RKEControlPlaneSpec{
+					ClusterName:           provClusterName,
+					ManagementClusterName: mgmtClusterName,
+				},
+				Status: v1.RKEControlPlaneStatus{},
+			},
+			wantError:             false,
+			wantedConditionStatus: "True",
+			appClientIsInvoked:    true,
+			planClientIsInvoked:   false,
+			clusterCacheIsInvoked: true,
+		},
+		{
+			name: "app is ready at the global version but the cluster is pinned",
+			setup: setupConfig{
+				mgmtClusterName: mgmtClusterName,
+				app: &catalog.App{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      appName(provClusterName),
+						Namespace: namespace.System,
+					},
+					Spec: catalog.ReleaseSpec{
+						Chart: &catalog.Chart{
+							Metadata: &catalog.Metadata{
+								Version: "160.1.0",
+							},
+						},
+					},
+					Status: catalog.ReleaseStatus{
+						Summary: catalog.Summary{
+							State:         string(catalog.StatusDeployed),
+							Error:         false,
+							Transitioning: false,
+						},
+					},
+				},
+				cluster:      pinnedCluster,
+				chartVersion: "160.1.0",
+			},
+			input: &v1.RKEControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      controlPlaneName,
+					Namespace: namespace.System,
+				},
+				Spec: v1.RKEControlPlaneSpec{
+					ClusterName:           provClusterName,
+					ManagementClusterName: mgmtClusterName,
+				},
+				Status: v1.RKEControlPlaneStatus{
+					Conditions: []genericcondition.GenericCondition{
+						{
+							Type:    string(capr.SystemUpgradeControllerReady),
+							Status:  "True",
+							Message: "160.1.0",
+						},
+					},
+				},
+			},
+			wantError:             false,
+			wantedConditionStatus: "False",
+			appClientIsInvoked:    true,
+			planClientIsInvoked:   false,
+			clusterCacheIsInvoked: true,
+		},
 		{
 			name: "app is in failed state",
 			setup: setupConfig{
@@ -0,0 +1,58 @@
+// Package certificaterotation surfaces the certificate rotation progress and the expiration dates of the certificates
+// of RKE2/K3s clusters, as observed by the planner on their control planes, in the status of the provisioning clusters.
+package certificaterotation
+
+import (
+	"context"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/capr"
+	provcontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type handler struct {
+	clusters     provcontrollers.ClusterClient
+	clusterCache provcontrollers.ClusterCache
+}
+
+func Register(ctx context.Context, clients *wrangler.Context) {
+	h := &handler{
+		clusters:     clients.Provisioning.Cluster(),
+		clusterCache: clients.Provisioning.Cluster().Cache(),
+	}
+
+	clients.RKE.RKEControlPlane().OnChange(ctx, "certificate-rotation-status", h.OnChange)
+}
+
+// OnChange copies the certificate rotation status of the control plane to the provisioning cluster it belongs to, which
+// has the same namespace and name.
+func (h *handler) OnChange(_ string, cp *rkev1.RKEControlPlane) (*rkev1.RKEControlPlane, error) {
+	if cp == nil || cp.DeletionTimestamp != nil {
+		return cp, nil
+	}
+
+	cluster, err := h.clusterCache.Get(cp.Namespace, cp.Name)
+	if apierrors.IsNotFound(err) {
+		return cp, nil
+	} else if err != nil {
+		return cp, err
+	}
+
+	updated := cluster.DeepCopy()
+	updated.Status.CertificateExpiration = cp.Status.CertificateExpiration
+	if conditionStatus := capr.CertificatesRotated.GetStatus(cp); conditionStatus != "" {
+		capr.CertificatesRotated.SetStatus(updated, conditionStatus)
+		capr.CertificatesRotated.Reason(updated, capr.CertificatesRotated.GetReason(cp))
+		capr.CertificatesRotated.Message(updated, capr.CertificatesRotated.GetMessage(cp))
+	}
+
+	if equality.Semantic.DeepEqual(cluster.Status, updated.Status) {
+		return cp, nil
+	}
+
+	_, err = h.clusters.UpdateStatus(updated)
+	return cp, err
+}
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"maps"
 	"regexp"
 	"strconv"
 	"strings"
@@ -54,6 +55,7 @@
 	externallyManagedAnn      = "provisioning.cattle.io/externally-managed"
 
 	manageSchedulingDefaultsAnn = "provisioning.cattle.io/enable-scheduling-customization"
+	agentImageOverrideAnn       = "provisioning.cattle.io/agent-image-override"
 )
 
 var (
@@ -395,6 +397,9 @@ func (h *handler) updateImportedCluster(cluster *v1.Cluster, status v1.ClusterSt
 		// backpopulateMgmtClusterFleetWorkspaceName() is not required, newCluster already has FleetWorkspaceName from mgmt cluster
 	}
 
+	setAgentImageOverride(cluster, newCluster)
+	newCluster.Spec.SystemChartVersions = maps.Clone(cluster.Spec.SystemChartVersions)
+
 	delete(cluster.Annotations, creatorIDAnn)
 	status.FleetWorkspaceName = newCluster.Spec.FleetWorkspaceName
 
@@ -428,6 +433,7 @@ func (h *handler) createNewCluster(cluster *v1.Cluster, status v1.ClusterStatus,
 	spec.EnableNetworkPolicy = cluster.Spec.EnableNetworkPolicy
 	spec.DesiredAgentImage = image.ResolveWithCluster(settings.AgentImage.Get(), cluster)
 	spec.DesiredAuthImage = image.ResolveWithCluster(settings.AuthImage.Get(), cluster)
+	spec.SystemChartVersions = maps.Clone(cluster.Spec.SystemChartVersions)
 
 	spec.ClusterSecrets.PrivateRegistrySecret = image.GetPrivateRepoSecretFromCluster(cluster)
 	spec.ClusterSecrets.PrivateRegistryURL = image.GetPrivateRepoURLFromCluster(cluster)
@@ -517,6 +523,7 @@ func (h *handler) createNewCluster(cluster *v1.Cluster, status v1.ClusterStatus,
 	for k, v := range cluster.Annotations {
 		newCluster.Annotations[k] = v
 	}
+	setAgentImageOverride(cluster, newCluster)
 
 	delete(cluster.Annotations, creatorIDAnn)
 
@@ -547,6 +554,30 @@ func (h *handler) createNewCluster(cluster *v1.Cluster, status v1.ClusterStatus,
 	}, cluster, status, newCluster)
 }
 
+// setAgentImageOverride overrides the agent image of the management cluster with the agent image resolved with the
+// agent image registry of the cluster. The applied override is recorded in an annotation, so that it's reverted once
+// the agent image registry is unset, without reverting overrides set directly on the management cluster.
+func setAgentImageOverride(cluster *v1.Cluster, mgmtCluster *v3.Cluster) {
+	annotations := maps.Clone(mgmtCluster.Annotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	defer func() { mgmtCluster.Annotations = annotations }()
+
+	if cluster.Spec.AgentImageRegistry != "" {
+		override := image.ResolveAgentImageWithCluster(settings.AgentImage.Get(), cluster)
+		mgmtCluster.Spec.AgentImageOverride = override
+		annotations[agentImageOverrideAnn] = override
+		return
+	}
+	if applied, ok := annotations[agentImageOverrideAnn]; ok {
+		if mgmtCluster.Spec.AgentImageOverride == applied {
+			mgmtCluster.Spec.AgentImageOverride = ""
+		}
+		delete(annotations, agentImageOverrideAnn)
+	}
+}
+
 // backpopulateMgmtClusterFleetWorkspaceName backpopulates the fleet workspace name field from the v3 management cluster object onto the new desired object
 func (h *handler) backpopulateMgmtClusterFleetWorkspaceName(rCluster *v3.Cluster) error {
 	if rCluster == nil {
@@ -6,8 +6,10 @@
 
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/wrangler/v3/pkg/generic/fake"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -392,3 +394,74 @@ func getTestFleetAgentResourceReq() *corev1.ResourceRequirements {
 		},
 	}
 }
+
+func TestSetAgentImageOverride(t *testing.T) {
+	require.NoError(t, settings.AgentImage.Set("rancher/rancher-agent:v2.12.1"))
+	t.Cleanup(func() { _ = settings.AgentImage.Set(settings.AgentImage.Default) })
+
+	tests := []struct {
+		name            string
+		registry        string
+		mgmtCluster     *v3.Cluster
+		wantOverride    string
+		wantAnnotations map[string]string
+	}{
+		{
+			name:         "registry is set",
+			registry:     "registry.example.com",
+			mgmtCluster:  &v3.Cluster{},
+			wantOverride: "registry.example.com/rancher/rancher-agent:v2.12.1",
+			wantAnnotations: map[string]string{
+				agentImageOverrideAnn: "registry.example.com/rancher/rancher-agent:v2.12.1",
+			},
+		},
+		{
+			name:     "registry is unset",
+			registry: "",
+			mgmtCluster: &v3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{agentImageOverrideAnn: "registry.example.com/rancher/rancher-agent:v2.12.1"},
+				},
+				Spec: v3.ClusterSpec{AgentImageOverride: "registry.example.com/rancher/rancher-agent:v2.12.1"},
+			},
+			wantOverride:    "",
+			wantAnnotations: map[string]string{},
+		},
+		{
+			name:     "override set on the management cluster is kept",
+			registry: "",
+			mgmtCluster: &v3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{agentImageOverrideAnn: "registry.example.com/rancher/rancher-agent:v2.12.1"},
+				},
+				Spec: v3.ClusterSpec{AgentImageOverride: "custom/rancher-agent:v2.12.1"},
+			},
+			wantOverride:    "custom/rancher-agent:v2.12.1",
+			wantAnnotations: map[string]string{},
+		},
+		{
+			name:     "no registry",
+			registry: "",
+			mgmtCluster: &v3.Cluster{
+				Spec: v3.ClusterSpec{AgentImageOverride: "custom/rancher-agent:v2.12.1"},
+			},
+			wantOverride:    "custom/rancher-agent:v2.12.1",
+			wantAnnotations: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := tt.mgmtCluster.DeepCopy()
+			cluster := &v1.Cluster{Spec: v1.ClusterSpec{AgentImageRegistry: tt.registry}}
+			mgmtCluster := &v3.Cluster{ObjectMeta: tt.mgmtCluster.ObjectMeta, Spec: tt.mgmtCluster.Spec}
+
+			setAgentImageOverride(cluster, mgmtCluster)
+
+			assert.Equal(t, tt.wantOverride, mgmtCluster.Spec.AgentImageOverride)
+			assert.Equal(t, tt.wantAnnotations, mgmtCluster.Annotations)
+			// the annotations of the cached management cluster must not be modified
+			assert.Equal(t, original.Annotations, tt.mgmtCluster.Annotations)
+		})
+	}
+}
@@ -3,7 +3,9 @@
 import (
 	"context"
 
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/certificaterotation"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/cluster"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/etcdsnapshotschedule"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/fleetcluster"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/fleetworkspace"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/harvestercleanup"
@@ -25,6 +27,8 @@ func Register(ctx context.Context, clients *wrangler.Context, kubeconfigManager
 	provisioningcluster.Register(ctx, clients)
 	provisioninglog.Register(ctx, clients)
 	machineconfigcleanup.Register(ctx, clients)
+	etcdsnapshotschedule.Register(ctx, clients)
+	certificaterotation.Register(ctx, clients)
 
 	if features.Fleet.Enabled() {
 		managedchart.Register(ctx, clients)
@@ -0,0 +1,117 @@
+// Package etcdsnapshotschedule takes the etcd snapshots of the schedule defined on provisioning clusters, by
+// requesting an etcd snapshot creation operation each time the schedule fires.
+package etcdsnapshotschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	provv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	provcontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/robfig/cron"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScheduledSnapshotName is the base name of the snapshots taken by the schedule, which allows the distribution to
+// prune them separately from its own snapshots.
+const ScheduledSnapshotName = "scheduled"
+
+type handler struct {
+	clusters provcontrollers.ClusterController
+	now      func() time.Time
+}
+
+func Register(ctx context.Context, clients *wrangler.Context) {
+	h := &handler{
+		clusters: clients.Provisioning.Cluster(),
+		now:      time.Now,
+	}
+
+	clients.Provisioning.Cluster().OnChange(ctx, "etcd-snapshot-schedule", h.OnChange)
+}
+
+func (h *handler) OnChange(_ string, cluster *provv1.Cluster) (*provv1.Cluster, error) {
+	if cluster == nil || cluster.DeletionTimestamp != nil || cluster.Spec.RKEConfig == nil {
+		return cluster, nil
+	}
+
+	schedule := cluster.Spec.RKEConfig.ETCDSnapshotSchedule
+	if schedule == nil {
+		return h.updateStatus(cluster, nil)
+	}
+
+	status := cluster.Status.ETCDSnapshotSchedule.DeepCopy()
+	if status == nil {
+		status = &rkev1.ETCDSnapshotScheduleStatus{}
+	}
+
+	sched, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		status.Error = fmt.Sprintf("invalid cron schedule %q: %v", schedule.Cron, err)
+		status.NextScheduleTime = nil
+		return h.updateStatus(cluster, status)
+	}
+	status.Error = ""
+
+	last := cluster.CreationTimestamp.Time
+	if status.LastScheduleTime != nil {
+		last = status.LastScheduleTime.Time
+	}
+
+	now := h.now()
+	next := sched.Next(last)
+	if !now.Before(next) {
+		// Schedules missed while Rancher was down are not caught up on, only a single snapshot is taken. The request is
+		// persisted in the status before the snapshot is requested, so that the schedule doesn't fire again if
+		// requesting it fails, and the snapshot is requested on the next sync instead.
+		logrus.Infof("[etcdsnapshotschedule] rkecluster %s/%s: requesting scheduled etcd snapshot", cluster.Namespace, cluster.Name)
+		status.LastScheduleTime = &metav1.Time{Time: now}
+		status.ScheduledGeneration = snapshotCreateGeneration(cluster) + 1
+		next = sched.Next(now)
+	}
+
+	status.NextScheduleTime = &metav1.Time{Time: next}
+	if cluster, err = h.updateStatus(cluster, status); err != nil {
+		return cluster, err
+	}
+	h.clusters.EnqueueAfter(cluster.Namespace, cluster.Name, next.Sub(now))
+	return h.requestSnapshot(cluster, schedule)
+}
+
+// requestSnapshot bumps the generation of the etcd snapshot creation to the generation requested by the schedule, if
+// it's still below it.
+func (h *handler) requestSnapshot(cluster *provv1.Cluster, schedule *rkev1.ETCDSnapshotSchedule) (*provv1.Cluster, error) {
+	status := cluster.Status.ETCDSnapshotSchedule
+	if status == nil || snapshotCreateGeneration(cluster) >= status.ScheduledGeneration {
+		return cluster, nil
+	}
+	cluster = cluster.DeepCopy()
+	cluster.Spec.RKEConfig.ETCDSnapshotCreate = &rkev1.ETCDSnapshotCreate{
+		Generation: status.ScheduledGeneration,
+		Name:       ScheduledSnapshotName,
+		Retention:  schedule.Retention,
+		S3:         schedule.S3.DeepCopy(),
+	}
+	return h.clusters.Update(cluster)
+}
+
+func snapshotCreateGeneration(cluster *provv1.Cluster) int {
+	if cluster.Spec.RKEConfig.ETCDSnapshotCreate == nil {
+		return 0
+	}
+	return cluster.Spec.RKEConfig.ETCDSnapshotCreate.Generation
+}
+
+func (h *handler) updateStatus(cluster *provv1.Cluster, status *rkev1.ETCDSnapshotScheduleStatus) (*provv1.Cluster, error) {
+	if equality.Semantic.DeepEqual(cluster.Status.ETCDSnapshotSchedule, status) {
+		return cluster, nil
+	}
+	cluster = cluster.DeepCopy()
+	cluster.Status.ETCDSnapshotSchedule = status
+	return h.clusters.UpdateStatus(cluster)
+}
@@ -0,0 +1,145 @@
+package etcdsnapshotschedule
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	provv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOnChange(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newCluster := func(schedule *rkev1.ETCDSnapshotSchedule, status *rkev1.ETCDSnapshotScheduleStatus) *provv1.Cluster {
+		return &provv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "cluster",
+				Namespace:         "fleet-default",
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: provv1.ClusterSpec{
+				RKEConfig: &provv1.RKEConfig{
+					ETCDSnapshotCreate:   &rkev1.ETCDSnapshotCreate{Generation: 3},
+					ETCDSnapshotSchedule: schedule,
+				},
+			},
+			Status: provv1.ClusterStatus{ETCDSnapshotSchedule: status},
+		}
+	}
+
+	hourly := &rkev1.ETCDSnapshotSchedule{Cron: "0 * * * *", Retention: 5, S3: &rkev1.ETCDSnapshotS3{Bucket: "snapshots"}}
+
+	t.Run("snapshot requested when schedule fires", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		clusters := fake.NewMockControllerInterface[*provv1.Cluster, *provv1.ClusterList](ctrl)
+		now := created.Add(90 * time.Minute)
+		h := &handler{clusters: clusters, now: func() time.Time { return now }}
+
+		last := metav1.NewTime(created.Add(30 * time.Minute))
+		// The request is persisted in the status before the snapshot is requested.
+		updateStatus := clusters.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(cluster *provv1.Cluster) (*provv1.Cluster, error) {
+			assert.Equal(t, now, cluster.Status.ETCDSnapshotSchedule.LastScheduleTime.Time)
+			assert.Equal(t, created.Add(2*time.Hour), cluster.Status.ETCDSnapshotSchedule.NextScheduleTime.Time)
+			assert.Equal(t, 4, cluster.Status.ETCDSnapshotSchedule.ScheduledGeneration)
+			assert.Equal(t, 3, cluster.Spec.RKEConfig.ETCDSnapshotCreate.Generation)
+			return cluster, nil
+		})
+		clusters.EXPECT().EnqueueAfter("fleet-default", "cluster", 30*time.Minute)
+		clusters.EXPECT().Update(gomock.Any()).DoAndReturn(func(cluster *provv1.Cluster) (*provv1.Cluster, error) {
+			assert.Equal(t, &rkev1.ETCDSnapshotCreate{
+				Generation: 4,
+				Name:       ScheduledSnapshotName,
+				Retention:  5,
+				S3:         &rkev1.ETCDSnapshotS3{Bucket: "snapshots"},
+			}, cluster.Spec.RKEConfig.ETCDSnapshotCreate)
+			return cluster, nil
+		}).After(updateStatus)
+
+		_, err := h.OnChange("", newCluster(hourly, &rkev1.ETCDSnapshotScheduleStatus{LastScheduleTime: &last}))
+		require.NoError(t, err)
+	})
+
+	t.Run("snapshot not requested when persisting the schedule fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		clusters := fake.NewMockControllerInterface[*provv1.Cluster, *provv1.ClusterList](ctrl)
+		now := created.Add(90 * time.Minute)
+		h := &handler{clusters: clusters, now: func() time.Time { return now }}
+
+		last := metav1.NewTime(created.Add(30 * time.Minute))
+		clusters.EXPECT().UpdateStatus(gomock.Any()).Return(nil, fmt.Errorf("conflict"))
+
+		_, err := h.OnChange("", newCluster(hourly, &rkev1.ETCDSnapshotScheduleStatus{LastScheduleTime: &last}))
+		require.Error(t, err)
+	})
+
+	t.Run("scheduled snapshot requested again when requesting it failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		clusters := fake.NewMockControllerInterface[*provv1.Cluster, *provv1.ClusterList](ctrl)
+		now := created.Add(90 * time.Minute)
+		h := &handler{clusters: clusters, now: func() time.Time { return now }}
+
+		last := metav1.NewTime(created.Add(80 * time.Minute))
+		next := metav1.NewTime(created.Add(2 * time.Hour))
+		clusters.EXPECT().EnqueueAfter("fleet-default", "cluster", 30*time.Minute)
+		clusters.EXPECT().Update(gomock.Any()).DoAndReturn(func(cluster *provv1.Cluster) (*provv1.Cluster, error) {
+			assert.Equal(t, 4, cluster.Spec.RKEConfig.ETCDSnapshotCreate.Generation)
+			assert.Equal(t, ScheduledSnapshotName, cluster.Spec.RKEConfig.ETCDSnapshotCreate.Name)
+			return cluster, nil
+		})
+
+		_, err := h.OnChange("", newCluster(hourly, &rkev1.ETCDSnapshotScheduleStatus{
+			LastScheduleTime:    &last,
+			NextScheduleTime:    &next,
+			ScheduledGeneration: 4,
+		}))
+		require.NoError(t, err)
+	})
+
+	t.Run("enqueued until schedule fires", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		clusters := fake.NewMockControllerInterface[*provv1.Cluster, *provv1.ClusterList](ctrl)
+		now := created.Add(20 * time.Minute)
+		h := &handler{clusters: clusters, now: func() time.Time { return now }}
+
+		next := metav1.NewTime(created.Add(time.Hour))
+		clusters.EXPECT().EnqueueAfter("fleet-default", "cluster", 40*time.Minute)
+
+		_, err := h.OnChange("", newCluster(hourly, &rkev1.ETCDSnapshotScheduleStatus{NextScheduleTime: &next}))
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid cron reported in status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		clusters := fake.NewMockControllerInterface[*provv1.Cluster, *provv1.ClusterList](ctrl)
+		h := &handler{clusters: clusters, now: time.Now}
+
+		clusters.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(cluster *provv1.Cluster) (*provv1.Cluster, error) {
+			assert.Contains(t, cluster.Status.ETCDSnapshotSchedule.Error, "invalid cron schedule")
+			return cluster, nil
+		})
+
+		_, err := h.OnChange("", newCluster(&rkev1.ETCDSnapshotSchedule{Cron: "every hour"}, nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("status cleared when schedule removed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		clusters := fake.NewMockControllerInterface[*provv1.Cluster, *provv1.ClusterList](ctrl)
+		h := &handler{clusters: clusters, now: time.Now}
+
+		clusters.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(cluster *provv1.Cluster) (*provv1.Cluster, error) {
+			assert.Nil(t, cluster.Status.ETCDSnapshotSchedule)
+			return cluster, nil
+		})
+
+		_, err := h.OnChange("", newCluster(nil, &rkev1.ETCDSnapshotScheduleStatus{}))
+		require.NoError(t, err)
+	})
+}
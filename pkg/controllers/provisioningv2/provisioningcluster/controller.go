@@ -16,6 +16,7 @@
 	mgmtcontroller "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	rocontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
 	rkecontroller "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/provisioningv2/clustertemplate"
 	"github.com/rancher/rancher/pkg/wrangler"
 	"github.com/rancher/wrangler/v3/pkg/condition"
 	corecontrollers "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
@@ -32,6 +33,8 @@
 
 const (
 	byNodeInfra                       = "by-node-infra"
+	byClusterTemplate                 = "by-cluster-template"
+	byPrivateRegistry                 = "by-private-registry"
 	restoreRKEConfigKubernetesVersion = "kubernetesVersion"
 	restoreRKEConfigAll               = "all"
 	restoreRKEConfigNone              = "none"
@@ -50,6 +53,8 @@ type handler struct {
 	rkeControlPlane   rkecontroller.RKEControlPlaneCache
 	etcdSnapshotCache rkecontroller.ETCDSnapshotCache
 	capiMachineCache  capicontrollers.MachineCache
+	clusterTemplates  rocontrollers.ClusterTemplateCache
+	privateRegistries rocontrollers.PrivateRegistryCache
 }
 
 func Register(ctx context.Context, clients *wrangler.Context) {
@@ -65,6 +70,8 @@ func Register(ctx context.Context, clients *wrangler.Context) {
 		rkeControlPlane:   clients.RKE.RKEControlPlane().Cache(),
 		etcdSnapshotCache: clients.RKE.ETCDSnapshot().Cache(),
 		capiMachineCache:  clients.CAPI.Machine().Cache(),
+		clusterTemplates:  clients.Provisioning.ClusterTemplate().Cache(),
+		privateRegistries: clients.Provisioning.PrivateRegistry().Cache(),
 	}
 
 	if features.MCM.Enabled() {
@@ -73,6 +80,8 @@ func Register(ctx context.Context, clients *wrangler.Context) {
 
 	clients.Dynamic.OnChange(ctx, "rke-dynamic", matchRKENodeGroup, h.infraWatch)
 	clients.Provisioning.Cluster().Cache().AddIndexer(byNodeInfra, byNodeInfraIndex)
+	clients.Provisioning.Cluster().Cache().AddIndexer(byClusterTemplate, byClusterTemplateIndex)
+	clients.Provisioning.Cluster().Cache().AddIndexer(byPrivateRegistry, byPrivateRegistryIndex)
 
 	rocontrollers.RegisterClusterGeneratingHandler(ctx,
 		clients.Provisioning.Cluster(),
@@ -102,6 +111,30 @@ func Register(ctx context.Context, clients *wrangler.Context) {
 		return nil, nil
 	}, clients.Provisioning.Cluster(), clients.RKE.RKEControlPlane())
 
+	relatedresource.Watch(ctx, "cluster-template-trigger", func(namespace, name string, obj runtime.Object) ([]relatedresource.Key, error) {
+		clusters, err := clients.Provisioning.Cluster().Cache().GetByIndex(byClusterTemplate, namespace+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+		var result []relatedresource.Key
+		for _, cluster := range clusters {
+			result = append(result, relatedresource.NewKey(cluster.Namespace, cluster.Name))
+		}
+		return result, nil
+	}, clients.Provisioning.Cluster(), clients.Provisioning.ClusterTemplate())
+
+	relatedresource.Watch(ctx, "private-registry-trigger", func(namespace, name string, obj runtime.Object) ([]relatedresource.Key, error) {
+		clusters, err := clients.Provisioning.Cluster().Cache().GetByIndex(byPrivateRegistry, namespace+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+		var result []relatedresource.Key
+		for _, cluster := range clusters {
+			result = append(result, relatedresource.NewKey(cluster.Namespace, cluster.Name))
+		}
+		return result, nil
+	}, clients.Provisioning.Cluster(), clients.Provisioning.PrivateRegistry())
+
 	clients.Provisioning.Cluster().OnChange(ctx, "provisioning-cluster-change", h.OnChange)
 	clients.Provisioning.Cluster().OnRemove(ctx, "rke-cluster-remove", h.OnRemove)
 }
@@ -122,6 +155,13 @@ func byNodeInfraIndex(obj *rancherv1.Cluster) ([]string, error) {
 	return result, nil
 }
 
+func byClusterTemplateIndex(obj *rancherv1.Cluster) ([]string, error) {
+	if obj.Spec.ClusterTemplateName == "" {
+		return nil, nil
+	}
+	return []string{obj.Namespace + "/" + obj.Spec.ClusterTemplateName}, nil
+}
+
 func toInfraRefKey(ref corev1.ObjectReference, namespace string) string {
 	if ref.APIVersion == "" {
 		ref.APIVersion = capr.DefaultMachineConfigAPIVersion
@@ -287,6 +327,10 @@ func (h *handler) OnRancherClusterChange(obj *rancherv1.Cluster, status rancherv
 		return nil, status, fmt.Errorf("kubernetesVersion not set on %s/%s", obj.Namespace, obj.Name)
 	}
 
+	if err := h.validateClusterTemplate(obj); err != nil {
+		return nil, status, err
+	}
+
 	if len(obj.Finalizers) == 0 && obj.DeletionTimestamp.IsZero() {
 		// If the cluster doesn't have any finalizers, then we don't apply any objects to ensure the finalizer can be put on the cluster.
 		return nil, status, generic.ErrSkip
@@ -365,10 +409,32 @@ func (h *handler) OnRancherClusterChange(obj *rancherv1.Cluster, status rancherv
 		}
 	}
 
-	objs, err := objects(obj, h.dynamic, h.dynamicSchema, h.secretCache)
+	objs, err := objects(obj, h.dynamic, h.dynamicSchema, h.secretCache, h.privateRegistries, false)
 	return objs, status, err
 }
 
+// validateClusterTemplate returns an error if the cluster references a cluster template and sets fields locked by the
+// template to other values, so that the drifted spec is not applied.
+func (h *handler) validateClusterTemplate(cluster *rancherv1.Cluster) error {
+	if cluster.Spec.ClusterTemplateName == "" {
+		return nil
+	}
+
+	template, err := h.clusterTemplates.Get(cluster.Namespace, cluster.Spec.ClusterTemplateName)
+	if err != nil {
+		return fmt.Errorf("error retrieving cluster template %s/%s: %w", cluster.Namespace, cluster.Spec.ClusterTemplateName, err)
+	}
+
+	errs, err := clustertemplate.Validate(template, cluster.Spec)
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("cluster %s/%s does not comply with its cluster template: %w", cluster.Namespace, cluster.Name, errs.ToAggregate())
+	}
+	return nil
+}
+
 // getRKEControlPlaneForCluster retrieves the rkecontrolplane that corresponds to a provisioning cluster object.
 // If it cannot retrieve the corresponding CAPI cluster (is not found), if the capi cluster controlplane ref is not
 // an rkecontrolplane, or the rkecontrolplane object can't be found and the cluster is deleting, it returns nil, nil.
@@ -0,0 +1,233 @@
+package provisioningcluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/rancher/lasso/pkg/dynamic"
+	rancherv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/capr"
+	"github.com/rancher/rancher/pkg/features"
+	capicontrollers "github.com/rancher/rancher/pkg/generated/controllers/cluster.x-k8s.io/v1beta1"
+	mgmtcontroller "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	rocontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/rancher/wrangler/v3/pkg/data"
+	v1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	"github.com/rancher/wrangler/v3/pkg/gvk"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	ChangeCreate = "create"
+	ChangeUpdate = "update"
+	ChangeDelete = "delete"
+)
+
+// ObjectChange is a change to one of the objects generated for a cluster.
+type ObjectChange struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	Action     string `json:"action"`
+	// Patch is the JSON merge patch from the current to the desired spec of the object, set for updates only.
+	Patch string `json:"patch,omitempty"`
+}
+
+// PlanPreview is the impact a cluster spec would have if it were applied. The machine plans are rendered by the
+// planner from the spec of the rkecontrolplane, so a change to the plans shows up as an update of the rkecontrolplane.
+type PlanPreview struct {
+	Changes []ObjectChange `json:"changes,omitempty"`
+	// ReplacedMachinePools are the machine pools whose machines would be replaced by a rolling update.
+	ReplacedMachinePools []string `json:"replacedMachinePools,omitempty"`
+}
+
+// Previewer renders the objects generated for a cluster spec and compares them to the current ones, without applying
+// anything.
+type Previewer struct {
+	dynamic                *dynamic.Controller
+	dynamicSchema          mgmtcontroller.DynamicSchemaCache
+	secretCache            v1.SecretCache
+	privateRegistries      rocontrollers.PrivateRegistryCache
+	machineDeploymentCache capicontrollers.MachineDeploymentCache
+}
+
+func NewPreviewer(clients *wrangler.Context) *Previewer {
+	p := &Previewer{
+		dynamic:                clients.Dynamic,
+		secretCache:            clients.Core.Secret().Cache(),
+		privateRegistries:      clients.Provisioning.PrivateRegistry().Cache(),
+		machineDeploymentCache: clients.CAPI.MachineDeployment().Cache(),
+	}
+	if features.MCM.Enabled() {
+		p.dynamicSchema = clients.Mgmt.DynamicSchema().Cache()
+	}
+	return p
+}
+
+// Preview returns the changes applying the given spec to the cluster would cause.
+func (p *Previewer) Preview(cluster *rancherv1.Cluster, spec rancherv1.ClusterSpec) (*PlanPreview, error) {
+	if spec.RKEConfig == nil {
+		return nil, fmt.Errorf("cluster %s/%s is not provisioned by Rancher", cluster.Namespace, cluster.Name)
+	}
+
+	desiredCluster := cluster.DeepCopy()
+	desiredCluster.Spec = spec
+	desired, err := objects(desiredCluster, p.dynamic, p.dynamicSchema, p.secretCache, p.privateRegistries, true)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &PlanPreview{}
+	desiredMachineDeployments := map[string]bool{}
+	for _, obj := range desired {
+		change, err := p.diff(obj)
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			preview.Changes = append(preview.Changes, *change)
+		}
+
+		if md, ok := obj.(*capi.MachineDeployment); ok {
+			desiredMachineDeployments[md.Name] = true
+			replaced, err := p.replacesMachines(md)
+			if err != nil {
+				return nil, err
+			}
+			if replaced {
+				preview.ReplacedMachinePools = append(preview.ReplacedMachinePools, md.Spec.Template.Labels[capr.RKEMachinePoolNameLabel])
+			}
+		}
+	}
+
+	// The machine deployments of removed machine pools are deleted
+	machineDeployments, err := p.machineDeploymentCache.List(cluster.Namespace, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, md := range machineDeployments {
+		if md.Spec.ClusterName != cluster.Name || md.Spec.Template.Labels[capr.RKEMachinePoolNameLabel] == "" || desiredMachineDeployments[md.Name] {
+			continue
+		}
+		preview.Changes = append(preview.Changes, ObjectChange{
+			APIVersion: capi.GroupVersion.String(),
+			Kind:       "MachineDeployment",
+			Namespace:  md.Namespace,
+			Name:       md.Name,
+			Action:     ChangeDelete,
+		})
+	}
+
+	sort.Strings(preview.ReplacedMachinePools)
+	return preview, nil
+}
+
+// diff returns the change needed to get from the current to the desired object, or nil if there is none.
+func (p *Previewer) diff(desired runtime.Object) (*ObjectChange, error) {
+	objGVK, err := gvk.Get(desired)
+	if err != nil {
+		return nil, err
+	}
+	m, err := meta.Accessor(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &ObjectChange{
+		Namespace: m.GetNamespace(),
+		Name:      m.GetName(),
+	}
+	change.APIVersion, change.Kind = objGVK.ToAPIVersionAndKind()
+
+	current, err := p.dynamic.Get(objGVK, m.GetNamespace(), m.GetName())
+	if apierrors.IsNotFound(err) {
+		change.Action = ChangeCreate
+		return change, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	patch, err := specPatch(current, desired)
+	if err != nil || patch == "" {
+		return nil, err
+	}
+	change.Action = ChangeUpdate
+	change.Patch = patch
+	return change, nil
+}
+
+// replacesMachines returns whether applying the machine deployment would cause its machines to be replaced, which
+// happens when the machine template or the bootstrap template it references change.
+func (p *Previewer) replacesMachines(desired *capi.MachineDeployment) (bool, error) {
+	current, err := p.machineDeploymentCache.Get(desired.Namespace, desired.Name)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return !equality.Semantic.DeepEqual(current.Spec.Template.Spec.InfrastructureRef, desired.Spec.Template.Spec.InfrastructureRef) ||
+		!equality.Semantic.DeepEqual(current.Spec.Template.Spec.Bootstrap.ConfigRef, desired.Spec.Template.Spec.Bootstrap.ConfigRef), nil
+}
+
+// specPatch returns the JSON merge patch from the spec of the current object to the spec of the desired one, or an
+// empty string if they match. Fields the desired object does not set are left alone when it is applied, so they are
+// dropped from the patch.
+func specPatch(current, desired runtime.Object) (string, error) {
+	currentData, err := data.Convert(current)
+	if err != nil {
+		return "", err
+	}
+	desiredData, err := data.Convert(desired)
+	if err != nil {
+		return "", err
+	}
+
+	currentSpec, err := json.Marshal(currentData.Map("spec"))
+	if err != nil {
+		return "", err
+	}
+	desiredSpec, err := json.Marshal(desiredData.Map("spec"))
+	if err != nil {
+		return "", err
+	}
+
+	patch, err := jsonpatch.CreateMergePatch(currentSpec, desiredSpec)
+	if err != nil {
+		return "", err
+	}
+
+	var patchData map[string]interface{}
+	if err := json.Unmarshal(patch, &patchData); err != nil {
+		return "", err
+	}
+	removeNulls(patchData)
+	if len(patchData) == 0 {
+		return "", nil
+	}
+
+	patch, err = json.Marshal(patchData)
+	return string(patch), err
+}
+
+func removeNulls(m map[string]interface{}) {
+	for k, v := range m {
+		switch v := v.(type) {
+		case nil:
+			delete(m, k)
+		case map[string]interface{}:
+			removeNulls(v)
+			if len(v) == 0 {
+				delete(m, k)
+			}
+		}
+	}
+}
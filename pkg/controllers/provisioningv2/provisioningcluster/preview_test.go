@@ -0,0 +1,38 @@
+package provisioningcluster
+
+import (
+	"testing"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecPatch(t *testing.T) {
+	current := &rkev1.RKEControlPlane{
+		Spec: rkev1.RKEControlPlaneSpec{
+			KubernetesVersion: "v1.30.1+rke2r1",
+			ClusterName:       "cluster",
+			// Set by another controller, and left alone when the desired object is applied
+			ManagementClusterName: "c-m-abcdef",
+		},
+	}
+
+	t.Run("no change", func(t *testing.T) {
+		desired := current.DeepCopy()
+		desired.Spec.ManagementClusterName = ""
+
+		patch, err := specPatch(current, desired)
+		require.NoError(t, err)
+		assert.Empty(t, patch)
+	})
+
+	t.Run("changed field", func(t *testing.T) {
+		desired := current.DeepCopy()
+		desired.Spec.KubernetesVersion = "v1.31.1+rke2r1"
+
+		patch, err := specPatch(current, desired)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"kubernetesVersion":"v1.31.1+rke2r1"}`, patch)
+	})
+}
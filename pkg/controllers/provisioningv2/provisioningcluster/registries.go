@@ -0,0 +1,60 @@
+package provisioningcluster
+
+import (
+	"fmt"
+
+	rancherv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	rocontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+)
+
+// addPrivateRegistries adds the mirrors and configs of the private registries referenced by the cluster to the
+// registries of the rkecontrolplane, from which the planner renders the registries.yaml of the nodes. Later private
+// registries take precedence over earlier ones, and the registries set on the cluster itself over all of them.
+func addPrivateRegistries(controlPlane *rkev1.RKEControlPlane, cluster *rancherv1.Cluster, privateRegistries rocontrollers.PrivateRegistryCache) error {
+	if len(cluster.Spec.RKEConfig.PrivateRegistryNames) == 0 {
+		return nil
+	}
+
+	registries := &rkev1.Registry{}
+	for _, name := range cluster.Spec.RKEConfig.PrivateRegistryNames {
+		privateRegistry, err := privateRegistries.Get(cluster.Namespace, name)
+		if err != nil {
+			return fmt.Errorf("error retrieving private registry %s/%s: %w", cluster.Namespace, name, err)
+		}
+		mergeRegistries(registries, privateRegistry.Spec.Mirrors, privateRegistry.Spec.Configs)
+	}
+	if controlPlane.Spec.Registries != nil {
+		mergeRegistries(registries, controlPlane.Spec.Registries.Mirrors, controlPlane.Spec.Registries.Configs)
+	}
+
+	controlPlane.Spec.Registries = registries
+	return nil
+}
+
+func mergeRegistries(registries *rkev1.Registry, mirrors map[string]rkev1.Mirror, configs map[string]rkev1.RegistryConfig) {
+	for name, mirror := range mirrors {
+		if registries.Mirrors == nil {
+			registries.Mirrors = map[string]rkev1.Mirror{}
+		}
+		registries.Mirrors[name] = *mirror.DeepCopy()
+	}
+	for name, config := range configs {
+		if registries.Configs == nil {
+			registries.Configs = map[string]rkev1.RegistryConfig{}
+		}
+		registries.Configs[name] = *config.DeepCopy()
+	}
+}
+
+func byPrivateRegistryIndex(obj *rancherv1.Cluster) ([]string, error) {
+	if obj.Spec.RKEConfig == nil {
+		return nil, nil
+	}
+
+	var result []string
+	for _, name := range obj.Spec.RKEConfig.PrivateRegistryNames {
+		result = append(result, obj.Namespace+"/"+name)
+	}
+	return result, nil
+}
@@ -0,0 +1,121 @@
+package provisioningcluster
+
+import (
+	"testing"
+
+	rancherv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestAddPrivateRegistries(t *testing.T) {
+	mirrors := &rancherv1.PrivateRegistry{
+		ObjectMeta: metav1.ObjectMeta{Name: "mirrors", Namespace: "fleet-default"},
+		Spec: rancherv1.PrivateRegistrySpec{
+			Mirrors: map[string]rkev1.Mirror{
+				"docker.io": {Endpoints: []string{"https://mirror.example.com"}},
+				"quay.io":   {Endpoints: []string{"https://mirror.example.com"}},
+			},
+			Configs: map[string]rkev1.RegistryConfig{
+				"mirror.example.com": {AuthConfigSecretName: "mirror-credentials"},
+			},
+		},
+	}
+	internal := &rancherv1.PrivateRegistry{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal", Namespace: "fleet-default"},
+		Spec: rancherv1.PrivateRegistrySpec{
+			Mirrors: map[string]rkev1.Mirror{
+				"quay.io": {Endpoints: []string{"https://quay.internal.example.com"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		names       []string
+		registries  *rkev1.Registry
+		expected    *rkev1.Registry
+		expectedErr bool
+	}{
+		{
+			name:       "no private registries",
+			registries: &rkev1.Registry{Mirrors: map[string]rkev1.Mirror{"docker.io": {}}},
+			expected:   &rkev1.Registry{Mirrors: map[string]rkev1.Mirror{"docker.io": {}}},
+		},
+		{
+			name:  "later private registries take precedence",
+			names: []string{"mirrors", "internal"},
+			expected: &rkev1.Registry{
+				Mirrors: map[string]rkev1.Mirror{
+					"docker.io": {Endpoints: []string{"https://mirror.example.com"}},
+					"quay.io":   {Endpoints: []string{"https://quay.internal.example.com"}},
+				},
+				Configs: map[string]rkev1.RegistryConfig{
+					"mirror.example.com": {AuthConfigSecretName: "mirror-credentials"},
+				},
+			},
+		},
+		{
+			name:  "cluster registries take precedence",
+			names: []string{"mirrors"},
+			registries: &rkev1.Registry{
+				Configs: map[string]rkev1.RegistryConfig{
+					"mirror.example.com": {AuthConfigSecretName: "cluster-credentials"},
+				},
+			},
+			expected: &rkev1.Registry{
+				Mirrors: map[string]rkev1.Mirror{
+					"docker.io": {Endpoints: []string{"https://mirror.example.com"}},
+					"quay.io":   {Endpoints: []string{"https://mirror.example.com"}},
+				},
+				Configs: map[string]rkev1.RegistryConfig{
+					"mirror.example.com": {AuthConfigSecretName: "cluster-credentials"},
+				},
+			},
+		},
+		{
+			name:        "missing private registry",
+			names:       []string{"missing"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			privateRegistries := fake.NewMockCacheInterface[*rancherv1.PrivateRegistry](ctrl)
+			privateRegistries.EXPECT().Get("fleet-default", gomock.Any()).DoAndReturn(func(namespace, name string) (*rancherv1.PrivateRegistry, error) {
+				switch name {
+				case mirrors.Name:
+					return mirrors, nil
+				case internal.Name:
+					return internal, nil
+				}
+				return nil, apierrors.NewNotFound(schema.GroupResource{Group: "provisioning.cattle.io", Resource: "privateregistries"}, name)
+			}).AnyTimes()
+
+			cluster := &rancherv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "fleet-default"},
+				Spec: rancherv1.ClusterSpec{
+					RKEConfig: &rancherv1.RKEConfig{PrivateRegistryNames: tt.names},
+				},
+			}
+			controlPlane := &rkev1.RKEControlPlane{}
+			controlPlane.Spec.Registries = tt.registries
+
+			err := addPrivateRegistries(controlPlane, cluster, privateRegistries)
+			if tt.expectedErr {
+				assert.ErrorContains(t, err, "fleet-default/missing")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, controlPlane.Spec.Registries)
+		})
+	}
+}
@@ -7,6 +7,7 @@
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rancher/lasso/pkg/dynamic"
@@ -17,6 +18,8 @@
 	"github.com/rancher/rancher/pkg/capr/planner"
 	"github.com/rancher/rancher/pkg/controllers/capr/machineprovision"
 	mgmtcontroller "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	rocontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/provisioningv2/machineconfig"
 	"github.com/rancher/wrangler/v3/pkg/apply"
 	"github.com/rancher/wrangler/v3/pkg/data"
 	"github.com/rancher/wrangler/v3/pkg/data/convert"
@@ -26,12 +29,14 @@
 	"github.com/rancher/wrangler/v3/pkg/name"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	capi "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
@@ -45,8 +50,9 @@ func getInfraRef(rkeCluster *rkev1.RKECluster) *corev1.ObjectReference {
 }
 
 // objects generates the corresponding rkecontrolplanes.rke.cattle.io, clusters.cluster.x-k8s.io, and
-// machinedeployments.cluster.x-k8s.io objects based on the passed in clusters.provisioning.cattle.io object
-func objects(cluster *rancherv1.Cluster, dynamic *dynamic.Controller, dynamicSchema mgmtcontroller.DynamicSchemaCache, secrets v1.SecretCache) (result []runtime.Object, _ error) {
+// machinedeployments.cluster.x-k8s.io objects based on the passed in clusters.provisioning.cattle.io object. If dryRun
+// is true, the machine configs of the machine pools are not updated to be owned by the cluster.
+func objects(cluster *rancherv1.Cluster, dynamic *dynamic.Controller, dynamicSchema mgmtcontroller.DynamicSchemaCache, secrets v1.SecretCache, privateRegistries rocontrollers.PrivateRegistryCache, dryRun bool) (result []runtime.Object, _ error) {
 	if !cluster.DeletionTimestamp.IsZero() {
 		return nil, nil
 	}
@@ -62,12 +68,15 @@ func objects(cluster *rancherv1.Cluster, dynamic *dynamic.Controller, dynamicSch
 	if err != nil {
 		return nil, err
 	}
+	if err := addPrivateRegistries(rkeControlPlane, cluster, privateRegistries); err != nil {
+		return nil, err
+	}
 	result = append(result, rkeControlPlane)
 
 	capiCluster := capiCluster(cluster, rkeControlPlane, infraRef)
 	result = append(result, capiCluster)
 
-	machineDeployments, err := machineDeployments(cluster, capiCluster, dynamic, dynamicSchema, secrets)
+	machineDeployments, err := machineDeployments(cluster, capiCluster, dynamic, dynamicSchema, secrets, dryRun)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +133,7 @@ func takeOwnership(dynamic *dynamic.Controller, cluster *rancherv1.Cluster, node
 }
 
 func toMachineTemplate(machinePoolName string, cluster *rancherv1.Cluster, machinePool rancherv1.RKEMachinePool,
-	dynamic *dynamic.Controller, secrets v1.SecretCache) (*unstructured.Unstructured, error) {
+	dynamic *dynamic.Controller, secrets v1.SecretCache, dryRun bool) (*unstructured.Unstructured, error) {
 	apiVersion := machinePool.NodeConfig.APIVersion
 	kind := machinePool.NodeConfig.Kind
 	if apiVersion == "" {
@@ -137,8 +146,10 @@ func toMachineTemplate(machinePoolName string, cluster *rancherv1.Cluster, machi
 		return nil, err
 	}
 
-	if err := takeOwnership(dynamic, cluster, nodeConfig); err != nil {
-		return nil, err
+	if !dryRun {
+		if err := takeOwnership(dynamic, cluster, nodeConfig); err != nil {
+			return nil, err
+		}
 	}
 
 	machinePoolData, err := data.Convert(nodeConfig.DeepCopyObject())
@@ -158,6 +169,10 @@ func toMachineTemplate(machinePoolName string, cluster *rancherv1.Cluster, machi
 
 	pruneBySchema(machinePoolData, spec)
 
+	if errs := machineconfig.Validate(machinePoolData, spec, field.NewPath(kind).Key(machinePool.NodeConfig.Name)); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid machine config for machine pool [%s]: %w", machinePool.Name, errs.ToAggregate())
+	}
+
 	commonData, err := convert.EncodeToMap(machinePool.RKECommonNodeConfig)
 	if err != nil {
 		return nil, err
@@ -171,7 +186,13 @@ func toMachineTemplate(machinePoolName string, cluster *rancherv1.Cluster, machi
 
 	if secretName != "" {
 		_, err := machineprovision.GetCloudCredentialSecret(secrets, cluster.Namespace, secretName)
-		if err != nil {
+		if apierrors.IsNotFound(err) {
+			fldPath := field.NewPath("spec", "rkeConfig", "machinePools").Key(machinePool.Name).Child("cloudCredentialSecretName")
+			if machinePool.CloudCredentialSecretName == "" {
+				fldPath = field.NewPath("spec", "cloudCredentialSecretName")
+			}
+			return nil, field.NotFound(fldPath, secretName)
+		} else if err != nil {
 			return nil, err
 		}
 		machinePoolData.SetNested(secretName, "common", "cloudCredentialSecretName")
@@ -209,6 +230,54 @@ func toMachineTemplate(machinePoolName string, cluster *rancherv1.Cluster, machi
 	return ustr, nil
 }
 
+// rollingUpdateLimits returns the maxSurge and maxUnavailable values to use for the machine deployment of a machine
+// pool. A pool with the etcd role never has more than one machine unavailable at a time, so that replacing its
+// machines can not cost the etcd cluster its quorum.
+func rollingUpdateLimits(machinePool rancherv1.RKEMachinePool) (*intstr.IntOrString, *intstr.IntOrString, error) {
+	maxSurge := machinePool.RollingUpdate.MaxSurge
+	maxUnavailable := machinePool.RollingUpdate.MaxUnavailable
+
+	if machinePool.RollingUpdate.DeleteBeforeCreate {
+		maxSurge = &intstr.IntOrString{Type: intstr.Int, IntVal: 0}
+		if maxUnavailable == nil {
+			maxUnavailable = &intstr.IntOrString{Type: intstr.Int, IntVal: 1}
+		}
+	}
+
+	replicas := 1
+	if machinePool.Quantity != nil && *machinePool.Quantity > 0 {
+		replicas = int(*machinePool.Quantity)
+	}
+
+	if maxUnavailable != nil && machinePool.EtcdRole {
+		unavailable, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailable, replicas, false)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid maxUnavailable for machine pool %s: %w", machinePool.Name, err)
+		}
+		if unavailable > 1 {
+			logrus.Debugf("[provisioningcluster] limiting maxUnavailable of etcd machine pool %s to 1", machinePool.Name)
+			maxUnavailable = &intstr.IntOrString{Type: intstr.Int, IntVal: 1}
+		}
+	}
+
+	// With both limits at 0 the rolling update could never make progress.
+	if maxSurge != nil && maxUnavailable != nil {
+		surge, err := intstr.GetScaledValueFromIntOrPercent(maxSurge, replicas, true)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid maxSurge for machine pool %s: %w", machinePool.Name, err)
+		}
+		unavailable, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailable, replicas, false)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid maxUnavailable for machine pool %s: %w", machinePool.Name, err)
+		}
+		if surge == 0 && unavailable == 0 {
+			return nil, nil, fmt.Errorf("maxSurge and maxUnavailable of machine pool %s can not both be 0", machinePool.Name)
+		}
+	}
+
+	return maxSurge, maxUnavailable, nil
+}
+
 func populateHostnameLengthLimitAnnotation(mp rancherv1.RKEMachinePool, cluster *rancherv1.Cluster, annotations map[string]string) error {
 	if cluster == nil {
 		return errors.New("cannot add hostname length limit annotation for nil cluster")
@@ -268,7 +337,7 @@ func createMachineTemplateHash(dataMap map[string]interface{}) string {
 }
 
 func machineDeployments(cluster *rancherv1.Cluster, capiCluster *capi.Cluster, dynamic *dynamic.Controller,
-	dynamicSchema mgmtcontroller.DynamicSchemaCache, secrets v1.SecretCache) (result []runtime.Object, _ error) {
+	dynamicSchema mgmtcontroller.DynamicSchemaCache, secrets v1.SecretCache, dryRun bool) (result []runtime.Object, _ error) {
 	bootstrapName := name.SafeConcatName(cluster.Name, "bootstrap", "template")
 
 	if dynamicSchema == nil {
@@ -320,7 +389,7 @@ func machineDeployments(cluster *rancherv1.Cluster, capiCluster *capi.Cluster, d
 		)
 
 		if machinePool.NodeConfig.APIVersion == "" || machinePool.NodeConfig.APIVersion == "rke-machine-config.cattle.io/v1" {
-			machineTemplate, err := toMachineTemplate(machineDeploymentName, cluster, machinePool, dynamic, secrets)
+			machineTemplate, err := toMachineTemplate(machineDeploymentName, cluster, machinePool, dynamic, secrets, dryRun)
 			if err != nil {
 				return nil, err
 			}
@@ -363,6 +432,19 @@ func machineDeployments(cluster *rancherv1.Cluster, capiCluster *capi.Cluster, d
 			return nil, err
 		}
 
+		nodeDrainTimeout := machinePool.DrainBeforeDeleteTimeout
+		if machinePool.DrainOptions != nil {
+			// The planner drains the machines of the pool with these options instead of the ones of the cluster.
+			drainOptions, err := json.Marshal(machinePool.DrainOptions)
+			if err != nil {
+				return nil, err
+			}
+			machineSpecAnnotations[capr.MachinePoolDrainOptionsAnnotation] = string(drainOptions)
+			if nodeDrainTimeout == nil && machinePool.DrainOptions.ForceAfter > 0 {
+				nodeDrainTimeout = &metav1.Duration{Duration: time.Duration(machinePool.DrainOptions.ForceAfter) * time.Second}
+			}
+		}
+
 		machineDeployment := &capi.MachineDeployment{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace:   cluster.Namespace,
@@ -402,15 +484,19 @@ func machineDeployments(cluster *rancherv1.Cluster, capiCluster *capi.Cluster, d
 							},
 						},
 						InfrastructureRef: infraRef,
-						NodeDrainTimeout:  machinePool.DrainBeforeDeleteTimeout,
+						NodeDrainTimeout:  nodeDrainTimeout,
 					},
 				},
 				Paused: machinePool.Paused,
 			},
 		}
 		if machinePool.RollingUpdate != nil {
-			machineDeployment.Spec.Strategy.RollingUpdate.MaxSurge = machinePool.RollingUpdate.MaxSurge
-			machineDeployment.Spec.Strategy.RollingUpdate.MaxUnavailable = machinePool.RollingUpdate.MaxUnavailable
+			maxSurge, maxUnavailable, err := rollingUpdateLimits(machinePool)
+			if err != nil {
+				return nil, err
+			}
+			machineDeployment.Spec.Strategy.RollingUpdate.MaxSurge = maxSurge
+			machineDeployment.Spec.Strategy.RollingUpdate.MaxUnavailable = maxUnavailable
 		}
 
 		if machinePool.EtcdRole {
@@ -510,9 +596,14 @@ func assign(labels map[string]string, key string, value interface{}) error {
 func rkeCluster(cluster *rancherv1.Cluster) *rkev1.RKECluster {
 	return &rkev1.RKECluster{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        cluster.Name,
-			Namespace:   cluster.Namespace,
-			Annotations: map[string]string{capr.DeleteMissingCustomMachinesAfterAnnotation: cluster.Annotations[capr.DeleteMissingCustomMachinesAfterAnnotation]},
+			Name:      cluster.Name,
+			Namespace: cluster.Namespace,
+			Annotations: map[string]string{
+				capr.DeleteMissingCustomMachinesAfterAnnotation: cluster.Annotations[capr.DeleteMissingCustomMachinesAfterAnnotation],
+				capr.ReplaceUnhealthyMachinesAfterAnnotation:    cluster.Annotations[capr.ReplaceUnhealthyMachinesAfterAnnotation],
+				capr.MaxUnhealthyMachinesAnnotation:             cluster.Annotations[capr.MaxUnhealthyMachinesAnnotation],
+				capr.MaxMachineReplacementsAnnotation:           cluster.Annotations[capr.MaxMachineReplacementsAnnotation],
+			},
 		},
 	}
 }
@@ -525,6 +616,7 @@ func rkeControlPlane(cluster *rancherv1.Cluster) (*rkev1.RKEControlPlane, error)
 	// set the corresponding specification for various operations to nil as these cause unnecessary reconciliation.
 	filteredClusterSpec.RKEConfig.ETCDSnapshotRestore = nil
 	filteredClusterSpec.RKEConfig.ETCDSnapshotCreate = nil
+	filteredClusterSpec.RKEConfig.ETCDSnapshotSchedule = nil
 	filteredClusterSpec.RKEConfig.RotateEncryptionKeys = nil
 	filteredClusterSpec.RKEConfig.RotateCertificates = nil
 	b64GZCluster, err := capr.CompressInterface(filteredClusterSpec)
@@ -5,6 +5,9 @@
 
 	provv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 )
 
 func TestPopulateHostnameLengthLimitAnnotation(t *testing.T) {
@@ -94,3 +97,68 @@ func TestPopulateHostnameLengthLimitAnnotation(t *testing.T) {
 		})
 	}
 }
+
+func TestRollingUpdateLimits(t *testing.T) {
+	zero := intstr.FromInt32(0)
+	one := intstr.FromInt32(1)
+	three := intstr.FromInt32(3)
+	half := intstr.FromString("50%")
+
+	tests := []struct {
+		name                   string
+		machinePool            provv1.RKEMachinePool
+		expectedMaxSurge       *intstr.IntOrString
+		expectedMaxUnavailable *intstr.IntOrString
+		expectedErr            string
+	}{
+		{
+			name: "passed through",
+			machinePool: provv1.RKEMachinePool{
+				Quantity:      ptr.To[int32](10),
+				RollingUpdate: &provv1.RKEMachinePoolRollingUpdate{MaxSurge: &three, MaxUnavailable: &half},
+			},
+			expectedMaxSurge:       &three,
+			expectedMaxUnavailable: &half,
+		},
+		{
+			name: "delete before create",
+			machinePool: provv1.RKEMachinePool{
+				Quantity:      ptr.To[int32](10),
+				RollingUpdate: &provv1.RKEMachinePoolRollingUpdate{MaxSurge: &three, DeleteBeforeCreate: true},
+			},
+			expectedMaxSurge:       &zero,
+			expectedMaxUnavailable: &one,
+		},
+		{
+			name: "etcd pool limited to one unavailable machine",
+			machinePool: provv1.RKEMachinePool{
+				EtcdRole:      true,
+				Quantity:      ptr.To[int32](5),
+				RollingUpdate: &provv1.RKEMachinePoolRollingUpdate{MaxUnavailable: &half},
+			},
+			expectedMaxUnavailable: &one,
+		},
+		{
+			name: "both limits 0",
+			machinePool: provv1.RKEMachinePool{
+				Name:          "pool",
+				Quantity:      ptr.To[int32](3),
+				RollingUpdate: &provv1.RKEMachinePoolRollingUpdate{MaxUnavailable: &zero, DeleteBeforeCreate: true},
+			},
+			expectedErr: "maxSurge and maxUnavailable of machine pool pool can not both be 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxSurge, maxUnavailable, err := rollingUpdateLimits(tt.machinePool)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMaxSurge, maxSurge)
+			assert.Equal(t, tt.expectedMaxUnavailable, maxUnavailable)
+		})
+	}
+}
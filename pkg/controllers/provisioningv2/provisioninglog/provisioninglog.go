@@ -19,7 +19,9 @@
 )
 
 const (
-	provisioningLogName = "provisioning-log"
+	// ProvisioningLogName is the name of the configmap the provisioning log of a cluster is recorded in, in the
+	// namespace of its management cluster.
+	ProvisioningLogName = "provisioning-log"
 	maxLen              = 10000
 )
 
@@ -48,7 +50,7 @@ func (h *handler) OnConfigMap(_ string, cm *corev1.ConfigMap) (*corev1.ConfigMap
 	if cm == nil || !cm.DeletionTimestamp.IsZero() {
 		return nil, nil
 	}
-	if cm.Name != provisioningLogName || (!clusterRegexp.MatchString(cm.Namespace) && cm.Namespace != "local") {
+	if cm.Name != ProvisioningLogName || (!clusterRegexp.MatchString(cm.Namespace) && cm.Namespace != "local") {
 		return cm, nil
 	}
 	provCluster, err := h.clusterCache.GetByIndex(clusterindex.ClusterV1ByClusterV3Reference, cm.Namespace)
@@ -138,15 +140,15 @@ func (h *handler) OnNamespace(_ string, ns *corev1.Namespace) (*corev1.Namespace
 	if !clusterRegexp.MatchString(ns.Name) {
 		return ns, nil
 	}
-	if _, err := h.configMapsCache.Get(ns.Name, provisioningLogName); apierrors.IsNotFound(err) {
+	if _, err := h.configMapsCache.Get(ns.Name, ProvisioningLogName); apierrors.IsNotFound(err) {
 		_, err := h.configMaps.Create(&corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      provisioningLogName,
+				Name:      ProvisioningLogName,
 				Namespace: ns.Name,
 			},
 		})
 		if err != nil {
-			return nil, fmt.Errorf("creating %s for %s: %w", provisioningLogName, ns.Name, err)
+			return nil, fmt.Errorf("creating %s for %s: %w", ProvisioningLogName, ns.Name, err)
 		}
 	}
 	return ns, nil
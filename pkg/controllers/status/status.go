@@ -9,6 +9,7 @@
 const (
 	SummaryCompleted = "Completed"
 	SummaryError     = "Error"
+	SummaryPending   = "Pending"
 )
 
 type Status struct {
@@ -74,6 +74,8 @@ func BasicCRDs() []string {
 func ProvisioningV2CRDs() []string {
 	return []string{
 		"clusters.provisioning.cattle.io",
+		"clustertemplates.provisioning.cattle.io",
+		"privateregistries.provisioning.cattle.io",
 	}
 }
 
@@ -142,7 +144,9 @@ func ClusterAuthCRDs() []string {
 // MCMCRDs returns a list of CRD names needed for Multi Cluster Management.
 func MCMCRDs() []string {
 	return []string{
+		"apiusagereports.management.cattle.io",
 		"authconfigs.management.cattle.io",
+		"bootstrapprincipals.management.cattle.io",
 		"clusters.management.cattle.io",
 		"clusterregistrationtokens.management.cattle.io",
 		"clusterroletemplatebindings.management.cattle.io",
@@ -152,20 +156,24 @@ func MCMCRDs() []string {
 		"fleetworkspaces.management.cattle.io",
 		"globalroles.management.cattle.io",
 		"globalrolebindings.management.cattle.io",
+		"globalrolegroupmappings.management.cattle.io",
 		"groups.management.cattle.io",
 		"groupmembers.management.cattle.io",
 		"kontainerdrivers.management.cattle.io",
+		"managedsettings.management.cattle.io",
 		"monitormetrics.management.cattle.io",
 		"nodes.management.cattle.io",
 		"nodedrivers.management.cattle.io",
 		"nodepools.management.cattle.io",
 		"podsecurityadmissionconfigurationtemplates.management.cattle.io",
+		"podsecurityadmissionprofiles.management.cattle.io",
 		"preferences.management.cattle.io",
 		"projects.management.cattle.io",
 		"projectnetworkpolicys.management.cattle.io",
 		"projectroletemplatebindings.management.cattle.io",
 		"rancherusernotificationtypes.management.cattle.io",
 		"roletemplates.management.cattle.io",
+		"roletemplatepolicies.management.cattle.io",
 		"samltokens.management.cattle.io",
 		"settings.management.cattle.io",
 		"templates.management.cattle.io",
@@ -202,6 +210,7 @@ func TelemetryCRDs() []string {
 var MigratedResources = map[string]bool{
 	"activedirectoryproviders.management.cattle.io":                   false,
 	"apiservices.management.cattle.io":                                false,
+	"apiusagereports.management.cattle.io":                            false,
 	"apps.catalog.cattle.io":                                          false,
 	"auditpolicies.auditlog.cattle.io":                                true,
 	"authconfigs.management.cattle.io":                                false,
@@ -209,6 +218,7 @@ func TelemetryCRDs() []string {
 	"authtokens.management.cattle.io":                                 false,
 	"azureadproviders.management.cattle.io":                           false,
 	"basicauths.project.cattle.io":                                    false,
+	"bootstrapprincipals.management.cattle.io":                        false,
 	"certificates.project.cattle.io":                                  false,
 	"cloudcredentials.management.cattle.io":                           false,
 	"clusterauthtokens.cluster.cattle.io":                             false,
@@ -221,6 +231,7 @@ func TelemetryCRDs() []string {
 	"clusters.cluster.x-k8s.io":                                       false,
 	"clusters.management.cattle.io":                                   false,
 	"clusters.provisioning.cattle.io":                                 true,
+	"clustertemplates.provisioning.cattle.io":                         true,
 	"clusteruserattributes.cluster.cattle.io":                         false,
 	"composeconfigs.management.cattle.io":                             false,
 	"custommachines.rke.cattle.io":                                    true,
@@ -233,6 +244,7 @@ func TelemetryCRDs() []string {
 	"freeipaproviders.management.cattle.io":                           false,
 	"githubproviders.management.cattle.io":                            false,
 	"globalrolebindings.management.cattle.io":                         true,
+	"globalrolegroupmappings.management.cattle.io":                    false,
 	"globalroles.management.cattle.io":                                true,
 	"googleoauthproviders.management.cattle.io":                       false,
 	"groupmembers.management.cattle.io":                               false,
@@ -245,6 +257,7 @@ func TelemetryCRDs() []string {
 	"machines.cluster.x-k8s.io":                                       false,
 	"machinesets.cluster.x-k8s.io":                                    false,
 	"managedcharts.management.cattle.io":                              false,
+	"managedsettings.management.cattle.io":                            true,
 	"monitormetrics.management.cattle.io":                             false,
 	"navlinks.ui.cattle.io":                                           false,
 	"nodedrivers.management.cattle.io":                                true,
@@ -255,8 +268,10 @@ func TelemetryCRDs() []string {
 	"openldapproviders.management.cattle.io":                          false,
 	"operations.catalog.cattle.io":                                    false,
 	"podsecurityadmissionconfigurationtemplates.management.cattle.io": false,
+	"podsecurityadmissionprofiles.management.cattle.io":               true,
 	"preferences.management.cattle.io":                                false,
 	"principals.management.cattle.io":                                 false,
+	"privateregistries.provisioning.cattle.io":                        true,
 	"projectnetworkpolicies.management.cattle.io":                     false,
 	"projectroletemplatebindings.management.cattle.io":                true,
 	"projects.management.cattle.io":                                   true,
@@ -265,6 +280,7 @@ func TelemetryCRDs() []string {
 	"rkebootstraptemplates.rke.cattle.io":                             true,
 	"rkeclusters.rke.cattle.io":                                       true,
 	"rkecontrolplanes.rke.cattle.io":                                  true,
+	"roletemplatepolicies.management.cattle.io":                       false,
 	"roletemplates.management.cattle.io":                              true,
 	"samlproviders.management.cattle.io":                              false,
 	"samltokens.management.cattle.io":                                 false,
@@ -0,0 +1,132 @@
+package management
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	wcorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// rbacBootstrapStatusConfigMap is the name of the ConfigMap summarizing whether the built-in roles were applied at
+	// the last startup, so that partially-initialized RBAC can be alerted on.
+	rbacBootstrapStatusConfigMap = "rbac-bootstrap-status"
+
+	rbacBootstrapStatusLabelValue = "rbac-status"
+
+	// Data keys of the status ConfigMap.
+	rbacBootstrapCompleteKey      = "complete"
+	rbacBootstrapTimestampKey     = "timestamp"
+	rbacBootstrapGlobalRolesKey   = "globalroles"
+	rbacBootstrapRoleTemplatesKey = "roletemplates"
+
+	roleKindPending = "Pending"
+	roleKindApplied = "Applied"
+	roleKindFailed  = "Failed"
+)
+
+// roleKindStatus is the result of applying the built-in roles of one kind.
+type roleKindStatus struct {
+	// Status is Pending if the roles weren't applied yet, Applied if all of them were, and Failed otherwise.
+	Status string `json:"status"`
+	// Error is the error that prevented the roles from being applied, if any.
+	Error string `json:"error,omitempty"`
+	// Failed lists the names of the roles that couldn't be applied, if known.
+	Failed []string `json:"failed,omitempty"`
+}
+
+// newRoleKindStatus returns the status of the built-in roles of one kind given the error of their reconciliation.
+func newRoleKindStatus(err error) roleKindStatus {
+	if err == nil {
+		return roleKindStatus{Status: roleKindApplied}
+	}
+
+	status := roleKindStatus{Status: roleKindFailed, Error: err.Error()}
+	var reconcileErr *reconcileError
+	if errors.As(err, &reconcileErr) {
+		for name := range reconcileErr.failed {
+			status.Failed = append(status.Failed, name)
+		}
+		sort.Strings(status.Failed)
+	}
+	return status
+}
+
+// rbacBootstrapStatus summarizes the result of applying the built-in GlobalRoles and RoleTemplates at startup.
+type rbacBootstrapStatus struct {
+	globalRoles   roleKindStatus
+	roleTemplates roleKindStatus
+	now           func() time.Time
+}
+
+func newRBACBootstrapStatus() *rbacBootstrapStatus {
+	return &rbacBootstrapStatus{
+		globalRoles:   roleKindStatus{Status: roleKindPending},
+		roleTemplates: roleKindStatus{Status: roleKindPending},
+		now:           time.Now,
+	}
+}
+
+func (s *rbacBootstrapStatus) complete() bool {
+	return s.globalRoles.Status == roleKindApplied && s.roleTemplates.Status == roleKindApplied
+}
+
+func (s *rbacBootstrapStatus) toConfigMap() (*corev1.ConfigMap, error) {
+	globalRoles, err := json.Marshal(s.globalRoles)
+	if err != nil {
+		return nil, err
+	}
+	roleTemplates, err := json.Marshal(s.roleTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      rbacBootstrapStatusConfigMap,
+			Namespace: cattleNamespace,
+			Labels:    map[string]string{defaultAdminLabelKey: rbacBootstrapStatusLabelValue},
+		},
+		Data: map[string]string{
+			rbacBootstrapCompleteKey:      fmt.Sprint(s.complete()),
+			rbacBootstrapTimestampKey:     s.now().UTC().Format(time.RFC3339),
+			rbacBootstrapGlobalRolesKey:   string(globalRoles),
+			rbacBootstrapRoleTemplatesKey: string(roleTemplates),
+		},
+	}, nil
+}
+
+// save persists the status to its ConfigMap. A failure to persist it is only logged, as it must not prevent startup.
+func (s *rbacBootstrapStatus) save(configMaps wcorev1.ConfigMapClient) {
+	if err := s.saveConfigMap(configMaps); err != nil {
+		logrus.Warnf("Error saving %s/%s config map: %v", cattleNamespace, rbacBootstrapStatusConfigMap, err)
+	}
+}
+
+func (s *rbacBootstrapStatus) saveConfigMap(configMaps wcorev1.ConfigMapClient) error {
+	desired, err := s.toConfigMap()
+	if err != nil {
+		return err
+	}
+
+	existing, err := configMaps.Get(cattleNamespace, rbacBootstrapStatusConfigMap, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = configMaps.Create(desired)
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	existing = existing.DeepCopy()
+	existing.Labels = desired.Labels
+	existing.Data = desired.Data
+	_, err = configMaps.Update(existing)
+	return err
+}
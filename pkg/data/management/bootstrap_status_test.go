@@ -0,0 +1,126 @@
+package management
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestNewRoleKindStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want roleKindStatus
+	}{
+		{
+			name: "applied",
+			want: roleKindStatus{Status: roleKindApplied},
+		},
+		{
+			name: "roles failed",
+			err: fmt.Errorf("problem reconciling global roles: %w", &reconcileError{failed: map[string]error{
+				"user":  fmt.Errorf("couldn't update user"),
+				"admin": fmt.Errorf("couldn't create admin"),
+			}}),
+			want: roleKindStatus{
+				Status: roleKindFailed,
+				Error:  "problem reconciling global roles: couldn't create admin, couldn't update user",
+				Failed: []string{"admin", "user"},
+			},
+		},
+		{
+			name: "roles couldn't be listed",
+			err:  errExpected,
+			want: roleKindStatus{
+				Status: roleKindFailed,
+				Error:  errExpected.Error(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newRoleKindStatus(tt.err))
+		})
+	}
+}
+
+func TestRBACBootstrapStatusSaveConfigMap(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	status := &rbacBootstrapStatus{
+		globalRoles:   roleKindStatus{Status: roleKindFailed, Error: "couldn't create admin", Failed: []string{"admin"}},
+		roleTemplates: roleKindStatus{Status: roleKindPending},
+		now:           func() time.Time { return now },
+	}
+	wantData := map[string]string{
+		rbacBootstrapCompleteKey:      "false",
+		rbacBootstrapTimestampKey:     "2024-01-02T03:04:05Z",
+		rbacBootstrapGlobalRolesKey:   `{"status":"Failed","error":"couldn't create admin","failed":["admin"]}`,
+		rbacBootstrapRoleTemplatesKey: `{"status":"Pending"}`,
+	}
+
+	tests := []struct {
+		name     string
+		existing *corev1.ConfigMap
+		getErr   error
+		wantErr  bool
+	}{
+		{
+			name:   "config map is created",
+			getErr: apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, rbacBootstrapStatusConfigMap),
+		},
+		{
+			name: "config map is updated",
+			existing: &corev1.ConfigMap{
+				ObjectMeta: v1.ObjectMeta{
+					Name:            rbacBootstrapStatusConfigMap,
+					Namespace:       cattleNamespace,
+					ResourceVersion: "1",
+				},
+				Data: map[string]string{rbacBootstrapCompleteKey: "true"},
+			},
+		},
+		{
+			name:    "config map can't be read",
+			getErr:  errExpected,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			configMaps := fake.NewMockClientInterface[*corev1.ConfigMap, *corev1.ConfigMapList](ctrl)
+			configMaps.EXPECT().Get(cattleNamespace, rbacBootstrapStatusConfigMap, v1.GetOptions{}).Return(tt.existing, tt.getErr)
+			saved := func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+				assert.Equal(t, rbacBootstrapStatusConfigMap, cm.Name)
+				assert.Equal(t, cattleNamespace, cm.Namespace)
+				assert.Equal(t, rbacBootstrapStatusLabelValue, cm.Labels[defaultAdminLabelKey])
+				assert.Equal(t, wantData, cm.Data)
+				return cm, nil
+			}
+			if tt.existing != nil {
+				configMaps.EXPECT().Update(gomock.Any()).DoAndReturn(func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+					assert.Equal(t, "1", cm.ResourceVersion)
+					return saved(cm)
+				})
+			} else if !tt.wantErr {
+				configMaps.EXPECT().Create(gomock.Any()).DoAndReturn(saved)
+			}
+
+			err := status.saveConfigMap(configMaps)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@
 
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/auth/providers/local/pbkdf2"
+	"github.com/rancher/rancher/pkg/crds"
 	"github.com/rancher/rancher/pkg/features"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/types/config"
@@ -34,11 +35,15 @@
 )
 
 func addRoles(wrangler *wrangler.Context, management *config.ManagementContext) (string, error) {
+	status := newRBACBootstrapStatus()
+	defer status.save(wrangler.Core.ConfigMap())
+
 	rb := newRoleBuilder()
 
 	clusterCreateRole := rb.addRole("Create Clusters", "clusters-create")
 		clusterCreateRole.addRule().apiGroups("management.cattle.io").resources("clusters").verbs("create").
 		addRule().apiGroups("provisioning.cattle.io").resources("clusters").verbs("create").
+		addRule().apiGroups("provisioning.cattle.io").resources("clustertemplates", "privateregistries").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("templates", "templateversions").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("nodedrivers").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("kontainerdrivers").verbs("get", "list", "watch").
@@ -97,7 +102,9 @@ func addRoles(wrangler *wrangler.Context, management *config.ManagementContext)
 	// TODO user should be dynamically authorized to only see herself
 	// TODO enable when groups are "in". they need to be self-service
 
-	if err := rb.reconcileGlobalRoles(wrangler.Mgmt.GlobalRole()); err != nil {
+	err := rb.reconcileGlobalRoles(wrangler.Mgmt.GlobalRole())
+	status.globalRoles = newRoleKindStatus(err)
+	if err != nil {
 		return "", fmt.Errorf("problem reconciling global roles: %w", err)
 	}
 
@@ -195,6 +202,10 @@ func addRoles(wrangler *wrangler.Context, management *config.ManagementContext)
 	rb.addRoleTemplate("Manage Navlinks", "navlinks-manage", "cluster", false, false, false).
 		addRule().apiGroups("ui.cattle.io").resources("navlinks").verbs("*")
 
+	if features.SupportReadOnlyRole.Enabled() {
+		addSupportReadOnlyRole(rb, crds.RequiredCRDs())
+	}
+
 	// Project roles
 	rb.addRoleTemplate("Project Owner", "project-owner", "project", false, false, false).
 		addRule().apiGroups("ui.cattle.io").resources("navlinks").verbs("get", "list", "watch").
@@ -371,7 +382,9 @@ func addRoles(wrangler *wrangler.Context, management *config.ManagementContext)
 	//	addRule().apiGroups("").resources("events").verbs("get", "list", "watch").
 	//	addRule().apiGroups("management.cattle.io").resources("clusterevents").verbs("get", "list", "watch")
 
-	if err := rb.reconcileRoleTemplates(wrangler.Mgmt.RoleTemplate()); err != nil {
+	err = rb.reconcileRoleTemplates(wrangler.Mgmt.RoleTemplate())
+	status.roleTemplates = newRoleKindStatus(err)
+	if err != nil {
 		return "", fmt.Errorf("problem reconciling role templates: %w", err)
 	}
 
@@ -406,6 +419,7 @@ func addUserRules(role *roleBuilder) *roleBuilder {
 		addRule().apiGroups("management.cattle.io").resources("kontainerdrivers").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("fleetworkspaces").verbs("create").
 		addRule().apiGroups("provisioning.cattle.io").resources("clusters").verbs("create").
+		addRule().apiGroups("provisioning.cattle.io").resources("clustertemplates", "privateregistries").verbs("get", "list", "watch").
 		addRule().apiGroups("rke-machine-config.cattle.io").resources("*").verbs("create").
 		addRule().apiGroups("management.cattle.io").resources("rancherusernotifications").verbs("get", "list", "watch").
 		addRule().apiGroups("catalog.cattle.io").resources("clusterrepos").verbs("get", "list", "watch").
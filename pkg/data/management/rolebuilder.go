@@ -3,6 +3,8 @@
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
@@ -250,6 +252,27 @@ func (n *namespacedRuleBuilder) addRule() *ruleBuilder {
 // gatherExistingFnc returns a map of objects that already exist using the object name as the key.
 type gatherExistingFnc[T runtime.Object] func() (ObjectNameMap map[string]T, err error)
 
+// reconcileError is returned when some of the built-in roles couldn't be applied. It keeps the error of each role, so
+// that the roles that failed can be reported.
+type reconcileError struct {
+	// failed maps the names of the roles that couldn't be applied to their error.
+	failed map[string]error
+}
+
+func (e *reconcileError) Error() string {
+	names := make([]string, 0, len(e.failed))
+	for name := range e.failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	messages := make([]string, 0, len(names))
+	for _, name := range names {
+		messages = append(messages, e.failed[name].Error())
+	}
+	return strings.Join(messages, ", ")
+}
+
 func reconcile[T generic.RuntimeMetaObject, TList runtime.Object](
 	rb *roleBuilder, build buildFnc[T], gatherExisting gatherExistingFnc[T],
 	compareAndModify compareAndModifyFnc[T], client generic.NonNamespacedClientInterface[T, TList]) error {
@@ -271,11 +294,14 @@ func reconcile[T generic.RuntimeMetaObject, TList runtime.Object](
 		return err
 	}
 
+	// A role that fails to be applied doesn't prevent the others from being applied.
+	failed := map[string]error{}
 	for name := range existing {
 		if _, ok := builtRoles[name]; !ok {
 			logrus.Infof("Removing %v", name)
 			if err := client.Delete(name, nil); err != nil {
-				return errors.Wrapf(err, "couldn't delete %v", name)
+				failed[name] = errors.Wrapf(err, "couldn't delete %v", name)
+				continue
 			}
 			delete(existing, name)
 		}
@@ -285,11 +311,12 @@ func reconcile[T generic.RuntimeMetaObject, TList runtime.Object](
 		if existingCR, ok := existing[name]; ok {
 			equal, modified, err := compareAndModify(existingCR, gr)
 			if err != nil {
-				return err
+				failed[name] = err
+				continue
 			}
 			if !equal {
 				if _, err := client.Update(modified); err != nil {
-					return errors.Wrapf(err, "couldn't update %v", name)
+					failed[name] = errors.Wrapf(err, "couldn't update %v", name)
 				}
 			}
 			continue
@@ -297,10 +324,13 @@ func reconcile[T generic.RuntimeMetaObject, TList runtime.Object](
 
 		logrus.Infof("Creating %v", name)
 		if _, err := client.Create(gr); err != nil {
-			return errors.Wrapf(err, "couldn't create %v", name)
+			failed[name] = errors.Wrapf(err, "couldn't create %v", name)
 		}
 	}
 
+	if len(failed) > 0 {
+		return &reconcileError{failed: failed}
+	}
 	return nil
 }
 
@@ -84,6 +84,7 @@ func Test_reconcileGlobalRoles(t *testing.T) {
 		grsToCreate []*v3.GlobalRole
 		setup       func(mocks testMocks)
 		wantErr     bool
+		wantFailed  []string
 	}{
 		{
 			name:        "Create new GR with no preexisting",
@@ -263,6 +264,18 @@ func(toUpdate *v3.GlobalRole) (*v3.GlobalRole, error) {
 				mocks.grClientMock.EXPECT().Update(ObjectMatcher(adminGR)).Return(nil, errExpected)
 			},
 		},
+		{
+			name:        "Fail to create one of multiple new GRs",
+			grsToCreate: []*v3.GlobalRole{basicGR, readGR},
+			wantErr:     true,
+			wantFailed:  []string{basicGR.Name},
+			setup: func(mocks testMocks) {
+				mocks.grClientMock.EXPECT().List(gomock.Any()).Return(&v3.GlobalRoleList{}, nil)
+				mocks.grClientMock.EXPECT().WithImpersonation(controllers.WebhookImpersonation()).Return(mocks.grClientMock, nil)
+				mocks.grClientMock.EXPECT().Create(ObjectMatcher(basicGR)).Return(nil, errExpected)
+				mocks.grClientMock.EXPECT().Create(ObjectMatcher(readGR)).Return(readGR, nil)
+			},
+		},
 	}
 	for _, tt := range tests {
 		test := tt
@@ -284,6 +297,9 @@ func(toUpdate *v3.GlobalRole) (*v3.GlobalRole, error) {
 			} else {
 				require.NoError(t, err, "Unexpected error while reconciling roles.")
 			}
+			if test.wantFailed != nil {
+				require.Equal(t, test.wantFailed, newRoleKindStatus(err).Failed, "Unexpected roles failed to be reconciled.")
+			}
 		})
 	}
 }
@@ -0,0 +1,31 @@
+package management
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+const supportReadOnlyRoleName = "support-read-only"
+
+// addSupportReadOnlyRole adds a cluster role template granting read access to the resources of the given CRDs. The rules are
+// generated from the CRDs Rancher installs rather than listed, so that the role covers the CRDs added in later
+// versions. CRDs of tokens are excluded, as their objects hold credentials.
+func addSupportReadOnlyRole(rb *roleBuilder, crdNames []string) *roleBuilder {
+	resourcesByGroup := map[string][]string{}
+	for _, crdName := range crdNames {
+		resource, group, ok := strings.Cut(crdName, ".")
+		if !ok || strings.HasSuffix(resource, "tokens") {
+			continue
+		}
+		resourcesByGroup[group] = append(resourcesByGroup[group], resource)
+	}
+
+	role := rb.addRoleTemplate("Support Read-only", supportReadOnlyRoleName, "cluster", false, false, false)
+	for _, group := range slices.Sorted(maps.Keys(resourcesByGroup)) {
+		resources := resourcesByGroup[group]
+		slices.Sort(resources)
+		role.addRule().apiGroups(group).resources(slices.Compact(resources)...).verbs("get", "list", "watch")
+	}
+	return role
+}
@@ -0,0 +1,56 @@
+package management
+
+import (
+	"testing"
+
+	"github.com/rancher/rancher/pkg/crds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestAddSupportReadOnlyRole(t *testing.T) {
+	rb := newRoleBuilder()
+	rb.addRoleTemplate("Cluster Owner", "cluster-owner", "cluster", false, false, true).
+		addRule().apiGroups("*").resources("*").verbs("*")
+
+	role := addSupportReadOnlyRole(rb, []string{
+		"settings.management.cattle.io",
+		"clusters.provisioning.cattle.io",
+		"clusters.management.cattle.io",
+		"tokens.management.cattle.io",
+		"clusterregistrationtokens.management.cattle.io",
+		"settings.management.cattle.io",
+		"invalid",
+	})
+
+	assert.Equal(t, rb, role.first())
+	assert.Equal(t, supportReadOnlyRoleName, role.name)
+	assert.True(t, role.builtin)
+	assert.Equal(t, "cluster", role.context)
+	assert.False(t, role.administrative)
+	assert.Equal(t, []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"management.cattle.io"},
+			Resources: []string{"clusters", "settings"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+		{
+			APIGroups: []string{"provisioning.cattle.io"},
+			Resources: []string{"clusters"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+	}, role.policyRules())
+}
+
+func TestAddSupportReadOnlyRoleRequiredCRDs(t *testing.T) {
+	role := addSupportReadOnlyRole(newRoleBuilder(), crds.RequiredCRDs())
+
+	rules := role.policyRules()
+	require.NotEmpty(t, rules)
+	for _, rule := range rules {
+		assert.Equal(t, []string{"get", "list", "watch"}, rule.Verbs)
+		assert.NotContains(t, rule.Resources, "tokens")
+		assert.NotContains(t, rule.Resources, "secrets")
+	}
+}
@@ -0,0 +1,50 @@
+package expansion
+
+import (
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/condition"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// ClusterExpansion holds the expansion methods for management clusters.
+type ClusterExpansion interface {
+	// UpdateCondition sets the status, reason and message of a condition of the named cluster. The cluster is only
+	// updated if the condition changed.
+	UpdateCondition(name string, cond condition.Cond, status corev1.ConditionStatus, reason, message string) (*v3.Cluster, error)
+}
+
+// Clusters returns the expansion methods for the given management cluster client.
+func Clusters(client mgmtcontrollers.ClusterClient) ClusterExpansion {
+	return &clusters{client: client}
+}
+
+type clusters struct {
+	client mgmtcontrollers.ClusterClient
+}
+
+func (c *clusters) UpdateCondition(name string, cond condition.Cond, status corev1.ConditionStatus, reason, message string) (*v3.Cluster, error) {
+	var cluster *v3.Cluster
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		cluster, err = c.client.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if cond.GetStatus(cluster) == string(status) && cond.GetReason(cluster) == reason && cond.GetMessage(cluster) == message {
+			return nil
+		}
+
+		cluster = cluster.DeepCopy()
+		cond.SetStatus(cluster, string(status))
+		cond.Reason(cluster, reason)
+		cond.Message(cluster, message)
+		// The management cluster CRD has no status subresource.
+		cluster, err = c.client.Update(cluster)
+		return err
+	})
+	return cluster, err
+}
@@ -0,0 +1,48 @@
+package expansion
+
+import (
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/capr"
+	rkecontrollers "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// CustomMachineExpansion holds the expansion methods for custom machines.
+type CustomMachineExpansion interface {
+	// MarkReady sets the Ready condition and the ready status field of the named custom machine. Marking a ready
+	// machine as ready is a no-op.
+	MarkReady(namespace, name string) (*rkev1.CustomMachine, error)
+}
+
+// CustomMachines returns the expansion methods for the given custom machine client.
+func CustomMachines(client rkecontrollers.CustomMachineClient) CustomMachineExpansion {
+	return &customMachines{client: client}
+}
+
+type customMachines struct {
+	client rkecontrollers.CustomMachineClient
+}
+
+func (c *customMachines) MarkReady(namespace, name string) (*rkev1.CustomMachine, error) {
+	var machine *rkev1.CustomMachine
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		machine, err = c.client.Get(namespace, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if machine.Status.Ready && capr.Ready.IsTrue(machine) {
+			return nil
+		}
+
+		machine = machine.DeepCopy()
+		machine.Status.Ready = true
+		capr.Ready.SetStatus(machine, "True")
+		capr.Ready.Message(machine, "")
+		machine, err = c.client.UpdateStatus(machine)
+		return err
+	})
+	return machine, err
+}
@@ -0,0 +1,8 @@
+// Package expansion provides expansion methods for the generated clients, implementing common operations which
+// need several steps, and need to be retried on conflicts.
+//
+// The generated clients are regenerated from scratch, which rules out the client-gen expansion files. The methods
+// are instead provided by wrappers around the generated clients, e.g.
+//
+//	expansion.Clusters(wranglerContext.Mgmt.Cluster()).UpdateCondition(name, v3.ClusterConditionReady, ...)
+package expansion
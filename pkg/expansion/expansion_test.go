@@ -0,0 +1,85 @@
+package expansion
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/capr"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+var errConflict = apierrors.NewConflict(schema.GroupResource{}, "test", nil)
+
+func TestClustersUpdateCondition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := fake.NewMockNonNamespacedClientInterface[*v3.Cluster, *v3.ClusterList](ctrl)
+
+	client.EXPECT().Get("c-1", metav1.GetOptions{}).Return(&v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "c-1"}}, nil).Times(2)
+	client.EXPECT().Update(gomock.Any()).Return(nil, errConflict)
+	client.EXPECT().Update(gomock.Any()).DoAndReturn(func(cluster *v3.Cluster) (*v3.Cluster, error) {
+		assert.True(t, v3.ClusterConditionReady.IsFalse(cluster))
+		assert.Equal(t, "Unavailable", v3.ClusterConditionReady.GetReason(cluster))
+		assert.Equal(t, "agent disconnected", v3.ClusterConditionReady.GetMessage(cluster))
+		return cluster, nil
+	})
+
+	cluster, err := Clusters(client).UpdateCondition("c-1", v3.ClusterConditionReady, corev1.ConditionFalse, "Unavailable", "agent disconnected")
+	require.NoError(t, err)
+	assert.Equal(t, "c-1", cluster.Name)
+
+	// The condition is already set, no update is needed.
+	client.EXPECT().Get("c-1", metav1.GetOptions{}).Return(cluster, nil)
+	_, err = Clusters(client).UpdateCondition("c-1", v3.ClusterConditionReady, corev1.ConditionFalse, "Unavailable", "agent disconnected")
+	require.NoError(t, err)
+}
+
+func TestTokensRevoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := fake.NewMockNonNamespacedClientInterface[*v3.Token, *v3.TokenList](ctrl)
+
+	client.EXPECT().Get("token-1", metav1.GetOptions{}).Return(&v3.Token{ObjectMeta: metav1.ObjectMeta{Name: "token-1"}}, nil).Times(2)
+	client.EXPECT().Update(gomock.Any()).Return(nil, errConflict)
+	client.EXPECT().Update(gomock.Any()).DoAndReturn(func(token *v3.Token) (*v3.Token, error) {
+		return token, nil
+	})
+
+	token, err := Tokens(client).Revoke("token-1")
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(false), token.Enabled)
+
+	// Revoking a disabled token is a no-op.
+	client.EXPECT().Get("token-1", metav1.GetOptions{}).Return(token, nil)
+	_, err = Tokens(client).Revoke("token-1")
+	require.NoError(t, err)
+}
+
+func TestCustomMachinesMarkReady(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := fake.NewMockClientInterface[*rkev1.CustomMachine, *rkev1.CustomMachineList](ctrl)
+
+	client.EXPECT().Get("fleet-default", "machine", metav1.GetOptions{}).Return(&rkev1.CustomMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "fleet-default"},
+	}, nil).Times(2)
+	client.EXPECT().UpdateStatus(gomock.Any()).Return(nil, errConflict)
+	client.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(machine *rkev1.CustomMachine) (*rkev1.CustomMachine, error) {
+		return machine, nil
+	})
+
+	machine, err := CustomMachines(client).MarkReady("fleet-default", "machine")
+	require.NoError(t, err)
+	assert.True(t, machine.Status.Ready)
+	assert.True(t, capr.Ready.IsTrue(machine))
+
+	client.EXPECT().Get("fleet-default", "machine", metav1.GetOptions{}).Return(machine, nil)
+	_, err = CustomMachines(client).MarkReady("fleet-default", "machine")
+	require.NoError(t, err)
+}
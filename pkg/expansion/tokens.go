@@ -0,0 +1,46 @@
+package expansion
+
+import (
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+)
+
+// TokenExpansion holds the expansion methods for v3 tokens.
+type TokenExpansion interface {
+	// Revoke disables the named token. The token is kept, so that it can be re-enabled, but can no longer be used to
+	// authenticate. Revoking a disabled token is a no-op.
+	Revoke(name string) (*v3.Token, error)
+}
+
+// Tokens returns the expansion methods for the given v3 token client.
+func Tokens(client mgmtcontrollers.TokenClient) TokenExpansion {
+	return &tokens{client: client}
+}
+
+type tokens struct {
+	client mgmtcontrollers.TokenClient
+}
+
+func (t *tokens) Revoke(name string) (*v3.Token, error) {
+	var token *v3.Token
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		token, err = t.client.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if token.Enabled != nil && !*token.Enabled {
+			return nil
+		}
+
+		token = token.DeepCopy()
+		token.Enabled = ptr.To(false)
+		token, err = t.client.Update(token)
+		return err
+	})
+	return token, err
+}
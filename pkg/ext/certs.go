@@ -14,6 +14,7 @@
 	"sync"
 	"time"
 
+	"github.com/rancher/rancher/pkg/metrics"
 	wranglerapiregistrationv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/apiregistration.k8s.io/v1"
 	wranglercorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
 	"github.com/sirupsen/logrus"
@@ -283,11 +284,29 @@ func (p *rotatingSNIProvider) createOrUpdateCerts(secret *corev1.Secret) error {
 		logrus.Info("updated imperative api cert secret")
 	}
 
+	return p.setContent(cert, key)
+}
+
+// setContent replaces the served cert and key and notifies the listeners. The extension API server reloads them for
+// new TLS handshakes without closing the existing connections. Nothing happens if the content didn't change, which is
+// the case when the watch reports the update of the secret made by the provider itself.
+func (p *rotatingSNIProvider) setContent(cert, key []byte) error {
+	keyPair, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		return fmt.Errorf("failed to parse cert: %w", err)
+	}
+
 	p.contentMu.Lock()
+	if bytes.Equal(p.cert, cert) && bytes.Equal(p.key, key) {
+		p.contentMu.Unlock()
+		return nil
+	}
 	p.cert = cert
 	p.key = key
 	p.contentMu.Unlock()
 
+	metrics.SetServingCertificateExpiry(p.name, keyPair.Leaf.NotAfter)
+	metrics.IncServingCertificateReloads(p.name)
 	p.notify()
 
 	return nil
@@ -358,12 +377,9 @@ func (p *rotatingSNIProvider) handleCertEvent(event watch.Event) error {
 			return fmt.Errorf("secret does not contain field '%s'", corev1.TLSPrivateKeyKey)
 		}
 
-		p.contentMu.Lock()
-		p.cert = certData
-		p.key = keyData
-		p.contentMu.Unlock()
-
-		p.notify()
+		if err := p.setContent(certData, keyData); err != nil {
+			return err
+		}
 	case watch.Deleted:
 		if err := p.createOrUpdateCerts(nil); err != nil {
 			return err
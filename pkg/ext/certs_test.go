@@ -289,3 +289,28 @@ func TestRotatingSNIProviderWithExistingExpiringSecret(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, secret)
 }
+
+func TestRotatingSNIProviderSetContent(t *testing.T) {
+	provider, _, _ := setup(t)
+
+	notified := 0
+	provider.AddListener(listenerFunc(func() { notified++ }))
+
+	cert, key, err := GenerateSelfSignedCertKeyWithOpts(provider.sninames[0], time.Hour)
+	assert.NoError(t, err)
+
+	assert.NoError(t, provider.setContent(cert, key))
+	assert.Equal(t, 1, notified)
+	gotCert, gotKey := provider.CurrentCertKeyContent()
+	assert.Equal(t, cert, gotCert)
+	assert.Equal(t, key, gotKey)
+
+	// the watch reports the secret updated by the provider itself
+	assert.NoError(t, provider.setContent(cert, key))
+	assert.Equal(t, 1, notified, "listeners are not notified if the content didn't change")
+
+	assert.Error(t, provider.setContent([]byte("invalid"), key))
+	assert.Equal(t, 1, notified)
+	gotCert, _ = provider.CurrentCertKeyContent()
+	assert.Equal(t, cert, gotCert, "invalid content is not served")
+}
@@ -9,6 +9,7 @@
 	"strings"
 	"time"
 
+	"github.com/rancher/rancher/pkg/auth/decisionlog"
 	extstores "github.com/rancher/rancher/pkg/ext/stores"
 	"github.com/rancher/rancher/pkg/features"
 	"github.com/rancher/rancher/pkg/wrangler"
@@ -174,9 +175,9 @@ func NewExtensionAPIServer(ctx context.Context, wranglerContext *wrangler.Contex
 		for {
 			if err := sniProvider.Run(ctx.Done()); err != nil {
 				logrus.Errorf("sni provider failed: %s", err)
-				if ctx.Err() != nil {
-					return
-				}
+			}
+			if ctx.Err() != nil {
+				return
 			}
 			time.Sleep(10 * time.Second)
 		}
@@ -202,11 +203,34 @@ func NewExtensionAPIServer(ctx context.Context, wranglerContext *wrangler.Contex
 
 	authenticators = append(authenticators, defaultAuthenticator)
 
-	scheme := wrangler.Scheme
-
 	authenticator := steveext.NewUnionAuthenticator(authenticators...)
 
-	aslAuthorizer := steveext.NewAccessSetAuthorizer(wranglerContext.ASL)
+	aslAuthorizer := decisionlog.NewAuthorizer(steveext.NewAccessSetAuthorizer(wranglerContext.ASL), decisionlog.NewExplainer(wranglerContext), "extension API server")
+	gate := NewFeatureGate()
+	extensionAPIServer, err := NewAPIServer(authenticator, aslAuthorizer, gate, ln, additionalSniProviders)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = extstores.InstallStores(ctx, extensionAPIServer, wranglerContext, wrangler.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to install stores: %w", err)
+	}
+
+	return newInstrumentedAPIServer(extensionAPIServer, gate), nil
+}
+
+// NewAPIServer creates an extension API server serving the ext.cattle.io types of Rancher, without any store installed.
+// Requests to the resources disabled by the feature gate and to paths other than the API and OpenAPI ones are denied,
+// and the other requests are delegated to the given authorizer.
+func NewAPIServer(
+	authn authenticator.Request,
+	delegate authorizer.Authorizer,
+	gate *FeatureGate,
+	ln net.Listener,
+	sniCerts []dynamiccertificates.SNICertKeyContentProvider,
+) (*steveext.ExtensionAPIServer, error) {
+	scheme := wrangler.Scheme
+
 	codecs := serializer.NewCodecFactory(scheme)
 	extOpts := steveext.ExtensionAPIServerOptions{
 		Listener:              ln,
@@ -223,10 +247,14 @@ func NewExtensionAPIServer(ctx context.Context, wranglerContext *wrangler.Contex
 			// TODO(frameworks): verify this needs to be added for openAPI stuff
 			"com.github.rancher.rancher.pkg.apis.scc.cattle.io.v1": "io.cattle.scc.v1", // assume this is needed for my new CRDs too?
 		},
-		Authenticator: authenticator,
+		Authenticator: authn,
 		Authorizer: authorizer.AuthorizerFunc(func(ctx context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+			if decision, reason, err := gate.Authorize(ctx, a); decision != authorizer.DecisionNoOpinion || err != nil {
+				return decision, reason, err
+			}
+
 			if a.IsResourceRequest() {
-				return aslAuthorizer.Authorize(ctx, a)
+				return delegate.Authorize(ctx, a)
 			}
 
 			// An API server has a lot more routes exposed but for now
@@ -245,20 +273,15 @@ func NewExtensionAPIServer(ctx context.Context, wranglerContext *wrangler.Contex
 				return authorizer.DecisionDeny, "only /api, /apis, /openapi/v2 and /openapi/v3 supported", nil
 			}
 
-			return aslAuthorizer.Authorize(ctx, a)
+			return delegate.Authorize(ctx, a)
 		}),
-		SNICerts: additionalSniProviders,
+		SNICerts: sniCerts,
 	}
 
 	extensionAPIServer, err := steveext.NewExtensionAPIServer(scheme, codecs, extOpts)
 	if err != nil {
 		return nil, fmt.Errorf("new extension API server: %w", err)
 	}
-
-	if err = extstores.InstallStores(extensionAPIServer, wranglerContext, scheme); err != nil {
-		return nil, fmt.Errorf("failed to install stores: %w", err)
-	}
-
 	return extensionAPIServer, nil
 }
 
@@ -0,0 +1,105 @@
+// Package exttest provides an in-process extension API server to run HTTP level tests of the stores of pkg/ext, e.g.
+// of content negotiation, discovery and authorization, without a running Rancher or kube-apiserver.
+package exttest
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	extv1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/ext"
+	"github.com/rancher/rancher/pkg/wrangler"
+	steveext "github.com/rancher/steve/pkg/ext"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// Server is an extension API server with the stores installed by a test. Requests are served in-process with
+// [Server.Do], as the user of the request.
+type Server struct {
+	server *steveext.ExtensionAPIServer
+}
+
+// Options are the options of a Server.
+type Options struct {
+	// Authorizer authorizes the requests allowed by the extension API server, e.g. to check the RBAC of a store.
+	// It defaults to allowing all requests.
+	Authorizer authorizer.Authorizer
+	// Install installs the stores under test.
+	Install func(server *steveext.ExtensionAPIServer) error
+}
+
+// NewServer creates and runs an extension API server, which is stopped at the end of the test.
+func NewServer(t testing.TB, opts Options) *Server {
+	t.Helper()
+
+	authz := opts.Authorizer
+	if authz == nil {
+		authz = authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+			return authorizer.DecisionAllow, "", nil
+		})
+	}
+
+	// The server requires a listener, even though the requests of the tests don't go through it.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to create listener: %v", err)
+	}
+
+	steveext.AddToScheme(wrangler.Scheme)
+	extv1.AddToScheme(wrangler.Scheme)
+
+	server, err := ext.NewAPIServer(authenticator.RequestFunc(userFromContext), authz, ext.NewFeatureGate(), ln, nil)
+	if err != nil {
+		t.Fatalf("failed to create extension API server: %v", err)
+	}
+	if opts.Install != nil {
+		if err := opts.Install(server); err != nil {
+			t.Fatalf("failed to install stores: %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	if err := server.Run(ctx); err != nil {
+		t.Fatalf("failed to run extension API server: %v", err)
+	}
+
+	return &Server{server: server}
+}
+
+// Do serves the request as the given user, or as an unauthenticated user if it's nil, and returns the response.
+func (s *Server) Do(req *http.Request, reqUser user.Info) *httptest.ResponseRecorder {
+	if reqUser != nil {
+		req = req.WithContext(request.WithUser(req.Context(), reqUser))
+	}
+
+	rec := httptest.NewRecorder()
+	s.server.ServeHTTP(rec, req)
+	return rec
+}
+
+// NewRequest returns a request to the given path of the extension API server with a JSON body, if any.
+func NewRequest(method, path string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req
+}
+
+// userFromContext authenticates requests as the user set by [Server.Do], like the extension API server of Rancher
+// does for the requests proxied by the authentication middleware.
+func userFromContext(req *http.Request) (*authenticator.Response, bool, error) {
+	reqUser, ok := request.UserFrom(req.Context())
+	if !ok {
+		return nil, false, nil
+	}
+	return &authenticator.Response{User: reqUser}, true, nil
+}
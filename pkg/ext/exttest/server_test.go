@@ -0,0 +1,81 @@
+package exttest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	extv1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/ext/stores/selfuser"
+	steveext "github.com/rancher/steve/pkg/ext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+func newSelfUserServer(t *testing.T, authz authorizer.Authorizer) *Server {
+	return NewServer(t, Options{
+		Authorizer: authz,
+		Install: func(server *steveext.ExtensionAPIServer) error {
+			return server.Install(extv1.SelfUserResourceName, selfuser.GVK, selfuser.New())
+		},
+	})
+}
+
+func TestServerDiscovery(t *testing.T) {
+	s := newSelfUserServer(t, nil)
+
+	rec := s.Do(NewRequest(http.MethodGet, "/apis/ext.cattle.io/v1", nil), &user.DefaultInfo{Name: "admin"})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resources metav1.APIResourceList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resources))
+	assert.Equal(t, "ext.cattle.io/v1", resources.GroupVersion)
+	require.Len(t, resources.APIResources, 1)
+	assert.Equal(t, extv1.SelfUserResourceName, resources.APIResources[0].Name)
+	assert.Equal(t, "SelfUser", resources.APIResources[0].Kind)
+}
+
+func TestServerContentNegotiation(t *testing.T) {
+	s := newSelfUserServer(t, nil)
+	body := []byte(`{"apiVersion":"ext.cattle.io/v1","kind":"SelfUser"}`)
+
+	req := NewRequest(http.MethodPost, "/apis/ext.cattle.io/v1/selfusers", body)
+	rec := s.Do(req, &user.DefaultInfo{Name: "u-abc"})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var created extv1.SelfUser
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.Equal(t, "u-abc", created.Status.UserID)
+
+	req = NewRequest(http.MethodPost, "/apis/ext.cattle.io/v1/selfusers", body)
+	req.Header.Set("Accept", "application/yaml")
+	rec = s.Do(req, &user.DefaultInfo{Name: "u-abc"})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Equal(t, "application/yaml", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "userID: u-abc")
+}
+
+func TestServerAuthorization(t *testing.T) {
+	s := newSelfUserServer(t, authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+		if a.GetUser().GetName() == "u-denied" {
+			return authorizer.DecisionDeny, "denied", nil
+		}
+		return authorizer.DecisionAllow, "", nil
+	}))
+	body := []byte(`{"apiVersion":"ext.cattle.io/v1","kind":"SelfUser"}`)
+
+	rec := s.Do(NewRequest(http.MethodPost, "/apis/ext.cattle.io/v1/selfusers", body), &user.DefaultInfo{Name: "u-denied"})
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	rec = s.Do(NewRequest(http.MethodPost, "/apis/ext.cattle.io/v1/selfusers", body), nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+
+	// only the API and OpenAPI paths are served, whatever the delegate authorizer decides
+	rec = s.Do(NewRequest(http.MethodGet, "/version", nil), &user.DefaultInfo{Name: "admin"})
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+}
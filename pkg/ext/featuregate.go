@@ -0,0 +1,288 @@
+package ext
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	extv1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/ext/stores/tokens"
+	"github.com/rancher/rancher/pkg/features"
+	"github.com/sirupsen/logrus"
+	apidiscoveryv2 "k8s.io/api/apidiscovery/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+// featureGatedResources are the ext.cattle.io resources that are only served while their feature is enabled.
+var featureGatedResources = map[string]*features.Feature{
+	tokens.PluralName:              features.ExtTokens,
+	extv1.KubeconfigResourceName:   features.ExtKubeconfigs,
+	extv1.UserActivityResourceName: features.ExtUserActivity,
+}
+
+// groupVersionPath is the path of the API of the ext.cattle.io resources.
+var groupVersionPath = "/apis/" + extv1.SchemeGroupVersion.String()
+
+// aggregatedDiscoveryJSON is the media type of the aggregated discovery served as JSON, see
+// [apidiscoveryv2.APIGroupDiscoveryList].
+const aggregatedDiscoveryJSON = "application/json;g=apidiscovery.k8s.io;v=v2;as=APIGroupDiscoveryList"
+
+// FeatureGate hides the resources of disabled features. The stores of these resources are always installed, as they
+// can't be removed from a running extension API server, and the gate is kept up to date by watching the features so
+// that the resources can be toggled at runtime.
+type FeatureGate struct {
+	mu sync.RWMutex
+	// disabled maps the name of the disabled resources to the name of their feature
+	disabled map[string]string
+}
+
+// NewFeatureGate returns the feature gate of the ext.cattle.io resources of Rancher. The same gate is meant to be used
+// by the authorizer and the handler of the extension API server.
+func NewFeatureGate() *FeatureGate {
+	return newFeatureGate(featureGatedResources)
+}
+
+func newFeatureGate(resources map[string]*features.Feature) *FeatureGate {
+	g := &FeatureGate{disabled: map[string]string{}}
+	for resource, feature := range resources {
+		resource, feature := resource, feature
+		feature.Watch(func(enabled bool) {
+			g.set(resource, feature.Name(), enabled)
+		})
+	}
+	return g
+}
+
+func (g *FeatureGate) set(resource, featureName string, enabled bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if enabled {
+		delete(g.disabled, resource)
+		logrus.Infof("Feature %s is enabled, serving %s.%s", featureName, resource, extv1.SchemeGroupVersion.Group)
+		return
+	}
+	g.disabled[resource] = featureName
+	logrus.Infof("Feature %s is disabled, not serving %s.%s", featureName, resource, extv1.SchemeGroupVersion.Group)
+}
+
+// disabledFeature returns the name of the feature of the resource if it's disabled.
+func (g *FeatureGate) disabledFeature(resource string) (string, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	featureName, ok := g.disabled[resource]
+	return featureName, ok
+}
+
+func (g *FeatureGate) anyDisabled() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return len(g.disabled) > 0
+}
+
+// Authorize denies the resource requests to the resources of disabled features and has no opinion about any other request.
+func (g *FeatureGate) Authorize(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if !a.IsResourceRequest() || a.GetAPIGroup() != extv1.SchemeGroupVersion.Group {
+		return authorizer.DecisionNoOpinion, "", nil
+	}
+
+	if featureName, ok := g.disabledFeature(a.GetResource()); ok {
+		return authorizer.DecisionDeny, fmt.Sprintf("feature %s is disabled", featureName), nil
+	}
+	return authorizer.DecisionNoOpinion, "", nil
+}
+
+// Handler serves the extension API server as if the resources of disabled features weren't installed: requests to
+// them are answered with NotFound, and they are left out of the discovery of the ext.cattle.io API.
+func (g *FeatureGate) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !g.anyDisabled() {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		path := strings.TrimSuffix(req.URL.Path, "/")
+		switch {
+		case path == "/apis":
+			g.serveAggregatedDiscovery(w, req, next)
+		case path == groupVersionPath:
+			g.serveDiscovery(w, req, next)
+		case strings.HasPrefix(path, groupVersionPath+"/"):
+			if _, ok := g.disabledFeature(resourceOfPath(strings.TrimPrefix(path, groupVersionPath+"/"))); ok {
+				writeNotFound(w)
+				return
+			}
+			next.ServeHTTP(w, req)
+		default:
+			next.ServeHTTP(w, req)
+		}
+	})
+}
+
+// resourceOfPath returns the resource of a path relative to the API of the ext.cattle.io resources.
+func resourceOfPath(path string) string {
+	segments := strings.Split(path, "/")
+	if segments[0] == "watch" {
+		segments = segments[1:]
+	}
+	if len(segments) > 0 && segments[0] == "namespaces" {
+		if len(segments) < 3 {
+			return ""
+		}
+		segments = segments[2:]
+	}
+	if len(segments) == 0 {
+		return ""
+	}
+	return segments[0]
+}
+
+// serveDiscovery serves the discovery of the ext.cattle.io API without the resources of disabled features and their
+// subresources.
+func (g *FeatureGate) serveDiscovery(w http.ResponseWriter, req *http.Request, next http.Handler) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept", "application/json")
+	buf := &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+	next.ServeHTTP(buf, req)
+
+	body := buf.body.Bytes()
+	if buf.status == http.StatusOK {
+		var resources metav1.APIResourceList
+		if err := json.Unmarshal(body, &resources); err != nil {
+			logrus.Errorf("[ext] Error decoding the discovery of %s: %v", groupVersionPath, err)
+		} else {
+			resources.APIResources = slices.DeleteFunc(resources.APIResources, func(resource metav1.APIResource) bool {
+				name, _, _ := strings.Cut(resource.Name, "/")
+				_, disabled := g.disabledFeature(name)
+				return disabled
+			})
+			if filtered, err := json.Marshal(resources); err != nil {
+				logrus.Errorf("[ext] Error encoding the discovery of %s: %v", groupVersionPath, err)
+			} else {
+				body = filtered
+			}
+		}
+	}
+
+	writeBuffered(w, buf, body, groupVersionPath)
+}
+
+// serveAggregatedDiscovery serves the aggregated discovery of all the groups without the resources of disabled
+// features. It is served as JSON to be filtered, clients which don't accept the aggregated discovery fall back to the
+// discovery of each group version, filtered by serveDiscovery.
+func (g *FeatureGate) serveAggregatedDiscovery(w http.ResponseWriter, req *http.Request, next http.Handler) {
+	req = req.Clone(req.Context())
+	if acceptsAggregatedDiscovery(req) {
+		req.Header.Set("Accept", aggregatedDiscoveryJSON+",application/json")
+	} else {
+		req.Header.Set("Accept", "application/json")
+	}
+	// The ETag of the served discovery doesn't match the filtered one.
+	req.Header.Del("If-None-Match")
+	buf := &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+	next.ServeHTTP(buf, req)
+
+	body := buf.body.Bytes()
+	if buf.status == http.StatusOK && strings.Contains(buf.header.Get("Content-Type"), "as=APIGroupDiscoveryList") {
+		buf.header.Del("ETag")
+		var discovery apidiscoveryv2.APIGroupDiscoveryList
+		if err := json.Unmarshal(body, &discovery); err != nil {
+			logrus.Errorf("[ext] Error decoding the aggregated discovery: %v", err)
+		} else {
+			for i := range discovery.Items {
+				if discovery.Items[i].Name != extv1.SchemeGroupVersion.Group {
+					continue
+				}
+				for j := range discovery.Items[i].Versions {
+					version := &discovery.Items[i].Versions[j]
+					if version.Version != extv1.SchemeGroupVersion.Version {
+						continue
+					}
+					version.Resources = slices.DeleteFunc(version.Resources, func(resource apidiscoveryv2.APIResourceDiscovery) bool {
+						_, disabled := g.disabledFeature(resource.Resource)
+						return disabled
+					})
+				}
+			}
+			if filtered, err := json.Marshal(discovery); err != nil {
+				logrus.Errorf("[ext] Error encoding the aggregated discovery: %v", err)
+			} else {
+				body = filtered
+			}
+		}
+	}
+
+	writeBuffered(w, buf, body, "/apis")
+}
+
+// acceptsAggregatedDiscovery returns true if the request accepts the v2 aggregated discovery, in any encoding.
+func acceptsAggregatedDiscovery(req *http.Request) bool {
+	for _, mediaRange := range strings.Split(req.Header.Get("Accept"), ",") {
+		params := map[string]string{}
+		for _, param := range strings.Split(mediaRange, ";")[1:] {
+			key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			params[key] = value
+		}
+		if params["g"] == "apidiscovery.k8s.io" && params["v"] == "v2" && params["as"] == "APIGroupDiscoveryList" {
+			return true
+		}
+	}
+	return false
+}
+
+// writeBuffered writes the buffered response with the given body to the path.
+func writeBuffered(w http.ResponseWriter, buf *bufferedResponse, body []byte, path string) {
+	for key, values := range buf.header {
+		if key != "Content-Length" {
+			w.Header()[key] = values
+		}
+	}
+	w.WriteHeader(buf.status)
+	if _, err := w.Write(body); err != nil {
+		logrus.Errorf("[ext] Error writing the discovery of %s: %v", path, err)
+	}
+}
+
+// writeNotFound answers like the extension API server does for the paths it doesn't serve.
+func writeNotFound(w http.ResponseWriter) {
+	status := metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  "the server could not find the requested resource",
+		Reason:   metav1.StatusReasonNotFound,
+		Details:  &metav1.StatusDetails{},
+		Code:     http.StatusNotFound,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		logrus.Errorf("[ext] Error writing a NotFound response: %v", err)
+	}
+}
+
+// bufferedResponse is a response held in memory.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *bufferedResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *bufferedResponse) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *bufferedResponse) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
@@ -0,0 +1,175 @@
+package ext
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/features"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apidiscoveryv2 "k8s.io/api/apidiscovery/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+func TestFeatureGate(t *testing.T) {
+	feature := features.GetFeatureByName(features.ExtTokens.Name())
+	require.NotNil(t, feature)
+	initial := feature.Enabled()
+	t.Cleanup(func() { feature.Set(initial) })
+
+	feature.Set(false)
+	gate := newFeatureGate(map[string]*features.Feature{"tokens": feature})
+
+	tokensRequest := authorizer.AttributesRecord{
+		ResourceRequest: true,
+		APIGroup:        "ext.cattle.io",
+		Resource:        "tokens",
+	}
+	otherRequest := authorizer.AttributesRecord{
+		ResourceRequest: true,
+		APIGroup:        "ext.cattle.io",
+		Resource:        "useractivities",
+	}
+
+	decision, reason, err := gate.Authorize(context.Background(), tokensRequest)
+	require.NoError(t, err)
+	assert.Equal(t, authorizer.DecisionDeny, decision)
+	assert.Equal(t, "feature ext-tokens is disabled", reason)
+
+	decision, _, err = gate.Authorize(context.Background(), otherRequest)
+	require.NoError(t, err)
+	assert.Equal(t, authorizer.DecisionNoOpinion, decision)
+
+	feature.Set(true)
+	decision, _, err = gate.Authorize(context.Background(), tokensRequest)
+	require.NoError(t, err)
+	assert.Equal(t, authorizer.DecisionNoOpinion, decision)
+}
+
+func TestFeatureGateHandler(t *testing.T) {
+	feature := features.GetFeatureByName(features.ExtTokens.Name())
+	require.NotNil(t, feature)
+	initial := feature.Enabled()
+	t.Cleanup(func() { feature.Set(initial) })
+
+	feature.Set(false)
+	gate := newFeatureGate(map[string]*features.Feature{"tokens": feature})
+
+	discovery := metav1.APIResourceList{
+		GroupVersion: "ext.cattle.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "tokens", Kind: "Token"},
+			{Name: "tokens/status", Kind: "Token"},
+			{Name: "useractivities", Kind: "UserActivity"},
+		},
+	}
+	aggregated := apidiscoveryv2.APIGroupDiscoveryList{
+		Items: []apidiscoveryv2.APIGroupDiscovery{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "ext.cattle.io"},
+				Versions: []apidiscoveryv2.APIVersionDiscovery{{
+					Version: "v1",
+					Resources: []apidiscoveryv2.APIResourceDiscovery{
+						{Resource: "tokens", Subresources: []apidiscoveryv2.APISubresourceDiscovery{{Subresource: "status"}}},
+						{Resource: "useractivities"},
+					},
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "other.cattle.io"},
+				Versions: []apidiscoveryv2.APIVersionDiscovery{{
+					Version:   "v1",
+					Resources: []apidiscoveryv2.APIResourceDiscovery{{Resource: "tokens"}},
+				}},
+			},
+		},
+	}
+	var served, accept string
+	handler := gate.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		served, accept = req.URL.Path, req.Header.Get("Accept")
+		switch {
+		case req.URL.Path == "/apis/ext.cattle.io/v1":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(discovery)
+		case req.URL.Path == "/apis" && strings.HasPrefix(accept, aggregatedDiscoveryJSON):
+			w.Header().Set("Content-Type", aggregatedDiscoveryJSON)
+			w.Header().Set("ETag", `"1234"`)
+			json.NewEncoder(w).Encode(aggregated)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+		}
+	}))
+	serve := func(path, accept string) *httptest.ResponseRecorder {
+		served = ""
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept", accept)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("requests to disabled resources are not found", func(t *testing.T) {
+		for _, path := range []string{
+			"/apis/ext.cattle.io/v1/tokens",
+			"/apis/ext.cattle.io/v1/tokens/token-1/status",
+			"/apis/ext.cattle.io/v1/watch/tokens",
+			"/apis/ext.cattle.io/v1/namespaces/default/tokens",
+		} {
+			rec := serve(path, "application/json")
+			assert.Equal(t, http.StatusNotFound, rec.Code, path)
+			assert.Empty(t, served, path)
+		}
+	})
+
+	t.Run("requests to other resources are served", func(t *testing.T) {
+		rec := serve("/apis/ext.cattle.io/v1/useractivities/ua-1", "application/json")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "/apis/ext.cattle.io/v1/useractivities/ua-1", served)
+	})
+
+	t.Run("disabled resources are left out of the discovery", func(t *testing.T) {
+		rec := serve("/apis/ext.cattle.io/v1", "application/vnd.kubernetes.protobuf,application/json")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/json", accept)
+		var got metav1.APIResourceList
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, []metav1.APIResource{{Name: "useractivities", Kind: "UserActivity"}}, got.APIResources)
+	})
+
+	t.Run("disabled resources are left out of the aggregated discovery", func(t *testing.T) {
+		rec := serve("/apis", "application/vnd.kubernetes.protobuf;g=apidiscovery.k8s.io;v=v2;as=APIGroupDiscoveryList,application/json")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, aggregatedDiscoveryJSON+",application/json", accept)
+		assert.Equal(t, aggregatedDiscoveryJSON, rec.Header().Get("Content-Type"))
+		assert.Empty(t, rec.Header().Get("ETag"))
+		var got apidiscoveryv2.APIGroupDiscoveryList
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, []apidiscoveryv2.APIResourceDiscovery{{Resource: "useractivities"}}, got.Items[0].Versions[0].Resources)
+		assert.Equal(t, aggregated.Items[1], got.Items[1])
+	})
+
+	t.Run("clients not accepting the aggregated discovery are served the groups", func(t *testing.T) {
+		serve("/apis", "application/vnd.kubernetes.protobuf,application/json")
+		assert.Equal(t, "/apis", served)
+		assert.Equal(t, "application/json", accept)
+	})
+
+	t.Run("requests are served unchanged while all resources are enabled", func(t *testing.T) {
+		feature.Set(true)
+		rec := serve("/apis/ext.cattle.io/v1/tokens", "application/json")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "/apis/ext.cattle.io/v1/tokens", served)
+
+		rec = serve("/apis/ext.cattle.io/v1", "application/vnd.kubernetes.protobuf")
+		assert.Equal(t, "application/vnd.kubernetes.protobuf", accept)
+		var got metav1.APIResourceList
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Len(t, got.APIResources, 3)
+	})
+}
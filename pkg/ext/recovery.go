@@ -0,0 +1,78 @@
+package ext
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/rancher/rancher/pkg/metrics"
+	"github.com/rancher/rancher/pkg/tracing"
+	steveserver "github.com/rancher/steve/pkg/server"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// requestIDHeader is the header correlating a request with the logs of the extension API server.
+const requestIDHeader = "X-Request-Id"
+
+// instrumentedAPIServer traces the requests to the extension API server and recovers from their panics. It also hides
+// the resources of disabled features, see [FeatureGate.Handler]. Large responses are already compressed by the generic
+// API server, with the APIResponseCompression feature enabled by default.
+type instrumentedAPIServer struct {
+	steveserver.ExtensionAPIServer
+	handler http.Handler
+}
+
+func newInstrumentedAPIServer(server steveserver.ExtensionAPIServer, gate *FeatureGate) *instrumentedAPIServer {
+	return &instrumentedAPIServer{
+		ExtensionAPIServer: server,
+		handler:            tracing.Handler(withPanicRecovery(gate.Handler(server)), "ext-apiserver"),
+	}
+}
+
+func (s *instrumentedAPIServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.handler.ServeHTTP(w, req)
+}
+
+// withPanicRecovery converts the panics of the handler into an internal error Status, logged with its stack trace and
+// the ID of the request. The ID is taken from the X-Request-Id header, the ID of the trace of the request, or generated,
+// and returned in the response.
+func withPanicRecovery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestID := req.Header.Get(requestIDHeader)
+		if requestID == "" {
+			requestID = tracing.TraceID(req.Context())
+		}
+		if requestID == "" {
+			requestID = string(uuid.NewUUID())
+		}
+
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+			if r == http.ErrAbortHandler {
+				// The handler deliberately aborted the response, let the HTTP server handle it.
+				panic(r)
+			}
+
+			metrics.IncExtAPIServerPanics()
+			logrus.Errorf("[ext] Panic serving %s %s (request %s): %v\n%s", req.Method, req.URL.Path, requestID, r, debug.Stack())
+
+			status := apierrors.NewInternalError(fmt.Errorf("panic serving request %s", requestID)).Status()
+			status.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+			w.Header().Set(requestIDHeader, requestID)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			if err := json.NewEncoder(w).Encode(status); err != nil {
+				logrus.Errorf("[ext] Error writing the response to request %s: %v", requestID, err)
+			}
+		}()
+
+		next.ServeHTTP(w, req)
+	})
+}
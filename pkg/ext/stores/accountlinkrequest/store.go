@@ -0,0 +1,198 @@
+// accountlinkrequest implements the store for the imperative accountlinkrequest resource.
+package accountlinkrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const (
+	SingularName = "accountlinkrequest"
+	kind         = "AccountLinkRequest"
+)
+
+var (
+	_ rest.Creater                  = &Store{}
+	_ rest.Storage                  = &Store{}
+	_ rest.Scoper                   = &Store{}
+	_ rest.SingularNameProvider     = &Store{}
+	_ rest.GroupVersionKindProvider = &Store{}
+)
+
+var GVK = ext.SchemeGroupVersion.WithKind(kind)
+
+// requiredPermissions are the permissions needed to link accounts, as the users, their bindings and their tokens are
+// changed on behalf of the requester. The transferred bindings may grant roles the requester doesn't hold, hence the
+// escalate permissions.
+var requiredPermissions = []struct {
+	verb     string
+	resource string
+}{
+	{"update", "users"},
+	{"escalate", "globalroles"},
+	{"escalate", "roletemplates"},
+	{"create", "globalrolebindings"},
+	{"delete", "globalrolebindings"},
+	{"create", "clusterroletemplatebindings"},
+	{"delete", "clusterroletemplatebindings"},
+	{"create", "projectroletemplatebindings"},
+	{"delete", "projectroletemplatebindings"},
+	{"update", "tokens"},
+	{"delete", "tokens"},
+}
+
+// linker is the subset of [principalmigration.Linker] used by the store.
+type linker interface {
+	Link(userID, principalID, duplicateUserID string, dryRun bool) (principalmigration.LinkResult, error)
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+// Store links the principal or the duplicate account of an AccountLinkRequest to its user.
+type Store struct {
+	authorizer authorizer.Authorizer
+	linker     linker
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+func New(wranglerContext *wrangler.Context, authorizer authorizer.Authorizer) *Store {
+	return &Store{
+		authorizer: authorizer,
+		linker:     principalmigration.NewLinker(wranglerContext),
+	}
+}
+
+// GroupVersionKind implements [rest.GroupVersionKindProvider], a required interface.
+func (s *Store) GroupVersionKind(_ schema.GroupVersion) schema.GroupVersionKind {
+	return GVK
+}
+
+// NamespaceScoped implements [rest.Scoper], a required interface.
+func (s *Store) NamespaceScoped() bool {
+	return false
+}
+
+// GetSingularName implements [rest.SingularNameProvider], a required interface.
+func (s *Store) GetSingularName() string {
+	return SingularName
+}
+
+// New implements [rest.Storage], a required interface.
+func (s *Store) New() runtime.Object {
+	return &ext.AccountLinkRequest{}
+}
+
+// Destroy implements [rest.Storage], a required interface.
+func (s *Store) Destroy() {
+}
+
+// Create implements [rest.Creator], the interface to support the `create`
+// verb. Delegates to the actual store method after some generic boilerplate.
+func (s *Store) Create(
+	ctx context.Context,
+	obj runtime.Object,
+	createValidation rest.ValidateObjectFunc,
+	options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		err := createValidation(ctx, obj)
+		if err != nil {
+			return obj, err
+		}
+	}
+	dryRun := options != nil && len(options.DryRun) > 0 && options.DryRun[0] == metav1.DryRunAll
+
+	objAccountLinkRequest, ok := obj.(*ext.AccountLinkRequest)
+	if !ok {
+		var zeroT *ext.AccountLinkRequest
+		return nil, apierrors.NewInternalError(fmt.Errorf("expected %T but got %T",
+			zeroT, obj))
+	}
+
+	spec := objAccountLinkRequest.Spec
+	if spec.UserID == "" {
+		return nil, apierrors.NewBadRequest("userID must be set")
+	}
+	if spec.PrincipalID == "" && spec.DuplicateUserID == "" {
+		return nil, apierrors.NewBadRequest("at least one of principalID and duplicateUserID must be set")
+	}
+
+	userInfo, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("can't get user info from context"))
+	}
+	for _, permission := range requiredPermissions {
+		decision, _, err := s.authorizer.Authorize(ctx, &authorizer.AttributesRecord{
+			User:            userInfo,
+			Verb:            permission.verb,
+			APIGroup:        apiv3.SchemeGroupVersion.Group,
+			Resource:        permission.resource,
+			ResourceRequest: true,
+		})
+		if err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("error checking permissions %w", err))
+		}
+		if decision != authorizer.DecisionAllow {
+			return nil, apierrors.NewForbidden(ext.Resource(ext.AccountLinkRequestResourceName), "",
+				fmt.Errorf("not allowed to %s %s", permission.verb, permission.resource))
+		}
+	}
+
+	logrus.Infof("[%s] User %s requested to link principal %q and user %q to user %s (dry run: %v)",
+		SingularName, userInfo.GetName(), spec.PrincipalID, spec.DuplicateUserID, spec.UserID, dryRun)
+	result, err := s.linker.Link(spec.UserID, spec.PrincipalID, spec.DuplicateUserID, dryRun)
+	if err != nil {
+		switch {
+		case errors.Is(err, principalmigration.ErrInvalidLink), apierrors.IsNotFound(err):
+			return nil, apierrors.NewBadRequest(err.Error())
+		case apierrors.IsConflict(err):
+			return nil, apierrors.NewConflict(ext.Resource(ext.AccountLinkRequestResourceName), "", err)
+		default:
+			return nil, apierrors.NewInternalError(err)
+		}
+	}
+
+	objAccountLinkRequest.Status = linkStatus(result)
+	return objAccountLinkRequest, nil
+}
+
+// linkStatus returns the status of a request from the result of the link.
+func linkStatus(result principalmigration.LinkResult) ext.AccountLinkRequestStatus {
+	condition := metav1.Condition{
+		Type:   "AccountsLinked",
+		Status: metav1.ConditionTrue,
+	}
+	summary := status.SummaryCompleted
+	if len(result.Failed) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "TransferFailed"
+		condition.Message = fmt.Sprintf("failed to transfer %d bindings and tokens", len(result.Failed))
+		summary = status.SummaryError
+	}
+
+	return ext.AccountLinkRequestStatus{
+		Conditions:         []metav1.Condition{condition},
+		Summary:            summary,
+		LinkedPrincipalIDs: result.LinkedPrincipalIDs,
+		Bindings:           result.Bindings,
+		Tokens:             result.Tokens,
+		Failed:             result.Failed,
+	}
+}
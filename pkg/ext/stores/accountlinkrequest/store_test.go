@@ -0,0 +1,112 @@
+package accountlinkrequest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+type fakeLinker struct {
+	args   []any
+	result principalmigration.LinkResult
+	err    error
+}
+
+func (f *fakeLinker) Link(userID, principalID, duplicateUserID string, dryRun bool) (principalmigration.LinkResult, error) {
+	f.args = []any{userID, principalID, duplicateUserID, dryRun}
+	return f.result, f.err
+}
+
+func TestCreate(t *testing.T) {
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})
+	allowAll := authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+		return authorizer.DecisionAllow, "", nil
+	})
+	validSpec := ext.AccountLinkRequestSpec{UserID: "u-local", PrincipalID: "activedirectory_user://uid=bob"}
+
+	t.Run("invalid requests", func(t *testing.T) {
+		store := &Store{authorizer: allowAll, linker: &fakeLinker{}}
+		for _, spec := range []ext.AccountLinkRequestSpec{
+			{},
+			{PrincipalID: "activedirectory_user://uid=bob"},
+			{UserID: "u-local"},
+		} {
+			_, err := store.Create(ctx, &ext.AccountLinkRequest{Spec: spec}, nil, &metav1.CreateOptions{})
+			assert.True(t, apierrors.IsBadRequest(err))
+		}
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		store := &Store{
+			authorizer: authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+				// The user can't update tokens.
+				if a.GetResource() == "tokens" && a.GetVerb() == "update" {
+					return authorizer.DecisionNoOpinion, "", nil
+				}
+				return authorizer.DecisionAllow, "", nil
+			}),
+			linker: &fakeLinker{},
+		}
+		_, err := store.Create(ctx, &ext.AccountLinkRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		assert.True(t, apierrors.IsForbidden(err))
+
+		// The transferred bindings may grant roles the user doesn't hold.
+		store.authorizer = authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+			if a.GetResource() == "globalroles" && a.GetVerb() == "escalate" {
+				return authorizer.DecisionNoOpinion, "", nil
+			}
+			return authorizer.DecisionAllow, "", nil
+		})
+		_, err = store.Create(ctx, &ext.AccountLinkRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		assert.True(t, apierrors.IsForbidden(err))
+	})
+
+	t.Run("link errors", func(t *testing.T) {
+		for _, test := range []struct {
+			err  error
+			want func(error) bool
+		}{
+			{fmt.Errorf("%w: no", principalmigration.ErrInvalidLink), apierrors.IsBadRequest},
+			{fmt.Errorf("failed: %w", apierrors.NewNotFound(schema.GroupResource{}, "u-local")), apierrors.IsBadRequest},
+			{fmt.Errorf("failed: %w", apierrors.NewConflict(schema.GroupResource{}, "u-local", fmt.Errorf("changed"))), apierrors.IsConflict},
+			{fmt.Errorf("unavailable"), apierrors.IsInternalError},
+		} {
+			store := &Store{authorizer: allowAll, linker: &fakeLinker{err: test.err}}
+			_, err := store.Create(ctx, &ext.AccountLinkRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+			assert.True(t, test.want(err), err)
+		}
+	})
+
+	t.Run("accounts are linked", func(t *testing.T) {
+		linker := &fakeLinker{result: principalmigration.LinkResult{
+			LinkedPrincipalIDs: []string{"activedirectory_user://uid=bob"},
+			Bindings:           2,
+			Tokens:             1,
+		}}
+		store := &Store{authorizer: allowAll, linker: linker}
+
+		obj, err := store.Create(ctx, &ext.AccountLinkRequest{Spec: validSpec}, nil,
+			&metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		require.NoError(t, err)
+		assert.Equal(t, []any{"u-local", "activedirectory_user://uid=bob", "", true}, linker.args)
+		assert.Equal(t, ext.AccountLinkRequestStatus{
+			Conditions:         []metav1.Condition{{Type: "AccountsLinked", Status: metav1.ConditionTrue}},
+			Summary:            status.SummaryCompleted,
+			LinkedPrincipalIDs: []string{"activedirectory_user://uid=bob"},
+			Bindings:           2,
+			Tokens:             1,
+		}, obj.(*ext.AccountLinkRequest).Status)
+	})
+}
@@ -1,72 +1,110 @@
 package stores
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	extv1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
 	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/rancher/rancher/pkg/ext/stores/accountlinkrequest"
 	"github.com/rancher/rancher/pkg/ext/stores/groupmembershiprefreshrequest"
 	"github.com/rancher/rancher/pkg/ext/stores/kubeconfig"
 	"github.com/rancher/rancher/pkg/ext/stores/passwordchangerequest"
+	"github.com/rancher/rancher/pkg/ext/stores/principalmigrationrequest"
 	"github.com/rancher/rancher/pkg/ext/stores/selfuser"
+	"github.com/rancher/rancher/pkg/ext/stores/tokenrevocationrequest"
 	"github.com/rancher/rancher/pkg/ext/stores/tokens"
 	"github.com/rancher/rancher/pkg/ext/stores/useractivity"
+	"github.com/rancher/rancher/pkg/ext/stores/usermergerequest"
+	"github.com/rancher/rancher/pkg/ext/stores/whocanrequest"
 	"github.com/rancher/rancher/pkg/features"
 	"github.com/rancher/rancher/pkg/wrangler"
 	steveext "github.com/rancher/steve/pkg/ext"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 func InstallStores(
+	ctx context.Context,
 	server *steveext.ExtensionAPIServer,
 	wranglerContext *wrangler.Context,
 	scheme *runtime.Scheme,
 ) error {
 	steveext.AddToScheme(scheme)
 	extv1.AddToScheme(scheme)
+	if err := tokens.AddFieldLabelConversionFunc(scheme); err != nil {
+		return fmt.Errorf("unable to add %s field selectors: %w", tokens.SingularName, err)
+	}
 
+	// The useractivity, token and kubeconfig stores are always installed, the extension API server hides them while
+	// their feature is disabled.
+	userActivityStore := useractivity.New(wranglerContext)
 	err := server.Install(
 		extv1.UserActivityResourceName,
 		useractivity.GVK,
-		useractivity.New(wranglerContext),
+		userActivityStore,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to install useractivity store: %w", err)
 	}
 	logrus.Infof("Successfully installed useractivity store")
+	// Store the activity whose writes were coalesced, periodically and before shutting down.
+	go userActivityStore.Run(ctx)
 
-	if features.ExtTokens.Enabled() {
-		if err := server.Install(
-			tokens.PluralName,
-			tokens.GVK,
-			tokens.NewFromWrangler(wranglerContext, server.GetAuthorizer()),
-		); err != nil {
-			return fmt.Errorf("unable to install %s store: %w", tokens.SingularName, err)
-		}
-		logrus.Infof("Successfully installed token store")
-	} else {
-		logrus.Infof("Feature ext-tokens is disabled")
+	tokenStore := tokens.NewFromWrangler(wranglerContext, server.GetAuthorizer())
+	tokenStore.WatchRBAC(ctx, wranglerContext)
+	// Purge the tokens at the end of their deletion grace period.
+	go wait.JitterUntil(tokenStore.PurgeDeleted, time.Minute, .1, true, ctx.Done())
+	if err := server.Install(
+		tokens.PluralName,
+		tokens.GVK,
+		tokenStore,
+	); err != nil {
+		return fmt.Errorf("unable to install %s store: %w", tokens.SingularName, err)
+	}
+	logrus.Infof("Successfully installed token store")
+	if err := server.Install(
+		tokens.StatusResourceName,
+		tokens.GVK,
+		tokens.NewStatus(tokenStore),
+	); err != nil {
+		return fmt.Errorf("unable to install %s status store: %w", tokens.SingularName, err)
 	}
 
-	if features.ExtKubeconfigs.Enabled() {
-		userManager, err := common.NewUserManagerNoBindings(wranglerContext)
-		if err != nil {
-			return fmt.Errorf("error getting user manager: %w", err)
+	features.ExtTokens.Watch(func(enabled bool) {
+		if !enabled {
+			return
 		}
+		// Tokens created by older versions of Rancher lack the marker
+		// picked up by rancher-backup.
+		go func() {
+			if err := tokenStore.MarkForBackup(); err != nil {
+				logrus.Errorf("Failed to mark tokens for backup: %v", err)
+			}
+			// Tokens created by older versions of Rancher are not
+			// owned by their user, and not garbage collected with it.
+			if err := tokenStore.SetUserOwners(); err != nil {
+				logrus.Errorf("Failed to set the user owners of tokens: %v", err)
+			}
+		}()
+	})
 
-		if err := server.Install(
-			extv1.KubeconfigResourceName,
-			extv1.SchemeGroupVersion.WithKind(kubeconfig.Kind),
-			kubeconfig.New(features.MCM.Enabled(), wranglerContext, server.GetAuthorizer(), userManager),
-		); err != nil {
-			return fmt.Errorf("unable to install kubeconfig store: %w", err)
-		}
-		logrus.Infof("Successfully installed kubeconfig store")
-	} else {
-		logrus.Infof("Feature ext-kubeconfigs is disabled")
+	userManager, err := common.NewUserManagerNoBindings(wranglerContext)
+	if err != nil {
+		return fmt.Errorf("error getting user manager: %w", err)
 	}
 
+	if err := server.Install(
+		extv1.KubeconfigResourceName,
+		extv1.SchemeGroupVersion.WithKind(kubeconfig.Kind),
+		kubeconfig.New(features.MCM.Enabled(), wranglerContext, server.GetAuthorizer(), userManager),
+	); err != nil {
+		return fmt.Errorf("unable to install kubeconfig store: %w", err)
+	}
+	logrus.Infof("Successfully installed kubeconfig store")
+
 	err = server.Install(
 		extv1.PasswordChangeRequestResourceName,
 		passwordchangerequest.GVK,
@@ -92,6 +130,41 @@ func InstallStores(
 	if err != nil {
 		return fmt.Errorf("unable to install %s store: %w", selfuser.SingularName, err)
 	}
+	err = server.Install(
+		extv1.TokenRevocationRequestResourceName,
+		tokenrevocationrequest.GVK,
+		tokenrevocationrequest.New(wranglerContext, server.GetAuthorizer()))
+	if err != nil {
+		return fmt.Errorf("unable to install %s store: %w", tokenrevocationrequest.SingularName, err)
+	}
+	err = server.Install(
+		extv1.PrincipalMigrationRequestResourceName,
+		principalmigrationrequest.GVK,
+		principalmigrationrequest.New(wranglerContext, server.GetAuthorizer()))
+	if err != nil {
+		return fmt.Errorf("unable to install %s store: %w", principalmigrationrequest.SingularName, err)
+	}
+	err = server.Install(
+		extv1.AccountLinkRequestResourceName,
+		accountlinkrequest.GVK,
+		accountlinkrequest.New(wranglerContext, server.GetAuthorizer()))
+	if err != nil {
+		return fmt.Errorf("unable to install %s store: %w", accountlinkrequest.SingularName, err)
+	}
+	err = server.Install(
+		extv1.UserMergeRequestResourceName,
+		usermergerequest.GVK,
+		usermergerequest.New(wranglerContext, server.GetAuthorizer()))
+	if err != nil {
+		return fmt.Errorf("unable to install %s store: %w", usermergerequest.SingularName, err)
+	}
+	err = server.Install(
+		extv1.WhoCanRequestResourceName,
+		whocanrequest.GVK,
+		whocanrequest.New(wranglerContext, server.GetAuthorizer()))
+	if err != nil {
+		return fmt.Errorf("unable to install %s store: %w", whocanrequest.SingularName, err)
+	}
 
 	return nil
 }
@@ -265,6 +265,7 @@ func (s *Store) Create(
 	defaultTTLSeconds := *defaultTTL / 1000
 
 	ttlMilliseconds := kubeconfig.Spec.TTL * 1000
+	isDefaultTTL := ttlMilliseconds == 0
 	switch {
 	case ttlMilliseconds < 0:
 		return nil, apierrors.NewBadRequest("spec.ttl can't be negative")
@@ -372,6 +373,23 @@ func (s *Store) Create(
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid currentContext %s", kubeconfig.Spec.CurrentContext))
 	}
 
+	// The clusters may override the default TTL of their kubeconfig tokens with a shorter one,
+	// which the TTL of the kubeconfig must not exceed.
+	for _, cluster := range clusters {
+		clusterTTL, err := tokens.GetClusterKubeconfigTokenTTLOverrideInMilliSeconds(cluster.Annotations)
+		if err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("error getting token TTL for cluster %s: %w", cluster.Name, err))
+		}
+		if clusterTTL == nil || *clusterTTL == 0 || (ttlMilliseconds != 0 && ttlMilliseconds <= *clusterTTL) {
+			continue
+		}
+		if !isDefaultTTL {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("spec.ttl %d exceeds max ttl %d of cluster %s", kubeconfig.Spec.TTL, *clusterTTL/1000, cluster.Name))
+		}
+		ttlMilliseconds = *clusterTTL
+		kubeconfig.Spec.TTL = ttlMilliseconds / 1000
+	}
+
 	dryRun := options != nil && len(options.DryRun) > 0
 	generateToken := s.shouldGenerateToken()
 
@@ -259,6 +259,7 @@ func TestStoreCreate(t *testing.T) {
 	getServerURL := func() string { return serverURL }
 	downstream1 := "c-m-tbgzfbgf"
 	downstream2 := "c-m-bxn2p7w6" // ACE enabled.
+	production := "c-m-6kv4jq2x"  // Overrides the kubeconfig token TTL.
 
 	_, rancherCACert, err := generateCAKeyAndCert()
 	require.NoError(t, err)
@@ -291,6 +292,13 @@ func TestStoreCreate(t *testing.T) {
 		},
 		Status: v3.ClusterStatus{CACert: base64.StdEncoding.EncodeToString([]byte(downstream2CACert))},
 	}
+	productionCluster := &v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        production,
+			Annotations: map[string]string{tokens.KubeconfigTokenTTLAnnotation: "60"},
+		},
+		Spec: v3.ClusterSpec{DisplayName: "production"},
+	}
 
 	ctrl := gomock.NewController(t)
 	userCache := fake.NewMockNonNamespacedCacheInterface[*v3.User](ctrl)
@@ -332,6 +340,8 @@ func TestStoreCreate(t *testing.T) {
 			return downstream1Cluster.DeepCopy(), nil
 		case downstream2:
 			return downstream2Cluster.DeepCopy(), nil
+		case production:
+			return productionCluster.DeepCopy(), nil
 		default:
 			return nil, apierrors.NewNotFound(gvr.GroupResource(), name)
 		}
@@ -1089,6 +1099,38 @@ func TestStoreCreate(t *testing.T) {
 		assert.True(t, apierrors.IsBadRequest(err))
 		assert.Contains(t, err.Error(), "exceeds max ttl")
 	})
+	t.Run("ttl exceeds the max of a cluster", func(t *testing.T) {
+		store := &Store{
+			authorizer: authorizer.AuthorizerFunc(func(ctx context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+				return authorizer.DecisionAllow, "", nil
+			}),
+			userCache:     userCache,
+			tokenCache:    tokenCache,
+			clusterCache:  clusterCache,
+			userMgr:       userManager,
+			getDefaultTTL: getDefaultTTL,
+		}
+
+		ctx := request.WithUser(context.Background(), &k8suser.DefaultInfo{
+			Extra: map[string][]string{
+				common.ExtraRequestTokenID: {authTokenID},
+			},
+			Name: userID,
+		})
+		kubeconfig := &ext.Kubeconfig{
+			Spec: ext.KubeconfigSpec{
+				Clusters:       []string{downstream1, production},
+				CurrentContext: downstream1,
+				TTL:            2 * 3600,
+			},
+		}
+
+		obj, err := store.Create(ctx, kubeconfig, nil, options)
+		require.Error(t, err)
+		assert.Nil(t, obj)
+		assert.True(t, apierrors.IsBadRequest(err))
+		assert.Contains(t, err.Error(), "spec.ttl 7200 exceeds max ttl 3600 of cluster "+production)
+	})
 }
 
 func generateCAKeyAndCert() (*ecdsa.PrivateKey, string, error) {
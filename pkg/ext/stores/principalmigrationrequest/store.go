@@ -0,0 +1,176 @@
+// principalmigrationrequest implements the store for the imperative principalmigrationrequest resource.
+package principalmigrationrequest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const (
+	SingularName = "principalmigrationrequest"
+	kind         = "PrincipalMigrationRequest"
+)
+
+var (
+	_ rest.Creater                  = &Store{}
+	_ rest.Storage                  = &Store{}
+	_ rest.Scoper                   = &Store{}
+	_ rest.SingularNameProvider     = &Store{}
+	_ rest.GroupVersionKindProvider = &Store{}
+)
+
+var GVK = ext.SchemeGroupVersion.WithKind(kind)
+
+// migrator is the subset of [principalmigration.Migrator] used by the store.
+type migrator interface {
+	ListBindings(userName string) ([]*apiv3.ClusterRoleTemplateBinding, []*apiv3.ProjectRoleTemplateBinding, error)
+	Migrate(crtbs []*apiv3.ClusterRoleTemplateBinding, prtbs []*apiv3.ProjectRoleTemplateBinding, rewrite principalmigration.Rewrite, dryRun bool) principalmigration.Result
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+// Store migrates the cluster and project role template bindings selected by a PrincipalMigrationRequest.
+type Store struct {
+	authorizer authorizer.Authorizer
+	migrator   migrator
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+func New(wranglerContext *wrangler.Context, authorizer authorizer.Authorizer) *Store {
+	return &Store{
+		authorizer: authorizer,
+		migrator:   principalmigration.NewMigrator(wranglerContext),
+	}
+}
+
+// GroupVersionKind implements [rest.GroupVersionKindProvider], a required interface.
+func (s *Store) GroupVersionKind(_ schema.GroupVersion) schema.GroupVersionKind {
+	return GVK
+}
+
+// NamespaceScoped implements [rest.Scoper], a required interface.
+func (s *Store) NamespaceScoped() bool {
+	return false
+}
+
+// GetSingularName implements [rest.SingularNameProvider], a required interface.
+func (s *Store) GetSingularName() string {
+	return SingularName
+}
+
+// New implements [rest.Storage], a required interface.
+func (s *Store) New() runtime.Object {
+	return &ext.PrincipalMigrationRequest{}
+}
+
+// Destroy implements [rest.Storage], a required interface.
+func (s *Store) Destroy() {
+}
+
+// Create implements [rest.Creator], the interface to support the `create`
+// verb. Delegates to the actual store method after some generic boilerplate.
+func (s *Store) Create(
+	ctx context.Context,
+	obj runtime.Object,
+	createValidation rest.ValidateObjectFunc,
+	options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		err := createValidation(ctx, obj)
+		if err != nil {
+			return obj, err
+		}
+	}
+	dryRun := options != nil && len(options.DryRun) > 0 && options.DryRun[0] == metav1.DryRunAll
+
+	objPrincipalMigrationRequest, ok := obj.(*ext.PrincipalMigrationRequest)
+	if !ok {
+		var zeroT *ext.PrincipalMigrationRequest
+		return nil, apierrors.NewInternalError(fmt.Errorf("expected %T but got %T",
+			zeroT, obj))
+	}
+
+	spec := objPrincipalMigrationRequest.Spec
+	if !strings.HasSuffix(spec.OldPrefix, "://") || !strings.HasSuffix(spec.NewPrefix, "://") {
+		return nil, apierrors.NewBadRequest("oldPrefix and newPrefix must be principal ID prefixes, e.g. openldap_user://")
+	}
+	if spec.OldPrefix == spec.NewPrefix {
+		return nil, apierrors.NewBadRequest("oldPrefix and newPrefix must differ")
+	}
+
+	userInfo, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("can't get user info from context"))
+	}
+	// Bindings are migrated by replacing them, which the user must be allowed to do.
+	for _, resource := range []string{"clusterroletemplatebindings", "projectroletemplatebindings"} {
+		for _, verb := range []string{"create", "delete"} {
+			decision, _, err := s.authorizer.Authorize(ctx, &authorizer.AttributesRecord{
+				User:            userInfo,
+				Verb:            verb,
+				APIGroup:        apiv3.SchemeGroupVersion.Group,
+				Resource:        resource,
+				ResourceRequest: true,
+			})
+			if err != nil {
+				return nil, apierrors.NewInternalError(fmt.Errorf("error checking permissions %w", err))
+			}
+			if decision != authorizer.DecisionAllow {
+				return nil, apierrors.NewForbidden(ext.Resource(ext.PrincipalMigrationRequestResourceName), "",
+					fmt.Errorf("not allowed to %s %s", verb, resource))
+			}
+		}
+	}
+
+	crtbs, prtbs, err := s.migrator.ListBindings(spec.UserID)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	logrus.Infof("[%s] User %s requested the migration of principals from %s to %s (user: %q, dry run: %v)",
+		SingularName, userInfo.GetName(), spec.OldPrefix, spec.NewPrefix, spec.UserID, dryRun)
+	result := s.migrator.Migrate(crtbs, prtbs, principalmigration.PrefixRewrite(spec.OldPrefix, spec.NewPrefix), dryRun)
+
+	objPrincipalMigrationRequest.Status = migrationStatus(result)
+	return objPrincipalMigrationRequest, nil
+}
+
+// migrationStatus returns the status of a request from the result of the migration.
+func migrationStatus(result principalmigration.Result) ext.PrincipalMigrationRequestStatus {
+	condition := metav1.Condition{
+		Type:   "PrincipalsMigrated",
+		Status: metav1.ConditionTrue,
+	}
+	summary := status.SummaryCompleted
+	if len(result.Failed) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "MigrationFailed"
+		condition.Message = fmt.Sprintf("failed to migrate %d of %d bindings", len(result.Failed), result.Matched)
+		summary = status.SummaryError
+	}
+
+	return ext.PrincipalMigrationRequestStatus{
+		Conditions: []metav1.Condition{condition},
+		Summary:    summary,
+		Matched:    result.Matched,
+		Migrated:   result.Migrated,
+		Failed:     result.Failed,
+	}
+}
@@ -0,0 +1,116 @@
+package principalmigrationrequest
+
+import (
+	"context"
+	"testing"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+type fakeMigrator struct {
+	userName string
+	dryRun   bool
+	rewrite  principalmigration.Rewrite
+	result   principalmigration.Result
+}
+
+func (f *fakeMigrator) ListBindings(userName string) ([]*apiv3.ClusterRoleTemplateBinding, []*apiv3.ProjectRoleTemplateBinding, error) {
+	f.userName = userName
+	return nil, nil, nil
+}
+
+func (f *fakeMigrator) Migrate(_ []*apiv3.ClusterRoleTemplateBinding, _ []*apiv3.ProjectRoleTemplateBinding, rewrite principalmigration.Rewrite, dryRun bool) principalmigration.Result {
+	f.rewrite = rewrite
+	f.dryRun = dryRun
+	return f.result
+}
+
+func TestCreate(t *testing.T) {
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})
+	allowAll := authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+		return authorizer.DecisionAllow, "", nil
+	})
+	validSpec := ext.PrincipalMigrationRequestSpec{
+		OldPrefix: "openldap_user://",
+		NewPrefix: "activedirectory_user://",
+		UserID:    "u-bob",
+	}
+
+	t.Run("invalid prefixes", func(t *testing.T) {
+		store := &Store{authorizer: allowAll, migrator: &fakeMigrator{}}
+		for _, spec := range []ext.PrincipalMigrationRequestSpec{
+			{},
+			{OldPrefix: "openldap_user", NewPrefix: "activedirectory_user://"},
+			{OldPrefix: "openldap_user://", NewPrefix: "openldap_user://"},
+		} {
+			_, err := store.Create(ctx, &ext.PrincipalMigrationRequest{Spec: spec}, nil, &metav1.CreateOptions{})
+			assert.True(t, apierrors.IsBadRequest(err))
+		}
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		store := &Store{
+			authorizer: authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+				// The user can't delete the project role template bindings.
+				if a.GetResource() == "projectroletemplatebindings" && a.GetVerb() == "delete" {
+					return authorizer.DecisionNoOpinion, "", nil
+				}
+				return authorizer.DecisionAllow, "", nil
+			}),
+			migrator: &fakeMigrator{},
+		}
+		_, err := store.Create(ctx, &ext.PrincipalMigrationRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		assert.True(t, apierrors.IsForbidden(err))
+	})
+
+	t.Run("bindings are migrated", func(t *testing.T) {
+		migrator := &fakeMigrator{result: principalmigration.Result{Matched: 3, Migrated: 3}}
+		store := &Store{authorizer: allowAll, migrator: migrator}
+
+		obj, err := store.Create(ctx, &ext.PrincipalMigrationRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, ext.PrincipalMigrationRequestStatus{
+			Conditions: []metav1.Condition{{Type: "PrincipalsMigrated", Status: metav1.ConditionTrue}},
+			Summary:    status.SummaryCompleted,
+			Matched:    3,
+			Migrated:   3,
+		}, obj.(*ext.PrincipalMigrationRequest).Status)
+		assert.Equal(t, "u-bob", migrator.userName)
+		assert.False(t, migrator.dryRun)
+		principalID, ok := migrator.rewrite("openldap_user://uid=bob")
+		assert.True(t, ok)
+		assert.Equal(t, "activedirectory_user://uid=bob", principalID)
+	})
+
+	t.Run("dry run and failures", func(t *testing.T) {
+		migrator := &fakeMigrator{result: principalmigration.Result{Matched: 2, Migrated: 1, Failed: []string{"c-1/crtb-1"}}}
+		store := &Store{authorizer: allowAll, migrator: migrator}
+
+		obj, err := store.Create(ctx, &ext.PrincipalMigrationRequest{Spec: validSpec}, nil,
+			&metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		require.NoError(t, err)
+		assert.True(t, migrator.dryRun)
+		assert.Equal(t, ext.PrincipalMigrationRequestStatus{
+			Conditions: []metav1.Condition{{
+				Type:    "PrincipalsMigrated",
+				Status:  metav1.ConditionFalse,
+				Reason:  "MigrationFailed",
+				Message: "failed to migrate 1 of 2 bindings",
+			}},
+			Summary:  status.SummaryError,
+			Matched:  2,
+			Migrated: 1,
+			Failed:   []string{"c-1/crtb-1"},
+		}, obj.(*ext.PrincipalMigrationRequest).Status)
+	})
+}
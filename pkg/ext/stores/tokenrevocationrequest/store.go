@@ -0,0 +1,276 @@
+// tokenrevocationrequest implements the store for the imperative tokenrevocationrequest resource.
+package tokenrevocationrequest
+
+import (
+	"context"
+	"fmt"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	exttokens "github.com/rancher/rancher/pkg/ext/stores/tokens"
+	v3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const (
+	SingularName = "tokenrevocationrequest"
+	kind         = "TokenRevocationRequest"
+
+	// progressInterval is the number of revoked tokens between two progress reports in the logs.
+	progressInterval = 100
+)
+
+var (
+	_ rest.Creater                  = &Store{}
+	_ rest.Storage                  = &Store{}
+	_ rest.Scoper                   = &Store{}
+	_ rest.SingularNameProvider     = &Store{}
+	_ rest.GroupVersionKindProvider = &Store{}
+)
+
+var GVK = ext.SchemeGroupVersion.WithKind(kind)
+
+// extTokenStore is the subset of the ext token system store used to revoke ext tokens.
+type extTokenStore interface {
+	ListSelected(selector labels.Selector) (*ext.TokenList, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteHash(token *ext.Token)
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+// Store revokes the management.cattle.io and ext.cattle.io tokens matching the selection of a TokenRevocationRequest.
+type Store struct {
+	authorizer authorizer.Authorizer
+	tokens     v3.TokenClient
+	tokenCache v3.TokenCache
+	extTokens  extTokenStore
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+func New(wranglerContext *wrangler.Context, authorizer authorizer.Authorizer) *Store {
+	return &Store{
+		authorizer: authorizer,
+		tokens:     wranglerContext.Mgmt.Token(),
+		tokenCache: wranglerContext.Mgmt.Token().Cache(),
+		extTokens:  exttokens.NewSystemFromWrangler(wranglerContext),
+	}
+}
+
+// GroupVersionKind implements [rest.GroupVersionKindProvider], a required interface.
+func (s *Store) GroupVersionKind(_ schema.GroupVersion) schema.GroupVersionKind {
+	return GVK
+}
+
+// NamespaceScoped implements [rest.Scoper], a required interface.
+func (s *Store) NamespaceScoped() bool {
+	return false
+}
+
+// GetSingularName implements [rest.SingularNameProvider], a required interface.
+func (s *Store) GetSingularName() string {
+	return SingularName
+}
+
+// New implements [rest.Storage], a required interface.
+func (s *Store) New() runtime.Object {
+	return &ext.TokenRevocationRequest{}
+}
+
+// Destroy implements [rest.Storage], a required interface.
+func (s *Store) Destroy() {
+}
+
+// Create implements [rest.Creator], the interface to support the `create`
+// verb. Delegates to the actual store method after some generic boilerplate.
+func (s *Store) Create(
+	ctx context.Context,
+	obj runtime.Object,
+	createValidation rest.ValidateObjectFunc,
+	options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		err := createValidation(ctx, obj)
+		if err != nil {
+			return obj, err
+		}
+	}
+	dryRun := options != nil && len(options.DryRun) > 0 && options.DryRun[0] == metav1.DryRunAll
+
+	objTokenRevocationRequest, ok := obj.(*ext.TokenRevocationRequest)
+	if !ok {
+		var zeroT *ext.TokenRevocationRequest
+		return nil, apierrors.NewInternalError(fmt.Errorf("expected %T but got %T",
+			zeroT, obj))
+	}
+
+	spec := objTokenRevocationRequest.Spec
+	if spec.UserID == "" && spec.ClusterName == "" && spec.LabelSelector == nil && spec.CreatedBefore == nil {
+		return nil, apierrors.NewBadRequest("at least one of userID, clusterName, labelSelector and createdBefore must be set")
+	}
+	selector := labels.Everything()
+	if spec.LabelSelector != nil {
+		// An empty selector matches all the tokens, which is too easy to ask for by mistake.
+		if len(spec.LabelSelector.MatchLabels) == 0 && len(spec.LabelSelector.MatchExpressions) == 0 {
+			return nil, apierrors.NewBadRequest("labelSelector must not be empty")
+		}
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(spec.LabelSelector); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid label selector: %s", err))
+		}
+	}
+
+	userInfo, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("can't get user info from context"))
+	}
+	// Only users that can delete all the tokens are allowed to revoke them in bulk.
+	for _, group := range []string{apiv3.SchemeGroupVersion.Group, ext.SchemeGroupVersion.Group} {
+		decision, _, err := s.authorizer.Authorize(ctx, &authorizer.AttributesRecord{
+			User:            userInfo,
+			Verb:            "delete",
+			APIGroup:        group,
+			Resource:        "tokens",
+			ResourceRequest: true,
+		})
+		if err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("error checking permissions %w", err))
+		}
+		if decision != authorizer.DecisionAllow {
+			return nil, apierrors.NewForbidden(ext.Resource(ext.TokenRevocationRequestResourceName), "",
+				fmt.Errorf("not allowed to delete %s tokens", group))
+		}
+	}
+
+	revoker := &revoker{store: s, dryRun: dryRun, requester: userInfo.GetName()}
+	if err := revoker.revoke(spec, selector); err != nil {
+		return nil, err
+	}
+
+	objTokenRevocationRequest.Status = revoker.status()
+	return objTokenRevocationRequest, nil
+}
+
+// revoker revokes the tokens selected by a request and keeps track of its progress.
+type revoker struct {
+	store     *Store
+	dryRun    bool
+	requester string
+
+	matched int
+	revoked int
+	failed  []string
+}
+
+// revoke revokes the management.cattle.io and ext.cattle.io tokens matching the request.
+func (r *revoker) revoke(spec ext.TokenRevocationRequestSpec, selector labels.Selector) error {
+	createdBefore := func(created metav1.Time) bool {
+		return spec.CreatedBefore == nil || created.Before(spec.CreatedBefore)
+	}
+
+	tokens, err := r.store.tokenCache.List(selector)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to list tokens: %w", err))
+	}
+	var selected []*apiv3.Token
+	for _, token := range tokens {
+		if (spec.UserID == "" || token.UserID == spec.UserID) &&
+			(spec.ClusterName == "" || token.ClusterName == spec.ClusterName) &&
+			createdBefore(token.CreationTimestamp) {
+			selected = append(selected, token)
+		}
+	}
+
+	// Ext tokens are never scoped to a cluster.
+	var selectedExt []ext.Token
+	if spec.ClusterName == "" {
+		extSelector := selector
+		if spec.UserID != "" {
+			userRequirement, err := labels.NewRequirement(exttokens.UserIDLabel, selection.Equals, []string{spec.UserID})
+			if err != nil {
+				return apierrors.NewBadRequest(fmt.Sprintf("invalid user ID: %s", err))
+			}
+			extSelector = extSelector.Add(*userRequirement)
+		}
+		extTokens, err := r.store.extTokens.ListSelected(extSelector)
+		if err != nil {
+			return err
+		}
+		for _, token := range extTokens.Items {
+			if createdBefore(token.CreationTimestamp) {
+				selectedExt = append(selectedExt, token)
+			}
+		}
+	}
+
+	r.matched = len(selected) + len(selectedExt)
+	logrus.Infof("[%s] User %s requested the revocation of %d tokens (dry run: %v)", SingularName, r.requester, r.matched, r.dryRun)
+	if r.dryRun {
+		return nil
+	}
+
+	for _, token := range selected {
+		err := r.store.tokens.Delete(token.Name, &metav1.DeleteOptions{})
+		r.done(token.Name, err)
+	}
+	for _, token := range selectedExt {
+		err := r.store.extTokens.Delete(token.Name, &metav1.DeleteOptions{})
+		if err == nil {
+			r.store.extTokens.DeleteHash(&token)
+		}
+		r.done(token.Name, err)
+	}
+
+	logrus.Infof("[%s] Revoked %d of %d tokens, %d failed", SingularName, r.revoked, r.matched, len(r.failed))
+	return nil
+}
+
+// done records the revocation of a token, and reports the progress periodically.
+func (r *revoker) done(name string, err error) {
+	if err != nil && !apierrors.IsNotFound(err) {
+		logrus.Errorf("[%s] Failed to revoke token %s: %v", SingularName, name, err)
+		r.failed = append(r.failed, name)
+	} else {
+		r.revoked++
+	}
+
+	if processed := r.revoked + len(r.failed); processed%progressInterval == 0 {
+		logrus.Infof("[%s] Processed %d of %d tokens", SingularName, processed, r.matched)
+	}
+}
+
+// status returns the status of the request once processed.
+func (r *revoker) status() ext.TokenRevocationRequestStatus {
+	condition := metav1.Condition{
+		Type:   "TokensRevoked",
+		Status: metav1.ConditionTrue,
+	}
+	summary := status.SummaryCompleted
+	if len(r.failed) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RevocationFailed"
+		condition.Message = fmt.Sprintf("failed to revoke %d of %d tokens", len(r.failed), r.matched)
+		summary = status.SummaryError
+	}
+
+	return ext.TokenRevocationRequestStatus{
+		Conditions: []metav1.Condition{condition},
+		Summary:    summary,
+		Matched:    r.matched,
+		Revoked:    r.revoked,
+		Failed:     r.failed,
+	}
+}
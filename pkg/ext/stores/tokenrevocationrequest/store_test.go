@@ -0,0 +1,240 @@
+package tokenrevocationrequest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	exttokens "github.com/rancher/rancher/pkg/ext/stores/tokens"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+type fakeExtTokenStore struct {
+	tokens   []ext.Token
+	selector string
+	deleted  []string
+	hashes   []string
+}
+
+func (f *fakeExtTokenStore) ListSelected(selector labels.Selector) (*ext.TokenList, error) {
+	f.selector = selector.String()
+	return &ext.TokenList{Items: f.tokens}, nil
+}
+
+func (f *fakeExtTokenStore) Delete(name string, _ *metav1.DeleteOptions) error {
+	f.deleted = append(f.deleted, name)
+	return nil
+}
+
+func (f *fakeExtTokenStore) DeleteHash(token *ext.Token) {
+	f.hashes = append(f.hashes, token.Name)
+}
+
+var allowAll = authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+	return authorizer.DecisionAllow, "", nil
+})
+
+func TestCreateValidation(t *testing.T) {
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})
+	store := &Store{authorizer: allowAll}
+
+	_, err := store.Create(ctx, &ext.TokenRevocationRequest{}, nil, &metav1.CreateOptions{})
+	assert.True(t, apierrors.IsBadRequest(err))
+
+	_, err = store.Create(ctx, &ext.TokenRevocationRequest{
+		Spec: ext.TokenRevocationRequestSpec{
+			LabelSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "a", Operator: "bogus"}},
+			},
+		},
+	}, nil, &metav1.CreateOptions{})
+	assert.True(t, apierrors.IsBadRequest(err))
+
+	// an empty selector would revoke all the tokens
+	_, err = store.Create(ctx, &ext.TokenRevocationRequest{
+		Spec: ext.TokenRevocationRequestSpec{
+			LabelSelector: &metav1.LabelSelector{},
+		},
+	}, nil, &metav1.CreateOptions{})
+	assert.True(t, apierrors.IsBadRequest(err))
+}
+
+func TestCreateForbidden(t *testing.T) {
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "user"})
+	store := &Store{
+		authorizer: authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+			// The user can delete only the management.cattle.io tokens.
+			if a.GetAPIGroup() == apiv3.SchemeGroupVersion.Group {
+				return authorizer.DecisionAllow, "", nil
+			}
+			return authorizer.DecisionNoOpinion, "", nil
+		}),
+	}
+
+	_, err := store.Create(ctx, &ext.TokenRevocationRequest{
+		Spec: ext.TokenRevocationRequestSpec{UserID: "u-1"},
+	}, nil, &metav1.CreateOptions{})
+	assert.True(t, apierrors.IsForbidden(err))
+
+	store.authorizer = authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+		return authorizer.DecisionNoOpinion, "", errors.New("unavailable")
+	})
+	_, err = store.Create(ctx, &ext.TokenRevocationRequest{
+		Spec: ext.TokenRevocationRequestSpec{UserID: "u-1"},
+	}, nil, &metav1.CreateOptions{})
+	assert.True(t, apierrors.IsInternalError(err))
+}
+
+func TestCreate(t *testing.T) {
+	now := time.Now()
+	old := metav1.NewTime(now.Add(-48 * time.Hour))
+	recent := metav1.NewTime(now.Add(-time.Hour))
+	cutoff := metav1.NewTime(now.Add(-24 * time.Hour))
+
+	token := func(name, userID, clusterName string, created metav1.Time) *apiv3.Token {
+		return &apiv3.Token{
+			ObjectMeta:  metav1.ObjectMeta{Name: name, CreationTimestamp: created},
+			UserID:      userID,
+			ClusterName: clusterName,
+		}
+	}
+	extToken := func(name string, created metav1.Time) ext.Token {
+		return ext.Token{ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: created}}
+	}
+
+	tests := map[string]struct {
+		spec          ext.TokenRevocationRequestSpec
+		dryRun        bool
+		deleteErr     error
+		wantSelector  string
+		wantDeleted   []string
+		wantExtListed bool
+		wantExt       []string
+		wantStatus    ext.TokenRevocationRequestStatus
+	}{
+		"by user": {
+			spec:          ext.TokenRevocationRequestSpec{UserID: "u-1"},
+			wantSelector:  exttokens.UserIDLabel + "=u-1",
+			wantDeleted:   []string{"t-old", "t-recent", "t-cluster"},
+			wantExtListed: true,
+			wantExt:       []string{"e-old", "e-recent"},
+			wantStatus: ext.TokenRevocationRequestStatus{
+				Conditions: []metav1.Condition{{Type: "TokensRevoked", Status: metav1.ConditionTrue}},
+				Summary:    status.SummaryCompleted,
+				Matched:    5,
+				Revoked:    5,
+			},
+		},
+		"by user and creation": {
+			spec:          ext.TokenRevocationRequestSpec{UserID: "u-1", CreatedBefore: &cutoff},
+			wantSelector:  exttokens.UserIDLabel + "=u-1",
+			wantDeleted:   []string{"t-old"},
+			wantExtListed: true,
+			wantExt:       []string{"e-old"},
+			wantStatus: ext.TokenRevocationRequestStatus{
+				Conditions: []metav1.Condition{{Type: "TokensRevoked", Status: metav1.ConditionTrue}},
+				Summary:    status.SummaryCompleted,
+				Matched:    2,
+				Revoked:    2,
+			},
+		},
+		"by cluster skips ext tokens": {
+			spec:        ext.TokenRevocationRequestSpec{ClusterName: "c-1"},
+			wantDeleted: []string{"t-cluster"},
+			wantStatus: ext.TokenRevocationRequestStatus{
+				Conditions: []metav1.Condition{{Type: "TokensRevoked", Status: metav1.ConditionTrue}},
+				Summary:    status.SummaryCompleted,
+				Matched:    1,
+				Revoked:    1,
+			},
+		},
+		"dry run": {
+			spec:          ext.TokenRevocationRequestSpec{UserID: "u-1", CreatedBefore: &cutoff},
+			dryRun:        true,
+			wantSelector:  exttokens.UserIDLabel + "=u-1",
+			wantExtListed: true,
+			wantStatus: ext.TokenRevocationRequestStatus{
+				Conditions: []metav1.Condition{{Type: "TokensRevoked", Status: metav1.ConditionTrue}},
+				Summary:    status.SummaryCompleted,
+				Matched:    2,
+			},
+		},
+		"failures are reported": {
+			spec:        ext.TokenRevocationRequestSpec{ClusterName: "c-1"},
+			deleteErr:   errors.New("unavailable"),
+			wantDeleted: []string{"t-cluster"},
+			wantStatus: ext.TokenRevocationRequestStatus{
+				Conditions: []metav1.Condition{{
+					Type:    "TokensRevoked",
+					Status:  metav1.ConditionFalse,
+					Reason:  "RevocationFailed",
+					Message: "failed to revoke 1 of 1 tokens",
+				}},
+				Summary: status.SummaryError,
+				Matched: 1,
+				Failed:  []string{"t-cluster"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tokens := fake.NewMockNonNamespacedControllerInterface[*apiv3.Token, *apiv3.TokenList](ctrl)
+			tokenCache := fake.NewMockNonNamespacedCacheInterface[*apiv3.Token](ctrl)
+			extTokens := &fakeExtTokenStore{
+				tokens: []ext.Token{extToken("e-old", old), extToken("e-recent", recent)},
+			}
+
+			tokenCache.EXPECT().List(labels.Everything()).Return([]*apiv3.Token{
+				token("t-old", "u-1", "", old),
+				token("t-recent", "u-1", "", recent),
+				token("t-cluster", "u-1", "c-1", recent),
+				token("t-other", "u-2", "", old),
+			}, nil)
+			var deleted []string
+			tokens.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(name string, _ *metav1.DeleteOptions) error {
+				deleted = append(deleted, name)
+				return test.deleteErr
+			}).AnyTimes()
+
+			store := &Store{
+				authorizer: allowAll,
+				tokens:     tokens,
+				tokenCache: tokenCache,
+				extTokens:  extTokens,
+			}
+
+			options := &metav1.CreateOptions{}
+			if test.dryRun {
+				options.DryRun = []string{metav1.DryRunAll}
+			}
+			ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})
+			obj, err := store.Create(ctx, &ext.TokenRevocationRequest{Spec: test.spec}, nil, options)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.wantStatus, obj.(*ext.TokenRevocationRequest).Status)
+			assert.Equal(t, test.wantDeleted, deleted)
+			assert.Equal(t, test.wantExt, extTokens.deleted)
+			assert.Equal(t, test.wantExt, extTokens.hashes)
+			if test.wantExtListed {
+				assert.Equal(t, test.wantSelector, extTokens.selector)
+			} else {
+				assert.Empty(t, extTokens.selector)
+			}
+		})
+	}
+}
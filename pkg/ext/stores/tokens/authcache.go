@@ -0,0 +1,111 @@
+package tokens
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/metrics"
+	"github.com/rancher/rancher/pkg/wrangler"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+const (
+	// authDecisionTTL is how long an authorization decision of the token store is reused. It bounds how stale a
+	// decision can be if an RBAC change is missed.
+	authDecisionTTL = 10 * time.Second
+	// authDecisionCacheSize is the maximum number of cached authorization decisions.
+	authDecisionCacheSize = 1000
+)
+
+// decisionCache caches the full-access decisions of the token store for a short time, keyed by the user and the verb
+// of the requests. It spares busy users, e.g. automation, an authorization of each of their requests. The cache is
+// purged on every change to the cluster-wide RBAC resources, see [Store.WatchRBAC].
+type decisionCache struct {
+	decisions *cache.LRUExpireCache
+}
+
+func newDecisionCache() *decisionCache {
+	return &decisionCache{
+		decisions: cache.NewLRUExpireCache(authDecisionCacheSize),
+	}
+}
+
+// authorize returns whether the user has full access to the tokens for the verb, from the cache if possible. A nil
+// cache always asks the authorizer.
+func (c *decisionCache) authorize(ctx context.Context, authz authorizer.Authorizer, userInfo user.Info, verb string) (bool, error) {
+	var key string
+	if c != nil {
+		key = decisionKey(userInfo, verb)
+		if fullAccess, ok := c.decisions.Get(key); ok {
+			metrics.IncExtTokenAuthorizationCacheLookups(true)
+			return fullAccess.(bool), nil
+		}
+		metrics.IncExtTokenAuthorizationCacheLookups(false)
+	}
+
+	decision, _, err := authz.Authorize(ctx, &authorizer.AttributesRecord{
+		User:            userInfo,
+		Verb:            verb,
+		Resource:        "*",
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	fullAccess := decision == authorizer.DecisionAllow
+	if c != nil {
+		c.decisions.Add(key, fullAccess, authDecisionTTL)
+	}
+	return fullAccess, nil
+}
+
+// purge drops all the cached decisions.
+func (c *decisionCache) purge() {
+	c.decisions.RemoveAll(func(any) bool { return true })
+}
+
+// decisionKey identifies a decision by the verb, the name, the groups and the extra attributes of the user, as the
+// authorizer grants permissions to groups too, and extra attributes such as the scopes of a request may restrict them.
+func decisionKey(userInfo user.Info, verb string) string {
+	groups := slices.Clone(userInfo.GetGroups())
+	slices.Sort(groups)
+	key := []string{verb, userInfo.GetName(), strings.Join(groups, "\x01")}
+
+	extra := userInfo.GetExtra()
+	for _, name := range slices.Sorted(maps.Keys(extra)) {
+		key = append(key, name+"="+strings.Join(extra[name], "\x01"))
+	}
+	return strings.Join(key, "\x00")
+}
+
+// WatchRBAC purges the cached authorization decisions of the store whenever a cluster role, a cluster role binding or
+// a global role binding changes, so that granted or revoked permissions apply to the next request. The decisions are
+// about all the tokens, which namespaced roles and bindings can't grant, so their changes don't purge the cache.
+func (t *Store) WatchRBAC(ctx context.Context, wranglerContext *wrangler.Context) {
+	purge := func() {
+		if t.decisions != nil {
+			t.decisions.purge()
+		}
+	}
+
+	wranglerContext.RBAC.ClusterRole().OnChange(ctx, "ext-token-authz-cache-clusterrole", func(_ string, obj *rbacv1.ClusterRole) (*rbacv1.ClusterRole, error) {
+		purge()
+		return obj, nil
+	})
+	wranglerContext.RBAC.ClusterRoleBinding().OnChange(ctx, "ext-token-authz-cache-clusterrolebinding", func(_ string, obj *rbacv1.ClusterRoleBinding) (*rbacv1.ClusterRoleBinding, error) {
+		purge()
+		return obj, nil
+	})
+	wranglerContext.Mgmt.GlobalRoleBinding().OnChange(ctx, "ext-token-authz-cache-globalrolebinding", func(_ string, obj *apiv3.GlobalRoleBinding) (*apiv3.GlobalRoleBinding, error) {
+		purge()
+		return obj, nil
+	})
+}
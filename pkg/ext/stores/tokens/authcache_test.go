@@ -0,0 +1,100 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+func TestDecisionCache(t *testing.T) {
+	calls := 0
+	allowed := map[string]bool{"admin": true}
+	var authzErr error
+	authz := authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+		calls++
+		if authzErr != nil {
+			return authorizer.DecisionNoOpinion, "", authzErr
+		}
+		if allowed[a.GetUser().GetName()] {
+			return authorizer.DecisionAllow, "", nil
+		}
+		return authorizer.DecisionNoOpinion, "", nil
+	})
+	ctx := context.Background()
+	admin := &user.DefaultInfo{Name: "admin"}
+	bob := &user.DefaultInfo{Name: "bob", Groups: []string{"b", "a"}}
+
+	c := newDecisionCache()
+
+	fullAccess, err := c.authorize(ctx, authz, admin, "get")
+	require.NoError(t, err)
+	assert.True(t, fullAccess)
+	fullAccess, err = c.authorize(ctx, authz, admin, "get")
+	require.NoError(t, err)
+	assert.True(t, fullAccess)
+	assert.Equal(t, 1, calls)
+
+	// decisions are per verb and per user
+	_, err = c.authorize(ctx, authz, admin, "list")
+	require.NoError(t, err)
+	fullAccess, err = c.authorize(ctx, authz, bob, "get")
+	require.NoError(t, err)
+	assert.False(t, fullAccess)
+	assert.Equal(t, 3, calls)
+
+	// the order of the groups doesn't matter, the groups themselves do
+	_, err = c.authorize(ctx, authz, &user.DefaultInfo{Name: "bob", Groups: []string{"a", "b"}}, "get")
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	_, err = c.authorize(ctx, authz, &user.DefaultInfo{Name: "bob", Groups: []string{"a"}}, "get")
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+
+	// so do the extra attributes
+	_, err = c.authorize(ctx, authz, &user.DefaultInfo{Name: "bob", Groups: []string{"a"}, Extra: map[string][]string{"scopes": {"read"}}}, "get")
+	require.NoError(t, err)
+	assert.Equal(t, 5, calls)
+	_, err = c.authorize(ctx, authz, &user.DefaultInfo{Name: "bob", Groups: []string{"a"}, Extra: map[string][]string{"scopes": {"read"}}}, "get")
+	require.NoError(t, err)
+	assert.Equal(t, 5, calls)
+
+	// a purge applies permission changes to the next request
+	allowed["bob"] = true
+	c.purge()
+	fullAccess, err = c.authorize(ctx, authz, bob, "get")
+	require.NoError(t, err)
+	assert.True(t, fullAccess)
+	assert.Equal(t, 6, calls)
+
+	// errors are not cached
+	c.purge()
+	authzErr = errors.New("unavailable")
+	_, err = c.authorize(ctx, authz, admin, "get")
+	assert.Error(t, err)
+	authzErr = nil
+	fullAccess, err = c.authorize(ctx, authz, admin, "get")
+	require.NoError(t, err)
+	assert.True(t, fullAccess)
+	assert.Equal(t, 8, calls)
+}
+
+func TestDecisionCacheNil(t *testing.T) {
+	calls := 0
+	authz := authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+		calls++
+		return authorizer.DecisionAllow, "", nil
+	})
+
+	var c *decisionCache
+	for range 2 {
+		fullAccess, err := c.authorize(context.Background(), authz, &user.DefaultInfo{Name: "admin"}, "get")
+		require.NoError(t, err)
+		assert.True(t, fullAccess)
+	}
+	assert.Equal(t, 2, calls)
+}
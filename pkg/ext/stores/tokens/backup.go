@@ -0,0 +1,237 @@
+package tokens
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/rancher/rancher/pkg/capr"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// BackupVersion is the version of the serialization format written by Export,
+// and accepted by Import.
+const BackupVersion = "v1"
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+// Backup is the serialized form of the ext tokens, as written by Export and
+// consumed by Import.
+type Backup struct {
+	Version string       `json:"version"`
+	Items   []BackupItem `json:"items"`
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+// BackupItem holds a single backed up token. Data is the data of its backing
+// secret, including the creation time and the last activity of the token,
+// except for the hash. The hash is resolved from the backend it is stored in,
+// for the restored token to keep working for its owner whatever the hash
+// backend of the restoring Rancher.
+type BackupItem struct {
+	Name        string            `json:"name"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Data        map[string][]byte `json:"data"`
+	Hash        string            `json:"hash"`
+}
+
+// Export serializes all ext tokens for backup. Broken tokens are skipped, the
+// same as they are for listing.
+func (t *SystemStore) Export() (*Backup, error) {
+	secrets, err := t.secretClient.List(TokenNamespace, metav1.ListOptions{
+		LabelSelector: labels.Set{SecretKindLabel: SecretKindLabelValue}.AsSelector().String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+
+	backup := &Backup{
+		Version: BackupVersion,
+		Items:   make([]BackupItem, 0, len(secrets.Items)),
+	}
+	for _, secret := range secrets.Items {
+		if _, err := fromSecret(&secret); err != nil {
+			logrus.Warnf("tokens: export: skipping broken token %s: %s", secret.Name, err)
+			continue
+		}
+
+		hash, err := t.hashes.Load(string(secret.Data[FieldHash]))
+		if err != nil {
+			return nil, fmt.Errorf("token %s: failed to load hash: %w", secret.Name, err)
+		}
+
+		data := maps.Clone(secret.Data)
+		delete(data, FieldHash)
+		if _, ok := data[FieldCreationTimestamp]; !ok && !secret.CreationTimestamp.IsZero() {
+			data[FieldCreationTimestamp] = []byte(secret.CreationTimestamp.UTC().Format(time.RFC3339))
+		}
+
+		backup.Items = append(backup.Items, BackupItem{
+			Name:        secret.Name,
+			Labels:      secret.Labels,
+			Annotations: secret.Annotations,
+			Data:        data,
+			Hash:        hash,
+		})
+	}
+
+	return backup, nil
+}
+
+// Import restores ext tokens from a backup written by Export. Tokens which
+// already exist are left untouched. The hashes of the restored tokens are
+// stored with the configured hash backend, all other data is kept as is.
+// Errors for individual tokens do not abort the restore, they are collected
+// and returned at the end.
+func (t *SystemStore) Import(backup *Backup) error {
+	if backup == nil {
+		return nil
+	}
+	if backup.Version != BackupVersion {
+		return fmt.Errorf("unsupported token backup version %q", backup.Version)
+	}
+
+	if err := t.ensureNamespace(); err != nil {
+		return fmt.Errorf("error ensuring namespace %s: %w", TokenNamespace, err)
+	}
+
+	var errs []error
+	for _, item := range backup.Items {
+		if err := t.importItem(item); err != nil {
+			errs = append(errs, fmt.Errorf("token %s: %w", item.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// importItem restores a single token from its backup.
+func (t *SystemStore) importItem(item BackupItem) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        item.Name,
+			Namespace:   TokenNamespace,
+			Labels:      maps.Clone(item.Labels),
+			Annotations: item.Annotations,
+		},
+		Data: maps.Clone(item.Data),
+	}
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels[SecretKindLabel] = SecretKindLabelValue
+	secret.Labels[capr.BackupLabel] = "true"
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[FieldHash] = []byte(item.Hash)
+
+	// Refuse to restore something which cannot be read back as a token.
+	if _, err := fromSecret(secret); err != nil {
+		return fmt.Errorf("invalid backup data: %w", err)
+	}
+
+	if _, err := t.secretClient.Get(TokenNamespace, item.Name, metav1.GetOptions{}); err == nil {
+		logrus.Debugf("tokens: import: token %s already exists, skipping", item.Name)
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	stored, err := t.hashes.Store(string(secret.Data[FieldUID]), item.Hash)
+	if err != nil {
+		return fmt.Errorf("failed to store hash: %w", err)
+	}
+	secret.Data[FieldHash] = []byte(stored)
+
+	if _, err := t.secretClient.Create(secret); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// Restored concurrently, the hash stored for the same UID is the one of the existing token.
+			return nil
+		}
+		if err := t.hashes.Delete(stored); err != nil {
+			logrus.Errorf("tokens: import: failed to delete hash of token %s: %v", item.Name, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarkForBackup adds the backup label to the backing secrets of all ext tokens
+// created before the label was introduced. It also records their creation
+// time in the data of the secrets, for restored tokens to keep their
+// expiration.
+func (t *SystemStore) MarkForBackup() error {
+	unmarked, err := labels.NewRequirement(capr.BackupLabel, selection.DoesNotExist, nil)
+	if err != nil {
+		return err
+	}
+	selector := labels.Set{SecretKindLabel: SecretKindLabelValue}.AsSelector().Add(*unmarked)
+
+	secrets, err := t.secretClient.List(TokenNamespace, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list tokens: %w", err)
+	}
+
+	var errs []error
+	for _, secret := range secrets.Items {
+		patch, err := backupPatch(&secret)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("token %s: %w", secret.Name, err))
+			continue
+		}
+		if _, err := t.secretClient.Patch(TokenNamespace, secret.Name, types.MergePatchType, patch); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("token %s: %w", secret.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// backupPatch returns the merge patch marking the secret for backup.
+func backupPatch(secret *corev1.Secret) ([]byte, error) {
+	patch := map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]string{capr.BackupLabel: "true"},
+		},
+	}
+	if _, ok := secret.Data[FieldCreationTimestamp]; !ok && !secret.CreationTimestamp.IsZero() {
+		patch["data"] = map[string][]byte{
+			FieldCreationTimestamp: []byte(secret.CreationTimestamp.UTC().Format(time.RFC3339)),
+		}
+	}
+	return json.Marshal(patch)
+}
+
+// creationTimestamp returns the creation time of the token backed by the
+// secret. A secret restored by rancher-backup is created anew, and the time
+// recorded in its data takes precedence over the one of its metadata. Only
+// the store writes the data, users cannot change it.
+func creationTimestamp(secret *corev1.Secret) (metav1.Time, error) {
+	value := string(secret.Data[FieldCreationTimestamp])
+	if value == "" {
+		return secret.CreationTimestamp, nil
+	}
+
+	ts, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return metav1.Time{}, fmt.Errorf("failed to parse %s: %w", FieldCreationTimestamp, err)
+	}
+
+	return metav1.NewTime(ts), nil
+}
@@ -0,0 +1,259 @@
+package tokens
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/tokens"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_SystemStore_MarkForBackup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+
+	users.EXPECT().Cache().Return(nil)
+	secrets.EXPECT().Cache().Return(nil)
+
+	store := NewSystem(nil, nil, secrets, users, nil, nil, nil, nil)
+
+	secrets.EXPECT().List("cattle-tokens", metav1.ListOptions{
+		LabelSelector: "cattle.io/kind=token,!resources.cattle.io/backup",
+	}).Return(&corev1.SecretList{
+		Items: []corev1.Secret{properSecret},
+	}, nil)
+	secrets.EXPECT().Patch("cattle-tokens", "bogus", types.MergePatchType,
+		[]byte(`{"metadata":{"labels":{"resources.cattle.io/backup":"true"}}}`)).
+		Return(nil, fmt.Errorf("some error"))
+
+	err := store.MarkForBackup()
+	assert.ErrorContains(t, err, "token bogus: some error")
+}
+
+func Test_SystemStore_MarkForBackup_CreationTimestamp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+
+	users.EXPECT().Cache().Return(nil)
+	secrets.EXPECT().Cache().Return(nil)
+
+	store := NewSystem(nil, nil, secrets, users, nil, nil, nil, nil)
+
+	unmarked := properSecret.DeepCopy()
+	unmarked.CreationTimestamp = metav1.NewTime(time.Date(2024, 12, 6, 3, 2, 1, 0, time.UTC))
+
+	secrets.EXPECT().List("cattle-tokens", metav1.ListOptions{
+		LabelSelector: "cattle.io/kind=token,!resources.cattle.io/backup",
+	}).Return(&corev1.SecretList{
+		Items: []corev1.Secret{*unmarked},
+	}, nil)
+	secrets.EXPECT().Patch("cattle-tokens", "bogus", types.MergePatchType,
+		[]byte(`{"data":{"creation-timestamp":"MjAyNC0xMi0wNlQwMzowMjowMVo="},"metadata":{"labels":{"resources.cattle.io/backup":"true"}}}`)).
+		Return(nil, nil)
+
+	require.NoError(t, store.MarkForBackup())
+}
+
+func Test_creationTimestamp(t *testing.T) {
+	created := metav1.NewTime(time.Date(2024, 12, 6, 3, 2, 1, 0, time.UTC))
+	restored := metav1.NewTime(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	t.Run("from metadata", func(t *testing.T) {
+		secret := properSecret.DeepCopy()
+		secret.CreationTimestamp = created
+
+		token, err := fromSecret(secret)
+		require.NoError(t, err)
+		assert.Equal(t, created, token.CreationTimestamp)
+	})
+
+	t.Run("from data of restored secret", func(t *testing.T) {
+		secret := properSecret.DeepCopy()
+		secret.CreationTimestamp = restored
+		secret.Data[FieldCreationTimestamp] = []byte(created.Format(time.RFC3339))
+
+		token, err := fromSecret(secret)
+		require.NoError(t, err)
+		assert.True(t, created.Equal(&token.CreationTimestamp))
+	})
+
+	t.Run("annotations are ignored", func(t *testing.T) {
+		secret := properSecret.DeepCopy()
+		secret.CreationTimestamp = created
+		secret.Annotations = map[string]string{
+			"ext.cattle.io/restored-creation-timestamp": restored.Format(time.RFC3339),
+		}
+
+		token, err := fromSecret(secret)
+		require.NoError(t, err)
+		assert.Equal(t, created, token.CreationTimestamp)
+	})
+
+	t.Run("broken", func(t *testing.T) {
+		secret := properSecret.DeepCopy()
+		secret.Data[FieldCreationTimestamp] = []byte("yesterday")
+
+		_, err := fromSecret(secret)
+		assert.ErrorContains(t, err, "failed to parse creation-timestamp")
+	})
+}
+
+func Test_creationTimestamp_SessionMaxTTL(t *testing.T) {
+	setSetting(t, settings.AuthUserSessionMaxTTL, "12h")
+
+	created := metav1.NewTime(time.Date(2024, 12, 6, 3, 2, 1, 0, time.UTC))
+	restored := metav1.NewTime(created.Add(11 * time.Hour))
+
+	// A restored secret, whose owner tries to extend the session by claiming a later creation time.
+	secret := properSecret.DeepCopy()
+	secret.CreationTimestamp = restored
+	secret.Data[FieldCreationTimestamp] = []byte(created.Format(time.RFC3339))
+	secret.Annotations = map[string]string{
+		"ext.cattle.io/restored-creation-timestamp": restored.Format(time.RFC3339),
+	}
+
+	token, err := fromSecret(secret)
+	require.NoError(t, err)
+
+	expiresAt, ok := tokens.SessionMaxExpiresAt(token.CreationTimestamp.Time)
+	require.True(t, ok)
+	assert.True(t, created.Add(12*time.Hour).Equal(expiresAt))
+}
+
+func Test_SystemStore_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+
+	users.EXPECT().Cache().Return(nil)
+	secrets.EXPECT().Cache().Return(nil)
+
+	store := NewSystem(nil, nil, secrets, users, nil, nil, nil, nil)
+
+	created := metav1.NewTime(time.Date(2024, 12, 6, 3, 2, 1, 0, time.UTC))
+	secret := properSecret.DeepCopy()
+	secret.CreationTimestamp = created
+	secret.Data[FieldLastActivitySeen] = []byte("2024-12-06T04:02:01Z")
+
+	secrets.EXPECT().List("cattle-tokens", metav1.ListOptions{
+		LabelSelector: "cattle.io/kind=token",
+	}).Return(&corev1.SecretList{
+		Items: []corev1.Secret{*secret, badSecret},
+	}, nil)
+
+	backup, err := store.Export()
+	require.NoError(t, err)
+	assert.Equal(t, BackupVersion, backup.Version)
+	// The broken token is skipped.
+	require.Len(t, backup.Items, 1)
+
+	item := backup.Items[0]
+	assert.Equal(t, "bogus", item.Name)
+	assert.Equal(t, "kla9jkdmj", item.Hash)
+	assert.NotContains(t, item.Data, FieldHash)
+	assert.Equal(t, "2024-12-06T03:02:01Z", string(item.Data[FieldCreationTimestamp]))
+	assert.Equal(t, "2024-12-06T04:02:01Z", string(item.Data[FieldLastActivitySeen]))
+	// The secret listed is left untouched.
+	assert.Equal(t, "kla9jkdmj", string(secret.Data[FieldHash]))
+}
+
+func Test_SystemStore_Import(t *testing.T) {
+	created := metav1.NewTime(time.Date(2024, 12, 6, 3, 2, 1, 0, time.UTC))
+	item := BackupItem{
+		Name:   "bogus",
+		Labels: map[string]string{UserIDLabel: properUser},
+		Data: map[string][]byte{
+			FieldCreationTimestamp: []byte(created.Format(time.RFC3339)),
+			FieldDescription:       []byte(""),
+			FieldEnabled:           []byte("false"),
+			FieldKind:              []byte(IsLogin),
+			FieldLastUpdateTime:    []byte("13:00:05"),
+			FieldPrincipal:         properPrincipalBytes,
+			FieldTTL:               []byte("4000"),
+			FieldUID:               []byte("2905498-kafld-lkad"),
+			FieldUserID:            []byte(properUser),
+		},
+		Hash: "kla9jkdmj",
+	}
+
+	setup := func(t *testing.T) (*SystemStore, *fake.MockControllerInterface[*corev1.Secret, *corev1.SecretList]) {
+		ctrl := gomock.NewController(t)
+
+		namespaces := fake.NewMockNonNamespacedControllerInterface[*corev1.Namespace, *corev1.NamespaceList](ctrl)
+		nsCache := fake.NewMockNonNamespacedCacheInterface[*corev1.Namespace](ctrl)
+		secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+		users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+
+		users.EXPECT().Cache().Return(nil)
+		secrets.EXPECT().Cache().Return(nil)
+		nsCache.EXPECT().Get("cattle-tokens").Return(&corev1.Namespace{}, nil)
+
+		return NewSystem(namespaces, nsCache, secrets, users, nil, nil, nil, nil), secrets
+	}
+
+	t.Run("hash and data are restored", func(t *testing.T) {
+		store, secrets := setup(t)
+
+		secrets.EXPECT().Get("cattle-tokens", "bogus", metav1.GetOptions{}).
+			Return(nil, apierrors.NewNotFound(corev1.Resource("secrets"), "bogus"))
+		secrets.EXPECT().Create(gomock.Any()).DoAndReturn(func(secret *corev1.Secret) (*corev1.Secret, error) {
+			assert.Equal(t, "cattle-tokens", secret.Namespace)
+			assert.Equal(t, "true", secret.Labels["resources.cattle.io/backup"])
+			assert.Equal(t, SecretKindLabelValue, secret.Labels[SecretKindLabel])
+			assert.Equal(t, "kla9jkdmj", string(secret.Data[FieldHash]))
+
+			token, err := fromSecret(secret)
+			require.NoError(t, err)
+			assert.True(t, created.Equal(&token.CreationTimestamp))
+			return secret, nil
+		})
+
+		require.NoError(t, store.Import(&Backup{Version: BackupVersion, Items: []BackupItem{item}}))
+		// The backup is left untouched.
+		assert.NotContains(t, item.Data, FieldHash)
+	})
+
+	t.Run("existing tokens are skipped", func(t *testing.T) {
+		store, secrets := setup(t)
+
+		secrets.EXPECT().Get("cattle-tokens", "bogus", metav1.GetOptions{}).Return(&properSecret, nil)
+
+		require.NoError(t, store.Import(&Backup{Version: BackupVersion, Items: []BackupItem{item}}))
+	})
+
+	t.Run("invalid tokens are reported", func(t *testing.T) {
+		store, _ := setup(t)
+
+		broken := item
+		broken.Data = map[string][]byte{FieldUID: []byte("2905498-kafld-lkad")}
+
+		err := store.Import(&Backup{Version: BackupVersion, Items: []BackupItem{broken}})
+		assert.ErrorContains(t, err, "token bogus: invalid backup data")
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+		users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+		users.EXPECT().Cache().Return(nil)
+		secrets.EXPECT().Cache().Return(nil)
+
+		err := NewSystem(nil, nil, secrets, users, nil, nil, nil, nil).Import(&Backup{Version: "v0"})
+		assert.EqualError(t, err, `unsupported token backup version "v0"`)
+	})
+}
@@ -0,0 +1,219 @@
+package tokens
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rancher/rancher/pkg/settings"
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	// HashBackendSecret keeps the hashes of the tokens in their backing secrets.
+	HashBackendSecret = "secret"
+	// HashBackendVault keeps the hashes of the tokens in a HashiCorp Vault KV version 2 secrets engine, the backing
+	// secrets of the tokens only reference them.
+	HashBackendVault = "vault"
+
+	// VaultTokenFileEnv is the environment variable holding the path of the file containing the Vault token, e.g.
+	// written by the Vault agent. It is not a setting, as whoever can change the settings could otherwise have the
+	// content of any file of the Rancher container sent to a server of their choice.
+	VaultTokenFileEnv = "CATTLE_TOKEN_HASH_VAULT_TOKEN_FILE"
+
+	defaultVaultTokenFile = "/var/run/secrets/vault/token"
+	vaultReferencePrefix  = "vault:"
+	vaultTokenHeader      = "X-Vault-Token"
+
+	// vaultRequestTimeout bounds the requests to Vault, hashes are loaded while authenticating requests.
+	vaultRequestTimeout = 5 * time.Second
+	// vaultHashCacheTTL is how long a hash loaded from Vault is reused. A hash never changes for a given reference,
+	// the TTL only bounds the memory kept for tokens no longer in use.
+	vaultHashCacheTTL = 10 * time.Minute
+	// vaultHashCacheSize is the maximum number of cached hashes loaded from Vault.
+	vaultHashCacheSize = 10000
+)
+
+// hashBackend is a helper interface hiding where the hashes of the tokens are persisted from the store. The value
+// returned by Store is kept in the backing secret of the token, in place of the hash.
+type hashBackend interface {
+	// Store saves the hash of the token with the given UID and returns the value to keep in its backing secret.
+	Store(uid, hash string) (string, error)
+	// Load returns the hash of a token, given the value kept in its backing secret.
+	Load(stored string) (string, error)
+	// Delete removes the hash of a token, given the value kept in its backing secret.
+	Delete(stored string) error
+	// External returns whether new hashes are stored outside of the backing secrets.
+	External() bool
+}
+
+// newHashBackend returns the hash backend configured by the token-hash-backend setting. Hashes are always loaded from
+// where they were stored, so that changing the setting only affects new tokens.
+func newHashBackend() hashBackend {
+	return &settingsHashBackend{
+		vault: &vaultHashBackend{
+			client: &http.Client{Timeout: vaultRequestTimeout},
+			hashes: cache.NewLRUExpireCache(vaultHashCacheSize),
+		},
+	}
+}
+
+// settingsHashBackend is an implementation of the hashBackend interface dispatching to the backend configured by the
+// settings.
+type settingsHashBackend struct {
+	vault *vaultHashBackend
+}
+
+func (b *settingsHashBackend) Store(uid, hash string) (string, error) {
+	if b.External() {
+		return b.vault.Store(uid, hash)
+	}
+	return hash, nil
+}
+
+func (b *settingsHashBackend) Load(stored string) (string, error) {
+	if strings.HasPrefix(stored, vaultReferencePrefix) {
+		return b.vault.Load(stored)
+	}
+	return stored, nil
+}
+
+func (b *settingsHashBackend) Delete(stored string) error {
+	if strings.HasPrefix(stored, vaultReferencePrefix) {
+		return b.vault.Delete(stored)
+	}
+	return nil
+}
+
+func (b *settingsHashBackend) External() bool {
+	return settings.TokenHashBackend.Get() == HashBackendVault
+}
+
+// vaultHashBackend stores the hashes of the tokens in a Vault KV version 2 secrets engine, at
+// <token-hash-vault-mount>/<token-hash-vault-path>/<token UID>. The backing secrets keep a reference of the form
+// vault:<mount>:<path>. Loaded hashes are cached, so that authenticating with a token does not need a request to
+// Vault each time.
+type vaultHashBackend struct {
+	client *http.Client
+	hashes *cache.LRUExpireCache
+}
+
+type vaultKVData struct {
+	Data struct {
+		Hash string `json:"hash"`
+	} `json:"data"`
+}
+
+func (b *vaultHashBackend) Store(uid, hash string) (string, error) {
+	mount := strings.Trim(settings.TokenHashVaultMount.Get(), "/")
+	path := strings.Trim(settings.TokenHashVaultPath.Get(), "/") + "/" + uid
+
+	var body vaultKVData
+	body.Data.Hash = hash
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	if _, err := b.do(http.MethodPost, mount+"/data/"+path, payload); err != nil {
+		return "", fmt.Errorf("failed to store token hash in vault: %w", err)
+	}
+	return vaultReferencePrefix + mount + ":" + path, nil
+}
+
+func (b *vaultHashBackend) Load(stored string) (string, error) {
+	if hash, ok := b.hashes.Get(stored); ok {
+		return hash.(string), nil
+	}
+
+	mount, path, err := parseVaultReference(stored)
+	if err != nil {
+		return "", err
+	}
+	response, err := b.do(http.MethodGet, mount+"/data/"+path, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to load token hash from vault: %w", err)
+	}
+
+	var secret struct {
+		Data vaultKVData `json:"data"`
+	}
+	if err := json.Unmarshal(response, &secret); err != nil {
+		return "", fmt.Errorf("failed to decode token hash from vault: %w", err)
+	}
+	if secret.Data.Data.Hash == "" {
+		return "", fmt.Errorf("token hash missing in vault at %s/%s", mount, path)
+	}
+	b.hashes.Add(stored, secret.Data.Data.Hash, vaultHashCacheTTL)
+	return secret.Data.Data.Hash, nil
+}
+
+func (b *vaultHashBackend) Delete(stored string) error {
+	mount, path, err := parseVaultReference(stored)
+	if err != nil {
+		return err
+	}
+	b.hashes.Remove(stored)
+	// deleting the metadata removes all the versions of the secret
+	if _, err := b.do(http.MethodDelete, mount+"/metadata/"+path, nil); err != nil {
+		return fmt.Errorf("failed to delete token hash from vault: %w", err)
+	}
+	return nil
+}
+
+// do sends a request to the Vault API, authenticated with the token read from the file named by VaultTokenFileEnv,
+// and returns the body of the response.
+func (b *vaultHashBackend) do(method, path string, payload []byte) ([]byte, error) {
+	address := strings.TrimRight(settings.TokenHashVaultAddress.Get(), "/")
+	if address == "" {
+		return nil, fmt.Errorf("setting %s is not set", settings.TokenHashVaultAddress.Name)
+	}
+	tokenFile := os.Getenv(VaultTokenFileEnv)
+	if tokenFile == "" {
+		tokenFile = defaultVaultTokenFile
+	}
+	vaultToken, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault token: %w", err)
+	}
+
+	req, err := http.NewRequest(method, address+"/v1/"+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(vaultTokenHeader, strings.TrimSpace(string(vaultToken)))
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound && method == http.MethodDelete {
+		return body, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status %d from vault: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// parseVaultReference returns the mount and path of the hash referenced by the value kept in the backing secret.
+func parseVaultReference(stored string) (string, string, error) {
+	mount, path, ok := strings.Cut(strings.TrimPrefix(stored, vaultReferencePrefix), ":")
+	if !ok || mount == "" || path == "" {
+		return "", "", fmt.Errorf("invalid vault reference %q", stored)
+	}
+	return mount, path, nil
+}
@@ -0,0 +1,159 @@
+package tokens
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVault is a minimal KV version 2 secrets engine.
+type fakeVault struct {
+	mu      sync.Mutex
+	secrets map[string]string
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get(vaultTokenHeader) != "vault-token" {
+		http.Error(w, "permission denied", http.StatusForbidden)
+		return
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	switch r.Method {
+	case http.MethodPost:
+		var body vaultKVData
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		v.secrets[path] = body.Data.Hash
+	case http.MethodGet:
+		hash, ok := v.secrets[path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		var data vaultKVData
+		data.Data.Hash = hash
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	case http.MethodDelete:
+		path = strings.Replace(path, "/metadata/", "/data/", 1)
+		if _, ok := v.secrets[path]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+		delete(v.secrets, path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func setSetting(t *testing.T, setting settings.Setting, value string) {
+	t.Helper()
+	previous := setting.Get()
+	require.NoError(t, setting.Set(value))
+	t.Cleanup(func() { _ = setting.Set(previous) })
+}
+
+func TestHashBackend(t *testing.T) {
+	vault := &fakeVault{secrets: map[string]string{}}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("vault-token\n"), 0600))
+
+	setSetting(t, settings.TokenHashVaultAddress, server.URL)
+	t.Setenv(VaultTokenFileEnv, tokenFile)
+	setSetting(t, settings.TokenHashVaultMount, "kv")
+	setSetting(t, settings.TokenHashVaultPath, "/rancher/tokens/")
+
+	backend := newHashBackend()
+
+	// secret backend keeps the hash as is
+	setSetting(t, settings.TokenHashBackend, HashBackendSecret)
+	assert.False(t, backend.External())
+	stored, err := backend.Store("uid-1", "hash-1")
+	require.NoError(t, err)
+	assert.Equal(t, "hash-1", stored)
+	assert.Empty(t, vault.secrets)
+
+	// vault backend only leaves a reference
+	setSetting(t, settings.TokenHashBackend, HashBackendVault)
+	assert.True(t, backend.External())
+	reference, err := backend.Store("uid-2", "hash-2")
+	require.NoError(t, err)
+	assert.Equal(t, "vault:kv:rancher/tokens/uid-2", reference)
+	assert.Equal(t, map[string]string{"kv/data/rancher/tokens/uid-2": "hash-2"}, vault.secrets)
+
+	// hashes are loaded from where they were stored, whatever the current setting
+	hash, err := backend.Load(reference)
+	require.NoError(t, err)
+	assert.Equal(t, "hash-2", hash)
+	hash, err = backend.Load(stored)
+	require.NoError(t, err)
+	assert.Equal(t, "hash-1", hash)
+
+	require.NoError(t, backend.Delete(stored))
+	require.NoError(t, backend.Delete(reference))
+	assert.Empty(t, vault.secrets)
+	require.NoError(t, backend.Delete(reference), "deleting a missing hash is not an error")
+
+	_, err = backend.Load(reference)
+	assert.Error(t, err)
+	_, err = backend.Load("vault:invalid")
+	assert.Error(t, err)
+}
+
+func TestHashBackendVaultUnauthorized(t *testing.T) {
+	server := httptest.NewServer(&fakeVault{secrets: map[string]string{}})
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("wrong-token"), 0600))
+
+	setSetting(t, settings.TokenHashVaultAddress, server.URL)
+	t.Setenv(VaultTokenFileEnv, tokenFile)
+	setSetting(t, settings.TokenHashBackend, HashBackendVault)
+
+	_, err := newHashBackend().Store("uid", "hash")
+	assert.ErrorContains(t, err, "unexpected status 403")
+}
+
+func TestHashBackendVaultCache(t *testing.T) {
+	vault := &fakeVault{secrets: map[string]string{}}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("vault-token"), 0600))
+
+	setSetting(t, settings.TokenHashVaultAddress, server.URL)
+	t.Setenv(VaultTokenFileEnv, tokenFile)
+	setSetting(t, settings.TokenHashBackend, HashBackendVault)
+
+	backend := newHashBackend()
+	reference, err := backend.Store("uid", "hash")
+	require.NoError(t, err)
+
+	hash, err := backend.Load(reference)
+	require.NoError(t, err)
+	assert.Equal(t, "hash", hash)
+
+	// loaded hashes are served from the cache
+	server.Close()
+	hash, err = backend.Load(reference)
+	require.NoError(t, err)
+	assert.Equal(t, "hash", hash)
+}
@@ -0,0 +1,115 @@
+package tokens
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	userOwnerAPIVersion = "management.cattle.io/v3"
+	userOwnerKind       = "User"
+)
+
+// userOwnerReference returns the owner reference of the backing secrets of the tokens of the user. A namespaced
+// secret can be owned by the cluster-scoped user, the garbage collector then deletes the secrets when the user is
+// deleted, even if the user lifecycle did not get to delete the tokens itself.
+func userOwnerReference(user *apiv3.User) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: userOwnerAPIVersion,
+		Kind:       userOwnerKind,
+		Name:       user.Name,
+		UID:        user.UID,
+	}
+}
+
+// isUserOwnerReference returns whether the reference is to a user.
+func isUserOwnerReference(ref metav1.OwnerReference) bool {
+	return ref.APIVersion == userOwnerAPIVersion && ref.Kind == userOwnerKind
+}
+
+// withUserOwnerReference returns the references with the given user reference in place of any other user reference,
+// or without any user reference if it is nil. A token is only ever owned by its user.
+func withUserOwnerReference(refs []metav1.OwnerReference, owner *metav1.OwnerReference) []metav1.OwnerReference {
+	var result []metav1.OwnerReference
+	for _, ref := range refs {
+		if !isUserOwnerReference(ref) {
+			result = append(result, ref)
+		}
+	}
+	if owner != nil {
+		result = append(result, *owner)
+	}
+	return result
+}
+
+// userOwnerReferenceOf returns the user reference of the given references, if any.
+func userOwnerReferenceOf(refs []metav1.OwnerReference) *metav1.OwnerReference {
+	for _, ref := range refs {
+		if isUserOwnerReference(ref) {
+			return &ref
+		}
+	}
+	return nil
+}
+
+// SetUserOwners adds the owner reference to their user to the backing secrets of all ext tokens created before the
+// references were introduced. Secrets of tokens whose user no longer exists are left alone, the user lifecycle is
+// responsible for them.
+func (t *SystemStore) SetUserOwners() error {
+	secrets, err := t.secretClient.List(TokenNamespace, metav1.ListOptions{
+		LabelSelector: labels.Set{SecretKindLabel: SecretKindLabelValue}.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list tokens: %w", err)
+	}
+
+	var errs []error
+	for _, secret := range secrets.Items {
+		if userOwnerReferenceOf(secret.OwnerReferences) != nil {
+			continue
+		}
+
+		userID := string(secret.Data[FieldUserID])
+		if userID == "" {
+			continue
+		}
+		user, err := t.userClient.Get(userID)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("token %s: %w", secret.Name, err))
+			}
+			continue
+		}
+
+		owner := userOwnerReference(user)
+		patch, err := ownerPatch(&secret, &owner)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("token %s: %w", secret.Name, err))
+			continue
+		}
+		if _, err := t.secretClient.Patch(TokenNamespace, secret.Name, types.MergePatchType, patch); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("token %s: %w", secret.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ownerPatch returns the merge patch adding the user owner reference to the secret. The patch is based on the
+// version of the secret read, to not overwrite concurrent changes of its references.
+func ownerPatch(secret *corev1.Secret, owner *metav1.OwnerReference) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"resourceVersion": secret.ResourceVersion,
+			"ownerReferences": withUserOwnerReference(secret.OwnerReferences, owner),
+		},
+	})
+}
@@ -0,0 +1,71 @@
+package tokens
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_withUserOwnerReference(t *testing.T) {
+	other := metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "1"}
+	stale := metav1.OwnerReference{APIVersion: "management.cattle.io/v3", Kind: "User", Name: "u-old", UID: "2"}
+	owner := userOwnerReference(&v3.User{ObjectMeta: metav1.ObjectMeta{Name: "u-new", UID: "3"}})
+
+	assert.Equal(t, []metav1.OwnerReference{other, owner},
+		withUserOwnerReference([]metav1.OwnerReference{other, stale}, &owner))
+	assert.Equal(t, []metav1.OwnerReference{other},
+		withUserOwnerReference([]metav1.OwnerReference{stale, other}, nil))
+	assert.Nil(t, withUserOwnerReference(nil, nil))
+}
+
+func Test_SystemStore_SetUserOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+	ucache := fake.NewMockNonNamespacedCacheInterface[*v3.User](ctrl)
+
+	users.EXPECT().Cache().Return(ucache)
+	secrets.EXPECT().Cache().Return(nil)
+
+	store := NewSystem(nil, nil, secrets, users, nil, nil, nil, nil)
+
+	owned := properSecret.DeepCopy()
+	owned.Name = "owned"
+	owned.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "management.cattle.io/v3",
+		Kind:       "User",
+		Name:       properUser,
+		UID:        "user-uid",
+	}}
+	orphan := properSecret.DeepCopy()
+	orphan.Name = "orphan"
+	orphan.Data[FieldUserID] = []byte("gone")
+	unowned := properSecret.DeepCopy()
+	unowned.ResourceVersion = "42"
+
+	secrets.EXPECT().List("cattle-tokens", metav1.ListOptions{
+		LabelSelector: "cattle.io/kind=token",
+	}).Return(&corev1.SecretList{
+		Items: []corev1.Secret{*owned, *orphan, *unowned},
+	}, nil)
+	ucache.EXPECT().Get("gone").
+		Return(nil, apierrors.NewNotFound(schema.GroupResource{Group: "management.cattle.io", Resource: "users"}, "gone"))
+	ucache.EXPECT().Get(properUser).Return(&v3.User{
+		ObjectMeta: metav1.ObjectMeta{Name: properUser, UID: "user-uid"},
+	}, nil)
+	secrets.EXPECT().Patch("cattle-tokens", "bogus", types.MergePatchType,
+		[]byte(`{"metadata":{"ownerReferences":[{"apiVersion":"management.cattle.io/v3","kind":"User","name":"lkajdlksjlkds","uid":"user-uid"}],"resourceVersion":"42"}}`)).
+		Return(nil, nil)
+
+	require.NoError(t, store.SetUserOwners())
+}
@@ -0,0 +1,158 @@
+package tokens
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// DeletedAtAnnotation marks a token deleted during the deletion grace period, with the time of the deletion. An
+	// admin restores the token by removing the annotation.
+	DeletedAtAnnotation = "ext.cattle.io/deleted-at"
+	// enabledBeforeDeletionAnnotation keeps whether a deleted token was enabled, to restore it as it was.
+	enabledBeforeDeletionAnnotation = "ext.cattle.io/enabled-before-deletion"
+)
+
+// deletionGracePeriod returns how long deleted tokens are kept before they are purged.
+func deletionGracePeriod() time.Duration {
+	return time.Duration(settings.ExtTokenDeletionGracePeriodMinutes.GetInt()) * time.Minute
+}
+
+// deletedAt returns when the token was deleted, if it is in its deletion grace period.
+func deletedAt(token *ext.Token) (time.Time, bool) {
+	value, ok := token.Annotations[DeletedAtAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	deleted, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// Purge the token at the next opportunity rather than keeping it forever.
+		return time.Time{}, true
+	}
+	return deleted, true
+}
+
+// softDelete disables the token and marks it deleted, for it to be purged at the end of the deletion grace period.
+func (t *SystemStore) softDelete(token *ext.Token, now time.Time) (*ext.Token, error) {
+	enabled := token.Spec.Enabled == nil || *token.Spec.Enabled
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			// Fail on concurrent changes, e.g. restoring or re-enabling the token.
+			"resourceVersion": token.ResourceVersion,
+			"annotations": map[string]string{
+				DeletedAtAnnotation:             now.UTC().Format(time.RFC3339),
+				enabledBeforeDeletionAnnotation: strconv.FormatBool(enabled),
+			},
+		},
+		"data": map[string]string{
+			FieldEnabled: base64.StdEncoding.EncodeToString([]byte("false")),
+		},
+	})
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to marshal patch: %w", err))
+	}
+
+	secret, err := t.secretClient.Patch(TokenNamespace, token.Name, types.MergePatchType, patch)
+	if err != nil {
+		if apierrors.IsConflict(err) {
+			return nil, apierrors.NewConflict(GVR.GroupResource(), token.Name, errors.New(optimisticLockErrorMsg))
+		}
+		if apierrors.IsNotFound(err) {
+			return nil, apierrors.NewNotFound(GVR.GroupResource(), token.Name)
+		}
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to mark token %s deleted: %w", token.Name, err))
+	}
+
+	deleted, err := fromSecret(secret)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error converting secret %s to token: %w", secret.Name, err))
+	}
+	deleted.Status.Value = ""
+	return deleted, nil
+}
+
+// restoreUpdate handles the deletion grace period of the token in an update. Only admins may restore a deleted token,
+// by removing the DeletedAtAnnotation; a deleted token can't be changed otherwise, and the deletion annotations can't
+// be set by an update.
+func restoreUpdate(fullPermission bool, oldToken, token *ext.Token) error {
+	_, oldDeleted := deletedAt(oldToken)
+	_, deleted := token.Annotations[DeletedAtAnnotation]
+
+	if !oldDeleted {
+		if _, ok := token.Annotations[enabledBeforeDeletionAnnotation]; deleted || ok {
+			return apierrors.NewBadRequest("tokens are marked deleted by deleting them")
+		}
+		return nil
+	}
+
+	if deleted || !fullPermission {
+		return apierrors.NewBadRequest(fmt.Sprintf("token %s is deleted, an admin can restore it by removing the annotation %s",
+			token.Name, DeletedAtAnnotation))
+	}
+
+	enabled, err := strconv.ParseBool(oldToken.Annotations[enabledBeforeDeletionAnnotation])
+	if err != nil {
+		enabled = false // Don't enable a token which may not have been.
+	}
+	token.Spec.Enabled = &enabled
+	delete(token.Annotations, enabledBeforeDeletionAnnotation)
+	return nil
+}
+
+// PurgeDeleted purges the tokens deleted longer than the deletion grace period ago.
+func (t *SystemStore) PurgeDeleted() {
+	secrets, err := t.secretCache.List(TokenNamespace, labels.SelectorFromSet(labels.Set{
+		SecretKindLabel: SecretKindLabelValue,
+	}))
+	if err != nil {
+		logrus.Errorf("Error listing tokens to purge: %v", err)
+		return
+	}
+
+	gracePeriod := deletionGracePeriod()
+	now := time.Now()
+
+	var count int
+	for _, secret := range secrets {
+		if _, ok := secret.Annotations[DeletedAtAnnotation]; !ok {
+			continue
+		}
+		token, err := fromSecret(secret)
+		if err != nil {
+			logrus.Errorf("Error converting secret %s to token: %v", secret.Name, err)
+			continue
+		}
+		deleted, _ := deletedAt(token)
+		if deleted.Add(gracePeriod).After(now) {
+			continue
+		}
+
+		err = t.secretClient.Delete(TokenNamespace, token.Name, &metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{ResourceVersion: &secret.ResourceVersion},
+		})
+		if err != nil {
+			if !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+				logrus.Errorf("Error purging deleted token %s: %v", token.Name, err)
+			}
+			continue
+		}
+		t.DeleteHash(token)
+		count++
+	}
+	if count > 0 {
+		logrus.Infof("Purged %d deleted tokens", count)
+	}
+}
@@ -0,0 +1,173 @@
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+)
+
+func setDeletionGracePeriod(t *testing.T, minutes string) {
+	orig := settings.ExtTokenDeletionGracePeriodMinutes.Get()
+	t.Cleanup(func() {
+		require.NoError(t, settings.ExtTokenDeletionGracePeriodMinutes.Set(orig))
+	})
+	require.NoError(t, settings.ExtTokenDeletionGracePeriodMinutes.Set(minutes))
+}
+
+func deletedSecret(deleted time.Time) *corev1.Secret {
+	secret := properSecret.DeepCopy()
+	secret.Annotations = map[string]string{
+		DeletedAtAnnotation:             deleted.UTC().Format(time.RFC3339),
+		enabledBeforeDeletionAnnotation: "true",
+	}
+	return secret
+}
+
+func TestStoreDeleteGracePeriod(t *testing.T) {
+	setDeletionGracePeriod(t, "60")
+
+	newStore := func(t *testing.T, secret *corev1.Secret) (*Store, *fake.MockControllerInterface[*corev1.Secret, *corev1.SecretList]) {
+		ctrl := gomock.NewController(t)
+		secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+		users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+		auth := NewMockauthHandler(ctrl)
+
+		auth.EXPECT().UserName(gomock.Any(), gomock.Any(), "delete").
+			Return(&mockUser{name: properUser}, false, true, nil)
+		users.EXPECT().Cache().Return(nil)
+		secrets.EXPECT().Cache().Return(nil)
+		secrets.EXPECT().Get("cattle-tokens", "bogus", gomock.Any()).
+			Return(secret, nil)
+
+		return New(nil, nil, nil, secrets, users, nil, nil, nil, auth), secrets
+	}
+
+	t.Run("token is disabled and marked deleted", func(t *testing.T) {
+		secret := properSecret.DeepCopy()
+		secret.Data[FieldEnabled] = []byte("true")
+		store, secrets := newStore(t, secret)
+
+		secrets.EXPECT().Patch("cattle-tokens", "bogus", types.MergePatchType, gomock.Any()).
+			DoAndReturn(func(_, _ string, _ types.PatchType, data []byte, _ ...string) (*corev1.Secret, error) {
+				var patch struct {
+					Metadata struct {
+						Annotations map[string]string `json:"annotations"`
+					} `json:"metadata"`
+					Data map[string]string `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(data, &patch))
+				assert.Equal(t, "true", patch.Metadata.Annotations[enabledBeforeDeletionAnnotation])
+				assert.Equal(t, "ZmFsc2U=", patch.Data[FieldEnabled]) // false
+
+				return deletedSecret(time.Now()), nil
+			})
+
+		obj, ok, err := store.Delete(context.TODO(), "bogus", nil, &metav1.DeleteOptions{})
+		require.NoError(t, err)
+		assert.False(t, ok)
+		token := obj.(*ext.Token)
+		assert.Contains(t, token.Annotations, DeletedAtAnnotation)
+		assert.False(t, *token.Spec.Enabled)
+	})
+
+	t.Run("deleting a deleted token does nothing", func(t *testing.T) {
+		store, _ := newStore(t, deletedSecret(time.Now()))
+
+		_, ok, err := store.Delete(context.TODO(), "bogus", nil, &metav1.DeleteOptions{})
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("zero grace period deletes immediately", func(t *testing.T) {
+		store, secrets := newStore(t, deletedSecret(time.Now()))
+		secrets.EXPECT().Delete("cattle-tokens", "bogus", gomock.Any()).Return(nil)
+
+		_, ok, err := store.Delete(context.TODO(), "bogus", nil, &metav1.DeleteOptions{GracePeriodSeconds: pointer.Int64(0)})
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestRestoreUpdate(t *testing.T) {
+	deleted := func(enabledBefore string) *ext.Token {
+		token := properToken.DeepCopy()
+		token.Annotations = map[string]string{
+			DeletedAtAnnotation:             "2025-02-05T13:10:00Z",
+			enabledBeforeDeletionAnnotation: enabledBefore,
+		}
+		return token
+	}
+
+	// an admin restores a deleted token as it was
+	token := deleted("true")
+	delete(token.Annotations, DeletedAtAnnotation)
+	require.NoError(t, restoreUpdate(true, deleted("true"), token))
+	assert.True(t, *token.Spec.Enabled)
+	assert.Empty(t, token.Annotations)
+
+	token = deleted("false")
+	delete(token.Annotations, DeletedAtAnnotation)
+	require.NoError(t, restoreUpdate(true, deleted("false"), token))
+	assert.False(t, *token.Spec.Enabled)
+
+	// other users can't restore it
+	token = deleted("true")
+	delete(token.Annotations, DeletedAtAnnotation)
+	assert.True(t, apierrors.IsBadRequest(restoreUpdate(false, deleted("true"), token)))
+
+	// nor can a deleted token be changed otherwise
+	token = deleted("true")
+	token.Spec.Description = "changed"
+	assert.True(t, apierrors.IsBadRequest(restoreUpdate(true, deleted("true"), token)))
+
+	// nor can a token be marked deleted by an update
+	token = properToken.DeepCopy()
+	token.Annotations = map[string]string{DeletedAtAnnotation: "2025-02-05T13:10:00Z"}
+	assert.True(t, apierrors.IsBadRequest(restoreUpdate(true, &properToken, token)))
+
+	assert.NoError(t, restoreUpdate(false, &properToken, properToken.DeepCopy()))
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	setDeletionGracePeriod(t, "60")
+
+	ctrl := gomock.NewController(t)
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	scache := fake.NewMockCacheInterface[*corev1.Secret](ctrl)
+	users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+
+	users.EXPECT().Cache().Return(nil)
+	secrets.EXPECT().Cache().Return(scache)
+
+	expired := deletedSecret(time.Now().Add(-2 * time.Hour))
+	expired.Name = "expired"
+	expired.ResourceVersion = "5"
+	recent := deletedSecret(time.Now().Add(-time.Minute))
+	recent.Name = "recent"
+	scache.EXPECT().List("cattle-tokens", gomock.Any()).
+		DoAndReturn(func(_ string, selector labels.Selector) ([]*corev1.Secret, error) {
+			assert.Equal(t, "cattle.io/kind=token", selector.String())
+			return []*corev1.Secret{properSecret.DeepCopy(), expired, recent}, nil
+		})
+	secrets.EXPECT().Delete("cattle-tokens", "expired", &metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: pointer.String("5")},
+	}).Return(nil)
+
+	store := NewSystem(nil, nil, secrets, users, nil, nil, nil, nil)
+	store.PurgeDeleted()
+}
@@ -0,0 +1,141 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/tracing"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// StatusResourceName is the name the status subresource of tokens is installed under.
+const StatusResourceName = PluralName + "/status"
+
+var (
+	_ rest.Getter                   = &StatusStore{}
+	_ rest.Updater                  = &StatusStore{}
+	_ rest.Storage                  = &StatusStore{}
+	_ rest.Scoper                   = &StatusStore{}
+	_ rest.GroupVersionKindProvider = &StatusStore{}
+)
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+// StatusStore is the store of the status subresource of tokens. Most of the
+// status of a token is computed by the store. Only the times of the last use
+// of the token and of the last activity seen with it can be updated, by users
+// with full access to the tokens, e.g. controllers. Any other change of the
+// token is ignored, just as updates of the main resource ignore changes of the
+// status.
+type StatusStore struct {
+	store *Store
+}
+
+// NewStatus returns the store of the status subresource of the tokens of the
+// given store.
+func NewStatus(store *Store) *StatusStore {
+	return &StatusStore{store: store}
+}
+
+// GroupVersionKind implements [rest.GroupVersionKindProvider], a required interface.
+func (s *StatusStore) GroupVersionKind(_ schema.GroupVersion) schema.GroupVersionKind {
+	return GVK
+}
+
+// NamespaceScoped implements [rest.Scoper], a required interface.
+func (s *StatusStore) NamespaceScoped() bool {
+	return false
+}
+
+// New implements [rest.Storage], a required interface.
+func (s *StatusStore) New() runtime.Object {
+	return s.store.New()
+}
+
+// Destroy implements [rest.Storage], a required interface.
+func (s *StatusStore) Destroy() {
+}
+
+// Get implements [rest.Getter], the interface to support the `get` verb.
+func (s *StatusStore) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return s.store.Get(ctx, name, options)
+}
+
+// Update implements [rest.Updater], the interface to support the `update` and
+// `patch` verbs.
+func (s *StatusStore) Update(
+	ctx context.Context,
+	name string,
+	objInfo rest.UpdatedObjectInfo,
+	createValidation rest.ValidateObjectFunc,
+	updateValidation rest.ValidateObjectUpdateFunc,
+	forceAllowCreate bool,
+	options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	ctx, span := tracing.Start(ctx, "tokens.UpdateStatus")
+	defer span.End()
+
+	userInfo, fullAccess, _, err := s.store.auth.UserName(ctx, &s.store.SystemStore, "update")
+	if err != nil {
+		return nil, false, err
+	}
+	if !fullAccess {
+		return nil, false, apierrors.NewForbidden(GVR.GroupResource(), name,
+			fmt.Errorf("user %s is not allowed to update the status of tokens", userInfo.GetName()))
+	}
+
+	oldToken, err := s.store.currentToken(name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	newObj, err := objInfo.UpdatedObject(ctx, oldToken)
+	if err != nil {
+		return nil, false, apierrors.NewInternalError(fmt.Errorf("error getting updated object: %w", err))
+	}
+
+	newToken, ok := newObj.(*ext.Token)
+	if !ok {
+		return nil, false, apierrors.NewBadRequest(fmt.Sprintf("invalid object type %T", newObj))
+	}
+
+	if updateValidation != nil {
+		err = updateValidation(ctx, newObj, oldToken)
+		if err != nil {
+			return nil, false, apierrors.NewBadRequest(fmt.Sprintf("error validating update: %s", err))
+		}
+	}
+
+	authTokenID, err := s.store.auth.SessionID(ctx)
+	if err != nil {
+		return nil, false, apierrors.NewInternalError(fmt.Errorf("error getting the authentication token: %w", err))
+	}
+
+	resultToken, err := s.store.SystemStore.updateStatus(authTokenID, oldToken, newToken, options)
+
+	return resultToken, false, err
+}
+
+// updateStatus stores the changes of the updatable status fields of the token,
+// and keeps the rest of it unchanged.
+func (t *SystemStore) updateStatus(authTokenID string, oldToken, token *ext.Token,
+	options *metav1.UpdateOptions) (*ext.Token, error) {
+	dryRun := options != nil && len(options.DryRun) > 0 && options.DryRun[0] == metav1.DryRunAll
+
+	if token.ObjectMeta.UID != oldToken.ObjectMeta.UID {
+		return nil, apierrors.NewBadRequest("meta.UID is immutable")
+	}
+
+	updated := oldToken.DeepCopy()
+	updated.ResourceVersion = token.ResourceVersion
+	updated.Status.LastUsedAt = token.Status.LastUsedAt
+	updated.Status.LastActivitySeen = token.Status.LastActivitySeen
+	updated.Status.Value = ""
+
+	return t.save(authTokenID, oldToken, updated, dryRun)
+}
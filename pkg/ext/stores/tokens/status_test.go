@@ -0,0 +1,84 @@
+package tokens
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+func Test_StatusStore_Update(t *testing.T) {
+	lastUsedAt := metav1.NewTime(time.Date(2024, 12, 6, 3, 2, 1, 0, time.UTC))
+
+	t.Run("no full access, forbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+		users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+		auth := NewMockauthHandler(ctrl)
+
+		auth.EXPECT().UserName(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&mockUser{name: properUser}, false, true, nil)
+		users.EXPECT().Cache().Return(nil)
+		secrets.EXPECT().Cache().Return(nil)
+
+		store := NewStatus(New(nil, nil, nil, secrets, users, nil, nil, nil, auth))
+		changed := properToken.DeepCopy()
+		changed.Status.LastUsedAt = &lastUsedAt
+
+		_, _, err := store.Update(context.TODO(), "bogus", rest.DefaultUpdatedObjectInfo(changed),
+			nil, nil, false, &metav1.UpdateOptions{})
+		assert.True(t, apierrors.IsForbidden(err))
+	})
+
+	t.Run("only the updatable status fields change", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+		scache := fake.NewMockCacheInterface[*corev1.Secret](ctrl)
+		users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+		auth := NewMockauthHandler(ctrl)
+
+		auth.EXPECT().UserName(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&mockUser{name: "admin"}, true, true, nil)
+		auth.EXPECT().SessionID(gomock.Any()).Return("", nil)
+		users.EXPECT().Cache().Return(nil)
+		secrets.EXPECT().Cache().Return(scache)
+		scache.EXPECT().Get("cattle-tokens", "bogus").Return(&properSecret, nil)
+
+		var stored *corev1.Secret
+		secrets.EXPECT().Update(gomock.Any()).DoAndReturn(func(secret *corev1.Secret) (*corev1.Secret, error) {
+			stored = secret
+			return &properSecret, nil
+		})
+
+		store := NewStatus(New(nil, nil, nil, secrets, users, nil, nil, nil, auth))
+		changed := properToken.DeepCopy()
+		changed.Spec.Description = "changed"
+		changed.Spec.TTL = 10000
+		changed.Status.Hash = "changed"
+		changed.Status.LastUpdateTime = "changed"
+		changed.Status.LastUsedAt = &lastUsedAt
+		changed.Status.LastActivitySeen = &lastUsedAt
+
+		_, created, err := store.Update(context.TODO(), "bogus", rest.DefaultUpdatedObjectInfo(changed),
+			nil, nil, false, &metav1.UpdateOptions{})
+		require.NoError(t, err)
+		assert.False(t, created)
+
+		require.NotNil(t, stored)
+		assert.Equal(t, "", stored.StringData[FieldDescription])
+		assert.Equal(t, "4000", stored.StringData[FieldTTL])
+		assert.Equal(t, "kla9jkdmj", stored.StringData[FieldHash])
+		assert.Equal(t, "13:00:05", stored.StringData[FieldLastUpdateTime])
+		assert.Equal(t, "2024-12-06T03:02:01Z", stored.StringData[FieldLastUsedAt])
+		assert.Equal(t, "2024-12-06T03:02:01Z", stored.StringData[FieldLastActivitySeen])
+	})
+}
@@ -8,6 +8,7 @@
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -20,9 +21,11 @@
 	"github.com/rancher/rancher/pkg/auth/providers/common"
 	"github.com/rancher/rancher/pkg/auth/tokens"
 	"github.com/rancher/rancher/pkg/auth/tokens/hashers"
+	"github.com/rancher/rancher/pkg/capr"
 	extcommon "github.com/rancher/rancher/pkg/ext/common"
 	v3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/tracing"
 	"github.com/rancher/rancher/pkg/wrangler"
 	extcore "github.com/rancher/steve/pkg/ext"
 	v1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
@@ -30,11 +33,14 @@
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/apimachinery/pkg/watch"
@@ -57,22 +63,28 @@
 	SecretKindLabel      = "cattle.io/kind"
 	SecretKindLabelValue = "token"
 	GeneratePrefix       = "token-"
+	// UserIDField is the field tokens are selected by to list the tokens of a user.
+	UserIDField = "spec.userID"
 
 	// names of the data fields used by the backing secrets to store token information
-	FieldDescription      = "description"
-	FieldEnabled          = "enabled"
-	FieldHash             = "hash"
-	FieldKind             = "kind"
-	FieldLastActivitySeen = "last-activity-seen"
-	FieldLastUpdateTime   = "last-update-time"
-	FieldLastUsedAt       = "last-used-at"
-	FieldPrincipal        = "principal"
-	FieldTTL              = "ttl"
-	FieldUID              = "kube-uid"
-	FieldUserID           = "user-id"
+	FieldCreationTimestamp = "creation-timestamp"
+	FieldDescription       = "description"
+	FieldEnabled           = "enabled"
+	FieldHash              = "hash"
+	FieldKind              = "kind"
+	FieldLastActivitySeen  = "last-activity-seen"
+	FieldLastUpdateTime    = "last-update-time"
+	FieldLastUsedAt        = "last-used-at"
+	FieldPrincipal         = "principal"
+	FieldTTL               = "ttl"
+	FieldUID               = "kube-uid"
+	FieldUserID            = "user-id"
 
 	SingularName = "token"
 	PluralName   = SingularName + "s"
+
+	// optimisticLockErrorMsg tells clients how to resolve a conflicting update, like the kube-apiserver does.
+	optimisticLockErrorMsg = "the object has been modified; please apply your changes to the latest version and try again"
 )
 
 var GV = schema.GroupVersion{
@@ -110,6 +122,7 @@ type Store struct {
 // words, it generally has access to all the tokens, in all ways.
 type SystemStore struct {
 	authorizer      authorizer.Authorizer
+	decisions       *decisionCache      // short-lived cache of the authorizer decisions
 	namespaceClient v1.NamespaceClient  // access to namespaces.
 	namespaceCache  v1.NamespaceCache   // quick access to namespaces.
 	secretClient    v1.SecretClient     // direct access to the backing secrets
@@ -118,6 +131,7 @@ type SystemStore struct {
 	v3TokenClient   v3.TokenCache       // cached access to v3.Tokens. See Fetch.
 	timer           timeHandler         // access to timestamp generation
 	hasher          hashHandler         // access to generation and hashing of secret values
+	hashes          hashBackend         // access to the storage of the hashed secret values
 	auth            authHandler         // access to user retrieval from context
 	tableConverter  rest.TableConvertor // custom column formatting
 }
@@ -156,6 +170,7 @@ func New(
 	tokenStore := Store{
 		SystemStore: SystemStore{
 			authorizer:      authorizer,
+			decisions:       newDecisionCache(),
 			namespaceClient: namespaceClient,
 			namespaceCache:  namespaceCache,
 			secretClient:    secretClient,
@@ -164,6 +179,7 @@ func New(
 			v3TokenClient:   tokenClient,
 			timer:           timer,
 			hasher:          hasher,
+			hashes:          newHashBackend(),
 			auth:            auth,
 			tableConverter: printerstorage.TableConvertor{
 				TableGenerator: printers.NewTableGenerator().With(printHandler),
@@ -211,6 +227,7 @@ func NewSystem(
 		v3TokenClient:   tokenClient,
 		timer:           timer,
 		hasher:          hasher,
+		hashes:          newHashBackend(),
 		auth:            auth,
 	}
 	return &tokenStore
@@ -249,13 +266,16 @@ func (t *SystemStore) ensureNamespace() error {
 
 // Create implements [rest.Creator], the interface to support the `create`
 // verb. Delegates to the actual store method after some generic boilerplate.
-// Note: Name and GenerateName are not respected. A name is generated with a
-// predefined prefix instead.
+// Note: Name and GenerateName are respected, for external tools to manage
+// tokens by name. Without either a name is generated with a predefined prefix.
 func (t *Store) Create(
 	ctx context.Context,
 	obj runtime.Object,
 	createValidation rest.ValidateObjectFunc,
 	options *metav1.CreateOptions) (runtime.Object, error) {
+	ctx, span := tracing.Start(ctx, "tokens.Create")
+	defer span.End()
+
 	if createValidation != nil {
 		err := createValidation(ctx, obj)
 		if err != nil {
@@ -280,6 +300,9 @@ func (t *Store) DeleteCollection(
 	options *metav1.DeleteOptions,
 	listOptions *metainternalversion.ListOptions,
 ) (runtime.Object, error) {
+	ctx, span := tracing.Start(ctx, "tokens.DeleteCollection")
+	defer span.End()
+
 	userInfo, fullAccess, _, err := t.auth.UserName(ctx, &t.SystemStore, "delete")
 	if err != nil {
 		return nil, apierrors.NewInternalError(fmt.Errorf("error getting user info: %w", err))
@@ -332,6 +355,8 @@ func (t *Store) Delete(
 	name string,
 	deleteValidation rest.ValidateObjectFunc,
 	options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	ctx, span := tracing.Start(ctx, "tokens.Delete")
+	defer span.End()
 
 	userInfo, fullAccess, isRancherUser, err := t.auth.UserName(ctx, &t.SystemStore, "delete")
 	if err != nil {
@@ -385,10 +410,27 @@ func (t *Store) deleteCore(
 		options.Preconditions.UID = &secret.UID
 	}
 
+	// Keep the token disabled during the deletion grace period, unless the deletion is explicitly immediate.
+	immediate := options != nil && options.GracePeriodSeconds != nil && *options.GracePeriodSeconds == 0
+	if _, deleted := deletedAt(token); deleted && !immediate {
+		return token, false, nil
+	}
+	if deletionGracePeriod() > 0 && !immediate {
+		if options != nil && len(options.DryRun) > 0 && options.DryRun[0] == metav1.DryRunAll {
+			return token, false, nil
+		}
+		deleted, err := t.SystemStore.softDelete(token, time.Now())
+		if err != nil {
+			return nil, false, err
+		}
+		return deleted, false, nil
+	}
+
 	// and now actually delete
 	if err := t.SystemStore.Delete(token.Name, options); err != nil {
 		return nil, false, err
 	}
+	t.SystemStore.DeleteHash(token)
 
 	return token, true, nil
 }
@@ -398,6 +440,8 @@ func (t *Store) Get(
 	ctx context.Context,
 	name string,
 	options *metav1.GetOptions) (runtime.Object, error) {
+	ctx, span := tracing.Start(ctx, "tokens.Get")
+	defer span.End()
 
 	userInfo, fullAccess, _, err := t.auth.UserName(ctx, &t.SystemStore, "get")
 	if err != nil {
@@ -445,6 +489,9 @@ func (t *Store) NewList() runtime.Object {
 func (t *Store) List(
 	ctx context.Context,
 	internaloptions *metainternalversion.ListOptions) (runtime.Object, error) {
+	ctx, span := tracing.Start(ctx, "tokens.List")
+	defer span.End()
+
 	options, err := extcore.ConvertListOptions(internaloptions)
 	if err != nil {
 		return nil, apierrors.NewInternalError(err)
@@ -522,30 +569,17 @@ func (t *Store) Update(
 	updateValidation rest.ValidateObjectUpdateFunc,
 	forceAllowCreate bool,
 	options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	ctx, span := tracing.Start(ctx, "tokens.Update")
+	defer span.End()
 
 	userInfo, fullAccess, isRancherUser, err := t.auth.UserName(ctx, &t.SystemStore, "update")
 	if err != nil {
 		return nil, false, err
 	}
 
-	oldSecret, err := t.secretCache.Get(TokenNamespace, name)
+	oldToken, err := t.currentToken(name)
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			// Rethrow the NotFound error with the correct group and resource information.
-			return nil, false, apierrors.NewNotFound(GVR.GroupResource(), name)
-		}
-		return nil, false, fmt.Errorf("error getting secret for token %s: %w", name, err)
-	}
-
-	// validate that secret is indeed holding an ext token
-	if oldSecret.Labels[SecretKindLabel] != SecretKindLabelValue {
-		return nil, false, apierrors.NewNotFound(GVR.GroupResource(), name)
-	}
-
-	oldToken, err := fromSecret(oldSecret)
-	if err != nil {
-		return nil, false, apierrors.NewInternalError(
-			fmt.Errorf("error converting secret %s to token: %w", name, err))
+		return nil, false, err
 	}
 
 	newObj, err := objInfo.UpdatedObject(ctx, oldToken)
@@ -579,6 +613,30 @@ func (t *Store) Update(
 	return resultToken, false, err
 }
 
+// currentToken returns the token to update, from the cache.
+func (t *SystemStore) currentToken(name string) (*ext.Token, error) {
+	oldSecret, err := t.secretCache.Get(TokenNamespace, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Rethrow the NotFound error with the correct group and resource information.
+			return nil, apierrors.NewNotFound(GVR.GroupResource(), name)
+		}
+		return nil, fmt.Errorf("error getting secret for token %s: %w", name, err)
+	}
+
+	// validate that secret is indeed holding an ext token
+	if oldSecret.Labels[SecretKindLabel] != SecretKindLabelValue {
+		return nil, apierrors.NewNotFound(GVR.GroupResource(), name)
+	}
+
+	oldToken, err := fromSecret(oldSecret)
+	if err != nil {
+		return nil, apierrors.NewInternalError(
+			fmt.Errorf("error converting secret %s to token: %w", name, err))
+	}
+	return oldToken, nil
+}
+
 // Watch implements [rest.Watcher], the interface to support the `watch` verb.
 func (t *Store) Watch(
 	ctx context.Context,
@@ -664,36 +722,67 @@ func (t *SystemStore) Create(ctx context.Context, group schema.GroupResource, to
 
 	rest.FillObjectMetaSystemFields(token)
 
+	generateName, err := validateName(token)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return early as the user does not wish to actually change anything.
 	if dryRun {
-		// enforce our choice of name
-		token.ObjectMeta.Name, err = t.generateName(GeneratePrefix)
-		token.ObjectMeta.GenerateName = ""
-		if err != nil {
-			return nil, err
+		if token.ObjectMeta.Name == "" {
+			token.ObjectMeta.Name, err = t.generateName(generateName)
+			token.ObjectMeta.GenerateName = ""
+			if err != nil {
+				return nil, err
+			}
 		}
 		return token, nil
 	}
 
+	// Persist the hash with the configured backend, the secret keeps what is needed to load it back
+	token.Status.Hash, err = t.hashes.Store(string(token.UID), hashedValue)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to store token hash: %w", err))
+	}
+
 	secret, err := toSecret(token)
 	if err != nil {
+		t.DeleteHash(token)
 		return nil, apierrors.NewInternalError(fmt.Errorf("failed to convert token %s for storage: %w",
 			token.Name, err))
 	}
 
-	// enforce our choice of name, without racing create
-	secret.ObjectMeta.Name = ""
-	secret.ObjectMeta.GenerateName = GeneratePrefix
+	// generate the name if none is asked for, without racing create
+	secret.ObjectMeta.GenerateName = generateName
+
+	// The token is owned by its user, see [userOwnerReference].
+	owner := userOwnerReference(user)
+	secret.OwnerReferences = withUserOwnerReference(secret.OwnerReferences, &owner)
 
 	if err = t.ensureNamespace(); err != nil {
 		return nil, apierrors.NewInternalError(fmt.Errorf("error ensuring namespace %s: %w", TokenNamespace, err))
 	}
 
-	newSecret, err := t.secretClient.Create(secret)
+	// record the creation time, for restores from backup to keep it
+	secret.StringData[FieldCreationTimestamp] = token.Status.LastUpdateTime
+
+	// A generated name may collide with an existing secret. Retry with a
+	// fresh suffix, as core kubernetes does.
+	var newSecret *corev1.Secret
+	err = retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return generateName != "" && apierrors.IsAlreadyExists(err)
+	}, func() error {
+		var err error
+		newSecret, err = t.secretClient.Create(secret)
+		return err
+	})
 	if err != nil {
+		t.DeleteHash(token)
 		if apierrors.IsAlreadyExists(err) {
-			// note: should not be possible due to the forced use of generateName
-			return nil, err
+			if token.Name != "" {
+				return nil, apierrors.NewAlreadyExists(GVR.GroupResource(), token.Name)
+			}
+			return nil, apierrors.NewServerTimeout(GVR.GroupResource(), "create", 1)
 		}
 		return nil, apierrors.NewInternalError(fmt.Errorf("failed to store token: %w", err))
 	}
@@ -704,6 +793,7 @@ func (t *SystemStore) Create(ctx context.Context, group schema.GroupResource, to
 		// An error here means that something broken was stored.
 		// Do not leave that broken thing behind.
 		t.secretClient.Delete(TokenNamespace, newSecret.Name, &metav1.DeleteOptions{})
+		t.DeleteHash(token)
 
 		// And report what was broken
 		return nil, apierrors.NewInternalError(fmt.Errorf("failed to regenerate token %s: %w",
@@ -732,6 +822,20 @@ func (t *SystemStore) Delete(name string, options *metav1.DeleteOptions) error {
 	return apierrors.NewInternalError(fmt.Errorf("failed to delete token %s: %w", name, err))
 }
 
+// DeleteHash removes the hash of the token from the backend it is stored in, if it is not kept in the backing secret.
+// Failures are only logged, as the token itself is already gone.
+func (t *SystemStore) DeleteHash(token *ext.Token) {
+	if err := t.hashes.Delete(token.Status.Hash); err != nil {
+		logrus.Errorf("failed to delete hash of token %s: %v", token.Name, err)
+	}
+}
+
+// ResolveHash returns the hash of the token, loading it from the backend it is stored in if it is not kept in the
+// backing secret.
+func (t *SystemStore) ResolveHash(token *ext.Token) (string, error) {
+	return t.hashes.Load(token.Status.Hash)
+}
+
 // Get retrieves the named ext token, without permission checking
 func (t *SystemStore) Get(name, authTokenID string, options *metav1.GetOptions) (*ext.Token, error) {
 
@@ -793,6 +897,36 @@ func (t *Store) list(ctx context.Context, options *metav1.ListOptions) (*ext.Tok
 	return t.SystemStore.list(fullAccess, userInfo.GetName(), authTokenID, options)
 }
 
+// ListSelected returns the set of tokens matching the label selector. It is an
+// internal call invoked by other parts of Rancher
+func (t *SystemStore) ListSelected(selector labels.Selector) (*ext.TokenList, error) {
+	// Only select the secrets backing tokens.
+	kindRequirement, err := labels.NewRequirement(SecretKindLabel, selection.Equals, []string{SecretKindLabelValue})
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to select tokens: %w", err))
+	}
+
+	secrets, err := t.secretCache.List(TokenNamespace, selector.Add(*kindRequirement))
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to list tokens: %w", err))
+	}
+
+	var tokens []ext.Token
+	for _, secret := range secrets {
+		token, err := fromSecret(secret)
+		// ignore broken tokens
+		if err != nil {
+			continue
+		}
+
+		tokens = append(tokens, *token)
+	}
+
+	return &ext.TokenList{
+		Items: tokens,
+	}, nil
+}
+
 // ListForUser returns the set of token owned by the named user. It is an
 // internal call invoked by other parts of Rancher
 func (t *SystemStore) ListForUser(userName string) (*ext.TokenList, error) {
@@ -894,6 +1028,10 @@ func (t *SystemStore) update(authTokenID string, fullPermission bool, oldToken,
 		return nil, apierrors.NewBadRequest("spec.userprincipal is immutable")
 	}
 
+	if err := restoreUpdate(fullPermission, oldToken, token); err != nil {
+		return nil, err
+	}
+
 	// Regular users are not allowed to extend the TTL.
 	if !fullPermission {
 		ttl, err := clampMaxTTL(token.Spec.TTL)
@@ -908,16 +1046,37 @@ func (t *SystemStore) update(authTokenID string, fullPermission bool, oldToken,
 
 	// Keep the status of the resource unchanged, never store a token value, etc.
 	// IOW changes to hash, value, etc. are all ignored without a peep.
+	// The status is updated through the status subresource, see [StatusStore].
 	token.Status = oldToken.Status
 	token.Status.Value = ""
 	// Refresh time of last update to current.
 	token.Status.LastUpdateTime = t.timer.Now()
 
+	return t.save(authTokenID, oldToken, token, dryRun)
+}
+
+// save stores the updated token, and returns it as stored.
+func (t *SystemStore) save(authTokenID string, oldToken, token *ext.Token, dryRun bool) (*ext.Token, error) {
 	secret, err := toSecret(token)
 	if err != nil {
 		return nil, apierrors.NewInternalError(fmt.Errorf("failed to convert token for storage: %w", err))
 	}
 
+	// Keep the creation time. The one of the update request is ignored.
+	if !oldToken.CreationTimestamp.IsZero() {
+		secret.StringData[FieldCreationTimestamp] = oldToken.CreationTimestamp.UTC().Format(time.RFC3339)
+	}
+
+	// Keep the user owning the token. The owner references of the update request cannot change it.
+	secret.OwnerReferences = withUserOwnerReference(secret.OwnerReferences, userOwnerReferenceOf(oldToken.OwnerReferences))
+
+	// Only overwrite the version of the token the changes are based on, to not lose concurrent changes. That is the
+	// version read by the client, if given, else the one read by the store.
+	secret.ResourceVersion = token.ResourceVersion
+	if secret.ResourceVersion == "" {
+		secret.ResourceVersion = oldToken.ResourceVersion
+	}
+
 	// Abort, user does not wish to actually change anything.
 	if dryRun {
 		return token, nil
@@ -925,6 +1084,12 @@ func (t *SystemStore) update(authTokenID string, fullPermission bool, oldToken,
 
 	newSecret, err := t.secretClient.Update(secret)
 	if err != nil {
+		if apierrors.IsConflict(err) {
+			return nil, apierrors.NewConflict(GVR.GroupResource(), token.Name, errors.New(optimisticLockErrorMsg))
+		}
+		if apierrors.IsNotFound(err) {
+			return nil, apierrors.NewNotFound(GVR.GroupResource(), token.Name)
+		}
 		return nil, apierrors.NewInternalError(fmt.Errorf("failed to save updated token: %w", err))
 	}
 
@@ -1004,6 +1169,27 @@ func (t *SystemStore) Disable(name string) error {
 	return err
 }
 
+// UpdateTTL patches the time-to-live of the token, in milliseconds.
+// Called by refreshAttributes to renew login sessions.
+func (t *SystemStore) UpdateTTL(name string, ttl int64) error {
+	// Operate directly on the backend secret holding the token
+	patch, err := json.Marshal([]struct {
+		Op    string `json:"op"`
+		Path  string `json:"path"`
+		Value any    `json:"value"`
+	}{{
+		Op:    "replace",
+		Path:  "/data/" + FieldTTL,
+		Value: base64.StdEncoding.EncodeToString([]byte(strconv.FormatInt(ttl, 10))),
+	}})
+	if err != nil {
+		return err
+	}
+
+	_, err = t.secretClient.Patch(TokenNamespace, name, types.JSONPatchType, patch)
+	return err
+}
+
 // watch implements the core resource watcher for tokens
 func (t *Store) watch(ctx context.Context, options *metav1.ListOptions) (watch.Interface, error) {
 	userInfo, fullAccess, _, err := t.auth.UserName(ctx, &t.SystemStore, "watch")
@@ -1033,6 +1219,14 @@ func (t *Store) watch(ctx context.Context, options *metav1.ListOptions) (watch.I
 		return consumer, nil
 	}
 
+	empty := metav1.ListOptions{}
+	if localOptions == empty {
+		// The setup indicated that we can bail out. I.e the options ask
+		// for something which cannot match. Simply return the watcher,
+		// without feeding it anything.
+		return consumer, nil
+	}
+
 	if !features.FeatureGates().Enabled(features.WatchListClient) {
 		localOptions.SendInitialEvents = nil
 		localOptions.ResourceVersionMatch = ""
@@ -1192,7 +1386,26 @@ func userMatchOrDefault(name string, token *ext.Token) bool {
 	return name == token.Spec.UserID
 }
 
-// generateName computes a unique name for a new token, from a fixed prefix.
+// validateName validates the name or the name prefix asked for the new token,
+// and returns the prefix to generate its name from, empty if a name is asked
+// for.
+func validateName(token *ext.Token) (string, error) {
+	if token.Name != "" {
+		if errs := apimachineryvalidation.NameIsDNSSubdomain(token.Name, false); len(errs) > 0 {
+			return "", apierrors.NewBadRequest(fmt.Sprintf("invalid name %s: %s", token.Name, strings.Join(errs, ", ")))
+		}
+		return "", nil
+	}
+	if token.GenerateName != "" {
+		if errs := apimachineryvalidation.NameIsDNSSubdomain(token.GenerateName, true); len(errs) > 0 {
+			return "", apierrors.NewBadRequest(fmt.Sprintf("invalid generateName %s: %s", token.GenerateName, strings.Join(errs, ", ")))
+		}
+		return token.GenerateName, nil
+	}
+	return GeneratePrefix, nil
+}
+
+// generateName computes a unique name for a new token, from a prefix.
 func (t *SystemStore) generateName(prefix string) (string, error) {
 	var tokenID string
 
@@ -1313,19 +1526,12 @@ func (tp *tokenAuth) UserName(ctx context.Context, store *SystemStore, verb stri
 		return nil, false, false, apierrors.NewInternalError(fmt.Errorf("context has no user info"))
 	}
 
-	decision, _, err := store.authorizer.Authorize(ctx, &authorizer.AttributesRecord{
-		User:            userInfo,
-		Verb:            verb,
-		Resource:        "*",
-		ResourceRequest: true,
-	})
+	fullAccess, err := store.decisions.authorize(ctx, store.authorizer, userInfo, verb)
 	if err != nil {
 		logrus.Errorf("ext token store (%s request) by user %q: auth error: %v", verb, userInfo.GetName(), err)
 		return nil, false, false, err
 	}
 
-	fullAccess := decision == authorizer.DecisionAllow
-
 	isRancherUser := false
 	userName := userInfo.GetName()
 
@@ -1387,12 +1593,18 @@ func SessionID(ctx context.Context) (string, error) {
 }
 
 // ListOptionMerge merges any external filter options with the internal filter
-// (for the current user).  A non-error empty result indicates that the options
-// specified a filter which cannot match anything.  I.e. the calling user
-// requests a filter for a different user than itself.
+// (for the current user).  When the calling user requests a filter for a
+// different user than itself, the result specifies a filter which cannot
+// match anything. A field selector on UserIDField is translated to the
+// matching label selector beforehand.
 func ListOptionMerge(fullAccess bool, userName string, options *metav1.ListOptions) (metav1.ListOptions, error) {
 	var localOptions metav1.ListOptions
 
+	options, err := fieldSelectorToLabelSelector(options)
+	if err != nil {
+		return localOptions, err
+	}
+
 	// for admins we do not impose any additional restrictions over the requested
 	if fullAccess {
 		return *options, nil
@@ -1419,8 +1631,13 @@ func ListOptionMerge(fullAccess bool, userName string, options *metav1.ListOptio
 			// The external filter does filter for a user, possible conflict.
 			if callerSelector[UserIDLabel] != userName {
 				// It asks for a user other than the current.
-				// We can bail now, with an empty result, as
-				// nothing can match.
+				// Nothing can match, add the internal filter
+				// for the selector to express that.
+				requirement, err := labels.NewRequirement(UserIDLabel, selection.Equals, []string{userName})
+				if err != nil {
+					return localOptions, err
+				}
+				localOptions.LabelSelector = labels.SelectorFromSet(callerSelector).Add(*requirement).String()
 				return localOptions, nil
 			}
 			// It asks for the current user, same as the internal
@@ -1435,6 +1652,70 @@ func ListOptionMerge(fullAccess bool, userName string, options *metav1.ListOptio
 	return localOptions, nil
 }
 
+// AddFieldLabelConversionFunc registers the fields tokens can be selected by
+// with the scheme, for the API server to pass them on to the store.
+func AddFieldLabelConversionFunc(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(GVK, func(label, value string) (string, string, error) {
+		switch label {
+		case "metadata.name", UserIDField:
+			return label, value, nil
+		default:
+			return "", "", fmt.Errorf("field label not supported: %s", label)
+		}
+	})
+}
+
+// fieldSelectorToLabelSelector moves a UserIDField requirement of the field
+// selector of the options into their label selector, as the owner of a token
+// is kept in a label of its backing secret. This allows external tools to
+// list the tokens they manage by owner.
+func fieldSelectorToLabelSelector(options *metav1.ListOptions) (*metav1.ListOptions, error) {
+	if options == nil || options.FieldSelector == "" {
+		return options, nil
+	}
+
+	selector, err := fields.ParseSelector(options.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var userID string
+	var remaining []fields.Selector
+	for _, requirement := range selector.Requirements() {
+		switch {
+		case requirement.Field != UserIDField:
+			if requirement.Operator == selection.NotEquals {
+				remaining = append(remaining, fields.OneTermNotEqualSelector(requirement.Field, requirement.Value))
+			} else {
+				remaining = append(remaining, fields.OneTermEqualSelector(requirement.Field, requirement.Value))
+			}
+		case requirement.Operator == selection.NotEquals:
+			return nil, fmt.Errorf("only equality is supported for field %s", UserIDField)
+		case userID != "" && userID != requirement.Value:
+			return nil, fmt.Errorf("conflicting requirements for field %s", UserIDField)
+		default:
+			userID = requirement.Value
+		}
+	}
+	if userID == "" {
+		return options, nil
+	}
+
+	callerSelector, err := labels.ConvertSelectorToLabelsMap(options.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	if callerSelector.Has(UserIDLabel) && callerSelector[UserIDLabel] != userID {
+		return nil, fmt.Errorf("field %s conflicts with label %s", UserIDField, UserIDLabel)
+	}
+	callerSelector[UserIDLabel] = userID
+
+	localOptions := *options
+	localOptions.LabelSelector = callerSelector.AsSelector().String()
+	localOptions.FieldSelector = fields.AndSelectors(remaining...).String()
+	return &localOptions, nil
+}
+
 // toSecret converts a Token object into the equivalent Secret resource.
 func toSecret(token *ext.Token) (*corev1.Secret, error) {
 	// base structure
@@ -1458,6 +1739,7 @@ func toSecret(token *ext.Token) (*corev1.Secret, error) {
 		secret.Labels[k] = v
 	}
 	secret.Labels[SecretKindLabel] = SecretKindLabelValue
+	secret.Labels[capr.BackupLabel] = "true"
 	secret.Labels[UserIDLabel] = token.Spec.UserID
 	secret.Labels[KindLabel] = token.Spec.Kind
 
@@ -1497,7 +1779,11 @@ func toSecret(token *ext.Token) (*corev1.Secret, error) {
 	secret.StringData[FieldLastUsedAt] = lastUsedAtAsString
 	secret.StringData[FieldHash] = token.Status.Hash
 	secret.StringData[FieldLastUpdateTime] = token.Status.LastUpdateTime
-	secret.StringData[FieldLastActivitySeen] = ""
+	lastActivitySeenAsString := ""
+	if token.Status.LastActivitySeen != nil {
+		lastActivitySeenAsString = token.Status.LastActivitySeen.Format(time.RFC3339)
+	}
+	secret.StringData[FieldLastActivitySeen] = lastActivitySeenAsString
 
 	return secret, nil
 }
@@ -1514,8 +1800,16 @@ func fromSecret(secret *corev1.Secret) (*ext.Token, error) {
 		},
 		ObjectMeta: *secret.ObjectMeta.DeepCopy(),
 	}
-	token.Namespace = ""                  // token is not namespaced.
-	delete(token.Labels, SecretKindLabel) // Remove an internal label.
+	token.Namespace = ""                   // token is not namespaced.
+	delete(token.Labels, SecretKindLabel)  // Remove an internal label.
+	delete(token.Labels, capr.BackupLabel) // Remove an internal label.
+
+	// system - creation time, kept across restores from backup
+	created, err := creationTimestamp(secret)
+	if err != nil {
+		return nil, err
+	}
+	token.ObjectMeta.CreationTimestamp = created
 
 	// system - kubernetes uid
 	if token.ObjectMeta.UID = types.UID(string(secret.Data[FieldUID])); token.ObjectMeta.UID == "" {
@@ -5,6 +5,7 @@
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -1125,6 +1127,9 @@ func Test_Store_Create(t *testing.T) {
 			name: "failed to create secret - already exists",
 			err:  helloAlreadyExistsError,
 			tok: &ext.Token{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "hello",
+				},
 				Spec: ext.TokenSpec{
 					UserID: "world",
 				},
@@ -1281,6 +1286,229 @@ func Test_Store_Create(t *testing.T) {
 				return copy
 			}(),
 		},
+		{
+			name: "created secret ok with name",
+			err:  nil,
+			tok: &ext.Token{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "terraform-ci",
+				},
+				Spec: ext.TokenSpec{
+					UserID: "world",
+				},
+			},
+			opts: &metav1.CreateOptions{},
+			storeSetup: func( // configure store backend clients
+				space *fake.MockNonNamespacedControllerInterface[*corev1.Namespace, *corev1.NamespaceList],
+				secrets *fake.MockControllerInterface[*corev1.Secret, *corev1.SecretList],
+				scache *fake.MockCacheInterface[*corev1.Secret],
+				users *fake.MockNonNamespacedCacheInterface[*v3.User],
+				token *fake.MockNonNamespacedCacheInterface[*v3.Token],
+				timer *MocktimeHandler,
+				hasher *MockhashHandler,
+				auth *MockauthHandler) {
+
+				auth.EXPECT().UserName(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&mockUser{name: "world"}, false, true, nil)
+
+				// session token fetch for user principal
+				auth.EXPECT().SessionID(gomock.Any()).
+					Return("session-token", nil)
+				token.EXPECT().Get("session-token").Return(&v3.Token{
+					AuthProvider: "local",
+					UserPrincipal: v3.Principal{
+						ObjectMeta: metav1.ObjectMeta{Name: "local://world"},
+					}}, nil)
+
+				users.EXPECT().Get("world").
+					Return(&v3.User{
+						DisplayName: "worldwide",
+						Username:    "wide",
+						Enabled:     pointer.Bool(true),
+					}, nil)
+
+				hasher.EXPECT().MakeAndHashSecret().Return("94084kdlafj43", "", nil)
+				timer.EXPECT().Now().Return("this is a fake now")
+
+				// the asked for name is used for the secret
+				secrets.EXPECT().Create(gomock.Any()).
+					DoAndReturn(func(secret *corev1.Secret) (*corev1.Secret, error) {
+						assert.Equal(t, "terraform-ci", secret.Name)
+						assert.Empty(t, secret.GenerateName)
+						return &properSecret, nil
+					})
+			},
+			rtok: func() *ext.Token {
+				copy := properToken.DeepCopy()
+				copy.Status.Hash = ""
+				copy.Status.Value = "94084kdlafj43"
+				return copy
+			}(),
+		},
+		{
+			name: "created secret ok with generate name",
+			err:  nil,
+			tok: &ext.Token{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "terraform-",
+				},
+				Spec: ext.TokenSpec{
+					UserID: "world",
+				},
+			},
+			opts: &metav1.CreateOptions{},
+			storeSetup: func( // configure store backend clients
+				space *fake.MockNonNamespacedControllerInterface[*corev1.Namespace, *corev1.NamespaceList],
+				secrets *fake.MockControllerInterface[*corev1.Secret, *corev1.SecretList],
+				scache *fake.MockCacheInterface[*corev1.Secret],
+				users *fake.MockNonNamespacedCacheInterface[*v3.User],
+				token *fake.MockNonNamespacedCacheInterface[*v3.Token],
+				timer *MocktimeHandler,
+				hasher *MockhashHandler,
+				auth *MockauthHandler) {
+
+				auth.EXPECT().UserName(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&mockUser{name: "world"}, false, true, nil)
+
+				// session token fetch for user principal
+				auth.EXPECT().SessionID(gomock.Any()).
+					Return("session-token", nil)
+				token.EXPECT().Get("session-token").Return(&v3.Token{
+					AuthProvider: "local",
+					UserPrincipal: v3.Principal{
+						ObjectMeta: metav1.ObjectMeta{Name: "local://world"},
+					}}, nil)
+
+				users.EXPECT().Get("world").
+					Return(&v3.User{
+						DisplayName: "worldwide",
+						Username:    "wide",
+						Enabled:     pointer.Bool(true),
+					}, nil)
+
+				hasher.EXPECT().MakeAndHashSecret().Return("94084kdlafj43", "", nil)
+				timer.EXPECT().Now().Return("this is a fake now")
+
+				// the asked for prefix is used for the secret
+				secrets.EXPECT().Create(gomock.Any()).
+					DoAndReturn(func(secret *corev1.Secret) (*corev1.Secret, error) {
+						assert.Empty(t, secret.Name)
+						assert.Equal(t, "terraform-", secret.GenerateName)
+						return &properSecret, nil
+					})
+			},
+			rtok: func() *ext.Token {
+				copy := properToken.DeepCopy()
+				copy.Status.Hash = ""
+				copy.Status.Value = "94084kdlafj43"
+				return copy
+			}(),
+		},
+		{
+			name: "created secret ok with generate name after collision",
+			err:  nil,
+			tok: &ext.Token{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "terraform-",
+				},
+				Spec: ext.TokenSpec{
+					UserID: "world",
+				},
+			},
+			opts: &metav1.CreateOptions{},
+			storeSetup: func( // configure store backend clients
+				space *fake.MockNonNamespacedControllerInterface[*corev1.Namespace, *corev1.NamespaceList],
+				secrets *fake.MockControllerInterface[*corev1.Secret, *corev1.SecretList],
+				scache *fake.MockCacheInterface[*corev1.Secret],
+				users *fake.MockNonNamespacedCacheInterface[*v3.User],
+				token *fake.MockNonNamespacedCacheInterface[*v3.Token],
+				timer *MocktimeHandler,
+				hasher *MockhashHandler,
+				auth *MockauthHandler) {
+
+				auth.EXPECT().UserName(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&mockUser{name: "world"}, false, true, nil)
+
+				// session token fetch for user principal
+				auth.EXPECT().SessionID(gomock.Any()).
+					Return("session-token", nil)
+				token.EXPECT().Get("session-token").Return(&v3.Token{
+					AuthProvider: "local",
+					UserPrincipal: v3.Principal{
+						ObjectMeta: metav1.ObjectMeta{Name: "local://world"},
+					}}, nil)
+
+				users.EXPECT().Get("world").
+					Return(&v3.User{
+						DisplayName: "worldwide",
+						Username:    "wide",
+						Enabled:     pointer.Bool(true),
+					}, nil)
+
+				hasher.EXPECT().MakeAndHashSecret().Return("94084kdlafj43", "", nil)
+				timer.EXPECT().Now().Return("this is a fake now")
+
+				// the generated name collides once, and is retried
+				gomock.InOrder(
+					secrets.EXPECT().Create(gomock.Any()).
+						Return(nil, apierrors.NewAlreadyExists(corev1.Resource("secrets"), "terraform-abcde")),
+					secrets.EXPECT().Create(gomock.Any()).
+						Return(&properSecret, nil),
+				)
+			},
+			rtok: func() *ext.Token {
+				copy := properToken.DeepCopy()
+				copy.Status.Hash = ""
+				copy.Status.Value = "94084kdlafj43"
+				return copy
+			}(),
+		},
+		{
+			name: "invalid name",
+			err: apierrors.NewBadRequest(fmt.Sprintf("invalid name Terraform_CI: %s",
+				strings.Join(apimachineryvalidation.NameIsDNSSubdomain("Terraform_CI", false), ", "))),
+			tok: &ext.Token{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "Terraform_CI",
+				},
+				Spec: ext.TokenSpec{
+					UserID: "world",
+				},
+			},
+			opts: &metav1.CreateOptions{},
+			storeSetup: func( // configure store backend clients
+				space *fake.MockNonNamespacedControllerInterface[*corev1.Namespace, *corev1.NamespaceList],
+				secrets *fake.MockControllerInterface[*corev1.Secret, *corev1.SecretList],
+				scache *fake.MockCacheInterface[*corev1.Secret],
+				users *fake.MockNonNamespacedCacheInterface[*v3.User],
+				token *fake.MockNonNamespacedCacheInterface[*v3.Token],
+				timer *MocktimeHandler,
+				hasher *MockhashHandler,
+				auth *MockauthHandler) {
+
+				auth.EXPECT().UserName(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&mockUser{name: "world"}, false, true, nil)
+
+				// session token fetch for user principal
+				auth.EXPECT().SessionID(gomock.Any()).
+					Return("session-token", nil)
+				token.EXPECT().Get("session-token").Return(&v3.Token{
+					AuthProvider: "local",
+					UserPrincipal: v3.Principal{
+						ObjectMeta: metav1.ObjectMeta{Name: "local://world"},
+					}}, nil)
+
+				users.EXPECT().Get("world").
+					Return(&v3.User{
+						DisplayName: "worldwide",
+						Username:    "wide",
+						Enabled:     pointer.Bool(true),
+					}, nil)
+
+				hasher.EXPECT().MakeAndHashSecret().Return("94084kdlafj43", "", nil)
+				timer.EXPECT().Now().Return("this is a fake now")
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -1320,6 +1548,65 @@ func Test_Store_Create(t *testing.T) {
 	}
 }
 
+func Test_ListOptionMerge(t *testing.T) {
+	tests := []struct {
+		name       string
+		fullAccess bool
+		options    *metav1.ListOptions
+		want       metav1.ListOptions
+		wantErr    bool
+	}{
+		{
+			name:    "no options",
+			options: &metav1.ListOptions{},
+			want:    metav1.ListOptions{LabelSelector: "cattle.io/user-id=world"},
+		},
+		{
+			name:    "other user",
+			options: &metav1.ListOptions{LabelSelector: "cattle.io/user-id=hello"},
+			want:    metav1.ListOptions{LabelSelector: "cattle.io/user-id=hello,cattle.io/user-id=world"},
+		},
+		{
+			name:    "owner field selector",
+			options: &metav1.ListOptions{FieldSelector: "spec.userID=world,metadata.name=token-1"},
+			want:    metav1.ListOptions{LabelSelector: "cattle.io/user-id=world", FieldSelector: "metadata.name=token-1"},
+		},
+		{
+			name:       "owner field selector with full access",
+			fullAccess: true,
+			options:    &metav1.ListOptions{LabelSelector: "team=ci", FieldSelector: "spec.userID=hello"},
+			want:       metav1.ListOptions{LabelSelector: "cattle.io/user-id=hello,team=ci"},
+		},
+		{
+			name:    "owner field selector of other user",
+			options: &metav1.ListOptions{FieldSelector: "spec.userID=hello"},
+			want:    metav1.ListOptions{LabelSelector: "cattle.io/user-id=hello,cattle.io/user-id=world"},
+		},
+		{
+			name:    "owner field selector inequality",
+			options: &metav1.ListOptions{FieldSelector: "spec.userID!=world"},
+			wantErr: true,
+		},
+		{
+			name:    "owner field selector conflicting with label",
+			options: &metav1.ListOptions{LabelSelector: "cattle.io/user-id=hello", FieldSelector: "spec.userID=world"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options, err := ListOptionMerge(test.fullAccess, "world", test.options)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, options)
+		})
+	}
+}
+
 func Test_SystemStore_List(t *testing.T) {
 	tests := []struct {
 		name       string              // test name
@@ -1656,6 +1943,32 @@ func Test_SystemStore_UpdateLastActivitySeen(t *testing.T) {
 	})
 }
 
+func Test_SystemStore_UpdateTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// assemble and configure store from mock clients ...
+	secrets := fake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	users := fake.NewMockNonNamespacedControllerInterface[*v3.User, *v3.UserList](ctrl)
+
+	users.EXPECT().Cache().Return(nil)
+	secrets.EXPECT().Cache().Return(nil)
+
+	store := NewSystem(nil, nil, secrets, users, nil, nil, nil, nil)
+
+	var patchData []byte
+	secrets.EXPECT().Patch("cattle-tokens", "atoken", types.JSONPatchType, gomock.Any()).
+		DoAndReturn(func(space, name string, pt types.PatchType, data []byte, subresources ...any) (*ext.Token, error) {
+			patchData = data
+			return nil, nil
+		}).Times(1)
+
+	err := store.UpdateTTL("atoken", 3600000)
+	assert.NoError(t, err)
+	require.Equal(t,
+		`[{"op":"replace","path":"/data/ttl","value":"MzYwMDAwMA=="}]`,
+		string(patchData))
+}
+
 func Test_SystemStore_Update(t *testing.T) {
 	tests := []struct {
 		name       string                // test name
@@ -1919,6 +2232,81 @@ func Test_SystemStore_Update(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name:     "reject concurrent change",
+			fullPerm: true,
+			opts:     &metav1.UpdateOptions{},
+			old: func() *ext.Token {
+				stored := properToken.DeepCopy()
+				stored.ResourceVersion = "1"
+				return stored
+			}(),
+			token: func() *ext.Token {
+				changed := properToken.DeepCopy()
+				changed.Spec.TTL = 3000
+				return changed
+			}(),
+			storeSetup: func(
+				secrets *fake.MockControllerInterface[*corev1.Secret, *corev1.SecretList],
+				scache *fake.MockCacheInterface[*corev1.Secret],
+				timer *MocktimeHandler,
+				hasher *MockhashHandler,
+				auth *MockauthHandler) {
+
+				// Fake current time
+				timer.EXPECT().Now().Return("this is a fake now")
+
+				// Update: Only overwrite the version read by the store, which was changed since
+				secrets.EXPECT().
+					Update(gomock.Cond(func(secret *corev1.Secret) bool {
+						return secret.ResourceVersion == "1"
+					})).
+					Return(nil, apierrors.NewConflict(corev1.Resource("secrets"), properToken.Name, fmt.Errorf("changed")))
+			},
+			err: apierrors.NewConflict(GVR.GroupResource(), properToken.Name,
+				fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again")),
+		},
+		{
+			name:     "keep resource version of client",
+			fullPerm: true,
+			opts:     &metav1.UpdateOptions{},
+			old: func() *ext.Token {
+				stored := properToken.DeepCopy()
+				stored.ResourceVersion = "1"
+				return stored
+			}(),
+			token: func() *ext.Token {
+				changed := properToken.DeepCopy()
+				changed.ResourceVersion = "2"
+				changed.Spec.TTL = 3000
+				return changed
+			}(),
+			rtok: func() *ext.Token {
+				changed := properToken.DeepCopy()
+				changed.Spec.TTL = 3000
+				changed.Status.LastUpdateTime = "this is a fake now"
+				changed.Status.ExpiresAt = "0001-01-01T00:00:03Z"
+				return changed
+			}(),
+			storeSetup: func(
+				secrets *fake.MockControllerInterface[*corev1.Secret, *corev1.SecretList],
+				scache *fake.MockCacheInterface[*corev1.Secret],
+				timer *MocktimeHandler,
+				hasher *MockhashHandler,
+				auth *MockauthHandler) {
+
+				// Fake current time
+				timer.EXPECT().Now().Return("this is a fake now")
+
+				// Update: The client read a newer version than the store
+				secrets.EXPECT().
+					Update(gomock.Cond(func(secret *corev1.Secret) bool {
+						return secret.ResourceVersion == "2"
+					})).
+					Return(&ttlSubSecret, nil)
+			},
+			err: nil,
+		},
 	}
 
 	for _, test := range tests {
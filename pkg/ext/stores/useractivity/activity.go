@@ -0,0 +1,150 @@
+package useractivity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	v3Legacy "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/accessor"
+	exttokenstore "github.com/rancher/rancher/pkg/ext/stores/tokens"
+	v3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// activityFlushInterval is the interval at which the idle timeouts whose writes were coalesced are stored.
+	activityFlushInterval = time.Minute
+	// maxFlushAttempts is the number of flushes attempting to store an idle timeout before it is dropped.
+	maxFlushAttempts = 3
+)
+
+// activityWriter stores the idle timeouts of tokens, coalescing the writes which would advance the stored idle timeout
+// of a token by less than the auth-user-session-idle-write-threshold setting. The idle timeouts not stored yet are kept
+// in memory until the idle timeout advances past the threshold, or until they are flushed, periodically and on
+// shutdown.
+type activityWriter struct {
+	tokens        v3.TokenClient             // direct access for patching of v3 tokens
+	extTokenStore *exttokenstore.SystemStore // patching of ext tokens
+
+	mu      sync.Mutex
+	pending map[string]pendingActivity // idle timeouts not stored yet, by token name
+}
+
+type pendingActivity struct {
+	token       accessor.TokenAccessor
+	idleTimeout time.Time
+	attempts    int // failed attempts to store the idle timeout
+}
+
+func newActivityWriter(tokens v3.TokenClient, extTokenStore *exttokenstore.SystemStore) *activityWriter {
+	return &activityWriter{
+		tokens:        tokens,
+		extTokenStore: extTokenStore,
+		pending:       map[string]pendingActivity{},
+	}
+}
+
+// record stores the idle timeout of the token, unless it advances the stored one by less than the threshold. The
+// threshold is capped by maxThreshold, so that the stored idle timeout never lags behind enough for an active session
+// to expire.
+func (w *activityWriter) record(token accessor.TokenAccessor, idleTimeout time.Time, maxThreshold time.Duration) error {
+	threshold := min(settings.AuthUserSessionIdleWriteThreshold.GetDuration(), maxThreshold)
+
+	w.mu.Lock()
+	if stored := token.GetLastActivitySeen(); stored != nil && idleTimeout.Sub(stored.Time) < threshold {
+		if pending, ok := w.pending[token.GetName()]; !ok || pending.idleTimeout.Before(idleTimeout) {
+			w.pending[token.GetName()] = pendingActivity{token: token, idleTimeout: idleTimeout}
+		}
+		w.mu.Unlock()
+		return nil
+	}
+	delete(w.pending, token.GetName())
+	w.mu.Unlock()
+
+	return w.write(token, idleTimeout)
+}
+
+// run flushes the coalesced idle timeouts every activityFlushInterval, and a last time once the context is done.
+func (w *activityWriter) run(ctx context.Context) {
+	ticker := time.NewTicker(activityFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			w.flush()
+			return
+		case <-ticker.C:
+			w.flush()
+		}
+	}
+}
+
+// flush stores the idle timeouts which were coalesced so far. The idle timeouts of deleted tokens are dropped, and
+// those which failed to be stored are retried by the following flushes, up to maxFlushAttempts times.
+func (w *activityWriter) flush() {
+	w.mu.Lock()
+	pending := w.pending
+	w.pending = map[string]pendingActivity{}
+	w.mu.Unlock()
+
+	for name, activity := range pending {
+		err := w.write(activity.token, activity.idleTimeout)
+		switch {
+		case err == nil:
+		case apierrors.IsNotFound(err):
+			logrus.Debugf("[%s] Dropped the activity of deleted token %s", SingularName, name)
+		case activity.attempts+1 >= maxFlushAttempts:
+			logrus.Errorf("[%s] Failed to flush the activity of token %s, giving up: %v", SingularName, name, err)
+		default:
+			logrus.Warnf("[%s] Failed to flush the activity of token %s, will retry: %v", SingularName, name, err)
+			activity.attempts++
+			w.retry(name, activity)
+		}
+	}
+}
+
+// retry keeps the idle timeout of the token for the next flush, unless a later one was recorded in the meantime.
+func (w *activityWriter) retry(name string, activity pendingActivity) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if recorded, ok := w.pending[name]; ok && !recorded.idleTimeout.Before(activity.idleTimeout) {
+		return
+	}
+	w.pending[name] = activity
+}
+
+func (w *activityWriter) write(token accessor.TokenAccessor, idleTimeout time.Time) error {
+	switch token.(type) {
+	case *v3Legacy.Token:
+		patch, err := json.Marshal([]struct {
+			Op    string `json:"op"`
+			Path  string `json:"path"`
+			Value any    `json:"value"`
+		}{{
+			Op:    "replace",
+			Path:  "/activityLastSeenAt",
+			Value: metav1.NewTime(idleTimeout),
+		}})
+		if err != nil {
+			return fmt.Errorf("failed to marshall patch data: %w", err)
+		}
+		_, err = w.tokens.Patch(token.GetName(), types.JSONPatchType, patch)
+		if err != nil {
+			return fmt.Errorf("failed to store activityLastSeenAt to token %s: %w", token.GetName(), err)
+		}
+	case *ext.Token:
+		err := w.extTokenStore.UpdateLastActivitySeen(token.GetName(), idleTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to store activityLastSeenAt to ext token %s: %w", token.GetName(), err)
+		}
+	}
+	return nil
+}
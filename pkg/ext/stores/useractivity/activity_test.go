@@ -0,0 +1,166 @@
+package useractivity
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	wranglerfake "github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestActivityWriterRecord(t *testing.T) {
+	stored := time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		threshold    string
+		idleTimeout  time.Time
+		maxThreshold time.Duration
+		wantStored   bool
+		wantPending  bool
+	}{
+		{
+			name:         "idle timeout advancing by less than the threshold is coalesced",
+			threshold:    "30s",
+			idleTimeout:  stored.Add(20 * time.Second),
+			maxThreshold: 5 * time.Minute,
+			wantPending:  true,
+		},
+		{
+			name:         "idle timeout advancing by the threshold is stored",
+			threshold:    "30s",
+			idleTimeout:  stored.Add(30 * time.Second),
+			maxThreshold: 5 * time.Minute,
+			wantStored:   true,
+		},
+		{
+			name:         "threshold is capped",
+			threshold:    "10m",
+			idleTimeout:  stored.Add(time.Minute),
+			maxThreshold: 15 * time.Second,
+			wantStored:   true,
+		},
+		{
+			name:         "zero threshold stores every heartbeat",
+			threshold:    "0s",
+			idleTimeout:  stored.Add(time.Second),
+			maxThreshold: 5 * time.Minute,
+			wantStored:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, settings.AuthUserSessionIdleWriteThreshold.Set(tt.threshold))
+			t.Cleanup(func() {
+				settings.AuthUserSessionIdleWriteThreshold.Set(settings.AuthUserSessionIdleWriteThreshold.Default)
+			})
+
+			ctrl := gomock.NewController(t)
+			tokens := wranglerfake.NewMockNonNamespacedControllerInterface[*apiv3.Token, *apiv3.TokenList](ctrl)
+			var patched bool
+			tokens.EXPECT().Patch("token-12345", types.JSONPatchType, gomock.Any()).DoAndReturn(
+				func(_ string, _ types.PatchType, data []byte, _ ...string) (*apiv3.Token, error) {
+					patched = true
+					assert.JSONEq(t, patchOf(t, tt.idleTimeout), string(data))
+					return &apiv3.Token{}, nil
+				}).AnyTimes()
+
+			w := newActivityWriter(tokens, nil)
+			token := &apiv3.Token{
+				ObjectMeta:         metav1.ObjectMeta{Name: "token-12345"},
+				ActivityLastSeenAt: &metav1.Time{Time: stored},
+			}
+			require.NoError(t, w.record(token, tt.idleTimeout, tt.maxThreshold))
+			assert.Equal(t, tt.wantStored, patched)
+			_, pending := w.pending["token-12345"]
+			assert.Equal(t, tt.wantPending, pending)
+		})
+	}
+}
+
+func TestActivityWriterFlush(t *testing.T) {
+	stored := time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	tokens := wranglerfake.NewMockNonNamespacedControllerInterface[*apiv3.Token, *apiv3.TokenList](ctrl)
+	// Only the most advanced idle timeout coalesced is stored.
+	tokens.EXPECT().Patch("token-12345", types.JSONPatchType, gomock.Any()).DoAndReturn(
+		func(_ string, _ types.PatchType, data []byte, _ ...string) (*apiv3.Token, error) {
+			assert.JSONEq(t, patchOf(t, stored.Add(20*time.Second)), string(data))
+			return &apiv3.Token{}, nil
+		}).Times(1)
+
+	w := newActivityWriter(tokens, nil)
+	token := &apiv3.Token{
+		ObjectMeta:         metav1.ObjectMeta{Name: "token-12345"},
+		ActivityLastSeenAt: &metav1.Time{Time: stored},
+	}
+	require.NoError(t, w.record(token, stored.Add(20*time.Second), 5*time.Minute))
+	require.NoError(t, w.record(token, stored.Add(10*time.Second), 5*time.Minute))
+
+	w.flush()
+	assert.Empty(t, w.pending)
+	// Nothing is left to store.
+	w.flush()
+}
+
+func TestActivityWriterFlushFailures(t *testing.T) {
+	stored := time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	tokens := wranglerfake.NewMockNonNamespacedControllerInterface[*apiv3.Token, *apiv3.TokenList](ctrl)
+	tokens.EXPECT().Patch("token-failing", types.JSONPatchType, gomock.Any()).
+		Return(nil, errors.New("connection refused")).Times(maxFlushAttempts)
+	tokens.EXPECT().Patch("token-deleted", types.JSONPatchType, gomock.Any()).
+		Return(nil, apierrors.NewNotFound(apiv3.Resource("tokens"), "token-deleted")).Times(1)
+
+	w := newActivityWriter(tokens, nil)
+	for _, name := range []string{"token-failing", "token-deleted"} {
+		token := &apiv3.Token{
+			ObjectMeta:         metav1.ObjectMeta{Name: name},
+			ActivityLastSeenAt: &metav1.Time{Time: stored},
+		}
+		require.NoError(t, w.record(token, stored.Add(20*time.Second), 5*time.Minute))
+	}
+
+	// The activity of the deleted token is dropped, the other one is retried by the following flushes.
+	w.flush()
+	assert.Len(t, w.pending, 1)
+	assert.Equal(t, 1, w.pending["token-failing"].attempts)
+	for range maxFlushAttempts - 1 {
+		w.flush()
+	}
+	assert.Empty(t, w.pending)
+}
+
+func TestActivityWriterRetryKeepsLaterActivity(t *testing.T) {
+	stored := time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)
+	token := &apiv3.Token{ObjectMeta: metav1.ObjectMeta{Name: "token-12345"}}
+
+	w := newActivityWriter(nil, nil)
+	w.pending["token-12345"] = pendingActivity{token: token, idleTimeout: stored.Add(20 * time.Second)}
+	w.retry("token-12345", pendingActivity{token: token, idleTimeout: stored.Add(10 * time.Second), attempts: 1})
+	assert.Equal(t, stored.Add(20*time.Second), w.pending["token-12345"].idleTimeout)
+	assert.Equal(t, 0, w.pending["token-12345"].attempts)
+}
+
+func patchOf(t *testing.T, idleTimeout time.Time) string {
+	t.Helper()
+	patch, err := json.Marshal([]map[string]any{{
+		"op":    "replace",
+		"path":  "/activityLastSeenAt",
+		"value": metav1.NewTime(idleTimeout),
+	}})
+	require.NoError(t, err)
+	return string(patch)
+}
@@ -2,7 +2,8 @@
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"slices"
 	"strings"
@@ -12,36 +13,46 @@
 	v3Legacy "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/auth/accessor"
 	"github.com/rancher/rancher/pkg/auth/providers/common"
+	authtokens "github.com/rancher/rancher/pkg/auth/tokens"
 	exttokenstore "github.com/rancher/rancher/pkg/ext/stores/tokens"
 	v3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
-	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/tracing"
 	"github.com/rancher/rancher/pkg/wrangler"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
 	k8suser "k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/clock"
 )
 
 const (
 	SingularName             = "useractivity"
 	GroupCattleAuthenticated = "system:cattle:authenticated"
 	TokenKind                = "authn.management.cattle.io/kind"
-)
 
-var timeNow = func() time.Time {
-	return time.Now().UTC()
-}
+	// namePrefix is the prefix of the names of UserActivities, see [Name].
+	namePrefix = "ua-"
+
+	// maxAdditionalTokens is the maximum number of additional tokens whose activity is recorded by a request.
+	maxAdditionalTokens = 20
+
+	// minHeartbeatInterval and maxHeartbeatInterval bound the interval at which clients are asked to report activity.
+	minHeartbeatInterval = 15 * time.Second
+	maxHeartbeatInterval = 5 * time.Minute
+)
 
 // +k8s:openapi-gen=false
 // +k8s:deepcopy-gen=false
 type Store struct {
 	tokens        v3.TokenClient             // direct access for patching of v3 tokens
 	userCache     v3.UserCache               // cached fetch of v3 users
-	extTokenStore *exttokenstore.SystemStore // unified fetch of v3 and ext tokens; patching of ext tokens
+	extTokenStore *exttokenstore.SystemStore // unified fetch of v3 and ext tokens
+	activity      *activityWriter            // coalesced storing of the activity of tokens
+	clock         clock.PassiveClock         // source of the time of the activity
 }
 
 var GV = schema.GroupVersion{
@@ -58,13 +69,35 @@ type Store struct {
 var GVR = ext.SchemeGroupVersion.WithResource(ext.UserActivityResourceName)
 
 func New(wranglerCtx *wrangler.Context) *Store {
+	extTokenStore := exttokenstore.NewSystemFromWrangler(wranglerCtx)
 	return &Store{
 		tokens:        wranglerCtx.Mgmt.Token(),
 		userCache:     wranglerCtx.Mgmt.User().Cache(),
-		extTokenStore: exttokenstore.NewSystemFromWrangler(wranglerCtx),
+		extTokenStore: extTokenStore,
+		activity:      newActivityWriter(wranglerCtx.Mgmt.Token(), extTokenStore),
+		clock:         clock.RealClock{},
 	}
 }
 
+// Run stores the activity of the tokens whose writes were coalesced, periodically until the context is done, and a
+// last time on shutdown.
+func (s *Store) Run(ctx context.Context) {
+	s.activity.run(ctx)
+}
+
+// Flush stores the activity of the tokens whose writes were coalesced so far.
+func (s *Store) Flush() {
+	s.activity.flush()
+}
+
+// Export serializes the ext tokens for backup, see [exttokenstore.SystemStore.Export]. The activity whose writes were
+// coalesced is flushed first, for the backup to hold the latest activity of the sessions. The activity of v3 tokens is
+// kept in the tokens themselves, which rancher-backup already covers.
+func (s *Store) Export() (*exttokenstore.Backup, error) {
+	s.Flush()
+	return s.extTokenStore.Export()
+}
+
 // GroupVersionKind implements [rest.GroupVersionKindProvider]
 func (s *Store) GroupVersionKind(_ schema.GroupVersion) schema.GroupVersionKind {
 	return GVK
@@ -98,6 +131,9 @@ func (s *Store) Create(ctx context.Context,
 	obj runtime.Object,
 	createValidation rest.ValidateObjectFunc,
 	options *metav1.CreateOptions) (runtime.Object, error) {
+	ctx, span := tracing.Start(ctx, "useractivity.Create")
+	defer span.End()
+
 	userInfo, err := s.userFrom(ctx)
 	if err != nil {
 		return nil, err
@@ -123,13 +159,16 @@ func (s *Store) Create(ctx context.Context,
 		var zeroUA *ext.UserActivity
 		return nil, apierrors.NewInternalError(fmt.Errorf("expected %T but got %T", zeroUA, objUserActivity))
 	}
-	// retrieve token information
-	if objUserActivity.Name == "" {
-		return nil, apierrors.NewBadRequest("name is required")
+	spec, name, err := specForCreate(userInfo.GetName(), authTokenID, objUserActivity)
+	if err != nil {
+		return nil, err
 	}
-	// ensure generate name is not used
-	if objUserActivity.GenerateName != "" {
-		return nil, apierrors.NewBadRequest("name generation is not allowed")
+	objUserActivity.Name = name
+	objUserActivity.GenerateName = ""
+	objUserActivity.Spec = spec
+
+	if len(spec.AdditionalTokenIDs) > maxAdditionalTokens {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("at most %d additional tokens are allowed", maxAdditionalTokens))
 	}
 
 	// retrieve auth token
@@ -138,70 +177,79 @@ func (s *Store) Create(ctx context.Context,
 		return nil, apierrors.NewForbidden(GVR.GroupResource(), "", fmt.Errorf("error getting request token %s: %w", authTokenID, err))
 	}
 
+	// check if it's a dry-run
+	dryRun := options != nil && len(options.DryRun) > 0 && options.DryRun[0] == metav1.DryRunAll
+
+	// The status is computed by the store, the one of the request is ignored.
+	objUserActivity.Status, err = s.recordActivity(authToken, spec.TokenID, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	// The activity of the additional tokens is recorded independently, their errors are reported in the status.
+	for _, tokenID := range spec.AdditionalTokenIDs {
+		tokenStatus := ext.UserActivityTokenStatus{TokenID: tokenID}
+		if status, err := s.recordActivity(authToken, tokenID, dryRun); err != nil {
+			tokenStatus.Error = err.Error()
+		} else {
+			tokenStatus.ExpiresAt = status.ExpiresAt
+		}
+		objUserActivity.Status.AdditionalTokens = append(objUserActivity.Status.AdditionalTokens, tokenStatus)
+	}
+
+	return objUserActivity, nil
+}
+
+// recordActivity records the activity of the user for the activity token, on behalf of the request token, and returns
+// the resulting status. Nothing is stored for dry-runs, and the writes of heartbeats repeated in quick succession e.g. by
+// several tabs of a browser are coalesced, see [activityWriter].
+func (s *Store) recordActivity(authToken accessor.TokenAccessor, tokenID string, dryRun bool) (ext.UserActivityStatus, error) {
+	var status ext.UserActivityStatus
+
 	// retrieve activity token
-	activityToken, err := s.extTokenStore.Fetch(objUserActivity.Name)
+	activityToken, err := s.extTokenStore.Fetch(tokenID)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return nil, apierrors.NewBadRequest(fmt.Sprintf("token not found %s: %v", objUserActivity.Name, err))
+			return status, apierrors.NewBadRequest(fmt.Sprintf("token not found %s: %v", tokenID, err))
 		} else {
-			return nil, apierrors.NewInternalError(fmt.Errorf("failed to get token %s: %w", objUserActivity.Name, err))
+			return status, apierrors.NewInternalError(fmt.Errorf("failed to get token %s: %w", tokenID, err))
 		}
 	}
 
 	// validate activity token
 	if err = validateActivityToken(authToken, activityToken); err != nil {
-		return nil, err
+		return status, err
 	}
 
 	// set when last activity happened
-	lastActivity := metav1.Time{
-		Time: timeNow(),
-	}
-	// retrieve setting for auth-user-session-idle-ttl-minutes
-	idleTimeout := settings.AuthUserSessionIdleTTLMinutes.GetInt()
-
-	// check if it's a dry-run
-	dryRun := options != nil && len(options.DryRun) > 0 && options.DryRun[0] == metav1.DryRunAll
+	lastActivity := s.clock.Now().UTC()
+	// retrieve the idle timeout of the kind of the activity token
+	idleTimeout := authtokens.IdleTTL(tokenKind(activityToken))
+	interval := heartbeatInterval(idleTimeout)
 
 	// once validated the request, we can define the lastActivity time.
-	newIdleTimeout := metav1.Time{
-		Time: lastActivity.Add(time.Minute * time.Duration(idleTimeout)).UTC(),
+	newIdleTimeout := metav1.NewTime(lastActivity.Add(idleTimeout))
+	// activity doesn't extend a login session beyond its maximum lifetime
+	if maxExpiresAt, ok := sessionMaxExpiresAt(activityToken); ok && maxExpiresAt.Before(newIdleTimeout.Time) {
+		newIdleTimeout = metav1.NewTime(maxExpiresAt.UTC())
+	}
+	status.HeartbeatIntervalSeconds = int64(interval.Seconds())
+	if expiresAt, ok := sessionExpiresAt(activityToken); ok {
+		remaining := max(int64(expiresAt.Sub(lastActivity).Seconds()), 0)
+		status.SessionRemainingSeconds = &remaining
 	}
-	objUserActivity.Status.ExpiresAt = newIdleTimeout.Time.Format(time.RFC3339)
+	status.ExpiresAt = newIdleTimeout
 
 	// discard the changes if this is a dry-run
 	if dryRun {
-		return objUserActivity, nil
+		return status, nil
 	}
 
-	switch activityToken.(type) {
-	case *v3Legacy.Token:
-		patch, err := json.Marshal([]struct {
-			Op    string `json:"op"`
-			Path  string `json:"path"`
-			Value any    `json:"value"`
-		}{{
-			Op:    "replace",
-			Path:  "/activityLastSeenAt",
-			Value: newIdleTimeout,
-		}})
-		if err != nil {
-			return nil, apierrors.NewInternalError(fmt.Errorf("failed to marshall patch data: %w", err))
-		}
-		_, err = s.tokens.Patch(activityToken.GetName(), types.JSONPatchType, patch)
-		if err != nil {
-			return nil, apierrors.NewInternalError(fmt.Errorf("failed to store activityLastSeenAt to token %s: %w",
-				activityToken.GetName(), err))
-		}
-	case *ext.Token:
-		err := s.extTokenStore.UpdateLastActivitySeen(activityToken.GetName(), newIdleTimeout.Time)
-		if err != nil {
-			return nil, apierrors.NewInternalError(fmt.Errorf("failed to store activityLastSeenAt to ext token %s: %w",
-				activityToken.GetName(), err))
-		}
+	if err := s.activity.record(activityToken, newIdleTimeout.Time, interval); err != nil {
+		return status, apierrors.NewInternalError(err)
 	}
 
-	return objUserActivity, nil
+	return status, nil
 }
 
 // Get implements [rest.Getter]
@@ -211,6 +259,9 @@ func (s *Store) Create(ctx context.Context,
 func (s *Store) Get(ctx context.Context,
 	name string,
 	options *metav1.GetOptions) (runtime.Object, error) {
+	ctx, span := tracing.Start(ctx, "useractivity.Get")
+	defer span.End()
+
 	userInfo, err := s.userFrom(ctx)
 	if err != nil {
 		return nil, err
@@ -229,13 +280,21 @@ func (s *Store) Get(ctx context.Context,
 		return nil, apierrors.NewForbidden(GVR.GroupResource(), "", fmt.Errorf("error getting request token %s: %w", authTokenID, err))
 	}
 
+	// UserActivities are named by the hash of their user and token, or by the name of their token.
+	tokenID := name
+	if strings.HasPrefix(name, namePrefix) {
+		if tokenID, err = s.tokenOfName(userInfo.GetName(), authTokenID, name); err != nil {
+			return nil, err
+		}
+	}
+
 	// retrieve activity token
-	activityToken, err := s.extTokenStore.Fetch(name)
+	activityToken, err := s.extTokenStore.Fetch(tokenID)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return nil, apierrors.NewBadRequest(fmt.Sprintf("token not found %s: %v", name, err))
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("token not found %s: %v", tokenID, err))
 		} else {
-			return nil, apierrors.NewInternalError(fmt.Errorf("failed to get token %s: %w", name, err))
+			return nil, apierrors.NewInternalError(fmt.Errorf("failed to get token %s: %w", tokenID, err))
 		}
 	}
 
@@ -250,18 +309,92 @@ func (s *Store) Get(ctx context.Context,
 			CreationTimestamp: activityToken.GetCreationTime(),
 			Name:              name,
 		},
+		Spec: ext.UserActivitySpec{
+			UserID:  userInfo.GetName(),
+			TokenID: tokenID,
+		},
 		Status: ext.UserActivityStatus{},
 	}
 
 	if lastActivity := activityToken.GetLastActivitySeen(); lastActivity != nil {
-		ua.Status.ExpiresAt = lastActivity.String()
-	} else {
-		ua.Status.ExpiresAt = metav1.Time{}.String()
+		ua.Status.ExpiresAt = metav1.NewTime(lastActivity.UTC())
 	}
 
 	return ua, nil
 }
 
+// Name returns the name of the UserActivity of a token of a user. It is an opaque hash, deterministic so that the
+// activity of a token can be read back by name.
+func Name(userID, tokenID string) string {
+	sum := sha256.Sum256([]byte(userID + "/" + tokenID))
+	return namePrefix + hex.EncodeToString(sum[:16])
+}
+
+// specForCreate returns the spec and the name of a UserActivity to create, validated against the user and the token of
+// the request. Requests without spec are converted to it, and keep the name they asked for: either the name of the
+// token, or a generateName matching the name of the request token.
+func specForCreate(userName, authTokenID string, ua *ext.UserActivity) (ext.UserActivitySpec, string, error) {
+	spec := ua.Spec
+	if spec.UserID != "" && spec.UserID != userName {
+		return spec, "", apierrors.NewForbidden(GVR.GroupResource(), ua.Name,
+			fmt.Errorf("spec.userID %s is not the user making the request", spec.UserID))
+	}
+	spec.UserID = userName
+
+	if spec.TokenID == "" && ua.Name != "" && !strings.HasPrefix(ua.Name, namePrefix) {
+		spec.TokenID = ua.Name
+		return spec, ua.Name, nil
+	}
+	if spec.TokenID == "" && ua.Name == "" && ua.GenerateName != "" {
+		if !strings.HasPrefix(authTokenID, ua.GenerateName) {
+			return spec, "", apierrors.NewBadRequest(fmt.Sprintf("generateName %s does not match the request token",
+				ua.GenerateName))
+		}
+		spec.TokenID = authTokenID
+		return spec, authTokenID, nil
+	}
+
+	if spec.TokenID == "" {
+		spec.TokenID = authTokenID
+	}
+	name := Name(spec.UserID, spec.TokenID)
+	if ua.Name != "" && ua.Name != name {
+		return spec, "", apierrors.NewBadRequest(fmt.Sprintf("name %s does not match the spec, it must be %s or empty",
+			ua.Name, name))
+	}
+	return spec, name, nil
+}
+
+// tokenOfName returns the name of the token of the user whose UserActivity has the given name, see [Name].
+func (s *Store) tokenOfName(userName, authTokenID, name string) (string, error) {
+	// The activity of the request token is the one read the most.
+	if Name(userName, authTokenID) == name {
+		return authTokenID, nil
+	}
+
+	v3Tokens, err := s.tokens.List(metav1.ListOptions{
+		LabelSelector: labels.Set{authtokens.UserIDLabel: userName}.String(),
+	})
+	if err != nil {
+		return "", apierrors.NewInternalError(fmt.Errorf("failed to list tokens of user %s: %w", userName, err))
+	}
+	for _, token := range v3Tokens.Items {
+		if Name(userName, token.Name) == name {
+			return token.Name, nil
+		}
+	}
+	extTokens, err := s.extTokenStore.ListForUser(userName)
+	if err != nil {
+		return "", err
+	}
+	for _, token := range extTokens.Items {
+		if Name(userName, token.Name) == name {
+			return token.Name, nil
+		}
+	}
+	return "", apierrors.NewNotFound(GVR.GroupResource(), name)
+}
+
 // userFrom is a helper that extracts and validates the user info from the request's context.
 func (s *Store) userFrom(ctx context.Context) (k8suser.Info, error) {
 	userInfo, ok := request.UserFrom(ctx)
@@ -324,10 +457,10 @@ func validateActivityToken(auth, activity accessor.TokenAccessor) error {
 				auth.GetName(), activity.GetName()))
 	}
 
-	// verify that activity token is a session token
-	if activity.GetIsDerived() {
+	// verify that activity token is a session token, or a token of a kind with an idle timeout
+	if activity.GetIsDerived() && authtokens.IdleTTL(tokenKind(activity)) == 0 {
 		return apierrors.NewForbidden(GVR.GroupResource(), "",
-			fmt.Errorf("activity token %s is not a session token",
+			fmt.Errorf("activity token %s is not a session token and its kind has no idle timeout",
 				activity.GetName()))
 	}
 
@@ -339,3 +472,65 @@ func validateActivityToken(auth, activity accessor.TokenAccessor) error {
 
 	return nil
 }
+
+// tokenKind returns the kind of the token, which determines its idle timeout.
+func tokenKind(token accessor.TokenAccessor) string {
+	if !token.GetIsDerived() {
+		return authtokens.SessionTokenKind
+	}
+	switch token := token.(type) {
+	case *v3Legacy.Token:
+		return token.Labels[TokenKind]
+	case *ext.Token:
+		return token.Spec.Kind
+	}
+	return ""
+}
+
+// heartbeatInterval returns the interval at which clients should report activity to keep a session with the given idle
+// timeout alive. Reporting activity several times per idle timeout tolerates missed or delayed heartbeats.
+func heartbeatInterval(idleTimeout time.Duration) time.Duration {
+	return min(max(idleTimeout/4, minHeartbeatInterval), maxHeartbeatInterval)
+}
+
+// sessionExpiresAt returns the time at which the token expires, either because of its time to live or because its
+// login session reached its maximum lifetime, and false if it doesn't expire.
+func sessionExpiresAt(token accessor.TokenAccessor) (time.Time, bool) {
+	expiresAt, ok := ttlExpiresAt(token)
+	if maxExpiresAt, maxOK := sessionMaxExpiresAt(token); maxOK && (!ok || maxExpiresAt.Before(expiresAt)) {
+		return maxExpiresAt, true
+	}
+	return expiresAt, ok
+}
+
+// ttlExpiresAt returns the time at which the token expires because of its time to live, and false if it doesn't.
+func ttlExpiresAt(token accessor.TokenAccessor) (time.Time, bool) {
+	switch token := token.(type) {
+	case *v3Legacy.Token:
+		if token.TTLMillis == 0 {
+			return time.Time{}, false
+		}
+		return token.CreationTimestamp.Add(time.Duration(token.TTLMillis) * time.Millisecond), true
+	case *ext.Token:
+		if token.Spec.TTL < 0 {
+			return time.Time{}, false
+		}
+		return token.CreationTimestamp.Add(time.Duration(token.Spec.TTL) * time.Millisecond), true
+	}
+	return time.Time{}, false
+}
+
+// sessionMaxExpiresAt returns the time at which the login session of the token reaches its maximum lifetime, and false
+// if the token isn't a login token or the lifetime of login sessions isn't limited.
+func sessionMaxExpiresAt(token accessor.TokenAccessor) (time.Time, bool) {
+	if token.GetIsDerived() {
+		return time.Time{}, false
+	}
+	switch token := token.(type) {
+	case *v3Legacy.Token:
+		return authtokens.SessionMaxExpiresAt(token.CreationTimestamp.Time)
+	case *ext.Token:
+		return authtokens.SessionMaxExpiresAt(token.CreationTimestamp.Time)
+	}
+	return time.Time{}, false
+}
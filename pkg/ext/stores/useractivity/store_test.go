@@ -13,15 +13,21 @@
 	"github.com/rancher/rancher/pkg/auth/providers/common"
 	exttokenstore "github.com/rancher/rancher/pkg/ext/stores/tokens"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
 	wranglerfake "github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	k8suser "k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
 )
 
 func TestStoreCreate(t *testing.T) {
@@ -102,8 +108,13 @@ type args struct {
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "token-12345",
 				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-12345",
+				},
 				Status: ext.UserActivityStatus{
-					ExpiresAt: metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, &time.Location{})).Format(time.RFC3339),
+					ExpiresAt:                metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)),
+					HeartbeatIntervalSeconds: 300,
 				},
 			},
 			wantErr: false,
@@ -181,8 +192,14 @@ type args struct {
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "token-12345",
 				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-12345",
+				},
 				Status: ext.UserActivityStatus{
-					ExpiresAt: metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, &time.Location{})).Format(time.RFC3339),
+					ExpiresAt:                metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)),
+					HeartbeatIntervalSeconds: 300,
+					SessionRemainingSeconds:  pointer.Int64(0),
 				},
 			},
 			wantErr: false,
@@ -313,8 +330,366 @@ type args struct {
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "token-12345",
 				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-12345",
+				},
+				Status: ext.UserActivityStatus{
+					ExpiresAt:                metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)),
+					HeartbeatIntervalSeconds: 300,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "dry run, status of the request is ignored",
+			args: args{
+				ctx: request.WithUser(context.Background(), &k8suser.DefaultInfo{
+					Name:   "admin",
+					Groups: []string{GroupCattleAuthenticated},
+					Extra: map[string][]string{
+						common.ExtraRequestTokenID: {"token-12345"},
+					},
+				}),
+				obj: &ext.UserActivity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "token-12345",
+					},
+					Status: ext.UserActivityStatus{
+						ExpiresAt:                metav1.NewTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)),
+						HeartbeatIntervalSeconds: 1,
+						SessionRemainingSeconds:  pointer.Int64(1000),
+					},
+				},
+				validateFunc: nil,
+				options: &metav1.CreateOptions{
+					DryRun: []string{metav1.DryRunAll},
+				},
+			},
+			mockSetup: func() {
+				gomock.InOrder(
+					mockUserCacheFake.EXPECT().Get("admin").Return(&v3.User{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "admin",
+						},
+					}, nil),
+
+					mockTokenCacheFake.EXPECT().Get("token-12345").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-12345",
+						},
+						AuthProvider:  "oidc",
+						UserPrincipal: v3.Principal{},
+					}, nil),
+
+					mockTokenCacheFake.EXPECT().Get("token-12345").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-12345",
+							Labels: map[string]string{
+								TokenKind: "session",
+							},
+						},
+						AuthProvider:  "oidc",
+						UserPrincipal: v3.Principal{},
+					}, nil),
+				)
+			},
+			want: &ext.UserActivity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "token-12345",
+				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-12345",
+				},
+				Status: ext.UserActivityStatus{
+					ExpiresAt:                metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)),
+					HeartbeatIntervalSeconds: 300,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "dry run, generate name",
+			args: args{
+				ctx: request.WithUser(context.Background(), &k8suser.DefaultInfo{
+					Name:   "admin",
+					Groups: []string{GroupCattleAuthenticated},
+					Extra: map[string][]string{
+						common.ExtraRequestTokenID: {"token-12345"},
+					},
+				}),
+				obj: &ext.UserActivity{
+					ObjectMeta: metav1.ObjectMeta{
+						GenerateName: "token-",
+					},
+				},
+				validateFunc: nil,
+				options: &metav1.CreateOptions{
+					DryRun: []string{metav1.DryRunAll},
+				},
+			},
+			mockSetup: func() {
+				gomock.InOrder(
+					mockUserCacheFake.EXPECT().Get("admin").Return(&v3.User{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "admin",
+						},
+					}, nil),
+
+					mockTokenCacheFake.EXPECT().Get("token-12345").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-12345",
+						},
+						AuthProvider:  "oidc",
+						UserPrincipal: v3.Principal{},
+					}, nil),
+
+					mockTokenCacheFake.EXPECT().Get("token-12345").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-12345",
+							Labels: map[string]string{
+								TokenKind: "session",
+							},
+						},
+						AuthProvider:  "oidc",
+						UserPrincipal: v3.Principal{},
+					}, nil),
+				)
+			},
+			want: &ext.UserActivity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "token-12345",
+				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-12345",
+				},
+				Status: ext.UserActivityStatus{
+					ExpiresAt:                metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)),
+					HeartbeatIntervalSeconds: 300,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "generate name not matching the request token",
+			args: args{
+				ctx: request.WithUser(context.Background(), &k8suser.DefaultInfo{
+					Name:   "admin",
+					Groups: []string{GroupCattleAuthenticated},
+					Extra: map[string][]string{
+						common.ExtraRequestTokenID: {"token-12345"},
+					},
+				}),
+				obj: &ext.UserActivity{
+					ObjectMeta: metav1.ObjectMeta{
+						GenerateName: "kubeconfig-",
+					},
+				},
+				validateFunc: nil,
+				options:      nil,
+			},
+			mockSetup: func() {
+				mockUserCacheFake.EXPECT().Get("admin").Return(&v3.User{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "admin",
+					},
+				}, nil)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "activity of additional tokens is recorded",
+			args: args{
+				ctx: request.WithUser(context.Background(), &k8suser.DefaultInfo{
+					Name:   "admin",
+					Groups: []string{GroupCattleAuthenticated},
+					Extra: map[string][]string{
+						common.ExtraRequestTokenID: {"token-12345"},
+					},
+				}),
+				obj: &ext.UserActivity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "token-12345",
+					},
+					Spec: ext.UserActivitySpec{
+						AdditionalTokenIDs: []string{"token-67890", "token-local"},
+					},
+				},
+				validateFunc: nil,
+				options:      nil,
+			},
+			mockSetup: func() {
+				gomock.InOrder(
+					mockUserCacheFake.EXPECT().Get("admin").Return(&v3.User{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "admin",
+						},
+					}, nil),
+
+					mockTokenCacheFake.EXPECT().Get("token-12345").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-12345",
+						},
+						AuthProvider:  "oidc",
+						UserPrincipal: v3.Principal{},
+					}, nil),
+					mockTokenCacheFake.EXPECT().Get("token-12345").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-12345",
+							Labels: map[string]string{
+								TokenKind: "session",
+							},
+						},
+						AuthProvider:  "oidc",
+						UserPrincipal: v3.Principal{},
+					}, nil),
+					mockTokenControllerFake.EXPECT().
+						Patch("token-12345", types.JSONPatchType, gomock.Any()).
+						Return(&apiv3.Token{}, nil),
+					mockTokenCacheFake.EXPECT().Get("token-67890").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-67890",
+							Labels: map[string]string{
+								TokenKind: "session",
+							},
+						},
+						AuthProvider:  "oidc",
+						UserPrincipal: v3.Principal{},
+					}, nil),
+					mockTokenControllerFake.EXPECT().
+						Patch("token-67890", types.JSONPatchType, gomock.Any()).
+						Return(&apiv3.Token{}, nil),
+					mockTokenCacheFake.EXPECT().Get("token-local").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-local",
+							Labels: map[string]string{
+								TokenKind: "session",
+							},
+						},
+						AuthProvider:  "local",
+						UserPrincipal: v3.Principal{},
+					}, nil),
+				)
+			},
+			want: &ext.UserActivity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "token-12345",
+				},
+				Spec: ext.UserActivitySpec{
+					UserID:             "admin",
+					TokenID:            "token-12345",
+					AdditionalTokenIDs: []string{"token-67890", "token-local"},
+				},
+				Status: ext.UserActivityStatus{
+					ExpiresAt:                metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)),
+					HeartbeatIntervalSeconds: 300,
+					AdditionalTokens: []ext.UserActivityTokenStatus{
+						{
+							TokenID:   "token-67890",
+							ExpiresAt: metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)),
+						},
+						{
+							TokenID: "token-local",
+							Error: apierrors.NewForbidden(GVR.GroupResource(), "",
+								fmt.Errorf("request token token-12345 and activity token token-local have different auth providers")).Error(),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "too many additional tokens",
+			args: args{
+				ctx: request.WithUser(context.Background(), &k8suser.DefaultInfo{
+					Name:   "admin",
+					Groups: []string{GroupCattleAuthenticated},
+					Extra: map[string][]string{
+						common.ExtraRequestTokenID: {"token-12345"},
+					},
+				}),
+				obj: &ext.UserActivity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "token-12345",
+					},
+					Spec: ext.UserActivitySpec{
+						AdditionalTokenIDs: make([]string, maxAdditionalTokens+1),
+					},
+				},
+				validateFunc: nil,
+				options:      nil,
+			},
+			mockSetup: func() {
+				mockUserCacheFake.EXPECT().Get("admin").Return(&v3.User{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "admin",
+					},
+				}, nil)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "repeated heartbeat is coalesced",
+			args: args{
+				ctx: request.WithUser(context.Background(), &k8suser.DefaultInfo{
+					Name:   "admin",
+					Groups: []string{GroupCattleAuthenticated},
+					Extra: map[string][]string{
+						common.ExtraRequestTokenID: {"token-12345"},
+					},
+				}),
+				obj: &ext.UserActivity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "token-12345",
+					},
+				},
+				validateFunc: nil,
+				options:      nil,
+			},
+			mockSetup: func() {
+				gomock.InOrder(
+					mockUserCacheFake.EXPECT().Get("admin").Return(&v3.User{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "admin",
+						},
+					}, nil),
+
+					mockTokenCacheFake.EXPECT().Get("token-12345").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-12345",
+						},
+						AuthProvider:  "oidc",
+						UserPrincipal: v3.Principal{},
+					}, nil),
+					// The stored idle timeout is 20 seconds short of the new one, less than the write threshold.
+					mockTokenCacheFake.EXPECT().Get("token-12345").Return(&apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "token-12345",
+							Labels: map[string]string{
+								TokenKind: "session",
+							},
+						},
+						AuthProvider:       "oidc",
+						UserPrincipal:      v3.Principal{},
+						ActivityLastSeenAt: &metav1.Time{Time: time.Date(2025, 2, 2, 0, 53, 40, 0, time.UTC)},
+					}, nil),
+				)
+			},
+			want: &ext.UserActivity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "token-12345",
+				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-12345",
+				},
 				Status: ext.UserActivityStatus{
-					ExpiresAt: metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, &time.Location{})).Format(time.RFC3339),
+					ExpiresAt:                metav1.NewTime(time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)),
+					HeartbeatIntervalSeconds: 300,
 				},
 			},
 			wantErr: false,
@@ -342,14 +717,10 @@ type args struct {
 				tokens:        mockTokenControllerFake,
 				userCache:     mockUserCacheFake,
 				extTokenStore: store,
+				activity:      newActivityWriter(mockTokenControllerFake, store),
+				clock:         clocktesting.NewFakePassiveClock(time.Date(2025, 2, 1, 8, 54, 0, 0, time.UTC)),
 			}
 
-			// Mock the time function
-			mockNow := time.Date(2025, 2, 1, 8, 54, 0, 0, time.UTC)
-			origTimeNow := timeNow
-			timeNow = func() time.Time { return mockNow }
-			defer func() { timeNow = origTimeNow }() // Restore original function after test
-
 			// Setup mocks
 			tt.mockSetup()
 
@@ -426,8 +797,100 @@ type args struct {
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "token-12345",
 				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-12345",
+				},
+				Status: ext.UserActivityStatus{
+					ExpiresAt: metav1.NewTime(time.Date(2025, 1, 31, 16, 44, 0, 0, time.UTC)),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "useractivity of the request token retrieved by name",
+			args: args{
+				ctx: request.WithUser(context.Background(), &k8suser.DefaultInfo{
+					Name:   "admin",
+					Groups: []string{GroupCattleAuthenticated},
+					Extra: map[string][]string{
+						common.ExtraRequestTokenID: {"token-12345"},
+					},
+				}),
+				name: Name("admin", "token-12345"),
+			},
+			mockSetup: func() {
+				mockTokenCacheFake.EXPECT().Get("token-12345").Return(&apiv3.Token{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "token-12345",
+					},
+					UserID: "admin",
+					ActivityLastSeenAt: &metav1.Time{
+						Time: time.Date(2025, 1, 31, 16, 44, 0, 0, &time.Location{}),
+					},
+				}, nil).AnyTimes()
+				mockUserCacheFake.EXPECT().Get(gomock.Any()).Return(
+					&apiv3.User{}, nil,
+				)
+			},
+			want: &ext.UserActivity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: Name("admin", "token-12345"),
+				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-12345",
+				},
+				Status: ext.UserActivityStatus{
+					ExpiresAt: metav1.NewTime(time.Date(2025, 1, 31, 16, 44, 0, 0, time.UTC)),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "useractivity of another token of the user retrieved by name",
+			args: args{
+				ctx: request.WithUser(context.Background(), &k8suser.DefaultInfo{
+					Name:   "admin",
+					Groups: []string{GroupCattleAuthenticated},
+					Extra: map[string][]string{
+						common.ExtraRequestTokenID: {"token-12345"},
+					},
+				}),
+				name: Name("admin", "token-67890"),
+			},
+			mockSetup: func() {
+				mockTokenControllerFake.EXPECT().List(metav1.ListOptions{
+					LabelSelector: "authn.management.cattle.io/token-userId=admin",
+				}).Return(&apiv3.TokenList{Items: []apiv3.Token{
+					{ObjectMeta: metav1.ObjectMeta{Name: "token-12345"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "token-67890"}},
+				}}, nil)
+				mockTokenCacheFake.EXPECT().Get(gomock.Any()).DoAndReturn(func(name string) (*apiv3.Token, error) {
+					return &apiv3.Token{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: name,
+						},
+						UserID: "admin",
+						ActivityLastSeenAt: &metav1.Time{
+							Time: time.Date(2025, 1, 31, 16, 44, 0, 0, &time.Location{}),
+						},
+					}, nil
+				}).AnyTimes()
+				mockUserCacheFake.EXPECT().Get(gomock.Any()).Return(
+					&apiv3.User{}, nil,
+				)
+			},
+			want: &ext.UserActivity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: Name("admin", "token-67890"),
+				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-67890",
+				},
 				Status: ext.UserActivityStatus{
-					ExpiresAt: time.Date(2025, 1, 31, 16, 44, 0, 0, &time.Location{}).String(),
+					ExpiresAt: metav1.NewTime(time.Date(2025, 1, 31, 16, 44, 0, 0, time.UTC)),
 				},
 			},
 			wantErr: false,
@@ -487,8 +950,12 @@ type args struct {
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "token-12345",
 				},
+				Spec: ext.UserActivitySpec{
+					UserID:  "admin",
+					TokenID: "token-12345",
+				},
 				Status: ext.UserActivityStatus{
-					ExpiresAt: time.Date(2025, 1, 31, 16, 44, 0, 0, &time.Location{}).String(),
+					ExpiresAt: metav1.NewTime(time.Date(2025, 1, 31, 16, 44, 0, 0, time.UTC)),
 				},
 			},
 			wantErr: false,
@@ -566,3 +1033,285 @@ type args struct {
 		})
 	}
 }
+
+func TestSpecForCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		ua       *ext.UserActivity
+		wantSpec ext.UserActivitySpec
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name:     "empty request records the activity of the request token",
+			ua:       &ext.UserActivity{},
+			wantSpec: ext.UserActivitySpec{UserID: "admin", TokenID: "token-12345"},
+			wantName: Name("admin", "token-12345"),
+		},
+		{
+			name:     "spec names another token",
+			ua:       &ext.UserActivity{Spec: ext.UserActivitySpec{UserID: "admin", TokenID: "token-67890"}},
+			wantSpec: ext.UserActivitySpec{UserID: "admin", TokenID: "token-67890"},
+			wantName: Name("admin", "token-67890"),
+		},
+		{
+			name: "name matching the spec",
+			ua: &ext.UserActivity{
+				ObjectMeta: metav1.ObjectMeta{Name: Name("admin", "token-67890")},
+				Spec:       ext.UserActivitySpec{TokenID: "token-67890"},
+			},
+			wantSpec: ext.UserActivitySpec{UserID: "admin", TokenID: "token-67890"},
+			wantName: Name("admin", "token-67890"),
+		},
+		{
+			name: "name not matching the spec",
+			ua: &ext.UserActivity{
+				ObjectMeta: metav1.ObjectMeta{Name: Name("admin", "token-12345")},
+				Spec:       ext.UserActivitySpec{TokenID: "token-67890"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "user other than the request user",
+			ua:      &ext.UserActivity{Spec: ext.UserActivitySpec{UserID: "u-1", TokenID: "token-67890"}},
+			wantErr: true,
+		},
+		{
+			name:     "name of the token is converted",
+			ua:       &ext.UserActivity{ObjectMeta: metav1.ObjectMeta{Name: "token-67890"}},
+			wantSpec: ext.UserActivitySpec{UserID: "admin", TokenID: "token-67890"},
+			wantName: "token-67890",
+		},
+		{
+			name:     "generateName of the request token is converted",
+			ua:       &ext.UserActivity{ObjectMeta: metav1.ObjectMeta{GenerateName: "token-"}},
+			wantSpec: ext.UserActivitySpec{UserID: "admin", TokenID: "token-12345"},
+			wantName: "token-12345",
+		},
+		{
+			name:    "generateName of another token",
+			ua:      &ext.UserActivity{ObjectMeta: metav1.ObjectMeta{GenerateName: "kubeconfig-"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, name, err := specForCreate("admin", "token-12345", tt.ua)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("specForCreate() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("specForCreate() error = %v", err)
+			}
+			if spec != tt.wantSpec {
+				t.Errorf("specForCreate() spec = %v, want %v", spec, tt.wantSpec)
+			}
+			if name != tt.wantName {
+				t.Errorf("specForCreate() name = %s, want %s", name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestUserActivityStatusJSON(t *testing.T) {
+	// the expiration is serialized in RFC3339 format in UTC, regardless of the time zone of the server
+	status := ext.UserActivityStatus{
+		ExpiresAt: metav1.NewTime(time.Date(2025, 2, 1, 10, 54, 0, 0, time.FixedZone("UTC+2", 2*60*60))),
+	}
+	data, err := json.Marshal(status)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"expiresAt":"2025-02-01T08:54:00Z"}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestValidateActivityTokenKind(t *testing.T) {
+	origByKind := settings.AuthUserSessionIdleTTLMinutesByKind.Get()
+	t.Cleanup(func() {
+		if err := settings.AuthUserSessionIdleTTLMinutesByKind.Set(origByKind); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	auth := &apiv3.Token{
+		ObjectMeta:   metav1.ObjectMeta{Name: "token-auth"},
+		UserID:       "admin",
+		AuthProvider: "oidc",
+	}
+	kubeconfig := &apiv3.Token{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "token-kubeconfig",
+			Labels: map[string]string{TokenKind: "kubeconfig"},
+		},
+		UserID:       "admin",
+		AuthProvider: "oidc",
+		IsDerived:    true,
+	}
+	extKubeconfig := &ext.Token{
+		ObjectMeta: metav1.ObjectMeta{Name: "token-ext-kubeconfig"},
+		Spec: ext.TokenSpec{
+			UserID: "admin",
+			Kind:   "kubeconfig",
+		},
+	}
+	if got := tokenKind(auth); got != "session" {
+		t.Errorf("tokenKind() = %s, want session", got)
+	}
+	if got := tokenKind(kubeconfig); got != "kubeconfig" {
+		t.Errorf("tokenKind() = %s, want kubeconfig", got)
+	}
+	if got := tokenKind(extKubeconfig); got != "kubeconfig" {
+		t.Errorf("tokenKind() = %s, want kubeconfig", got)
+	}
+
+	// derived tokens are only accepted if their kind has an idle timeout
+	if err := settings.AuthUserSessionIdleTTLMinutesByKind.Set(""); err != nil {
+		t.Fatal(err)
+	}
+	if err := validateActivityToken(auth, kubeconfig); err == nil {
+		t.Error("validateActivityToken() expected an error for a kubeconfig token without idle timeout")
+	}
+
+	if err := settings.AuthUserSessionIdleTTLMinutesByKind.Set(`{"kubeconfig": 480}`); err != nil {
+		t.Fatal(err)
+	}
+	if err := validateActivityToken(auth, kubeconfig); err != nil {
+		t.Errorf("validateActivityToken() unexpected error = %v", err)
+	}
+}
+
+func TestHeartbeatInterval(t *testing.T) {
+	tests := []struct {
+		idleTimeout time.Duration
+		want        time.Duration
+	}{
+		{idleTimeout: time.Minute, want: 15 * time.Second},
+		{idleTimeout: 8 * time.Minute, want: 2 * time.Minute},
+		{idleTimeout: 16 * time.Hour, want: 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := heartbeatInterval(tt.idleTimeout); got != tt.want {
+			t.Errorf("heartbeatInterval(%s) = %s, want %s", tt.idleTimeout, got, tt.want)
+		}
+	}
+}
+
+func TestSessionExpiresAt(t *testing.T) {
+	created := metav1.NewTime(time.Date(2025, 2, 1, 8, 0, 0, 0, time.UTC))
+
+	expiresAt, ok := sessionExpiresAt(&apiv3.Token{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		TTLMillis:  3600000,
+	})
+	if !ok || !expiresAt.Equal(created.Add(time.Hour)) {
+		t.Errorf("sessionExpiresAt() = %s, %v, want %s, true", expiresAt, ok, created.Add(time.Hour))
+	}
+
+	if _, ok := sessionExpiresAt(&apiv3.Token{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created}}); ok {
+		t.Error("sessionExpiresAt() expected a v3 token without TTL not to expire")
+	}
+
+	expiresAt, ok = sessionExpiresAt(&ext.Token{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		Spec:       ext.TokenSpec{TTL: 7200000},
+	})
+	if !ok || !expiresAt.Equal(created.Add(2*time.Hour)) {
+		t.Errorf("sessionExpiresAt() = %s, %v, want %s, true", expiresAt, ok, created.Add(2*time.Hour))
+	}
+
+	if _, ok := sessionExpiresAt(&ext.Token{Spec: ext.TokenSpec{TTL: -1}}); ok {
+		t.Error("sessionExpiresAt() expected an ext token with a negative TTL not to expire")
+	}
+}
+
+func TestSessionExpiresAtMaxTTL(t *testing.T) {
+	origMaxTTL := settings.AuthUserSessionMaxTTL.Get()
+	t.Cleanup(func() {
+		if err := settings.AuthUserSessionMaxTTL.Set(origMaxTTL); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if err := settings.AuthUserSessionMaxTTL.Set("1h"); err != nil {
+		t.Fatal(err)
+	}
+
+	created := metav1.NewTime(time.Date(2025, 2, 1, 8, 0, 0, 0, time.UTC))
+
+	// the maximum lifetime comes before the time to live
+	expiresAt, ok := sessionExpiresAt(&apiv3.Token{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		TTLMillis:  7200000,
+	})
+	if !ok || !expiresAt.Equal(created.Add(time.Hour)) {
+		t.Errorf("sessionExpiresAt() = %s, %v, want %s, true", expiresAt, ok, created.Add(time.Hour))
+	}
+
+	// the maximum lifetime applies to sessions without a time to live
+	expiresAt, ok = sessionExpiresAt(&ext.Token{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		Spec:       ext.TokenSpec{Kind: "session", TTL: -1},
+	})
+	if !ok || !expiresAt.Equal(created.Add(time.Hour)) {
+		t.Errorf("sessionExpiresAt() = %s, %v, want %s, true", expiresAt, ok, created.Add(time.Hour))
+	}
+
+	// the time to live comes before the maximum lifetime
+	expiresAt, ok = sessionExpiresAt(&apiv3.Token{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		TTLMillis:  1800000,
+	})
+	if !ok || !expiresAt.Equal(created.Add(30*time.Minute)) {
+		t.Errorf("sessionExpiresAt() = %s, %v, want %s, true", expiresAt, ok, created.Add(30*time.Minute))
+	}
+
+	// derived tokens aren't limited by the maximum lifetime of sessions
+	if _, ok := sessionExpiresAt(&apiv3.Token{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		IsDerived:  true,
+	}); ok {
+		t.Error("sessionExpiresAt() expected a derived token without TTL not to expire")
+	}
+}
+
+func TestStoreExport(t *testing.T) {
+	stored := time.Date(2025, 2, 2, 0, 54, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	tokens := wranglerfake.NewMockNonNamespacedControllerInterface[*apiv3.Token, *apiv3.TokenList](ctrl)
+	secrets := wranglerfake.NewMockControllerInterface[*corev1.Secret, *corev1.SecretList](ctrl)
+	users := wranglerfake.NewMockNonNamespacedControllerInterface[*apiv3.User, *apiv3.UserList](ctrl)
+
+	users.EXPECT().Cache().Return(nil)
+	secrets.EXPECT().Cache().Return(nil)
+
+	extTokenStore := exttokenstore.NewSystem(nil, nil, secrets, users, nil, nil, nil, nil)
+	uas := &Store{
+		tokens:        tokens,
+		extTokenStore: extTokenStore,
+		activity:      newActivityWriter(tokens, extTokenStore),
+	}
+
+	token := &apiv3.Token{
+		ObjectMeta:         metav1.ObjectMeta{Name: "token-12345"},
+		ActivityLastSeenAt: &metav1.Time{Time: stored},
+	}
+	require.NoError(t, uas.activity.record(token, stored.Add(10*time.Second), 5*time.Minute))
+
+	// The coalesced activity is stored before the tokens are listed.
+	patch := tokens.EXPECT().Patch("token-12345", types.JSONPatchType, gomock.Any()).Return(&apiv3.Token{}, nil)
+	secrets.EXPECT().List("cattle-tokens", metav1.ListOptions{
+		LabelSelector: "cattle.io/kind=token",
+	}).Return(&corev1.SecretList{}, nil).After(patch)
+
+	backup, err := uas.Export()
+	require.NoError(t, err)
+	assert.Equal(t, exttokenstore.BackupVersion, backup.Version)
+	assert.Empty(t, backup.Items)
+	assert.Empty(t, uas.activity.pending)
+}
@@ -0,0 +1,215 @@
+// usermergerequest implements the store for the imperative usermergerequest resource.
+package usermergerequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const (
+	SingularName = "usermergerequest"
+	kind         = "UserMergeRequest"
+)
+
+var (
+	_ rest.Creater                  = &Store{}
+	_ rest.Storage                  = &Store{}
+	_ rest.Scoper                   = &Store{}
+	_ rest.SingularNameProvider     = &Store{}
+	_ rest.GroupVersionKindProvider = &Store{}
+)
+
+var GVK = ext.SchemeGroupVersion.WithKind(kind)
+
+// requiredPermissions are the permissions needed to merge accounts, as the users, their bindings, their tokens and their
+// preferences are changed, and the duplicate users deleted, on behalf of the requester. The requester must also be able
+// to grant the bindings of the duplicate users, which is checked with the escalation checker.
+var requiredPermissions = []struct {
+	verb     string
+	resource string
+}{
+	{"update", "users"},
+	{"delete", "users"},
+	{"create", "preferences"},
+	{"create", "globalrolebindings"},
+	{"delete", "globalrolebindings"},
+	{"create", "clusterroletemplatebindings"},
+	{"delete", "clusterroletemplatebindings"},
+	{"create", "projectroletemplatebindings"},
+	{"delete", "projectroletemplatebindings"},
+	{"update", "tokens"},
+	{"delete", "tokens"},
+}
+
+// merger is the subset of [principalmigration.Linker] used by the store.
+type merger interface {
+	Merge(userID string, duplicateUserIDs []string, dryRun bool) (principalmigration.MergeResult, error)
+}
+
+// escalationChecker is the subset of [rbac.EscalationChecker] used by the store.
+type escalationChecker interface {
+	CheckUserBindings(u user.Info, userID string) error
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+// Store merges the duplicate accounts of a UserMergeRequest into its user.
+type Store struct {
+	authorizer        authorizer.Authorizer
+	merger            merger
+	escalationChecker escalationChecker
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+func New(wranglerContext *wrangler.Context, authorizer authorizer.Authorizer) *Store {
+	return &Store{
+		authorizer:        authorizer,
+		merger:            principalmigration.NewLinker(wranglerContext),
+		escalationChecker: rbac.NewEscalationChecker(wranglerContext.Mgmt, wranglerContext.RBAC),
+	}
+}
+
+// GroupVersionKind implements [rest.GroupVersionKindProvider], a required interface.
+func (s *Store) GroupVersionKind(_ schema.GroupVersion) schema.GroupVersionKind {
+	return GVK
+}
+
+// NamespaceScoped implements [rest.Scoper], a required interface.
+func (s *Store) NamespaceScoped() bool {
+	return false
+}
+
+// GetSingularName implements [rest.SingularNameProvider], a required interface.
+func (s *Store) GetSingularName() string {
+	return SingularName
+}
+
+// New implements [rest.Storage], a required interface.
+func (s *Store) New() runtime.Object {
+	return &ext.UserMergeRequest{}
+}
+
+// Destroy implements [rest.Storage], a required interface.
+func (s *Store) Destroy() {
+}
+
+// Create implements [rest.Creator], the interface to support the `create`
+// verb. Delegates to the actual store method after some generic boilerplate.
+func (s *Store) Create(
+	ctx context.Context,
+	obj runtime.Object,
+	createValidation rest.ValidateObjectFunc,
+	options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		err := createValidation(ctx, obj)
+		if err != nil {
+			return obj, err
+		}
+	}
+	dryRun := options != nil && len(options.DryRun) > 0 && options.DryRun[0] == metav1.DryRunAll
+
+	objUserMergeRequest, ok := obj.(*ext.UserMergeRequest)
+	if !ok {
+		var zeroT *ext.UserMergeRequest
+		return nil, apierrors.NewInternalError(fmt.Errorf("expected %T but got %T",
+			zeroT, obj))
+	}
+
+	spec := objUserMergeRequest.Spec
+	if spec.UserID == "" {
+		return nil, apierrors.NewBadRequest("userID must be set")
+	}
+	if len(spec.DuplicateUserIDs) == 0 {
+		return nil, apierrors.NewBadRequest("duplicateUserIDs must be set")
+	}
+
+	userInfo, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("can't get user info from context"))
+	}
+	for _, permission := range requiredPermissions {
+		decision, _, err := s.authorizer.Authorize(ctx, &authorizer.AttributesRecord{
+			User:            userInfo,
+			Verb:            permission.verb,
+			APIGroup:        apiv3.SchemeGroupVersion.Group,
+			Resource:        permission.resource,
+			ResourceRequest: true,
+		})
+		if err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("error checking permissions %w", err))
+		}
+		if decision != authorizer.DecisionAllow {
+			return nil, apierrors.NewForbidden(ext.Resource(ext.UserMergeRequestResourceName), "",
+				fmt.Errorf("not allowed to %s %s", permission.verb, permission.resource))
+		}
+	}
+	// The bindings of the duplicate users are moved to the user, which mustn't grant roles the requester can't grant.
+	for _, duplicateUserID := range spec.DuplicateUserIDs {
+		if err := s.escalationChecker.CheckUserBindings(userInfo, duplicateUserID); err != nil {
+			return nil, apierrors.NewForbidden(ext.Resource(ext.UserMergeRequestResourceName), "", err)
+		}
+	}
+
+	logrus.Infof("[%s] User %s requested to merge users %v into user %s (dry run: %v)",
+		SingularName, userInfo.GetName(), spec.DuplicateUserIDs, spec.UserID, dryRun)
+	result, err := s.merger.Merge(spec.UserID, spec.DuplicateUserIDs, dryRun)
+	if err != nil {
+		switch {
+		case errors.Is(err, principalmigration.ErrInvalidLink), apierrors.IsNotFound(err):
+			return nil, apierrors.NewBadRequest(err.Error())
+		case apierrors.IsConflict(err):
+			return nil, apierrors.NewConflict(ext.Resource(ext.UserMergeRequestResourceName), "", err)
+		default:
+			return nil, apierrors.NewInternalError(err)
+		}
+	}
+
+	objUserMergeRequest.Status = mergeStatus(result)
+	return objUserMergeRequest, nil
+}
+
+// mergeStatus returns the status of a request from the result of the merge.
+func mergeStatus(result principalmigration.MergeResult) ext.UserMergeRequestStatus {
+	condition := metav1.Condition{
+		Type:   "UsersMerged",
+		Status: metav1.ConditionTrue,
+	}
+	summary := status.SummaryCompleted
+	if len(result.Failed) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "TransferFailed"
+		condition.Message = fmt.Sprintf("failed to transfer %d bindings, tokens and preferences, the users they belong to were kept", len(result.Failed))
+		summary = status.SummaryError
+	}
+
+	return ext.UserMergeRequestStatus{
+		Conditions:         []metav1.Condition{condition},
+		Summary:            summary,
+		MergedUserIDs:      result.MergedUserIDs,
+		LinkedPrincipalIDs: result.LinkedPrincipalIDs,
+		Bindings:           result.Bindings,
+		Tokens:             result.Tokens,
+		Preferences:        result.Preferences,
+		Failed:             result.Failed,
+	}
+}
@@ -0,0 +1,145 @@
+package usermergerequest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/auth/principalmigration"
+	"github.com/rancher/rancher/pkg/controllers/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+type fakeMerger struct {
+	args   []any
+	result principalmigration.MergeResult
+	err    error
+}
+
+func (f *fakeMerger) Merge(userID string, duplicateUserIDs []string, dryRun bool) (principalmigration.MergeResult, error) {
+	f.args = []any{userID, duplicateUserIDs, dryRun}
+	return f.result, f.err
+}
+
+type fakeEscalationChecker struct {
+	forbidden map[string]bool
+}
+
+func (f *fakeEscalationChecker) CheckUserBindings(u user.Info, userID string) error {
+	if f.forbidden[userID] {
+		return fmt.Errorf("user %s cannot grant the bindings of user %s", u.GetName(), userID)
+	}
+	return nil
+}
+
+func TestCreate(t *testing.T) {
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})
+	allowAll := authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+		return authorizer.DecisionAllow, "", nil
+	})
+	validSpec := ext.UserMergeRequestSpec{UserID: "u-bob", DuplicateUserIDs: []string{"u-dup"}}
+
+	t.Run("invalid requests", func(t *testing.T) {
+		store := &Store{authorizer: allowAll, merger: &fakeMerger{}, escalationChecker: &fakeEscalationChecker{}}
+		for _, spec := range []ext.UserMergeRequestSpec{
+			{},
+			{DuplicateUserIDs: []string{"u-dup"}},
+			{UserID: "u-bob"},
+		} {
+			_, err := store.Create(ctx, &ext.UserMergeRequest{Spec: spec}, nil, &metav1.CreateOptions{})
+			assert.True(t, apierrors.IsBadRequest(err))
+		}
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		store := &Store{
+			authorizer: authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+				// The user can't delete users.
+				if a.GetResource() == "users" && a.GetVerb() == "delete" {
+					return authorizer.DecisionNoOpinion, "", nil
+				}
+				return authorizer.DecisionAllow, "", nil
+			}),
+			merger:            &fakeMerger{},
+			escalationChecker: &fakeEscalationChecker{},
+		}
+		_, err := store.Create(ctx, &ext.UserMergeRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		assert.True(t, apierrors.IsForbidden(err))
+
+		// The merged bindings may grant roles the user doesn't hold.
+		merger := &fakeMerger{}
+		store = &Store{
+			authorizer:        allowAll,
+			merger:            merger,
+			escalationChecker: &fakeEscalationChecker{forbidden: map[string]bool{"u-dup": true}},
+		}
+		_, err = store.Create(ctx, &ext.UserMergeRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		assert.True(t, apierrors.IsForbidden(err))
+		assert.ErrorContains(t, err, "user admin cannot grant the bindings of user u-dup")
+		assert.Nil(t, merger.args)
+	})
+
+	t.Run("merge errors", func(t *testing.T) {
+		for _, test := range []struct {
+			err  error
+			want func(error) bool
+		}{
+			{fmt.Errorf("%w: no", principalmigration.ErrInvalidLink), apierrors.IsBadRequest},
+			{fmt.Errorf("failed: %w", apierrors.NewNotFound(schema.GroupResource{}, "u-dup")), apierrors.IsBadRequest},
+			{fmt.Errorf("failed: %w", apierrors.NewConflict(schema.GroupResource{}, "u-bob", fmt.Errorf("changed"))), apierrors.IsConflict},
+			{fmt.Errorf("unavailable"), apierrors.IsInternalError},
+		} {
+			store := &Store{authorizer: allowAll, merger: &fakeMerger{err: test.err}, escalationChecker: &fakeEscalationChecker{}}
+			_, err := store.Create(ctx, &ext.UserMergeRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+			assert.True(t, test.want(err), err)
+		}
+	})
+
+	t.Run("users are merged", func(t *testing.T) {
+		merger := &fakeMerger{result: principalmigration.MergeResult{
+			LinkResult: principalmigration.LinkResult{
+				LinkedPrincipalIDs: []string{"okta_user://bob@example.com"},
+				Bindings:           2,
+				Tokens:             1,
+			},
+			MergedUserIDs: []string{"u-dup"},
+			Preferences:   3,
+		}}
+		store := &Store{authorizer: allowAll, merger: merger, escalationChecker: &fakeEscalationChecker{}}
+
+		obj, err := store.Create(ctx, &ext.UserMergeRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []any{"u-bob", []string{"u-dup"}, false}, merger.args)
+		assert.Equal(t, ext.UserMergeRequestStatus{
+			Conditions:         []metav1.Condition{{Type: "UsersMerged", Status: metav1.ConditionTrue}},
+			Summary:            status.SummaryCompleted,
+			MergedUserIDs:      []string{"u-dup"},
+			LinkedPrincipalIDs: []string{"okta_user://bob@example.com"},
+			Bindings:           2,
+			Tokens:             1,
+			Preferences:        3,
+		}, obj.(*ext.UserMergeRequest).Status)
+	})
+
+	t.Run("failed transfers are reported", func(t *testing.T) {
+		merger := &fakeMerger{result: principalmigration.MergeResult{
+			LinkResult: principalmigration.LinkResult{Failed: []string{"Preference/u-dup/theme"}},
+		}}
+		store := &Store{authorizer: allowAll, merger: merger, escalationChecker: &fakeEscalationChecker{}}
+
+		obj, err := store.Create(ctx, &ext.UserMergeRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		require.NoError(t, err)
+		mergeStatus := obj.(*ext.UserMergeRequest).Status
+		assert.Equal(t, status.SummaryError, mergeStatus.Summary)
+		assert.Equal(t, metav1.ConditionFalse, mergeStatus.Conditions[0].Status)
+		assert.Equal(t, []string{"Preference/u-dup/theme"}, mergeStatus.Failed)
+	})
+}
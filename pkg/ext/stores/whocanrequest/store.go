@@ -0,0 +1,177 @@
+// whocanrequest implements the store for the imperative whocanrequest resource.
+package whocanrequest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	apiv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/whocan"
+	"github.com/rancher/rancher/pkg/wrangler"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+const (
+	SingularName = "whocanrequest"
+	kind         = "WhoCanRequest"
+)
+
+var (
+	_ rest.Creater                  = &Store{}
+	_ rest.Storage                  = &Store{}
+	_ rest.Scoper                   = &Store{}
+	_ rest.SingularNameProvider     = &Store{}
+	_ rest.GroupVersionKindProvider = &Store{}
+)
+
+var GVK = ext.SchemeGroupVersion.WithKind(kind)
+
+// resolver is the subset of [whocan.Resolver] used by the store.
+type resolver interface {
+	Subjects(clusterName, projectName string, a authorizer.Attributes) ([]whocan.Subject, error)
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+// Store finds the subjects which can perform the action described by a WhoCanRequest.
+type Store struct {
+	authorizer authorizer.Authorizer
+	resolver   resolver
+}
+
+// +k8s:openapi-gen=false
+// +k8s:deepcopy-gen=false
+
+func New(wranglerContext *wrangler.Context, authorizer authorizer.Authorizer) *Store {
+	return &Store{
+		authorizer: authorizer,
+		resolver:   whocan.NewResolver(wranglerContext),
+	}
+}
+
+// GroupVersionKind implements [rest.GroupVersionKindProvider], a required interface.
+func (s *Store) GroupVersionKind(_ schema.GroupVersion) schema.GroupVersionKind {
+	return GVK
+}
+
+// NamespaceScoped implements [rest.Scoper], a required interface.
+func (s *Store) NamespaceScoped() bool {
+	return false
+}
+
+// GetSingularName implements [rest.SingularNameProvider], a required interface.
+func (s *Store) GetSingularName() string {
+	return SingularName
+}
+
+// New implements [rest.Storage], a required interface.
+func (s *Store) New() runtime.Object {
+	return &ext.WhoCanRequest{}
+}
+
+// Destroy implements [rest.Storage], a required interface.
+func (s *Store) Destroy() {
+}
+
+// Create implements [rest.Creator], the interface to support the `create`
+// verb. Delegates to the actual store method after some generic boilerplate.
+func (s *Store) Create(
+	ctx context.Context,
+	obj runtime.Object,
+	createValidation rest.ValidateObjectFunc,
+	options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		err := createValidation(ctx, obj)
+		if err != nil {
+			return obj, err
+		}
+	}
+
+	objWhoCanRequest, ok := obj.(*ext.WhoCanRequest)
+	if !ok {
+		var zeroT *ext.WhoCanRequest
+		return nil, apierrors.NewInternalError(fmt.Errorf("expected %T but got %T",
+			zeroT, obj))
+	}
+
+	spec := objWhoCanRequest.Spec
+	if spec.Verb == "" || spec.Resource == "" || spec.ClusterName == "" {
+		return nil, apierrors.NewBadRequest("verb, resource and clusterName must be set")
+	}
+
+	userInfo, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("can't get user info from context"))
+	}
+	// The answer discloses the bindings considered, which the user must be allowed to list.
+	checks := []authorizer.AttributesRecord{
+		{Resource: "globalrolebindings"},
+		{Resource: "clusterroletemplatebindings", Namespace: spec.ClusterName},
+	}
+	if spec.ProjectName != "" {
+		// The bindings are in the backing namespace of the project, any namespace is checked.
+		checks = append(checks, authorizer.AttributesRecord{Resource: "projectroletemplatebindings"})
+	}
+	for _, check := range checks {
+		check.User = userInfo
+		check.Verb = "list"
+		check.APIGroup = apiv3.SchemeGroupVersion.Group
+		check.ResourceRequest = true
+		decision, _, err := s.authorizer.Authorize(ctx, &check)
+		if err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("error checking permissions %w", err))
+		}
+		if decision != authorizer.DecisionAllow {
+			return nil, apierrors.NewForbidden(ext.Resource(ext.WhoCanRequestResourceName), "",
+				fmt.Errorf("not allowed to list %s", check.Resource))
+		}
+	}
+
+	resource, subresource, _ := strings.Cut(spec.Resource, "/")
+	subjects, err := s.resolver.Subjects(spec.ClusterName, spec.ProjectName, &authorizer.AttributesRecord{
+		Verb:            spec.Verb,
+		Namespace:       spec.Namespace,
+		APIGroup:        spec.APIGroup,
+		Resource:        resource,
+		Subresource:     subresource,
+		Name:            spec.ResourceName,
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	objWhoCanRequest.Status = whoCanStatus(subjects)
+	return objWhoCanRequest, nil
+}
+
+// whoCanStatus returns the status of a request from the subjects which can perform the action.
+func whoCanStatus(subjects []whocan.Subject) ext.WhoCanRequestStatus {
+	var status ext.WhoCanRequestStatus
+	for _, subject := range subjects {
+		statusSubject := ext.WhoCanRequestSubject{
+			Kind:     subject.Kind,
+			Name:     subject.Name,
+			Bindings: make([]ext.WhoCanRequestBinding, 0, len(subject.Bindings)),
+		}
+		for _, binding := range subject.Bindings {
+			statusSubject.Bindings = append(statusSubject.Bindings, ext.WhoCanRequestBinding{
+				Kind:      binding.Kind,
+				Namespace: binding.Namespace,
+				Name:      binding.Name,
+				RoleName:  binding.RoleName,
+			})
+		}
+		status.Subjects = append(status.Subjects, statusSubject)
+	}
+	return status
+}
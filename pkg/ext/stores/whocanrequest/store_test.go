@@ -0,0 +1,91 @@
+package whocanrequest
+
+import (
+	"context"
+	"testing"
+
+	ext "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/auth/whocan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+type fakeResolver struct {
+	clusterName string
+	projectName string
+	attributes  authorizer.Attributes
+	subjects    []whocan.Subject
+}
+
+func (f *fakeResolver) Subjects(clusterName, projectName string, a authorizer.Attributes) ([]whocan.Subject, error) {
+	f.clusterName, f.projectName, f.attributes = clusterName, projectName, a
+	return f.subjects, nil
+}
+
+func TestCreate(t *testing.T) {
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})
+	allowAll := authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+		return authorizer.DecisionAllow, "", nil
+	})
+	validSpec := ext.WhoCanRequestSpec{
+		Verb:        "get",
+		Resource:    "pods/log",
+		Namespace:   "default",
+		ClusterName: "c-1",
+		ProjectName: "p-1",
+	}
+
+	t.Run("invalid spec", func(t *testing.T) {
+		store := &Store{authorizer: allowAll, resolver: &fakeResolver{}}
+		for _, spec := range []ext.WhoCanRequestSpec{
+			{},
+			{Verb: "get", Resource: "pods"},
+			{Verb: "get", ClusterName: "c-1"},
+		} {
+			_, err := store.Create(ctx, &ext.WhoCanRequest{Spec: spec}, nil, &metav1.CreateOptions{})
+			assert.True(t, apierrors.IsBadRequest(err))
+		}
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		store := &Store{
+			authorizer: authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+				// The user can't list the project role template bindings.
+				if a.GetResource() == "projectroletemplatebindings" {
+					return authorizer.DecisionNoOpinion, "", nil
+				}
+				return authorizer.DecisionAllow, "", nil
+			}),
+			resolver: &fakeResolver{},
+		}
+		_, err := store.Create(ctx, &ext.WhoCanRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		assert.True(t, apierrors.IsForbidden(err))
+	})
+
+	t.Run("subjects are found", func(t *testing.T) {
+		resolver := &fakeResolver{subjects: []whocan.Subject{
+			{Kind: whocan.SubjectUser, Name: "u-1", Bindings: []whocan.Binding{
+				{Kind: "ProjectRoleTemplateBinding", Namespace: "p-1", Name: "prtb-1", RoleName: "project-member"},
+			}},
+		}}
+		store := &Store{authorizer: allowAll, resolver: resolver}
+
+		obj, err := store.Create(ctx, &ext.WhoCanRequest{Spec: validSpec}, nil, &metav1.CreateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, ext.WhoCanRequestStatus{
+			Subjects: []ext.WhoCanRequestSubject{{Kind: "User", Name: "u-1", Bindings: []ext.WhoCanRequestBinding{
+				{Kind: "ProjectRoleTemplateBinding", Namespace: "p-1", Name: "prtb-1", RoleName: "project-member"},
+			}}},
+		}, obj.(*ext.WhoCanRequest).Status)
+		assert.Equal(t, "c-1", resolver.clusterName)
+		assert.Equal(t, "p-1", resolver.projectName)
+		assert.Equal(t, "pods", resolver.attributes.GetResource())
+		assert.Equal(t, "log", resolver.attributes.GetSubresource())
+		assert.Equal(t, "default", resolver.attributes.GetNamespace())
+	})
+}
@@ -0,0 +1,58 @@
+package fakeclientset
+
+import (
+	"context"
+	"testing"
+
+	rkev1apply "github.com/rancher/rancher/pkg/generated/applyconfiguration/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/generated/clientset/versioned/fake"
+	"github.com/rancher/rancher/pkg/generated/clientset/versioned/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// newApplyClientset returns a fake clientset backed by a field managed object tracker, the default tracker doesn't
+// support apply patches. The rancher types have no OpenAPI schema, so their structure is deduced from the objects.
+func newApplyClientset() *fake.Clientset {
+	tracker := clienttesting.NewFieldManagedObjectTracker(scheme.Scheme, scheme.Codecs.UniversalDecoder(), managedfields.NewDeducedTypeConverter())
+	cs := &fake.Clientset{}
+	cs.AddReactor("*", "*", clienttesting.ObjectReaction(tracker))
+	return cs
+}
+
+func TestApply(t *testing.T) {
+	machines := newApplyClientset().RkeV1().CustomMachines("fleet-default")
+
+	machine, err := machines.Apply(context.TODO(),
+		rkev1apply.CustomMachine("machine", "fleet-default").
+			WithLabels(map[string]string{"cluster": "test"}).
+			WithSpec(rkev1apply.CustomMachineSpec().WithProviderID("rke2://machine")),
+		metav1.ApplyOptions{FieldManager: "test"})
+	require.NoError(t, err)
+	assert.Equal(t, "rke2://machine", machine.Spec.ProviderID)
+	assert.Equal(t, map[string]string{"cluster": "test"}, machine.Labels)
+
+	// Another field manager taking over the provider ID leaves the labels alone.
+	machine, err = machines.Apply(context.TODO(),
+		rkev1apply.CustomMachine("machine", "fleet-default").
+			WithSpec(rkev1apply.CustomMachineSpec().WithProviderID("rke2://other")),
+		metav1.ApplyOptions{FieldManager: "other", Force: true})
+	require.NoError(t, err)
+	assert.Equal(t, "rke2://other", machine.Spec.ProviderID)
+	assert.Equal(t, map[string]string{"cluster": "test"}, machine.Labels)
+
+	machine, err = machines.ApplyStatus(context.TODO(),
+		rkev1apply.CustomMachine("machine", "fleet-default").
+			WithStatus(rkev1apply.CustomMachineStatus().WithReady(true)),
+		metav1.ApplyOptions{FieldManager: "test"})
+	require.NoError(t, err)
+	assert.True(t, machine.Status.Ready)
+
+	machine, err = machines.Get(context.TODO(), "machine", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, machine.Status.Ready)
+	assert.Equal(t, "rke2://other", machine.Spec.ProviderID)
+}
@@ -0,0 +1,198 @@
+// Package fakeclientset extends the generated fake clientset with field selector support for the rke.cattle.io
+// types. The object tracker backing the fake clientset only applies label selectors, which makes it impossible to
+// unit test controllers relying on filtered lists and watches without standing up envtest.
+package fakeclientset
+
+import (
+	"strconv"
+	"sync"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/generated/clientset/versioned/fake"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/testing"
+)
+
+// InstallFieldSelectors adds reactors to the clientset which apply field selectors to list and watch requests for the
+// rke.cattle.io types. Requests without a field selector are left to the default reactors.
+func InstallFieldSelectors(cs *fake.Clientset) {
+	cs.PrependReactor("list", "*", func(action testing.Action) (bool, runtime.Object, error) {
+		listAction, ok := action.(testing.ListAction)
+		if !ok || action.GetResource().Group != rkev1.SchemeGroupVersion.Group {
+			return false, nil, nil
+		}
+		restrictions := listAction.GetListRestrictions()
+		if restrictions.Fields == nil || restrictions.Fields.Empty() {
+			return false, nil, nil
+		}
+
+		list, err := cs.Tracker().List(action.GetResource(), listAction.GetKind(), action.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return true, nil, err
+		}
+
+		filtered := make([]runtime.Object, 0, len(items))
+		for _, item := range items {
+			if matches(restrictions.Labels, restrictions.Fields, item) {
+				filtered = append(filtered, item)
+			}
+		}
+		if err := meta.SetList(list, filtered); err != nil {
+			return true, nil, err
+		}
+		return true, list, nil
+	})
+
+	cs.PrependWatchReactor("*", func(action testing.Action) (bool, watch.Interface, error) {
+		watchAction, ok := action.(testing.WatchAction)
+		if !ok || action.GetResource().Group != rkev1.SchemeGroupVersion.Group {
+			return false, nil, nil
+		}
+		restrictions := watchAction.GetWatchRestrictions()
+		if restrictions.Fields == nil || restrictions.Fields.Empty() {
+			return false, nil, nil
+		}
+
+		upstream, err := cs.Tracker().Watch(action.GetResource(), action.GetNamespace(), metav1.ListOptions{})
+		if err != nil {
+			return false, nil, err
+		}
+		return true, newFilteredWatch(upstream, restrictions.Labels, restrictions.Fields), nil
+	})
+}
+
+// ObjectFields returns the fields that can be used in field selectors for the given object. All objects support
+// metadata.name and metadata.namespace, the rke.cattle.io types additionally support a few type-specific fields.
+func ObjectFields(obj runtime.Object) fields.Set {
+	set := fields.Set{}
+	if accessor, err := meta.Accessor(obj); err == nil {
+		set["metadata.name"] = accessor.GetName()
+		set["metadata.namespace"] = accessor.GetNamespace()
+	}
+
+	switch o := obj.(type) {
+	case *rkev1.CustomMachine:
+		set["status.ready"] = strconv.FormatBool(o.Status.Ready)
+	case *rkev1.ETCDSnapshot:
+		set["spec.clusterName"] = o.Spec.ClusterName
+	case *rkev1.RKEBootstrap:
+		set["spec.clusterName"] = o.Spec.ClusterName
+		set["status.ready"] = strconv.FormatBool(o.Status.Ready)
+	case *rkev1.RKEBootstrapTemplate:
+		set["spec.clusterName"] = o.Spec.ClusterName
+	case *rkev1.RKECluster:
+		set["status.ready"] = strconv.FormatBool(o.Status.Ready)
+	case *rkev1.RKEControlPlane:
+		set["spec.clusterName"] = o.Spec.ClusterName
+		set["spec.managementClusterName"] = o.Spec.ManagementClusterName
+		set["status.ready"] = strconv.FormatBool(o.Status.Ready)
+	}
+
+	return set
+}
+
+func matches(labelSelector labels.Selector, fieldSelector fields.Selector, obj runtime.Object) bool {
+	if labelSelector != nil && !labelSelector.Empty() {
+		accessor, err := meta.Accessor(obj)
+		if err != nil || !labelSelector.Matches(labels.Set(accessor.GetLabels())) {
+			return false
+		}
+	}
+	return fieldSelector.Matches(ObjectFields(obj))
+}
+
+// filteredWatch only passes on the events of objects matching the selectors. Like the apiserver, it keeps track of
+// the objects the watcher has seen, so that an object starting to match is sent as Added, and an object no longer
+// matching is sent as Deleted.
+type filteredWatch struct {
+	upstream      watch.Interface
+	labelSelector labels.Selector
+	fieldSelector fields.Selector
+	result        chan watch.Event
+	done          chan struct{}
+	stopOnce      sync.Once
+	visible       map[string]bool
+}
+
+func newFilteredWatch(upstream watch.Interface, labelSelector labels.Selector, fieldSelector fields.Selector) *filteredWatch {
+	w := &filteredWatch{
+		upstream:      upstream,
+		labelSelector: labelSelector,
+		fieldSelector: fieldSelector,
+		result:        make(chan watch.Event),
+		done:          make(chan struct{}),
+		visible:       map[string]bool{},
+	}
+	go w.run()
+	return w
+}
+
+func (w *filteredWatch) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.done)
+		w.upstream.Stop()
+	})
+}
+
+func (w *filteredWatch) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *filteredWatch) run() {
+	defer close(w.result)
+
+	for event := range w.upstream.ResultChan() {
+		event, ok := w.filter(event)
+		if !ok {
+			continue
+		}
+		select {
+		case w.result <- event:
+		case <-w.done:
+			return
+		}
+	}
+}
+
+func (w *filteredWatch) filter(event watch.Event) (watch.Event, bool) {
+	accessor, err := meta.Accessor(event.Object)
+	if err != nil {
+		// Bookmarks and errors are passed on as is.
+		return event, true
+	}
+	key := accessor.GetNamespace() + "/" + accessor.GetName()
+
+	switch event.Type {
+	case watch.Added, watch.Modified:
+		if matches(w.labelSelector, w.fieldSelector, event.Object) {
+			if !w.visible[key] {
+				event.Type = watch.Added
+			}
+			w.visible[key] = true
+			return event, true
+		}
+		if w.visible[key] {
+			delete(w.visible, key)
+			event.Type = watch.Deleted
+			return event, true
+		}
+		return event, false
+	case watch.Deleted:
+		if w.visible[key] {
+			delete(w.visible, key)
+			return event, true
+		}
+		return event, false
+	default:
+		return event, true
+	}
+}
@@ -0,0 +1,91 @@
+package fakeclientset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/generated/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func customMachine(name string, ready bool) *rkev1.CustomMachine {
+	return &rkev1.CustomMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "fleet-default",
+			Labels:    map[string]string{"cluster": "test"},
+		},
+		Status: rkev1.CustomMachineStatus{Ready: ready},
+	}
+}
+
+func TestList(t *testing.T) {
+	cs := fake.NewSimpleClientset(customMachine("ready", true), customMachine("not-ready", false))
+	InstallFieldSelectors(cs)
+
+	list, err := cs.RkeV1().CustomMachines("fleet-default").List(context.TODO(), metav1.ListOptions{
+		FieldSelector: "status.ready=true",
+	})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "ready", list.Items[0].Name)
+
+	list, err = cs.RkeV1().CustomMachines("fleet-default").List(context.TODO(), metav1.ListOptions{
+		FieldSelector: "status.ready=true",
+		LabelSelector: "cluster=other",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+
+	list, err = cs.RkeV1().CustomMachines("fleet-default").List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 2)
+}
+
+func TestWatch(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+	InstallFieldSelectors(cs)
+
+	machines := cs.RkeV1().CustomMachines("fleet-default")
+	w, err := machines.Watch(context.TODO(), metav1.ListOptions{FieldSelector: "status.ready=true"})
+	require.NoError(t, err)
+	defer w.Stop()
+
+	machine, err := machines.Create(context.TODO(), customMachine("machine", false), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	machine.Status.Ready = true
+	machine, err = machines.Update(context.TODO(), machine, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assertEvent(t, w, watch.Added, "machine")
+
+	machine.Status.Ready = false
+	_, err = machines.Update(context.TODO(), machine, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assertEvent(t, w, watch.Deleted, "machine")
+
+	require.NoError(t, machines.Delete(context.TODO(), "machine", metav1.DeleteOptions{}))
+	select {
+	case event := <-w.ResultChan():
+		t.Fatalf("unexpected event %s", event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func assertEvent(t *testing.T, w watch.Interface, eventType watch.EventType, name string) {
+	t.Helper()
+	select {
+	case event := <-w.ResultChan():
+		assert.Equal(t, eventType, event.Type)
+		machine, ok := event.Object.(*rkev1.CustomMachine)
+		require.True(t, ok)
+		assert.Equal(t, name, machine.Name)
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for %s event", eventType)
+	}
+}
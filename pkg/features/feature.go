@@ -3,9 +3,12 @@
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
+	"github.com/Masterminds/semver/v3"
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	managementv3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	"github.com/sirupsen/logrus"
@@ -55,7 +58,7 @@
 		"Gitops components in fleet",
 		true,
 		false,
-		true)
+		true).requires(Fleet)
 	Auth = newFeature(
 		"auth",
 		"Enable authentication",
@@ -115,13 +118,13 @@
 		"[Experimental]: Deploy container workloads to underlying harvester cluster",
 		false,
 		true,
-		true)
+		true).requires(Harvester)
 	ProvisioningV2FleetWorkspaceBackPopulation = newFeature(
 		"provisioningv2-fleet-workspace-back-population",
 		"[Experimental]: Allow Fleet workspace name to be changed on clusters administrated by provisioning v2",
 		false,
 		false,
-		true)
+		true).requires(ProvisioningV2, Fleet)
 	UIExtension = newFeature(
 		"uiextension",
 		"Enable UI Extensions when starting Rancher",
@@ -145,7 +148,7 @@
 		"Support running pre-bootstrap workloads on downstream clusters",
 		false,
 		false,
-		true)
+		true).requires(ProvisioningV2)
 	CleanStaleSecrets = newFeature(
 		"clean-stale-secrets",
 		"Remove unused impersonation secrets from the cattle-impersonation namespace",
@@ -158,18 +161,30 @@
 		false,
 		false,
 		true).lockOnInstall()
+	AuthControllersLeaderElection = newFeature(
+		"auth-controllers-leader-election",
+		"[Experimental] Run the CRTB, PRTB and RoleTemplate controllers under their own leader election, so that another Rancher replica than the one running the management controllers can reconcile them",
+		false,
+		false,
+		true)
+	ControllerServiceAccounts = newFeature(
+		"controller-service-accounts",
+		"[Experimental] Make the management controllers act in downstream clusters as service accounts with narrow RBAC specific to what they do, instead of as cluster admins",
+		false,
+		false,
+		true)
 	ClusterAgentSchedulingCustomization = newFeature(
 		"cluster-agent-scheduling-customization",
 		"Enables the automatic deployment of Pod Disruption Budgets and Priority Classes when deploying the cattle-cluster-agent. Disabling this feature will not impact existing clusters.",
 		false,
 		true,
-		true)
+		true).requiresKubernetes("v1.21.0")
 	Provisioningv2ETCDSnapshotBackPopulation = newFeature(
 		"v2prov-etcd-snapshot-backpopulate",
 		"Allow Rancher to create ETCD Snapshot CRs for downstream clusters in the local cluster",
 		true,
 		false,
-		true)
+		true).requires(ProvisioningV2)
 	OIDCProvider = newFeature(
 		"oidc-provider",
 		"Provide an OIDC provider embedded in Rancher. Required to enable SSO in Rancher Prime components.",
@@ -180,13 +195,19 @@
 		"ext-kubeconfigs",
 		"Enable Imperative API resource kubeconfigs.ext.cattle.io.",
 		true,
-		false,
+		true,
 		true)
 	ExtTokens = newFeature(
 		"ext-tokens",
 		"Enable Imperative API resource tokens.ext.cattle.io.",
 		true,
-		false,
+		true,
+		true)
+	ExtUserActivity = newFeature(
+		"ext-useractivity",
+		"Enable Imperative API resource useractivities.ext.cattle.io.",
+		true,
+		true,
 		true)
 	RancherSCCRegistrationExtension = newFeature(
 		"rancher-scc-registration-extension",
@@ -194,6 +215,12 @@
 		isPrime(),
 		false,
 		true)
+	SupportReadOnlyRole = newFeature(
+		"support-read-only-role",
+		"Provide the built-in Support Read-only cluster role template, granting read access to all the Rancher resources except tokens, for support engineers.",
+		false,
+		false,
+		true)
 )
 
 func ListEnabled() []string {
@@ -206,6 +233,16 @@ func ListEnabled() []string {
 	return ret
 }
 
+// List returns all the features, sorted by name.
+func List() []*Feature {
+	ret := make([]*Feature, 0, len(features))
+	for _, f := range features {
+		ret = append(ret, f)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].name < ret[j].name })
+	return ret
+}
+
 type Feature struct {
 	name        string
 	description string
@@ -220,6 +257,14 @@ type Feature struct {
 	install bool
 	// If a feature is locked on install, it can't be modified after install. A new Rancher instance is required to change the value.
 	lockedOnInstall bool
+	// features that must be enabled for this feature to work
+	dependencies []*Feature
+	// minimum Kubernetes version a downstream cluster must run for this feature to be applied to it
+	minKubernetesVersion *semver.Version
+
+	watchersLock sync.Mutex
+	// functions called when the effective value of the feature changes
+	watchers []func(enabled bool)
 }
 
 // InitializeFeatures updates feature default if given valid --features flag and creates/updates necessary features in k8s
@@ -387,8 +432,90 @@ func (f *Feature) Dynamic() bool {
 	return f.dynamic
 }
 
+// Set sets the effective value of the feature and notifies the watchers of the feature if the value changed.
 func (f *Feature) Set(val bool) {
+	f.watchersLock.Lock()
+	changed := f.val != val
 	f.val = val
+	watchers := f.watchers
+	f.watchersLock.Unlock()
+
+	if !changed {
+		return
+	}
+	for _, watcher := range watchers {
+		watcher(val)
+	}
+}
+
+// Watch registers a function that is called with the effective value of the feature right away and then every
+// time it changes. Only dynamic features change while Rancher is running.
+func (f *Feature) Watch(watcher func(enabled bool)) {
+	f.watchersLock.Lock()
+	f.watchers = append(f.watchers, watcher)
+	val := f.val
+	f.watchersLock.Unlock()
+
+	watcher(val)
+}
+
+// Dependencies returns the names of the features that must be enabled for the feature to work.
+func (f *Feature) Dependencies() []string {
+	var names []string
+	for _, dependency := range f.dependencies {
+		names = append(names, dependency.name)
+	}
+	return names
+}
+
+// Dependents returns the names of the features that depend on the feature.
+func (f *Feature) Dependents() []string {
+	var names []string
+	for _, other := range List() {
+		for _, dependency := range other.dependencies {
+			if dependency == f {
+				names = append(names, other.name)
+			}
+		}
+	}
+	return names
+}
+
+// UnmetDependencies returns the names of the features the feature depends on that are disabled.
+func (f *Feature) UnmetDependencies() []string {
+	var names []string
+	for _, dependency := range f.dependencies {
+		if !dependency.Enabled() {
+			names = append(names, dependency.name)
+		}
+	}
+	return names
+}
+
+// MinKubernetesVersion returns the minimum Kubernetes version a downstream cluster must run for the feature to be
+// applied to it, or an empty string if the feature doesn't depend on the Kubernetes version.
+func (f *Feature) MinKubernetesVersion() string {
+	if f.minKubernetesVersion == nil {
+		return ""
+	}
+	return f.minKubernetesVersion.Original()
+}
+
+// SupportsKubernetesVersion returns whether the feature can be applied to a cluster running the given Kubernetes version.
+func (f *Feature) SupportsKubernetesVersion(kubernetesVersion string) (bool, error) {
+	if f.minKubernetesVersion == nil {
+		return true, nil
+	}
+	v, err := semver.NewVersion(kubernetesVersion)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse Kubernetes version %s: %w", kubernetesVersion, err)
+	}
+	// pre-release and build metadata (e.g. v1.30.4+rke2r1) don't matter when comparing with the minimum version
+	core, err := semver.NewVersion(fmt.Sprintf("%d.%d.%d", v.Major(), v.Minor(), v.Patch()))
+	if err != nil {
+		return false, err
+	}
+	return !core.LessThan(f.minKubernetesVersion), nil
 }
 
 func (f *Feature) Name() string {
@@ -400,6 +527,16 @@ func (f *Feature) lockOnInstall() *Feature {
 	return f
 }
 
+func (f *Feature) requires(dependencies ...*Feature) *Feature {
+	f.dependencies = append(f.dependencies, dependencies...)
+	return f
+}
+
+func (f *Feature) requiresKubernetes(minVersion string) *Feature {
+	f.minKubernetesVersion = semver.MustParse(minVersion)
+	return f
+}
+
 func GetFeatureByName(name string) *Feature {
 	return features[name]
 }
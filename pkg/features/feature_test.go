@@ -68,3 +68,62 @@ func TestInitializeFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestWatch(t *testing.T) {
+	f := &Feature{name: "watched", val: false, dynamic: true}
+
+	var got []bool
+	f.Watch(func(enabled bool) {
+		got = append(got, enabled)
+	})
+	assert.Equal(t, []bool{false}, got, "watcher is called with the current value")
+
+	f.Set(true)
+	f.Set(true)
+	f.Set(false)
+	assert.Equal(t, []bool{false, true, false}, got, "watcher is only called when the value changes")
+}
+
+func TestDependencies(t *testing.T) {
+	enabled := &Feature{name: "enabled", val: true}
+	disabled := &Feature{name: "disabled", val: false}
+	f := (&Feature{name: "dependent"}).requires(enabled, disabled)
+
+	assert.Equal(t, []string{"enabled", "disabled"}, f.Dependencies())
+	assert.Equal(t, []string{"disabled"}, f.UnmetDependencies())
+
+	disabled.Set(true)
+	assert.Empty(t, f.UnmetDependencies())
+}
+
+func TestSupportsKubernetesVersion(t *testing.T) {
+	f := (&Feature{name: "versioned"}).requiresKubernetes("v1.21.0")
+	assert.Equal(t, "v1.21.0", f.MinKubernetesVersion())
+
+	tests := []struct {
+		version   string
+		supported bool
+		wantErr   bool
+	}{
+		{version: "v1.20.15", supported: false},
+		{version: "v1.21.0", supported: true},
+		{version: "v1.30.4+rke2r1", supported: true},
+		{version: "v1.21.0-rc.1", supported: true},
+		{version: "invalid", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			supported, err := f.SupportsKubernetesVersion(tt.version)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.supported, supported)
+		})
+	}
+
+	supported, err := (&Feature{name: "unversioned"}).SupportsKubernetesVersion("invalid")
+	assert.NoError(t, err)
+	assert.True(t, supported, "features without minimum version support all clusters")
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ExponentialBackOffValuesApplyConfiguration represents a declarative configuration of the ExponentialBackOffValues type for use
+// with apply.
+type ExponentialBackOffValuesApplyConfiguration struct {
+	MinWait    *int `json:"minWait,omitempty"`
+	MaxWait    *int `json:"maxWait,omitempty"`
+	MaxRetries *int `json:"maxRetries,omitempty"`
+}
+
+// ExponentialBackOffValuesApplyConfiguration constructs a declarative configuration of the ExponentialBackOffValues type for use with
+// apply.
+func ExponentialBackOffValues() *ExponentialBackOffValuesApplyConfiguration {
+	return &ExponentialBackOffValuesApplyConfiguration{}
+}
+
+// WithMinWait sets the MinWait field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinWait field is set to the value of the last call.
+func (b *ExponentialBackOffValuesApplyConfiguration) WithMinWait(value int) *ExponentialBackOffValuesApplyConfiguration {
+	b.MinWait = &value
+	return b
+}
+
+// WithMaxWait sets the MaxWait field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxWait field is set to the value of the last call.
+func (b *ExponentialBackOffValuesApplyConfiguration) WithMaxWait(value int) *ExponentialBackOffValuesApplyConfiguration {
+	b.MaxWait = &value
+	return b
+}
+
+// WithMaxRetries sets the MaxRetries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRetries field is set to the value of the last call.
+func (b *ExponentialBackOffValuesApplyConfiguration) WithMaxRetries(value int) *ExponentialBackOffValuesApplyConfiguration {
+	b.MaxRetries = &value
+	return b
+}
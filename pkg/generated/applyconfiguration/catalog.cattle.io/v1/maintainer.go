@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// MaintainerApplyConfiguration represents a declarative configuration of the Maintainer type for use
+// with apply.
+type MaintainerApplyConfiguration struct {
+	Name  *string `json:"name,omitempty"`
+	Email *string `json:"email,omitempty"`
+	URL   *string `json:"url,omitempty"`
+}
+
+// MaintainerApplyConfiguration constructs a declarative configuration of the Maintainer type for use with
+// apply.
+func Maintainer() *MaintainerApplyConfiguration {
+	return &MaintainerApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *MaintainerApplyConfiguration) WithName(value string) *MaintainerApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithEmail sets the Email field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Email field is set to the value of the last call.
+func (b *MaintainerApplyConfiguration) WithEmail(value string) *MaintainerApplyConfiguration {
+	b.Email = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *MaintainerApplyConfiguration) WithURL(value string) *MaintainerApplyConfiguration {
+	b.URL = &value
+	return b
+}
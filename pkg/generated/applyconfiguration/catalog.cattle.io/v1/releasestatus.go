@@ -0,0 +1,48 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ReleaseStatusApplyConfiguration represents a declarative configuration of the ReleaseStatus type for use
+// with apply.
+type ReleaseStatusApplyConfiguration struct {
+	Summary            *SummaryApplyConfiguration `json:"summary,omitempty"`
+	ObservedGeneration *int64                     `json:"observedGeneration,omitempty"`
+}
+
+// ReleaseStatusApplyConfiguration constructs a declarative configuration of the ReleaseStatus type for use with
+// apply.
+func ReleaseStatus() *ReleaseStatusApplyConfiguration {
+	return &ReleaseStatusApplyConfiguration{}
+}
+
+// WithSummary sets the Summary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Summary field is set to the value of the last call.
+func (b *ReleaseStatusApplyConfiguration) WithSummary(value *SummaryApplyConfiguration) *ReleaseStatusApplyConfiguration {
+	b.Summary = value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *ReleaseStatusApplyConfiguration) WithObservedGeneration(value int64) *ReleaseStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
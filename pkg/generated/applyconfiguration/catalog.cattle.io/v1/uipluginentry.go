@@ -0,0 +1,99 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// UIPluginEntryApplyConfiguration represents a declarative configuration of the UIPluginEntry type for use
+// with apply.
+type UIPluginEntryApplyConfiguration struct {
+	Name               *string           `json:"name,omitempty"`
+	Version            *string           `json:"version,omitempty"`
+	Endpoint           *string           `json:"endpoint,omitempty"`
+	CompressedEndpoint *string           `json:"compressedEndpoint,omitempty"`
+	NoCache            *bool             `json:"noCache,omitempty"`
+	NoAuth             *bool             `json:"noAuth,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+}
+
+// UIPluginEntryApplyConfiguration constructs a declarative configuration of the UIPluginEntry type for use with
+// apply.
+func UIPluginEntry() *UIPluginEntryApplyConfiguration {
+	return &UIPluginEntryApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *UIPluginEntryApplyConfiguration) WithName(value string) *UIPluginEntryApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *UIPluginEntryApplyConfiguration) WithVersion(value string) *UIPluginEntryApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithEndpoint sets the Endpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Endpoint field is set to the value of the last call.
+func (b *UIPluginEntryApplyConfiguration) WithEndpoint(value string) *UIPluginEntryApplyConfiguration {
+	b.Endpoint = &value
+	return b
+}
+
+// WithCompressedEndpoint sets the CompressedEndpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompressedEndpoint field is set to the value of the last call.
+func (b *UIPluginEntryApplyConfiguration) WithCompressedEndpoint(value string) *UIPluginEntryApplyConfiguration {
+	b.CompressedEndpoint = &value
+	return b
+}
+
+// WithNoCache sets the NoCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NoCache field is set to the value of the last call.
+func (b *UIPluginEntryApplyConfiguration) WithNoCache(value bool) *UIPluginEntryApplyConfiguration {
+	b.NoCache = &value
+	return b
+}
+
+// WithNoAuth sets the NoAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NoAuth field is set to the value of the last call.
+func (b *UIPluginEntryApplyConfiguration) WithNoAuth(value bool) *UIPluginEntryApplyConfiguration {
+	b.NoAuth = &value
+	return b
+}
+
+// WithMetadata puts the entries into the Metadata field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Metadata field,
+// overwriting an existing map entries in Metadata field with the same key.
+func (b *UIPluginEntryApplyConfiguration) WithMetadata(entries map[string]string) *UIPluginEntryApplyConfiguration {
+	if b.Metadata == nil && len(entries) > 0 {
+		b.Metadata = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Metadata[k] = v
+	}
+	return b
+}
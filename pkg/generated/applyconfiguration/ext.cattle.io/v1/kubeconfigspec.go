@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// KubeconfigSpecApplyConfiguration represents a declarative configuration of the KubeconfigSpec type for use
+// with apply.
+type KubeconfigSpecApplyConfiguration struct {
+	Clusters       []string `json:"clusters,omitempty"`
+	CurrentContext *string  `json:"currentContext,omitempty"`
+	Description    *string  `json:"description,omitempty"`
+	TTL            *int64   `json:"ttl,omitempty"`
+}
+
+// KubeconfigSpecApplyConfiguration constructs a declarative configuration of the KubeconfigSpec type for use with
+// apply.
+func KubeconfigSpec() *KubeconfigSpecApplyConfiguration {
+	return &KubeconfigSpecApplyConfiguration{}
+}
+
+// WithClusters adds the given value to the Clusters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Clusters field.
+func (b *KubeconfigSpecApplyConfiguration) WithClusters(values ...string) *KubeconfigSpecApplyConfiguration {
+	for i := range values {
+		b.Clusters = append(b.Clusters, values[i])
+	}
+	return b
+}
+
+// WithCurrentContext sets the CurrentContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentContext field is set to the value of the last call.
+func (b *KubeconfigSpecApplyConfiguration) WithCurrentContext(value string) *KubeconfigSpecApplyConfiguration {
+	b.CurrentContext = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *KubeconfigSpecApplyConfiguration) WithDescription(value string) *KubeconfigSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithTTL sets the TTL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTL field is set to the value of the last call.
+func (b *KubeconfigSpecApplyConfiguration) WithTTL(value int64) *KubeconfigSpecApplyConfiguration {
+	b.TTL = &value
+	return b
+}
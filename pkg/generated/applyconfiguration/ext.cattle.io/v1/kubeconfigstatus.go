@@ -0,0 +1,77 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// KubeconfigStatusApplyConfiguration represents a declarative configuration of the KubeconfigStatus type for use
+// with apply.
+type KubeconfigStatusApplyConfiguration struct {
+	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Summary    *string                              `json:"summary,omitempty"`
+	Tokens     []string                             `json:"tokens,omitempty"`
+	Value      *string                              `json:"value,omitempty"`
+}
+
+// KubeconfigStatusApplyConfiguration constructs a declarative configuration of the KubeconfigStatus type for use with
+// apply.
+func KubeconfigStatus() *KubeconfigStatusApplyConfiguration {
+	return &KubeconfigStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KubeconfigStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *KubeconfigStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithSummary sets the Summary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Summary field is set to the value of the last call.
+func (b *KubeconfigStatusApplyConfiguration) WithSummary(value string) *KubeconfigStatusApplyConfiguration {
+	b.Summary = &value
+	return b
+}
+
+// WithTokens adds the given value to the Tokens field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tokens field.
+func (b *KubeconfigStatusApplyConfiguration) WithTokens(values ...string) *KubeconfigStatusApplyConfiguration {
+	for i := range values {
+		b.Tokens = append(b.Tokens, values[i])
+	}
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *KubeconfigStatusApplyConfiguration) WithValue(value string) *KubeconfigStatusApplyConfiguration {
+	b.Value = &value
+	return b
+}
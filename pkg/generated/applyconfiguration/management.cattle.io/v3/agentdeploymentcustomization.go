@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// AgentDeploymentCustomizationApplyConfiguration represents a declarative configuration of the AgentDeploymentCustomization type for use
+// with apply.
+type AgentDeploymentCustomizationApplyConfiguration struct {
+	AppendTolerations            []v1.Toleration                                 `json:"appendTolerations,omitempty"`
+	OverrideAffinity             *v1.Affinity                                    `json:"overrideAffinity,omitempty"`
+	OverrideResourceRequirements *v1.ResourceRequirements                        `json:"overrideResourceRequirements,omitempty"`
+	SchedulingCustomization      *AgentSchedulingCustomizationApplyConfiguration `json:"schedulingCustomization,omitempty"`
+}
+
+// AgentDeploymentCustomizationApplyConfiguration constructs a declarative configuration of the AgentDeploymentCustomization type for use with
+// apply.
+func AgentDeploymentCustomization() *AgentDeploymentCustomizationApplyConfiguration {
+	return &AgentDeploymentCustomizationApplyConfiguration{}
+}
+
+// WithAppendTolerations adds the given value to the AppendTolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AppendTolerations field.
+func (b *AgentDeploymentCustomizationApplyConfiguration) WithAppendTolerations(values ...v1.Toleration) *AgentDeploymentCustomizationApplyConfiguration {
+	for i := range values {
+		b.AppendTolerations = append(b.AppendTolerations, values[i])
+	}
+	return b
+}
+
+// WithOverrideAffinity sets the OverrideAffinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OverrideAffinity field is set to the value of the last call.
+func (b *AgentDeploymentCustomizationApplyConfiguration) WithOverrideAffinity(value v1.Affinity) *AgentDeploymentCustomizationApplyConfiguration {
+	b.OverrideAffinity = &value
+	return b
+}
+
+// WithOverrideResourceRequirements sets the OverrideResourceRequirements field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OverrideResourceRequirements field is set to the value of the last call.
+func (b *AgentDeploymentCustomizationApplyConfiguration) WithOverrideResourceRequirements(value v1.ResourceRequirements) *AgentDeploymentCustomizationApplyConfiguration {
+	b.OverrideResourceRequirements = &value
+	return b
+}
+
+// WithSchedulingCustomization sets the SchedulingCustomization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SchedulingCustomization field is set to the value of the last call.
+func (b *AgentDeploymentCustomizationApplyConfiguration) WithSchedulingCustomization(value *AgentSchedulingCustomizationApplyConfiguration) *AgentDeploymentCustomizationApplyConfiguration {
+	b.SchedulingCustomization = value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	v1 "github.com/rancher/aks-operator/pkg/apis/aks.cattle.io/v1"
+)
+
+// AKSStatusApplyConfiguration represents a declarative configuration of the AKSStatus type for use
+// with apply.
+type AKSStatusApplyConfiguration struct {
+	UpstreamSpec          *v1.AKSClusterConfigSpec `json:"upstreamSpec,omitempty"`
+	PrivateRequiresTunnel *bool                    `json:"privateRequiresTunnel,omitempty"`
+	RBACEnabled           *bool                    `json:"rbacEnabled,omitempty"`
+}
+
+// AKSStatusApplyConfiguration constructs a declarative configuration of the AKSStatus type for use with
+// apply.
+func AKSStatus() *AKSStatusApplyConfiguration {
+	return &AKSStatusApplyConfiguration{}
+}
+
+// WithUpstreamSpec sets the UpstreamSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpstreamSpec field is set to the value of the last call.
+func (b *AKSStatusApplyConfiguration) WithUpstreamSpec(value v1.AKSClusterConfigSpec) *AKSStatusApplyConfiguration {
+	b.UpstreamSpec = &value
+	return b
+}
+
+// WithPrivateRequiresTunnel sets the PrivateRequiresTunnel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrivateRequiresTunnel field is set to the value of the last call.
+func (b *AKSStatusApplyConfiguration) WithPrivateRequiresTunnel(value bool) *AKSStatusApplyConfiguration {
+	b.PrivateRequiresTunnel = &value
+	return b
+}
+
+// WithRBACEnabled sets the RBACEnabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RBACEnabled field is set to the value of the last call.
+func (b *AKSStatusApplyConfiguration) WithRBACEnabled(value bool) *AKSStatusApplyConfiguration {
+	b.RBACEnabled = &value
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// APIServiceSpecApplyConfiguration represents a declarative configuration of the APIServiceSpec type for use
+// with apply.
+type APIServiceSpecApplyConfiguration struct {
+	PathPrefixes    []string `json:"pathPrefixes,omitempty"`
+	Paths           []string `json:"paths,omitempty"`
+	SecretName      *string  `json:"secretName,omitempty"`
+	SecretNamespace *string  `json:"secretNamespace,omitempty"`
+}
+
+// APIServiceSpecApplyConfiguration constructs a declarative configuration of the APIServiceSpec type for use with
+// apply.
+func APIServiceSpec() *APIServiceSpecApplyConfiguration {
+	return &APIServiceSpecApplyConfiguration{}
+}
+
+// WithPathPrefixes adds the given value to the PathPrefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PathPrefixes field.
+func (b *APIServiceSpecApplyConfiguration) WithPathPrefixes(values ...string) *APIServiceSpecApplyConfiguration {
+	for i := range values {
+		b.PathPrefixes = append(b.PathPrefixes, values[i])
+	}
+	return b
+}
+
+// WithPaths adds the given value to the Paths field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Paths field.
+func (b *APIServiceSpecApplyConfiguration) WithPaths(values ...string) *APIServiceSpecApplyConfiguration {
+	for i := range values {
+		b.Paths = append(b.Paths, values[i])
+	}
+	return b
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *APIServiceSpecApplyConfiguration) WithSecretName(value string) *APIServiceSpecApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithSecretNamespace sets the SecretNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretNamespace field is set to the value of the last call.
+func (b *APIServiceSpecApplyConfiguration) WithSecretNamespace(value string) *APIServiceSpecApplyConfiguration {
+	b.SecretNamespace = &value
+	return b
+}
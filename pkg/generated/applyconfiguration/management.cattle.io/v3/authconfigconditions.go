@@ -0,0 +1,89 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	condition "github.com/rancher/norman/condition"
+	v1 "k8s.io/api/core/v1"
+)
+
+// AuthConfigConditionsApplyConfiguration represents a declarative configuration of the AuthConfigConditions type for use
+// with apply.
+type AuthConfigConditionsApplyConfiguration struct {
+	Type               *condition.Cond     `json:"type,omitempty"`
+	Status             *v1.ConditionStatus `json:"status,omitempty"`
+	LastUpdateTime     *string             `json:"lastUpdateTime,omitempty"`
+	LastTransitionTime *string             `json:"lastTransitionTime,omitempty"`
+	Reason             *string             `json:"reason,omitempty"`
+	Message            *string             `json:"message,omitempty"`
+}
+
+// AuthConfigConditionsApplyConfiguration constructs a declarative configuration of the AuthConfigConditions type for use with
+// apply.
+func AuthConfigConditions() *AuthConfigConditionsApplyConfiguration {
+	return &AuthConfigConditionsApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *AuthConfigConditionsApplyConfiguration) WithType(value condition.Cond) *AuthConfigConditionsApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *AuthConfigConditionsApplyConfiguration) WithStatus(value v1.ConditionStatus) *AuthConfigConditionsApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *AuthConfigConditionsApplyConfiguration) WithLastUpdateTime(value string) *AuthConfigConditionsApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *AuthConfigConditionsApplyConfiguration) WithLastTransitionTime(value string) *AuthConfigConditionsApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *AuthConfigConditionsApplyConfiguration) WithReason(value string) *AuthConfigConditionsApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *AuthConfigConditionsApplyConfiguration) WithMessage(value string) *AuthConfigConditionsApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// AuthConfigStatusApplyConfiguration represents a declarative configuration of the AuthConfigStatus type for use
+// with apply.
+type AuthConfigStatusApplyConfiguration struct {
+	Conditions []AuthConfigConditionsApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// AuthConfigStatusApplyConfiguration constructs a declarative configuration of the AuthConfigStatus type for use with
+// apply.
+func AuthConfigStatus() *AuthConfigStatusApplyConfiguration {
+	return &AuthConfigStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *AuthConfigStatusApplyConfiguration) WithConditions(values ...*AuthConfigConditionsApplyConfiguration) *AuthConfigStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// BootstrapPrincipalSpecApplyConfiguration represents a declarative configuration of the BootstrapPrincipalSpec type for use
+// with apply.
+type BootstrapPrincipalSpecApplyConfiguration struct {
+	UserPrincipalName  *string  `json:"userPrincipalName,omitempty"`
+	GroupPrincipalName *string  `json:"groupPrincipalName,omitempty"`
+	GlobalRoleNames    []string `json:"globalRoleNames,omitempty"`
+}
+
+// BootstrapPrincipalSpecApplyConfiguration constructs a declarative configuration of the BootstrapPrincipalSpec type for use with
+// apply.
+func BootstrapPrincipalSpec() *BootstrapPrincipalSpecApplyConfiguration {
+	return &BootstrapPrincipalSpecApplyConfiguration{}
+}
+
+// WithUserPrincipalName sets the UserPrincipalName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserPrincipalName field is set to the value of the last call.
+func (b *BootstrapPrincipalSpecApplyConfiguration) WithUserPrincipalName(value string) *BootstrapPrincipalSpecApplyConfiguration {
+	b.UserPrincipalName = &value
+	return b
+}
+
+// WithGroupPrincipalName sets the GroupPrincipalName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GroupPrincipalName field is set to the value of the last call.
+func (b *BootstrapPrincipalSpecApplyConfiguration) WithGroupPrincipalName(value string) *BootstrapPrincipalSpecApplyConfiguration {
+	b.GroupPrincipalName = &value
+	return b
+}
+
+// WithGlobalRoleNames adds the given value to the GlobalRoleNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the GlobalRoleNames field.
+func (b *BootstrapPrincipalSpecApplyConfiguration) WithGlobalRoleNames(values ...string) *BootstrapPrincipalSpecApplyConfiguration {
+	for i := range values {
+		b.GlobalRoleNames = append(b.GlobalRoleNames, values[i])
+	}
+	return b
+}
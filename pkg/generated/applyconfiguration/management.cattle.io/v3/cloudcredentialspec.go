@@ -0,0 +1,61 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	managementcattleiov3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+// CloudCredentialSpecApplyConfiguration represents a declarative configuration of the CloudCredentialSpec type for use
+// with apply.
+type CloudCredentialSpecApplyConfiguration struct {
+	DisplayName        *string                                  `json:"displayName,omitempty"`
+	Description        *string                                  `json:"description,omitempty"`
+	S3CredentialConfig *managementcattleiov3.S3CredentialConfig `json:"s3credentialConfig,omitempty"`
+}
+
+// CloudCredentialSpecApplyConfiguration constructs a declarative configuration of the CloudCredentialSpec type for use with
+// apply.
+func CloudCredentialSpec() *CloudCredentialSpecApplyConfiguration {
+	return &CloudCredentialSpecApplyConfiguration{}
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *CloudCredentialSpecApplyConfiguration) WithDisplayName(value string) *CloudCredentialSpecApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *CloudCredentialSpecApplyConfiguration) WithDescription(value string) *CloudCredentialSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithS3CredentialConfig sets the S3CredentialConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the S3CredentialConfig field is set to the value of the last call.
+func (b *CloudCredentialSpecApplyConfiguration) WithS3CredentialConfig(value managementcattleiov3.S3CredentialConfig) *CloudCredentialSpecApplyConfiguration {
+	b.S3CredentialConfig = &value
+	return b
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	v1 "github.com/rancher/aks-operator/pkg/apis/aks.cattle.io/v1"
+	ekscattleiov1 "github.com/rancher/eks-operator/pkg/apis/eks.cattle.io/v1"
+	gkecattleiov1 "github.com/rancher/gke-operator/pkg/apis/gke.cattle.io/v1"
+	managementcattleiov3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+// ClusterSpecApplyConfiguration represents a declarative configuration of the ClusterSpec type for use
+// with apply.
+type ClusterSpecApplyConfiguration struct {
+	DisplayName                         *string                                  `json:"displayName,omitempty"`
+	Description                         *string                                  `json:"description,omitempty"`
+	Internal                            *bool                                    `json:"internal,omitempty"`
+	K3sConfig                           *K3sConfigApplyConfiguration             `json:"k3sConfig,omitempty"`
+	Rke2Config                          *Rke2ConfigApplyConfiguration            `json:"rke2Config,omitempty"`
+	ImportedConfig                      *ImportedConfigApplyConfiguration        `json:"importedConfig,omitempty"`
+	GoogleKubernetesEngineConfig        *managementcattleiov3.MapStringInterface `json:"googleKubernetesEngineConfig,omitempty"`
+	AzureKubernetesServiceConfig        *managementcattleiov3.MapStringInterface `json:"azureKubernetesServiceConfig,omitempty"`
+	AmazonElasticContainerServiceConfig *managementcattleiov3.MapStringInterface `json:"amazonElasticContainerServiceConfig,omitempty"`
+	GenericEngineConfig                 *managementcattleiov3.MapStringInterface `json:"genericEngineConfig,omitempty"`
+	AKSConfig                           *v1.AKSClusterConfigSpec                 `json:"aksConfig,omitempty"`
+	EKSConfig                           *ekscattleiov1.EKSClusterConfigSpec      `json:"eksConfig,omitempty"`
+	GKEConfig                           *gkecattleiov1.GKEClusterConfigSpec      `json:"gkeConfig,omitempty"`
+	ClusterTemplateName                 *string                                  `json:"clusterTemplateName,omitempty"`
+	ClusterTemplateRevisionName         *string                                  `json:"clusterTemplateRevisionName,omitempty"`
+	ClusterTemplateAnswers              *AnswerApplyConfiguration                `json:"answers,omitempty"`
+	ClusterTemplateQuestions            []QuestionApplyConfiguration             `json:"questions,omitempty"`
+	FleetWorkspaceName                  *string                                  `json:"fleetWorkspaceName,omitempty"`
+}
+
+// ClusterSpecApplyConfiguration constructs a declarative configuration of the ClusterSpec type for use with
+// apply.
+func ClusterSpec() *ClusterSpecApplyConfiguration {
+	return &ClusterSpecApplyConfiguration{}
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithDisplayName(value string) *ClusterSpecApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithDescription(value string) *ClusterSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithInternal sets the Internal field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Internal field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithInternal(value bool) *ClusterSpecApplyConfiguration {
+	b.Internal = &value
+	return b
+}
+
+// WithK3sConfig sets the K3sConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the K3sConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithK3sConfig(value *K3sConfigApplyConfiguration) *ClusterSpecApplyConfiguration {
+	b.K3sConfig = value
+	return b
+}
+
+// WithRke2Config sets the Rke2Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rke2Config field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithRke2Config(value *Rke2ConfigApplyConfiguration) *ClusterSpecApplyConfiguration {
+	b.Rke2Config = value
+	return b
+}
+
+// WithImportedConfig sets the ImportedConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImportedConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithImportedConfig(value *ImportedConfigApplyConfiguration) *ClusterSpecApplyConfiguration {
+	b.ImportedConfig = value
+	return b
+}
+
+// WithGoogleKubernetesEngineConfig sets the GoogleKubernetesEngineConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GoogleKubernetesEngineConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithGoogleKubernetesEngineConfig(value managementcattleiov3.MapStringInterface) *ClusterSpecApplyConfiguration {
+	b.GoogleKubernetesEngineConfig = &value
+	return b
+}
+
+// WithAzureKubernetesServiceConfig sets the AzureKubernetesServiceConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AzureKubernetesServiceConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithAzureKubernetesServiceConfig(value managementcattleiov3.MapStringInterface) *ClusterSpecApplyConfiguration {
+	b.AzureKubernetesServiceConfig = &value
+	return b
+}
+
+// WithAmazonElasticContainerServiceConfig sets the AmazonElasticContainerServiceConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AmazonElasticContainerServiceConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithAmazonElasticContainerServiceConfig(value managementcattleiov3.MapStringInterface) *ClusterSpecApplyConfiguration {
+	b.AmazonElasticContainerServiceConfig = &value
+	return b
+}
+
+// WithGenericEngineConfig sets the GenericEngineConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenericEngineConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithGenericEngineConfig(value managementcattleiov3.MapStringInterface) *ClusterSpecApplyConfiguration {
+	b.GenericEngineConfig = &value
+	return b
+}
+
+// WithAKSConfig sets the AKSConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AKSConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithAKSConfig(value v1.AKSClusterConfigSpec) *ClusterSpecApplyConfiguration {
+	b.AKSConfig = &value
+	return b
+}
+
+// WithEKSConfig sets the EKSConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EKSConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithEKSConfig(value ekscattleiov1.EKSClusterConfigSpec) *ClusterSpecApplyConfiguration {
+	b.EKSConfig = &value
+	return b
+}
+
+// WithGKEConfig sets the GKEConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GKEConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithGKEConfig(value gkecattleiov1.GKEClusterConfigSpec) *ClusterSpecApplyConfiguration {
+	b.GKEConfig = &value
+	return b
+}
+
+// WithClusterTemplateName sets the ClusterTemplateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterTemplateName field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithClusterTemplateName(value string) *ClusterSpecApplyConfiguration {
+	b.ClusterTemplateName = &value
+	return b
+}
+
+// WithClusterTemplateRevisionName sets the ClusterTemplateRevisionName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterTemplateRevisionName field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithClusterTemplateRevisionName(value string) *ClusterSpecApplyConfiguration {
+	b.ClusterTemplateRevisionName = &value
+	return b
+}
+
+// WithClusterTemplateAnswers sets the ClusterTemplateAnswers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterTemplateAnswers field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithClusterTemplateAnswers(value *AnswerApplyConfiguration) *ClusterSpecApplyConfiguration {
+	b.ClusterTemplateAnswers = value
+	return b
+}
+
+// WithClusterTemplateQuestions adds the given value to the ClusterTemplateQuestions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ClusterTemplateQuestions field.
+func (b *ClusterSpecApplyConfiguration) WithClusterTemplateQuestions(values ...*QuestionApplyConfiguration) *ClusterSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithClusterTemplateQuestions")
+		}
+		b.ClusterTemplateQuestions = append(b.ClusterTemplateQuestions, *values[i])
+	}
+	return b
+}
+
+// WithFleetWorkspaceName sets the FleetWorkspaceName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FleetWorkspaceName field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithFleetWorkspaceName(value string) *ClusterSpecApplyConfiguration {
+	b.FleetWorkspaceName = &value
+	return b
+}
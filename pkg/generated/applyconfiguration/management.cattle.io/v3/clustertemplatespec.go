@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// ClusterTemplateSpecApplyConfiguration represents a declarative configuration of the ClusterTemplateSpec type for use
+// with apply.
+type ClusterTemplateSpecApplyConfiguration struct {
+	DisplayName         *string                    `json:"displayName,omitempty"`
+	Description         *string                    `json:"description,omitempty"`
+	DefaultRevisionName *string                    `json:"defaultRevisionName,omitempty"`
+	Members             []MemberApplyConfiguration `json:"members,omitempty"`
+}
+
+// ClusterTemplateSpecApplyConfiguration constructs a declarative configuration of the ClusterTemplateSpec type for use with
+// apply.
+func ClusterTemplateSpec() *ClusterTemplateSpecApplyConfiguration {
+	return &ClusterTemplateSpecApplyConfiguration{}
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *ClusterTemplateSpecApplyConfiguration) WithDisplayName(value string) *ClusterTemplateSpecApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *ClusterTemplateSpecApplyConfiguration) WithDescription(value string) *ClusterTemplateSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithDefaultRevisionName sets the DefaultRevisionName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultRevisionName field is set to the value of the last call.
+func (b *ClusterTemplateSpecApplyConfiguration) WithDefaultRevisionName(value string) *ClusterTemplateSpecApplyConfiguration {
+	b.DefaultRevisionName = &value
+	return b
+}
+
+// WithMembers adds the given value to the Members field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Members field.
+func (b *ClusterTemplateSpecApplyConfiguration) WithMembers(values ...*MemberApplyConfiguration) *ClusterTemplateSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMembers")
+		}
+		b.Members = append(b.Members, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// ClusterUpgradeStrategyApplyConfiguration represents a declarative configuration of the ClusterUpgradeStrategy type for use
+// with apply.
+type ClusterUpgradeStrategyApplyConfiguration struct {
+	ServerConcurrency *int  `json:"serverConcurrency,omitempty"`
+	WorkerConcurrency *int  `json:"workerConcurrency,omitempty"`
+	DrainServerNodes  *bool `json:"drainServerNodes,omitempty"`
+	DrainWorkerNodes  *bool `json:"drainWorkerNodes,omitempty"`
+}
+
+// ClusterUpgradeStrategyApplyConfiguration constructs a declarative configuration of the ClusterUpgradeStrategy type for use with
+// apply.
+func ClusterUpgradeStrategy() *ClusterUpgradeStrategyApplyConfiguration {
+	return &ClusterUpgradeStrategyApplyConfiguration{}
+}
+
+// WithServerConcurrency sets the ServerConcurrency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServerConcurrency field is set to the value of the last call.
+func (b *ClusterUpgradeStrategyApplyConfiguration) WithServerConcurrency(value int) *ClusterUpgradeStrategyApplyConfiguration {
+	b.ServerConcurrency = &value
+	return b
+}
+
+// WithWorkerConcurrency sets the WorkerConcurrency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkerConcurrency field is set to the value of the last call.
+func (b *ClusterUpgradeStrategyApplyConfiguration) WithWorkerConcurrency(value int) *ClusterUpgradeStrategyApplyConfiguration {
+	b.WorkerConcurrency = &value
+	return b
+}
+
+// WithDrainServerNodes sets the DrainServerNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrainServerNodes field is set to the value of the last call.
+func (b *ClusterUpgradeStrategyApplyConfiguration) WithDrainServerNodes(value bool) *ClusterUpgradeStrategyApplyConfiguration {
+	b.DrainServerNodes = &value
+	return b
+}
+
+// WithDrainWorkerNodes sets the DrainWorkerNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrainWorkerNodes field is set to the value of the last call.
+func (b *ClusterUpgradeStrategyApplyConfiguration) WithDrainWorkerNodes(value bool) *ClusterUpgradeStrategyApplyConfiguration {
+	b.DrainWorkerNodes = &value
+	return b
+}
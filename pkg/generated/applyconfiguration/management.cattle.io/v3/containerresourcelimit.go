@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// ContainerResourceLimitApplyConfiguration represents a declarative configuration of the ContainerResourceLimit type for use
+// with apply.
+type ContainerResourceLimitApplyConfiguration struct {
+	RequestsCPU    *string `json:"requestsCpu,omitempty"`
+	RequestsMemory *string `json:"requestsMemory,omitempty"`
+	LimitsCPU      *string `json:"limitsCpu,omitempty"`
+	LimitsMemory   *string `json:"limitsMemory,omitempty"`
+}
+
+// ContainerResourceLimitApplyConfiguration constructs a declarative configuration of the ContainerResourceLimit type for use with
+// apply.
+func ContainerResourceLimit() *ContainerResourceLimitApplyConfiguration {
+	return &ContainerResourceLimitApplyConfiguration{}
+}
+
+// WithRequestsCPU sets the RequestsCPU field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestsCPU field is set to the value of the last call.
+func (b *ContainerResourceLimitApplyConfiguration) WithRequestsCPU(value string) *ContainerResourceLimitApplyConfiguration {
+	b.RequestsCPU = &value
+	return b
+}
+
+// WithRequestsMemory sets the RequestsMemory field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestsMemory field is set to the value of the last call.
+func (b *ContainerResourceLimitApplyConfiguration) WithRequestsMemory(value string) *ContainerResourceLimitApplyConfiguration {
+	b.RequestsMemory = &value
+	return b
+}
+
+// WithLimitsCPU sets the LimitsCPU field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LimitsCPU field is set to the value of the last call.
+func (b *ContainerResourceLimitApplyConfiguration) WithLimitsCPU(value string) *ContainerResourceLimitApplyConfiguration {
+	b.LimitsCPU = &value
+	return b
+}
+
+// WithLimitsMemory sets the LimitsMemory field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LimitsMemory field is set to the value of the last call.
+func (b *ContainerResourceLimitApplyConfiguration) WithLimitsMemory(value string) *ContainerResourceLimitApplyConfiguration {
+	b.LimitsMemory = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// FeatureClusterStatusApplyConfiguration represents a declarative configuration of the FeatureClusterStatus type for use
+// with apply.
+type FeatureClusterStatusApplyConfiguration struct {
+	ClusterName *string `json:"clusterName,omitempty"`
+	Applied     *bool   `json:"applied,omitempty"`
+	Message     *string `json:"message,omitempty"`
+}
+
+// FeatureClusterStatusApplyConfiguration constructs a declarative configuration of the FeatureClusterStatus type for use with
+// apply.
+func FeatureClusterStatus() *FeatureClusterStatusApplyConfiguration {
+	return &FeatureClusterStatusApplyConfiguration{}
+}
+
+// WithClusterName sets the ClusterName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterName field is set to the value of the last call.
+func (b *FeatureClusterStatusApplyConfiguration) WithClusterName(value string) *FeatureClusterStatusApplyConfiguration {
+	b.ClusterName = &value
+	return b
+}
+
+// WithApplied sets the Applied field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Applied field is set to the value of the last call.
+func (b *FeatureClusterStatusApplyConfiguration) WithApplied(value bool) *FeatureClusterStatusApplyConfiguration {
+	b.Applied = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *FeatureClusterStatusApplyConfiguration) WithMessage(value string) *FeatureClusterStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
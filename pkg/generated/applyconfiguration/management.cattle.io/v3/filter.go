@@ -0,0 +1,41 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// FilterApplyConfiguration represents a declarative configuration of the Filter type for use
+// with apply.
+type FilterApplyConfiguration struct {
+	Modifiers []string `json:"modifiers,omitempty"`
+}
+
+// FilterApplyConfiguration constructs a declarative configuration of the Filter type for use with
+// apply.
+func Filter() *FilterApplyConfiguration {
+	return &FilterApplyConfiguration{}
+}
+
+// WithModifiers adds the given value to the Modifiers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Modifiers field.
+func (b *FilterApplyConfiguration) WithModifiers(values ...string) *FilterApplyConfiguration {
+	for i := range values {
+		b.Modifiers = append(b.Modifiers, values[i])
+	}
+	return b
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	v1 "k8s.io/api/rbac/v1"
+)
+
+// FleetWorkspacePermissionApplyConfiguration represents a declarative configuration of the FleetWorkspacePermission type for use
+// with apply.
+type FleetWorkspacePermissionApplyConfiguration struct {
+	ResourceRules  []v1.PolicyRule `json:"resourceRules,omitempty"`
+	WorkspaceVerbs []string        `json:"workspaceVerbs,omitempty"`
+}
+
+// FleetWorkspacePermissionApplyConfiguration constructs a declarative configuration of the FleetWorkspacePermission type for use with
+// apply.
+func FleetWorkspacePermission() *FleetWorkspacePermissionApplyConfiguration {
+	return &FleetWorkspacePermissionApplyConfiguration{}
+}
+
+// WithResourceRules adds the given value to the ResourceRules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResourceRules field.
+func (b *FleetWorkspacePermissionApplyConfiguration) WithResourceRules(values ...v1.PolicyRule) *FleetWorkspacePermissionApplyConfiguration {
+	for i := range values {
+		b.ResourceRules = append(b.ResourceRules, values[i])
+	}
+	return b
+}
+
+// WithWorkspaceVerbs adds the given value to the WorkspaceVerbs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkspaceVerbs field.
+func (b *FleetWorkspacePermissionApplyConfiguration) WithWorkspaceVerbs(values ...string) *FleetWorkspacePermissionApplyConfiguration {
+	for i := range values {
+		b.WorkspaceVerbs = append(b.WorkspaceVerbs, values[i])
+	}
+	return b
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// GlobalRoleApplyConfiguration represents a declarative configuration of the GlobalRole type for use
+// with apply.
+type GlobalRoleApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration      `json:",inline"`
+	*v1.ObjectMetaApplyConfiguration   `json:"metadata,omitempty"`
+	DisplayName                        *string                                     `json:"displayName,omitempty"`
+	Description                        *string                                     `json:"description,omitempty"`
+	Rules                              []rbacv1.PolicyRule                         `json:"rules,omitempty"`
+	NewUserDefault                     *bool                                       `json:"newUserDefault,omitempty"`
+	Builtin                            *bool                                       `json:"builtin,omitempty"`
+	InheritedClusterRoles              []string                                    `json:"inheritedClusterRoles,omitempty"`
+	NamespacedRules                    map[string][]rbacv1.PolicyRule              `json:"namespacedRules,omitempty"`
+	InheritedFleetWorkspacePermissions *FleetWorkspacePermissionApplyConfiguration `json:"inheritedFleetWorkspacePermissions,omitempty"`
+	Status                             *GlobalRoleStatusApplyConfiguration         `json:"status,omitempty"`
+}
+
+// GlobalRole constructs a declarative configuration of the GlobalRole type for use with
+// apply.
+func GlobalRole(name string) *GlobalRoleApplyConfiguration {
+	b := &GlobalRoleApplyConfiguration{}
+	b.WithName(name)
+	b.WithKind("GlobalRole")
+	b.WithAPIVersion("management.cattle.io/v3")
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithKind(value string) *GlobalRoleApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithAPIVersion(value string) *GlobalRoleApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithName(value string) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Name = &value
+	return b
+}
+
+// WithGenerateName sets the GenerateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateName field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithGenerateName(value string) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.GenerateName = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithNamespace(value string) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Namespace = &value
+	return b
+}
+
+// WithUID sets the UID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UID field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithUID(value types.UID) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.UID = &value
+	return b
+}
+
+// WithResourceVersion sets the ResourceVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceVersion field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithResourceVersion(value string) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.ResourceVersion = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithGeneration(value int64) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Generation = &value
+	return b
+}
+
+// WithCreationTimestamp sets the CreationTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreationTimestamp field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithCreationTimestamp(value metav1.Time) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.CreationTimestamp = &value
+	return b
+}
+
+// WithDeletionTimestamp sets the DeletionTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionTimestamp field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithDeletionTimestamp(value metav1.Time) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionTimestamp = &value
+	return b
+}
+
+// WithDeletionGracePeriodSeconds sets the DeletionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionGracePeriodSeconds field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithDeletionGracePeriodSeconds(value int64) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionGracePeriodSeconds = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *GlobalRoleApplyConfiguration) WithLabels(entries map[string]string) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Labels == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *GlobalRoleApplyConfiguration) WithAnnotations(entries map[string]string) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Annotations == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Annotations[k] = v
+	}
+	return b
+}
+
+// WithOwnerReferences adds the given value to the OwnerReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OwnerReferences field.
+func (b *GlobalRoleApplyConfiguration) WithOwnerReferences(values ...*v1.OwnerReferenceApplyConfiguration) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOwnerReferences")
+		}
+		b.ObjectMetaApplyConfiguration.OwnerReferences = append(b.ObjectMetaApplyConfiguration.OwnerReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinalizers adds the given value to the Finalizers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Finalizers field.
+func (b *GlobalRoleApplyConfiguration) WithFinalizers(values ...string) *GlobalRoleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		b.ObjectMetaApplyConfiguration.Finalizers = append(b.ObjectMetaApplyConfiguration.Finalizers, values[i])
+	}
+	return b
+}
+
+func (b *GlobalRoleApplyConfiguration) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &v1.ObjectMetaApplyConfiguration{}
+	}
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithDisplayName(value string) *GlobalRoleApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithDescription(value string) *GlobalRoleApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithRules adds the given value to the Rules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Rules field.
+func (b *GlobalRoleApplyConfiguration) WithRules(values ...rbacv1.PolicyRule) *GlobalRoleApplyConfiguration {
+	for i := range values {
+		b.Rules = append(b.Rules, values[i])
+	}
+	return b
+}
+
+// WithNewUserDefault sets the NewUserDefault field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NewUserDefault field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithNewUserDefault(value bool) *GlobalRoleApplyConfiguration {
+	b.NewUserDefault = &value
+	return b
+}
+
+// WithBuiltin sets the Builtin field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Builtin field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithBuiltin(value bool) *GlobalRoleApplyConfiguration {
+	b.Builtin = &value
+	return b
+}
+
+// WithInheritedClusterRoles adds the given value to the InheritedClusterRoles field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the InheritedClusterRoles field.
+func (b *GlobalRoleApplyConfiguration) WithInheritedClusterRoles(values ...string) *GlobalRoleApplyConfiguration {
+	for i := range values {
+		b.InheritedClusterRoles = append(b.InheritedClusterRoles, values[i])
+	}
+	return b
+}
+
+// WithNamespacedRules puts the entries into the NamespacedRules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NamespacedRules field,
+// overwriting an existing map entries in NamespacedRules field with the same key.
+func (b *GlobalRoleApplyConfiguration) WithNamespacedRules(entries map[string][]rbacv1.PolicyRule) *GlobalRoleApplyConfiguration {
+	if b.NamespacedRules == nil && len(entries) > 0 {
+		b.NamespacedRules = make(map[string][]rbacv1.PolicyRule, len(entries))
+	}
+	for k, v := range entries {
+		b.NamespacedRules[k] = v
+	}
+	return b
+}
+
+// WithInheritedFleetWorkspacePermissions sets the InheritedFleetWorkspacePermissions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InheritedFleetWorkspacePermissions field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithInheritedFleetWorkspacePermissions(value *FleetWorkspacePermissionApplyConfiguration) *GlobalRoleApplyConfiguration {
+	b.InheritedFleetWorkspacePermissions = value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *GlobalRoleApplyConfiguration) WithStatus(value *GlobalRoleStatusApplyConfiguration) *GlobalRoleApplyConfiguration {
+	b.Status = value
+	return b
+}
+
+// GetName retrieves the value of the Name field in the declarative configuration.
+func (b *GlobalRoleApplyConfiguration) GetName() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Name
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// GlobalRoleStatusApplyConfiguration represents a declarative configuration of the GlobalRoleStatus type for use
+// with apply.
+type GlobalRoleStatusApplyConfiguration struct {
+	ObservedGeneration *int64                           `json:"observedGeneration,omitempty"`
+	LastUpdate         *string                          `json:"lastUpdateTime,omitempty"`
+	Summary            *string                          `json:"summary,omitempty"`
+	Conditions         []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// GlobalRoleStatusApplyConfiguration constructs a declarative configuration of the GlobalRoleStatus type for use with
+// apply.
+func GlobalRoleStatus() *GlobalRoleStatusApplyConfiguration {
+	return &GlobalRoleStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *GlobalRoleStatusApplyConfiguration) WithObservedGeneration(value int64) *GlobalRoleStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithLastUpdate sets the LastUpdate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdate field is set to the value of the last call.
+func (b *GlobalRoleStatusApplyConfiguration) WithLastUpdate(value string) *GlobalRoleStatusApplyConfiguration {
+	b.LastUpdate = &value
+	return b
+}
+
+// WithSummary sets the Summary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Summary field is set to the value of the last call.
+func (b *GlobalRoleStatusApplyConfiguration) WithSummary(value string) *GlobalRoleStatusApplyConfiguration {
+	b.Summary = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *GlobalRoleStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *GlobalRoleStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
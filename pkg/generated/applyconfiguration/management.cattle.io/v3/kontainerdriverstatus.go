@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// KontainerDriverStatusApplyConfiguration represents a declarative configuration of the KontainerDriverStatus type for use
+// with apply.
+type KontainerDriverStatusApplyConfiguration struct {
+	ActualURL      *string                       `json:"actualUrl,omitempty"`
+	ExecutablePath *string                       `json:"executablePath,omitempty"`
+	Conditions     []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	DisplayName    *string                       `json:"displayName,omitempty"`
+}
+
+// KontainerDriverStatusApplyConfiguration constructs a declarative configuration of the KontainerDriverStatus type for use with
+// apply.
+func KontainerDriverStatus() *KontainerDriverStatusApplyConfiguration {
+	return &KontainerDriverStatusApplyConfiguration{}
+}
+
+// WithActualURL sets the ActualURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActualURL field is set to the value of the last call.
+func (b *KontainerDriverStatusApplyConfiguration) WithActualURL(value string) *KontainerDriverStatusApplyConfiguration {
+	b.ActualURL = &value
+	return b
+}
+
+// WithExecutablePath sets the ExecutablePath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExecutablePath field is set to the value of the last call.
+func (b *KontainerDriverStatusApplyConfiguration) WithExecutablePath(value string) *KontainerDriverStatusApplyConfiguration {
+	b.ExecutablePath = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KontainerDriverStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *KontainerDriverStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *KontainerDriverStatusApplyConfiguration) WithDisplayName(value string) *KontainerDriverStatusApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// LoadBalancerCapabilitiesApplyConfiguration represents a declarative configuration of the LoadBalancerCapabilities type for use
+// with apply.
+type LoadBalancerCapabilitiesApplyConfiguration struct {
+	Enabled              *bool    `json:"enabled,omitempty"`
+	Provider             *string  `json:"provider,omitempty"`
+	ProtocolsSupported   []string `json:"protocolsSupported,omitempty"`
+	HealthCheckSupported *bool    `json:"healthCheckSupported,omitempty"`
+}
+
+// LoadBalancerCapabilitiesApplyConfiguration constructs a declarative configuration of the LoadBalancerCapabilities type for use with
+// apply.
+func LoadBalancerCapabilities() *LoadBalancerCapabilitiesApplyConfiguration {
+	return &LoadBalancerCapabilitiesApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *LoadBalancerCapabilitiesApplyConfiguration) WithEnabled(value bool) *LoadBalancerCapabilitiesApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithProvider sets the Provider field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provider field is set to the value of the last call.
+func (b *LoadBalancerCapabilitiesApplyConfiguration) WithProvider(value string) *LoadBalancerCapabilitiesApplyConfiguration {
+	b.Provider = &value
+	return b
+}
+
+// WithProtocolsSupported adds the given value to the ProtocolsSupported field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ProtocolsSupported field.
+func (b *LoadBalancerCapabilitiesApplyConfiguration) WithProtocolsSupported(values ...string) *LoadBalancerCapabilitiesApplyConfiguration {
+	for i := range values {
+		b.ProtocolsSupported = append(b.ProtocolsSupported, values[i])
+	}
+	return b
+}
+
+// WithHealthCheckSupported sets the HealthCheckSupported field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthCheckSupported field is set to the value of the last call.
+func (b *LoadBalancerCapabilitiesApplyConfiguration) WithHealthCheckSupported(value bool) *LoadBalancerCapabilitiesApplyConfiguration {
+	b.HealthCheckSupported = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// LocalClusterAuthEndpointApplyConfiguration represents a declarative configuration of the LocalClusterAuthEndpoint type for use
+// with apply.
+type LocalClusterAuthEndpointApplyConfiguration struct {
+	Enabled *bool   `json:"enabled,omitempty"`
+	FQDN    *string `json:"fqdn,omitempty"`
+	CACerts *string `json:"caCerts,omitempty"`
+}
+
+// LocalClusterAuthEndpointApplyConfiguration constructs a declarative configuration of the LocalClusterAuthEndpoint type for use with
+// apply.
+func LocalClusterAuthEndpoint() *LocalClusterAuthEndpointApplyConfiguration {
+	return &LocalClusterAuthEndpointApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *LocalClusterAuthEndpointApplyConfiguration) WithEnabled(value bool) *LocalClusterAuthEndpointApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithFQDN sets the FQDN field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FQDN field is set to the value of the last call.
+func (b *LocalClusterAuthEndpointApplyConfiguration) WithFQDN(value string) *LocalClusterAuthEndpointApplyConfiguration {
+	b.FQDN = &value
+	return b
+}
+
+// WithCACerts sets the CACerts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CACerts field is set to the value of the last call.
+func (b *LocalClusterAuthEndpointApplyConfiguration) WithCACerts(value string) *LocalClusterAuthEndpointApplyConfiguration {
+	b.CACerts = &value
+	return b
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// ManagedSettingClusterStatusApplyConfiguration represents a declarative configuration of the ManagedSettingClusterStatus type for use
+// with apply.
+type ManagedSettingClusterStatusApplyConfiguration struct {
+	ClusterName        *string `json:"clusterName,omitempty"`
+	ObservedGeneration *int64  `json:"observedGeneration,omitempty"`
+	Applied            *bool   `json:"applied,omitempty"`
+	Error              *string `json:"error,omitempty"`
+}
+
+// ManagedSettingClusterStatusApplyConfiguration constructs a declarative configuration of the ManagedSettingClusterStatus type for use with
+// apply.
+func ManagedSettingClusterStatus() *ManagedSettingClusterStatusApplyConfiguration {
+	return &ManagedSettingClusterStatusApplyConfiguration{}
+}
+
+// WithClusterName sets the ClusterName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterName field is set to the value of the last call.
+func (b *ManagedSettingClusterStatusApplyConfiguration) WithClusterName(value string) *ManagedSettingClusterStatusApplyConfiguration {
+	b.ClusterName = &value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *ManagedSettingClusterStatusApplyConfiguration) WithObservedGeneration(value int64) *ManagedSettingClusterStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithApplied sets the Applied field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Applied field is set to the value of the last call.
+func (b *ManagedSettingClusterStatusApplyConfiguration) WithApplied(value bool) *ManagedSettingClusterStatusApplyConfiguration {
+	b.Applied = &value
+	return b
+}
+
+// WithError sets the Error field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Error field is set to the value of the last call.
+func (b *ManagedSettingClusterStatusApplyConfiguration) WithError(value string) *ManagedSettingClusterStatusApplyConfiguration {
+	b.Error = &value
+	return b
+}
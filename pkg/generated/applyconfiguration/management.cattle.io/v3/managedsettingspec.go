@@ -0,0 +1,58 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// ManagedSettingSpecApplyConfiguration represents a declarative configuration of the ManagedSettingSpec type for use
+// with apply.
+type ManagedSettingSpecApplyConfiguration struct {
+	ClusterSelector *v1.LabelSelectorApplyConfiguration `json:"clusterSelector,omitempty"`
+	Settings        map[string]string                   `json:"settings,omitempty"`
+}
+
+// ManagedSettingSpecApplyConfiguration constructs a declarative configuration of the ManagedSettingSpec type for use with
+// apply.
+func ManagedSettingSpec() *ManagedSettingSpecApplyConfiguration {
+	return &ManagedSettingSpecApplyConfiguration{}
+}
+
+// WithClusterSelector sets the ClusterSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterSelector field is set to the value of the last call.
+func (b *ManagedSettingSpecApplyConfiguration) WithClusterSelector(value *v1.LabelSelectorApplyConfiguration) *ManagedSettingSpecApplyConfiguration {
+	b.ClusterSelector = value
+	return b
+}
+
+// WithSettings puts the entries into the Settings field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Settings field,
+// overwriting an existing map entries in Settings field with the same key.
+func (b *ManagedSettingSpecApplyConfiguration) WithSettings(entries map[string]string) *ManagedSettingSpecApplyConfiguration {
+	if b.Settings == nil && len(entries) > 0 {
+		b.Settings = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Settings[k] = v
+	}
+	return b
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// MapDeltaApplyConfiguration represents a declarative configuration of the MapDelta type for use
+// with apply.
+type MapDeltaApplyConfiguration struct {
+	Add    map[string]string `json:"add,omitempty"`
+	Delete map[string]bool   `json:"delete,omitempty"`
+}
+
+// MapDeltaApplyConfiguration constructs a declarative configuration of the MapDelta type for use with
+// apply.
+func MapDelta() *MapDeltaApplyConfiguration {
+	return &MapDeltaApplyConfiguration{}
+}
+
+// WithAdd puts the entries into the Add field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Add field,
+// overwriting an existing map entries in Add field with the same key.
+func (b *MapDeltaApplyConfiguration) WithAdd(entries map[string]string) *MapDeltaApplyConfiguration {
+	if b.Add == nil && len(entries) > 0 {
+		b.Add = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Add[k] = v
+	}
+	return b
+}
+
+// WithDelete puts the entries into the Delete field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Delete field,
+// overwriting an existing map entries in Delete field with the same key.
+func (b *MapDeltaApplyConfiguration) WithDelete(entries map[string]bool) *MapDeltaApplyConfiguration {
+	if b.Delete == nil && len(entries) > 0 {
+		b.Delete = make(map[string]bool, len(entries))
+	}
+	for k, v := range entries {
+		b.Delete[k] = v
+	}
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// NodeDriverStatusApplyConfiguration represents a declarative configuration of the NodeDriverStatus type for use
+// with apply.
+type NodeDriverStatusApplyConfiguration struct {
+	Conditions                  []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	AppliedURL                  *string                       `json:"appliedURL,omitempty"`
+	AppliedChecksum             *string                       `json:"appliedChecksum,omitempty"`
+	AppliedDockerMachineVersion *string                       `json:"appliedDockerMachineVersion,omitempty"`
+}
+
+// NodeDriverStatusApplyConfiguration constructs a declarative configuration of the NodeDriverStatus type for use with
+// apply.
+func NodeDriverStatus() *NodeDriverStatusApplyConfiguration {
+	return &NodeDriverStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *NodeDriverStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *NodeDriverStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithAppliedURL sets the AppliedURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppliedURL field is set to the value of the last call.
+func (b *NodeDriverStatusApplyConfiguration) WithAppliedURL(value string) *NodeDriverStatusApplyConfiguration {
+	b.AppliedURL = &value
+	return b
+}
+
+// WithAppliedChecksum sets the AppliedChecksum field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppliedChecksum field is set to the value of the last call.
+func (b *NodeDriverStatusApplyConfiguration) WithAppliedChecksum(value string) *NodeDriverStatusApplyConfiguration {
+	b.AppliedChecksum = &value
+	return b
+}
+
+// WithAppliedDockerMachineVersion sets the AppliedDockerMachineVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppliedDockerMachineVersion field is set to the value of the last call.
+func (b *NodeDriverStatusApplyConfiguration) WithAppliedDockerMachineVersion(value string) *NodeDriverStatusApplyConfiguration {
+	b.AppliedDockerMachineVersion = &value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	types "github.com/rancher/rke/types"
+)
+
+// NodePlanApplyConfiguration represents a declarative configuration of the NodePlan type for use
+// with apply.
+type NodePlanApplyConfiguration struct {
+	Plan               *types.RKEConfigNodePlan `json:"plan,omitempty"`
+	Version            *int                     `json:"version,omitempty"`
+	AgentCheckInterval *int                     `json:"agentCheckInterval,omitempty"`
+}
+
+// NodePlanApplyConfiguration constructs a declarative configuration of the NodePlan type for use with
+// apply.
+func NodePlan() *NodePlanApplyConfiguration {
+	return &NodePlanApplyConfiguration{}
+}
+
+// WithPlan sets the Plan field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Plan field is set to the value of the last call.
+func (b *NodePlanApplyConfiguration) WithPlan(value types.RKEConfigNodePlan) *NodePlanApplyConfiguration {
+	b.Plan = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *NodePlanApplyConfiguration) WithVersion(value int) *NodePlanApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithAgentCheckInterval sets the AgentCheckInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AgentCheckInterval field is set to the value of the last call.
+func (b *NodePlanApplyConfiguration) WithAgentCheckInterval(value int) *NodePlanApplyConfiguration {
+	b.AgentCheckInterval = &value
+	return b
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	types "github.com/rancher/rke/types"
+	v1 "k8s.io/api/core/v1"
+)
+
+// NodeSpecApplyConfiguration represents a declarative configuration of the NodeSpec type for use
+// with apply.
+type NodeSpecApplyConfiguration struct {
+	Etcd                     *bool                             `json:"etcd,omitempty"`
+	ControlPlane             *bool                             `json:"controlPlane,omitempty"`
+	Worker                   *bool                             `json:"worker,omitempty"`
+	NodeTemplateName         *string                           `json:"nodeTemplateName,omitempty"`
+	NodePoolName             *string                           `json:"nodePoolName,omitempty"`
+	CustomConfig             *CustomConfigApplyConfiguration   `json:"customConfig,omitempty"`
+	Imported                 *bool                             `json:"imported,omitempty"`
+	Description              *string                           `json:"description,omitempty"`
+	DisplayName              *string                           `json:"displayName,omitempty"`
+	RequestedHostname        *string                           `json:"requestedHostname,omitempty"`
+	InternalNodeSpec         *v1.NodeSpec                      `json:"internalNodeSpec,omitempty"`
+	DesiredNodeTaints        []v1.Taint                        `json:"desiredNodeTaints,omitempty"`
+	UpdateTaintsFromAPI      *bool                             `json:"updateTaintsFromAPI,omitempty"`
+	DesiredNodeUnschedulable *string                           `json:"desiredNodeUnschedulable,omitempty"`
+	NodeDrainInput           *types.NodeDrainInput             `json:"nodeDrainInput,omitempty"`
+	MetadataUpdate           *MetadataUpdateApplyConfiguration `json:"metadataUpdate,omitempty"`
+	ScaledownTime            *string                           `json:"scaledownTime,omitempty"`
+}
+
+// NodeSpecApplyConfiguration constructs a declarative configuration of the NodeSpec type for use with
+// apply.
+func NodeSpec() *NodeSpecApplyConfiguration {
+	return &NodeSpecApplyConfiguration{}
+}
+
+// WithEtcd sets the Etcd field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Etcd field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithEtcd(value bool) *NodeSpecApplyConfiguration {
+	b.Etcd = &value
+	return b
+}
+
+// WithControlPlane sets the ControlPlane field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControlPlane field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithControlPlane(value bool) *NodeSpecApplyConfiguration {
+	b.ControlPlane = &value
+	return b
+}
+
+// WithWorker sets the Worker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Worker field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithWorker(value bool) *NodeSpecApplyConfiguration {
+	b.Worker = &value
+	return b
+}
+
+// WithNodeTemplateName sets the NodeTemplateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeTemplateName field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithNodeTemplateName(value string) *NodeSpecApplyConfiguration {
+	b.NodeTemplateName = &value
+	return b
+}
+
+// WithNodePoolName sets the NodePoolName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodePoolName field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithNodePoolName(value string) *NodeSpecApplyConfiguration {
+	b.NodePoolName = &value
+	return b
+}
+
+// WithCustomConfig sets the CustomConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CustomConfig field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithCustomConfig(value *CustomConfigApplyConfiguration) *NodeSpecApplyConfiguration {
+	b.CustomConfig = value
+	return b
+}
+
+// WithImported sets the Imported field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Imported field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithImported(value bool) *NodeSpecApplyConfiguration {
+	b.Imported = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithDescription(value string) *NodeSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithDisplayName(value string) *NodeSpecApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithRequestedHostname sets the RequestedHostname field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestedHostname field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithRequestedHostname(value string) *NodeSpecApplyConfiguration {
+	b.RequestedHostname = &value
+	return b
+}
+
+// WithInternalNodeSpec sets the InternalNodeSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InternalNodeSpec field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithInternalNodeSpec(value v1.NodeSpec) *NodeSpecApplyConfiguration {
+	b.InternalNodeSpec = &value
+	return b
+}
+
+// WithDesiredNodeTaints adds the given value to the DesiredNodeTaints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DesiredNodeTaints field.
+func (b *NodeSpecApplyConfiguration) WithDesiredNodeTaints(values ...v1.Taint) *NodeSpecApplyConfiguration {
+	for i := range values {
+		b.DesiredNodeTaints = append(b.DesiredNodeTaints, values[i])
+	}
+	return b
+}
+
+// WithUpdateTaintsFromAPI sets the UpdateTaintsFromAPI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateTaintsFromAPI field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithUpdateTaintsFromAPI(value bool) *NodeSpecApplyConfiguration {
+	b.UpdateTaintsFromAPI = &value
+	return b
+}
+
+// WithDesiredNodeUnschedulable sets the DesiredNodeUnschedulable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DesiredNodeUnschedulable field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithDesiredNodeUnschedulable(value string) *NodeSpecApplyConfiguration {
+	b.DesiredNodeUnschedulable = &value
+	return b
+}
+
+// WithNodeDrainInput sets the NodeDrainInput field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeDrainInput field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithNodeDrainInput(value types.NodeDrainInput) *NodeSpecApplyConfiguration {
+	b.NodeDrainInput = &value
+	return b
+}
+
+// WithMetadataUpdate sets the MetadataUpdate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetadataUpdate field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithMetadataUpdate(value *MetadataUpdateApplyConfiguration) *NodeSpecApplyConfiguration {
+	b.MetadataUpdate = value
+	return b
+}
+
+// WithScaledownTime sets the ScaledownTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaledownTime field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithScaledownTime(value string) *NodeSpecApplyConfiguration {
+	b.ScaledownTime = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// OIDCClientSecretStatusApplyConfiguration represents a declarative configuration of the OIDCClientSecretStatus type for use
+// with apply.
+type OIDCClientSecretStatusApplyConfiguration struct {
+	LastUsedAt         *string `json:"lastUsedAt,omitempty"`
+	CreatedAt          *string `json:"createdAt,omitempty"`
+	LastFiveCharacters *string `json:"lastFiveCharacters,omitempty"`
+}
+
+// OIDCClientSecretStatusApplyConfiguration constructs a declarative configuration of the OIDCClientSecretStatus type for use with
+// apply.
+func OIDCClientSecretStatus() *OIDCClientSecretStatusApplyConfiguration {
+	return &OIDCClientSecretStatusApplyConfiguration{}
+}
+
+// WithLastUsedAt sets the LastUsedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUsedAt field is set to the value of the last call.
+func (b *OIDCClientSecretStatusApplyConfiguration) WithLastUsedAt(value string) *OIDCClientSecretStatusApplyConfiguration {
+	b.LastUsedAt = &value
+	return b
+}
+
+// WithCreatedAt sets the CreatedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreatedAt field is set to the value of the last call.
+func (b *OIDCClientSecretStatusApplyConfiguration) WithCreatedAt(value string) *OIDCClientSecretStatusApplyConfiguration {
+	b.CreatedAt = &value
+	return b
+}
+
+// WithLastFiveCharacters sets the LastFiveCharacters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFiveCharacters field is set to the value of the last call.
+func (b *OIDCClientSecretStatusApplyConfiguration) WithLastFiveCharacters(value string) *OIDCClientSecretStatusApplyConfiguration {
+	b.LastFiveCharacters = &value
+	return b
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// OIDCClientSpecApplyConfiguration represents a declarative configuration of the OIDCClientSpec type for use
+// with apply.
+type OIDCClientSpecApplyConfiguration struct {
+	Description                   *string  `json:"description,omitempty"`
+	RedirectURIs                  []string `json:"redirectURIs,omitempty"`
+	TokenExpirationSeconds        *int64   `json:"tokenExpirationSeconds,omitempty"`
+	RefreshTokenExpirationSeconds *int64   `json:"refreshTokenExpirationSeconds,omitempty"`
+}
+
+// OIDCClientSpecApplyConfiguration constructs a declarative configuration of the OIDCClientSpec type for use with
+// apply.
+func OIDCClientSpec() *OIDCClientSpecApplyConfiguration {
+	return &OIDCClientSpecApplyConfiguration{}
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *OIDCClientSpecApplyConfiguration) WithDescription(value string) *OIDCClientSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithRedirectURIs adds the given value to the RedirectURIs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RedirectURIs field.
+func (b *OIDCClientSpecApplyConfiguration) WithRedirectURIs(values ...string) *OIDCClientSpecApplyConfiguration {
+	for i := range values {
+		b.RedirectURIs = append(b.RedirectURIs, values[i])
+	}
+	return b
+}
+
+// WithTokenExpirationSeconds sets the TokenExpirationSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenExpirationSeconds field is set to the value of the last call.
+func (b *OIDCClientSpecApplyConfiguration) WithTokenExpirationSeconds(value int64) *OIDCClientSpecApplyConfiguration {
+	b.TokenExpirationSeconds = &value
+	return b
+}
+
+// WithRefreshTokenExpirationSeconds sets the RefreshTokenExpirationSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RefreshTokenExpirationSeconds field is set to the value of the last call.
+func (b *OIDCClientSpecApplyConfiguration) WithRefreshTokenExpirationSeconds(value int64) *OIDCClientSpecApplyConfiguration {
+	b.RefreshTokenExpirationSeconds = &value
+	return b
+}
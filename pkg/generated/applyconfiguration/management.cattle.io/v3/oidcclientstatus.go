@@ -0,0 +1,54 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// OIDCClientStatusApplyConfiguration represents a declarative configuration of the OIDCClientStatus type for use
+// with apply.
+type OIDCClientStatusApplyConfiguration struct {
+	ClientID      *string                                             `json:"clientID,omitempty"`
+	ClientSecrets map[string]OIDCClientSecretStatusApplyConfiguration `json:"clientSecrets,omitempty"`
+}
+
+// OIDCClientStatusApplyConfiguration constructs a declarative configuration of the OIDCClientStatus type for use with
+// apply.
+func OIDCClientStatus() *OIDCClientStatusApplyConfiguration {
+	return &OIDCClientStatusApplyConfiguration{}
+}
+
+// WithClientID sets the ClientID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientID field is set to the value of the last call.
+func (b *OIDCClientStatusApplyConfiguration) WithClientID(value string) *OIDCClientStatusApplyConfiguration {
+	b.ClientID = &value
+	return b
+}
+
+// WithClientSecrets puts the entries into the ClientSecrets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ClientSecrets field,
+// overwriting an existing map entries in ClientSecrets field with the same key.
+func (b *OIDCClientStatusApplyConfiguration) WithClientSecrets(entries map[string]OIDCClientSecretStatusApplyConfiguration) *OIDCClientStatusApplyConfiguration {
+	if b.ClientSecrets == nil && len(entries) > 0 {
+		b.ClientSecrets = make(map[string]OIDCClientSecretStatusApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.ClientSecrets[k] = v
+	}
+	return b
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration represents a declarative configuration of the PodSecurityAdmissionConfigurationTemplateExemptions type for use
+// with apply.
+type PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration struct {
+	Usernames      []string `json:"usernames,omitempty"`
+	RuntimeClasses []string `json:"runtimeClasses,omitempty"`
+	Namespaces     []string `json:"namespaces,omitempty"`
+}
+
+// PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration constructs a declarative configuration of the PodSecurityAdmissionConfigurationTemplateExemptions type for use with
+// apply.
+func PodSecurityAdmissionConfigurationTemplateExemptions() *PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration {
+	return &PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration{}
+}
+
+// WithUsernames adds the given value to the Usernames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Usernames field.
+func (b *PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration) WithUsernames(values ...string) *PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration {
+	for i := range values {
+		b.Usernames = append(b.Usernames, values[i])
+	}
+	return b
+}
+
+// WithRuntimeClasses adds the given value to the RuntimeClasses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RuntimeClasses field.
+func (b *PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration) WithRuntimeClasses(values ...string) *PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration {
+	for i := range values {
+		b.RuntimeClasses = append(b.RuntimeClasses, values[i])
+	}
+	return b
+}
+
+// WithNamespaces adds the given value to the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Namespaces field.
+func (b *PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration) WithNamespaces(values ...string) *PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration {
+	for i := range values {
+		b.Namespaces = append(b.Namespaces, values[i])
+	}
+	return b
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// PrincipalApplyConfiguration represents a declarative configuration of the Principal type for use
+// with apply.
+type PrincipalApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration    `json:",inline"`
+	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	DisplayName                      *string           `json:"displayName,omitempty"`
+	LoginName                        *string           `json:"loginName,omitempty"`
+	ProfilePicture                   *string           `json:"profilePicture,omitempty"`
+	ProfileURL                       *string           `json:"profileURL,omitempty"`
+	PrincipalType                    *string           `json:"principalType,omitempty"`
+	Me                               *bool             `json:"me,omitempty"`
+	MemberOf                         *bool             `json:"memberOf,omitempty"`
+	Provider                         *string           `json:"provider,omitempty"`
+	ExtraInfo                        map[string]string `json:"extraInfo,omitempty"`
+}
+
+// Principal constructs a declarative configuration of the Principal type for use with
+// apply.
+func Principal(name string) *PrincipalApplyConfiguration {
+	b := &PrincipalApplyConfiguration{}
+	b.WithName(name)
+	b.WithKind("Principal")
+	b.WithAPIVersion("management.cattle.io/v3")
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithKind(value string) *PrincipalApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithAPIVersion(value string) *PrincipalApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithName(value string) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Name = &value
+	return b
+}
+
+// WithGenerateName sets the GenerateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateName field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithGenerateName(value string) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.GenerateName = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithNamespace(value string) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Namespace = &value
+	return b
+}
+
+// WithUID sets the UID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UID field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithUID(value types.UID) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.UID = &value
+	return b
+}
+
+// WithResourceVersion sets the ResourceVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceVersion field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithResourceVersion(value string) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.ResourceVersion = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithGeneration(value int64) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Generation = &value
+	return b
+}
+
+// WithCreationTimestamp sets the CreationTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreationTimestamp field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithCreationTimestamp(value metav1.Time) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.CreationTimestamp = &value
+	return b
+}
+
+// WithDeletionTimestamp sets the DeletionTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionTimestamp field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithDeletionTimestamp(value metav1.Time) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionTimestamp = &value
+	return b
+}
+
+// WithDeletionGracePeriodSeconds sets the DeletionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionGracePeriodSeconds field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithDeletionGracePeriodSeconds(value int64) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionGracePeriodSeconds = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *PrincipalApplyConfiguration) WithLabels(entries map[string]string) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Labels == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *PrincipalApplyConfiguration) WithAnnotations(entries map[string]string) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Annotations == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Annotations[k] = v
+	}
+	return b
+}
+
+// WithOwnerReferences adds the given value to the OwnerReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OwnerReferences field.
+func (b *PrincipalApplyConfiguration) WithOwnerReferences(values ...*v1.OwnerReferenceApplyConfiguration) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOwnerReferences")
+		}
+		b.ObjectMetaApplyConfiguration.OwnerReferences = append(b.ObjectMetaApplyConfiguration.OwnerReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinalizers adds the given value to the Finalizers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Finalizers field.
+func (b *PrincipalApplyConfiguration) WithFinalizers(values ...string) *PrincipalApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		b.ObjectMetaApplyConfiguration.Finalizers = append(b.ObjectMetaApplyConfiguration.Finalizers, values[i])
+	}
+	return b
+}
+
+func (b *PrincipalApplyConfiguration) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &v1.ObjectMetaApplyConfiguration{}
+	}
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithDisplayName(value string) *PrincipalApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithLoginName sets the LoginName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LoginName field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithLoginName(value string) *PrincipalApplyConfiguration {
+	b.LoginName = &value
+	return b
+}
+
+// WithProfilePicture sets the ProfilePicture field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProfilePicture field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithProfilePicture(value string) *PrincipalApplyConfiguration {
+	b.ProfilePicture = &value
+	return b
+}
+
+// WithProfileURL sets the ProfileURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProfileURL field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithProfileURL(value string) *PrincipalApplyConfiguration {
+	b.ProfileURL = &value
+	return b
+}
+
+// WithPrincipalType sets the PrincipalType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrincipalType field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithPrincipalType(value string) *PrincipalApplyConfiguration {
+	b.PrincipalType = &value
+	return b
+}
+
+// WithMe sets the Me field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Me field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithMe(value bool) *PrincipalApplyConfiguration {
+	b.Me = &value
+	return b
+}
+
+// WithMemberOf sets the MemberOf field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemberOf field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithMemberOf(value bool) *PrincipalApplyConfiguration {
+	b.MemberOf = &value
+	return b
+}
+
+// WithProvider sets the Provider field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provider field is set to the value of the last call.
+func (b *PrincipalApplyConfiguration) WithProvider(value string) *PrincipalApplyConfiguration {
+	b.Provider = &value
+	return b
+}
+
+// WithExtraInfo puts the entries into the ExtraInfo field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ExtraInfo field,
+// overwriting an existing map entries in ExtraInfo field with the same key.
+func (b *PrincipalApplyConfiguration) WithExtraInfo(entries map[string]string) *PrincipalApplyConfiguration {
+	if b.ExtraInfo == nil && len(entries) > 0 {
+		b.ExtraInfo = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ExtraInfo[k] = v
+	}
+	return b
+}
+
+// GetName retrieves the value of the Name field in the declarative configuration.
+func (b *PrincipalApplyConfiguration) GetName() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Name
+}
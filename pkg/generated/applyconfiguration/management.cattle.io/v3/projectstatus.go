@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// ProjectStatusApplyConfiguration represents a declarative configuration of the ProjectStatus type for use
+// with apply.
+type ProjectStatusApplyConfiguration struct {
+	Conditions       []ProjectConditionApplyConfiguration    `json:"conditions,omitempty"`
+	BackingNamespace *string                                 `json:"backingNamespace,omitempty"`
+	UsedResources    *ResourceQuotaLimitApplyConfiguration   `json:"usedResources,omitempty"`
+	MemberSummary    *ProjectMemberSummaryApplyConfiguration `json:"memberSummary,omitempty"`
+}
+
+// ProjectStatusApplyConfiguration constructs a declarative configuration of the ProjectStatus type for use with
+// apply.
+func ProjectStatus() *ProjectStatusApplyConfiguration {
+	return &ProjectStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *ProjectStatusApplyConfiguration) WithConditions(values ...*ProjectConditionApplyConfiguration) *ProjectStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithBackingNamespace sets the BackingNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackingNamespace field is set to the value of the last call.
+func (b *ProjectStatusApplyConfiguration) WithBackingNamespace(value string) *ProjectStatusApplyConfiguration {
+	b.BackingNamespace = &value
+	return b
+}
+
+// WithUsedResources sets the UsedResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsedResources field is set to the value of the last call.
+func (b *ProjectStatusApplyConfiguration) WithUsedResources(value *ResourceQuotaLimitApplyConfiguration) *ProjectStatusApplyConfiguration {
+	b.UsedResources = value
+	return b
+}
+
+// WithMemberSummary sets the MemberSummary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemberSummary field is set to the value of the last call.
+func (b *ProjectStatusApplyConfiguration) WithMemberSummary(value *ProjectMemberSummaryApplyConfiguration) *ProjectStatusApplyConfiguration {
+	b.MemberSummary = value
+	return b
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v3
+
+// ValuesApplyConfiguration represents a declarative configuration of the Values type for use
+// with apply.
+type ValuesApplyConfiguration struct {
+	StringValue      *string  `json:"stringValue,omitempty"`
+	IntValue         *int     `json:"intValue,omitempty"`
+	BoolValue        *bool    `json:"boolValue,omitempty"`
+	StringSliceValue []string `json:"stringSliceValue,omitempty"`
+}
+
+// ValuesApplyConfiguration constructs a declarative configuration of the Values type for use with
+// apply.
+func Values() *ValuesApplyConfiguration {
+	return &ValuesApplyConfiguration{}
+}
+
+// WithStringValue sets the StringValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StringValue field is set to the value of the last call.
+func (b *ValuesApplyConfiguration) WithStringValue(value string) *ValuesApplyConfiguration {
+	b.StringValue = &value
+	return b
+}
+
+// WithIntValue sets the IntValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IntValue field is set to the value of the last call.
+func (b *ValuesApplyConfiguration) WithIntValue(value int) *ValuesApplyConfiguration {
+	b.IntValue = &value
+	return b
+}
+
+// WithBoolValue sets the BoolValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BoolValue field is set to the value of the last call.
+func (b *ValuesApplyConfiguration) WithBoolValue(value bool) *ValuesApplyConfiguration {
+	b.BoolValue = &value
+	return b
+}
+
+// WithStringSliceValue adds the given value to the StringSliceValue field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the StringSliceValue field.
+func (b *ValuesApplyConfiguration) WithStringSliceValue(values ...string) *ValuesApplyConfiguration {
+	for i := range values {
+		b.StringSliceValue = append(b.StringSliceValue, values[i])
+	}
+	return b
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// AgentSchedulingCustomizationApplyConfiguration represents a declarative configuration of the AgentSchedulingCustomization type for use
+// with apply.
+type AgentSchedulingCustomizationApplyConfiguration struct {
+	PriorityClass       *PriorityClassSpecApplyConfiguration       `json:"priorityClass,omitempty"`
+	PodDisruptionBudget *PodDisruptionBudgetSpecApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+}
+
+// AgentSchedulingCustomizationApplyConfiguration constructs a declarative configuration of the AgentSchedulingCustomization type for use with
+// apply.
+func AgentSchedulingCustomization() *AgentSchedulingCustomizationApplyConfiguration {
+	return &AgentSchedulingCustomizationApplyConfiguration{}
+}
+
+// WithPriorityClass sets the PriorityClass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClass field is set to the value of the last call.
+func (b *AgentSchedulingCustomizationApplyConfiguration) WithPriorityClass(value *PriorityClassSpecApplyConfiguration) *AgentSchedulingCustomizationApplyConfiguration {
+	b.PriorityClass = value
+	return b
+}
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *AgentSchedulingCustomizationApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetSpecApplyConfiguration) *AgentSchedulingCustomizationApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
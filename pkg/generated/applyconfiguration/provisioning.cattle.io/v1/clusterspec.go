@@ -0,0 +1,180 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	rkecattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/rke.cattle.io/v1"
+)
+
+// ClusterSpecApplyConfiguration represents a declarative configuration of the ClusterSpec type for use
+// with apply.
+type ClusterSpecApplyConfiguration struct {
+	CloudCredentialSecretName                            *string                                                   `json:"cloudCredentialSecretName,omitempty"`
+	KubernetesVersion                                    *string                                                   `json:"kubernetesVersion,omitempty"`
+	ClusterAPIConfig                                     *ClusterAPIConfigApplyConfiguration                       `json:"clusterAPIConfig,omitempty"`
+	RKEConfig                                            *RKEConfigApplyConfiguration                              `json:"rkeConfig,omitempty"`
+	LocalClusterAuthEndpoint                             *rkecattleiov1.LocalClusterAuthEndpointApplyConfiguration `json:"localClusterAuthEndpoint,omitempty"`
+	AgentEnvVars                                         []rkecattleiov1.EnvVarApplyConfiguration                  `json:"agentEnvVars,omitempty"`
+	AgentImageRegistry                                   *string                                                   `json:"agentImageRegistry,omitempty"`
+	ClusterAgentDeploymentCustomization                  *AgentDeploymentCustomizationApplyConfiguration           `json:"clusterAgentDeploymentCustomization,omitempty"`
+	DefaultPodSecurityAdmissionConfigurationTemplateName *string                                                   `json:"defaultPodSecurityAdmissionConfigurationTemplateName,omitempty"`
+	DefaultClusterRoleForProjectMembers                  *string                                                   `json:"defaultClusterRoleForProjectMembers,omitempty"`
+	EnableNetworkPolicy                                  *bool                                                     `json:"enableNetworkPolicy,omitempty"`
+	FleetAgentDeploymentCustomization                    *AgentDeploymentCustomizationApplyConfiguration           `json:"fleetAgentDeploymentCustomization,omitempty"`
+	RedeploySystemAgentGeneration                        *int64                                                    `json:"redeploySystemAgentGeneration,omitempty"`
+	SystemChartVersions                                  map[string]string                                         `json:"systemChartVersions,omitempty"`
+	ClusterTemplateName                                  *string                                                   `json:"clusterTemplateName,omitempty"`
+}
+
+// ClusterSpecApplyConfiguration constructs a declarative configuration of the ClusterSpec type for use with
+// apply.
+func ClusterSpec() *ClusterSpecApplyConfiguration {
+	return &ClusterSpecApplyConfiguration{}
+}
+
+// WithCloudCredentialSecretName sets the CloudCredentialSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CloudCredentialSecretName field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithCloudCredentialSecretName(value string) *ClusterSpecApplyConfiguration {
+	b.CloudCredentialSecretName = &value
+	return b
+}
+
+// WithKubernetesVersion sets the KubernetesVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KubernetesVersion field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithKubernetesVersion(value string) *ClusterSpecApplyConfiguration {
+	b.KubernetesVersion = &value
+	return b
+}
+
+// WithClusterAPIConfig sets the ClusterAPIConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterAPIConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithClusterAPIConfig(value *ClusterAPIConfigApplyConfiguration) *ClusterSpecApplyConfiguration {
+	b.ClusterAPIConfig = value
+	return b
+}
+
+// WithRKEConfig sets the RKEConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RKEConfig field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithRKEConfig(value *RKEConfigApplyConfiguration) *ClusterSpecApplyConfiguration {
+	b.RKEConfig = value
+	return b
+}
+
+// WithLocalClusterAuthEndpoint sets the LocalClusterAuthEndpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LocalClusterAuthEndpoint field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithLocalClusterAuthEndpoint(value *rkecattleiov1.LocalClusterAuthEndpointApplyConfiguration) *ClusterSpecApplyConfiguration {
+	b.LocalClusterAuthEndpoint = value
+	return b
+}
+
+// WithAgentEnvVars adds the given value to the AgentEnvVars field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AgentEnvVars field.
+func (b *ClusterSpecApplyConfiguration) WithAgentEnvVars(values ...*rkecattleiov1.EnvVarApplyConfiguration) *ClusterSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAgentEnvVars")
+		}
+		b.AgentEnvVars = append(b.AgentEnvVars, *values[i])
+	}
+	return b
+}
+
+// WithAgentImageRegistry sets the AgentImageRegistry field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AgentImageRegistry field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithAgentImageRegistry(value string) *ClusterSpecApplyConfiguration {
+	b.AgentImageRegistry = &value
+	return b
+}
+
+// WithClusterAgentDeploymentCustomization sets the ClusterAgentDeploymentCustomization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterAgentDeploymentCustomization field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithClusterAgentDeploymentCustomization(value *AgentDeploymentCustomizationApplyConfiguration) *ClusterSpecApplyConfiguration {
+	b.ClusterAgentDeploymentCustomization = value
+	return b
+}
+
+// WithDefaultPodSecurityAdmissionConfigurationTemplateName sets the DefaultPodSecurityAdmissionConfigurationTemplateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultPodSecurityAdmissionConfigurationTemplateName field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithDefaultPodSecurityAdmissionConfigurationTemplateName(value string) *ClusterSpecApplyConfiguration {
+	b.DefaultPodSecurityAdmissionConfigurationTemplateName = &value
+	return b
+}
+
+// WithDefaultClusterRoleForProjectMembers sets the DefaultClusterRoleForProjectMembers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultClusterRoleForProjectMembers field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithDefaultClusterRoleForProjectMembers(value string) *ClusterSpecApplyConfiguration {
+	b.DefaultClusterRoleForProjectMembers = &value
+	return b
+}
+
+// WithEnableNetworkPolicy sets the EnableNetworkPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnableNetworkPolicy field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithEnableNetworkPolicy(value bool) *ClusterSpecApplyConfiguration {
+	b.EnableNetworkPolicy = &value
+	return b
+}
+
+// WithFleetAgentDeploymentCustomization sets the FleetAgentDeploymentCustomization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FleetAgentDeploymentCustomization field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithFleetAgentDeploymentCustomization(value *AgentDeploymentCustomizationApplyConfiguration) *ClusterSpecApplyConfiguration {
+	b.FleetAgentDeploymentCustomization = value
+	return b
+}
+
+// WithRedeploySystemAgentGeneration sets the RedeploySystemAgentGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RedeploySystemAgentGeneration field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithRedeploySystemAgentGeneration(value int64) *ClusterSpecApplyConfiguration {
+	b.RedeploySystemAgentGeneration = &value
+	return b
+}
+
+// WithSystemChartVersions puts the entries into the SystemChartVersions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the SystemChartVersions field,
+// overwriting an existing map entries in SystemChartVersions field with the same key.
+func (b *ClusterSpecApplyConfiguration) WithSystemChartVersions(entries map[string]string) *ClusterSpecApplyConfiguration {
+	if b.SystemChartVersions == nil && len(entries) > 0 {
+		b.SystemChartVersions = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.SystemChartVersions[k] = v
+	}
+	return b
+}
+
+// WithClusterTemplateName sets the ClusterTemplateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterTemplateName field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithClusterTemplateName(value string) *ClusterSpecApplyConfiguration {
+	b.ClusterTemplateName = &value
+	return b
+}
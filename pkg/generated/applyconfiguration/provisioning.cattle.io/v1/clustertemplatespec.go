@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ClusterTemplateSpecApplyConfiguration represents a declarative configuration of the ClusterTemplateSpec type for use
+// with apply.
+type ClusterTemplateSpecApplyConfiguration struct {
+	DisplayName      *string                        `json:"displayName,omitempty"`
+	Description      *string                        `json:"description,omitempty"`
+	ClusterSpec      *ClusterSpecApplyConfiguration `json:"clusterSpec,omitempty"`
+	AllowedOverrides []string                       `json:"allowedOverrides,omitempty"`
+}
+
+// ClusterTemplateSpecApplyConfiguration constructs a declarative configuration of the ClusterTemplateSpec type for use with
+// apply.
+func ClusterTemplateSpec() *ClusterTemplateSpecApplyConfiguration {
+	return &ClusterTemplateSpecApplyConfiguration{}
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *ClusterTemplateSpecApplyConfiguration) WithDisplayName(value string) *ClusterTemplateSpecApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *ClusterTemplateSpecApplyConfiguration) WithDescription(value string) *ClusterTemplateSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithClusterSpec sets the ClusterSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterSpec field is set to the value of the last call.
+func (b *ClusterTemplateSpecApplyConfiguration) WithClusterSpec(value *ClusterSpecApplyConfiguration) *ClusterTemplateSpecApplyConfiguration {
+	b.ClusterSpec = value
+	return b
+}
+
+// WithAllowedOverrides adds the given value to the AllowedOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedOverrides field.
+func (b *ClusterTemplateSpecApplyConfiguration) WithAllowedOverrides(values ...string) *ClusterTemplateSpecApplyConfiguration {
+	for i := range values {
+		b.AllowedOverrides = append(b.AllowedOverrides, values[i])
+	}
+	return b
+}
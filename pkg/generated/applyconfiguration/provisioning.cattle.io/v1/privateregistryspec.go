@@ -0,0 +1,73 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	rkecattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/rke.cattle.io/v1"
+)
+
+// PrivateRegistrySpecApplyConfiguration represents a declarative configuration of the PrivateRegistrySpec type for use
+// with apply.
+type PrivateRegistrySpecApplyConfiguration struct {
+	DisplayName *string                                                   `json:"displayName,omitempty"`
+	Mirrors     map[string]rkecattleiov1.MirrorApplyConfiguration         `json:"mirrors,omitempty"`
+	Configs     map[string]rkecattleiov1.RegistryConfigApplyConfiguration `json:"configs,omitempty"`
+}
+
+// PrivateRegistrySpecApplyConfiguration constructs a declarative configuration of the PrivateRegistrySpec type for use with
+// apply.
+func PrivateRegistrySpec() *PrivateRegistrySpecApplyConfiguration {
+	return &PrivateRegistrySpecApplyConfiguration{}
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *PrivateRegistrySpecApplyConfiguration) WithDisplayName(value string) *PrivateRegistrySpecApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithMirrors puts the entries into the Mirrors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Mirrors field,
+// overwriting an existing map entries in Mirrors field with the same key.
+func (b *PrivateRegistrySpecApplyConfiguration) WithMirrors(entries map[string]rkecattleiov1.MirrorApplyConfiguration) *PrivateRegistrySpecApplyConfiguration {
+	if b.Mirrors == nil && len(entries) > 0 {
+		b.Mirrors = make(map[string]rkecattleiov1.MirrorApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Mirrors[k] = v
+	}
+	return b
+}
+
+// WithConfigs puts the entries into the Configs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Configs field,
+// overwriting an existing map entries in Configs field with the same key.
+func (b *PrivateRegistrySpecApplyConfiguration) WithConfigs(entries map[string]rkecattleiov1.RegistryConfigApplyConfiguration) *PrivateRegistrySpecApplyConfiguration {
+	if b.Configs == nil && len(entries) > 0 {
+		b.Configs = make(map[string]rkecattleiov1.RegistryConfigApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Configs[k] = v
+	}
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// RKEMachinePoolRollingUpdateApplyConfiguration represents a declarative configuration of the RKEMachinePoolRollingUpdate type for use
+// with apply.
+type RKEMachinePoolRollingUpdateApplyConfiguration struct {
+	MaxUnavailable     *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	MaxSurge           *intstr.IntOrString `json:"maxSurge,omitempty"`
+	DeleteBeforeCreate *bool               `json:"deleteBeforeCreate,omitempty"`
+}
+
+// RKEMachinePoolRollingUpdateApplyConfiguration constructs a declarative configuration of the RKEMachinePoolRollingUpdate type for use with
+// apply.
+func RKEMachinePoolRollingUpdate() *RKEMachinePoolRollingUpdateApplyConfiguration {
+	return &RKEMachinePoolRollingUpdateApplyConfiguration{}
+}
+
+// WithMaxUnavailable sets the MaxUnavailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnavailable field is set to the value of the last call.
+func (b *RKEMachinePoolRollingUpdateApplyConfiguration) WithMaxUnavailable(value intstr.IntOrString) *RKEMachinePoolRollingUpdateApplyConfiguration {
+	b.MaxUnavailable = &value
+	return b
+}
+
+// WithMaxSurge sets the MaxSurge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSurge field is set to the value of the last call.
+func (b *RKEMachinePoolRollingUpdateApplyConfiguration) WithMaxSurge(value intstr.IntOrString) *RKEMachinePoolRollingUpdateApplyConfiguration {
+	b.MaxSurge = &value
+	return b
+}
+
+// WithDeleteBeforeCreate sets the DeleteBeforeCreate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeleteBeforeCreate field is set to the value of the last call.
+func (b *RKEMachinePoolRollingUpdateApplyConfiguration) WithDeleteBeforeCreate(value bool) *RKEMachinePoolRollingUpdateApplyConfiguration {
+	b.DeleteBeforeCreate = &value
+	return b
+}
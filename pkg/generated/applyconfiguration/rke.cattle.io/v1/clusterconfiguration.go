@@ -0,0 +1,143 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	rkecattleiov1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+)
+
+// ClusterConfigurationApplyConfiguration represents a declarative configuration of the ClusterConfiguration type for use
+// with apply.
+type ClusterConfigurationApplyConfiguration struct {
+	UpgradeStrategy       *ClusterUpgradeStrategyApplyConfiguration `json:"upgradeStrategy,omitempty"`
+	ChartValues           *rkecattleiov1.GenericMap                 `json:"chartValues,omitempty"`
+	MachineGlobalConfig   *rkecattleiov1.GenericMap                 `json:"machineGlobalConfig,omitempty"`
+	MachineSelectorConfig []RKESystemConfigApplyConfiguration       `json:"machineSelectorConfig,omitempty"`
+	MachineSelectorFiles  []RKEProvisioningFilesApplyConfiguration  `json:"machineSelectorFiles,omitempty"`
+	AdditionalManifest    *string                                   `json:"additionalManifest,omitempty"`
+	Registries            *RegistryApplyConfiguration               `json:"registries,omitempty"`
+	ETCD                  *ETCDApplyConfiguration                   `json:"etcd,omitempty"`
+	Networking            *NetworkingApplyConfiguration             `json:"networking,omitempty"`
+	DataDirectories       *DataDirectoriesApplyConfiguration        `json:"dataDirectories,omitempty"`
+	ProvisionGeneration   *int                                      `json:"provisionGeneration,omitempty"`
+}
+
+// ClusterConfigurationApplyConfiguration constructs a declarative configuration of the ClusterConfiguration type for use with
+// apply.
+func ClusterConfiguration() *ClusterConfigurationApplyConfiguration {
+	return &ClusterConfigurationApplyConfiguration{}
+}
+
+// WithUpgradeStrategy sets the UpgradeStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradeStrategy field is set to the value of the last call.
+func (b *ClusterConfigurationApplyConfiguration) WithUpgradeStrategy(value *ClusterUpgradeStrategyApplyConfiguration) *ClusterConfigurationApplyConfiguration {
+	b.UpgradeStrategy = value
+	return b
+}
+
+// WithChartValues sets the ChartValues field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ChartValues field is set to the value of the last call.
+func (b *ClusterConfigurationApplyConfiguration) WithChartValues(value rkecattleiov1.GenericMap) *ClusterConfigurationApplyConfiguration {
+	b.ChartValues = &value
+	return b
+}
+
+// WithMachineGlobalConfig sets the MachineGlobalConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineGlobalConfig field is set to the value of the last call.
+func (b *ClusterConfigurationApplyConfiguration) WithMachineGlobalConfig(value rkecattleiov1.GenericMap) *ClusterConfigurationApplyConfiguration {
+	b.MachineGlobalConfig = &value
+	return b
+}
+
+// WithMachineSelectorConfig adds the given value to the MachineSelectorConfig field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MachineSelectorConfig field.
+func (b *ClusterConfigurationApplyConfiguration) WithMachineSelectorConfig(values ...*RKESystemConfigApplyConfiguration) *ClusterConfigurationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMachineSelectorConfig")
+		}
+		b.MachineSelectorConfig = append(b.MachineSelectorConfig, *values[i])
+	}
+	return b
+}
+
+// WithMachineSelectorFiles adds the given value to the MachineSelectorFiles field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MachineSelectorFiles field.
+func (b *ClusterConfigurationApplyConfiguration) WithMachineSelectorFiles(values ...*RKEProvisioningFilesApplyConfiguration) *ClusterConfigurationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMachineSelectorFiles")
+		}
+		b.MachineSelectorFiles = append(b.MachineSelectorFiles, *values[i])
+	}
+	return b
+}
+
+// WithAdditionalManifest sets the AdditionalManifest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdditionalManifest field is set to the value of the last call.
+func (b *ClusterConfigurationApplyConfiguration) WithAdditionalManifest(value string) *ClusterConfigurationApplyConfiguration {
+	b.AdditionalManifest = &value
+	return b
+}
+
+// WithRegistries sets the Registries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Registries field is set to the value of the last call.
+func (b *ClusterConfigurationApplyConfiguration) WithRegistries(value *RegistryApplyConfiguration) *ClusterConfigurationApplyConfiguration {
+	b.Registries = value
+	return b
+}
+
+// WithETCD sets the ETCD field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ETCD field is set to the value of the last call.
+func (b *ClusterConfigurationApplyConfiguration) WithETCD(value *ETCDApplyConfiguration) *ClusterConfigurationApplyConfiguration {
+	b.ETCD = value
+	return b
+}
+
+// WithNetworking sets the Networking field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Networking field is set to the value of the last call.
+func (b *ClusterConfigurationApplyConfiguration) WithNetworking(value *NetworkingApplyConfiguration) *ClusterConfigurationApplyConfiguration {
+	b.Networking = value
+	return b
+}
+
+// WithDataDirectories sets the DataDirectories field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DataDirectories field is set to the value of the last call.
+func (b *ClusterConfigurationApplyConfiguration) WithDataDirectories(value *DataDirectoriesApplyConfiguration) *ClusterConfigurationApplyConfiguration {
+	b.DataDirectories = value
+	return b
+}
+
+// WithProvisionGeneration sets the ProvisionGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProvisionGeneration field is set to the value of the last call.
+func (b *ClusterConfigurationApplyConfiguration) WithProvisionGeneration(value int) *ClusterConfigurationApplyConfiguration {
+	b.ProvisionGeneration = &value
+	return b
+}
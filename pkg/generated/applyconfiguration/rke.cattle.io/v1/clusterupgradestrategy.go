@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ClusterUpgradeStrategyApplyConfiguration represents a declarative configuration of the ClusterUpgradeStrategy type for use
+// with apply.
+type ClusterUpgradeStrategyApplyConfiguration struct {
+	ControlPlaneConcurrency  *string                         `json:"controlPlaneConcurrency,omitempty"`
+	ControlPlaneDrainOptions *DrainOptionsApplyConfiguration `json:"controlPlaneDrainOptions,omitempty"`
+	WorkerConcurrency        *string                         `json:"workerConcurrency,omitempty"`
+	WorkerDrainOptions       *DrainOptionsApplyConfiguration `json:"workerDrainOptions,omitempty"`
+}
+
+// ClusterUpgradeStrategyApplyConfiguration constructs a declarative configuration of the ClusterUpgradeStrategy type for use with
+// apply.
+func ClusterUpgradeStrategy() *ClusterUpgradeStrategyApplyConfiguration {
+	return &ClusterUpgradeStrategyApplyConfiguration{}
+}
+
+// WithControlPlaneConcurrency sets the ControlPlaneConcurrency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControlPlaneConcurrency field is set to the value of the last call.
+func (b *ClusterUpgradeStrategyApplyConfiguration) WithControlPlaneConcurrency(value string) *ClusterUpgradeStrategyApplyConfiguration {
+	b.ControlPlaneConcurrency = &value
+	return b
+}
+
+// WithControlPlaneDrainOptions sets the ControlPlaneDrainOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControlPlaneDrainOptions field is set to the value of the last call.
+func (b *ClusterUpgradeStrategyApplyConfiguration) WithControlPlaneDrainOptions(value *DrainOptionsApplyConfiguration) *ClusterUpgradeStrategyApplyConfiguration {
+	b.ControlPlaneDrainOptions = value
+	return b
+}
+
+// WithWorkerConcurrency sets the WorkerConcurrency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkerConcurrency field is set to the value of the last call.
+func (b *ClusterUpgradeStrategyApplyConfiguration) WithWorkerConcurrency(value string) *ClusterUpgradeStrategyApplyConfiguration {
+	b.WorkerConcurrency = &value
+	return b
+}
+
+// WithWorkerDrainOptions sets the WorkerDrainOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkerDrainOptions field is set to the value of the last call.
+func (b *ClusterUpgradeStrategyApplyConfiguration) WithWorkerDrainOptions(value *DrainOptionsApplyConfiguration) *ClusterUpgradeStrategyApplyConfiguration {
+	b.WorkerDrainOptions = value
+	return b
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	genericcondition "github.com/rancher/wrangler/v3/pkg/genericcondition"
+	v1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// CustomMachineStatusApplyConfiguration represents a declarative configuration of the CustomMachineStatus type for use
+// with apply.
+type CustomMachineStatusApplyConfiguration struct {
+	Conditions []genericcondition.GenericCondition `json:"conditions,omitempty"`
+	Ready      *bool                               `json:"ready,omitempty"`
+	Addresses  []v1beta1.MachineAddress            `json:"addresses,omitempty"`
+	OS         *string                             `json:"os,omitempty"`
+	Arch       *string                             `json:"arch,omitempty"`
+}
+
+// CustomMachineStatusApplyConfiguration constructs a declarative configuration of the CustomMachineStatus type for use with
+// apply.
+func CustomMachineStatus() *CustomMachineStatusApplyConfiguration {
+	return &CustomMachineStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *CustomMachineStatusApplyConfiguration) WithConditions(values ...genericcondition.GenericCondition) *CustomMachineStatusApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *CustomMachineStatusApplyConfiguration) WithReady(value bool) *CustomMachineStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
+// WithAddresses adds the given value to the Addresses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Addresses field.
+func (b *CustomMachineStatusApplyConfiguration) WithAddresses(values ...v1beta1.MachineAddress) *CustomMachineStatusApplyConfiguration {
+	for i := range values {
+		b.Addresses = append(b.Addresses, values[i])
+	}
+	return b
+}
+
+// WithOS sets the OS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OS field is set to the value of the last call.
+func (b *CustomMachineStatusApplyConfiguration) WithOS(value string) *CustomMachineStatusApplyConfiguration {
+	b.OS = &value
+	return b
+}
+
+// WithArch sets the Arch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Arch field is set to the value of the last call.
+func (b *CustomMachineStatusApplyConfiguration) WithArch(value string) *CustomMachineStatusApplyConfiguration {
+	b.Arch = &value
+	return b
+}
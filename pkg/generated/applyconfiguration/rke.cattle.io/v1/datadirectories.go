@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// DataDirectoriesApplyConfiguration represents a declarative configuration of the DataDirectories type for use
+// with apply.
+type DataDirectoriesApplyConfiguration struct {
+	SystemAgent  *string `json:"systemAgent,omitempty"`
+	Provisioning *string `json:"provisioning,omitempty"`
+	K8sDistro    *string `json:"k8sDistro,omitempty"`
+}
+
+// DataDirectoriesApplyConfiguration constructs a declarative configuration of the DataDirectories type for use with
+// apply.
+func DataDirectories() *DataDirectoriesApplyConfiguration {
+	return &DataDirectoriesApplyConfiguration{}
+}
+
+// WithSystemAgent sets the SystemAgent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SystemAgent field is set to the value of the last call.
+func (b *DataDirectoriesApplyConfiguration) WithSystemAgent(value string) *DataDirectoriesApplyConfiguration {
+	b.SystemAgent = &value
+	return b
+}
+
+// WithProvisioning sets the Provisioning field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provisioning field is set to the value of the last call.
+func (b *DataDirectoriesApplyConfiguration) WithProvisioning(value string) *DataDirectoriesApplyConfiguration {
+	b.Provisioning = &value
+	return b
+}
+
+// WithK8sDistro sets the K8sDistro field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the K8sDistro field is set to the value of the last call.
+func (b *DataDirectoriesApplyConfiguration) WithK8sDistro(value string) *DataDirectoriesApplyConfiguration {
+	b.K8sDistro = &value
+	return b
+}
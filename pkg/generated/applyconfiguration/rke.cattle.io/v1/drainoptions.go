@@ -0,0 +1,157 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// DrainOptionsApplyConfiguration represents a declarative configuration of the DrainOptions type for use
+// with apply.
+type DrainOptionsApplyConfiguration struct {
+	Enabled                         *bool                         `json:"enabled,omitempty"`
+	Force                           *bool                         `json:"force,omitempty"`
+	IgnoreDaemonSets                *bool                         `json:"ignoreDaemonSets,omitempty"`
+	IgnoreErrors                    *bool                         `json:"ignoreErrors,omitempty"`
+	DeleteEmptyDirData              *bool                         `json:"deleteEmptyDirData,omitempty"`
+	DisableEviction                 *bool                         `json:"disableEviction,omitempty"`
+	GracePeriod                     *int                          `json:"gracePeriod,omitempty"`
+	Timeout                         *int                          `json:"timeout,omitempty"`
+	PodDisruptionBudgetTimeout      *int                          `json:"podDisruptionBudgetTimeout,omitempty"`
+	ForceAfter                      *int                          `json:"forceAfter,omitempty"`
+	SkipWaitForDeleteTimeoutSeconds *int                          `json:"skipWaitForDeleteTimeoutSeconds,omitempty"`
+	PreDrainHooks                   []DrainHookApplyConfiguration `json:"preDrainHooks,omitempty"`
+	PostDrainHooks                  []DrainHookApplyConfiguration `json:"postDrainHooks,omitempty"`
+}
+
+// DrainOptionsApplyConfiguration constructs a declarative configuration of the DrainOptions type for use with
+// apply.
+func DrainOptions() *DrainOptionsApplyConfiguration {
+	return &DrainOptionsApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithEnabled(value bool) *DrainOptionsApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithForce sets the Force field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Force field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithForce(value bool) *DrainOptionsApplyConfiguration {
+	b.Force = &value
+	return b
+}
+
+// WithIgnoreDaemonSets sets the IgnoreDaemonSets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IgnoreDaemonSets field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithIgnoreDaemonSets(value bool) *DrainOptionsApplyConfiguration {
+	b.IgnoreDaemonSets = &value
+	return b
+}
+
+// WithIgnoreErrors sets the IgnoreErrors field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IgnoreErrors field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithIgnoreErrors(value bool) *DrainOptionsApplyConfiguration {
+	b.IgnoreErrors = &value
+	return b
+}
+
+// WithDeleteEmptyDirData sets the DeleteEmptyDirData field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeleteEmptyDirData field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithDeleteEmptyDirData(value bool) *DrainOptionsApplyConfiguration {
+	b.DeleteEmptyDirData = &value
+	return b
+}
+
+// WithDisableEviction sets the DisableEviction field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableEviction field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithDisableEviction(value bool) *DrainOptionsApplyConfiguration {
+	b.DisableEviction = &value
+	return b
+}
+
+// WithGracePeriod sets the GracePeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GracePeriod field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithGracePeriod(value int) *DrainOptionsApplyConfiguration {
+	b.GracePeriod = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithTimeout(value int) *DrainOptionsApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithPodDisruptionBudgetTimeout sets the PodDisruptionBudgetTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudgetTimeout field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithPodDisruptionBudgetTimeout(value int) *DrainOptionsApplyConfiguration {
+	b.PodDisruptionBudgetTimeout = &value
+	return b
+}
+
+// WithForceAfter sets the ForceAfter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ForceAfter field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithForceAfter(value int) *DrainOptionsApplyConfiguration {
+	b.ForceAfter = &value
+	return b
+}
+
+// WithSkipWaitForDeleteTimeoutSeconds sets the SkipWaitForDeleteTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SkipWaitForDeleteTimeoutSeconds field is set to the value of the last call.
+func (b *DrainOptionsApplyConfiguration) WithSkipWaitForDeleteTimeoutSeconds(value int) *DrainOptionsApplyConfiguration {
+	b.SkipWaitForDeleteTimeoutSeconds = &value
+	return b
+}
+
+// WithPreDrainHooks adds the given value to the PreDrainHooks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PreDrainHooks field.
+func (b *DrainOptionsApplyConfiguration) WithPreDrainHooks(values ...*DrainHookApplyConfiguration) *DrainOptionsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPreDrainHooks")
+		}
+		b.PreDrainHooks = append(b.PreDrainHooks, *values[i])
+	}
+	return b
+}
+
+// WithPostDrainHooks adds the given value to the PostDrainHooks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PostDrainHooks field.
+func (b *DrainOptionsApplyConfiguration) WithPostDrainHooks(values ...*DrainHookApplyConfiguration) *DrainOptionsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPostDrainHooks")
+		}
+		b.PostDrainHooks = append(b.PostDrainHooks, *values[i])
+	}
+	return b
+}
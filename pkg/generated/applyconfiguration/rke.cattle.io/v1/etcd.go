@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ETCDApplyConfiguration represents a declarative configuration of the ETCD type for use
+// with apply.
+type ETCDApplyConfiguration struct {
+	DisableSnapshots     *bool                             `json:"disableSnapshots,omitempty"`
+	SnapshotScheduleCron *string                           `json:"snapshotScheduleCron,omitempty"`
+	SnapshotRetention    *int                              `json:"snapshotRetention,omitempty"`
+	S3                   *ETCDSnapshotS3ApplyConfiguration `json:"s3,omitempty"`
+}
+
+// ETCDApplyConfiguration constructs a declarative configuration of the ETCD type for use with
+// apply.
+func ETCD() *ETCDApplyConfiguration {
+	return &ETCDApplyConfiguration{}
+}
+
+// WithDisableSnapshots sets the DisableSnapshots field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableSnapshots field is set to the value of the last call.
+func (b *ETCDApplyConfiguration) WithDisableSnapshots(value bool) *ETCDApplyConfiguration {
+	b.DisableSnapshots = &value
+	return b
+}
+
+// WithSnapshotScheduleCron sets the SnapshotScheduleCron field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotScheduleCron field is set to the value of the last call.
+func (b *ETCDApplyConfiguration) WithSnapshotScheduleCron(value string) *ETCDApplyConfiguration {
+	b.SnapshotScheduleCron = &value
+	return b
+}
+
+// WithSnapshotRetention sets the SnapshotRetention field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotRetention field is set to the value of the last call.
+func (b *ETCDApplyConfiguration) WithSnapshotRetention(value int) *ETCDApplyConfiguration {
+	b.SnapshotRetention = &value
+	return b
+}
+
+// WithS3 sets the S3 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the S3 field is set to the value of the last call.
+func (b *ETCDApplyConfiguration) WithS3(value *ETCDSnapshotS3ApplyConfiguration) *ETCDApplyConfiguration {
+	b.S3 = value
+	return b
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ETCDSnapshotCreateApplyConfiguration represents a declarative configuration of the ETCDSnapshotCreate type for use
+// with apply.
+type ETCDSnapshotCreateApplyConfiguration struct {
+	Generation *int                              `json:"generation,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Retention  *int                              `json:"retention,omitempty"`
+	S3         *ETCDSnapshotS3ApplyConfiguration `json:"s3,omitempty"`
+}
+
+// ETCDSnapshotCreateApplyConfiguration constructs a declarative configuration of the ETCDSnapshotCreate type for use with
+// apply.
+func ETCDSnapshotCreate() *ETCDSnapshotCreateApplyConfiguration {
+	return &ETCDSnapshotCreateApplyConfiguration{}
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *ETCDSnapshotCreateApplyConfiguration) WithGeneration(value int) *ETCDSnapshotCreateApplyConfiguration {
+	b.Generation = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ETCDSnapshotCreateApplyConfiguration) WithName(value string) *ETCDSnapshotCreateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithRetention sets the Retention field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retention field is set to the value of the last call.
+func (b *ETCDSnapshotCreateApplyConfiguration) WithRetention(value int) *ETCDSnapshotCreateApplyConfiguration {
+	b.Retention = &value
+	return b
+}
+
+// WithS3 sets the S3 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the S3 field is set to the value of the last call.
+func (b *ETCDSnapshotCreateApplyConfiguration) WithS3(value *ETCDSnapshotS3ApplyConfiguration) *ETCDSnapshotCreateApplyConfiguration {
+	b.S3 = value
+	return b
+}
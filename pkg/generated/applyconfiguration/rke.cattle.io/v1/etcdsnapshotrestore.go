@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ETCDSnapshotRestoreApplyConfiguration represents a declarative configuration of the ETCDSnapshotRestore type for use
+// with apply.
+type ETCDSnapshotRestoreApplyConfiguration struct {
+	Name             *string `json:"name,omitempty"`
+	Generation       *int    `json:"generation,omitempty"`
+	RestoreRKEConfig *string `json:"restoreRKEConfig,omitempty"`
+}
+
+// ETCDSnapshotRestoreApplyConfiguration constructs a declarative configuration of the ETCDSnapshotRestore type for use with
+// apply.
+func ETCDSnapshotRestore() *ETCDSnapshotRestoreApplyConfiguration {
+	return &ETCDSnapshotRestoreApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ETCDSnapshotRestoreApplyConfiguration) WithName(value string) *ETCDSnapshotRestoreApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *ETCDSnapshotRestoreApplyConfiguration) WithGeneration(value int) *ETCDSnapshotRestoreApplyConfiguration {
+	b.Generation = &value
+	return b
+}
+
+// WithRestoreRKEConfig sets the RestoreRKEConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestoreRKEConfig field is set to the value of the last call.
+func (b *ETCDSnapshotRestoreApplyConfiguration) WithRestoreRKEConfig(value string) *ETCDSnapshotRestoreApplyConfiguration {
+	b.RestoreRKEConfig = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ETCDSnapshotScheduleApplyConfiguration represents a declarative configuration of the ETCDSnapshotSchedule type for use
+// with apply.
+type ETCDSnapshotScheduleApplyConfiguration struct {
+	Cron      *string                           `json:"cron,omitempty"`
+	Retention *int                              `json:"retention,omitempty"`
+	S3        *ETCDSnapshotS3ApplyConfiguration `json:"s3,omitempty"`
+}
+
+// ETCDSnapshotScheduleApplyConfiguration constructs a declarative configuration of the ETCDSnapshotSchedule type for use with
+// apply.
+func ETCDSnapshotSchedule() *ETCDSnapshotScheduleApplyConfiguration {
+	return &ETCDSnapshotScheduleApplyConfiguration{}
+}
+
+// WithCron sets the Cron field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cron field is set to the value of the last call.
+func (b *ETCDSnapshotScheduleApplyConfiguration) WithCron(value string) *ETCDSnapshotScheduleApplyConfiguration {
+	b.Cron = &value
+	return b
+}
+
+// WithRetention sets the Retention field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retention field is set to the value of the last call.
+func (b *ETCDSnapshotScheduleApplyConfiguration) WithRetention(value int) *ETCDSnapshotScheduleApplyConfiguration {
+	b.Retention = &value
+	return b
+}
+
+// WithS3 sets the S3 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the S3 field is set to the value of the last call.
+func (b *ETCDSnapshotScheduleApplyConfiguration) WithS3(value *ETCDSnapshotS3ApplyConfiguration) *ETCDSnapshotScheduleApplyConfiguration {
+	b.S3 = value
+	return b
+}
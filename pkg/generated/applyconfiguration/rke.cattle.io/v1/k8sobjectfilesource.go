@@ -0,0 +1,62 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// K8sObjectFileSourceApplyConfiguration represents a declarative configuration of the K8sObjectFileSource type for use
+// with apply.
+type K8sObjectFileSourceApplyConfiguration struct {
+	Name               *string                       `json:"name,omitempty"`
+	Items              []KeyToPathApplyConfiguration `json:"items,omitempty"`
+	DefaultPermissions *string                       `json:"defaultPermissions,omitempty"`
+}
+
+// K8sObjectFileSourceApplyConfiguration constructs a declarative configuration of the K8sObjectFileSource type for use with
+// apply.
+func K8sObjectFileSource() *K8sObjectFileSourceApplyConfiguration {
+	return &K8sObjectFileSourceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *K8sObjectFileSourceApplyConfiguration) WithName(value string) *K8sObjectFileSourceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithItems adds the given value to the Items field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Items field.
+func (b *K8sObjectFileSourceApplyConfiguration) WithItems(values ...*KeyToPathApplyConfiguration) *K8sObjectFileSourceApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithItems")
+		}
+		b.Items = append(b.Items, *values[i])
+	}
+	return b
+}
+
+// WithDefaultPermissions sets the DefaultPermissions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultPermissions field is set to the value of the last call.
+func (b *K8sObjectFileSourceApplyConfiguration) WithDefaultPermissions(value string) *K8sObjectFileSourceApplyConfiguration {
+	b.DefaultPermissions = &value
+	return b
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// MirrorApplyConfiguration represents a declarative configuration of the Mirror type for use
+// with apply.
+type MirrorApplyConfiguration struct {
+	Endpoints []string          `json:"endpoint,omitempty"`
+	Rewrites  map[string]string `json:"rewrite,omitempty"`
+}
+
+// MirrorApplyConfiguration constructs a declarative configuration of the Mirror type for use with
+// apply.
+func Mirror() *MirrorApplyConfiguration {
+	return &MirrorApplyConfiguration{}
+}
+
+// WithEndpoints adds the given value to the Endpoints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Endpoints field.
+func (b *MirrorApplyConfiguration) WithEndpoints(values ...string) *MirrorApplyConfiguration {
+	for i := range values {
+		b.Endpoints = append(b.Endpoints, values[i])
+	}
+	return b
+}
+
+// WithRewrites puts the entries into the Rewrites field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Rewrites field,
+// overwriting an existing map entries in Rewrites field with the same key.
+func (b *MirrorApplyConfiguration) WithRewrites(entries map[string]string) *MirrorApplyConfiguration {
+	if b.Rewrites == nil && len(entries) > 0 {
+		b.Rewrites = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Rewrites[k] = v
+	}
+	return b
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// NetworkingApplyConfiguration represents a declarative configuration of the Networking type for use
+// with apply.
+type NetworkingApplyConfiguration struct {
+	StackPreference *string `json:"stackPreference,omitempty"`
+}
+
+// NetworkingApplyConfiguration constructs a declarative configuration of the Networking type for use with
+// apply.
+func Networking() *NetworkingApplyConfiguration {
+	return &NetworkingApplyConfiguration{}
+}
+
+// WithStackPreference sets the StackPreference field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StackPreference field is set to the value of the last call.
+func (b *NetworkingApplyConfiguration) WithStackPreference(value string) *NetworkingApplyConfiguration {
+	b.StackPreference = &value
+	return b
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RegistryApplyConfiguration represents a declarative configuration of the Registry type for use
+// with apply.
+type RegistryApplyConfiguration struct {
+	Mirrors map[string]MirrorApplyConfiguration         `json:"mirrors,omitempty"`
+	Configs map[string]RegistryConfigApplyConfiguration `json:"configs,omitempty"`
+}
+
+// RegistryApplyConfiguration constructs a declarative configuration of the Registry type for use with
+// apply.
+func Registry() *RegistryApplyConfiguration {
+	return &RegistryApplyConfiguration{}
+}
+
+// WithMirrors puts the entries into the Mirrors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Mirrors field,
+// overwriting an existing map entries in Mirrors field with the same key.
+func (b *RegistryApplyConfiguration) WithMirrors(entries map[string]MirrorApplyConfiguration) *RegistryApplyConfiguration {
+	if b.Mirrors == nil && len(entries) > 0 {
+		b.Mirrors = make(map[string]MirrorApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Mirrors[k] = v
+	}
+	return b
+}
+
+// WithConfigs puts the entries into the Configs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Configs field,
+// overwriting an existing map entries in Configs field with the same key.
+func (b *RegistryApplyConfiguration) WithConfigs(entries map[string]RegistryConfigApplyConfiguration) *RegistryApplyConfiguration {
+	if b.Configs == nil && len(entries) > 0 {
+		b.Configs = make(map[string]RegistryConfigApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Configs[k] = v
+	}
+	return b
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RegistryConfigApplyConfiguration represents a declarative configuration of the RegistryConfig type for use
+// with apply.
+type RegistryConfigApplyConfiguration struct {
+	AuthConfigSecretName *string `json:"authConfigSecretName,omitempty"`
+	TLSSecretName        *string `json:"tlsSecretName,omitempty"`
+	CABundle             []byte  `json:"caBundle,omitempty"`
+	InsecureSkipVerify   *bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// RegistryConfigApplyConfiguration constructs a declarative configuration of the RegistryConfig type for use with
+// apply.
+func RegistryConfig() *RegistryConfigApplyConfiguration {
+	return &RegistryConfigApplyConfiguration{}
+}
+
+// WithAuthConfigSecretName sets the AuthConfigSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuthConfigSecretName field is set to the value of the last call.
+func (b *RegistryConfigApplyConfiguration) WithAuthConfigSecretName(value string) *RegistryConfigApplyConfiguration {
+	b.AuthConfigSecretName = &value
+	return b
+}
+
+// WithTLSSecretName sets the TLSSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSSecretName field is set to the value of the last call.
+func (b *RegistryConfigApplyConfiguration) WithTLSSecretName(value string) *RegistryConfigApplyConfiguration {
+	b.TLSSecretName = &value
+	return b
+}
+
+// WithCABundle adds the given value to the CABundle field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CABundle field.
+func (b *RegistryConfigApplyConfiguration) WithCABundle(values ...byte) *RegistryConfigApplyConfiguration {
+	for i := range values {
+		b.CABundle = append(b.CABundle, values[i])
+	}
+	return b
+}
+
+// WithInsecureSkipVerify sets the InsecureSkipVerify field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InsecureSkipVerify field is set to the value of the last call.
+func (b *RegistryConfigApplyConfiguration) WithInsecureSkipVerify(value bool) *RegistryConfigApplyConfiguration {
+	b.InsecureSkipVerify = &value
+	return b
+}
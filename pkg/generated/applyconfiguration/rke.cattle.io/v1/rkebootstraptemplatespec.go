@@ -0,0 +1,48 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RKEBootstrapTemplateSpecApplyConfiguration represents a declarative configuration of the RKEBootstrapTemplateSpec type for use
+// with apply.
+type RKEBootstrapTemplateSpecApplyConfiguration struct {
+	ClusterName *string                         `json:"clusterName,omitempty"`
+	Template    *RKEBootstrapApplyConfiguration `json:"template,omitempty"`
+}
+
+// RKEBootstrapTemplateSpecApplyConfiguration constructs a declarative configuration of the RKEBootstrapTemplateSpec type for use with
+// apply.
+func RKEBootstrapTemplateSpec() *RKEBootstrapTemplateSpecApplyConfiguration {
+	return &RKEBootstrapTemplateSpecApplyConfiguration{}
+}
+
+// WithClusterName sets the ClusterName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterName field is set to the value of the last call.
+func (b *RKEBootstrapTemplateSpecApplyConfiguration) WithClusterName(value string) *RKEBootstrapTemplateSpecApplyConfiguration {
+	b.ClusterName = &value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *RKEBootstrapTemplateSpecApplyConfiguration) WithTemplate(value *RKEBootstrapApplyConfiguration) *RKEBootstrapTemplateSpecApplyConfiguration {
+	b.Template = value
+	return b
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// RKEClusterSpecApplyConfiguration represents a declarative configuration of the RKEClusterSpec type for use
+// with apply.
+type RKEClusterSpecApplyConfiguration struct {
+	ControlPlaneEndpoint *v1beta1.APIEndpoint `json:"controlPlaneEndpoint,omitempty"`
+}
+
+// RKEClusterSpecApplyConfiguration constructs a declarative configuration of the RKEClusterSpec type for use with
+// apply.
+func RKEClusterSpec() *RKEClusterSpecApplyConfiguration {
+	return &RKEClusterSpecApplyConfiguration{}
+}
+
+// WithControlPlaneEndpoint sets the ControlPlaneEndpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControlPlaneEndpoint field is set to the value of the last call.
+func (b *RKEClusterSpecApplyConfiguration) WithControlPlaneEndpoint(value v1beta1.APIEndpoint) *RKEClusterSpecApplyConfiguration {
+	b.ControlPlaneEndpoint = &value
+	return b
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	genericcondition "github.com/rancher/wrangler/v3/pkg/genericcondition"
+)
+
+// RKEClusterStatusApplyConfiguration represents a declarative configuration of the RKEClusterStatus type for use
+// with apply.
+type RKEClusterStatusApplyConfiguration struct {
+	Conditions []genericcondition.GenericCondition `json:"conditions,omitempty"`
+	Ready      *bool                               `json:"ready,omitempty"`
+}
+
+// RKEClusterStatusApplyConfiguration constructs a declarative configuration of the RKEClusterStatus type for use with
+// apply.
+func RKEClusterStatus() *RKEClusterStatusApplyConfiguration {
+	return &RKEClusterStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *RKEClusterStatusApplyConfiguration) WithConditions(values ...genericcondition.GenericCondition) *RKEClusterStatusApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *RKEClusterStatusApplyConfiguration) WithReady(value bool) *RKEClusterStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
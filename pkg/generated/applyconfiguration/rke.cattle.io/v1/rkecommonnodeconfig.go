@@ -0,0 +1,69 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// RKECommonNodeConfigApplyConfiguration represents a declarative configuration of the RKECommonNodeConfig type for use
+// with apply.
+type RKECommonNodeConfigApplyConfiguration struct {
+	Labels                    map[string]string `json:"labels,omitempty"`
+	Taints                    []corev1.Taint    `json:"taints,omitempty"`
+	CloudCredentialSecretName *string           `json:"cloudCredentialSecretName,omitempty"`
+}
+
+// RKECommonNodeConfigApplyConfiguration constructs a declarative configuration of the RKECommonNodeConfig type for use with
+// apply.
+func RKECommonNodeConfig() *RKECommonNodeConfigApplyConfiguration {
+	return &RKECommonNodeConfigApplyConfiguration{}
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *RKECommonNodeConfigApplyConfiguration) WithLabels(entries map[string]string) *RKECommonNodeConfigApplyConfiguration {
+	if b.Labels == nil && len(entries) > 0 {
+		b.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Labels[k] = v
+	}
+	return b
+}
+
+// WithTaints adds the given value to the Taints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Taints field.
+func (b *RKECommonNodeConfigApplyConfiguration) WithTaints(values ...corev1.Taint) *RKECommonNodeConfigApplyConfiguration {
+	for i := range values {
+		b.Taints = append(b.Taints, values[i])
+	}
+	return b
+}
+
+// WithCloudCredentialSecretName sets the CloudCredentialSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CloudCredentialSecretName field is set to the value of the last call.
+func (b *RKECommonNodeConfigApplyConfiguration) WithCloudCredentialSecretName(value string) *RKECommonNodeConfigApplyConfiguration {
+	b.CloudCredentialSecretName = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// RKEProvisioningFilesApplyConfiguration represents a declarative configuration of the RKEProvisioningFiles type for use
+// with apply.
+type RKEProvisioningFilesApplyConfiguration struct {
+	MachineLabelSelector *metav1.LabelSelectorApplyConfiguration    `json:"machineLabelSelector,omitempty"`
+	FileSources          []ProvisioningFileSourceApplyConfiguration `json:"fileSources,omitempty"`
+}
+
+// RKEProvisioningFilesApplyConfiguration constructs a declarative configuration of the RKEProvisioningFiles type for use with
+// apply.
+func RKEProvisioningFiles() *RKEProvisioningFilesApplyConfiguration {
+	return &RKEProvisioningFilesApplyConfiguration{}
+}
+
+// WithMachineLabelSelector sets the MachineLabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineLabelSelector field is set to the value of the last call.
+func (b *RKEProvisioningFilesApplyConfiguration) WithMachineLabelSelector(value *metav1.LabelSelectorApplyConfiguration) *RKEProvisioningFilesApplyConfiguration {
+	b.MachineLabelSelector = value
+	return b
+}
+
+// WithFileSources adds the given value to the FileSources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FileSources field.
+func (b *RKEProvisioningFilesApplyConfiguration) WithFileSources(values ...*ProvisioningFileSourceApplyConfiguration) *RKEProvisioningFilesApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFileSources")
+		}
+		b.FileSources = append(b.FileSources, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	rkecattleiov1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// RKESystemConfigApplyConfiguration represents a declarative configuration of the RKESystemConfig type for use
+// with apply.
+type RKESystemConfigApplyConfiguration struct {
+	MachineLabelSelector *metav1.LabelSelectorApplyConfiguration `json:"machineLabelSelector,omitempty"`
+	Config               *rkecattleiov1.GenericMap               `json:"config,omitempty"`
+}
+
+// RKESystemConfigApplyConfiguration constructs a declarative configuration of the RKESystemConfig type for use with
+// apply.
+func RKESystemConfig() *RKESystemConfigApplyConfiguration {
+	return &RKESystemConfigApplyConfiguration{}
+}
+
+// WithMachineLabelSelector sets the MachineLabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineLabelSelector field is set to the value of the last call.
+func (b *RKESystemConfigApplyConfiguration) WithMachineLabelSelector(value *metav1.LabelSelectorApplyConfiguration) *RKESystemConfigApplyConfiguration {
+	b.MachineLabelSelector = value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *RKESystemConfigApplyConfiguration) WithConfig(value rkecattleiov1.GenericMap) *RKESystemConfigApplyConfiguration {
+	b.Config = &value
+	return b
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RotateCertificatesApplyConfiguration represents a declarative configuration of the RotateCertificates type for use
+// with apply.
+type RotateCertificatesApplyConfiguration struct {
+	Generation *int64   `json:"generation,omitempty"`
+	Services   []string `json:"services,omitempty"`
+}
+
+// RotateCertificatesApplyConfiguration constructs a declarative configuration of the RotateCertificates type for use with
+// apply.
+func RotateCertificates() *RotateCertificatesApplyConfiguration {
+	return &RotateCertificatesApplyConfiguration{}
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *RotateCertificatesApplyConfiguration) WithGeneration(value int64) *RotateCertificatesApplyConfiguration {
+	b.Generation = &value
+	return b
+}
+
+// WithServices adds the given value to the Services field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Services field.
+func (b *RotateCertificatesApplyConfiguration) WithServices(values ...string) *RotateCertificatesApplyConfiguration {
+	for i := range values {
+		b.Services = append(b.Services, values[i])
+	}
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SecretRequestSpecApplyConfiguration represents a declarative configuration of the SecretRequestSpec type for use
+// with apply.
+type SecretRequestSpecApplyConfiguration struct {
+	SecretType      *string                 `json:"secretType,omitempty"`
+	TargetSecretRef *corev1.SecretReference `json:"targetSecretRef,omitempty"`
+}
+
+// SecretRequestSpecApplyConfiguration constructs a declarative configuration of the SecretRequestSpec type for use with
+// apply.
+func SecretRequestSpec() *SecretRequestSpecApplyConfiguration {
+	return &SecretRequestSpecApplyConfiguration{}
+}
+
+// WithSecretType sets the SecretType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretType field is set to the value of the last call.
+func (b *SecretRequestSpecApplyConfiguration) WithSecretType(value string) *SecretRequestSpecApplyConfiguration {
+	b.SecretType = &value
+	return b
+}
+
+// WithTargetSecretRef sets the TargetSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetSecretRef field is set to the value of the last call.
+func (b *SecretRequestSpecApplyConfiguration) WithTargetSecretRef(value corev1.SecretReference) *SecretRequestSpecApplyConfiguration {
+	b.TargetSecretRef = &value
+	return b
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	genericcondition "github.com/rancher/wrangler/v3/pkg/genericcondition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretRequestStatusApplyConfiguration represents a declarative configuration of the SecretRequestStatus type for use
+// with apply.
+type SecretRequestStatusApplyConfiguration struct {
+	Conditions []genericcondition.GenericCondition `json:"conditions,omitempty"`
+	LastSyncTS *metav1.Time                        `json:"lastSyncTS,omitempty"`
+}
+
+// SecretRequestStatusApplyConfiguration constructs a declarative configuration of the SecretRequestStatus type for use with
+// apply.
+func SecretRequestStatus() *SecretRequestStatusApplyConfiguration {
+	return &SecretRequestStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *SecretRequestStatusApplyConfiguration) WithConditions(values ...genericcondition.GenericCondition) *SecretRequestStatusApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
+
+// WithLastSyncTS sets the LastSyncTS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastSyncTS field is set to the value of the last call.
+func (b *SecretRequestStatusApplyConfiguration) WithLastSyncTS(value metav1.Time) *SecretRequestStatusApplyConfiguration {
+	b.LastSyncTS = &value
+	return b
+}
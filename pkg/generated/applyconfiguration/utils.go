@@ -0,0 +1,542 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v1 "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
+	extcattleiov1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	provisioningcattleiov1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkecattleiov1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	telemetrycattleiov1 "github.com/rancher/rancher/pkg/apis/telemetry.cattle.io/v1"
+	catalogcattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/catalog.cattle.io/v1"
+	applyconfigurationextcattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/ext.cattle.io/v1"
+	internal "github.com/rancher/rancher/pkg/generated/applyconfiguration/internal"
+	managementcattleiov3 "github.com/rancher/rancher/pkg/generated/applyconfiguration/management.cattle.io/v3"
+	applyconfigurationprovisioningcattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/provisioning.cattle.io/v1"
+	applyconfigurationrkecattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/rke.cattle.io/v1"
+	applyconfigurationtelemetrycattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/telemetry.cattle.io/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=catalog.cattle.io, Version=v1
+	case v1.SchemeGroupVersion.WithKind("App"):
+		return &catalogcattleiov1.AppApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Chart"):
+		return &catalogcattleiov1.ChartApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterRepo"):
+		return &catalogcattleiov1.ClusterRepoApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExponentialBackOffValues"):
+		return &catalogcattleiov1.ExponentialBackOffValuesApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Info"):
+		return &catalogcattleiov1.InfoApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Maintainer"):
+		return &catalogcattleiov1.MaintainerApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Metadata"):
+		return &catalogcattleiov1.MetadataApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Operation"):
+		return &catalogcattleiov1.OperationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("OperationStatus"):
+		return &catalogcattleiov1.OperationStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ReleaseResource"):
+		return &catalogcattleiov1.ReleaseResourceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ReleaseSpec"):
+		return &catalogcattleiov1.ReleaseSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ReleaseStatus"):
+		return &catalogcattleiov1.ReleaseStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RepoSpec"):
+		return &catalogcattleiov1.RepoSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RepoStatus"):
+		return &catalogcattleiov1.RepoStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SecretReference"):
+		return &catalogcattleiov1.SecretReferenceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Summary"):
+		return &catalogcattleiov1.SummaryApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UIPlugin"):
+		return &catalogcattleiov1.UIPluginApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UIPluginEntry"):
+		return &catalogcattleiov1.UIPluginEntryApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UIPluginSpec"):
+		return &catalogcattleiov1.UIPluginSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UIPluginStatus"):
+		return &catalogcattleiov1.UIPluginStatusApplyConfiguration{}
+
+		// Group=ext.cattle.io, Version=v1
+	case extcattleiov1.SchemeGroupVersion.WithKind("Kubeconfig"):
+		return &applyconfigurationextcattleiov1.KubeconfigApplyConfiguration{}
+	case extcattleiov1.SchemeGroupVersion.WithKind("KubeconfigSpec"):
+		return &applyconfigurationextcattleiov1.KubeconfigSpecApplyConfiguration{}
+	case extcattleiov1.SchemeGroupVersion.WithKind("KubeconfigStatus"):
+		return &applyconfigurationextcattleiov1.KubeconfigStatusApplyConfiguration{}
+	case extcattleiov1.SchemeGroupVersion.WithKind("Token"):
+		return &applyconfigurationextcattleiov1.TokenApplyConfiguration{}
+	case extcattleiov1.SchemeGroupVersion.WithKind("TokenPrincipal"):
+		return &applyconfigurationextcattleiov1.TokenPrincipalApplyConfiguration{}
+	case extcattleiov1.SchemeGroupVersion.WithKind("TokenSpec"):
+		return &applyconfigurationextcattleiov1.TokenSpecApplyConfiguration{}
+	case extcattleiov1.SchemeGroupVersion.WithKind("TokenStatus"):
+		return &applyconfigurationextcattleiov1.TokenStatusApplyConfiguration{}
+
+		// Group=management.cattle.io, Version=v3
+	case v3.SchemeGroupVersion.WithKind("Action"):
+		return &managementcattleiov3.ActionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("AgentDeploymentCustomization"):
+		return &managementcattleiov3.AgentDeploymentCustomizationApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("AgentSchedulingCustomization"):
+		return &managementcattleiov3.AgentSchedulingCustomizationApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("AKSStatus"):
+		return &managementcattleiov3.AKSStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Answer"):
+		return &managementcattleiov3.AnswerApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("APIService"):
+		return &managementcattleiov3.APIServiceApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("APIServiceSpec"):
+		return &managementcattleiov3.APIServiceSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("APIServiceStatus"):
+		return &managementcattleiov3.APIServiceStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("APIUsageReport"):
+		return &managementcattleiov3.APIUsageReportApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("APIUsageReportEntry"):
+		return &managementcattleiov3.APIUsageReportEntryApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("APIUsageReportSpec"):
+		return &managementcattleiov3.APIUsageReportSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("AuthConfig"):
+		return &managementcattleiov3.AuthConfigApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("AuthConfigConditions"):
+		return &managementcattleiov3.AuthConfigConditionsApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("AuthConfigStatus"):
+		return &managementcattleiov3.AuthConfigStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("AuthProvider"):
+		return &managementcattleiov3.AuthProviderApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("AuthToken"):
+		return &managementcattleiov3.AuthTokenApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("BootstrapPrincipal"):
+		return &managementcattleiov3.BootstrapPrincipalApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("BootstrapPrincipalSpec"):
+		return &managementcattleiov3.BootstrapPrincipalSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Capabilities"):
+		return &managementcattleiov3.CapabilitiesApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("CertExpiration"):
+		return &managementcattleiov3.CertExpirationApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("CloudCredential"):
+		return &managementcattleiov3.CloudCredentialApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("CloudCredentialSpec"):
+		return &managementcattleiov3.CloudCredentialSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Cluster"):
+		return &managementcattleiov3.ClusterApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterComponentStatus"):
+		return &managementcattleiov3.ClusterComponentStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterCondition"):
+		return &managementcattleiov3.ClusterConditionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterMemberSummary"):
+		return &managementcattleiov3.ClusterMemberSummaryApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterProxyConfig"):
+		return &managementcattleiov3.ClusterProxyConfigApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterRegistrationToken"):
+		return &managementcattleiov3.ClusterRegistrationTokenApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterRegistrationTokenSpec"):
+		return &managementcattleiov3.ClusterRegistrationTokenSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterRegistrationTokenStatus"):
+		return &managementcattleiov3.ClusterRegistrationTokenStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterRoleTemplateBinding"):
+		return &managementcattleiov3.ClusterRoleTemplateBindingApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterRoleTemplateBindingStatus"):
+		return &managementcattleiov3.ClusterRoleTemplateBindingStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterSecrets"):
+		return &managementcattleiov3.ClusterSecretsApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterSpec"):
+		return &managementcattleiov3.ClusterSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterSpecBase"):
+		return &managementcattleiov3.ClusterSpecBaseApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterStatus"):
+		return &managementcattleiov3.ClusterStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterTemplate"):
+		return &managementcattleiov3.ClusterTemplateApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterTemplateRevision"):
+		return &managementcattleiov3.ClusterTemplateRevisionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterTemplateRevisionCondition"):
+		return &managementcattleiov3.ClusterTemplateRevisionConditionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterTemplateRevisionSpec"):
+		return &managementcattleiov3.ClusterTemplateRevisionSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterTemplateRevisionStatus"):
+		return &managementcattleiov3.ClusterTemplateRevisionStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterTemplateSpec"):
+		return &managementcattleiov3.ClusterTemplateSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ClusterUpgradeStrategy"):
+		return &managementcattleiov3.ClusterUpgradeStrategyApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ComposeCondition"):
+		return &managementcattleiov3.ComposeConditionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ComposeConfig"):
+		return &managementcattleiov3.ComposeConfigApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ComposeSpec"):
+		return &managementcattleiov3.ComposeSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ComposeStatus"):
+		return &managementcattleiov3.ComposeStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Condition"):
+		return &managementcattleiov3.ConditionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ContainerResourceLimit"):
+		return &managementcattleiov3.ContainerResourceLimitApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("CustomConfig"):
+		return &managementcattleiov3.CustomConfigApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("DynamicSchema"):
+		return &managementcattleiov3.DynamicSchemaApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("DynamicSchemaSpec"):
+		return &managementcattleiov3.DynamicSchemaSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("DynamicSchemaStatus"):
+		return &managementcattleiov3.DynamicSchemaStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("EKSStatus"):
+		return &managementcattleiov3.EKSStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("EtcdBackup"):
+		return &managementcattleiov3.EtcdBackupApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Feature"):
+		return &managementcattleiov3.FeatureApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("FeatureClusterStatus"):
+		return &managementcattleiov3.FeatureClusterStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("FeatureSpec"):
+		return &managementcattleiov3.FeatureSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("FeatureStatus"):
+		return &managementcattleiov3.FeatureStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Field"):
+		return &managementcattleiov3.FieldApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Filter"):
+		return &managementcattleiov3.FilterApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("FleetWorkspace"):
+		return &managementcattleiov3.FleetWorkspaceApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("FleetWorkspacePermission"):
+		return &managementcattleiov3.FleetWorkspacePermissionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ForbiddenRule"):
+		return &managementcattleiov3.ForbiddenRuleApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("GKEStatus"):
+		return &managementcattleiov3.GKEStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("GlobalRole"):
+		return &managementcattleiov3.GlobalRoleApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("GlobalRoleBinding"):
+		return &managementcattleiov3.GlobalRoleBindingApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("GlobalRoleBindingStatus"):
+		return &managementcattleiov3.GlobalRoleBindingStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("GlobalRoleGroupMapping"):
+		return &managementcattleiov3.GlobalRoleGroupMappingApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("GlobalRoleGroupMappingSpec"):
+		return &managementcattleiov3.GlobalRoleGroupMappingSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("GlobalRoleStatus"):
+		return &managementcattleiov3.GlobalRoleStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Group"):
+		return &managementcattleiov3.GroupApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("GroupMember"):
+		return &managementcattleiov3.GroupMemberApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ImportedConfig"):
+		return &managementcattleiov3.ImportedConfigApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("IngressCapabilities"):
+		return &managementcattleiov3.IngressCapabilitiesApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("K3sConfig"):
+		return &managementcattleiov3.K3sConfigApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("KontainerDriver"):
+		return &managementcattleiov3.KontainerDriverApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("KontainerDriverSpec"):
+		return &managementcattleiov3.KontainerDriverSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("KontainerDriverStatus"):
+		return &managementcattleiov3.KontainerDriverStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("LoadBalancerCapabilities"):
+		return &managementcattleiov3.LoadBalancerCapabilitiesApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("LocalClusterAuthEndpoint"):
+		return &managementcattleiov3.LocalClusterAuthEndpointApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ManagedChart"):
+		return &managementcattleiov3.ManagedChartApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ManagedChartSpec"):
+		return &managementcattleiov3.ManagedChartSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ManagedSetting"):
+		return &managementcattleiov3.ManagedSettingApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ManagedSettingClusterStatus"):
+		return &managementcattleiov3.ManagedSettingClusterStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ManagedSettingSpec"):
+		return &managementcattleiov3.ManagedSettingSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ManagedSettingStatus"):
+		return &managementcattleiov3.ManagedSettingStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("MapDelta"):
+		return &managementcattleiov3.MapDeltaApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Member"):
+		return &managementcattleiov3.MemberApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("MetadataUpdate"):
+		return &managementcattleiov3.MetadataUpdateApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NamespaceResourceQuota"):
+		return &managementcattleiov3.NamespaceResourceQuotaApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Node"):
+		return &managementcattleiov3.NodeApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeCommonParams"):
+		return &managementcattleiov3.NodeCommonParamsApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeCondition"):
+		return &managementcattleiov3.NodeConditionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeDriver"):
+		return &managementcattleiov3.NodeDriverApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeDriverSpec"):
+		return &managementcattleiov3.NodeDriverSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeDriverStatus"):
+		return &managementcattleiov3.NodeDriverStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodePlan"):
+		return &managementcattleiov3.NodePlanApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodePool"):
+		return &managementcattleiov3.NodePoolApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodePoolSpec"):
+		return &managementcattleiov3.NodePoolSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodePoolStatus"):
+		return &managementcattleiov3.NodePoolStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeSpec"):
+		return &managementcattleiov3.NodeSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeStatus"):
+		return &managementcattleiov3.NodeStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeTemplate"):
+		return &managementcattleiov3.NodeTemplateApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeTemplateCondition"):
+		return &managementcattleiov3.NodeTemplateConditionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeTemplateSpec"):
+		return &managementcattleiov3.NodeTemplateSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("NodeTemplateStatus"):
+		return &managementcattleiov3.NodeTemplateStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("OIDCClient"):
+		return &managementcattleiov3.OIDCClientApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("OIDCClientSecretStatus"):
+		return &managementcattleiov3.OIDCClientSecretStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("OIDCClientSpec"):
+		return &managementcattleiov3.OIDCClientSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("OIDCClientStatus"):
+		return &managementcattleiov3.OIDCClientStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PodDisruptionBudgetSpec"):
+		return &managementcattleiov3.PodDisruptionBudgetSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PodSecurityAdmissionConfigurationTemplate"):
+		return &managementcattleiov3.PodSecurityAdmissionConfigurationTemplateApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PodSecurityAdmissionConfigurationTemplateDefaults"):
+		return &managementcattleiov3.PodSecurityAdmissionConfigurationTemplateDefaultsApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PodSecurityAdmissionConfigurationTemplateExemptions"):
+		return &managementcattleiov3.PodSecurityAdmissionConfigurationTemplateExemptionsApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PodSecurityAdmissionConfigurationTemplateSpec"):
+		return &managementcattleiov3.PodSecurityAdmissionConfigurationTemplateSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PodSecurityAdmissionProfile"):
+		return &managementcattleiov3.PodSecurityAdmissionProfileApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PodSecurityAdmissionProfileClusterStatus"):
+		return &managementcattleiov3.PodSecurityAdmissionProfileClusterStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PodSecurityAdmissionProfileSpec"):
+		return &managementcattleiov3.PodSecurityAdmissionProfileSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PodSecurityAdmissionProfileStatus"):
+		return &managementcattleiov3.PodSecurityAdmissionProfileStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Preference"):
+		return &managementcattleiov3.PreferenceApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Principal"):
+		return &managementcattleiov3.PrincipalApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("PriorityClassSpec"):
+		return &managementcattleiov3.PriorityClassSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Project"):
+		return &managementcattleiov3.ProjectApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ProjectCondition"):
+		return &managementcattleiov3.ProjectConditionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ProjectMemberSummary"):
+		return &managementcattleiov3.ProjectMemberSummaryApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ProjectNetworkPolicy"):
+		return &managementcattleiov3.ProjectNetworkPolicyApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ProjectNetworkPolicySpec"):
+		return &managementcattleiov3.ProjectNetworkPolicySpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ProjectResourceQuota"):
+		return &managementcattleiov3.ProjectResourceQuotaApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ProjectRoleTemplateBinding"):
+		return &managementcattleiov3.ProjectRoleTemplateBindingApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ProjectSpec"):
+		return &managementcattleiov3.ProjectSpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ProjectStatus"):
+		return &managementcattleiov3.ProjectStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Question"):
+		return &managementcattleiov3.QuestionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("RancherUserNotification"):
+		return &managementcattleiov3.RancherUserNotificationApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("ResourceQuotaLimit"):
+		return &managementcattleiov3.ResourceQuotaLimitApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Rke2Config"):
+		return &managementcattleiov3.Rke2ConfigApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("RkeAddon"):
+		return &managementcattleiov3.RkeAddonApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("RkeK8sServiceOption"):
+		return &managementcattleiov3.RkeK8sServiceOptionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("RkeK8sSystemImage"):
+		return &managementcattleiov3.RkeK8sSystemImageApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("RoleTemplate"):
+		return &managementcattleiov3.RoleTemplateApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("RoleTemplatePolicy"):
+		return &managementcattleiov3.RoleTemplatePolicyApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("RoleTemplatePolicySpec"):
+		return &managementcattleiov3.RoleTemplatePolicySpecApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("SamlToken"):
+		return &managementcattleiov3.SamlTokenApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Setting"):
+		return &managementcattleiov3.SettingApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("SubQuestion"):
+		return &managementcattleiov3.SubQuestionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Token"):
+		return &managementcattleiov3.TokenApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("User"):
+		return &managementcattleiov3.UserApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("UserAttribute"):
+		return &managementcattleiov3.UserAttributeApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("UserCondition"):
+		return &managementcattleiov3.UserConditionApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("UserStatus"):
+		return &managementcattleiov3.UserStatusApplyConfiguration{}
+	case v3.SchemeGroupVersion.WithKind("Values"):
+		return &managementcattleiov3.ValuesApplyConfiguration{}
+
+		// Group=provisioning.cattle.io, Version=v1
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("AgentDeploymentCustomization"):
+		return &applyconfigurationprovisioningcattleiov1.AgentDeploymentCustomizationApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("AgentSchedulingCustomization"):
+		return &applyconfigurationprovisioningcattleiov1.AgentSchedulingCustomizationApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("Cluster"):
+		return &applyconfigurationprovisioningcattleiov1.ClusterApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("ClusterAPIConfig"):
+		return &applyconfigurationprovisioningcattleiov1.ClusterAPIConfigApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("ClusterSpec"):
+		return &applyconfigurationprovisioningcattleiov1.ClusterSpecApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("ClusterStatus"):
+		return &applyconfigurationprovisioningcattleiov1.ClusterStatusApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("ClusterTemplate"):
+		return &applyconfigurationprovisioningcattleiov1.ClusterTemplateApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("ClusterTemplateSpec"):
+		return &applyconfigurationprovisioningcattleiov1.ClusterTemplateSpecApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("PodDisruptionBudgetSpec"):
+		return &applyconfigurationprovisioningcattleiov1.PodDisruptionBudgetSpecApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("PriorityClassSpec"):
+		return &applyconfigurationprovisioningcattleiov1.PriorityClassSpecApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("PrivateRegistry"):
+		return &applyconfigurationprovisioningcattleiov1.PrivateRegistryApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("PrivateRegistrySpec"):
+		return &applyconfigurationprovisioningcattleiov1.PrivateRegistrySpecApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("RKEConfig"):
+		return &applyconfigurationprovisioningcattleiov1.RKEConfigApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("RKEMachinePool"):
+		return &applyconfigurationprovisioningcattleiov1.RKEMachinePoolApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("RKEMachinePoolDefaults"):
+		return &applyconfigurationprovisioningcattleiov1.RKEMachinePoolDefaultsApplyConfiguration{}
+	case provisioningcattleiov1.SchemeGroupVersion.WithKind("RKEMachinePoolRollingUpdate"):
+		return &applyconfigurationprovisioningcattleiov1.RKEMachinePoolRollingUpdateApplyConfiguration{}
+
+		// Group=rke.cattle.io, Version=v1
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ClusterConfiguration"):
+		return &applyconfigurationrkecattleiov1.ClusterConfigurationApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ClusterUpgradeStrategy"):
+		return &applyconfigurationrkecattleiov1.ClusterUpgradeStrategyApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("CustomMachine"):
+		return &applyconfigurationrkecattleiov1.CustomMachineApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("CustomMachineSpec"):
+		return &applyconfigurationrkecattleiov1.CustomMachineSpecApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("CustomMachineStatus"):
+		return &applyconfigurationrkecattleiov1.CustomMachineStatusApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("DataDirectories"):
+		return &applyconfigurationrkecattleiov1.DataDirectoriesApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("DrainHook"):
+		return &applyconfigurationrkecattleiov1.DrainHookApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("DrainOptions"):
+		return &applyconfigurationrkecattleiov1.DrainOptionsApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("EnvVar"):
+		return &applyconfigurationrkecattleiov1.EnvVarApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCD"):
+		return &applyconfigurationrkecattleiov1.ETCDApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCDSnapshot"):
+		return &applyconfigurationrkecattleiov1.ETCDSnapshotApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCDSnapshotCreate"):
+		return &applyconfigurationrkecattleiov1.ETCDSnapshotCreateApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCDSnapshotFile"):
+		return &applyconfigurationrkecattleiov1.ETCDSnapshotFileApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCDSnapshotRestore"):
+		return &applyconfigurationrkecattleiov1.ETCDSnapshotRestoreApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCDSnapshotS3"):
+		return &applyconfigurationrkecattleiov1.ETCDSnapshotS3ApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCDSnapshotSchedule"):
+		return &applyconfigurationrkecattleiov1.ETCDSnapshotScheduleApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCDSnapshotScheduleStatus"):
+		return &applyconfigurationrkecattleiov1.ETCDSnapshotScheduleStatusApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCDSnapshotSpec"):
+		return &applyconfigurationrkecattleiov1.ETCDSnapshotSpecApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ETCDSnapshotStatus"):
+		return &applyconfigurationrkecattleiov1.ETCDSnapshotStatusApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("K8sObjectFileSource"):
+		return &applyconfigurationrkecattleiov1.K8sObjectFileSourceApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("KeyToPath"):
+		return &applyconfigurationrkecattleiov1.KeyToPathApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("LocalClusterAuthEndpoint"):
+		return &applyconfigurationrkecattleiov1.LocalClusterAuthEndpointApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("Mirror"):
+		return &applyconfigurationrkecattleiov1.MirrorApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("Networking"):
+		return &applyconfigurationrkecattleiov1.NetworkingApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("ProvisioningFileSource"):
+		return &applyconfigurationrkecattleiov1.ProvisioningFileSourceApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("Registry"):
+		return &applyconfigurationrkecattleiov1.RegistryApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RegistryConfig"):
+		return &applyconfigurationrkecattleiov1.RegistryConfigApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEBootstrap"):
+		return &applyconfigurationrkecattleiov1.RKEBootstrapApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEBootstrapSpec"):
+		return &applyconfigurationrkecattleiov1.RKEBootstrapSpecApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEBootstrapStatus"):
+		return &applyconfigurationrkecattleiov1.RKEBootstrapStatusApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEBootstrapTemplate"):
+		return &applyconfigurationrkecattleiov1.RKEBootstrapTemplateApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEBootstrapTemplateSpec"):
+		return &applyconfigurationrkecattleiov1.RKEBootstrapTemplateSpecApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKECluster"):
+		return &applyconfigurationrkecattleiov1.RKEClusterApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEClusterSpec"):
+		return &applyconfigurationrkecattleiov1.RKEClusterSpecApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEClusterStatus"):
+		return &applyconfigurationrkecattleiov1.RKEClusterStatusApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKECommonNodeConfig"):
+		return &applyconfigurationrkecattleiov1.RKECommonNodeConfigApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEControlPlane"):
+		return &applyconfigurationrkecattleiov1.RKEControlPlaneApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEControlPlaneSpec"):
+		return &applyconfigurationrkecattleiov1.RKEControlPlaneSpecApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEControlPlaneStatus"):
+		return &applyconfigurationrkecattleiov1.RKEControlPlaneStatusApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKEProvisioningFiles"):
+		return &applyconfigurationrkecattleiov1.RKEProvisioningFilesApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RKESystemConfig"):
+		return &applyconfigurationrkecattleiov1.RKESystemConfigApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RotateCertificates"):
+		return &applyconfigurationrkecattleiov1.RotateCertificatesApplyConfiguration{}
+	case rkecattleiov1.SchemeGroupVersion.WithKind("RotateEncryptionKeys"):
+		return &applyconfigurationrkecattleiov1.RotateEncryptionKeysApplyConfiguration{}
+
+		// Group=telemetry.cattle.io, Version=v1
+	case telemetrycattleiov1.SchemeGroupVersion.WithKind("SecretRequest"):
+		return &applyconfigurationtelemetrycattleiov1.SecretRequestApplyConfiguration{}
+	case telemetrycattleiov1.SchemeGroupVersion.WithKind("SecretRequestSpec"):
+		return &applyconfigurationtelemetrycattleiov1.SecretRequestSpecApplyConfiguration{}
+	case telemetrycattleiov1.SchemeGroupVersion.WithKind("SecretRequestStatus"):
+		return &applyconfigurationtelemetrycattleiov1.SecretRequestStatusApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) *testing.TypeConverter {
+	return &testing.TypeConverter{Scheme: scheme, TypeResolver: internal.Parser()}
+}
@@ -23,6 +23,7 @@
 	http "net/http"
 
 	catalogv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/catalog.cattle.io/v1"
+	extv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/ext.cattle.io/v1"
 	provisioningv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/provisioning.cattle.io/v1"
 	rkev1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/rke.cattle.io/v1"
 	telemetryv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/telemetry.cattle.io/v1"
@@ -35,6 +36,7 @@
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	CatalogV1() catalogv1.CatalogV1Interface
+	ExtV1() extv1.ExtV1Interface
 	ProvisioningV1() provisioningv1.ProvisioningV1Interface
 	RkeV1() rkev1.RkeV1Interface
 	TelemetryV1() telemetryv1.TelemetryV1Interface
@@ -45,6 +47,7 @@ type Interface interface {
 type Clientset struct {
 	*discovery.DiscoveryClient
 	catalogV1      *catalogv1.CatalogV1Client
+	extV1          *extv1.ExtV1Client
 	provisioningV1 *provisioningv1.ProvisioningV1Client
 	rkeV1          *rkev1.RkeV1Client
 	telemetryV1    *telemetryv1.TelemetryV1Client
@@ -56,6 +59,11 @@ func (c *Clientset) CatalogV1() catalogv1.CatalogV1Interface {
 	return c.catalogV1
 }
 
+// ExtV1 retrieves the ExtV1Client
+func (c *Clientset) ExtV1() extv1.ExtV1Interface {
+	return c.extV1
+}
+
 // ProvisioningV1 retrieves the ProvisioningV1Client
 func (c *Clientset) ProvisioningV1() provisioningv1.ProvisioningV1Interface {
 	return c.provisioningV1
@@ -124,6 +132,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.extV1, err = extv1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.provisioningV1, err = provisioningv1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -162,6 +174,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.catalogV1 = catalogv1.New(c)
+	cs.extV1 = extv1.New(c)
 	cs.provisioningV1 = provisioningv1.New(c)
 	cs.rkeV1 = rkev1.New(c)
 	cs.telemetryV1 = telemetryv1.New(c)
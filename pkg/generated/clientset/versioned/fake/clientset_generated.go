@@ -22,6 +22,8 @@
 	clientset "github.com/rancher/rancher/pkg/generated/clientset/versioned"
 	catalogv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/catalog.cattle.io/v1"
 	fakecatalogv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/catalog.cattle.io/v1/fake"
+	extv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/ext.cattle.io/v1"
+	fakeextv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/ext.cattle.io/v1/fake"
 	provisioningv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/provisioning.cattle.io/v1"
 	fakeprovisioningv1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/provisioning.cattle.io/v1/fake"
 	rkev1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/rke.cattle.io/v1"
@@ -101,6 +103,11 @@ func (c *Clientset) CatalogV1() catalogv1.CatalogV1Interface {
 	return &fakecatalogv1.FakeCatalogV1{Fake: &c.Fake}
 }
 
+// ExtV1 retrieves the ExtV1Client
+func (c *Clientset) ExtV1() extv1.ExtV1Interface {
+	return &fakeextv1.FakeExtV1{Fake: &c.Fake}
+}
+
 // ProvisioningV1 retrieves the ProvisioningV1Client
 func (c *Clientset) ProvisioningV1() provisioningv1.ProvisioningV1Interface {
 	return &fakeprovisioningv1.FakeProvisioningV1{Fake: &c.Fake}
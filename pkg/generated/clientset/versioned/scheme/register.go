@@ -20,6 +20,7 @@
 
 import (
 	catalogv1 "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
+	extv1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
 	provisioningv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
 	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
 	telemetryv1 "github.com/rancher/rancher/pkg/apis/telemetry.cattle.io/v1"
@@ -36,6 +37,7 @@
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
 	catalogv1.AddToScheme,
+	extv1.AddToScheme,
 	provisioningv1.AddToScheme,
 	rkev1.AddToScheme,
 	telemetryv1.AddToScheme,
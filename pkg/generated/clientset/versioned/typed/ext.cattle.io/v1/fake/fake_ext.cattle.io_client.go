@@ -0,0 +1,80 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/ext.cattle.io/v1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeExtV1 struct {
+	*testing.Fake
+}
+
+func (c *FakeExtV1) AccountLinkRequests() v1.AccountLinkRequestInterface {
+	return newFakeAccountLinkRequests(c)
+}
+
+func (c *FakeExtV1) GroupMembershipRefreshRequests() v1.GroupMembershipRefreshRequestInterface {
+	return newFakeGroupMembershipRefreshRequests(c)
+}
+
+func (c *FakeExtV1) Kubeconfigs() v1.KubeconfigInterface {
+	return newFakeKubeconfigs(c)
+}
+
+func (c *FakeExtV1) PasswordChangeRequests() v1.PasswordChangeRequestInterface {
+	return newFakePasswordChangeRequests(c)
+}
+
+func (c *FakeExtV1) PrincipalMigrationRequests() v1.PrincipalMigrationRequestInterface {
+	return newFakePrincipalMigrationRequests(c)
+}
+
+func (c *FakeExtV1) SelfUsers() v1.SelfUserInterface {
+	return newFakeSelfUsers(c)
+}
+
+func (c *FakeExtV1) Tokens() v1.TokenInterface {
+	return newFakeTokens(c)
+}
+
+func (c *FakeExtV1) TokenRevocationRequests() v1.TokenRevocationRequestInterface {
+	return newFakeTokenRevocationRequests(c)
+}
+
+func (c *FakeExtV1) UserActivities() v1.UserActivityInterface {
+	return newFakeUserActivities(c)
+}
+
+func (c *FakeExtV1) UserMergeRequests() v1.UserMergeRequestInterface {
+	return newFakeUserMergeRequests(c)
+}
+
+func (c *FakeExtV1) WhoCanRequests() v1.WhoCanRequestInterface {
+	return newFakeWhoCanRequests(c)
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeExtV1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	extcattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/ext.cattle.io/v1"
+	typedextcattleiov1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/ext.cattle.io/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKubeconfigs implements KubeconfigInterface
+type fakeKubeconfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1.Kubeconfig, *v1.KubeconfigList, *extcattleiov1.KubeconfigApplyConfiguration]
+	Fake *FakeExtV1
+}
+
+func newFakeKubeconfigs(fake *FakeExtV1) typedextcattleiov1.KubeconfigInterface {
+	return &fakeKubeconfigs{
+		gentype.NewFakeClientWithListAndApply[*v1.Kubeconfig, *v1.KubeconfigList, *extcattleiov1.KubeconfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("kubeconfigs"),
+			v1.SchemeGroupVersion.WithKind("Kubeconfig"),
+			func() *v1.Kubeconfig { return &v1.Kubeconfig{} },
+			func() *v1.KubeconfigList { return &v1.KubeconfigList{} },
+			func(dst, src *v1.KubeconfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.KubeconfigList) []*v1.Kubeconfig { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.KubeconfigList, items []*v1.Kubeconfig) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	extcattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/ext.cattle.io/v1"
+	typedextcattleiov1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/ext.cattle.io/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeTokens implements TokenInterface
+type fakeTokens struct {
+	*gentype.FakeClientWithListAndApply[*v1.Token, *v1.TokenList, *extcattleiov1.TokenApplyConfiguration]
+	Fake *FakeExtV1
+}
+
+func newFakeTokens(fake *FakeExtV1) typedextcattleiov1.TokenInterface {
+	return &fakeTokens{
+		gentype.NewFakeClientWithListAndApply[*v1.Token, *v1.TokenList, *extcattleiov1.TokenApplyConfiguration](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("tokens"),
+			v1.SchemeGroupVersion.WithKind("Token"),
+			func() *v1.Token { return &v1.Token{} },
+			func() *v1.TokenList { return &v1.TokenList{} },
+			func(dst, src *v1.TokenList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.TokenList) []*v1.Token { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.TokenList, items []*v1.Token) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
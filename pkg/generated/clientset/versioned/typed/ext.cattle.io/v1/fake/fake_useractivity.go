@@ -0,0 +1,44 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	extcattleiov1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/ext.cattle.io/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeUserActivities implements UserActivityInterface
+type fakeUserActivities struct {
+	*gentype.FakeClient[*v1.UserActivity]
+	Fake *FakeExtV1
+}
+
+func newFakeUserActivities(fake *FakeExtV1) extcattleiov1.UserActivityInterface {
+	return &fakeUserActivities{
+		gentype.NewFakeClient[*v1.UserActivity](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("useractivities"),
+			v1.SchemeGroupVersion.WithKind("UserActivity"),
+			func() *v1.UserActivity { return &v1.UserActivity{} },
+		),
+		fake,
+	}
+}
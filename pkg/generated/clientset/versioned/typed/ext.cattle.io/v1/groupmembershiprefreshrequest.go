@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	extcattleiov1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	scheme "github.com/rancher/rancher/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// GroupMembershipRefreshRequestsGetter has a method to return a GroupMembershipRefreshRequestInterface.
+// A group's client should implement this interface.
+type GroupMembershipRefreshRequestsGetter interface {
+	GroupMembershipRefreshRequests() GroupMembershipRefreshRequestInterface
+}
+
+// GroupMembershipRefreshRequestInterface has methods to work with GroupMembershipRefreshRequest resources.
+type GroupMembershipRefreshRequestInterface interface {
+	Create(ctx context.Context, groupMembershipRefreshRequest *extcattleiov1.GroupMembershipRefreshRequest, opts metav1.CreateOptions) (*extcattleiov1.GroupMembershipRefreshRequest, error)
+	GroupMembershipRefreshRequestExpansion
+}
+
+// groupMembershipRefreshRequests implements GroupMembershipRefreshRequestInterface
+type groupMembershipRefreshRequests struct {
+	*gentype.Client[*extcattleiov1.GroupMembershipRefreshRequest]
+}
+
+// newGroupMembershipRefreshRequests returns a GroupMembershipRefreshRequests
+func newGroupMembershipRefreshRequests(c *ExtV1Client) *groupMembershipRefreshRequests {
+	return &groupMembershipRefreshRequests{
+		gentype.NewClient[*extcattleiov1.GroupMembershipRefreshRequest](
+			"groupmembershiprefreshrequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *extcattleiov1.GroupMembershipRefreshRequest {
+				return &extcattleiov1.GroupMembershipRefreshRequest{}
+			},
+		),
+	}
+}
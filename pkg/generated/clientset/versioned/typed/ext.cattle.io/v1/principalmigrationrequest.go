@@ -0,0 +1,58 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	extcattleiov1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	scheme "github.com/rancher/rancher/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// PrincipalMigrationRequestsGetter has a method to return a PrincipalMigrationRequestInterface.
+// A group's client should implement this interface.
+type PrincipalMigrationRequestsGetter interface {
+	PrincipalMigrationRequests() PrincipalMigrationRequestInterface
+}
+
+// PrincipalMigrationRequestInterface has methods to work with PrincipalMigrationRequest resources.
+type PrincipalMigrationRequestInterface interface {
+	Create(ctx context.Context, principalMigrationRequest *extcattleiov1.PrincipalMigrationRequest, opts metav1.CreateOptions) (*extcattleiov1.PrincipalMigrationRequest, error)
+	PrincipalMigrationRequestExpansion
+}
+
+// principalMigrationRequests implements PrincipalMigrationRequestInterface
+type principalMigrationRequests struct {
+	*gentype.Client[*extcattleiov1.PrincipalMigrationRequest]
+}
+
+// newPrincipalMigrationRequests returns a PrincipalMigrationRequests
+func newPrincipalMigrationRequests(c *ExtV1Client) *principalMigrationRequests {
+	return &principalMigrationRequests{
+		gentype.NewClient[*extcattleiov1.PrincipalMigrationRequest](
+			"principalmigrationrequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *extcattleiov1.PrincipalMigrationRequest { return &extcattleiov1.PrincipalMigrationRequest{} },
+		),
+	}
+}
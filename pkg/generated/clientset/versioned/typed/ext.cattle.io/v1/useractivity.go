@@ -0,0 +1,59 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	extcattleiov1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	scheme "github.com/rancher/rancher/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// UserActivitiesGetter has a method to return a UserActivityInterface.
+// A group's client should implement this interface.
+type UserActivitiesGetter interface {
+	UserActivities() UserActivityInterface
+}
+
+// UserActivityInterface has methods to work with UserActivity resources.
+type UserActivityInterface interface {
+	Create(ctx context.Context, userActivity *extcattleiov1.UserActivity, opts metav1.CreateOptions) (*extcattleiov1.UserActivity, error)
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*extcattleiov1.UserActivity, error)
+	UserActivityExpansion
+}
+
+// userActivities implements UserActivityInterface
+type userActivities struct {
+	*gentype.Client[*extcattleiov1.UserActivity]
+}
+
+// newUserActivities returns a UserActivities
+func newUserActivities(c *ExtV1Client) *userActivities {
+	return &userActivities{
+		gentype.NewClient[*extcattleiov1.UserActivity](
+			"useractivities",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *extcattleiov1.UserActivity { return &extcattleiov1.UserActivity{} },
+		),
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	extcattleiov1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	scheme "github.com/rancher/rancher/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// UserMergeRequestsGetter has a method to return a UserMergeRequestInterface.
+// A group's client should implement this interface.
+type UserMergeRequestsGetter interface {
+	UserMergeRequests() UserMergeRequestInterface
+}
+
+// UserMergeRequestInterface has methods to work with UserMergeRequest resources.
+type UserMergeRequestInterface interface {
+	Create(ctx context.Context, userMergeRequest *extcattleiov1.UserMergeRequest, opts metav1.CreateOptions) (*extcattleiov1.UserMergeRequest, error)
+	UserMergeRequestExpansion
+}
+
+// userMergeRequests implements UserMergeRequestInterface
+type userMergeRequests struct {
+	*gentype.Client[*extcattleiov1.UserMergeRequest]
+}
+
+// newUserMergeRequests returns a UserMergeRequests
+func newUserMergeRequests(c *ExtV1Client) *userMergeRequests {
+	return &userMergeRequests{
+		gentype.NewClient[*extcattleiov1.UserMergeRequest](
+			"usermergerequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *extcattleiov1.UserMergeRequest { return &extcattleiov1.UserMergeRequest{} },
+		),
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	extcattleiov1 "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1"
+	scheme "github.com/rancher/rancher/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// WhoCanRequestsGetter has a method to return a WhoCanRequestInterface.
+// A group's client should implement this interface.
+type WhoCanRequestsGetter interface {
+	WhoCanRequests() WhoCanRequestInterface
+}
+
+// WhoCanRequestInterface has methods to work with WhoCanRequest resources.
+type WhoCanRequestInterface interface {
+	Create(ctx context.Context, whoCanRequest *extcattleiov1.WhoCanRequest, opts metav1.CreateOptions) (*extcattleiov1.WhoCanRequest, error)
+	WhoCanRequestExpansion
+}
+
+// whoCanRequests implements WhoCanRequestInterface
+type whoCanRequests struct {
+	*gentype.Client[*extcattleiov1.WhoCanRequest]
+}
+
+// newWhoCanRequests returns a WhoCanRequests
+func newWhoCanRequests(c *ExtV1Client) *whoCanRequests {
+	return &whoCanRequests{
+		gentype.NewClient[*extcattleiov1.WhoCanRequest](
+			"whocanrequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *extcattleiov1.WhoCanRequest { return &extcattleiov1.WhoCanRequest{} },
+		),
+	}
+}
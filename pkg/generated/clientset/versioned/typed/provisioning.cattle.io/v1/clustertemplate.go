@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	provisioningcattleiov1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	applyconfigurationprovisioningcattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/provisioning.cattle.io/v1"
+	scheme "github.com/rancher/rancher/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ClusterTemplatesGetter has a method to return a ClusterTemplateInterface.
+// A group's client should implement this interface.
+type ClusterTemplatesGetter interface {
+	ClusterTemplates(namespace string) ClusterTemplateInterface
+}
+
+// ClusterTemplateInterface has methods to work with ClusterTemplate resources.
+type ClusterTemplateInterface interface {
+	Create(ctx context.Context, clusterTemplate *provisioningcattleiov1.ClusterTemplate, opts metav1.CreateOptions) (*provisioningcattleiov1.ClusterTemplate, error)
+	Update(ctx context.Context, clusterTemplate *provisioningcattleiov1.ClusterTemplate, opts metav1.UpdateOptions) (*provisioningcattleiov1.ClusterTemplate, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*provisioningcattleiov1.ClusterTemplate, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*provisioningcattleiov1.ClusterTemplateList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *provisioningcattleiov1.ClusterTemplate, err error)
+	Apply(ctx context.Context, clusterTemplate *applyconfigurationprovisioningcattleiov1.ClusterTemplateApplyConfiguration, opts metav1.ApplyOptions) (result *provisioningcattleiov1.ClusterTemplate, err error)
+	ClusterTemplateExpansion
+}
+
+// clusterTemplates implements ClusterTemplateInterface
+type clusterTemplates struct {
+	*gentype.ClientWithListAndApply[*provisioningcattleiov1.ClusterTemplate, *provisioningcattleiov1.ClusterTemplateList, *applyconfigurationprovisioningcattleiov1.ClusterTemplateApplyConfiguration]
+}
+
+// newClusterTemplates returns a ClusterTemplates
+func newClusterTemplates(c *ProvisioningV1Client, namespace string) *clusterTemplates {
+	return &clusterTemplates{
+		gentype.NewClientWithListAndApply[*provisioningcattleiov1.ClusterTemplate, *provisioningcattleiov1.ClusterTemplateList, *applyconfigurationprovisioningcattleiov1.ClusterTemplateApplyConfiguration](
+			"clustertemplates",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *provisioningcattleiov1.ClusterTemplate { return &provisioningcattleiov1.ClusterTemplate{} },
+			func() *provisioningcattleiov1.ClusterTemplateList {
+				return &provisioningcattleiov1.ClusterTemplateList{}
+			},
+		),
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	provisioningcattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/provisioning.cattle.io/v1"
+	typedprovisioningcattleiov1 "github.com/rancher/rancher/pkg/generated/clientset/versioned/typed/provisioning.cattle.io/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakePrivateRegistries implements PrivateRegistryInterface
+type fakePrivateRegistries struct {
+	*gentype.FakeClientWithListAndApply[*v1.PrivateRegistry, *v1.PrivateRegistryList, *provisioningcattleiov1.PrivateRegistryApplyConfiguration]
+	Fake *FakeProvisioningV1
+}
+
+func newFakePrivateRegistries(fake *FakeProvisioningV1, namespace string) typedprovisioningcattleiov1.PrivateRegistryInterface {
+	return &fakePrivateRegistries{
+		gentype.NewFakeClientWithListAndApply[*v1.PrivateRegistry, *v1.PrivateRegistryList, *provisioningcattleiov1.PrivateRegistryApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("privateregistries"),
+			v1.SchemeGroupVersion.WithKind("PrivateRegistry"),
+			func() *v1.PrivateRegistry { return &v1.PrivateRegistry{} },
+			func() *v1.PrivateRegistryList { return &v1.PrivateRegistryList{} },
+			func(dst, src *v1.PrivateRegistryList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.PrivateRegistryList) []*v1.PrivateRegistry { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.PrivateRegistryList, items []*v1.PrivateRegistry) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -1,5 +1,5 @@
 /*
-Copyright 2025 Rancher Labs, Inc.
+Copyright 2026 Rancher Labs, Inc.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -14,7 +14,7 @@
 limitations under the License.
 */
 
-// Code generated by main. DO NOT EDIT.
+// Code generated by client-gen. DO NOT EDIT.
 
 package fake
 
@@ -32,6 +32,14 @@ func (c *FakeProvisioningV1) Clusters(namespace string) v1.ClusterInterface {
 	return newFakeClusters(c, namespace)
 }
 
+func (c *FakeProvisioningV1) ClusterTemplates(namespace string) v1.ClusterTemplateInterface {
+	return newFakeClusterTemplates(c, namespace)
+}
+
+func (c *FakeProvisioningV1) PrivateRegistries(namespace string) v1.PrivateRegistryInterface {
+	return newFakePrivateRegistries(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeProvisioningV1) RESTClient() rest.Interface {
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Rancher Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	provisioningcattleiov1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	applyconfigurationprovisioningcattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/provisioning.cattle.io/v1"
+	scheme "github.com/rancher/rancher/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// PrivateRegistriesGetter has a method to return a PrivateRegistryInterface.
+// A group's client should implement this interface.
+type PrivateRegistriesGetter interface {
+	PrivateRegistries(namespace string) PrivateRegistryInterface
+}
+
+// PrivateRegistryInterface has methods to work with PrivateRegistry resources.
+type PrivateRegistryInterface interface {
+	Create(ctx context.Context, privateRegistry *provisioningcattleiov1.PrivateRegistry, opts metav1.CreateOptions) (*provisioningcattleiov1.PrivateRegistry, error)
+	Update(ctx context.Context, privateRegistry *provisioningcattleiov1.PrivateRegistry, opts metav1.UpdateOptions) (*provisioningcattleiov1.PrivateRegistry, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*provisioningcattleiov1.PrivateRegistry, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*provisioningcattleiov1.PrivateRegistryList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *provisioningcattleiov1.PrivateRegistry, err error)
+	Apply(ctx context.Context, privateRegistry *applyconfigurationprovisioningcattleiov1.PrivateRegistryApplyConfiguration, opts metav1.ApplyOptions) (result *provisioningcattleiov1.PrivateRegistry, err error)
+	PrivateRegistryExpansion
+}
+
+// privateRegistries implements PrivateRegistryInterface
+type privateRegistries struct {
+	*gentype.ClientWithListAndApply[*provisioningcattleiov1.PrivateRegistry, *provisioningcattleiov1.PrivateRegistryList, *applyconfigurationprovisioningcattleiov1.PrivateRegistryApplyConfiguration]
+}
+
+// newPrivateRegistries returns a PrivateRegistries
+func newPrivateRegistries(c *ProvisioningV1Client, namespace string) *privateRegistries {
+	return &privateRegistries{
+		gentype.NewClientWithListAndApply[*provisioningcattleiov1.PrivateRegistry, *provisioningcattleiov1.PrivateRegistryList, *applyconfigurationprovisioningcattleiov1.PrivateRegistryApplyConfiguration](
+			"privateregistries",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *provisioningcattleiov1.PrivateRegistry { return &provisioningcattleiov1.PrivateRegistry{} },
+			func() *provisioningcattleiov1.PrivateRegistryList {
+				return &provisioningcattleiov1.PrivateRegistryList{}
+			},
+		),
+	}
+}
@@ -1,5 +1,5 @@
 /*
-Copyright 2025 Rancher Labs, Inc.
+Copyright 2026 Rancher Labs, Inc.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -14,7 +14,7 @@
 limitations under the License.
 */
 
-// Code generated by main. DO NOT EDIT.
+// Code generated by client-gen. DO NOT EDIT.
 
 package v1
 
@@ -29,6 +29,8 @@
 type ProvisioningV1Interface interface {
 	RESTClient() rest.Interface
 	ClustersGetter
+	ClusterTemplatesGetter
+	PrivateRegistriesGetter
 }
 
 // ProvisioningV1Client is used to interact with features provided by the provisioning.cattle.io group.
@@ -40,6 +42,14 @@ func (c *ProvisioningV1Client) Clusters(namespace string) ClusterInterface {
 	return newClusters(c, namespace)
 }
 
+func (c *ProvisioningV1Client) ClusterTemplates(namespace string) ClusterTemplateInterface {
+	return newClusterTemplates(c, namespace)
+}
+
+func (c *ProvisioningV1Client) PrivateRegistries(namespace string) PrivateRegistryInterface {
+	return newPrivateRegistries(c, namespace)
+}
+
 // NewForConfig creates a new ProvisioningV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
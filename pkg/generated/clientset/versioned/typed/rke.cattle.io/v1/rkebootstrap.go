@@ -1,5 +1,5 @@
 /*
-Copyright 2025 Rancher Labs, Inc.
+Copyright 2026 Rancher Labs, Inc.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -14,7 +14,7 @@
 limitations under the License.
 */
 
-// Code generated by main. DO NOT EDIT.
+// Code generated by client-gen. DO NOT EDIT.
 
 package v1
 
@@ -22,6 +22,7 @@
 	context "context"
 
 	rkecattleiov1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	applyconfigurationrkecattleiov1 "github.com/rancher/rancher/pkg/generated/applyconfiguration/rke.cattle.io/v1"
 	scheme "github.com/rancher/rancher/pkg/generated/clientset/versioned/scheme"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,18 +48,21 @@ type RKEBootstrapInterface interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*rkecattleiov1.RKEBootstrapList, error)
 	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *rkecattleiov1.RKEBootstrap, err error)
+	Apply(ctx context.Context, rKEBootstrap *applyconfigurationrkecattleiov1.RKEBootstrapApplyConfiguration, opts metav1.ApplyOptions) (result *rkecattleiov1.RKEBootstrap, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, rKEBootstrap *applyconfigurationrkecattleiov1.RKEBootstrapApplyConfiguration, opts metav1.ApplyOptions) (result *rkecattleiov1.RKEBootstrap, err error)
 	RKEBootstrapExpansion
 }
 
 // rKEBootstraps implements RKEBootstrapInterface
 type rKEBootstraps struct {
-	*gentype.ClientWithList[*rkecattleiov1.RKEBootstrap, *rkecattleiov1.RKEBootstrapList]
+	*gentype.ClientWithListAndApply[*rkecattleiov1.RKEBootstrap, *rkecattleiov1.RKEBootstrapList, *applyconfigurationrkecattleiov1.RKEBootstrapApplyConfiguration]
 }
 
 // newRKEBootstraps returns a RKEBootstraps
 func newRKEBootstraps(c *RkeV1Client, namespace string) *rKEBootstraps {
 	return &rKEBootstraps{
-		gentype.NewClientWithList[*rkecattleiov1.RKEBootstrap, *rkecattleiov1.RKEBootstrapList](
+		gentype.NewClientWithListAndApply[*rkecattleiov1.RKEBootstrap, *rkecattleiov1.RKEBootstrapList, *applyconfigurationrkecattleiov1.RKEBootstrapApplyConfiguration](
 			"rkebootstraps",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -31,12 +31,17 @@ func init() {
 }
 
 type Interface interface {
+	AccountLinkRequest() AccountLinkRequestController
 	GroupMembershipRefreshRequest() GroupMembershipRefreshRequestController
 	Kubeconfig() KubeconfigController
 	PasswordChangeRequest() PasswordChangeRequestController
+	PrincipalMigrationRequest() PrincipalMigrationRequestController
 	SelfUser() SelfUserController
 	Token() TokenController
+	TokenRevocationRequest() TokenRevocationRequestController
 	UserActivity() UserActivityController
+	UserMergeRequest() UserMergeRequestController
+	WhoCanRequest() WhoCanRequestController
 }
 
 func New(controllerFactory controller.SharedControllerFactory) Interface {
@@ -49,6 +54,10 @@ type version struct {
 	controllerFactory controller.SharedControllerFactory
 }
 
+func (v *version) AccountLinkRequest() AccountLinkRequestController {
+	return generic.NewNonNamespacedController[*v1.AccountLinkRequest, *v1.AccountLinkRequestList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "AccountLinkRequest"}, "accountlinkrequests", v.controllerFactory)
+}
+
 func (v *version) GroupMembershipRefreshRequest() GroupMembershipRefreshRequestController {
 	return generic.NewController[*v1.GroupMembershipRefreshRequest, *v1.GroupMembershipRefreshRequestList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "GroupMembershipRefreshRequest"}, "groupmembershiprefreshrequests", true, v.controllerFactory)
 }
@@ -61,6 +70,10 @@ func (v *version) PasswordChangeRequest() PasswordChangeRequestController {
 	return generic.NewController[*v1.PasswordChangeRequest, *v1.PasswordChangeRequestList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "PasswordChangeRequest"}, "passwordchangerequests", true, v.controllerFactory)
 }
 
+func (v *version) PrincipalMigrationRequest() PrincipalMigrationRequestController {
+	return generic.NewNonNamespacedController[*v1.PrincipalMigrationRequest, *v1.PrincipalMigrationRequestList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "PrincipalMigrationRequest"}, "principalmigrationrequests", v.controllerFactory)
+}
+
 func (v *version) SelfUser() SelfUserController {
 	return generic.NewController[*v1.SelfUser, *v1.SelfUserList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "SelfUser"}, "selfusers", true, v.controllerFactory)
 }
@@ -69,6 +82,18 @@ func (v *version) Token() TokenController {
 	return generic.NewNonNamespacedController[*v1.Token, *v1.TokenList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "Token"}, "tokens", v.controllerFactory)
 }
 
+func (v *version) TokenRevocationRequest() TokenRevocationRequestController {
+	return generic.NewNonNamespacedController[*v1.TokenRevocationRequest, *v1.TokenRevocationRequestList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "TokenRevocationRequest"}, "tokenrevocationrequests", v.controllerFactory)
+}
+
 func (v *version) UserActivity() UserActivityController {
 	return generic.NewNonNamespacedController[*v1.UserActivity, *v1.UserActivityList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "UserActivity"}, "useractivities", v.controllerFactory)
 }
+
+func (v *version) UserMergeRequest() UserMergeRequestController {
+	return generic.NewNonNamespacedController[*v1.UserMergeRequest, *v1.UserMergeRequestList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "UserMergeRequest"}, "usermergerequests", v.controllerFactory)
+}
+
+func (v *version) WhoCanRequest() WhoCanRequestController {
+	return generic.NewNonNamespacedController[*v1.WhoCanRequest, *v1.WhoCanRequestList](schema.GroupVersionKind{Group: "ext.cattle.io", Version: "v1", Kind: "WhoCanRequest"}, "whocanrequests", v.controllerFactory)
+}
@@ -32,11 +32,13 @@ func init() {
 
 type Interface interface {
 	APIService() APIServiceController
+	APIUsageReport() APIUsageReportController
 	ActiveDirectoryProvider() ActiveDirectoryProviderController
 	AuthConfig() AuthConfigController
 	AuthProvider() AuthProviderController
 	AuthToken() AuthTokenController
 	AzureADProvider() AzureADProviderController
+	BootstrapPrincipal() BootstrapPrincipalController
 	CloudCredential() CloudCredentialController
 	Cluster() ClusterController
 	ClusterProxyConfig() ClusterProxyConfigController
@@ -55,12 +57,14 @@ type Interface interface {
 	GithubProvider() GithubProviderController
 	GlobalRole() GlobalRoleController
 	GlobalRoleBinding() GlobalRoleBindingController
+	GlobalRoleGroupMapping() GlobalRoleGroupMappingController
 	GoogleOAuthProvider() GoogleOAuthProviderController
 	Group() GroupController
 	GroupMember() GroupMemberController
 	KontainerDriver() KontainerDriverController
 	LocalProvider() LocalProviderController
 	ManagedChart() ManagedChartController
+	ManagedSetting() ManagedSettingController
 	Node() NodeController
 	NodeDriver() NodeDriverController
 	NodePool() NodePoolController
@@ -69,6 +73,7 @@ type Interface interface {
 	OIDCProvider() OIDCProviderController
 	OpenLdapProvider() OpenLdapProviderController
 	PodSecurityAdmissionConfigurationTemplate() PodSecurityAdmissionConfigurationTemplateController
+	PodSecurityAdmissionProfile() PodSecurityAdmissionProfileController
 	Preference() PreferenceController
 	Principal() PrincipalController
 	Project() ProjectController
@@ -79,6 +84,7 @@ type Interface interface {
 	RkeK8sServiceOption() RkeK8sServiceOptionController
 	RkeK8sSystemImage() RkeK8sSystemImageController
 	RoleTemplate() RoleTemplateController
+	RoleTemplatePolicy() RoleTemplatePolicyController
 	SamlProvider() SamlProviderController
 	SamlToken() SamlTokenController
 	Setting() SettingController
@@ -101,6 +107,10 @@ func (v *version) APIService() APIServiceController {
 	return generic.NewNonNamespacedController[*v3.APIService, *v3.APIServiceList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "APIService"}, "apiservices", v.controllerFactory)
 }
 
+func (v *version) APIUsageReport() APIUsageReportController {
+	return generic.NewNonNamespacedController[*v3.APIUsageReport, *v3.APIUsageReportList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "APIUsageReport"}, "apiusagereports", v.controllerFactory)
+}
+
 func (v *version) ActiveDirectoryProvider() ActiveDirectoryProviderController {
 	return generic.NewNonNamespacedController[*v3.ActiveDirectoryProvider, *v3.ActiveDirectoryProviderList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ActiveDirectoryProvider"}, "activedirectoryproviders", v.controllerFactory)
 }
@@ -121,6 +131,10 @@ func (v *version) AzureADProvider() AzureADProviderController {
 	return generic.NewNonNamespacedController[*v3.AzureADProvider, *v3.AzureADProviderList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "AzureADProvider"}, "azureadproviders", v.controllerFactory)
 }
 
+func (v *version) BootstrapPrincipal() BootstrapPrincipalController {
+	return generic.NewNonNamespacedController[*v3.BootstrapPrincipal, *v3.BootstrapPrincipalList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "BootstrapPrincipal"}, "bootstrapprincipals", v.controllerFactory)
+}
+
 func (v *version) CloudCredential() CloudCredentialController {
 	return generic.NewController[*v3.CloudCredential, *v3.CloudCredentialList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "CloudCredential"}, "cloudcredentials", true, v.controllerFactory)
 }
@@ -193,6 +207,10 @@ func (v *version) GlobalRoleBinding() GlobalRoleBindingController {
 	return generic.NewNonNamespacedController[*v3.GlobalRoleBinding, *v3.GlobalRoleBindingList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "GlobalRoleBinding"}, "globalrolebindings", v.controllerFactory)
 }
 
+func (v *version) GlobalRoleGroupMapping() GlobalRoleGroupMappingController {
+	return generic.NewNonNamespacedController[*v3.GlobalRoleGroupMapping, *v3.GlobalRoleGroupMappingList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "GlobalRoleGroupMapping"}, "globalrolegroupmappings", v.controllerFactory)
+}
+
 func (v *version) GoogleOAuthProvider() GoogleOAuthProviderController {
 	return generic.NewNonNamespacedController[*v3.GoogleOAuthProvider, *v3.GoogleOAuthProviderList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "GoogleOAuthProvider"}, "googleoauthproviders", v.controllerFactory)
 }
@@ -217,6 +235,10 @@ func (v *version) ManagedChart() ManagedChartController {
 	return generic.NewController[*v3.ManagedChart, *v3.ManagedChartList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ManagedChart"}, "managedcharts", true, v.controllerFactory)
 }
 
+func (v *version) ManagedSetting() ManagedSettingController {
+	return generic.NewNonNamespacedController[*v3.ManagedSetting, *v3.ManagedSettingList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ManagedSetting"}, "managedsettings", v.controllerFactory)
+}
+
 func (v *version) Node() NodeController {
 	return generic.NewController[*v3.Node, *v3.NodeList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "Node"}, "nodes", true, v.controllerFactory)
 }
@@ -249,6 +271,10 @@ func (v *version) PodSecurityAdmissionConfigurationTemplate() PodSecurityAdmissi
 	return generic.NewNonNamespacedController[*v3.PodSecurityAdmissionConfigurationTemplate, *v3.PodSecurityAdmissionConfigurationTemplateList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "PodSecurityAdmissionConfigurationTemplate"}, "podsecurityadmissionconfigurationtemplates", v.controllerFactory)
 }
 
+func (v *version) PodSecurityAdmissionProfile() PodSecurityAdmissionProfileController {
+	return generic.NewNonNamespacedController[*v3.PodSecurityAdmissionProfile, *v3.PodSecurityAdmissionProfileList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "PodSecurityAdmissionProfile"}, "podsecurityadmissionprofiles", v.controllerFactory)
+}
+
 func (v *version) Preference() PreferenceController {
 	return generic.NewController[*v3.Preference, *v3.PreferenceList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "Preference"}, "preferences", true, v.controllerFactory)
 }
@@ -289,6 +315,10 @@ func (v *version) RoleTemplate() RoleTemplateController {
 	return generic.NewNonNamespacedController[*v3.RoleTemplate, *v3.RoleTemplateList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "RoleTemplate"}, "roletemplates", v.controllerFactory)
 }
 
+func (v *version) RoleTemplatePolicy() RoleTemplatePolicyController {
+	return generic.NewNonNamespacedController[*v3.RoleTemplatePolicy, *v3.RoleTemplatePolicyList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "RoleTemplatePolicy"}, "roletemplatepolicies", v.controllerFactory)
+}
+
 func (v *version) SamlProvider() SamlProviderController {
 	return generic.NewNonNamespacedController[*v3.SamlProvider, *v3.SamlProviderList](schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "SamlProvider"}, "samlproviders", v.controllerFactory)
 }
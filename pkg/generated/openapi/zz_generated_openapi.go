@@ -29,6 +29,10 @@
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequest":                  schema_pkg_apis_extcattleio_v1_AccountLinkRequest(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequestList":              schema_pkg_apis_extcattleio_v1_AccountLinkRequestList(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequestSpec":              schema_pkg_apis_extcattleio_v1_AccountLinkRequestSpec(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequestStatus":            schema_pkg_apis_extcattleio_v1_AccountLinkRequestStatus(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.GroupMembershipRefreshRequest":       schema_pkg_apis_extcattleio_v1_GroupMembershipRefreshRequest(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.GroupMembershipRefreshRequestList":   schema_pkg_apis_extcattleio_v1_GroupMembershipRefreshRequestList(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.GroupMembershipRefreshRequestSpec":   schema_pkg_apis_extcattleio_v1_GroupMembershipRefreshRequestSpec(ref),
@@ -41,17 +45,37 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PasswordChangeRequestList":           schema_pkg_apis_extcattleio_v1_PasswordChangeRequestList(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PasswordChangeRequestSpec":           schema_pkg_apis_extcattleio_v1_PasswordChangeRequestSpec(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PasswordChangeRequestStatus":         schema_pkg_apis_extcattleio_v1_PasswordChangeRequestStatus(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequest":           schema_pkg_apis_extcattleio_v1_PrincipalMigrationRequest(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequestList":       schema_pkg_apis_extcattleio_v1_PrincipalMigrationRequestList(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequestSpec":       schema_pkg_apis_extcattleio_v1_PrincipalMigrationRequestSpec(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequestStatus":     schema_pkg_apis_extcattleio_v1_PrincipalMigrationRequestStatus(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUser":                            schema_pkg_apis_extcattleio_v1_SelfUser(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUserList":                        schema_pkg_apis_extcattleio_v1_SelfUserList(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUserStatus":                      schema_pkg_apis_extcattleio_v1_SelfUserStatus(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.Token":                               schema_pkg_apis_extcattleio_v1_Token(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenList":                           schema_pkg_apis_extcattleio_v1_TokenList(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenPrincipal":                      schema_pkg_apis_extcattleio_v1_TokenPrincipal(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequest":              schema_pkg_apis_extcattleio_v1_TokenRevocationRequest(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequestList":          schema_pkg_apis_extcattleio_v1_TokenRevocationRequestList(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequestSpec":          schema_pkg_apis_extcattleio_v1_TokenRevocationRequestSpec(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequestStatus":        schema_pkg_apis_extcattleio_v1_TokenRevocationRequestStatus(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenSpec":                           schema_pkg_apis_extcattleio_v1_TokenSpec(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenStatus":                         schema_pkg_apis_extcattleio_v1_TokenStatus(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivity":                        schema_pkg_apis_extcattleio_v1_UserActivity(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivityList":                    schema_pkg_apis_extcattleio_v1_UserActivityList(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivitySpec":                    schema_pkg_apis_extcattleio_v1_UserActivitySpec(ref),
 		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivityStatus":                  schema_pkg_apis_extcattleio_v1_UserActivityStatus(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivityTokenStatus":             schema_pkg_apis_extcattleio_v1_UserActivityTokenStatus(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequest":                    schema_pkg_apis_extcattleio_v1_UserMergeRequest(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequestList":                schema_pkg_apis_extcattleio_v1_UserMergeRequestList(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequestSpec":                schema_pkg_apis_extcattleio_v1_UserMergeRequestSpec(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequestStatus":              schema_pkg_apis_extcattleio_v1_UserMergeRequestStatus(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequest":                       schema_pkg_apis_extcattleio_v1_WhoCanRequest(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestBinding":                schema_pkg_apis_extcattleio_v1_WhoCanRequestBinding(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestList":                   schema_pkg_apis_extcattleio_v1_WhoCanRequestList(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestSpec":                   schema_pkg_apis_extcattleio_v1_WhoCanRequestSpec(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestStatus":                 schema_pkg_apis_extcattleio_v1_WhoCanRequestStatus(ref),
+		"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestSubject":                schema_pkg_apis_extcattleio_v1_WhoCanRequestSubject(ref),
 		"github.com/rancher/rancher/pkg/apis/telemetry.cattle.io/v1.SecretRequest":                 schema_pkg_apis_telemetrycattleio_v1_SecretRequest(ref),
 		"github.com/rancher/rancher/pkg/apis/telemetry.cattle.io/v1.SecretRequestList":             schema_pkg_apis_telemetrycattleio_v1_SecretRequestList(ref),
 		"github.com/rancher/rancher/pkg/apis/telemetry.cattle.io/v1.SecretRequestSpec":             schema_pkg_apis_telemetrycattleio_v1_SecretRequestSpec(ref),
@@ -112,6 +136,224 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_pkg_apis_extcattleio_v1_AccountLinkRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AccountLinkRequest is used to link an external principal, or a duplicate account, to an existing user, e.g. to move a local user to an external auth provider or the other way around.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the AccountLinkRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the most recently observed status of the AccountLinkRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequestStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequestSpec", "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_AccountLinkRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AccountLinkRequestList is a list of AccountLinkRequest resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequest"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.AccountLinkRequest", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_AccountLinkRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AccountLinkRequestSpec selects the user to keep and what is linked to it. At least one of PrincipalID and DuplicateUserID must be given.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the user to link to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"principalID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrincipalID is the external principal to link to the user. The user owning the principal, if any, is the duplicate user.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duplicateUserID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DuplicateUserID is the duplicate account to merge into the user. Its principals, except its local one, bindings and tokens are transferred to the user, and it is deactivated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"userID"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_AccountLinkRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AccountLinkRequestStatus defines the most recently observed status of the AccountLinkRequest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions indicate state for particular aspects of the AccountLinkRequest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary of the AccountLinkRequest status.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"linkedPrincipalIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LinkedPrincipalIDs are the principal IDs linked to the user.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"bindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bindings is the number of bindings transferred to the user.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"tokens": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tokens is the number of tokens transferred to the user.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed lists the bindings and tokens which could not be transferred.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"conditions", "bindings", "tokens"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
 func schema_pkg_apis_extcattleio_v1_GroupMembershipRefreshRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -659,11 +901,11 @@ func schema_pkg_apis_extcattleio_v1_PasswordChangeRequestStatus(ref common.Refer
 	}
 }
 
-func schema_pkg_apis_extcattleio_v1_SelfUser(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_extcattleio_v1_PrincipalMigrationRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SelfUser is used to retrieve the current user information.",
+				Description: "PrincipalMigrationRequest is used to migrate the cluster and project role template bindings of users from one principal ID prefix to another, e.g. after moving to a different auth provider.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -687,26 +929,33 @@ func schema_pkg_apis_extcattleio_v1_SelfUser(ref common.ReferenceCallback) commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the PrincipalMigrationRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequestSpec"),
+						},
+					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status is the most recently observed state of the Self User.",
+							Description: "Status is the most recently observed status of the PrincipalMigrationRequest.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUserStatus"),
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequestStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUserStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequestSpec", "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_extcattleio_v1_SelfUserList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_extcattleio_v1_PrincipalMigrationRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SelfUserList is a list of SelfUser resources",
+				Description: "PrincipalMigrationRequestList is a list of PrincipalMigrationRequest resources",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -736,7 +985,7 @@ func schema_pkg_apis_extcattleio_v1_SelfUserList(ref common.ReferenceCallback) c
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUser"),
+										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequest"),
 									},
 								},
 							},
@@ -747,69 +996,266 @@ func schema_pkg_apis_extcattleio_v1_SelfUserList(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUser", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.PrincipalMigrationRequest", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_extcattleio_v1_SelfUserStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_extcattleio_v1_PrincipalMigrationRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SelfUserStatus defines the most recently observed status of the SelfUser",
+				Description: "PrincipalMigrationRequestSpec selects the bindings to migrate.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"oldPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OldPrefix is the principal ID prefix the bindings are migrated from, e.g. \"openldap_user://\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"newPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewPrefix is the principal ID prefix the bindings are migrated to, e.g. \"activedirectory_user://\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "UserID restricts the migration to the bindings of the user. All the users are migrated if it's not set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"oldPrefix", "newPrefix"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_extcattleio_v1_Token(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_extcattleio_v1_PrincipalMigrationRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Token is used to authenticate requests to Rancher.",
+				Description: "PrincipalMigrationRequestStatus defines the most recently observed status of the PrincipalMigrationRequest.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Conditions indicate state for particular aspects of the PrincipalMigrationRequest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"summary": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Summary of the PrincipalMigrationRequest status.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"matched": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata Note: Name and GenerateName are not respected. A name is always generated with a predefined prefix.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Matched is the number of bindings selected by the request.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"spec": {
+					"migrated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec is the desired state of the Token.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenSpec"),
+							Description: "Migrated is the number of bindings migrated.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"status": {
+					"failed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status is the most recently observed status of the Token.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenStatus"),
+							Description: "Failed lists the namespaced names of the selected bindings which could not be migrated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"conditions", "matched", "migrated"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_SelfUser(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SelfUser is used to retrieve the current user information.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the most recently observed state of the Self User.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUserStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUserStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_SelfUserList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SelfUserList is a list of SelfUser resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUser"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.SelfUser", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_SelfUserStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SelfUserStatus defines the most recently observed status of the SelfUser",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_Token(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Token is used to authenticate requests to Rancher.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata Note: Name and GenerateName are not respected. A name is always generated with a predefined prefix.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the Token.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the most recently observed status of the Token.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenStatus"),
 						},
 					},
 				},
@@ -964,93 +1410,301 @@ func schema_pkg_apis_extcattleio_v1_TokenPrincipal(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_extcattleio_v1_TokenSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_extcattleio_v1_TokenRevocationRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TokenSpec defines the desired state of the Token.",
+				Description: "TokenRevocationRequest is used to revoke all the tokens matching a selection at once, e.g. after credentials leaked.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"userID": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UserID is the kube resource id of the user owning the token. By default that is the user who owned the token making the request creating this token. Currently this default is enforced, i.e. using a different user is rejected as forbidden.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"userPrincipal": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UserPrincipal holds the information about the ext auth provider managed user (principal) owning the token.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenPrincipal"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the TokenRevocationRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the most recently observed status of the TokenRevocationRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequestStatus"),
 						},
 					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequestSpec", "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_TokenRevocationRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenRevocationRequestList is a list of TokenRevocationRequest resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind describes the nature of the token. The value \"session\" indicates a login/session token. Any other value, including the empty string, which is the default, stands for some kind of derived token.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"description": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Human readable free-form description of the token. For example, its purpose.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ttl": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TTL is the time-to-live of the token, in milliseconds. Setting a value < 0 represents +infinity, i.e. a token which does not expire. The default is indicated by the value `0`. This default is provided by the `auth-token-max-ttl-minutes` setting. Note that this default is also the maximum specifiable TTL. A value <= 0 there enables non-expiring tokens.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"enabled": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates an active token. The default (`null`) indicates an enabled token.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequest"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"userPrincipal"},
+				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenPrincipal"},
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenRevocationRequest", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_extcattleio_v1_TokenStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_extcattleio_v1_TokenRevocationRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TokenStatus defines the most recently observed status of the Token.",
+				Description: "TokenRevocationRequestSpec selects the tokens to revoke. A token is revoked if it matches all the given criteria, at least one of which must be given.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value is the access key. It is shown only on token creation and not saved.",
+							Description: "UserID selects the tokens of the user.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hash": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Hash is the hash of the Value.",
+							Description: "ClusterName selects the tokens scoped to the cluster. Only management.cattle.io tokens can be scoped to a cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"current": {
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Current indicates whether the token was used to authenticate the current request.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "LabelSelector selects the tokens by their labels.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"createdBefore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreatedBefore selects the tokens created before the given time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_TokenRevocationRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenRevocationRequestStatus defines the most recently observed status of the TokenRevocationRequest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions indicate state for particular aspects of the TokenRevocationRequest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary of the TokenRevocationRequest status.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matched": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Matched is the number of tokens selected by the request.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"revoked": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revoked is the number of tokens revoked.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed lists the names of the selected tokens which could not be revoked.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"conditions", "matched", "revoked"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_TokenSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenSpec defines the desired state of the Token.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the kube resource id of the user owning the token. By default that is the user who owned the token making the request creating this token. Currently this default is enforced, i.e. using a different user is rejected as forbidden.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userPrincipal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserPrincipal holds the information about the ext auth provider managed user (principal) owning the token.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenPrincipal"),
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind describes the nature of the token. The value \"session\" indicates a login/session token. Any other value, including the empty string, which is the default, stands for some kind of derived token.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Human readable free-form description of the token. For example, its purpose.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is the time-to-live of the token, in milliseconds. Setting a value < 0 represents +infinity, i.e. a token which does not expire. The default is indicated by the value `0`. This default is provided by the `auth-token-max-ttl-minutes` setting. Note that this default is also the maximum specifiable TTL. A value <= 0 there enables non-expiring tokens.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates an active token. The default (`null`) indicates an enabled token.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"userPrincipal"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.TokenPrincipal"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_TokenStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenStatus defines the most recently observed status of the Token.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the access key. It is shown only on token creation and not saved.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hash is the hash of the Value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"current": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current indicates whether the token was used to authenticate the current request.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 					"expired": {
@@ -1125,6 +1779,13 @@ func schema_pkg_apis_extcattleio_v1_UserActivity(ref common.ReferenceCallback) c
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec identifies the user and the token whose activity is recorded.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivitySpec"),
+						},
+					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Status is the most recently observed status of the UserActivity.",
@@ -1137,7 +1798,7 @@ func schema_pkg_apis_extcattleio_v1_UserActivity(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivityStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivitySpec", "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivityStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1190,6 +1851,48 @@ func schema_pkg_apis_extcattleio_v1_UserActivityList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_extcattleio_v1_UserActivitySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserActivitySpec identifies the user and the token whose activity is recorded. The name of a UserActivity is an opaque hash of both, requests naming the token instead are still accepted for compatibility.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the name of the user whose activity is recorded. It defaults to, and must be, the user making the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenID is the name of the token whose activity is recorded. It defaults to the token authenticating the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"additionalTokenIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalTokenIDs are the names of other tokens of the user whose activity is recorded by the same request, e.g. the sessions of the other tabs of a browser. The outcome for each of them is reported in status.additionalTokens.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_extcattleio_v1_UserActivityStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1199,15 +1902,609 @@ func schema_pkg_apis_extcattleio_v1_UserActivityStatus(ref common.ReferenceCallb
 				Properties: map[string]spec.Schema{
 					"expiresAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExpiresAt is the timestamp at which the user's session expires if it stays idle, invalidating the corresponding session token. It is calculated by adding the duration specified in the auth-user-session-idle-ttl-minutes setting to the time of the request.",
+							Description: "ExpiresAt is the timestamp at which the user's session expires if it stays idle, invalidating the corresponding session token. It is calculated by adding the duration specified in the auth-user-session-idle-ttl-minutes setting to the time of the request. It is serialized in RFC3339 format, in UTC.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"heartbeatIntervalSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HeartbeatIntervalSeconds is the interval, in seconds, at which clients should report the activity of the user. It is computed from the idle timeout of the session token, and reporting activity more often than this is not needed to keep the session alive.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sessionRemainingSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionRemainingSeconds is the remaining lifetime of the session token, in seconds, after which it expires regardless of the activity of the user. It is not set if the session token doesn't expire.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"additionalTokens": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalTokens reports the activity recorded for the additional tokens of the spec, in the same order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivityTokenStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserActivityTokenStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_UserActivityTokenStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserActivityTokenStatus is the activity recorded for an additional token of a UserActivity.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tokenID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenID is the name of the token.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiresAt is the timestamp at which the session of the token expires if it stays idle. It is not set if the activity couldn't be recorded.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is why the activity couldn't be recorded for the token.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"tokenID"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_UserMergeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserMergeRequest is used to merge duplicate accounts, e.g. reported by the auth.cattle.io/duplicate-users annotation, into one user and delete them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the UserMergeRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the most recently observed status of the UserMergeRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequestStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequestSpec", "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_UserMergeRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserMergeRequestList is a list of UserMergeRequest resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequest"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.UserMergeRequest", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_UserMergeRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserMergeRequestSpec selects the user to keep and the duplicate accounts to merge into it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the user to keep.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duplicateUserIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DuplicateUserIDs are the duplicate accounts to merge into the user. Their principals, except their local one, bindings, tokens and preferences are transferred to the user, then they are deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"userID", "duplicateUserIDs"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_UserMergeRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserMergeRequestStatus defines the most recently observed status of the UserMergeRequest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions indicate state for particular aspects of the UserMergeRequest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary of the UserMergeRequest status.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mergedUserIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MergedUserIDs are the duplicate accounts merged into the user and deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"linkedPrincipalIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LinkedPrincipalIDs are the principal IDs linked to the user.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"bindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bindings is the number of bindings transferred to the user.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"tokens": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tokens is the number of tokens transferred to the user.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"preferences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Preferences is the number of preferences copied to the user.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed lists the bindings, tokens and preferences which could not be transferred. A duplicate account is only deleted once all of them were transferred.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"conditions", "bindings", "tokens", "preferences"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_WhoCanRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WhoCanRequest is used to find the users and groups which can perform an action in a cluster or a project, and the bindings allowing them to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the WhoCanRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the most recently observed status of the WhoCanRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestSpec", "github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_WhoCanRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WhoCanRequestList is a list of WhoCanRequest resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequest"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequest", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_WhoCanRequestBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WhoCanRequestBinding is a binding allowing a subject to perform the action.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the binding, e.g. \"ClusterRoleTemplateBinding\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the binding, empty for global role bindings.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the binding.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"roleName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleName is the name of the global role or role template the binding grants.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name", "roleName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_WhoCanRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WhoCanRequestSpec describes the action.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"verb": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verb is the verb of the action, e.g. \"get\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIGroup is the API group of the resource, empty for the core group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource is the resource of the action, optionally with a subresource, e.g. \"pods/log\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the resource. Any name is considered if it's not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the resource, empty for cluster scoped resources.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterName is the name of the cluster the action is performed in, e.g. \"local\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"projectName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectName is the name of the project of the namespace, e.g. \"p-xxxxx\". The project role template bindings are only considered if it's set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"verb", "resource", "clusterName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_WhoCanRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WhoCanRequestStatus defines the most recently observed status of the WhoCanRequest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"subjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subjects are the users and groups which can perform the action, sorted by kind and name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestSubject"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestSubject"},
+	}
+}
+
+func schema_pkg_apis_extcattleio_v1_WhoCanRequestSubject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WhoCanRequestSubject is a user or a group which can perform the action.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the subject, \"User\" or \"Group\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the user, or its principal ID if the bindings only name its principal, or the principal ID of the group.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bindings are the bindings allowing the subject to perform the action.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestBinding"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kind", "name", "bindings"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/rancher/rancher/pkg/apis/ext.cattle.io/v1.WhoCanRequestBinding"},
 	}
 }
 
@@ -0,0 +1,101 @@
+package image
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/rancher/pkg/settings"
+)
+
+// Cluster types whose agent images can be listed.
+const (
+	ClusterTypeRKE2     = "rke2"
+	ClusterTypeK3s      = "k3s"
+	ClusterTypeImported = "imported"
+)
+
+// ErrInvalidAgentImagesConfig is returned by GetAgentImages for invalid configs.
+var ErrInvalidAgentImagesConfig = errors.New("invalid agent images config")
+
+// AgentSystemCharts are the charts providing the images of the system components Rancher deploys to downstream
+// clusters. The fleet chart provides the image of the fleet agent.
+var AgentSystemCharts = []string{"fleet", "rancher-webhook", "system-upgrade-controller"}
+
+// AgentImagesConfig provides the parameters of the listing of the images needed by the agents of a downstream cluster.
+type AgentImagesConfig struct {
+	// RancherVersion is the version of Rancher the cluster is managed by. Defaults to the running version.
+	RancherVersion string
+	// ClusterType is either rke2, k3s or imported.
+	ClusterType string
+	// KubernetesVersion is the RKE2/K3s version of the cluster, required for rke2 and k3s clusters.
+	KubernetesVersion string
+	// ChartsPath is the path of the Rancher charts repository the system charts are read from. System chart images
+	// aren't listed if empty.
+	ChartsPath string
+	// Registry is the registry the images are pulled from, e.g. the agent image registry of a cluster.
+	Registry string
+}
+
+// GetAgentImages returns the sorted list of the images needed by the agents and system charts of a downstream cluster,
+// for air-gapped installs and upgrades. The images of the RKE2/K3s distributions themselves are provided by their
+// own air-gap artifacts and aren't listed.
+func GetAgentImages(config AgentImagesConfig) ([]string, error) {
+	imagesSet := make(map[string]map[string]struct{})
+
+	switch config.ClusterType {
+	case ClusterTypeRKE2, ClusterTypeK3s:
+		if config.KubernetesVersion == "" {
+			return nil, fmt.Errorf("%w: kubernetes version is required for %s clusters", ErrInvalidAgentImagesConfig, config.ClusterType)
+		}
+		if installer := settings.SystemAgentInstallerImage.Get(); installer != "" {
+			addSourceToImage(imagesSet, installer+config.ClusterType+":"+strings.ReplaceAll(config.KubernetesVersion, "+", "-"), "systemAgent")
+		}
+		addSourceToImage(imagesSet, settings.SystemAgentUpgradeImage.Get(), "systemAgent")
+		if config.ClusterType == ClusterTypeRKE2 {
+			addSourceToImage(imagesSet, settings.WinsAgentUpgradeImage.Get(), "winsAgent")
+		}
+	case ClusterTypeImported:
+	default:
+		return nil, fmt.Errorf("%w: unsupported cluster type %q", ErrInvalidAgentImagesConfig, config.ClusterType)
+	}
+
+	addSourceToImage(imagesSet, agentImage(config.RancherVersion), "agent")
+	addSourceToImage(imagesSet, settings.ShellImage.Get(), "agent")
+
+	chartsVersion := settings.GetRancherVersion()
+	if config.RancherVersion != "" {
+		chartsVersion = strings.TrimPrefix(config.RancherVersion, "v")
+		if !IsValidSemver(chartsVersion) || !settings.IsReleaseServerVersion(chartsVersion) {
+			chartsVersion = settings.RancherVersionDev
+		}
+	}
+	charts := Charts{ExportConfig{
+		ChartsPath:     config.ChartsPath,
+		RancherVersion: chartsVersion,
+		OsType:         Linux,
+		ChartNames:     AgentSystemCharts,
+	}}
+	if err := charts.FetchImages(imagesSet); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch images from system charts")
+	}
+
+	images, _ := generateImageAndSourceLists(imagesSet)
+	for i := range images {
+		images[i] = ResolveWithRegistry(images[i], config.Registry)
+	}
+	return images, nil
+}
+
+// agentImage returns the cluster agent image of the given Rancher version, or of the running version if empty.
+func agentImage(rancherVersion string) string {
+	current := settings.AgentImage.Get()
+	if rancherVersion == "" || rancherVersion == settings.ServerVersion.Get() {
+		return current
+	}
+	repository, _, _ := strings.Cut(current, ":")
+	if !strings.HasPrefix(rancherVersion, "v") && IsValidSemver(rancherVersion) {
+		rancherVersion = "v" + rancherVersion
+	}
+	return repository + ":" + rancherVersion
+}
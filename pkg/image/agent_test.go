@@ -0,0 +1,88 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAgentImages(t *testing.T) {
+	for _, s := range []struct {
+		setting settings.Setting
+		value   string
+	}{
+		{settings.AgentImage, "rancher/rancher-agent:v2.12.1"},
+		{settings.ServerVersion, "v2.12.1"},
+		{settings.ShellImage, "rancher/shell:v0.5.0"},
+		{settings.SystemAgentInstallerImage, "rancher/system-agent-installer-"},
+		{settings.SystemAgentUpgradeImage, "rancher/system-agent:v0.3.13-suc"},
+		{settings.WinsAgentUpgradeImage, "rancher/wins:v0.5.2"},
+	} {
+		previous := s.setting.Get()
+		require.NoError(t, s.setting.Set(s.value))
+		t.Cleanup(func() { _ = s.setting.Set(previous) })
+	}
+
+	tests := []struct {
+		name    string
+		config  AgentImagesConfig
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "imported cluster",
+			config: AgentImagesConfig{ClusterType: ClusterTypeImported},
+			want:   []string{"rancher/rancher-agent:v2.12.1", "rancher/shell:v0.5.0"},
+		},
+		{
+			name:   "imported cluster of another Rancher version",
+			config: AgentImagesConfig{ClusterType: ClusterTypeImported, RancherVersion: "2.12.2"},
+			want:   []string{"rancher/rancher-agent:v2.12.2", "rancher/shell:v0.5.0"},
+		},
+		{
+			name:   "k3s cluster",
+			config: AgentImagesConfig{ClusterType: ClusterTypeK3s, KubernetesVersion: "v1.32.4+k3s1"},
+			want: []string{
+				"rancher/rancher-agent:v2.12.1",
+				"rancher/shell:v0.5.0",
+				"rancher/system-agent-installer-k3s:v1.32.4-k3s1",
+				"rancher/system-agent:v0.3.13-suc",
+			},
+		},
+		{
+			name:   "rke2 cluster with registry",
+			config: AgentImagesConfig{ClusterType: ClusterTypeRKE2, KubernetesVersion: "v1.32.4+rke2r1", Registry: "registry.example.com"},
+			want: []string{
+				"registry.example.com/rancher/rancher-agent:v2.12.1",
+				"registry.example.com/rancher/shell:v0.5.0",
+				"registry.example.com/rancher/system-agent-installer-rke2:v1.32.4-rke2r1",
+				"registry.example.com/rancher/system-agent:v0.3.13-suc",
+				"registry.example.com/rancher/wins:v0.5.2",
+			},
+		},
+		{
+			name:    "rke2 cluster without kubernetes version",
+			config:  AgentImagesConfig{ClusterType: ClusterTypeRKE2},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported cluster type",
+			config:  AgentImagesConfig{ClusterType: "rke1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			images, err := GetAgentImages(tt.config)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidAgentImagesConfig)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, images)
+		})
+	}
+}
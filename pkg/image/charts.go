@@ -7,6 +7,7 @@
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -60,6 +61,9 @@ func (c Charts) FetchImages(imagesSet map[string]map[string]struct{}) error {
 		if len(versions) == 0 {
 			continue
 		}
+		if len(c.Config.ChartNames) > 0 && !slices.Contains(c.Config.ChartNames, versions[0].Metadata.Name) {
+			continue
+		}
 		// Always append the latest version of the chart if it passes the constraint check
 		// Note: Selecting the correct latest version relies on the charts-build-scripts `make standardize` command
 		// sorting the versions in the index file in descending order correctly.
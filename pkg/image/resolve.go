@@ -21,6 +21,8 @@ type ExportConfig struct {
 	ChartsPath      string
 	GithubEndpoints []GithubEndpoint
 	OsType          OSType
+	// ChartNames restricts the charts whose images are fetched. The images of all charts are fetched if empty.
+	ChartNames []string
 }
 
 type OSType int
@@ -41,7 +43,12 @@ func Resolve(image string) string {
 // It will use the cluster level registry if one is found, or the system default registry if no cluster level registry is found.
 // If either is not found, it returns the image.
 func ResolveWithCluster(image string, cluster *v3.Cluster) string {
-	reg := util.GetPrivateRegistryURL(cluster)
+	return ResolveWithRegistry(image, util.GetPrivateRegistryURL(cluster))
+}
+
+// ResolveWithRegistry returns the image concatenated with the URL of the given registry, adding rancher/ if is a
+// Dockerhub library image. If the registry is empty, it returns the image.
+func ResolveWithRegistry(image, reg string) string {
 	if reg != "" && !strings.HasPrefix(image, reg) {
 		// Images from Dockerhub Library repo, we add rancher prefix when using private registry
 		if !strings.Contains(image, "/") {
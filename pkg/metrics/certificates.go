@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	servingCertExpiry = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "cluster_manager",
+			Name:      "serving_certificate_expiry_timestamp_seconds",
+			Help:      "Unix time at which the certificate served by the listener expires",
+		},
+		[]string{"listener"},
+	)
+	servingCertReloads = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "cluster_manager",
+			Name:      "serving_certificate_reloads_total",
+			Help:      "Number of times the certificate served by the listener was reloaded",
+		},
+		[]string{"listener"},
+	)
+)
+
+// SetServingCertificateExpiry records when the certificate served by the listener expires.
+func SetServingCertificateExpiry(listener string, notAfter time.Time) {
+	if prometheusMetrics {
+		servingCertExpiry.With(prometheus.Labels{"listener": listener}).Set(float64(notAfter.Unix()))
+	}
+}
+
+// IncServingCertificateReloads records a reload of the certificate served by the listener.
+func IncServingCertificateReloads(listener string) {
+	if prometheusMetrics {
+		servingCertReloads.With(prometheus.Labels{"listener": listener}).Inc()
+	}
+}
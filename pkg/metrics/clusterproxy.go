@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	clusterProxyRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "cluster_manager",
+			Name:      "cluster_proxy_requests_total",
+			Help:      "Number of requests proxied to the API of downstream clusters, by user and response code",
+		},
+		[]string{"cluster", "user", "code"},
+	)
+	clusterProxyRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: "cluster_manager",
+			Name:      "cluster_proxy_request_duration_seconds",
+			Help:      "Duration of the requests proxied to the API of downstream clusters, by user",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"cluster", "user"},
+	)
+	clusterProxyThrottledRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "cluster_manager",
+			Name:      "cluster_proxy_throttled_requests_total",
+			Help:      "Number of requests to the API of downstream clusters rejected by the per-user rate limit",
+		},
+		[]string{"cluster", "user"},
+	)
+)
+
+// ObserveClusterProxyRequest records a request proxied to the API of a downstream cluster.
+func ObserveClusterProxyRequest(clusterID, user string, code int, duration time.Duration) {
+	if prometheusMetrics {
+		clusterProxyRequests.With(prometheus.Labels{"cluster": clusterID, "user": user, "code": strconv.Itoa(code)}).Inc()
+		clusterProxyRequestDuration.With(prometheus.Labels{"cluster": clusterID, "user": user}).Observe(duration.Seconds())
+	}
+}
+
+// IncClusterProxyThrottledRequests records a request to the API of a downstream cluster rejected by the per-user rate
+// limit.
+func IncClusterProxyThrottledRequests(clusterID, user string) {
+	if prometheusMetrics {
+		clusterProxyThrottledRequests.With(prometheus.Labels{"cluster": clusterID, "user": user}).Inc()
+	}
+}
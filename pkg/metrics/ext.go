@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	extAPIServerPanics = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: "ext_apiserver",
+			Name:      "panics_total",
+			Help:      "Number of requests to the extension API server which panicked",
+		},
+	)
+	extTokenAuthorizationCacheLookups = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "ext_apiserver",
+			Name:      "token_authorization_cache_lookups_total",
+			Help:      "Number of lookups of the authorization decisions cached by the token store, by result (hit or miss)",
+		},
+		[]string{"result"},
+	)
+)
+
+// IncExtAPIServerPanics records a request to the extension API server which panicked.
+func IncExtAPIServerPanics() {
+	if prometheusMetrics {
+		extAPIServerPanics.Inc()
+	}
+}
+
+// IncExtTokenAuthorizationCacheLookups records a lookup of the authorization decisions cached by the token store.
+func IncExtTokenAuthorizationCacheLookups(hit bool) {
+	if prometheusMetrics {
+		result := "miss"
+		if hit {
+			result = "hit"
+		}
+		extTokenAuthorizationCacheLookups.With(prometheus.Labels{"result": result}).Inc()
+	}
+}
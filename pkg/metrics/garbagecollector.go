@@ -84,7 +84,7 @@ func (gc *metricGarbageCollector) metricGarbageCollection() {
 
 	buildObservedLabelMaps(targetMetricsByNameForClientKey, "clientkey", observedLabelsMap)
 	buildObservedLabelMaps(targetMetricsByIPForPeer, "peer", observedLabelsMap)
-	buildObservedLabelMaps([]interface{}{clusterOwner}, "cluster", observedLabelsMap)
+	buildObservedLabelMaps([]interface{}{clusterOwner, tunnelRTT, tunnelConnectedSince, tunnelReconnects, clusterProxyRequests, clusterProxyRequestDuration, clusterProxyThrottledRequests}, "cluster", observedLabelsMap)
 
 	removedCount := removeMetricsForDeletedResource(observedLabelsMap, observedResourceNames)
 
@@ -152,6 +152,12 @@ func removeMetricsForDeletedResource(observedMetrics map[string]map[interface{}]
 					} else {
 						logrus.Errorf("[metrics-garbage-collector] failed to delete %T metrics related to %s: %v", v, m, label)
 					}
+				case *prometheus.HistogramVec:
+					if v.Delete(label) {
+						removedCount++
+					} else {
+						logrus.Errorf("[metrics-garbage-collector] failed to delete %T metrics related to %s: %v", v, m, label)
+					}
 				default:
 					logrus.Errorf("[metrics-garbage-collector] saw unknown Metric definition %T", v)
 				}
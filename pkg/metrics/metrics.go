@@ -45,10 +45,38 @@ func Register(ctx context.Context, scaledContext *config.ScaledContext) {
 	// Cluster Owner
 	prometheus.MustRegister(clusterOwner)
 
+	// Cluster agent tunnel health
+	prometheus.MustRegister(tunnelRTT)
+	prometheus.MustRegister(tunnelConnectedSince)
+	prometheus.MustRegister(tunnelReconnects)
+
+	// Cluster proxy requests
+	prometheus.MustRegister(clusterProxyRequests)
+	prometheus.MustRegister(clusterProxyRequestDuration)
+	prometheus.MustRegister(clusterProxyThrottledRequests)
+
+	// Serving certificates
+	prometheus.MustRegister(servingCertExpiry)
+	prometheus.MustRegister(servingCertReloads)
+
 	// node and node core metrics
 	prometheus.MustRegister(numNodes)
 	prometheus.MustRegister(numCores)
 
+	// RBAC handler sync lag
+	prometheus.MustRegister(rbacSyncRetries)
+	prometheus.MustRegister(rbacSyncFailedItems)
+	prometheus.MustRegister(rbacSyncCollector{})
+
+	// Extension API server
+	prometheus.MustRegister(extAPIServerPanics)
+	prometheus.MustRegister(extTokenAuthorizationCacheLookups)
+
+	// Namespaces backing users
+	prometheus.MustRegister(userNamespacePreferences)
+	prometheus.MustRegister(userNamespaceStorageBytes)
+	prometheus.MustRegister(userNamespaceOrphansDeleted)
+
 	gc := metricGarbageCollector{
 		clusterLister:  scaledContext.Management.Clusters("").Controller().Lister(),
 		nodeLister:     scaledContext.Management.Nodes("").Controller().Lister(),
@@ -0,0 +1,202 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const rbacSyncControllerLabel = "controller"
+
+var (
+	syncTrackersLock sync.Mutex
+	syncTrackers     = map[string]*SyncTracker{}
+
+	rbacSyncRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "rbac_sync",
+			Name:      "retries_total",
+			Help:      "Number of failed syncs of the RBAC handlers, each of which is retried",
+		},
+		[]string{rbacSyncControllerLabel},
+	)
+	rbacSyncFailedItems = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "rbac_sync",
+			Name:      "failed_items",
+			Help:      "Number of keys the RBAC handlers gave up retrying after too many failed syncs",
+		},
+		[]string{rbacSyncControllerLabel},
+	)
+	rbacSyncQueueDepth = prometheus.NewDesc(
+		"rbac_sync_queue_depth",
+		"Number of keys currently being synced or waiting to be retried by the RBAC handlers",
+		[]string{rbacSyncControllerLabel},
+		nil,
+	)
+	rbacSyncOldestItemAge = prometheus.NewDesc(
+		"rbac_sync_oldest_item_age_seconds",
+		"Time since the oldest key currently being synced or waiting to be retried by the RBAC handlers was first attempted",
+		[]string{rbacSyncControllerLabel},
+		nil,
+	)
+)
+
+// SyncItem is a key which is either being synced, or waiting to be retried after a failed sync.
+type SyncItem struct {
+	Key      string    `json:"key"`
+	Since    time.Time `json:"since"`
+	Attempts int       `json:"attempts"`
+	InFlight bool      `json:"inFlight"`
+}
+
+// SyncTracker keeps track of the keys a handler is working on, in order to report the sync lag of the handler.
+type SyncTracker struct {
+	controller string
+	now        func() time.Time
+
+	lock  sync.Mutex
+	items map[string]*SyncItem
+}
+
+// NewSyncTracker returns the tracker for the named controller, creating it if needed.
+func NewSyncTracker(controller string) *SyncTracker {
+	syncTrackersLock.Lock()
+	defer syncTrackersLock.Unlock()
+
+	if tracker, ok := syncTrackers[controller]; ok {
+		return tracker
+	}
+	tracker := &SyncTracker{
+		controller: controller,
+		now:        time.Now,
+		items:      map[string]*SyncItem{},
+	}
+	syncTrackers[controller] = tracker
+	return tracker
+}
+
+// Track runs f as a sync of the given key. A key which fails to sync is considered pending until it is synced
+// successfully, as the handler will be retried.
+func (s *SyncTracker) Track(key string, f func() error) error {
+	s.start(key)
+	err := f()
+	s.done(key, err)
+	return err
+}
+
+func (s *SyncTracker) start(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	item, ok := s.items[key]
+	if !ok {
+		item = &SyncItem{Key: key, Since: s.now()}
+		s.items[key] = item
+	}
+	item.Attempts++
+	item.InFlight = true
+}
+
+func (s *SyncTracker) done(key string, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err == nil {
+		delete(s.items, key)
+		return
+	}
+
+	if item, ok := s.items[key]; ok {
+		item.InFlight = false
+	}
+	if prometheusMetrics {
+		rbacSyncRetries.WithLabelValues(s.controller).Inc()
+	}
+}
+
+// Forget stops tracking the key, for keys which are no longer retried after a failed sync.
+func (s *SyncTracker) Forget(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.items, key)
+}
+
+// Items returns the keys currently being synced or waiting to be retried, oldest first.
+func (s *SyncTracker) Items() []SyncItem {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	items := make([]SyncItem, 0, len(s.items))
+	for _, item := range s.items {
+		items = append(items, *item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Since.Equal(items[j].Since) {
+			return items[i].Key < items[j].Key
+		}
+		return items[i].Since.Before(items[j].Since)
+	})
+	return items
+}
+
+// rbacSyncCollector reports the queue depth and age of the oldest item of all trackers. They are computed at
+// collection time, as the age keeps changing while a key is pending.
+type rbacSyncCollector struct{}
+
+func (rbacSyncCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- rbacSyncQueueDepth
+	ch <- rbacSyncOldestItemAge
+}
+
+func (rbacSyncCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, tracker := range listSyncTrackers() {
+		items := tracker.Items()
+		ch <- prometheus.MustNewConstMetric(rbacSyncQueueDepth, prometheus.GaugeValue, float64(len(items)), tracker.controller)
+
+		var age float64
+		if len(items) > 0 {
+			age = tracker.now().Sub(items[0].Since).Seconds()
+		}
+		ch <- prometheus.MustNewConstMetric(rbacSyncOldestItemAge, prometheus.GaugeValue, age, tracker.controller)
+	}
+}
+
+func listSyncTrackers() []*SyncTracker {
+	syncTrackersLock.Lock()
+	defer syncTrackersLock.Unlock()
+
+	trackers := make([]*SyncTracker, 0, len(syncTrackers))
+	for _, tracker := range syncTrackers {
+		trackers = append(trackers, tracker)
+	}
+	return trackers
+}
+
+// NewSyncDebugHandler returns a handler that dumps the keys currently being synced or waiting to be retried by the
+// RBAC handlers, by controller.
+func NewSyncDebugHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		result := map[string][]SyncItem{}
+		for _, tracker := range listSyncTrackers() {
+			result[tracker.controller] = tracker.Items()
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(rw).Encode(result); err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// SetRBACSyncFailedItems records the number of keys the controller gave up retrying.
+func SetRBACSyncFailedItems(controller string, count int) {
+	if prometheusMetrics {
+		rbacSyncFailedItems.WithLabelValues(controller).Set(float64(count))
+	}
+}
@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncTracker(t *testing.T) {
+	tracker := NewSyncTracker("test-sync-tracker")
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker.now = func() time.Time { return now }
+
+	err := tracker.Track("ns/failing", func() error {
+		items := tracker.Items()
+		require.Len(t, items, 1)
+		assert.True(t, items[0].InFlight)
+		return fmt.Errorf("some error")
+	})
+	require.Error(t, err)
+
+	now = now.Add(time.Minute)
+	err = tracker.Track("ns/failing", func() error { return fmt.Errorf("some error") })
+	require.Error(t, err)
+
+	err = tracker.Track("ns/ok", func() error { return nil })
+	require.NoError(t, err)
+
+	items := tracker.Items()
+	require.Len(t, items, 1)
+	assert.Equal(t, SyncItem{
+		Key:      "ns/failing",
+		Since:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Attempts: 2,
+		InFlight: false,
+	}, items[0])
+
+	rec := httptest.NewRecorder()
+	NewSyncDebugHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/rbac-sync", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var dump map[string][]SyncItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &dump))
+	assert.Len(t, dump["test-sync-tracker"], 1)
+
+	err = tracker.Track("ns/failing", func() error { return nil })
+	require.NoError(t, err)
+	assert.Empty(t, tracker.Items())
+
+	err = tracker.Track("ns/given-up", func() error { return fmt.Errorf("some error") })
+	require.Error(t, err)
+	tracker.Forget("ns/given-up")
+	assert.Empty(t, tracker.Items())
+
+	assert.Same(t, tracker, NewSyncTracker("test-sync-tracker"))
+}
@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	tunnelRTT = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "cluster_manager",
+			Name:      "tunnel_rtt_seconds",
+			Help:      "Round trip time of the last ping through the tunnel of the cluster agent",
+		},
+		[]string{"cluster"},
+	)
+	tunnelConnectedSince = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "cluster_manager",
+			Name:      "tunnel_connected_since_seconds",
+			Help:      "Unix time since which the tunnel of the cluster agent is connected, 0 if it is disconnected",
+		},
+		[]string{"cluster"},
+	)
+	tunnelReconnects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "cluster_manager",
+			Name:      "tunnel_reconnects_total",
+			Help:      "Number of times the tunnel of the cluster agent was observed to reconnect",
+		},
+		[]string{"cluster"},
+	)
+)
+
+// SetTunnelConnected records that the tunnel of the cluster agent is connected since the given time, and the round
+// trip time of the last ping through it.
+func SetTunnelConnected(clusterID string, since time.Time, rtt time.Duration) {
+	if prometheusMetrics {
+		tunnelConnectedSince.With(prometheus.Labels{"cluster": clusterID}).Set(float64(since.Unix()))
+		tunnelRTT.With(prometheus.Labels{"cluster": clusterID}).Set(rtt.Seconds())
+	}
+}
+
+// SetTunnelDisconnected records that the tunnel of the cluster agent is disconnected.
+func SetTunnelDisconnected(clusterID string) {
+	if prometheusMetrics {
+		tunnelConnectedSince.With(prometheus.Labels{"cluster": clusterID}).Set(0)
+		tunnelRTT.Delete(prometheus.Labels{"cluster": clusterID})
+	}
+}
+
+// IncTunnelReconnects records a reconnection of the tunnel of the cluster agent.
+func IncTunnelReconnects(clusterID string) {
+	if prometheusMetrics {
+		tunnelReconnects.With(prometheus.Labels{"cluster": clusterID}).Inc()
+	}
+}
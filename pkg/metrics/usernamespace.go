@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const userNamespaceUserLabel = "user"
+
+var (
+	userNamespacePreferences = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "user_namespace",
+			Name:      "preferences",
+			Help:      "Number of preferences kept in the namespace backing each user",
+		},
+		[]string{userNamespaceUserLabel},
+	)
+	userNamespaceStorageBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "user_namespace",
+			Name:      "storage_bytes",
+			Help:      "Total size of the preferences kept in the namespace backing each user",
+		},
+		[]string{userNamespaceUserLabel},
+	)
+	userNamespaceOrphansDeleted = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: "user_namespace",
+			Name:      "orphans_deleted_total",
+			Help:      "Number of namespaces and secrets left behind by deleted users which were cleaned up",
+		},
+	)
+)
+
+// SetUserNamespaceUsage records the number and total size of the preferences of a user.
+func SetUserNamespaceUsage(user string, preferences, bytes int) {
+	if prometheusMetrics {
+		labels := prometheus.Labels{userNamespaceUserLabel: user}
+		userNamespacePreferences.With(labels).Set(float64(preferences))
+		userNamespaceStorageBytes.With(labels).Set(float64(bytes))
+	}
+}
+
+// DeleteUserNamespaceUsage removes the usage recorded for a user whose namespace is deleted.
+func DeleteUserNamespaceUsage(user string) {
+	if prometheusMetrics {
+		labels := prometheus.Labels{userNamespaceUserLabel: user}
+		userNamespacePreferences.Delete(labels)
+		userNamespaceStorageBytes.Delete(labels)
+	}
+}
+
+// IncUserNamespaceOrphansDeleted records a namespace or secret left behind by a deleted user which was cleaned up.
+func IncUserNamespaceOrphansDeleted() {
+	if prometheusMetrics {
+		userNamespaceOrphansDeleted.Inc()
+	}
+}
@@ -8,6 +8,7 @@
 	"time"
 
 	"github.com/rancher/rancher/pkg/agent/clean/adunmigration"
+	"github.com/rancher/rancher/pkg/apiusage"
 
 	"github.com/pkg/errors"
 	"github.com/rancher/norman/types"
@@ -19,11 +20,13 @@
 	"github.com/rancher/rancher/pkg/auth/tokens"
 	"github.com/rancher/rancher/pkg/clustermanager"
 	managementController "github.com/rancher/rancher/pkg/controllers/management"
+	"github.com/rancher/rancher/pkg/controllers/management/auth"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterupstreamrefresher"
 	managementcrds "github.com/rancher/rancher/pkg/crds/management"
 	"github.com/rancher/rancher/pkg/cron"
 	managementdata "github.com/rancher/rancher/pkg/data/management"
 	"github.com/rancher/rancher/pkg/dialer"
+	"github.com/rancher/rancher/pkg/features"
 	"github.com/rancher/rancher/pkg/jailer"
 	"github.com/rancher/rancher/pkg/metrics"
 	"github.com/rancher/rancher/pkg/namespace"
@@ -114,6 +117,7 @@ func newMCM(ctx context.Context, wranglerContext *wrangler.Context, cfg *Options
 	if os.Getenv("CATTLE_PROMETHEUS_METRICS") == "true" {
 		metrics.Register(ctx, scaledContext)
 	}
+	apiusage.Start(ctx, wranglerContext.Mgmt.APIUsageReport())
 
 	mcm := &mcm{
 		router:              router,
@@ -220,5 +224,29 @@ func (m *mcm) Start(ctx context.Context) error {
 		return nil
 	})
 
+	if features.AuthControllersLeaderElection.Enabled() {
+		// The leader callback is retried on errors, the controllers are only registered once on the context.
+		authManagement, err := m.ScaledContext.NewSubsystemManagementContext()
+		if err != nil {
+			return errors.Wrap(err, "failed to create auth management context")
+		}
+		registered := false
+		m.wranglerContext.OnSubsystemLeader("auth", func(ctx context.Context) error {
+			if !registered {
+				err := m.wranglerContext.StartWithTransaction(ctx, func(ctx context.Context) error {
+					auth.RegisterRTBControllers(ctx, authManagement, m.clusterManager)
+					return nil
+				})
+				if err != nil {
+					return errors.Wrap(err, "failed to register auth controllers")
+				}
+				registered = true
+			}
+
+			logrus.Infof("Starting auth controllers")
+			return authManagement.ControllerFactory.Start(ctx, 50)
+		})
+	}
+
 	return nil
 }
@@ -14,7 +14,10 @@
 	"github.com/rancher/rancher/pkg/api/norman/customization/oci"
 	"github.com/rancher/rancher/pkg/api/norman/customization/vsphere"
 	managementapi "github.com/rancher/rancher/pkg/api/norman/server"
+	"github.com/rancher/rancher/pkg/api/steve/agentimages"
+	"github.com/rancher/rancher/pkg/api/steve/authexport"
 	"github.com/rancher/rancher/pkg/api/steve/supportconfigs"
+	"github.com/rancher/rancher/pkg/auth/providers"
 	"github.com/rancher/rancher/pkg/auth/providers/publicapi"
 	"github.com/rancher/rancher/pkg/auth/providers/saml"
 	"github.com/rancher/rancher/pkg/auth/requests"
@@ -65,6 +68,7 @@ func router(ctx context.Context, localClusterEnabled bool, tunnelAuthorizer *mcm
 	channelserver := channelserver.NewHandler(ctx)
 
 	supportConfigGenerator := supportconfigs.NewHandler(scaledContext)
+	agentImages := agentimages.NewHandler(scaledContext.Wrangler.Catalog.ClusterRepo().Cache())
 	// Unauthenticated routes
 	unauthed := mux.NewRouter()
 	unauthed.UseEncodedPath()
@@ -84,6 +88,7 @@ func router(ctx context.Context, localClusterEnabled bool, tunnelAuthorizer *mcm
 	unauthed.PathPrefix("/v1-{prefix}-release/channel").Handler(channelserver)
 	unauthed.PathPrefix("/v1-{prefix}-release/release").Handler(channelserver)
 	unauthed.PathPrefix("/v1-saml").Handler(saml.AuthHandler())
+	unauthed.PathPrefix("/v1-oidc").Handler(providers.BackChannelLogoutHandler(scaledContext.Wrangler))
 	unauthed.PathPrefix("/v3-public").Handler(publicAPI)
 
 	// Authenticated routes
@@ -111,6 +116,8 @@ func router(ctx context.Context, localClusterEnabled bool, tunnelAuthorizer *mcm
 	authed.Path("/meta/vsphere/{field}").Methods(http.MethodGet).Handler(vsphere.NewVsphereHandler(scaledContext))
 	authed.Path("/v3/tokenreview").Methods(http.MethodPost).Handler(&webhook.TokenReviewer{})
 	authed.Path(supportconfigs.Endpoint).Handler(&supportConfigGenerator)
+	authed.Path(agentimages.Endpoint).Methods(http.MethodGet).Handler(agentImages)
+	authed.Path(authexport.Endpoint).Methods(http.MethodGet).Handler(authexport.NewHandler(scaledContext))
 	authed.PathPrefix("/meta/proxy").Handler(metaProxy)
 	authed.PathPrefix("/v3/identit").Handler(tokenAPI)
 	authed.PathPrefix("/v3/token").Handler(tokenAPI)
@@ -125,6 +132,7 @@ func router(ctx context.Context, localClusterEnabled bool, tunnelAuthorizer *mcm
 	metricsAuthed.Use(requests.NewAuthenticatedFilter)
 	metricsAuthed.Use(metrics.NewMetricsHandler(scaledContext.K8sClient))
 	metricsAuthed.Path("/metrics").Handler(promhttp.Handler())
+	metricsAuthed.Path("/debug/rbac-sync").Methods(http.MethodGet).Handler(metrics.NewSyncDebugHandler())
 
 	unauthed.NotFoundHandler = saauthed
 	saauthed.NotFoundHandler = authed
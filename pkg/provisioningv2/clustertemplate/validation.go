@@ -0,0 +1,77 @@
+// Package clustertemplate validates provisioning clusters against the cluster template they reference.
+package clustertemplate
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	provv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	"github.com/rancher/wrangler/v3/pkg/data/convert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate returns an error for every field of the cluster spec that is locked by the template, but set to a different
+// value than the one of the template. Fields the template does not set are not locked, and neither are the ones below
+// the allowed overrides of the template.
+func Validate(template *provv1.ClusterTemplate, spec provv1.ClusterSpec) (field.ErrorList, error) {
+	templateData, err := convert.EncodeToMap(template.Spec.ClusterSpec)
+	if err != nil {
+		return nil, err
+	}
+	specData, err := convert.EncodeToMap(spec)
+	if err != nil {
+		return nil, err
+	}
+	// The reference to the template is not part of the template itself.
+	delete(templateData, "clusterTemplateName")
+
+	v := &validator{
+		template:         template,
+		allowedOverrides: template.Spec.AllowedOverrides,
+	}
+	v.compare(nil, templateData, specData)
+	return v.errs, nil
+}
+
+type validator struct {
+	template         *provv1.ClusterTemplate
+	allowedOverrides []string
+	errs             field.ErrorList
+}
+
+func (v *validator) compare(path []string, templateValue, value interface{}) {
+	if v.allowed(path) {
+		return
+	}
+
+	if templateMap, ok := templateValue.(map[string]interface{}); ok {
+		valueMap, _ := value.(map[string]interface{})
+		keys := make([]string, 0, len(templateMap))
+		for k := range templateMap {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v.compare(append(path[:len(path):len(path)], k), templateMap[k], valueMap[k])
+		}
+		return
+	}
+
+	// Lists are locked as a whole, as their items can not be matched between the template and the cluster.
+	if !reflect.DeepEqual(templateValue, value) {
+		v.errs = append(v.errs, field.Forbidden(field.NewPath("spec", path...),
+			fmt.Sprintf("field is locked by cluster template %s/%s", v.template.Namespace, v.template.Name)))
+	}
+}
+
+func (v *validator) allowed(path []string) bool {
+	p := strings.Join(path, ".")
+	for _, override := range v.allowedOverrides {
+		if p == override || strings.HasPrefix(p, override+".") {
+			return true
+		}
+	}
+	return false
+}
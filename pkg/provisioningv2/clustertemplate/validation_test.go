@@ -0,0 +1,84 @@
+package clustertemplate
+
+import (
+	"testing"
+
+	provv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidate(t *testing.T) {
+	template := &provv1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "hardened", Namespace: "fleet-default"},
+		Spec: provv1.ClusterTemplateSpec{
+			ClusterSpec: provv1.ClusterSpec{
+				KubernetesVersion: "v1.31.4+rke2r1",
+				RKEConfig: &provv1.RKEConfig{
+					ClusterConfiguration: rkev1.ClusterConfiguration{
+						MachineGlobalConfig: rkev1.GenericMap{Data: map[string]any{
+							"cni":     "calico",
+							"profile": "cis",
+						}},
+					},
+					MachinePools: []provv1.RKEMachinePool{{Name: "pool"}},
+				},
+			},
+			AllowedOverrides: []string{"kubernetesVersion", "rkeConfig.machinePools"},
+		},
+	}
+
+	newSpec := func(mutate func(spec *provv1.ClusterSpec)) provv1.ClusterSpec {
+		spec := template.Spec.ClusterSpec.DeepCopy()
+		spec.ClusterTemplateName = "hardened"
+		mutate(spec)
+		return *spec
+	}
+
+	tests := []struct {
+		name     string
+		spec     provv1.ClusterSpec
+		expected []string
+	}{
+		{
+			name: "matches template",
+			spec: newSpec(func(*provv1.ClusterSpec) {}),
+		},
+		{
+			name: "allowed overrides changed",
+			spec: newSpec(func(spec *provv1.ClusterSpec) {
+				spec.KubernetesVersion = "v1.32.0+rke2r1"
+				spec.RKEConfig.MachinePools = append(spec.RKEConfig.MachinePools, provv1.RKEMachinePool{Name: "other"})
+			}),
+		},
+		{
+			name: "fields not set by template changed",
+			spec: newSpec(func(spec *provv1.ClusterSpec) {
+				spec.CloudCredentialSecretName = "cattle-global-data:cc-abc"
+				spec.RKEConfig.MachineGlobalConfig.Data["disable"] = []any{"rke2-ingress-nginx"}
+			}),
+		},
+		{
+			name: "locked fields changed",
+			spec: newSpec(func(spec *provv1.ClusterSpec) {
+				spec.RKEConfig.MachineGlobalConfig.Data["cni"] = "cilium"
+				delete(spec.RKEConfig.MachineGlobalConfig.Data, "profile")
+			}),
+			expected: []string{"spec.rkeConfig.machineGlobalConfig.cni", "spec.rkeConfig.machineGlobalConfig.profile"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := Validate(template, tt.spec)
+			require.NoError(t, err)
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			assert.Equal(t, tt.expected, fields)
+		})
+	}
+}
@@ -18,6 +18,15 @@ func ResolveWithCluster(image string, cluster *v1.Cluster) string {
 	return resolve(GetPrivateRepoURLFromCluster(cluster), image)
 }
 
+// ResolveAgentImageWithCluster returns the image concatenated with the agent image registry of the cluster if set, or
+// with its system-default-registry otherwise.
+func ResolveAgentImageWithCluster(image string, cluster *v1.Cluster) string {
+	if cluster != nil && cluster.Spec.AgentImageRegistry != "" {
+		return resolve(cluster.Spec.AgentImageRegistry, image)
+	}
+	return ResolveWithCluster(image, cluster)
+}
+
 func resolve(reg, image string) string {
 	if reg != "" && !strings.HasPrefix(image, reg) {
 		//Images from Dockerhub Library repo, we add rancher prefix when using private registry
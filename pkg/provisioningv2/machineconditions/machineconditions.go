@@ -0,0 +1,79 @@
+// Package machineconditions maintains the conditions of rke.cattle.io machines, mirroring the state of the CAPI
+// machines owning them, so that machine failures surface on the machines themselves.
+package machineconditions
+
+import (
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/wrangler/v3/pkg/condition"
+	"k8s.io/apimachinery/pkg/runtime"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	ReasonWaitingForRegistration = "WaitingForRegistration"
+	ReasonMachineNotFound        = "MachineNotFound"
+	ReasonWaitingForBootstrap    = "WaitingForBootstrapData"
+	ReasonWaitingForNodeRef      = "WaitingForNodeRef"
+)
+
+var (
+	Provisioned     = condition.Cond(rkev1.CustomMachineConditionProvisioned)
+	Bootstrapped    = condition.Cond(rkev1.CustomMachineConditionBootstrapped)
+	NodeRefResolved = condition.Cond(rkev1.CustomMachineConditionNodeRefResolved)
+)
+
+// UpdateCustomMachine sets the Provisioned, Bootstrapped and NodeRefResolved conditions of the custom machine from
+// its own state and the one of the CAPI machine owning it, which may be nil if not known yet. It returns whether any
+// condition changed, in which case the status of the custom machine needs to be updated.
+func UpdateCustomMachine(customMachine *rkev1.CustomMachine, capiMachine *capi.Machine) bool {
+	changed := false
+
+	switch {
+	case capiMachine != nil && capiMachine.Status.FailureReason != nil:
+		message := ""
+		if capiMachine.Status.FailureMessage != nil {
+			message = *capiMachine.Status.FailureMessage
+		}
+		changed = set(customMachine, Provisioned, "False", string(*capiMachine.Status.FailureReason), message) || changed
+	case customMachine.Spec.ProviderID == "":
+		changed = set(customMachine, Provisioned, "False", ReasonWaitingForRegistration, "waiting for the machine to register") || changed
+	default:
+		changed = set(customMachine, Provisioned, "True", "", "") || changed
+	}
+
+	if capiMachine == nil {
+		changed = set(customMachine, Bootstrapped, "Unknown", ReasonMachineNotFound, "waiting for the CAPI machine owning this machine") || changed
+		changed = set(customMachine, NodeRefResolved, "Unknown", ReasonMachineNotFound, "waiting for the CAPI machine owning this machine") || changed
+		return changed
+	}
+
+	if capiMachine.Status.BootstrapReady {
+		changed = set(customMachine, Bootstrapped, "True", "", "") || changed
+	} else {
+		reason := conditions.GetReason(capiMachine, capi.BootstrapReadyCondition)
+		if reason == "" {
+			reason = ReasonWaitingForBootstrap
+		}
+		changed = set(customMachine, Bootstrapped, "False", reason, conditions.GetMessage(capiMachine, capi.BootstrapReadyCondition)) || changed
+	}
+
+	if capiMachine.Status.NodeRef != nil {
+		changed = set(customMachine, NodeRefResolved, "True", "", "") || changed
+	} else {
+		changed = set(customMachine, NodeRefResolved, "False", ReasonWaitingForNodeRef, "waiting for the machine to be matched to a node") || changed
+	}
+
+	return changed
+}
+
+// set sets the status, reason and message of the condition, and returns whether it changed.
+func set(obj runtime.Object, cond condition.Cond, status, reason, message string) bool {
+	if cond.GetStatus(obj) == status && cond.GetReason(obj) == reason && cond.GetMessage(obj) == message {
+		return false
+	}
+	cond.SetStatus(obj, status)
+	cond.Reason(obj, reason)
+	cond.Message(obj, message)
+	return true
+}
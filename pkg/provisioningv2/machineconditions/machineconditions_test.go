@@ -0,0 +1,85 @@
+package machineconditions
+
+import (
+	"testing"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+)
+
+func TestUpdateCustomMachine(t *testing.T) {
+	tests := []struct {
+		name            string
+		providerID      string
+		capiMachine     *capi.Machine
+		provisioned     string
+		provisionedMsg  string
+		bootstrapped    string
+		nodeRefResolved string
+	}{
+		{
+			name:            "no CAPI machine",
+			provisioned:     "False",
+			provisionedMsg:  "waiting for the machine to register",
+			bootstrapped:    "Unknown",
+			nodeRefResolved: "Unknown",
+		},
+		{
+			name:       "bootstrapped, waiting for node",
+			providerID: "rke2://node",
+			capiMachine: &capi.Machine{
+				Status: capi.MachineStatus{BootstrapReady: true},
+			},
+			provisioned:     "True",
+			bootstrapped:    "True",
+			nodeRefResolved: "False",
+		},
+		{
+			name:       "ready",
+			providerID: "rke2://node",
+			capiMachine: &capi.Machine{
+				Status: capi.MachineStatus{
+					BootstrapReady: true,
+					NodeRef:        &corev1.ObjectReference{Name: "node"},
+				},
+			},
+			provisioned:     "True",
+			bootstrapped:    "True",
+			nodeRefResolved: "True",
+		},
+		{
+			name:       "failed",
+			providerID: "rke2://node",
+			capiMachine: &capi.Machine{
+				Status: capi.MachineStatus{
+					FailureReason:  ptr.To(capierrors.CreateMachineError),
+					FailureMessage: ptr.To("machine could not be created"),
+				},
+			},
+			provisioned:     "False",
+			provisionedMsg:  "machine could not be created",
+			bootstrapped:    "False",
+			nodeRefResolved: "False",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine := &rkev1.CustomMachine{
+				Spec: rkev1.CustomMachineSpec{ProviderID: tt.providerID},
+			}
+
+			assert.True(t, UpdateCustomMachine(machine, tt.capiMachine))
+			assert.Equal(t, tt.provisioned, Provisioned.GetStatus(machine))
+			assert.Equal(t, tt.provisionedMsg, Provisioned.GetMessage(machine))
+			assert.Equal(t, tt.bootstrapped, Bootstrapped.GetStatus(machine))
+			assert.Equal(t, tt.nodeRefResolved, NodeRefResolved.GetStatus(machine))
+
+			assert.False(t, UpdateCustomMachine(machine, tt.capiMachine), "conditions should not change")
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Package machineconfig validates machine configs against the dynamic schema of their node driver, so that invalid
+// configs are reported with the offending fields before any machine is provisioned, instead of failing later in the
+// node driver.
+package machineconfig
+
+import (
+	"fmt"
+	"sort"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/data"
+	"github.com/rancher/wrangler/v3/pkg/data/convert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate returns the errors of the fields of the machine config that do not match the schema of its node driver.
+// Fields the schema does not know are ignored, they are pruned before provisioning.
+func Validate(config data.Object, spec v3.DynamicSchemaSpec, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	names := make([]string, 0, len(spec.ResourceFields))
+	for name := range spec.ResourceFields {
+		names = append(names, name)
+	}
+	// Sort the fields so that the errors, which end up in the cluster conditions, are stable.
+	sort.Strings(names)
+
+	for _, name := range names {
+		errs = append(errs, validateField(config[name], spec.ResourceFields[name], fldPath.Child(name))...)
+	}
+	return errs
+}
+
+func validateField(value interface{}, schemaField v3.Field, fldPath *field.Path) field.ErrorList {
+	str := convert.ToString(value)
+	if value == nil || str == "" {
+		if schemaField.Required {
+			return field.ErrorList{field.Required(fldPath, "")}
+		}
+		return nil
+	}
+
+	var errs field.ErrorList
+	switch schemaField.Type {
+	case "int":
+		n, err := convert.ToNumber(value)
+		if err != nil {
+			return field.ErrorList{field.Invalid(fldPath, value, "must be an integer")}
+		}
+		// Sizes and counts of machines are never negative, and a zero bound is indistinguishable from an unset one.
+		if n < 0 && schemaField.Min >= 0 {
+			errs = append(errs, field.Invalid(fldPath, n, "must not be negative"))
+		}
+		if schemaField.Min != 0 && n < schemaField.Min {
+			errs = append(errs, field.Invalid(fldPath, n, fmt.Sprintf("must be greater than or equal to %d", schemaField.Min)))
+		}
+		if schemaField.Max != 0 && n > schemaField.Max {
+			errs = append(errs, field.Invalid(fldPath, n, fmt.Sprintf("must be less than or equal to %d", schemaField.Max)))
+		}
+	case "string", "password", "":
+		if schemaField.MinLength != 0 && int64(len(str)) < schemaField.MinLength {
+			errs = append(errs, field.Invalid(fldPath, str, fmt.Sprintf("must be at least %d characters long", schemaField.MinLength)))
+		}
+		if schemaField.MaxLength != 0 && int64(len(str)) > schemaField.MaxLength {
+			errs = append(errs, field.TooLong(fldPath, str, int(schemaField.MaxLength)))
+		}
+	}
+
+	// Regions, zones and other provider specific values are restricted by the options of the field, if any.
+	if len(schemaField.Options) > 0 && !sets.New(schemaField.Options...).Has(str) {
+		errs = append(errs, field.NotSupported(fldPath, str, schemaField.Options))
+	}
+	return errs
+}
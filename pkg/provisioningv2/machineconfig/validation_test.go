@@ -0,0 +1,73 @@
+package machineconfig
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/data"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidate(t *testing.T) {
+	spec := v3.DynamicSchemaSpec{
+		ResourceFields: map[string]v3.Field{
+			"region":     {Type: "string", Options: []string{"us-east-1", "us-west-2"}},
+			"volumeSize": {Type: "int", Min: 8, Max: 16384},
+			"instances":  {Type: "int"},
+			"sshUser":    {Type: "string", Required: true, MaxLength: 8},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		config   data.Object
+		expected []string
+	}{
+		{
+			name:   "valid",
+			config: data.Object{"region": "us-east-1", "volumeSize": "100", "instances": 1, "sshUser": "ubuntu"},
+		},
+		{
+			name:     "region not in options",
+			config:   data.Object{"region": "mars-1", "sshUser": "ubuntu"},
+			expected: []string{"Amazonec2Config[config].region"},
+		},
+		{
+			name:     "volume size out of bounds",
+			config:   data.Object{"volumeSize": "4", "sshUser": "ubuntu"},
+			expected: []string{"Amazonec2Config[config].volumeSize"},
+		},
+		{
+			name:     "negative quantity",
+			config:   data.Object{"instances": -1, "sshUser": "ubuntu"},
+			expected: []string{"Amazonec2Config[config].instances"},
+		},
+		{
+			name:     "not an integer",
+			config:   data.Object{"volumeSize": "large", "sshUser": "ubuntu"},
+			expected: []string{"Amazonec2Config[config].volumeSize"},
+		},
+		{
+			name:     "required field missing and unknown field ignored",
+			config:   data.Object{"unknown": "value"},
+			expected: []string{"Amazonec2Config[config].sshUser"},
+		},
+		{
+			name:     "too long",
+			config:   data.Object{"sshUser": "administrator"},
+			expected: []string{"Amazonec2Config[config].sshUser"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := Validate(tt.config, spec, field.NewPath("Amazonec2Config").Key("config"))
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			assert.Equal(t, tt.expected, fields)
+		})
+	}
+}
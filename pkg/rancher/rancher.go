@@ -26,6 +26,7 @@
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/auth"
 	"github.com/rancher/rancher/pkg/auth/audit"
+	"github.com/rancher/rancher/pkg/auth/decisionlog"
 	"github.com/rancher/rancher/pkg/auth/providers/common"
 	"github.com/rancher/rancher/pkg/auth/providers/local/pbkdf2"
 	"github.com/rancher/rancher/pkg/auth/requests"
@@ -52,6 +53,7 @@
 	"github.com/rancher/rancher/pkg/settings"
 	telemetrycontrollers "github.com/rancher/rancher/pkg/telemetry/controllers"
 	"github.com/rancher/rancher/pkg/tls"
+	"github.com/rancher/rancher/pkg/tracing"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/rancher/pkg/ui"
 	"github.com/rancher/rancher/pkg/websocket"
@@ -140,6 +142,8 @@ func New(ctx context.Context, clientConfg clientcmd.ClientConfig, opts *Options)
 	if err != nil {
 		return nil, err
 	}
+	// propagate the traces of the requests made with a traced context to the kube-apiserver
+	tracing.WrapTransport(restConfig)
 
 	// Run the encryption migration before any controllers run otherwise the fields will be dropped
 	if err := migrateEncryptionConfig(ctx, restConfig); err != nil {
@@ -229,7 +233,10 @@ func New(ctx context.Context, clientConfg clientcmd.ClientConfig, opts *Options)
 			return nil, err
 		}
 
-		tokenAuthenticator := requests.NewAuthenticator(ctx, clusterrouter.GetClusterID, sc)
+		tokenAuthenticator := requests.Chain(
+			requests.NewJWTAuthenticator(ctx, sc),
+			requests.NewAuthenticator(ctx, clusterrouter.GetClusterID, sc),
+		)
 
 		authServer, err = auth.NewServer(ctx, wranglerContext, sc, tokenAuthenticator)
 		if err != nil {
@@ -290,6 +297,7 @@ func New(ctx context.Context, clientConfg clientcmd.ClientConfig, opts *Options)
 	clusterProxy, err := proxy.NewProxyMiddleware(wranglerContext.K8s.AuthorizationV1(),
 		wranglerContext.TunnelServer.Dialer,
 		wranglerContext.Mgmt.Cluster().Cache(),
+		decisionlog.NewExplainer(wranglerContext),
 		localClusterEnabled(opts),
 		steve,
 	)
@@ -50,6 +50,10 @@
 	projectManagementPlaneSuffix      = "project-mgmt"
 )
 
+// CrtbInProjectBindingOwner is the label value of the RoleBindings granted by CRTBs in the backing namespaces of the
+// projects of their cluster. The label key is the GetRTBLabel of the CRTB.
+const CrtbInProjectBindingOwner = "crtb-in-project-binding-owner"
+
 // BuildSubjectFromRTB This function will generate
 // PRTB and CRTB to the subject with user, group
 // or service account
@@ -212,6 +216,15 @@ func NameForClusterRoleBinding(role rbacv1.RoleRef, subject rbacv1.Subject) stri
 	return nm
 }
 
+// NameForRTBRoleBinding returns a deterministic name for a RoleBinding derived from the role template binding with the
+// provided key, as returned by GetRTBLabel, for the provided role and subject. Unlike NameForRoleBinding, the name is
+// specific to the role template binding, so that the RoleBindings of different role template bindings never collide.
+func NameForRTBRoleBinding(rtbNsAndName string, role rbacv1.RoleRef, subject rbacv1.Subject) string {
+	nm := "rb-" + getBindingHash(rtbNsAndName, role, subject)
+	logrus.Debugf("RoleBinding of role template binding %s with role.kind=%s role.name=%s subject.kind=%s subject.name=%s has name: %s", rtbNsAndName, role.Kind, role.Name, subject.Kind, subject.Name, nm)
+	return nm
+}
+
 // getBindingHash returns a hash created from the passed in arguments
 // uses base32 encoding for hash, since all characters in encoding scheme are valid in k8s resource names
 // probability of collision is: 1/32^10 == 1/(2^5)^10 == 1/2^50 (sufficiently low)
@@ -310,10 +323,14 @@ func IsAdminGlobalRole(rtName string, grLister normanv3.GlobalRoleLister) (bool,
 	if err != nil {
 		return false, err
 	}
+	return GlobalRoleIsAdmin(gr), nil
+}
 
+// GlobalRoleIsAdmin returns true if the GlobalRole is the builtin admin role, or has admin resource and nonResourceURLs rules.
+func GlobalRoleIsAdmin(gr *v3.GlobalRole) bool {
 	// global role is builtin admin role
 	if gr.Builtin && gr.Name == GlobalAdmin {
-		return true, nil
+		return true
 	}
 
 	var hasResourceRule, hasNonResourceRule bool
@@ -329,11 +346,7 @@ func IsAdminGlobalRole(rtName string, grLister normanv3.GlobalRoleLister) (bool,
 	}
 
 	// global role has an admin resource rule, and admin nonResourceURLs rule
-	if hasResourceRule && hasNonResourceRule {
-		return true, nil
-	}
-
-	return false, nil
+	return hasResourceRule && hasNonResourceRule
 }
 
 // CreateOrUpdateResource creates or updates the given non-namespaced resource
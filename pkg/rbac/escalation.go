@@ -0,0 +1,311 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	mgmt "github.com/rancher/rancher/pkg/apis/management.cattle.io"
+	v32 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	k8srbacv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/component-helpers/auth/rbac/validation"
+)
+
+const localClusterName = "local"
+
+var (
+	// escalateRoleTemplatesRule lets a user create role template bindings granting permissions they don't hold.
+	escalateRoleTemplatesRule = rbacv1.PolicyRule{
+		APIGroups: []string{mgmt.GroupName},
+		Resources: []string{"roletemplates"},
+		Verbs:     []string{"escalate"},
+	}
+	// escalateGlobalRolesRule lets a user create global role bindings granting permissions they don't hold.
+	escalateGlobalRolesRule = rbacv1.PolicyRule{
+		APIGroups: []string{mgmt.GroupName},
+		Resources: []string{"globalroles"},
+		Verbs:     []string{"escalate"},
+	}
+)
+
+// EscalationChecker prevents privilege escalation through role template bindings and global role bindings. A user can
+// only create a binding granting permissions they already hold in the scope of the binding, unless they can escalate
+// role templates or global roles. It is shared by all the paths creating bindings on behalf of a user, to reject the
+// bindings before they are persisted.
+//
+// The permissions of a user are the rules of the global roles, cluster role templates and project role templates bound
+// to them or to one of their groups. Global role rules are only held in the local cluster, the cluster roles global
+// roles inherit in the downstream clusters. The escalate permissions are management permissions, global role rules
+// grant them for every scope. The rules of role templates are resolved with [RulesFromTemplate], as they are when
+// their RBAC is reconciled.
+type EscalationChecker struct {
+	rtCache   v32.RoleTemplateCache
+	grCache   v32.GlobalRoleCache
+	grbCache  v32.GlobalRoleBindingCache
+	crtbCache v32.ClusterRoleTemplateBindingCache
+	prtbCache v32.ProjectRoleTemplateBindingCache
+	crCache   k8srbacv1.ClusterRoleCache
+}
+
+// NewEscalationChecker returns an escalation checker using the caches of the management and RBAC controllers.
+func NewEscalationChecker(mgmtControllers v32.Interface, rbacControllers k8srbacv1.Interface) *EscalationChecker {
+	return &EscalationChecker{
+		rtCache:   mgmtControllers.RoleTemplate().Cache(),
+		grCache:   mgmtControllers.GlobalRole().Cache(),
+		grbCache:  mgmtControllers.GlobalRoleBinding().Cache(),
+		crtbCache: mgmtControllers.ClusterRoleTemplateBinding().Cache(),
+		prtbCache: mgmtControllers.ProjectRoleTemplateBinding().Cache(),
+		crCache:   rbacControllers.ClusterRole().Cache(),
+	}
+}
+
+// CheckClusterRoleTemplateBinding returns an error if the user can't grant the role template in the cluster.
+func (e *EscalationChecker) CheckClusterRoleTemplateBinding(u user.Info, clusterName, roleTemplateName string) error {
+	requested, err := e.roleTemplateRules(roleTemplateName)
+	if err != nil {
+		return err
+	}
+	owned, global, err := e.clusterRules(u, clusterName)
+	if err != nil {
+		return err
+	}
+	return checkCovered(u, global, owned, requested, escalateRoleTemplatesRule,
+		fmt.Sprintf("role template %s in cluster %s", roleTemplateName, clusterName))
+}
+
+// CheckProjectRoleTemplateBinding returns an error if the user can't grant the role template in the project, whose name
+// is in the <cluster>:<project> format.
+func (e *EscalationChecker) CheckProjectRoleTemplateBinding(u user.Info, projectName, roleTemplateName string) error {
+	clusterName, _, ok := strings.Cut(projectName, ":")
+	if !ok {
+		return fmt.Errorf("invalid project name %s", projectName)
+	}
+	requested, err := e.roleTemplateRules(roleTemplateName)
+	if err != nil {
+		return err
+	}
+	owned, global, err := e.clusterRules(u, clusterName)
+	if err != nil {
+		return err
+	}
+
+	prtbs, err := e.prtbCache.List("", labels.Everything())
+	if err != nil {
+		return fmt.Errorf("listing project role template bindings: %w", err)
+	}
+	for _, prtb := range prtbs {
+		if prtb.ProjectName != projectName || !bindsUser(u, prtb.UserName, prtb.GroupPrincipalName) {
+			continue
+		}
+		rules, err := e.roleTemplateRules(prtb.RoleTemplateName)
+		if err != nil {
+			return err
+		}
+		owned = append(owned, rules...)
+	}
+	return checkCovered(u, global, owned, requested, escalateRoleTemplatesRule,
+		fmt.Sprintf("role template %s in project %s", roleTemplateName, projectName))
+}
+
+// CheckGlobalRoleBinding returns an error if the user can't grant the global role.
+func (e *EscalationChecker) CheckGlobalRoleBinding(u user.Info, globalRoleName string) error {
+	gr, err := e.grCache.Get(globalRoleName)
+	if err != nil {
+		return fmt.Errorf("getting global role %s: %w", globalRoleName, err)
+	}
+	ownedGlobal, ownedCluster, err := e.globalRules(u)
+	if err != nil {
+		return err
+	}
+
+	requestedGlobal := slices.Clone(gr.Rules)
+	for _, rules := range gr.NamespacedRules {
+		requestedGlobal = append(requestedGlobal, rules...)
+	}
+	if err := checkCovered(u, ownedGlobal, ownedGlobal, requestedGlobal, escalateGlobalRolesRule,
+		fmt.Sprintf("global role %s", globalRoleName)); err != nil {
+		return err
+	}
+
+	var requestedCluster []rbacv1.PolicyRule
+	for _, name := range gr.InheritedClusterRoles {
+		rules, err := e.roleTemplateRules(name)
+		if err != nil {
+			return err
+		}
+		requestedCluster = append(requestedCluster, rules...)
+	}
+	return checkCovered(u, ownedGlobal, ownedCluster, requestedCluster, escalateGlobalRolesRule,
+		fmt.Sprintf("global role %s in the downstream clusters", globalRoleName))
+}
+
+// CheckUserBindings returns an error if the user can't grant the global roles and role templates bound to the user with
+// the ID, e.g. before its bindings are moved to another user.
+func (e *EscalationChecker) CheckUserBindings(u user.Info, userID string) error {
+	var errs []error
+	grbs, err := e.grbCache.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("listing global role bindings: %w", err)
+	}
+	for _, grb := range grbs {
+		if grb.UserName == userID {
+			errs = append(errs, e.CheckGlobalRoleBinding(u, grb.GlobalRoleName))
+		}
+	}
+
+	crtbs, err := e.crtbCache.List("", labels.Everything())
+	if err != nil {
+		return fmt.Errorf("listing cluster role template bindings: %w", err)
+	}
+	for _, crtb := range crtbs {
+		if crtb.UserName == userID {
+			errs = append(errs, e.CheckClusterRoleTemplateBinding(u, crtb.ClusterName, crtb.RoleTemplateName))
+		}
+	}
+
+	prtbs, err := e.prtbCache.List("", labels.Everything())
+	if err != nil {
+		return fmt.Errorf("listing project role template bindings: %w", err)
+	}
+	for _, prtb := range prtbs {
+		if prtb.UserName == userID {
+			errs = append(errs, e.CheckProjectRoleTemplateBinding(u, prtb.ProjectName, prtb.RoleTemplateName))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// globalRules returns the rules of the global roles bound to the user, and the rules their inherited cluster roles grant
+// in the downstream clusters.
+func (e *EscalationChecker) globalRules(u user.Info) ([]rbacv1.PolicyRule, []rbacv1.PolicyRule, error) {
+	grbs, err := e.grbCache.List(labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing global role bindings: %w", err)
+	}
+
+	var global, cluster []rbacv1.PolicyRule
+	for _, grb := range grbs {
+		if !bindsUser(u, grb.UserName, grb.GroupPrincipalName) {
+			continue
+		}
+		gr, err := e.grCache.Get(grb.GlobalRoleName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting global role %s: %w", grb.GlobalRoleName, err)
+		}
+		global = append(global, gr.Rules...)
+		for _, name := range gr.InheritedClusterRoles {
+			rules, err := e.roleTemplateRules(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			cluster = append(cluster, rules...)
+		}
+	}
+	return global, cluster, nil
+}
+
+// clusterRules returns the rules the user holds in the cluster, and the rules of their global roles.
+func (e *EscalationChecker) clusterRules(u user.Info, clusterName string) ([]rbacv1.PolicyRule, []rbacv1.PolicyRule, error) {
+	global, inherited, err := e.globalRules(u)
+	if err != nil {
+		return nil, nil, err
+	}
+	var owned []rbacv1.PolicyRule
+	if clusterName == localClusterName {
+		owned = slices.Clone(global)
+	} else {
+		owned = slices.Clone(inherited)
+	}
+
+	crtbs, err := e.crtbCache.List(clusterName, labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing cluster role template bindings: %w", err)
+	}
+	for _, crtb := range crtbs {
+		if crtb.ClusterName != clusterName || !bindsUser(u, crtb.UserName, crtb.GroupPrincipalName) {
+			continue
+		}
+		rules, err := e.roleTemplateRules(crtb.RoleTemplateName)
+		if err != nil {
+			return nil, nil, err
+		}
+		owned = append(owned, rules...)
+	}
+	return owned, global, nil
+}
+
+// roleTemplateRules returns the rules of the role template, including the ones of the role templates it inherits.
+func (e *EscalationChecker) roleTemplateRules(name string) ([]rbacv1.PolicyRule, error) {
+	rt, err := e.rtCache.Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting role template %s: %w", name, err)
+	}
+	rules, err := RulesFromTemplate(e.crCache, e.rtCache, rt)
+	if err != nil {
+		return nil, fmt.Errorf("getting the rules of role template %s: %w", name, err)
+	}
+	return toLowerVerbs(rules), nil
+}
+
+// RequestUser returns the authenticated user making an API request, from its context. The impersonation headers are
+// not trusted, requests without an authenticated user are denied.
+func RequestUser(req *http.Request) (user.Info, bool) {
+	return request.UserFrom(req.Context())
+}
+
+func bindsUser(u user.Info, userName, groupPrincipalName string) bool {
+	if userName != "" {
+		return userName == u.GetName()
+	}
+	return groupPrincipalName != "" && slices.Contains(u.GetGroups(), groupPrincipalName)
+}
+
+// checkCovered returns an error listing the requested rules the owned rules don't cover, unless the global or owned
+// rules include the escalate rule.
+func checkCovered(u user.Info, global, owned, requested []rbacv1.PolicyRule, escalate rbacv1.PolicyRule, target string) error {
+	if len(requested) == 0 {
+		return nil
+	}
+	if covered, _ := validation.Covers(slices.Concat(global, owned), []rbacv1.PolicyRule{escalate}); covered {
+		return nil
+	}
+	covered, missing := validation.Covers(owned, requested)
+	if covered {
+		return nil
+	}
+	descriptions := make([]string, 0, len(missing))
+	for _, rule := range missing {
+		descriptions = append(descriptions, describeRule(rule))
+	}
+	return fmt.Errorf("user %s cannot grant %s, as they don't have the permissions it grants: %s",
+		u.GetName(), target, strings.Join(descriptions, ", "))
+}
+
+func describeRule(rule rbacv1.PolicyRule) string {
+	if len(rule.NonResourceURLs) > 0 {
+		return fmt.Sprintf("{verbs=%v nonResourceURLs=%v}", rule.Verbs, rule.NonResourceURLs)
+	}
+	description := fmt.Sprintf("{verbs=%v apiGroups=%v resources=%v", rule.Verbs, rule.APIGroups, rule.Resources)
+	if len(rule.ResourceNames) > 0 {
+		description += fmt.Sprintf(" resourceNames=%v", rule.ResourceNames)
+	}
+	return description + "}"
+}
+
+func toLowerVerbs(rules []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	lowered := make([]rbacv1.PolicyRule, 0, len(rules))
+	for _, rule := range rules {
+		rule := *rule.DeepCopy()
+		for i, verb := range rule.Verbs {
+			rule.Verbs[i] = strings.ToLower(verb)
+		}
+		lowered = append(lowered, rule)
+	}
+	return lowered
+}
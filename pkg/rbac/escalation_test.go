@@ -0,0 +1,433 @@
+package rbac
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+var (
+	readPods  = rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}}
+	writePods = rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"Create", "Delete"}}
+	allRules  = rbacv1.PolicyRule{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}
+
+	escalationRoleTemplates = map[string]*v3.RoleTemplate{
+		"view-pods":     {ObjectMeta: metav1.ObjectMeta{Name: "view-pods"}, Rules: []rbacv1.PolicyRule{readPods}},
+		"edit-pods":     {ObjectMeta: metav1.ObjectMeta{Name: "edit-pods"}, Rules: []rbacv1.PolicyRule{writePods}, RoleTemplateNames: []string{"view-pods"}},
+		"cluster-owner": {ObjectMeta: metav1.ObjectMeta{Name: "cluster-owner"}, Rules: []rbacv1.PolicyRule{allRules}},
+		"external":      {ObjectMeta: metav1.ObjectMeta{Name: "external"}, External: true, ExternalRules: []rbacv1.PolicyRule{readPods}},
+		"external-cr":   {ObjectMeta: metav1.ObjectMeta{Name: "external-cr"}, External: true, Context: "cluster"},
+		"broken":        {ObjectMeta: metav1.ObjectMeta{Name: "broken"}, RoleTemplateNames: []string{"missing"}},
+	}
+
+	escalationGlobalRoles = map[string]*v3.GlobalRole{
+		"user": {ObjectMeta: metav1.ObjectMeta{Name: "user"}, Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{"management.cattle.io"}, Resources: []string{"preferences"}, Verbs: []string{"*"}},
+		}},
+		"admin":            {ObjectMeta: metav1.ObjectMeta{Name: "admin"}, Rules: []rbacv1.PolicyRule{allRules}},
+		"restricted-admin": {ObjectMeta: metav1.ObjectMeta{Name: "restricted-admin"}, InheritedClusterRoles: []string{"cluster-owner"}},
+		"escalator": {ObjectMeta: metav1.ObjectMeta{Name: "escalator"}, Rules: []rbacv1.PolicyRule{
+			escalateRoleTemplatesRule, escalateGlobalRolesRule,
+		}},
+		"pods": {ObjectMeta: metav1.ObjectMeta{Name: "pods"}, Rules: []rbacv1.PolicyRule{readPods, writePods}},
+		"namespaced": {ObjectMeta: metav1.ObjectMeta{Name: "namespaced"}, NamespacedRules: map[string][]rbacv1.PolicyRule{
+			"fleet-default": {readPods},
+		}},
+	}
+)
+
+func newEscalationChecker(t *testing.T) *EscalationChecker {
+	grbs := []*v3.GlobalRoleBinding{
+		{UserName: "u-member", GlobalRoleName: "user"},
+		{UserName: "u-admin", GlobalRoleName: "admin"},
+		{UserName: "u-restricted", GlobalRoleName: "user"},
+		{UserName: "u-restricted", GlobalRoleName: "restricted-admin"},
+		{UserName: "u-escalator", GlobalRoleName: "escalator"},
+		{UserName: "u-pods", GlobalRoleName: "pods"},
+		{GroupPrincipalName: "github_team://1", GlobalRoleName: "user"},
+	}
+	crtbs := []*v3.ClusterRoleTemplateBinding{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "c-1"}, ClusterName: "c-1", UserName: "u-member", RoleTemplateName: "view-pods"},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "c-1"}, ClusterName: "c-1", UserName: "u-other", RoleTemplateName: "cluster-owner"},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "c-2"}, ClusterName: "c-2", GroupPrincipalName: "github_team://1", RoleTemplateName: "edit-pods"},
+	}
+	prtbs := []*v3.ProjectRoleTemplateBinding{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "c-1-p-1"}, ProjectName: "c-1:p-1", UserName: "u-member", RoleTemplateName: "edit-pods"},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "p-2"}, ProjectName: "c-1:p-2", UserName: "u-other", RoleTemplateName: "cluster-owner"},
+	}
+
+	ctrl := gomock.NewController(t)
+	rtCache := fake.NewMockNonNamespacedCacheInterface[*v3.RoleTemplate](ctrl)
+	rtCache.EXPECT().Get(gomock.Any()).DoAndReturn(func(name string) (*v3.RoleTemplate, error) {
+		if rt, ok := escalationRoleTemplates[name]; ok {
+			return rt, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+	}).AnyTimes()
+	grCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRole](ctrl)
+	grCache.EXPECT().Get(gomock.Any()).DoAndReturn(func(name string) (*v3.GlobalRole, error) {
+		if gr, ok := escalationGlobalRoles[name]; ok {
+			return gr, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+	}).AnyTimes()
+	grbCache := fake.NewMockNonNamespacedCacheInterface[*v3.GlobalRoleBinding](ctrl)
+	grbCache.EXPECT().List(labels.Everything()).Return(grbs, nil).AnyTimes()
+	crtbCache := fake.NewMockCacheInterface[*v3.ClusterRoleTemplateBinding](ctrl)
+	crtbCache.EXPECT().List(gomock.Any(), labels.Everything()).DoAndReturn(func(namespace string, _ labels.Selector) ([]*v3.ClusterRoleTemplateBinding, error) {
+		var result []*v3.ClusterRoleTemplateBinding
+		for _, crtb := range crtbs {
+			if namespace == "" || crtb.Namespace == namespace {
+				result = append(result, crtb)
+			}
+		}
+		return result, nil
+	}).AnyTimes()
+	// The project role template bindings are in the backing namespaces of the projects.
+	prtbCache := fake.NewMockCacheInterface[*v3.ProjectRoleTemplateBinding](ctrl)
+	prtbCache.EXPECT().List("", labels.Everything()).Return(prtbs, nil).AnyTimes()
+	crCache := fake.NewMockNonNamespacedCacheInterface[*rbacv1.ClusterRole](ctrl)
+	crCache.EXPECT().Get(gomock.Any()).DoAndReturn(func(name string) (*rbacv1.ClusterRole, error) {
+		if name == "external-cr" {
+			return &rbacv1.ClusterRole{Rules: []rbacv1.PolicyRule{writePods}}, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+	}).AnyTimes()
+
+	return &EscalationChecker{
+		rtCache:   rtCache,
+		grCache:   grCache,
+		grbCache:  grbCache,
+		crtbCache: crtbCache,
+		prtbCache: prtbCache,
+		crCache:   crCache,
+	}
+}
+
+func TestCheckClusterRoleTemplateBinding(t *testing.T) {
+	tests := []struct {
+		name         string
+		user         user.Info
+		clusterName  string
+		roleTemplate string
+		wantErr      string
+	}{
+		{
+			name:         "grants held role template",
+			user:         &user.DefaultInfo{Name: "u-member"},
+			clusterName:  "c-1",
+			roleTemplate: "view-pods",
+		},
+		{
+			name:         "grants role template covered by held rules",
+			user:         &user.DefaultInfo{Name: "u-member"},
+			clusterName:  "c-1",
+			roleTemplate: "external",
+		},
+		{
+			name:         "cannot grant more than held",
+			user:         &user.DefaultInfo{Name: "u-member"},
+			clusterName:  "c-1",
+			roleTemplate: "edit-pods",
+			wantErr:      "user u-member cannot grant role template edit-pods in cluster c-1",
+		},
+		{
+			name:         "permissions are scoped to the cluster",
+			user:         &user.DefaultInfo{Name: "u-member"},
+			clusterName:  "c-2",
+			roleTemplate: "view-pods",
+			wantErr:      "user u-member cannot grant role template view-pods in cluster c-2",
+		},
+		{
+			name:         "permissions held through a group",
+			user:         &user.DefaultInfo{Name: "u-member", Groups: []string{"github_team://1"}},
+			clusterName:  "c-2",
+			roleTemplate: "edit-pods",
+		},
+		{
+			name:         "external role template rules from the cluster role",
+			user:         &user.DefaultInfo{Name: "u-member", Groups: []string{"github_team://1"}},
+			clusterName:  "c-2",
+			roleTemplate: "external-cr",
+		},
+		{
+			name:         "admin grants any role template",
+			user:         &user.DefaultInfo{Name: "u-admin"},
+			clusterName:  "c-3",
+			roleTemplate: "cluster-owner",
+		},
+		{
+			name:         "inherited cluster roles grant downstream permissions",
+			user:         &user.DefaultInfo{Name: "u-restricted"},
+			clusterName:  "c-3",
+			roleTemplate: "cluster-owner",
+		},
+		{
+			name:         "inherited cluster roles don't grant local permissions",
+			user:         &user.DefaultInfo{Name: "u-restricted"},
+			clusterName:  "local",
+			roleTemplate: "view-pods",
+			wantErr:      "user u-restricted cannot grant role template view-pods in cluster local",
+		},
+		{
+			name:         "global role rules grant local permissions",
+			user:         &user.DefaultInfo{Name: "u-pods"},
+			clusterName:  "local",
+			roleTemplate: "edit-pods",
+		},
+		{
+			name:         "global role rules don't grant downstream permissions",
+			user:         &user.DefaultInfo{Name: "u-pods"},
+			clusterName:  "c-3",
+			roleTemplate: "view-pods",
+			wantErr:      "user u-pods cannot grant role template view-pods in cluster c-3",
+		},
+		{
+			name:         "escalate verb allows granting any role template",
+			user:         &user.DefaultInfo{Name: "u-escalator"},
+			clusterName:  "c-1",
+			roleTemplate: "cluster-owner",
+		},
+		{
+			name:         "unresolvable role template",
+			user:         &user.DefaultInfo{Name: "u-admin"},
+			clusterName:  "c-1",
+			roleTemplate: "broken",
+			wantErr:      "getting the rules of role template broken",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newEscalationChecker(t).CheckClusterRoleTemplateBinding(tt.user, tt.clusterName, tt.roleTemplate)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckProjectRoleTemplateBinding(t *testing.T) {
+	tests := []struct {
+		name         string
+		user         user.Info
+		projectName  string
+		roleTemplate string
+		wantErr      string
+	}{
+		{
+			name:         "grants role template held in the project",
+			user:         &user.DefaultInfo{Name: "u-member"},
+			projectName:  "c-1:p-1",
+			roleTemplate: "edit-pods",
+		},
+		{
+			name:         "grants role template held in the cluster",
+			user:         &user.DefaultInfo{Name: "u-member"},
+			projectName:  "c-1:p-2",
+			roleTemplate: "view-pods",
+		},
+		{
+			name:         "cluster owner grants any role template in its projects",
+			user:         &user.DefaultInfo{Name: "u-other"},
+			projectName:  "c-1:p-1",
+			roleTemplate: "edit-pods",
+		},
+		{
+			name:         "permissions are scoped to the project",
+			user:         &user.DefaultInfo{Name: "u-member"},
+			projectName:  "c-1:p-2",
+			roleTemplate: "edit-pods",
+			wantErr:      "user u-member cannot grant role template edit-pods in project c-1:p-2",
+		},
+		{
+			name:         "cannot grant more than held",
+			user:         &user.DefaultInfo{Name: "u-member"},
+			projectName:  "c-1:p-1",
+			roleTemplate: "cluster-owner",
+			wantErr:      "user u-member cannot grant role template cluster-owner in project c-1:p-1",
+		},
+		{
+			name:         "invalid project name",
+			user:         &user.DefaultInfo{Name: "u-admin"},
+			projectName:  "p-1",
+			roleTemplate: "view-pods",
+			wantErr:      "invalid project name p-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newEscalationChecker(t).CheckProjectRoleTemplateBinding(tt.user, tt.projectName, tt.roleTemplate)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckGlobalRoleBinding(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       user.Info
+		globalRole string
+		wantErr    string
+	}{
+		{
+			name:       "grants held global role",
+			user:       &user.DefaultInfo{Name: "u-member"},
+			globalRole: "user",
+		},
+		{
+			name:       "grants held global role through a group",
+			user:       &user.DefaultInfo{Name: "u-none", Groups: []string{"github_team://1"}},
+			globalRole: "user",
+		},
+		{
+			name:       "cannot grant more than held",
+			user:       &user.DefaultInfo{Name: "u-member"},
+			globalRole: "admin",
+			wantErr:    "user u-member cannot grant global role admin",
+		},
+		{
+			name:       "cannot grant namespaced rules not held",
+			user:       &user.DefaultInfo{Name: "u-member"},
+			globalRole: "namespaced",
+			wantErr:    "user u-member cannot grant global role namespaced",
+		},
+		{
+			name:       "cannot grant inherited cluster roles not held",
+			user:       &user.DefaultInfo{Name: "u-member"},
+			globalRole: "restricted-admin",
+			wantErr:    "user u-member cannot grant global role restricted-admin in the downstream clusters",
+		},
+		{
+			name:       "grants held inherited cluster roles",
+			user:       &user.DefaultInfo{Name: "u-restricted"},
+			globalRole: "restricted-admin",
+		},
+		{
+			name:       "cannot grant local permissions with inherited cluster roles",
+			user:       &user.DefaultInfo{Name: "u-restricted"},
+			globalRole: "admin",
+			wantErr:    "user u-restricted cannot grant global role admin",
+		},
+		{
+			name:       "admin grants any global role",
+			user:       &user.DefaultInfo{Name: "u-admin"},
+			globalRole: "restricted-admin",
+		},
+		{
+			name:       "escalate verb allows granting any global role",
+			user:       &user.DefaultInfo{Name: "u-escalator"},
+			globalRole: "admin",
+		},
+		{
+			name:       "global role not found",
+			user:       &user.DefaultInfo{Name: "u-admin"},
+			globalRole: "missing",
+			wantErr:    "getting global role missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newEscalationChecker(t).CheckGlobalRoleBinding(tt.user, tt.globalRole)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckUserBindings(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    user.Info
+		userID  string
+		wantErr []string
+	}{
+		{
+			name:   "admin grants the bindings of any user",
+			user:   &user.DefaultInfo{Name: "u-admin"},
+			userID: "u-other",
+		},
+		{
+			name:   "grants held bindings",
+			user:   &user.DefaultInfo{Name: "u-member"},
+			userID: "u-member",
+		},
+		{
+			name:   "user without bindings",
+			user:   &user.DefaultInfo{Name: "u-member"},
+			userID: "u-none",
+		},
+		{
+			name:   "cannot grant the bindings of a more privileged user",
+			user:   &user.DefaultInfo{Name: "u-member"},
+			userID: "u-other",
+			wantErr: []string{
+				"user u-member cannot grant role template cluster-owner in cluster c-1",
+				"user u-member cannot grant role template cluster-owner in project c-1:p-2",
+			},
+		},
+		{
+			name:    "cannot grant the global roles of a more privileged user",
+			user:    &user.DefaultInfo{Name: "u-member"},
+			userID:  "u-admin",
+			wantErr: []string{"user u-member cannot grant global role admin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newEscalationChecker(t).CheckUserBindings(tt.user, tt.userID)
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestRequestUser(t *testing.T) {
+	req := httptest.NewRequest("POST", "/v3/clusterroletemplatebindings", nil)
+	_, ok := RequestUser(req)
+	assert.False(t, ok)
+
+	// the impersonation headers are not trusted
+	req.Header.Set("Impersonate-User", "u-admin")
+	req.Header.Add("Impersonate-Group", "system:authenticated")
+	_, ok = RequestUser(req)
+	assert.False(t, ok)
+
+	req = req.WithContext(request.WithUser(req.Context(), &user.DefaultInfo{Name: "u-admin"}))
+	u, ok := RequestUser(req)
+	require.True(t, ok)
+	assert.Equal(t, "u-admin", u.GetName())
+}
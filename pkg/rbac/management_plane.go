@@ -0,0 +1,103 @@
+package rbac
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Management plane resources are resources of the management plane scoped to a cluster or a project. They live in the
+// namespace of their cluster or the backing namespace of their project, and the rules of a RoleTemplate for them are
+// granted in that namespace only, by a Role bound for each CRTB or PRTB of the RoleTemplate.
+//
+// Packages adding a CRD of such a resource register it with RegisterClusterManagementPlaneResource or
+// RegisterProjectManagementPlaneResource, typically from an init function, so that the CRTB/PRTB controllers grant
+// access to it.
+var managementPlaneResources = struct {
+	sync.RWMutex
+	cluster map[string]string
+	project map[string]string
+}{
+	cluster: map[string]string{
+		"clusterscans":                "management.cattle.io",
+		"clusterregistrationtokens":   "management.cattle.io",
+		"clusterroletemplatebindings": "management.cattle.io",
+		"etcdbackups":                 "management.cattle.io",
+		"nodes":                       "management.cattle.io",
+		"nodepools":                   "management.cattle.io",
+		"projects":                    "management.cattle.io",
+		"etcdsnapshots":               "rke.cattle.io",
+	},
+	project: map[string]string{
+		"projectroletemplatebindings": "management.cattle.io",
+		"secrets":                     "",
+	},
+}
+
+// RegisterClusterManagementPlaneResource registers a resource living in the namespace of a cluster. The rules of
+// cluster RoleTemplates for it are granted in that namespace.
+func RegisterClusterManagementPlaneResource(resource, apiGroup string) error {
+	return registerManagementPlaneResource(&managementPlaneResources.cluster, resource, apiGroup)
+}
+
+// RegisterProjectManagementPlaneResource registers a resource living in the backing namespace of a project. The rules
+// of project RoleTemplates for it are granted in that namespace, as well as the ones of cluster RoleTemplates in all
+// the projects of the cluster.
+func RegisterProjectManagementPlaneResource(resource, apiGroup string) error {
+	return registerManagementPlaneResource(&managementPlaneResources.project, resource, apiGroup)
+}
+
+// ClusterManagementPlaneResources returns the API groups of the cluster management plane resources, by resource.
+func ClusterManagementPlaneResources() map[string]string {
+	managementPlaneResources.RLock()
+	defer managementPlaneResources.RUnlock()
+	return maps.Clone(managementPlaneResources.cluster)
+}
+
+// ProjectManagementPlaneResources returns the API groups of the project management plane resources, by resource.
+func ProjectManagementPlaneResources() map[string]string {
+	managementPlaneResources.RLock()
+	defer managementPlaneResources.RUnlock()
+	return maps.Clone(managementPlaneResources.project)
+}
+
+func registerManagementPlaneResource(resources *map[string]string, resource, apiGroup string) error {
+	if err := validateManagementPlaneResource(resource, apiGroup); err != nil {
+		return err
+	}
+
+	managementPlaneResources.Lock()
+	defer managementPlaneResources.Unlock()
+	if registered, ok := (*resources)[resource]; ok {
+		if registered != apiGroup {
+			return fmt.Errorf("management plane resource %s is already registered in API group %q", resource, registered)
+		}
+		return nil
+	}
+	(*resources)[resource] = apiGroup
+	return nil
+}
+
+// validateManagementPlaneResource checks that the resource and API group name a single resource. The API group is
+// empty for the core API group.
+func validateManagementPlaneResource(resource, apiGroup string) error {
+	if resource == rbacv1.ResourceAll || apiGroup == rbacv1.APIGroupAll {
+		return fmt.Errorf("management plane resource %s in API group %q: wildcards aren't allowed", resource, apiGroup)
+	}
+	if strings.Contains(resource, "/") {
+		return fmt.Errorf("management plane resource %s: subresources aren't allowed", resource)
+	}
+	if errs := validation.IsDNS1123Label(resource); len(errs) > 0 {
+		return fmt.Errorf("management plane resource %s: %s", resource, strings.Join(errs, ", "))
+	}
+	if apiGroup != "" {
+		if errs := validation.IsDNS1123Subdomain(apiGroup); len(errs) > 0 {
+			return fmt.Errorf("API group %s of management plane resource %s: %s", apiGroup, resource, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
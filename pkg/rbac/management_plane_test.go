@@ -0,0 +1,141 @@
+package rbac
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// restoreManagementPlaneResources restores the registered management plane resources at the end of the test.
+func restoreManagementPlaneResources(t *testing.T) {
+	cluster := ClusterManagementPlaneResources()
+	project := ProjectManagementPlaneResources()
+	t.Cleanup(func() {
+		managementPlaneResources.Lock()
+		defer managementPlaneResources.Unlock()
+		managementPlaneResources.cluster = cluster
+		managementPlaneResources.project = project
+	})
+}
+
+func TestRegisterManagementPlaneResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		register func(resource, apiGroup string) error
+		get      func() map[string]string
+		resource string
+		apiGroup string
+		wantErr  string
+	}{
+		{
+			name:     "cluster resource",
+			register: RegisterClusterManagementPlaneResource,
+			get:      ClusterManagementPlaneResources,
+			resource: "clusterwidgets",
+			apiGroup: "widgets.cattle.io",
+		},
+		{
+			name:     "project resource in the core API group",
+			register: RegisterProjectManagementPlaneResource,
+			get:      ProjectManagementPlaneResources,
+			resource: "configmaps",
+			apiGroup: "",
+		},
+		{
+			name:     "resource registered again in the same API group",
+			register: RegisterProjectManagementPlaneResource,
+			get:      ProjectManagementPlaneResources,
+			resource: "secrets",
+			apiGroup: "",
+		},
+		{
+			name:     "resource registered in another API group",
+			register: RegisterClusterManagementPlaneResource,
+			get:      ClusterManagementPlaneResources,
+			resource: "nodes",
+			apiGroup: "widgets.cattle.io",
+			wantErr:  `management plane resource nodes is already registered in API group "management.cattle.io"`,
+		},
+		{
+			name:     "wildcard resource",
+			register: RegisterClusterManagementPlaneResource,
+			get:      ClusterManagementPlaneResources,
+			resource: "*",
+			apiGroup: "widgets.cattle.io",
+			wantErr:  "wildcards aren't allowed",
+		},
+		{
+			name:     "wildcard API group",
+			register: RegisterProjectManagementPlaneResource,
+			get:      ProjectManagementPlaneResources,
+			resource: "widgets",
+			apiGroup: "*",
+			wantErr:  "wildcards aren't allowed",
+		},
+		{
+			name:     "subresource",
+			register: RegisterProjectManagementPlaneResource,
+			get:      ProjectManagementPlaneResources,
+			resource: "widgets/status",
+			apiGroup: "widgets.cattle.io",
+			wantErr:  "subresources aren't allowed",
+		},
+		{
+			name:     "invalid resource",
+			register: RegisterProjectManagementPlaneResource,
+			get:      ProjectManagementPlaneResources,
+			resource: "Widgets",
+			apiGroup: "widgets.cattle.io",
+			wantErr:  "management plane resource Widgets",
+		},
+		{
+			name:     "invalid API group",
+			register: RegisterProjectManagementPlaneResource,
+			get:      ProjectManagementPlaneResources,
+			resource: "widgets",
+			apiGroup: "widgets_cattle",
+			wantErr:  "API group widgets_cattle of management plane resource widgets",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreManagementPlaneResources(t)
+			before := tt.get()
+
+			err := tt.register(tt.resource, tt.apiGroup)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				assert.Equal(t, before, tt.get())
+				return
+			}
+			require.NoError(t, err)
+			want := maps.Clone(before)
+			want[tt.resource] = tt.apiGroup
+			assert.Equal(t, want, tt.get())
+		})
+	}
+}
+
+func TestManagementPlaneResourcesAreCopies(t *testing.T) {
+	restoreManagementPlaneResources(t)
+
+	resources := ClusterManagementPlaneResources()
+	resources["clusterwidgets"] = "widgets.cattle.io"
+
+	assert.NotContains(t, ClusterManagementPlaneResources(), "clusterwidgets")
+}
+
+func TestRegisteredManagementPlaneResourcesAreScoped(t *testing.T) {
+	restoreManagementPlaneResources(t)
+
+	require.NoError(t, RegisterClusterManagementPlaneResource("clusterwidgets", "widgets.cattle.io"))
+	require.NoError(t, RegisterProjectManagementPlaneResource("projectwidgets", "widgets.cattle.io"))
+
+	assert.Equal(t, "widgets.cattle.io", ClusterManagementPlaneResources()["clusterwidgets"])
+	assert.NotContains(t, ClusterManagementPlaneResources(), "projectwidgets")
+	assert.Equal(t, "widgets.cattle.io", ProjectManagementPlaneResources()["projectwidgets"])
+	assert.NotContains(t, ProjectManagementPlaneResources(), "clusterwidgets")
+}
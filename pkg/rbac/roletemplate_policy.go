@@ -0,0 +1,157 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v32 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	k8srbacv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// RoleTemplatePolicyViolationAnnotation is set on the RoleTemplates granting a rule forbidden by a RoleTemplatePolicy to
+// the description of the forbidden rules.
+const RoleTemplatePolicyViolationAnnotation = "authz.management.cattle.io/policy-violation"
+
+// CheckRoleTemplateChange returns an error if the RoleTemplate, or a RoleTemplate inheriting it directly or through other
+// RoleTemplates, grants a rule forbidden by the policies once the RoleTemplate is created or updated to rt.
+func CheckRoleTemplateChange(clusterRoles k8srbacv1.ClusterRoleCache, roleTemplates v32.RoleTemplateCache, policies []*v3.RoleTemplatePolicy, rt *v3.RoleTemplate) error {
+	roleTemplates = &changedRoleTemplateCache{RoleTemplateCache: roleTemplates, rt: rt}
+	inheriting, err := InheritingRoleTemplates(roleTemplates, rt.Name)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, template := range append([]*v3.RoleTemplate{rt}, inheriting...) {
+		rules, err := RulesFromTemplate(clusterRoles, roleTemplates, template)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to gather the rules of roleTemplate %s: %w", template.Name, err))
+			continue
+		}
+		errs = append(errs, CheckRoleTemplatePolicies(policies, template, rules))
+	}
+	return errors.Join(errs...)
+}
+
+// InheritingRoleTemplates returns the RoleTemplates inheriting the RoleTemplate with the name, directly or through other
+// RoleTemplates, sorted by name.
+func InheritingRoleTemplates(roleTemplates v32.RoleTemplateCache, name string) ([]*v3.RoleTemplate, error) {
+	all, err := roleTemplates.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roleTemplates: %w", err)
+	}
+
+	inherited := map[string]bool{name: true}
+	var inheriting []*v3.RoleTemplate
+	for found := true; found; {
+		found = false
+		for _, rt := range all {
+			if inherited[rt.Name] || !slices.ContainsFunc(rt.RoleTemplateNames, func(n string) bool { return inherited[n] }) {
+				continue
+			}
+			inherited[rt.Name] = true
+			inheriting = append(inheriting, rt)
+			found = true
+		}
+	}
+	slices.SortFunc(inheriting, func(a, b *v3.RoleTemplate) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return inheriting, nil
+}
+
+// changedRoleTemplateCache returns the changed RoleTemplate in place of the RoleTemplate with the same name of the cache.
+type changedRoleTemplateCache struct {
+	v32.RoleTemplateCache
+	rt *v3.RoleTemplate
+}
+
+func (c *changedRoleTemplateCache) Get(name string) (*v3.RoleTemplate, error) {
+	if name == c.rt.Name {
+		return c.rt, nil
+	}
+	return c.RoleTemplateCache.Get(name)
+}
+
+func (c *changedRoleTemplateCache) List(selector labels.Selector) ([]*v3.RoleTemplate, error) {
+	all, err := c.RoleTemplateCache.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	all = slices.DeleteFunc(slices.Clone(all), func(rt *v3.RoleTemplate) bool { return rt.Name == c.rt.Name })
+	if selector.Matches(labels.Set(c.rt.Labels)) {
+		all = append(all, c.rt)
+	}
+	return all, nil
+}
+
+// CheckRoleTemplatePolicies returns an error describing each rule of the RoleTemplate granting a rule forbidden by the
+// policies. The rules must include the rules the RoleTemplate inherits, see RulesFromTemplate. Builtin RoleTemplates
+// aren't subject to policies.
+func CheckRoleTemplatePolicies(policies []*v3.RoleTemplatePolicy, rt *v3.RoleTemplate, rules []rbacv1.PolicyRule) error {
+	if rt.Builtin {
+		return nil
+	}
+
+	policies = slices.Clone(policies)
+	slices.SortFunc(policies, func(a, b *v3.RoleTemplatePolicy) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var violations []string
+	for _, policy := range policies {
+		for i, forbidden := range policy.Spec.ForbiddenRules {
+			if len(forbidden.Contexts) > 0 && !slices.Contains(forbidden.Contexts, rt.Context) {
+				continue
+			}
+			for _, rule := range rules {
+				if !grantsForbiddenRule(rule, forbidden) {
+					continue
+				}
+				violation := fmt.Sprintf("rule %s is forbidden by rule %d of roleTemplatePolicy %s", ruleString(rule), i, policy.Name)
+				if forbidden.Description != "" {
+					violation += ": " + forbidden.Description
+				}
+				violations = append(violations, violation)
+			}
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return fmt.Errorf("roleTemplate %s grants forbidden rules: %s", rt.Name, strings.Join(violations, "; "))
+}
+
+// grantsForbiddenRule returns true if the rule grants any of the verbs of the forbidden rule on any of its resources in
+// any of its API groups. Non-resource rules never grant a forbidden rule.
+func grantsForbiddenRule(rule rbacv1.PolicyRule, forbidden v3.ForbiddenRule) bool {
+	if len(rule.Resources) == 0 {
+		return false
+	}
+	return matchesForbidden(rule.APIGroups, forbidden.APIGroups) &&
+		matchesForbidden(rule.Resources, forbidden.Resources) &&
+		matchesForbidden(rule.Verbs, forbidden.Verbs)
+}
+
+// matchesForbidden returns true if any of the values of a rule matches any of the forbidden values. No forbidden values
+// match anything, and "*" matches anything on both sides.
+func matchesForbidden(values, forbidden []string) bool {
+	if len(forbidden) == 0 || slices.Contains(forbidden, rbacv1.ResourceAll) {
+		return len(values) > 0
+	}
+	for _, value := range values {
+		if value == rbacv1.ResourceAll || slices.Contains(forbidden, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func ruleString(rule rbacv1.PolicyRule) string {
+	return fmt.Sprintf("{apiGroups: %q, resources: %q, verbs: %q}", rule.APIGroups, rule.Resources, rule.Verbs)
+}
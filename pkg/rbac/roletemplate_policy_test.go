@@ -0,0 +1,192 @@
+package rbac
+
+import (
+	"fmt"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/wrangler/v3/pkg/generic/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestCheckRoleTemplatePolicies(t *testing.T) {
+	policies := []*v3.RoleTemplatePolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "secrets"},
+			Spec: v3.RoleTemplatePolicySpec{
+				ForbiddenRules: []v3.ForbiddenRule{
+					{
+						Description: "secrets can't be read cluster-wide",
+						Contexts:    []string{"cluster"},
+						APIGroups:   []string{""},
+						Resources:   []string{"secrets"},
+						Verbs:       []string{"get", "list", "watch"},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "escalation"},
+			Spec: v3.RoleTemplatePolicySpec{
+				ForbiddenRules: []v3.ForbiddenRule{
+					{
+						APIGroups: []string{"rbac.authorization.k8s.io"},
+						Verbs:     []string{"escalate", "bind"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		rt      *v3.RoleTemplate
+		rules   []rbacv1.PolicyRule
+		wantErr string
+	}{
+		{
+			name:  "rules aren't forbidden",
+			rt:    &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "rt-1"}, Context: "cluster"},
+			rules: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods", "configmaps"}, Verbs: []string{"*"}}},
+		},
+		{
+			name:    "rule grants a forbidden verb",
+			rt:      &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "rt-1"}, Context: "cluster"},
+			rules:   []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"list"}}},
+			wantErr: `roleTemplate rt-1 grants forbidden rules: rule {apiGroups: [""], resources: ["secrets"], verbs: ["list"]} is forbidden by rule 0 of roleTemplatePolicy secrets: secrets can't be read cluster-wide`,
+		},
+		{
+			name:    "wildcards match forbidden rules",
+			rt:      &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "rt-1"}, Context: "cluster"},
+			rules:   []rbacv1.PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}},
+			wantErr: `roleTemplate rt-1 grants forbidden rules: rule {apiGroups: ["*"], resources: ["*"], verbs: ["*"]} is forbidden by rule 0 of roleTemplatePolicy escalation; rule {apiGroups: ["*"], resources: ["*"], verbs: ["*"]} is forbidden by rule 0 of roleTemplatePolicy secrets: secrets can't be read cluster-wide`,
+		},
+		{
+			name:  "rule is only forbidden in other contexts",
+			rt:    &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "rt-1"}, Context: "project"},
+			rules: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"*"}}},
+		},
+		{
+			name:    "empty forbidden resources match any resource",
+			rt:      &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "rt-1"}, Context: "project"},
+			rules:   []rbacv1.PolicyRule{{APIGroups: []string{"rbac.authorization.k8s.io"}, Resources: []string{"roles"}, Verbs: []string{"bind"}}},
+			wantErr: `roleTemplate rt-1 grants forbidden rules: rule {apiGroups: ["rbac.authorization.k8s.io"], resources: ["roles"], verbs: ["bind"]} is forbidden by rule 0 of roleTemplatePolicy escalation`,
+		},
+		{
+			name:  "non-resource rules aren't forbidden",
+			rt:    &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "rt-1"}, Context: "cluster"},
+			rules: []rbacv1.PolicyRule{{NonResourceURLs: []string{"/healthz"}, Verbs: []string{"*"}}},
+		},
+		{
+			name:  "builtin roleTemplates aren't subject to policies",
+			rt:    &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "cluster-owner"}, Context: "cluster", Builtin: true},
+			rules: []rbacv1.PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckRoleTemplatePolicies(policies, tt.rt, tt.rules)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckRoleTemplateChange(t *testing.T) {
+	policies := []*v3.RoleTemplatePolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "secrets"},
+			Spec: v3.RoleTemplatePolicySpec{
+				ForbiddenRules: []v3.ForbiddenRule{{Contexts: []string{"cluster"}, Resources: []string{"secrets"}}},
+			},
+		},
+	}
+	readSecrets := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}}
+	readPods := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}
+	roleTemplates := []*v3.RoleTemplate{
+		{ObjectMeta: metav1.ObjectMeta{Name: "base"}, Context: "project", Rules: []rbacv1.PolicyRule{readPods}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "middle"}, Context: "project", RoleTemplateNames: []string{"base"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "top"}, Context: "cluster", RoleTemplateNames: []string{"middle"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Context: "cluster", Rules: []rbacv1.PolicyRule{readPods}},
+	}
+
+	tests := []struct {
+		name    string
+		rt      *v3.RoleTemplate
+		wantErr string
+	}{
+		{
+			name: "change grants allowed rules",
+			rt:   &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "base"}, Context: "project", Rules: []rbacv1.PolicyRule{readPods}},
+		},
+		{
+			name: "change grants a rule only forbidden in the contexts of other roleTemplates",
+			rt:   &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Context: "project", Rules: []rbacv1.PolicyRule{readSecrets}},
+		},
+		{
+			name:    "change grants a forbidden rule",
+			rt:      &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Context: "cluster", Rules: []rbacv1.PolicyRule{readSecrets}},
+			wantErr: "roleTemplate other grants forbidden rules",
+		},
+		{
+			name:    "change makes a roleTemplate inheriting it through another grant a forbidden rule",
+			rt:      &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "base"}, Context: "project", Rules: []rbacv1.PolicyRule{readSecrets}},
+			wantErr: "roleTemplate top grants forbidden rules",
+		},
+		{
+			name: "change removes a roleTemplate from the inherited ones",
+			rt:   &v3.RoleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "top"}, Context: "cluster"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			rtCache := fake.NewMockNonNamespacedCacheInterface[*v3.RoleTemplate](ctrl)
+			rtCache.EXPECT().List(labels.Everything()).Return(roleTemplates, nil).AnyTimes()
+			rtCache.EXPECT().Get(gomock.Any()).DoAndReturn(func(name string) (*v3.RoleTemplate, error) {
+				for _, rt := range roleTemplates {
+					if rt.Name == name {
+						return rt, nil
+					}
+				}
+				return nil, fmt.Errorf("roleTemplate %s not found", name)
+			}).AnyTimes()
+			crCache := fake.NewMockNonNamespacedCacheInterface[*rbacv1.ClusterRole](ctrl)
+
+			err := CheckRoleTemplateChange(crCache, rtCache, policies, tt.rt)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInheritingRoleTemplates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rtCache := fake.NewMockNonNamespacedCacheInterface[*v3.RoleTemplate](ctrl)
+	rtCache.EXPECT().List(labels.Everything()).Return([]*v3.RoleTemplate{
+		{ObjectMeta: metav1.ObjectMeta{Name: "top"}, RoleTemplateNames: []string{"middle"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "middle"}, RoleTemplateNames: []string{"base"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "base"}, RoleTemplateNames: []string{"top"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "also"}, RoleTemplateNames: []string{"base", "middle"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+	}, nil)
+
+	inheriting, err := InheritingRoleTemplates(rtCache, "base")
+	require.NoError(t, err)
+	var names []string
+	for _, rt := range inheriting {
+		names = append(names, rt.Name)
+	}
+	assert.Equal(t, []string{"also", "middle", "top"}, names)
+}
@@ -0,0 +1,148 @@
+package rbac
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/component-helpers/auth/rbac/validation"
+)
+
+// Editing the fields of a RoleTemplate the RBAC of the downstream clusters is derived from produces a new revision of
+// it. The permissions the new revision grants aren't granted in all downstream clusters at once, but rolled out cluster
+// by cluster, while the permissions it removes are revoked at once. The rollout can be paused and resumed with the
+// RoleTemplateRolloutPausedAnnotation.
+const (
+	// RoleTemplateRevisionAnnotation is the revision of a RoleTemplate, incremented each time its fields are edited.
+	RoleTemplateRevisionAnnotation = "authz.management.cattle.io/revision"
+	// RoleTemplateRevisionHashAnnotation is the hash of the fields of the current revision of a RoleTemplate.
+	RoleTemplateRevisionHashAnnotation = "authz.management.cattle.io/revision-hash"
+	// RoleTemplateRevisionSpecAnnotation is the JSON of the fields of the current revision of a RoleTemplate.
+	RoleTemplateRevisionSpecAnnotation = "authz.management.cattle.io/revision-spec"
+	// RoleTemplatePreviousRevisionSpecAnnotation is the JSON of the fields of the previous revision of a RoleTemplate,
+	// which is kept in the clusters the current revision wasn't rolled out to yet. It is removed along with
+	// RoleTemplateRolloutClustersAnnotation.
+	RoleTemplatePreviousRevisionSpecAnnotation = "authz.management.cattle.io/previous-revision-spec"
+	// RoleTemplateRolloutClustersAnnotation lists, comma separated, the clusters the current revision of a RoleTemplate
+	// was rolled out to. It is removed once the revision was rolled out to all clusters.
+	RoleTemplateRolloutClustersAnnotation = "authz.management.cattle.io/rollout-clusters"
+	// RoleTemplateRolloutPausedAnnotation pauses the rollout of the current revision of a RoleTemplate when set to "true".
+	// The clusters it was already rolled out to keep it.
+	RoleTemplateRolloutPausedAnnotation = "authz.management.cattle.io/rollout-paused"
+)
+
+// roleTemplateRevision are the fields of a RoleTemplate the RBAC of the downstream clusters is derived from.
+type roleTemplateRevision struct {
+	Rules             []rbacv1.PolicyRule `json:"rules,omitempty"`
+	RoleTemplateNames []string            `json:"roleTemplateNames,omitempty"`
+	External          bool                `json:"external,omitempty"`
+	ExternalRules     []rbacv1.PolicyRule `json:"externalRules,omitempty"`
+}
+
+func newRoleTemplateRevision(rt *v3.RoleTemplate) roleTemplateRevision {
+	return roleTemplateRevision{
+		Rules:             rt.Rules,
+		RoleTemplateNames: rt.RoleTemplateNames,
+		External:          rt.External,
+		ExternalRules:     rt.ExternalRules,
+	}
+}
+
+// RoleTemplateRevisionSpec returns the JSON of the fields of the RoleTemplate its revision is made of.
+func RoleTemplateRevisionSpec(rt *v3.RoleTemplate) string {
+	// Marshaling rules and strings can't fail.
+	data, _ := json.Marshal(newRoleTemplateRevision(rt))
+	return string(data)
+}
+
+// RoleTemplateRevisionHash returns the hash of the fields of the RoleTemplate its revision is made of, which identifies
+// its revision.
+func RoleTemplateRevisionHash(rt *v3.RoleTemplate) string {
+	sum := sha256.Sum256([]byte(RoleTemplateRevisionSpec(rt)))
+	return hex.EncodeToString(sum[:])
+}
+
+// RoleTemplateRolloutClusters returns the clusters the current revision of the RoleTemplate was rolled out to, and
+// whether it is being rolled out.
+func RoleTemplateRolloutClusters(rt *v3.RoleTemplate) ([]string, bool) {
+	clusters, ok := rt.Annotations[RoleTemplateRolloutClustersAnnotation]
+	if !ok {
+		return nil, false
+	}
+	if clusters == "" {
+		return []string{}, true
+	}
+	return strings.Split(clusters, ","), true
+}
+
+// IsRoleTemplateRevisionRolledOut returns true if the current revision of the RoleTemplate can be applied to the
+// RBAC derived from it in the cluster. A RoleTemplate whose fields were edited since its revision was recorded isn't
+// rolled out anywhere until it is recorded as a new revision.
+func IsRoleTemplateRevisionRolledOut(rt *v3.RoleTemplate, clusterName string) bool {
+	if hash, ok := rt.Annotations[RoleTemplateRevisionHashAnnotation]; ok && hash != RoleTemplateRevisionHash(rt) {
+		return false
+	}
+	clusters, ok := RoleTemplateRolloutClusters(rt)
+	return !ok || slices.Contains(clusters, clusterName)
+}
+
+// RoleTemplateForCluster returns the RoleTemplate the RBAC of the cluster is derived from. Until the current revision
+// of the RoleTemplate is rolled out to the cluster, it only keeps the rules and inherited RoleTemplates the previous
+// revisions already had, so that the permissions removed by the current revision are revoked at once while the ones it
+// adds are only granted once it's rolled out. The RoleTemplate is returned as is when the previous revisions are
+// unknown.
+func RoleTemplateForCluster(rt *v3.RoleTemplate, clusterName string) *v3.RoleTemplate {
+	if rt == nil || IsRoleTemplateRevisionRolledOut(rt, clusterName) {
+		return rt
+	}
+
+	var previous []roleTemplateRevision
+	if rt.Annotations[RoleTemplateRevisionHashAnnotation] != RoleTemplateRevisionHash(rt) {
+		// The fields were edited since the current revision was recorded.
+		if revision, ok := decodeRoleTemplateRevision(rt.Annotations[RoleTemplateRevisionSpecAnnotation]); ok {
+			previous = append(previous, revision)
+		}
+	}
+	if revision, ok := decodeRoleTemplateRevision(rt.Annotations[RoleTemplatePreviousRevisionSpecAnnotation]); ok {
+		previous = append(previous, revision)
+	}
+	if len(previous) == 0 {
+		return rt
+	}
+
+	rt = rt.DeepCopy()
+	for _, revision := range previous {
+		rt.Rules = coveredRules(revision.Rules, rt.Rules)
+		rt.ExternalRules = coveredRules(revision.ExternalRules, rt.ExternalRules)
+		rt.RoleTemplateNames = slices.DeleteFunc(rt.RoleTemplateNames, func(name string) bool {
+			return !slices.Contains(revision.RoleTemplateNames, name)
+		})
+		// The ClusterRole of an external RoleTemplate isn't managed by Rancher, it's left alone until the RoleTemplate
+		// stops being external in the cluster.
+		rt.External = rt.External || revision.External
+	}
+	return rt
+}
+
+func decodeRoleTemplateRevision(spec string) (roleTemplateRevision, bool) {
+	var revision roleTemplateRevision
+	if spec == "" || json.Unmarshal([]byte(spec), &revision) != nil {
+		return revision, false
+	}
+	return revision, true
+}
+
+// coveredRules returns the rules the owned rules cover.
+func coveredRules(owned, rules []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	var covered []rbacv1.PolicyRule
+	for _, rule := range rules {
+		if ok, _ := validation.Covers(owned, []rbacv1.PolicyRule{rule}); ok {
+			covered = append(covered, rule)
+		}
+	}
+	return covered
+}
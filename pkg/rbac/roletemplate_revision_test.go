@@ -0,0 +1,167 @@
+package rbac
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsRoleTemplateRevisionRolledOut(t *testing.T) {
+	rules := []rbacv1.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}}
+	hash := RoleTemplateRevisionHash(&v3.RoleTemplate{Rules: rules})
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name: "no revision",
+			want: true,
+		},
+		{
+			name:        "revision was rolled out to all clusters",
+			annotations: map[string]string{RoleTemplateRevisionHashAnnotation: hash},
+			want:        true,
+		},
+		{
+			name:        "revision isn't recorded yet",
+			annotations: map[string]string{RoleTemplateRevisionHashAnnotation: "old"},
+		},
+		{
+			name: "revision was rolled out to the cluster",
+			annotations: map[string]string{
+				RoleTemplateRevisionHashAnnotation:    hash,
+				RoleTemplateRolloutClustersAnnotation: "c-0,c-1",
+			},
+			want: true,
+		},
+		{
+			name: "revision wasn't rolled out to the cluster yet",
+			annotations: map[string]string{
+				RoleTemplateRevisionHashAnnotation:    hash,
+				RoleTemplateRolloutClustersAnnotation: "c-0",
+			},
+		},
+		{
+			name: "revision wasn't rolled out to any cluster yet",
+			annotations: map[string]string{
+				RoleTemplateRevisionHashAnnotation:    hash,
+				RoleTemplateRolloutClustersAnnotation: "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := &v3.RoleTemplate{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations},
+				Rules:      rules,
+			}
+			assert.Equal(t, tt.want, IsRoleTemplateRevisionRolledOut(rt, "c-1"))
+		})
+	}
+}
+
+func TestRoleTemplateRevisionHash(t *testing.T) {
+	rt := &v3.RoleTemplate{
+		Rules:             []rbacv1.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+		RoleTemplateNames: []string{"view"},
+	}
+	hash := RoleTemplateRevisionHash(rt)
+
+	inherited := rt.DeepCopy()
+	inherited.RoleTemplateNames = append(inherited.RoleTemplateNames, "edit")
+	assert.NotEqual(t, hash, RoleTemplateRevisionHash(inherited))
+
+	external := rt.DeepCopy()
+	external.External = true
+	assert.NotEqual(t, hash, RoleTemplateRevisionHash(external))
+
+	externalRules := rt.DeepCopy()
+	externalRules.ExternalRules = rt.Rules
+	assert.NotEqual(t, hash, RoleTemplateRevisionHash(externalRules))
+
+	renamed := rt.DeepCopy()
+	renamed.DisplayName = "renamed"
+	assert.Equal(t, hash, RoleTemplateRevisionHash(renamed))
+}
+
+func TestRoleTemplateForCluster(t *testing.T) {
+	getPods := rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}
+	getUpdatePods := rbacv1.PolicyRule{Verbs: []string{"get", "update"}, APIGroups: []string{""}, Resources: []string{"pods"}}
+	listSecrets := rbacv1.PolicyRule{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"secrets"}}
+
+	previous := &v3.RoleTemplate{Rules: []rbacv1.PolicyRule{getUpdatePods}, RoleTemplateNames: []string{"view", "edit"}}
+	current := &v3.RoleTemplate{Rules: []rbacv1.PolicyRule{getPods, listSecrets}, RoleTemplateNames: []string{"view", "admin"}}
+	newRoleTemplate := func(annotations map[string]string) *v3.RoleTemplate {
+		rt := current.DeepCopy()
+		rt.Annotations = annotations
+		return rt
+	}
+
+	tests := []struct {
+		name              string
+		rt                *v3.RoleTemplate
+		wantRules         []rbacv1.PolicyRule
+		wantRoleTemplates []string
+		wantExternal      bool
+	}{
+		{
+			name:              "revision was rolled out to the cluster",
+			rt:                newRoleTemplate(map[string]string{RoleTemplateRevisionHashAnnotation: RoleTemplateRevisionHash(current)}),
+			wantRules:         []rbacv1.PolicyRule{getPods, listSecrets},
+			wantRoleTemplates: []string{"view", "admin"},
+		},
+		{
+			name: "revision wasn't rolled out to the cluster yet",
+			rt: newRoleTemplate(map[string]string{
+				RoleTemplateRevisionHashAnnotation:         RoleTemplateRevisionHash(current),
+				RoleTemplateRevisionSpecAnnotation:         RoleTemplateRevisionSpec(current),
+				RoleTemplatePreviousRevisionSpecAnnotation: RoleTemplateRevisionSpec(previous),
+				RoleTemplateRolloutClustersAnnotation:      "c-0",
+			}),
+			wantRules:         []rbacv1.PolicyRule{getPods},
+			wantRoleTemplates: []string{"view"},
+		},
+		{
+			name: "revision isn't recorded yet",
+			rt: newRoleTemplate(map[string]string{
+				RoleTemplateRevisionHashAnnotation: RoleTemplateRevisionHash(previous),
+				RoleTemplateRevisionSpecAnnotation: RoleTemplateRevisionSpec(previous),
+			}),
+			wantRules:         []rbacv1.PolicyRule{getPods},
+			wantRoleTemplates: []string{"view"},
+		},
+		{
+			name: "previous revision was external",
+			rt: newRoleTemplate(map[string]string{
+				RoleTemplateRevisionHashAnnotation:         RoleTemplateRevisionHash(current),
+				RoleTemplatePreviousRevisionSpecAnnotation: RoleTemplateRevisionSpec(&v3.RoleTemplate{External: true}),
+				RoleTemplateRolloutClustersAnnotation:      "",
+			}),
+			wantRoleTemplates: []string{},
+			wantExternal:      true,
+		},
+		{
+			name: "previous revision is unknown",
+			rt: newRoleTemplate(map[string]string{
+				RoleTemplateRevisionHashAnnotation: "old",
+			}),
+			wantRules:         []rbacv1.PolicyRule{getPods, listSecrets},
+			wantRoleTemplates: []string{"view", "admin"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := RoleTemplateForCluster(tt.rt, "c-1")
+			assert.Equal(t, tt.wantRules, rt.Rules)
+			assert.Equal(t, tt.wantRoleTemplates, rt.RoleTemplateNames)
+			assert.Equal(t, tt.wantExternal, rt.External)
+			// The RoleTemplate of the cache isn't modified.
+			assert.Equal(t, current.Rules, tt.rt.Rules)
+		})
+	}
+}
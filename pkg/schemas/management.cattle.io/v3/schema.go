@@ -385,6 +385,7 @@ func authnTypes(schemas *types.Schemas) *types.Schemas {
 					Output: "user",
 				},
 				"refreshauthprovideraccess": {},
+				"unlock":                    {},
 			}
 			schema.CollectionActions = map[string]types.Action{
 				"changepassword": {
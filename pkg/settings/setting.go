@@ -61,32 +61,32 @@
 	}
 
 	AgentImage          = NewSetting("agent-image", "rancher/rancher-agent:head")
-	AgentRolloutTimeout = NewSetting("agent-rollout-timeout", "300s")
+	AgentRolloutTimeout = NewSetting("agent-rollout-timeout", "300s").WithType(TypeDuration)
 	// AgentTLSMode is translated to the environment variable STRICT_VERIFY when rendering the cluster/node agent manifests and should not be specified as a default agent setting as it has no direct effect on the agent itself.
-	AgentTLSMode                        = NewSetting("agent-tls-mode", AgentTLSModeStrict).WithDefaultOnUpgrade(AgentTLSModeSystemStore)
+	AgentTLSMode                        = NewSetting("agent-tls-mode", AgentTLSModeStrict).WithDefaultOnUpgrade(AgentTLSModeSystemStore).WithValues(AgentTLSModeStrict, AgentTLSModeSystemStore)
 	AuthImage                           = NewSetting("auth-image", v32.ToolsSystemImages.AuthSystemImages.KubeAPIAuth)
-	AuthorizationCacheTTLSeconds        = NewSetting("authorization-cache-ttl-seconds", "10")
-	AuthorizationDenyCacheTTLSeconds    = NewSetting("authorization-deny-cache-ttl-seconds", "10")
-	AzureGroupCacheSize                 = NewSetting("azure-group-cache-size", "10000")
+	AuthorizationCacheTTLSeconds        = NewSetting("authorization-cache-ttl-seconds", "10").WithMinInt(0)
+	AuthorizationDenyCacheTTLSeconds    = NewSetting("authorization-deny-cache-ttl-seconds", "10").WithMinInt(0)
+	AzureGroupCacheSize                 = NewSetting("azure-group-cache-size", "10000").WithMinInt(0)
 	CACerts                             = NewSetting("cacerts", "")
 	CLIURLDarwin                        = NewSetting("cli-url-darwin", "https://releases.rancher.com/cli/v1.0.0-alpha8/rancher-darwin-amd64-v1.0.0-alpha8.tar.gz")
 	CLIURLLinux                         = NewSetting("cli-url-linux", "https://releases.rancher.com/cli/v1.0.0-alpha8/rancher-linux-amd64-v1.0.0-alpha8.tar.gz")
 	CLIURLWindows                       = NewSetting("cli-url-windows", "https://releases.rancher.com/cli/v1.0.0-alpha8/rancher-windows-386-v1.0.0-alpha8.zip")
-	ClusterControllerStartCount         = NewSetting("cluster-controller-start-count", "50")
+	ClusterControllerStartCount         = NewSetting("cluster-controller-start-count", "50").WithMinInt(1)
 	EngineInstallURL                    = NewSetting("engine-install-url", "https://releases.rancher.com/install-docker/28.1.sh")
 	EngineISOURL                        = NewSetting("engine-iso-url", "https://releases.rancher.com/os/latest/rancheros-vmware.iso")
 	EngineNewestVersion                 = NewSetting("engine-newest-version", "v17.12.0")
 	EngineSupportedRange                = NewSetting("engine-supported-range", "~v1.11.2 || ~v1.12.0 || ~v1.13.0 || ~v17.03.0 || ~v17.06.0 || ~v17.09.0 || ~v18.06.0 || ~v18.09.0 || ~v19.03.0 || ~v20.10.0 || ~v23.0.0 || ~v24.0.0 || ~v25.0.0 || ~v26.0.0 || ~v26.1.0|| ~v27.0.0|| ~v27.1.0|| ~v27.2.0|| ~v27.3.0|| ~v27.4.0|| ~v27.5.0|| ~v28.0.0|| ~v28.1.0")
-	FirstLogin                          = NewSetting("first-login", "true")
-	GlobalRegistryEnabled               = NewSetting("global-registry-enabled", "false")
+	FirstLogin                          = NewSetting("first-login", "true").WithType(TypeBool)
+	GlobalRegistryEnabled               = NewSetting("global-registry-enabled", "false").WithType(TypeBool)
 	GithubProxyAPIURL                   = NewSetting("github-proxy-api-url", "https://api.github.com")
 	HelmVersion                         = NewSetting("helm-version", "dev")
-	HelmMaxHistory                      = NewSetting("helm-max-history", "10")
+	HelmMaxHistory                      = NewSetting("helm-max-history", "10").WithMinInt(0)
 	IngressIPDomain                     = NewSetting("ingress-ip-domain", "sslip.io")
 	InstallUUID                         = NewSetting("install-uuid", "")
 	InternalServerURL                   = NewSetting("internal-server-url", "")
 	InternalCACerts                     = NewSetting("internal-cacerts", "")
-	JailerTimeout                       = NewSetting("jailer-timeout", "60")
+	JailerTimeout                       = NewSetting("jailer-timeout", "60").WithMinInt(1)
 	KubernetesVersion                   = NewSetting("k8s-version", "")
 	KubernetesVersionToServiceOptions   = NewSetting("k8s-version-to-service-options", "")
 	KubernetesVersionToSystemImages     = NewSetting("k8s-version-to-images", "")
@@ -95,7 +95,7 @@
 	KDMBranch                           = NewSetting("kdm-branch", "dev-v2.12")
 	MachineVersion                      = NewSetting("machine-version", "dev")
 	Namespace                           = NewSetting("namespace", os.Getenv("CATTLE_NAMESPACE"))
-	PasswordMinLength                   = NewSetting("password-min-length", "12")
+	PasswordMinLength                   = NewSetting("password-min-length", "12").WithIntRange(2, 256)
 	PeerServices                        = NewSetting("peer-service", os.Getenv("CATTLE_PEER_SERVICE"))
 	RkeMetadataConfig                   = NewSetting("rke-metadata-config", getMetadataConfig())
 	ServerImage                         = NewSetting("server-image", "rancher/rancher")
@@ -117,24 +117,24 @@
 	WhitelistDomain                     = NewSetting("whitelist-domain", "forums.rancher.com")
 	WhitelistEnvironmentVars            = NewSetting("whitelist-envvars", "HTTP_PROXY,HTTPS_PROXY,NO_PROXY")
 	AuthUserInfoResyncCron              = NewSetting("auth-user-info-resync-cron", "0 0 * * *")
-	APIUIVersion                        = NewSetting("api-ui-version", "1.1.11")              // Please update the CATTLE_API_UI_VERSION in package/Dockerfile when updating the version here.
-	RotateCertsIfExpiringInDays         = NewSetting("rotate-certs-if-expiring-in-days", "7") // 7 days
-	ClusterTemplateEnforcement          = NewSetting("cluster-template-enforcement", "false")
+	APIUIVersion                        = NewSetting("api-ui-version", "1.1.11")                            // Please update the CATTLE_API_UI_VERSION in package/Dockerfile when updating the version here.
+	RotateCertsIfExpiringInDays         = NewSetting("rotate-certs-if-expiring-in-days", "7").WithMinInt(1) // 7 days
+	ClusterTemplateEnforcement          = NewSetting("cluster-template-enforcement", "false").WithType(TypeBool)
 	InitialDockerRootDir                = NewSetting("initial-docker-root-dir", "/var/lib/docker")
 	SystemCatalog                       = NewSetting("system-catalog", "external") // Options are 'external' or 'bundled'
 	ChartDefaultBranch                  = NewSetting("chart-default-branch", "dev-v2.13")
-	SystemManagedChartsOperationTimeout = NewSetting("system-managed-charts-operation-timeout", "300s")
+	SystemManagedChartsOperationTimeout = NewSetting("system-managed-charts-operation-timeout", "300s").WithType(TypeDuration)
 	FleetDefaultWorkspaceName           = NewSetting("fleet-default-workspace-name", fleetconst.ClustersDefaultNamespace) // fleetWorkspaceName to assign to clusters with none
 	ShellImage                          = NewSetting("shell-image", buildconfig.DefaultShellVersion)
 	IgnoreNodeName                      = NewSetting("ignore-node-name", "") // nodes to ignore when syncing v1.node to v3.node
 	NoDefaultAdmin                      = NewSetting("no-default-admin", "")
-	AKSUpstreamRefresh                  = NewSetting("aks-refresh", "300")
+	AKSUpstreamRefresh                  = NewSetting("aks-refresh", "300").WithMinInt(1)
 	EKSUpstreamRefreshCron              = NewSetting("eks-refresh-cron", "*/5 * * * *") // EKSUpstreamRefreshCron is deprecated and will be replaced by EKSUpstreamRefresh
-	EKSUpstreamRefresh                  = NewSetting("eks-refresh", "300")
-	GKEUpstreamRefresh                  = NewSetting("gke-refresh", "300")
-	HideLocalCluster                    = NewSetting("hide-local-cluster", "false")
+	EKSUpstreamRefresh                  = NewSetting("eks-refresh", "300").WithMinInt(1)
+	GKEUpstreamRefresh                  = NewSetting("gke-refresh", "300").WithMinInt(1)
+	HideLocalCluster                    = NewSetting("hide-local-cluster", "false").WithType(TypeBool)
 	MachineProvisionImage               = NewSetting("machine-provision-image", "rancher/machine:v0.15.0-rancher131")
-	SystemFeatureChartRefreshSeconds    = NewSetting("system-feature-chart-refresh-seconds", "21600")
+	SystemFeatureChartRefreshSeconds    = NewSetting("system-feature-chart-refresh-seconds", "21600").WithMinInt(1)
 	ClusterAgentDefaultAffinity         = NewSetting("cluster-agent-default-affinity", ClusterAgentAffinity)
 	FleetAgentDefaultAffinity           = NewSetting("fleet-agent-default-affinity", FleetAgentAffinity)
 	MaxUIPluginFileByteSize             = NewSetting("max-ui-plugin-file-byte-size", strconv.Itoa(DefaultMaxUIPluginFileSizeInBytes)) // Max file size in bytes for ui plugins
@@ -146,19 +146,81 @@
 	K3sDefaultVersion  = NewSetting("k3s-default-version", "")
 
 	// AuthTokenMaxTTLMinutes is the max allowable time to live for tokens. Excluding those created for UI sessions which is controlled by AuthUserSessionTTLMinutes.
-	AuthTokenMaxTTLMinutes = NewSetting("auth-token-max-ttl-minutes", "129600") // 90 days
+	AuthTokenMaxTTLMinutes = NewSetting("auth-token-max-ttl-minutes", "129600").WithMinInt(0) // 90 days
 
 	// AuthUserInfoMaxAgeSeconds represents the maximum age of a users auth tokens before an auth provider group membership sync will be performed.
 	AuthUserInfoMaxAgeSeconds = NewSetting("auth-user-info-max-age-seconds", "3600") // 1 hour
 
 	// AuthUserSessionTTLMinutes represents the time to live for tokens used for login sessions in minutes.
-	AuthUserSessionTTLMinutes = NewSetting("auth-user-session-ttl-minutes", "960") // 16 hours
+	AuthUserSessionTTLMinutes = NewSetting("auth-user-session-ttl-minutes", "960").WithMinInt(1) // 16 hours
 
 	// AuthUserSessionIdleTTLMinutes represents the time to live without user activity for tokens controlling a login session, in minutes.
 	// By default, the value for auth-user-session-idle-ttl-minutes should be set
 	// to the same value as auth-user-session-ttl-minutes (for backward compatibility reasons),
 	// and it must never be greater than this value.
-	AuthUserSessionIdleTTLMinutes = NewSetting("auth-user-session-idle-ttl-minutes", "960") // 16 hours
+	AuthUserSessionIdleTTLMinutes = NewSetting("auth-user-session-idle-ttl-minutes", "960").WithMinInt(1) // 16 hours
+
+	// AuthUserSessionIdleTTLMinutesByKind overrides the idle timeout of tokens by kind of token, e.g. to allow kubeconfig
+	// tokens used in CLI workflows to stay idle longer than login sessions of the UI. It is a JSON object mapping the
+	// kind of token to its idle timeout in minutes, e.g. {"session": 30, "kubeconfig": 480}. Timeouts must be at least 1
+	// minute and not greater than the time to live of the tokens of the kind. Login sessions not listed use
+	// auth-user-session-idle-ttl-minutes, other kinds don't time out.
+	AuthUserSessionIdleTTLMinutesByKind = NewSetting("auth-user-session-idle-ttl-minutes-by-kind", "")
+
+	// AuthUserSessionMaxTTL is the maximum lifetime of a login session, after which it expires even if the user is
+	// continuously active. The value should be expressed in valid time.Duration units e.g. "12h". See https://pkg.go.dev/time#ParseDuration
+	// An empty string or a zero value means the lifetime of sessions is only limited by auth-user-session-ttl-minutes.
+	AuthUserSessionMaxTTL = NewSetting("auth-user-session-max-ttl", "").WithType(TypeDuration)
+
+	// AuthUserSessionIdleWriteThreshold is the minimum amount by which the idle timeout of a token must advance for the
+	// activity of its user to be stored, to avoid writing tokens on every heartbeat of busy installations. The value
+	// should be expressed in valid time.Duration units e.g. "30s". It is capped by the heartbeat interval of the token.
+	// A zero value means the activity is stored on every heartbeat.
+	AuthUserSessionIdleWriteThreshold = NewSetting("auth-user-session-idle-write-threshold", "30s").WithType(TypeDuration)
+
+	// OIDCSessionRenewal controls whether the login sessions of users of OIDC auth providers are renewed when their
+	// refresh token is successfully used to refetch their group principals. Renewed sessions expire
+	// auth-user-session-ttl-minutes after the renewal, within auth-user-session-max-ttl.
+	OIDCSessionRenewal = NewSetting("oidc-session-renewal", "false")
+
+	// ExtTokenDeletionGracePeriodMinutes is the time in minutes a deleted ext token is kept disabled before it is purged,
+	// during which an admin can restore it. Zero, the default, purges deleted tokens immediately.
+	ExtTokenDeletionGracePeriodMinutes = NewSetting("ext-token-deletion-grace-period-minutes", "0").WithMinInt(0)
+
+	// LocalAuthMaxFailedLogins is the number of consecutive failed logins after which a local user is locked out for
+	// local-auth-lockout-minutes. Zero, the default, never locks users out, failed logins are only answered more slowly.
+	LocalAuthMaxFailedLogins = NewSetting("local-auth-max-failed-logins", "0").WithMinInt(0)
+
+	// LocalAuthLockoutMinutes is the time in minutes a local user is locked out for after too many failed logins.
+	LocalAuthLockoutMinutes = NewSetting("local-auth-lockout-minutes", "15").WithMinInt(1)
+
+	// TrustedProxyCIDRs is a comma-separated list of the CIDRs of the proxies in front of Rancher, e.g. its ingress
+	// controller, trusted to set the address of the clients in the X-Forwarded-For header. Failed logins are tracked by
+	// the address of the client, which is the address of the proxy otherwise.
+	TrustedProxyCIDRs = NewSetting("trusted-proxy-cidrs", "")
+
+	// LoginChallengeWebhookURL is the URL of the webhook deciding whether logins showing risk signals, e.g. following
+	// failed logins or from a new address, may proceed, e.g. by verifying a CAPTCHA token sent with the login or by
+	// asking the user for approval on another device. An empty string means logins are not challenged.
+	LoginChallengeWebhookURL = NewSetting("login-challenge-webhook-url", "")
+
+	// LdapNestedGroupCacheTTLSeconds is the time in seconds the parent groups of a group are cached for by the Active
+	// Directory and LDAP auth providers when resolving nested group memberships. Changes to nested memberships in the
+	// directory may take this long to be reflected. Zero disables the cache.
+	LdapNestedGroupCacheTTLSeconds = NewSetting("ldap-nested-group-cache-ttl-seconds", "300").WithMinInt(0) // 5 minutes
+
+	// LdapNestedGroupMaxDepth is the maximum number of levels of nested groups resolved by the Active Directory and
+	// LDAP auth providers. Zero, the default, resolves all levels.
+	LdapNestedGroupMaxDepth = NewSetting("ldap-nested-group-max-depth", "0").WithMinInt(0)
+
+	// PrincipalSearchTimeoutSeconds is the time in seconds the search of principals waits for each auth provider. The
+	// principals of providers which don't answer in time are left out of the results, which are marked as incomplete.
+	PrincipalSearchTimeoutSeconds = NewSetting("principal-search-timeout-seconds", "10").WithMinInt(1)
+
+	// PrincipalSearchTimeoutSecondsByProvider overrides principal-search-timeout-seconds by auth provider, e.g. to wait
+	// longer for a large directory. It is a JSON object mapping the name of the provider to its timeout in seconds, e.g.
+	// {"activedirectory": 20, "local": 5}.
+	PrincipalSearchTimeoutSecondsByProvider = NewSetting("principal-search-timeout-seconds-by-provider", "")
 
 	// ChartDefaultURL represents the default URL for the system charts repo. It should only be set for test or
 	// debug purposes.
@@ -167,17 +229,27 @@
 	// The value should be expressed in valid time.Duration units and truncated to a second e.g. "168h". See https://pkg.go.dev/time#ParseDuration
 	// DisableInactiveUserAfter should be greater than AuthUserSessionTTLMinutes.
 	// An empty string or a zero value means the feature is disabled.
-	DisableInactiveUserAfter = NewSetting("disable-inactive-user-after", "")
+	DisableInactiveUserAfter = NewSetting("disable-inactive-user-after", "").WithType(TypeDuration)
 
 	// DeleteInactiveUserAfter is the duration a user can be inactive after which it's deleted by the user retention process.
 	// The value should be expressed in valid time.Duration units and truncated to a second e.g. "168h". See https://pkg.go.dev/time#ParseDuration
 	// DeleteInactiveUserAfter should be greater than AuthUserSessionTTLMinutes.
 	// An empty string or a zero value means the feature is disabled.
-	DeleteInactiveUserAfter = NewSetting("delete-inactive-user-after", "")
+	DeleteInactiveUserAfter = NewSetting("delete-inactive-user-after", "").WithType(TypeDuration)
+
+	// OrphanedTokenRevocationGracePeriod is the duration after which the tokens of users of a disabled or removed auth
+	// provider are revoked. The value should be expressed in valid time.Duration units e.g. "720h".
+	// An empty string or a zero value means such tokens are only reported, never revoked.
+	OrphanedTokenRevocationGracePeriod = NewSetting("orphaned-token-revocation-grace-period", "").WithType(TypeDuration)
+
+	// AuthorizationDecisionLogging determines if the requests denied by the Rancher proxy and the extension API server are
+	// logged with an explanation: the bindings of the user and their groups that were considered, and the rules of their
+	// roles for the requested resource. Valid values are "true" and "false".
+	AuthorizationDecisionLogging = NewSetting("authorization-decision-logging", "false").WithType(TypeBool)
 
 	// UserRetentionDryRun determines if the user retention process should actually disable and delete users.
 	// Valid values are "true" and "false". An empty string means "false".
-	UserRetentionDryRun = NewSetting("user-retention-dry-run", "false")
+	UserRetentionDryRun = NewSetting("user-retention-dry-run", "false").WithType(TypeBool)
 
 	// UserLastLoginDefault is used if UserAttribute.LastLogin is not set.
 	// The value should be a date and time truncated to a second and formatted according to RFC3339 e.g. "2023-03-01T00:00:00Z".
@@ -188,6 +260,20 @@
 	// The value should be a valid cron expression e.g. "0 * * * *" (every hour)
 	UserRetentionCron = NewSetting("user-retention-cron", "")
 
+	// UserRetentionProviderRules overrides DisableInactiveUserAfter and DeleteInactiveUserAfter for the users of an auth provider.
+	// The value should be a JSON object keyed by auth provider name e.g. {"local":{"disableAfter":"720h","deleteAfter":"2880h"},"shibboleth":{"deleteAfter":"0s"}}
+	// A missing duration falls back to the global setting, a zero duration means users of the provider are never disabled or deleted.
+	// An empty string means no overrides.
+	UserRetentionProviderRules = NewSetting("user-retention-provider-rules", "")
+
+	// UserNamespaceObjectQuota is the maximum number of preferences, config maps and secrets in the namespace backing
+	// each user, enforced with a resource quota. Zero means no quota.
+	UserNamespaceObjectQuota = NewSetting("user-namespace-object-quota", "200").WithMinInt(0)
+
+	// UserNamespaceSizeQuotaBytes is the maximum total size in bytes of the preferences of each user, kept in the
+	// namespace backing the user. Zero means no quota.
+	UserNamespaceSizeQuotaBytes = NewSetting("user-namespace-size-quota-bytes", "1048576").WithMinInt(0) // 1 MiB
+
 	// ConfigMapName name of the configmap that stores rancher configuration information.
 	// Deprecated: to be removed in 2.8.0
 	ConfigMapName = NewSetting("config-map-name", "rancher-config")
@@ -212,13 +298,65 @@
 	// GkeOperatorVersion is the exact version of the gke-operator and gke-operator-crd chart that Rancher will install.
 	GkeOperatorVersion = NewSetting("gke-operator-version", "")
 
+	// ClusterDeletionBindingProtection determines what happens to the ClusterRoleTemplateBindings of a cluster being deleted.
+	// Valid values are "block", where the deletion of the cluster doesn't complete while bindings other than the ones of its
+	// creator remain, unless the cluster has the management.cattle.io/force-delete-with-bindings annotation set to "true",
+	// and "cascade", where all the bindings of the cluster are deleted and their RBAC cleaned up before the cluster is.
+	// An empty string means the bindings are left to be removed with the namespace of the cluster.
+	ClusterDeletionBindingProtection = NewSetting("cluster-deletion-binding-protection", "")
+
+	// RoleTemplateRolloutInterval is the interval at which a new revision of a RoleTemplate is rolled out to one more
+	// downstream cluster, for the permissions it grants not to be granted in all clusters at once. The permissions it
+	// removes are revoked in all clusters at once. The value should be expressed in valid time.Duration units e.g. "1m".
+	// A zero value means new revisions are rolled out to all clusters at once.
+	RoleTemplateRolloutInterval = NewSetting("roletemplate-rollout-interval", "1m").WithType(TypeDuration)
+
+	// ClusterProxyUserRateLimit is the number of requests per second a user can make to a downstream cluster through the cluster proxy.
+	// Requests over the limit are rejected with 429 Too Many Requests. A value of 0 disables the limit.
+	ClusterProxyUserRateLimit = NewSetting("cluster-proxy-user-rate-limit", "0").WithMinInt(0)
+
+	// ClusterProxyUserRateBurst is the number of requests a user can make to a downstream cluster through the cluster proxy in a burst
+	// above ClusterProxyUserRateLimit.
+	ClusterProxyUserRateBurst = NewSetting("cluster-proxy-user-rate-burst", "100").WithMinInt(1)
+
+	// APIUsageReportInterval is the period of the API usage reports aggregating the requests each user makes to each downstream
+	// cluster through the cluster proxy, e.g. "1h". See https://pkg.go.dev/time#ParseDuration
+	// An empty string or a zero value means the requests are not recorded.
+	APIUsageReportInterval = NewSetting("api-usage-report-interval", "").WithType(TypeDuration)
+
+	// APIUsageReportRetention is the duration after which the API usage reports are deleted, e.g. "168h".
+	// An empty string or a zero value means the reports are never deleted.
+	APIUsageReportRetention = NewSetting("api-usage-report-retention", "168h").WithType(TypeDuration)
+
+	// TrustedJWTIssuers is a JSON list of external issuers whose JWTs are accepted to authenticate API requests,
+	// e.g. for workload identity federation. Each issuer has an "issuer", a "jwksURL", trusted "audiences" and optional
+	// claim mappings: "usernameClaim", "groupsClaim", "userPrincipalPrefix", "groupPrincipalPrefix" and "createUsers".
+	TrustedJWTIssuers = NewSetting("trusted-jwt-issuers", "")
+
+	// TokenHashBackend is where the hashes of ext tokens are stored: "secret" keeps them in the backing secrets of
+	// the tokens, "vault" keeps them in HashiCorp Vault. Changing it only affects new tokens.
+	TokenHashBackend = NewSetting("token-hash-backend", "secret").WithValues("secret", "vault")
+
+	// TokenHashVaultAddress is the address of the Vault server the hashes of ext tokens are stored in, e.g. "https://vault.example.com:8200".
+	TokenHashVaultAddress = NewSetting("token-hash-vault-address", "")
+
+	// TokenHashVaultMount is the mount path of the KV version 2 secrets engine the hashes of ext tokens are stored in.
+	TokenHashVaultMount = NewSetting("token-hash-vault-mount", "secret")
+
+	// TokenHashVaultPath is the path, within TokenHashVaultMount, under which the hashes of ext tokens are stored.
+	TokenHashVaultPath = NewSetting("token-hash-vault-path", "rancher/tokens")
+
+	// ImportKubernetesVersionRange is the semver range of the Kubernetes versions supported for imported clusters,
+	// checked by the import preflight of provisioning clusters.
+	ImportKubernetesVersionRange = NewSetting("import-k8s-supported-range", ">= 1.30.0-0 < 1.34.0-0")
+
 	// KubeconfigDefaultTokenTTLMinutes is the default time to live applied to kubeconfigs created for users.
 	// This setting will take effect regardless of the kubeconfig-generate-token status.
-	KubeconfigDefaultTokenTTLMinutes = NewSetting("kubeconfig-default-token-ttl-minutes", "43200") // 30 days
+	KubeconfigDefaultTokenTTLMinutes = NewSetting("kubeconfig-default-token-ttl-minutes", "43200").WithMinInt(0) // 30 days
 
 	// KubeconfigGenerateToken determines whether the UI will return a generate token with kubeconfigs.
 	// If set to false the kubeconfig will contain a command to login to Rancher.
-	KubeconfigGenerateToken = NewSetting("kubeconfig-generate-token", "true")
+	KubeconfigGenerateToken = NewSetting("kubeconfig-generate-token", "true").WithType(TypeBool)
 
 	// PartnerChartDefaultBranch represents the default branch for the partner charts repo.
 	PartnerChartDefaultBranch = NewSetting("partner-chart-default-branch", "main")
@@ -248,7 +386,7 @@
 
 	// S3BucketCheckTimeout is the timeout for checking if an s3 bucket for etcd backups exists,
 	// in the go duration string format.
-	S3BucketCheckTimeout = NewSetting("s3-bucket-check-timeout", "30s")
+	S3BucketCheckTimeout = NewSetting("s3-bucket-check-timeout", "30s").WithType(TypeDuration)
 
 	// SystemDefaultRegistry is the default container registry used for images.
 	// The environmental variable "CATTLE_BASE_REGISTRY" controls the default value of this setting.
@@ -256,11 +394,11 @@
 
 	// K3sBasedUpgraderUninstallConcurrency defines the maximum number of clusters
 	// for which Rancher can simultaneously uninstall the legacy K3s-based upgrade app.
-	K3sBasedUpgraderUninstallConcurrency = NewSetting("k3s-based-upgrader-uninstall-concurrency", "5")
+	K3sBasedUpgraderUninstallConcurrency = NewSetting("k3s-based-upgrader-uninstall-concurrency", "5").WithMinInt(1)
 
 	// SystemAgentUpgraderInstallConcurrency defines the maximum number of clusters
 	// for which Rancher can simultaneously install or upgrade the resources needed for upgrading system-agent.
-	SystemAgentUpgraderInstallConcurrency = NewSetting("system-agent-upgrader-install-concurrency", "5")
+	SystemAgentUpgraderInstallConcurrency = NewSetting("system-agent-upgrader-install-concurrency", "5").WithMinInt(1)
 
 	// UIBanners holds configuration to display a custom fixed banner in the header, footer, or both
 	UIBanners = NewSetting("ui-banners", "{}")
@@ -348,7 +486,7 @@
 	// SkipHostedClusterChartInstallation controls whether the hosted cluster chart is installed on the server. Defaults to false.
 	// This setting is for development purposes only.
 	SkipHostedClusterChartInstallation = NewSetting("skip-hosted-cluster-chart-installation", os.Getenv("CATTLE_SKIP_HOSTED_CLUSTER_CHART_INSTALLATION"))
-	MachineProvisionImagePullPolicy    = NewSetting("machine-provision-image-pull-policy", string(v1.PullAlways))
+	MachineProvisionImagePullPolicy    = NewSetting("machine-provision-image-pull-policy", string(v1.PullAlways)).WithValues(string(v1.PullAlways), string(v1.PullIfNotPresent), string(v1.PullNever))
 
 	// The following settings are only used outside of Rancher (for example,
 	// by the UI) but need to be known so that Rancher doesn't remove them
@@ -365,7 +503,7 @@
 
 	// UnprivilegedJailUser controls whether jailed commands execute under a separate (unprivileged/non-root) user
 	// account. Setting it to false is only recommended for testing and development environments.
-	UnprivilegedJailUser = NewSetting("unprivileged-jail-user", "true")
+	UnprivilegedJailUser = NewSetting("unprivileged-jail-user", "true").WithType(TypeBool)
 
 	// ImportedClusterVersionManagement enables the version management feature on imported RKE2/K3s cluster,
 	// and the local cluster if it is an RKE2/K3s cluster.
@@ -374,10 +512,10 @@
 	// changing this flag will trigger a redeployment of the cluster agent during the next reconciliation
 	// (by default every 5 minutes, or as soon as the cluster is edited, whichever comes first).
 	// Valid values: ture, false
-	ImportedClusterVersionManagement = NewSetting("imported-cluster-version-management", "true")
+	ImportedClusterVersionManagement = NewSetting("imported-cluster-version-management", "true").WithType(TypeBool)
 
-	SQLCacheGCInterval  = NewSetting("sql-cache-gc-interval", "15m")
-	SQLCacheGCKeepCount = NewSetting("sql-cache-gc-keep-count", "1000")
+	SQLCacheGCInterval  = NewSetting("sql-cache-gc-interval", "15m").WithType(TypeDuration)
+	SQLCacheGCKeepCount = NewSetting("sql-cache-gc-keep-count", "1000").WithMinInt(0)
 
 	SCCOperatorImage = NewSetting("scc-operator-image", buildconfig.DefaultSccOperatorImage)
 )
@@ -412,6 +550,7 @@ func init() {
 	authsettings.AuthUserSessionTTLMinutes = AuthUserSessionTTLMinutes
 	authsettings.AuthUserSessionIdleTTLMinutes = AuthUserSessionIdleTTLMinutes
 	authsettings.AuthUserInfoMaxAgeSeconds = AuthUserInfoMaxAgeSeconds
+	authsettings.OIDCSessionRenewal = OIDCSessionRenewal
 	authsettings.FirstLogin = FirstLogin
 
 	if InjectDefaults == "" {
@@ -452,6 +591,13 @@ type Setting struct {
 	// on upgraded setups but use a new value for fresh installations for backward compatibility.
 	DefaultOnUpgrade string
 	ReadOnly         bool
+	// Type is the type of the value of the setting, values of typed settings are validated on write.
+	// An empty Type is the same as TypeString.
+	Type Type
+
+	// constraints on the value of the setting, see WithIntRange, WithMinInt and WithValues
+	min, max *int
+	values   []string
 }
 
 // SetIfUnset will store the given value of the setting if it was not already stored.
@@ -0,0 +1,152 @@
+package settings
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+)
+
+// Type is the type of the value of a setting.
+type Type string
+
+const (
+	// TypeString settings accept any value.
+	TypeString Type = "string"
+	// TypeInt settings accept integers, optionally within a range.
+	TypeInt Type = "int"
+	// TypeBool settings accept "true" and "false".
+	TypeBool Type = "bool"
+	// TypeDuration settings accept values parsable by time.ParseDuration.
+	TypeDuration Type = "duration"
+	// TypeEnum settings accept one of a fixed set of values.
+	TypeEnum Type = "enum"
+)
+
+// Schema describes the type, default and accepted values of a setting.
+type Schema struct {
+	Name    string   `json:"name"`
+	Type    Type     `json:"type"`
+	Default string   `json:"default"`
+	Min     *int     `json:"min,omitempty"`
+	Max     *int     `json:"max,omitempty"`
+	Values  []string `json:"values,omitempty"`
+}
+
+// WithIntRange takes a setting and returns a new setting whose value must be an integer between min and max, inclusive.
+func (s Setting) WithIntRange(min, max int) Setting {
+	s.Type = TypeInt
+	s.min = ptr.To(min)
+	s.max = ptr.To(max)
+	settings[s.Name] = s
+	return s
+}
+
+// WithMinInt takes a setting and returns a new setting whose value must be an integer greater than or equal to min.
+func (s Setting) WithMinInt(min int) Setting {
+	s.Type = TypeInt
+	s.min = ptr.To(min)
+	settings[s.Name] = s
+	return s
+}
+
+// WithType takes a setting and returns a new setting whose value must be of the given type.
+func (s Setting) WithType(t Type) Setting {
+	s.Type = t
+	settings[s.Name] = s
+	return s
+}
+
+// WithValues takes a setting and returns a new setting whose value must be one of the given values.
+func (s Setting) WithValues(values ...string) Setting {
+	s.Type = TypeEnum
+	s.values = values
+	settings[s.Name] = s
+	return s
+}
+
+// Schema returns the schema of the setting.
+func (s Setting) Schema() Schema {
+	t := s.Type
+	if t == "" {
+		t = TypeString
+	}
+	return Schema{
+		Name:    s.Name,
+		Type:    t,
+		Default: s.Default,
+		Min:     s.min,
+		Max:     s.max,
+		Values:  s.values,
+	}
+}
+
+// Validate returns an error if the value isn't valid for the setting. An empty value is always valid as it resets
+// the setting to its default.
+func (s Setting) Validate(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	switch s.Type {
+	case TypeInt:
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for setting %s: must be an integer", value, s.Name)
+		}
+		if s.min != nil && i < *s.min {
+			return fmt.Errorf("invalid value %q for setting %s: must be greater than or equal to %d", value, s.Name, *s.min)
+		}
+		if s.max != nil && i > *s.max {
+			return fmt.Errorf("invalid value %q for setting %s: must be less than or equal to %d", value, s.Name, *s.max)
+		}
+	case TypeBool:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("invalid value %q for setting %s: must be true or false", value, s.Name)
+		}
+	case TypeDuration:
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid value %q for setting %s: must be a duration: %w", value, s.Name, err)
+		}
+	case TypeEnum:
+		for _, v := range s.values {
+			if value == v {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value %q for setting %s: must be one of [%s]", value, s.Name, strings.Join(s.values, ", "))
+	}
+	return nil
+}
+
+// Validate returns an error if the value isn't valid for the setting with the given name. Values of unknown settings
+// are always valid.
+func Validate(name, value string) error {
+	s, ok := settings[name]
+	if !ok {
+		return nil
+	}
+	return s.Validate(value)
+}
+
+// GetSchema returns the schema of the setting with the given name.
+func GetSchema(name string) (Schema, bool) {
+	s, ok := settings[name]
+	if !ok {
+		return Schema{}, false
+	}
+	return s.Schema(), true
+}
+
+// Schemas returns the schemas of all the settings, sorted by name.
+func Schemas() []Schema {
+	schemas := make([]Schema, 0, len(settings))
+	for _, s := range settings {
+		schemas = append(schemas, s.Schema())
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Name < schemas[j].Name })
+	return schemas
+}
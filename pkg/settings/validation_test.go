@@ -0,0 +1,117 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		setting string
+		value   string
+		wantErr string
+	}{
+		{
+			name:    "empty value resets to default",
+			setting: AuthUserSessionIdleTTLMinutes.Name,
+			value:   "",
+		},
+		{
+			name:    "valid int",
+			setting: AuthUserSessionIdleTTLMinutes.Name,
+			value:   "30",
+		},
+		{
+			name:    "non numeric int",
+			setting: AuthUserSessionIdleTTLMinutes.Name,
+			value:   "thirty",
+			wantErr: `invalid value "thirty" for setting auth-user-session-idle-ttl-minutes: must be an integer`,
+		},
+		{
+			name:    "int below minimum",
+			setting: AuthUserSessionIdleTTLMinutes.Name,
+			value:   "0",
+			wantErr: `invalid value "0" for setting auth-user-session-idle-ttl-minutes: must be greater than or equal to 1`,
+		},
+		{
+			name:    "int above maximum",
+			setting: PasswordMinLength.Name,
+			value:   "1000",
+			wantErr: `invalid value "1000" for setting password-min-length: must be less than or equal to 256`,
+		},
+		{
+			name:    "valid duration",
+			setting: DisableInactiveUserAfter.Name,
+			value:   "168h",
+		},
+		{
+			name:    "invalid duration",
+			setting: DisableInactiveUserAfter.Name,
+			value:   "7 days",
+			wantErr: `invalid value "7 days" for setting disable-inactive-user-after: must be a duration`,
+		},
+		{
+			name:    "invalid bool",
+			setting: UserRetentionDryRun.Name,
+			value:   "yes",
+			wantErr: `invalid value "yes" for setting user-retention-dry-run: must be true or false`,
+		},
+		{
+			name:    "valid enum",
+			setting: MachineProvisionImagePullPolicy.Name,
+			value:   "IfNotPresent",
+		},
+		{
+			name:    "invalid enum",
+			setting: MachineProvisionImagePullPolicy.Name,
+			value:   "Sometimes",
+			wantErr: `invalid value "Sometimes" for setting machine-provision-image-pull-policy: must be one of [Always, IfNotPresent, Never]`,
+		},
+		{
+			name:    "untyped setting",
+			setting: ServerURL.Name,
+			value:   "anything",
+		},
+		{
+			name:    "unknown setting",
+			setting: "unknown",
+			value:   "anything",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.setting, tt.value)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetSchema(t *testing.T) {
+
+	schema, ok := GetSchema(PasswordMinLength.Name)
+	assert.True(t, ok)
+	assert.Equal(t, TypeInt, schema.Type)
+	assert.Equal(t, 2, *schema.Min)
+	assert.Equal(t, 256, *schema.Max)
+
+	schema, ok = GetSchema(ServerURL.Name)
+	assert.True(t, ok)
+	assert.Equal(t, TypeString, schema.Type)
+
+	_, ok = GetSchema("unknown")
+	assert.False(t, ok)
+
+	schemas := Schemas()
+	assert.Len(t, schemas, len(settings))
+	for i := 1; i < len(schemas); i++ {
+		assert.Less(t, schemas[i-1].Name, schemas[i].Name)
+	}
+}
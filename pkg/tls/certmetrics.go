@@ -0,0 +1,71 @@
+package tls
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"sync"
+	"time"
+
+	"github.com/rancher/rancher/pkg/metrics"
+	"github.com/rancher/rancher/pkg/namespace"
+	corev1controllers "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// servingCertSecrets maps the secrets in which dynamiclistener stores the certificates served by the Rancher listeners
+// to the name of the listeners.
+var servingCertSecrets = map[string]string{
+	"serving-cert":         "rancher",
+	"tls-rancher-internal": "rancher-internal",
+}
+
+// servingCertMetrics records the expiry of the certificates served by the Rancher listeners, and their reloads.
+// dynamiclistener reloads the certificates from the secrets on its own.
+type servingCertMetrics struct {
+	mu       sync.Mutex
+	notAfter map[string]time.Time
+}
+
+func registerServingCertMetrics(ctx context.Context, secrets corev1controllers.SecretController) {
+	m := &servingCertMetrics{notAfter: map[string]time.Time{}}
+	secrets.OnChange(ctx, "serving-cert-metrics", m.sync)
+}
+
+func (m *servingCertMetrics) sync(_ string, secret *v1.Secret) (*v1.Secret, error) {
+	if secret == nil || secret.Namespace != namespace.System {
+		return secret, nil
+	}
+	listener, ok := servingCertSecrets[secret.Name]
+	if !ok {
+		return secret, nil
+	}
+	notAfter, ok := certNotAfter(secret.Data[v1.TLSCertKey])
+	if !ok {
+		return secret, nil
+	}
+
+	m.mu.Lock()
+	previous, seen := m.notAfter[listener]
+	m.notAfter[listener] = notAfter
+	m.mu.Unlock()
+
+	metrics.SetServingCertificateExpiry(listener, notAfter)
+	if seen && !previous.Equal(notAfter) {
+		metrics.IncServingCertificateReloads(listener)
+	}
+	return secret, nil
+}
+
+// certNotAfter returns the expiry of the first certificate of the PEM encoded chain.
+func certNotAfter(certPEM []byte) (time.Time, bool) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return time.Time{}, false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return cert.NotAfter, true
+}
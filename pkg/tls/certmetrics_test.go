@@ -0,0 +1,27 @@
+package tls
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+func TestCertNotAfter(t *testing.T) {
+	certPEM, _, err := certutil.GenerateSelfSignedCertKey("rancher.cattle-system", nil, nil)
+	require.NoError(t, err)
+	block, _ := pem.Decode(certPEM)
+	require.NotNil(t, block)
+	want, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+
+	notAfter, ok := certNotAfter(certPEM)
+	assert.True(t, ok)
+	assert.Equal(t, want.NotAfter, notAfter)
+
+	_, ok = certNotAfter([]byte("invalid"))
+	assert.False(t, ok)
+}
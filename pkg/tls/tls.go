@@ -145,6 +145,8 @@ func ListenAndServe(ctx context.Context, restConfig *rest.Config, handler http.H
 		return errors.Wrap(err, "failed to ListenAndServe for fleet")
 	}
 
+	registerServingCertMetrics(ctx, core.Core().V1().Secret())
+
 	ctx = metrics.WithContextID(ctx, "tlscontext")
 	if err := core.Start(ctx, 5); err != nil {
 		return err
@@ -254,7 +256,7 @@ func readConfig(secrets corev1controllers.SecretController, acmeDomains []string
 			ExpirationDaysCheck:   expiration,
 			SANs:                  sans,
 			FilterCN:              filterCN,
-			CloseConnOnCertChange: true,
+			CloseConnOnCertChange: false, // renewed certificates are served to new connections without dropping the existing ones
 		},
 	}
 
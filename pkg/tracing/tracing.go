@@ -0,0 +1,107 @@
+// Package tracing exports OpenTelemetry traces of Rancher, e.g. of the requests to the extension API server and of the
+// syncs of the RBAC handlers, over OTLP.
+//
+// Tracing is enabled by setting the standard OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT
+// environment variables, and the exporter and sampler are configured by the other standard OTEL_* variables. While it's
+// disabled the spans are no-ops.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/rancher/rancher/pkg/version"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	endpointEnvVar       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	tracesEndpointEnvVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	instrumentationName = "github.com/rancher/rancher"
+	serviceName         = "rancher"
+)
+
+// propagator propagates the trace context and baggage as W3C headers.
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// Enabled returns whether an OTLP endpoint to export the traces to is configured.
+func Enabled() bool {
+	return os.Getenv(endpointEnvVar) != "" || os.Getenv(tracesEndpointEnvVar) != ""
+}
+
+// Setup registers the global tracer provider exporting the traces over OTLP, if tracing is enabled, and the W3C trace
+// context propagator. The returned function flushes and stops the exporter.
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagator)
+
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", version.Version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	logrus.Info("Exporting traces over OTLP")
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span for the operation, which must be ended by the caller. The span is a child of the span of the
+// context, if any.
+func Start(ctx context.Context, operation string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, operation, trace.WithAttributes(attrs...))
+}
+
+// End records the error of the operation, if any, and ends its span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Handler traces the requests served by the handler, continuing the traces propagated by the clients.
+func Handler(handler http.Handler, operation string) http.Handler {
+	return otelhttp.NewHandler(handler, operation, otelhttp.WithPropagators(propagator))
+}
+
+// WrapTransport propagates the trace of the requests of the clients created from the config, e.g. to the
+// kube-apiserver, when they are made with a context carrying a span.
+func WrapTransport(config *rest.Config) {
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return otelhttp.NewTransport(rt, otelhttp.WithPropagators(propagator))
+	})
+}
+
+// TraceID returns the ID of the trace of the context, or an empty string if it isn't traced.
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
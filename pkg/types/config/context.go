@@ -46,6 +46,8 @@
 	steve "github.com/rancher/steve/pkg/server"
 	"github.com/rancher/wrangler/v3/pkg/generated/controllers/core"
 	wcorev1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/core/v1"
+	"github.com/rancher/wrangler/v3/pkg/generated/controllers/networking.k8s.io"
+	wnetworkingv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/networking.k8s.io/v1"
 	"github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac"
 	wrbacv1 "github.com/rancher/wrangler/v3/pkg/generated/controllers/rbac/v1"
 	"github.com/rancher/wrangler/v3/pkg/generic"
@@ -97,7 +99,7 @@ func (c *ScaledContext) NewManagementContext() (*ManagementContext, error) {
 	if c.managementContext != nil {
 		return c.managementContext, nil
 	}
-	mgmt, err := newManagementContext(c)
+	mgmt, err := newManagementContext(c, c.ControllerFactory)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +111,25 @@ func (c *ScaledContext) NewManagementContext() (*ManagementContext, error) {
 	return mgmt, nil
 }
 
+// NewSubsystemManagementContext returns a management context backed by its own controller factory instead of the
+// one shared by the scaled context. Controllers registered on it only run once its factory is started, which lets a
+// subsystem run its controllers under a different leader election than the rest of the management controllers. The
+// factory shares the caches of the scaled context, along with the indexers registered on them.
+func (c *ScaledContext) NewSubsystemManagementContext() (*ManagementContext, error) {
+	controllerFactoryOpts := controllers.GetOptsFromEnv(controllers.Scaled)
+	controllerFactory := controller.NewSharedControllerFactory(c.ControllerFactory.SharedCacheFactory(), controllerFactoryOpts)
+
+	mgmt, err := newManagementContext(c, controllerFactory)
+	if err != nil {
+		return nil, err
+	}
+	mgmt.Dialer = c.Dialer
+	mgmt.UserManager = c.UserManager
+	mgmt.SystemTokens = c.SystemTokens
+	mgmt.Wrangler = c.Wrangler
+	return mgmt, nil
+}
+
 type ScaleContextOptions struct {
 	ControllerFactory controller.SharedControllerFactory
 }
@@ -227,8 +248,9 @@ type UserContext struct {
 	Storage        storagev1.Interface
 	Plan           upgrade.Interface // the field is initialized only for rancher-provisioned rke2/k3s cluster
 
-	RBACw wrbacv1.Interface
-	Corew wcorev1.Interface
+	RBACw       wrbacv1.Interface
+	Corew       wcorev1.Interface
+	Networkingw wnetworkingv1.Interface
 
 	K3s k3s.Interface
 
@@ -349,7 +371,7 @@ type UserOnlyContext struct {
 	CAValidatorSecret            wcorev1.SecretController
 }
 
-func newManagementContext(c *ScaledContext) (*ManagementContext, error) {
+func newManagementContext(c *ScaledContext, controllerFactory controller.SharedControllerFactory) (*ManagementContext, error) {
 	var err error
 
 	context := &ManagementContext{
@@ -357,7 +379,6 @@ func newManagementContext(c *ScaledContext) (*ManagementContext, error) {
 	}
 
 	config := c.RESTConfig
-	controllerFactory := c.ControllerFactory
 	context.ControllerFactory = controllerFactory
 
 	context.Management = managementv3.NewFromControllerFactory(controllerFactory)
@@ -463,6 +484,11 @@ func NewUserContext(scaledContext *ScaledContext, config rest.Config, clusterNam
 		return nil, err
 	}
 	context.Corew = corew.Core().V1()
+	networkingw, err := networking.NewFactoryFromConfigWithOptions(&wranglerConf, opts)
+	if err != nil {
+		return nil, err
+	}
+	context.Networkingw = networkingw.Networking().V1()
 
 	ctlg, err := catalog.NewFactoryFromConfigWithOptions(&context.RESTConfig, &catalog.FactoryOptions{SharedControllerFactory: controllerFactory})
 	if err != nil {
@@ -45,18 +45,18 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 }
 
 // CheckAccess mocks base method.
-func (m *MockManager) CheckAccess(accessMode string, allowedPrincipalIDs []string, userPrincipalID string, groups []v3.Principal) (bool, error) {
+func (m *MockManager) CheckAccess(accessMode string, allowedPrincipalIDs, deniedPrincipalIDs []string, userPrincipalID string, groups []v3.Principal) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckAccess", accessMode, allowedPrincipalIDs, userPrincipalID, groups)
+	ret := m.ctrl.Call(m, "CheckAccess", accessMode, allowedPrincipalIDs, deniedPrincipalIDs, userPrincipalID, groups)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CheckAccess indicates an expected call of CheckAccess.
-func (mr *MockManagerMockRecorder) CheckAccess(accessMode, allowedPrincipalIDs, userPrincipalID, groups any) *gomock.Call {
+func (mr *MockManagerMockRecorder) CheckAccess(accessMode, allowedPrincipalIDs, deniedPrincipalIDs, userPrincipalID, groups any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockManager)(nil).CheckAccess), accessMode, allowedPrincipalIDs, userPrincipalID, groups)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockManager)(nil).CheckAccess), accessMode, allowedPrincipalIDs, deniedPrincipalIDs, userPrincipalID, groups)
 }
 
 // CreateNewUserClusterRoleBinding mocks base method.
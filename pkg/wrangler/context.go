@@ -145,6 +145,7 @@ type Context struct {
 	RESTMapper              meta.RESTMapper
 	SharedControllerFactory controller.SharedControllerFactory
 	leadership              *leader.Manager
+	subsystemLeadership     map[string]*leader.Manager
 	controllerLock          *sync.Mutex
 
 	RESTClientGetter      genericclioptions.RESTClientGetter
@@ -185,6 +186,21 @@ func (w *Context) OnLeader(f func(ctx context.Context) error) {
 	w.leadership.OnLeader(f)
 }
 
+// OnSubsystemLeader registers f to be called when this replica acquires leadership for the given subsystem.
+// Each subsystem uses its own lock, independent of the one used by OnLeader, so the controllers of a subsystem
+// can run on a different replica than the rest of the controllers.
+func (w *Context) OnSubsystemLeader(subsystem string, f func(ctx context.Context) error) {
+	w.controllerLock.Lock()
+	defer w.controllerLock.Unlock()
+
+	leadership, ok := w.subsystemLeadership[subsystem]
+	if !ok {
+		leadership = leader.NewManager("", "cattle-controllers-"+subsystem, w.K8s)
+		w.subsystemLeadership[subsystem] = leadership
+	}
+	leadership.OnLeader(f)
+}
+
 func (w *Context) StartWithTransaction(ctx context.Context, f func(context.Context) error) (err error) {
 	transaction := controller.NewHandlerTransaction(ctx)
 	if err := f(transaction); err != nil {
@@ -219,6 +235,9 @@ func (w *Context) Start(ctx context.Context) error {
 		return err
 	}
 	w.leadership.Start(ctx)
+	for _, leadership := range w.subsystemLeadership {
+		leadership.Start(ctx)
+	}
 	return nil
 }
 
@@ -456,6 +475,7 @@ func NewContext(ctx context.Context, clientConfig clientcmd.ClientConfig, restCo
 		CachedDiscovery:         cache,
 		RESTMapper:              restMapper,
 		leadership:              leadership,
+		subsystemLeadership:     map[string]*leader.Manager{},
 		controllerLock:          &sync.Mutex{},
 		PeerManager:             peerManager,
 		RESTClientGetter:        restClientGetter,
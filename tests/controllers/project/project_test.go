@@ -0,0 +1,185 @@
+package project_integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apisv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/auth/project_cluster"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/multiclustermanager"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/rancher/rancher/tests/controllers/common"
+	"github.com/rancher/wrangler/v3/pkg/crd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+type ProjectTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	cancel            context.CancelFunc
+	testEnv           *envtest.Environment
+	managementContext *config.ManagementContext
+}
+
+const (
+	tick     = 1 * time.Second
+	duration = 20 * time.Second
+
+	clusterName               = "c-test"
+	crtbInProjectBindingOwner = "crtb-in-project-binding-owner"
+)
+
+func (s *ProjectTestSuite) SetupSuite() {
+	s.ctx, s.cancel = context.WithCancel(context.TODO())
+
+	// Start envtest
+	s.testEnv = &envtest.Environment{}
+	restCfg, err := s.testEnv.Start()
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), restCfg)
+
+	// Register CRDs
+	common.RegisterCRDs(s.ctx, s.T(), restCfg,
+		crd.CRD{
+			SchemaObject: v3.Project{},
+			Status:       true,
+		})
+
+	// Create wrangler context
+	wranglerContext, err := wrangler.NewContext(s.ctx, nil, restCfg)
+	assert.NoError(s.T(), err)
+
+	// Create management context
+	scaledContext, _, _, err := multiclustermanager.BuildScaledContext(s.ctx, wranglerContext, &multiclustermanager.Options{})
+	assert.NoError(s.T(), err)
+	s.managementContext, err = scaledContext.NewManagementContext()
+	assert.NoError(s.T(), err)
+
+	// Register controller
+	s.managementContext.Management.Projects("").AddLifecycle(s.ctx, project_cluster.ProjectRemoveController, project_cluster.NewProjectLifecycle(s.managementContext))
+
+	// Start controllers
+	common.StartNormanControllers(s.ctx, s.T(), s.managementContext,
+		schema.GroupVersionKind{
+			Group:   "management.cattle.io",
+			Version: "v3",
+			Kind:    "Project",
+		})
+
+	_, err = s.managementContext.Core.Namespaces("").Create(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+	})
+	assert.NoError(s.T(), err)
+}
+
+func (s *ProjectTestSuite) TearDownSuite() {
+	s.cancel()
+	err := s.testEnv.Stop()
+	assert.NoError(s.T(), err)
+}
+
+func (s *ProjectTestSuite) TestRemoveProjectDeletesCRTBRoleBindings() {
+	t := s.T()
+	const projectName = "p-test"
+
+	// The backing namespace of a project without status is named after the project.
+	_, err := s.managementContext.Core.Namespaces("").Create(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: projectName,
+		},
+	})
+	require.NoError(t, err)
+
+	crtbRoleBinding := func(name string) *rbacv1.RoleBinding {
+		return &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: projectName,
+				Labels: map[string]string{
+					"crtb-uid": crtbInProjectBindingOwner,
+				},
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "ClusterRole",
+				Name:     "crtb-role",
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					APIGroup: "rbac.authorization.k8s.io",
+					Kind:     "User",
+					Name:     "u-test",
+				},
+			},
+		}
+	}
+	otherRoleBinding := crtbRoleBinding("other-rb")
+	otherRoleBinding.Labels = map[string]string{"prtb-uid": "true"}
+
+	roleBindings := s.managementContext.RBAC.RoleBindings(projectName)
+	_, err = roleBindings.Create(crtbRoleBinding("crtb-rb"))
+	require.NoError(t, err)
+	_, err = roleBindings.Create(otherRoleBinding)
+	require.NoError(t, err)
+
+	projects := s.managementContext.Management.Projects(clusterName)
+	_, err = projects.Create(&v3.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      projectName,
+			Namespace: clusterName,
+		},
+		Spec: apisv3.ProjectSpec{
+			ClusterName: clusterName,
+			DisplayName: projectName,
+		},
+	})
+	require.NoError(t, err)
+
+	// Wait for the lifecycle to add its finalizer, so that the removal goes through it.
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		project, err := projects.Get(projectName, metav1.GetOptions{})
+		if assert.NoError(c, err) {
+			assert.NotEmpty(c, project.Finalizers)
+		}
+	}, duration, tick)
+
+	err = projects.Delete(projectName, &metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		_, err := projects.Get(projectName, metav1.GetOptions{})
+		assert.True(c, apierrors.IsNotFound(err))
+	}, duration, tick)
+
+	// testenv does not run the namespace controller, so the content of the terminating backing namespace is only
+	// deleted by the lifecycle.
+	ns, err := s.managementContext.Core.Namespaces("").Get(projectName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotNil(t, ns.DeletionTimestamp)
+
+	_, err = roleBindings.Get("crtb-rb", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = roleBindings.Get("other-rb", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// The CRTB controller can't grant new bindings in the terminating backing namespace.
+	_, err = roleBindings.Create(crtbRoleBinding("crtb-rb-late"))
+	assert.Error(t, err)
+}
+
+func TestProjectTestSuite(t *testing.T) {
+	suite.Run(t, new(ProjectTestSuite))
+}